@@ -45,6 +45,7 @@ var (
 	doShardReplications = flag.Bool("do-shard-replications", false, "copies the shard replication information")
 	doTablets           = flag.Bool("do-tablets", false, "copies the tablet information")
 	doRoutingRules      = flag.Bool("do-routing-rules", false, "copies the routing rules")
+	doServingGraph      = flag.Bool("do-serving-graph", false, "copies the cell-local SrvKeyspace and SrvVSchema records")
 )
 
 func main() {
@@ -92,6 +93,11 @@ func copyTopos(ctx context.Context, fromTS, toTS *topo.Server) {
 	if *doRoutingRules {
 		helpers.CopyRoutingRules(ctx, fromTS, toTS)
 	}
+	if *doServingGraph {
+		if err := helpers.CopyServingGraph(ctx, fromTS, toTS); err != nil {
+			log.Exitf("Copy serving graph failed: %v", err)
+		}
+	}
 }
 
 func compareTopos(ctx context.Context, fromTS, toTS *topo.Server) {
@@ -120,6 +126,12 @@ func compareTopos(ctx context.Context, fromTS, toTS *topo.Server) {
 			log.Exitf("Compare tablets failed: %v", err)
 		}
 	}
+	if *doServingGraph {
+		err = helpers.CompareServingGraph(ctx, fromTS, toTS)
+		if err != nil {
+			log.Exitf("Compare serving graph failed: %v", err)
+		}
+	}
 	if err == nil {
 		fmt.Println("Topologies are in sync")
 		os.Exit(0)
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vtgate/querycapture"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+/*
+
+  Vtreplay re-executes a workload captured by vtgate with
+  -query_capture_file against a vtgate, for performance regression
+  testing. For example, to replay a capture against a test keyspace
+  twice as fast as it was captured:
+
+  vtreplay \
+        -server vtgate-test.my.domain:15999 \
+        -capture_file /vt/logs/vtgate_capture.json \
+        -keyspace test_keyspace \
+        -speed 2
+
+*/
+
+var (
+	server      = flag.String("server", "", "vtgate server to connect to")
+	captureFile = flag.String("capture_file", "", "file written by vtgate -query_capture_file")
+	keyspace    = flag.String("keyspace", "", "if set, all the queries are sent to this keyspace instead of the captured one")
+	speed       = flag.Float64("speed", 1, "replay speed relative to the capture; 0 sends the queries as fast as possible")
+	concurrency = flag.Int("concurrency", 16, "maximum number of queries in flight")
+	timeout     = flag.Duration("timeout", 30*time.Second, "timeout for each query")
+)
+
+func main() {
+	logger := logutil.NewConsoleLogger()
+	flag.CommandLine.SetOutput(logutil.NewLoggerWriter(logger))
+
+	defer exit.Recover()
+
+	flag.Lookup("logtostderr").Value.Set("true")
+	flag.Parse()
+
+	if *server == "" || *captureFile == "" {
+		log.Exitf("vtreplay requires -server and -capture_file")
+	}
+	if *speed < 0 {
+		log.Exitf("invalid -speed %v", *speed)
+	}
+
+	f, err := os.Open(*captureFile)
+	if err != nil {
+		log.Exitf("cannot open capture file: %v", err)
+	}
+	defer f.Close()
+
+	ctx := context.Background()
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Exitf("cannot connect to %v: %v", *server, err)
+	}
+	defer conn.Close()
+
+	replayer := &querycapture.Replayer{
+		Speed:       *speed,
+		Concurrency: *concurrency,
+		Execute: func(ctx context.Context, q *querycapture.Query) error {
+			ctx, cancel := context.WithTimeout(ctx, *timeout)
+			defer cancel()
+			_, err := conn.Session(q.Target(*keyspace), nil).Execute(ctx, q.SQL, q.BindVariables)
+			if err != nil {
+				log.Warningf("%v: %v", q.SQL, err)
+			}
+			return err
+		},
+	}
+	start := time.Now()
+	stats, err := replayer.Replay(ctx, querycapture.NewReader(f))
+	if err != nil {
+		log.Errorf("replay stopped: %v", err)
+	}
+	fmt.Printf("Queries: %v\n", stats.Queries)
+	fmt.Printf("Errors: %v\n", stats.Errors)
+	fmt.Printf("Max Lag: %v\n", stats.Lag)
+	fmt.Printf("Total Time: %v\n", time.Since(start))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import "bytes"
+
+// binaryCollation is the collation of binary strings: values are
+// compared byte by byte, and trailing spaces are significant.
+type binaryCollation struct {
+	id   ID
+	name string
+}
+
+func (c *binaryCollation) ID() ID {
+	return c.id
+}
+
+func (c *binaryCollation) Name() string {
+	return c.name
+}
+
+func (c *binaryCollation) Collate(left, right []byte) int {
+	return bytes.Compare(left, right)
+}
+
+func (c *binaryCollation) WeightString(dst, src []byte) []byte {
+	return append(dst, src...)
+}
+
+// binPadCollation implements the _bin collations of the non binary
+// character sets. They compare bytes too, but are PAD SPACE: the shorter
+// value is compared as if it was padded with spaces, so trailing spaces
+// are ignored.
+type binPadCollation struct {
+	id   ID
+	name string
+}
+
+func (c *binPadCollation) ID() ID {
+	return c.id
+}
+
+func (c *binPadCollation) Name() string {
+	return c.name
+}
+
+func (c *binPadCollation) Collate(left, right []byte) int {
+	n := len(left)
+	if len(right) < n {
+		n = len(right)
+	}
+	if cmp := bytes.Compare(left[:n], right[:n]); cmp != 0 {
+		return cmp
+	}
+	if len(left) > n {
+		return comparePadding(left[n:])
+	}
+	return -comparePadding(right[n:])
+}
+
+func (c *binPadCollation) WeightString(dst, src []byte) []byte {
+	return append(dst, bytes.TrimRight(src, " ")...)
+}
+
+// comparePadding compares the remainder of the longer value of a PAD
+// SPACE comparison with the spaces the shorter one is padded with.
+func comparePadding(rest []byte) int {
+	for _, b := range rest {
+		switch {
+		case b < ' ':
+			return -1
+		case b > ' ':
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collations compares text values the way MySQL does, according
+// to the collation of their column. This lets vitess sort, deduplicate
+// and diff text values without asking MySQL for their weight strings.
+//
+// Only the collations registered in this package are supported. The
+// callers are expected to fall back to their previous behavior for the
+// other ones, which LookupByID reports as nil.
+package collations
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/collate"
+)
+
+// ID is the numeric identifier of a MySQL collation, as sent in the
+// column definitions of the protocol, and as found in the Charset field
+// of a querypb.Field.
+type ID uint32
+
+// Collation implements the comparison rules of a MySQL collation.
+// Implementations must be safe for concurrent use.
+type Collation interface {
+	// ID returns the numeric identifier of the collation.
+	ID() ID
+
+	// Name returns the name of the collation, as in SHOW COLLATION.
+	Name() string
+
+	// Collate compares left and right, and returns 0 if they are equal,
+	// a negative number if left sorts before right, and a positive
+	// number otherwise.
+	Collate(left, right []byte) int
+
+	// WeightString appends the weight string of src to dst, and returns
+	// the result. The weight strings of two values are equal if and only
+	// if Collate considers them equal, so they can be used to hash values.
+	WeightString(dst, src []byte) []byte
+}
+
+var (
+	mu     sync.RWMutex
+	byID   = make(map[ID]Collation)
+	byName = make(map[string]Collation)
+)
+
+// Register makes a collation available through LookupByID and
+// LookupByName. It replaces any collation with the same ID.
+func Register(coll Collation) {
+	mu.Lock()
+	defer mu.Unlock()
+	if old, ok := byID[coll.ID()]; ok {
+		delete(byName, old.Name())
+	}
+	byID[coll.ID()] = coll
+	byName[coll.Name()] = coll
+}
+
+// LookupByID returns the collation with the given ID, or nil if it is
+// not supported.
+func LookupByID(id ID) Collation {
+	mu.RLock()
+	defer mu.RUnlock()
+	return byID[id]
+}
+
+// LookupByName returns the collation with the given name, or nil if it
+// is not supported.
+func LookupByName(name string) Collation {
+	mu.RLock()
+	defer mu.RUnlock()
+	return byName[strings.ToLower(name)]
+}
+
+func init() {
+	for _, coll := range []Collation{
+		&binaryCollation{id: 63, name: "binary"},
+		&binaryCollation{id: 309, name: "utf8mb4_0900_bin"},
+		&binPadCollation{id: 46, name: "utf8mb4_bin"},
+		&binPadCollation{id: 83, name: "utf8_bin"},
+		&binPadCollation{id: 47, name: "latin1_bin"},
+		&binPadCollation{id: 65, name: "ascii_bin"},
+		&generalCollation{id: 33, name: "utf8_general_ci", utf8: true},
+		&generalCollation{id: 45, name: "utf8mb4_general_ci", utf8: true},
+		&generalCollation{id: 11, name: "ascii_general_ci"},
+		newUCACollation(255, "utf8mb4_0900_ai_ci", false, collate.Loose),
+		newUCACollation(278, "utf8mb4_0900_as_cs", false),
+		newUCACollation(305, "utf8mb4_0900_as_ci", false, collate.IgnoreCase),
+		newUCACollation(224, "utf8mb4_unicode_ci", true, collate.Loose),
+		newUCACollation(192, "utf8_unicode_ci", true, collate.Loose),
+		newUCACollation(246, "utf8mb4_unicode_520_ci", true, collate.Loose),
+		newUCACollation(214, "utf8_unicode_520_ci", true, collate.Loose),
+	} {
+		Register(coll)
+	}
+}
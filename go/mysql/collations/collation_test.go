@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	coll := LookupByID(45)
+	require.NotNil(t, coll)
+	assert.Equal(t, "utf8mb4_general_ci", coll.Name())
+	assert.Equal(t, coll, LookupByName("UTF8MB4_GENERAL_CI"))
+	assert.Nil(t, LookupByID(8))
+	assert.Nil(t, LookupByName("latin1_swedish_ci"))
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+func TestCollate(t *testing.T) {
+	testcases := []struct {
+		collation   string
+		left, right string
+		want        int
+	}{
+		{"binary", "abc", "abc", 0},
+		{"binary", "abc", "ABC", 1},
+		{"binary", "abc", "abc ", -1},
+		{"utf8mb4_0900_bin", "abc", "abc ", -1},
+		{"utf8mb4_bin", "abc", "abc  ", 0},
+		{"utf8mb4_bin", "abc", "abc\t", 1},
+		{"utf8mb4_bin", "abc", "abd", -1},
+		{"utf8mb4_bin", "abc", "ABC", 1},
+		{"latin1_bin", "a", "a ", 0},
+		{"utf8mb4_general_ci", "abc", "ABC", 0},
+		{"utf8mb4_general_ci", "résumé", "RESUME", 0},
+		{"utf8mb4_general_ci", "straße", "STRASE", 0},
+		{"utf8mb4_general_ci", "abc", "abc   ", 0},
+		{"utf8mb4_general_ci", "abc", "abd", -1},
+		{"utf8mb4_general_ci", "b", "A", 1},
+		{"utf8mb4_general_ci", "😀", "😺", 0},
+		{"utf8_general_ci", "Ünïcode", "unicode", 0},
+		{"ascii_general_ci", "Hello", "hELLO ", 0},
+		{"utf8mb4_0900_ai_ci", "résumé", "RESUME", 0},
+		{"utf8mb4_0900_ai_ci", "abc", "abc ", -1},
+		{"utf8mb4_0900_ai_ci", "a", "B", -1},
+		{"utf8mb4_0900_as_ci", "résumé", "RÉSUMÉ", 0},
+		{"utf8mb4_0900_as_ci", "résumé", "resume", 1},
+		{"utf8mb4_0900_as_cs", "abc", "ABC", -1},
+		{"utf8mb4_unicode_ci", "Abc", "abc  ", 0},
+		{"utf8mb4_unicode_520_ci", "ÀBC", "abc", 0},
+	}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s(%q,%q)", tc.collation, tc.left, tc.right), func(t *testing.T) {
+			coll := LookupByName(tc.collation)
+			require.NotNil(t, coll)
+			assert.Equal(t, tc.want, sign(coll.Collate([]byte(tc.left), []byte(tc.right))))
+			assert.Equal(t, -tc.want, sign(coll.Collate([]byte(tc.right), []byte(tc.left))))
+
+			lw := coll.WeightString(nil, []byte(tc.left))
+			rw := coll.WeightString(nil, []byte(tc.right))
+			assert.Equal(t, tc.want == 0, bytes.Equal(lw, rw), "weight strings %x and %x", lw, rw)
+		})
+	}
+}
+
+func TestWeightStringAppends(t *testing.T) {
+	coll := LookupByName("utf8mb4_general_ci")
+	dst := coll.WeightString([]byte("prefix"), []byte("a "))
+	assert.Equal(t, []byte("prefix\x00A"), dst)
+}
+
+func TestConcurrentCollate(t *testing.T) {
+	coll := LookupByName("utf8mb4_0900_ai_ci")
+	done := make(chan bool)
+	for i := 0; i < 4; i++ {
+		go func() {
+			for j := 0; j < 100; j++ {
+				assert.Equal(t, 0, coll.Collate([]byte("Été"), []byte("ete")))
+			}
+			done <- true
+		}()
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+}
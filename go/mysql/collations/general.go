@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// generalCollation implements the _general_ci collations. Every
+// character weighs as the upper case of its base letter, so case and
+// accents are ignored, and characters outside of the BMP all weigh the
+// same. The collations are PAD SPACE.
+type generalCollation struct {
+	id   ID
+	name string
+	// utf8 is false for single byte character sets, whose bytes are
+	// weighed as the code points of the same value.
+	utf8 bool
+}
+
+var (
+	generalWeightsOnce sync.Once
+	generalWeights     []uint16
+)
+
+// generalWeight returns the weight of r in the _general_ci collations.
+func generalWeight(r rune) uint16 {
+	if r > 0xFFFF || r < 0 {
+		return 0xFFFD
+	}
+	generalWeightsOnce.Do(func() {
+		generalWeights = make([]uint16, 0x10000)
+		var buf [utf8.UTFMax]byte
+		for r := rune(0); r <= 0xFFFF; r++ {
+			base := r
+			if r < 0xD800 || r > 0xDFFF {
+				n := utf8.EncodeRune(buf[:], r)
+				base, _ = utf8.DecodeRune(norm.NFD.Bytes(buf[:n]))
+			}
+			switch base {
+			case 'ß':
+				base = 'S'
+			default:
+				base = unicode.ToUpper(base)
+			}
+			if base > 0xFFFF {
+				base = 0xFFFD
+			}
+			generalWeights[r] = uint16(base)
+		}
+	})
+	return generalWeights[r]
+}
+
+func (c *generalCollation) ID() ID {
+	return c.id
+}
+
+func (c *generalCollation) Name() string {
+	return c.name
+}
+
+func (c *generalCollation) next(src []byte) (uint16, []byte) {
+	if !c.utf8 {
+		return generalWeight(rune(src[0])), src[1:]
+	}
+	r, n := utf8.DecodeRune(src)
+	return generalWeight(r), src[n:]
+}
+
+func (c *generalCollation) Collate(left, right []byte) int {
+	for len(left) > 0 && len(right) > 0 {
+		var wl, wr uint16
+		wl, left = c.next(left)
+		wr, right = c.next(right)
+		if wl != wr {
+			return int(wl) - int(wr)
+		}
+	}
+	if len(left) > 0 {
+		return c.comparePadding(left)
+	}
+	return -c.comparePadding(right)
+}
+
+func (c *generalCollation) comparePadding(rest []byte) int {
+	for len(rest) > 0 {
+		var w uint16
+		w, rest = c.next(rest)
+		if w != ' ' {
+			return int(w) - ' '
+		}
+	}
+	return 0
+}
+
+func (c *generalCollation) WeightString(dst, src []byte) []byte {
+	start := len(dst)
+	for len(src) > 0 {
+		var w uint16
+		w, src = c.next(src)
+		dst = append(dst, byte(w>>8), byte(w))
+	}
+	// Trailing spaces are not significant.
+	for len(dst)-start >= 2 && dst[len(dst)-2] == 0 && dst[len(dst)-1] == ' ' {
+		dst = dst[:len(dst)-2]
+	}
+	return dst
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// ucaCollation implements the collations based on the Unicode Collation
+// Algorithm, like utf8mb4_0900_ai_ci or utf8mb4_unicode_ci, with the
+// root collation of golang.org/x/text. The _0900_ collations are NO PAD,
+// the older ones are PAD SPACE.
+type ucaCollation struct {
+	id   ID
+	name string
+	pad  bool
+	// collators is a pool of *collate.Collator, which are not safe for
+	// concurrent use.
+	collators sync.Pool
+}
+
+func newUCACollation(id ID, name string, pad bool, options ...collate.Option) *ucaCollation {
+	c := &ucaCollation{id: id, name: name, pad: pad}
+	c.collators.New = func() interface{} {
+		return collate.New(language.Und, options...)
+	}
+	return c
+}
+
+func (c *ucaCollation) ID() ID {
+	return c.id
+}
+
+func (c *ucaCollation) Name() string {
+	return c.name
+}
+
+func (c *ucaCollation) trim(src []byte) []byte {
+	if c.pad {
+		return bytes.TrimRight(src, " ")
+	}
+	return src
+}
+
+func (c *ucaCollation) Collate(left, right []byte) int {
+	collator := c.collators.Get().(*collate.Collator)
+	defer c.collators.Put(collator)
+	return collator.Compare(c.trim(left), c.trim(right))
+}
+
+func (c *ucaCollation) WeightString(dst, src []byte) []byte {
+	collator := c.collators.Get().(*collate.Collator)
+	defer c.collators.Put(collator)
+	var buf collate.Buffer
+	return append(dst, collator.Key(&buf, c.trim(src))...)
+}
@@ -73,9 +73,9 @@ const (
 	// CLIENT_ODBC 1 << 6
 	// No special behavior since 3.22.
 
-	// CLIENT_LOCAL_FILES 1 << 7
+	// CapabilityClientLocalFiles is CLIENT_LOCAL_FILES.
 	// Client can use LOCAL INFILE request of LOAD DATA|XML.
-	// We do not set it.
+	CapabilityClientLocalFiles = 1 << 7
 
 	// CLIENT_IGNORE_SPACE 1 << 8
 	// Parser can ignore spaces before '('.
@@ -238,6 +238,9 @@ const (
 
 	// NullValue is the encoded value of NULL.
 	NullValue = 0xfb
+
+	// LocalInfilePacket is the header of the LOCAL INFILE request.
+	LocalInfilePacket = 0xfb
 )
 
 // Auth packet types
@@ -556,6 +559,15 @@ const (
 
 	// SSLockDeadlock is ER_LOCK_DEADLOCK
 	SSLockDeadlock = "40001"
+
+	// SSNoSuchTable is ER_NO_SUCH_TABLE
+	SSNoSuchTable = "42S02"
+
+	// SSNetPacketTooLarge is ER_NET_PACKET_TOO_LARGE
+	SSNetPacketTooLarge = "08S01"
+
+	// SSQueryInterrupted is ER_QUERY_INTERRUPTED
+	SSQueryInterrupted = "70100"
 )
 
 // A few interesting character set values.
@@ -613,6 +625,31 @@ var CharacterSetMap = map[string]uint8{
 	"eucjpms":  97,
 }
 
+// collationCharacterSets maps the collations that are not the default
+// one of their character set to it. The default collations are the
+// values of CharacterSetMap.
+var collationCharacterSets = map[uint8]string{
+	46:  "utf8mb4", // utf8mb4_bin
+	47:  "latin1",  // latin1_bin
+	48:  "latin1",  // latin1_general_ci
+	83:  "utf8",    // utf8_bin
+	192: "utf8",    // utf8_unicode_ci
+	224: "utf8mb4", // utf8mb4_unicode_ci
+	255: "utf8mb4", // utf8mb4_0900_ai_ci
+}
+
+// CharacterSetName returns the name of the character set of a collation
+// ID, like the one sent by a client in the handshake, or "" if it is
+// not known.
+func CharacterSetName(collation uint8) string {
+	for name, id := range CharacterSetMap {
+		if id == collation {
+			return name
+		}
+	}
+	return collationCharacterSets[collation]
+}
+
 // IsNum returns true if a MySQL type is a numeric value.
 // It is the same as IS_NUM defined in mysql.h.
 func IsNum(typ uint8) bool {
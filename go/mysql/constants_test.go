@@ -51,3 +51,28 @@ func TestIsConnErr(t *testing.T) {
 		}
 	}
 }
+
+func TestCharacterSetName(t *testing.T) {
+	testcases := []struct {
+		in   uint8
+		want string
+	}{{
+		in:   CharacterSetUtf8,
+		want: "utf8",
+	}, {
+		in:   8,
+		want: "latin1",
+	}, {
+		in:   224,
+		want: "utf8mb4",
+	}, {
+		in:   250,
+		want: "",
+	}}
+	for _, tcase := range testcases {
+		got := CharacterSetName(tcase.in)
+		if got != tcase.want {
+			t.Errorf("CharacterSetName(%d): %s, want %s", tcase.in, got, tcase.want)
+		}
+	}
+}
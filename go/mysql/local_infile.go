@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io"
+)
+
+// RequestLocalInfile asks the client to send the contents of a file, as
+// required to execute a LOAD DATA LOCAL INFILE statement. It must be
+// called from Handler.ComQuery, before any result is returned.
+// The returned reader streams the file contents as sent by the client.
+// It must be closed before returning from ComQuery, so the rest of the
+// file is consumed and the connection stays in sync.
+// Server -> Client -> Server.
+func (c *Conn) RequestLocalInfile(filename string) (io.ReadCloser, error) {
+	if c.Capabilities&CapabilityClientLocalFiles == 0 {
+		return nil, NewSQLError(ERNotAllowedCommand, SSSyntaxErrorOrAccessViolation, "The used command is not allowed with this MySQL version")
+	}
+
+	data, pos := c.startEphemeralPacketWithHeader(1 + len(filename))
+	pos = writeByte(data, pos, LocalInfilePacket)
+	writeEOFString(data, pos, filename)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return nil, NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	if err := c.flush(); err != nil {
+		return nil, NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	return &localInfileReader{c: c}, nil
+}
+
+// flush writes out any buffered data.
+func (c *Conn) flush() error {
+	c.bufMu.Lock()
+	defer c.bufMu.Unlock()
+
+	if c.bufferedWriter == nil {
+		return nil
+	}
+	c.stopFlushTimer()
+	return c.bufferedWriter.Flush()
+}
+
+// localInfileReader reads the file contents sent by the client in
+// response to a LOCAL INFILE request. The client sends the file as a
+// sequence of packets, terminated by an empty packet. Each packet is
+// read on its own, as a packet of MaxPacketSize is just a chunk of the
+// file here.
+type localInfileReader struct {
+	c    *Conn
+	buf  []byte
+	done bool
+	err  error
+}
+
+// Read is part of the io.Reader interface.
+func (r *localInfileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.done {
+			return 0, io.EOF
+		}
+		r.buf, r.err = r.c.readOnePacket()
+		if r.err != nil {
+			r.err = NewSQLError(CRServerLost, SSUnknownSQLState, "%v", r.err)
+			continue
+		}
+		if len(r.buf) == 0 {
+			r.done = true
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Close consumes the rest of the file.
+func (r *localInfileReader) Close() error {
+	for !r.done && r.err == nil {
+		r.buf, r.err = r.c.readOnePacket()
+		if len(r.buf) == 0 {
+			r.done = true
+		}
+	}
+	r.buf = nil
+	return r.err
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLocalInfile(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.Capabilities |= CapabilityClientLocalFiles
+
+	type result struct {
+		data []byte
+		err  error
+	}
+	done := make(chan result)
+	go func() {
+		r, err := sConn.RequestLocalInfile("data.csv")
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		defer r.Close()
+		data, err := ioutil.ReadAll(r)
+		done <- result{data: data, err: err}
+	}()
+
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{LocalInfilePacket}, "data.csv"...), data)
+
+	useWritePacket(t, cConn, []byte("1,a\n2,"))
+	useWritePacket(t, cConn, []byte("b\n"))
+	useWritePacket(t, cConn, nil)
+
+	res := <-done
+	require.NoError(t, res.err)
+	assert.Equal(t, "1,a\n2,b\n", string(res.data))
+}
+
+func TestRequestLocalInfileClose(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.Capabilities |= CapabilityClientLocalFiles
+
+	done := make(chan error)
+	go func() {
+		r, err := sConn.RequestLocalInfile("data.csv")
+		if err != nil {
+			done <- err
+			return
+		}
+		// Closing without reading must consume the whole file.
+		done <- r.Close()
+	}()
+
+	_, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	useWritePacket(t, cConn, []byte("1,a\n"))
+	useWritePacket(t, cConn, nil)
+	require.NoError(t, <-done)
+
+	// The connection is still in sync.
+	useWritePacket(t, cConn, []byte("next"))
+	data, err := sConn.ReadPacket()
+	require.NoError(t, err)
+	assert.Equal(t, "next", string(data))
+}
+
+func TestRequestLocalInfileNotAllowed(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	_, err := sConn.RequestLocalInfile("data.csv")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "The used command is not allowed with this MySQL version")
+}
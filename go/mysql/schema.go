@@ -17,6 +17,8 @@ limitations under the License.
 package mysql
 
 import (
+	"bytes"
+
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -32,8 +34,41 @@ import (
 const (
 	// BaseShowPrimary is the base query for fetching primary key info.
 	BaseShowPrimary = "SELECT table_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name='PRIMARY' ORDER BY table_name, ordinal_position"
+
+	// BaseShowForeignKeys is the base query for fetching the foreign keys.
+	BaseShowForeignKeys = "SELECT table_name, constraint_name, referenced_table_name, update_rule, delete_rule FROM information_schema.referential_constraints WHERE constraint_schema=database() ORDER BY table_name, constraint_name"
 )
 
+// BaseShowTablesForTables returns a query that shows the given tables
+// and their sizes, with the same fields as BaseShowTables.
+func BaseShowTablesForTables(tableNames []string) string {
+	var b bytes.Buffer
+	b.WriteString("SELECT t.table_name, t.table_type, unix_timestamp(t.create_time), t.table_comment, SUM(t.data_length + t.index_length), SUM(t.data_length + t.index_length) FROM information_schema.tables t WHERE t.table_schema = database() AND t.table_name IN (")
+	writeTableNames(&b, tableNames)
+	b.WriteString(") GROUP BY t.table_name, t.table_type, unix_timestamp(t.create_time), t.table_comment")
+	return b.String()
+}
+
+// BaseShowPartitionsForTables returns a query that fetches the partitions
+// of the given tables, if they are partitioned. The subpartitions are not
+// listed.
+func BaseShowPartitionsForTables(tableNames []string) string {
+	var b bytes.Buffer
+	b.WriteString("SELECT table_name, partition_name, partition_method, partition_expression, partition_description FROM information_schema.partitions WHERE table_schema=database() AND table_name IN (")
+	writeTableNames(&b, tableNames)
+	b.WriteString(") AND partition_name IS NOT NULL AND (subpartition_ordinal_position IS NULL OR subpartition_ordinal_position=1) ORDER BY table_name, partition_ordinal_position")
+	return b.String()
+}
+
+func writeTableNames(b *bytes.Buffer, tableNames []string) {
+	for i, tableName := range tableNames {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		sqltypes.NewVarChar(tableName).EncodeSQL(b)
+	}
+}
+
 // BaseShowTablesFields contains the fields returned by a BaseShowTables or a BaseShowTablesForTable command.
 // They are validated by the
 // testBaseShowTables test.
@@ -120,3 +155,61 @@ func ShowPrimaryRow(tableName, colName string) []sqltypes.Value {
 		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
 	}
 }
+
+// ShowPartitionsFields contains the fields for a BaseShowPartitionsForTables.
+var ShowPartitionsFields = []*querypb.Field{{
+	Name: "table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "partition_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "partition_method",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "partition_expression",
+	Type: sqltypes.Text,
+}, {
+	Name: "partition_description",
+	Type: sqltypes.Text,
+}}
+
+// ShowPartitionsRow returns a row for a partition.
+func ShowPartitionsRow(tableName, partitionName, method, expression, description string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(partitionName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(method)),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte(expression)),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte(description)),
+	}
+}
+
+// ShowForeignKeysFields contains the fields for a BaseShowForeignKeys.
+var ShowForeignKeysFields = []*querypb.Field{{
+	Name: "table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "constraint_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "referenced_table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "update_rule",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "delete_rule",
+	Type: sqltypes.VarChar,
+}}
+
+// ShowForeignKeysRow returns a row for a foreign key.
+func ShowForeignKeysRow(tableName, constraintName, referencedTableName, updateRule, deleteRule string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(constraintName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(referencedTableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(updateRule)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(deleteRule)),
+	}
+}
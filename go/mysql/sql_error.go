@@ -81,7 +81,8 @@ func (se *SQLError) SQLState() string {
 var errExtract = regexp.MustCompile(`.*\(errno ([0-9]*)\) \(sqlstate ([0-9a-zA-Z]{5})\).*`)
 
 // NewSQLErrorFromError returns a *SQLError from the provided error.
-// If it's not the right type, it still tries to get it from a regexp.
+// If it's not the right type, it uses the state of the vterror, or
+// it still tries to get it from a regexp.
 func NewSQLErrorFromError(err error) error {
 	if err == nil {
 		return nil
@@ -92,6 +93,16 @@ func NewSQLErrorFromError(err error) error {
 	}
 
 	msg := err.Error()
+	if state := vterrors.ErrState(err); state != vterrors.Undefined {
+		if code, ok := stateToMysqlCode[state]; ok {
+			return &SQLError{
+				Num:     code.num,
+				State:   code.state,
+				Message: msg,
+			}
+		}
+	}
+
 	match := errExtract.FindStringSubmatch(msg)
 	if len(match) < 2 {
 		// Map vitess error codes into the mysql equivalent
@@ -162,6 +173,36 @@ func NewSQLErrorFromError(err error) error {
 	return serr
 }
 
+type mysqlCode struct {
+	num   int
+	state string
+}
+
+// stateToMysqlCode maps the states of the vterrors to the MySQL error
+// numbers and SQLSTATEs the clients expect for them. TooManyConnections
+// is sent with the generic HY000 state rather than the 08004 one of
+// MySQL, which drivers take as a broken connection: a full vttablet
+// pool only rejects the query, and the connection stays usable.
+var stateToMysqlCode = map[vterrors.State]mysqlCode{
+	vterrors.NoSuchThread:           {num: ERNoSuchThread, state: SSUnknownSQLState},
+	vterrors.SyntaxError:            {num: ERParseError, state: SSSyntaxErrorOrAccessViolation},
+	vterrors.EmptyQuery:             {num: EREmptyQuery, state: SSSyntaxErrorOrAccessViolation},
+	vterrors.BadFieldError:          {num: ERBadFieldError, state: SSBadFieldError},
+	vterrors.NoSuchTable:            {num: ERNoSuchTable, state: SSNoSuchTable},
+	vterrors.UnknownSystemVariable:  {num: ERUnknownSystemVariable, state: SSUnknownSQLState},
+	vterrors.WrongValueForVar:       {num: ERWrongValueForVar, state: SSSyntaxErrorOrAccessViolation},
+	vterrors.LockDeadlock:           {num: ERLockDeadlock, state: SSLockDeadlock},
+	vterrors.LockWaitTimeout:        {num: ERLockWaitTimeout, state: SSUnknownSQLState},
+	vterrors.QueryInterrupted:       {num: ERQueryInterrupted, state: SSQueryInterrupted},
+	vterrors.TooManyConnections:     {num: ERConCount, state: SSUnknownSQLState},
+	vterrors.TooManyUserConnections: {num: ERTooManyUserConnections, state: SSSyntaxErrorOrAccessViolation},
+	vterrors.NetPacketTooLarge:      {num: ERNetPacketTooLarge, state: SSNetPacketTooLarge},
+	vterrors.ReadOnly:               {num: EROptionPreventsStatement, state: SSUnknownSQLState},
+	vterrors.AccessDenied:           {num: ERAccessDeniedError, state: SSAccessDeniedError},
+	vterrors.KillDenied:             {num: ERKillDenied, state: SSUnknownSQLState},
+	vterrors.NotSupportedYet:        {num: ERNotSupportedYet, state: SSSyntaxErrorOrAccessViolation},
+}
+
 var isGRPCOverflowRE = regexp.MustCompile(`.*grpc: received message larger than max \(\d+ vs. \d+\)`)
 
 func demuxResourceExhaustedErrors(msg string) int {
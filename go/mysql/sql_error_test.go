@@ -20,6 +20,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 func TestDumuxResourceExhaustedErrors(t *testing.T) {
@@ -43,3 +48,51 @@ func TestDumuxResourceExhaustedErrors(t *testing.T) {
 		assert.Equalf(t, c.want, got, c.msg)
 	}
 }
+
+func TestNewSQLErrorFromError(t *testing.T) {
+	testcases := []struct {
+		err   error
+		num   int
+		state string
+	}{{
+		err:   NewSQLError(ERLockDeadlock, SSLockDeadlock, "deadlock"),
+		num:   ERLockDeadlock,
+		state: SSLockDeadlock,
+	}, {
+		err:   vterrors.NewErrorf(vtrpcpb.Code_ABORTED, vterrors.LockDeadlock, "deadlock"),
+		num:   ERLockDeadlock,
+		state: SSLockDeadlock,
+	}, {
+		err:   vterrors.Wrap(vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.LockWaitTimeout, "too many queued transactions"), "target: ks.0.master"),
+		num:   ERLockWaitTimeout,
+		state: SSUnknownSQLState,
+	}, {
+		err:   vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "target: ks.0.master: vttablet: transaction pool connection limit exceeded (errno 1040) (sqlstate HY000)"),
+		num:   ERConCount,
+		state: SSUnknownSQLState,
+	}, {
+		err:   vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.TooManyConnections, "transaction pool connection limit exceeded"),
+		num:   ERConCount,
+		state: SSUnknownSQLState,
+	}, {
+		err:   vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "syntax error at position 5"),
+		num:   ERParseError,
+		state: SSSyntaxErrorOrAccessViolation,
+	}, {
+		err:   vterrors.Errorf(vtrpcpb.Code_ABORTED, "target: ks.0.master: vttablet: deadlock (errno 1213) (sqlstate 40001)"),
+		num:   ERLockDeadlock,
+		state: SSLockDeadlock,
+	}, {
+		err:   vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not allowed"),
+		num:   ERAccessDeniedError,
+		state: SSUnknownSQLState,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.err.Error(), func(t *testing.T) {
+			serr, ok := NewSQLErrorFromError(tcase.err).(*SQLError)
+			require.True(t, ok)
+			assert.Equal(t, tcase.num, serr.Number())
+			assert.Equal(t, tcase.state, serr.SQLState())
+		})
+	}
+}
@@ -39,7 +39,7 @@ func newMetricFuncCollector(v stats.Variable, name string, vt prometheus.ValueTy
 			name,
 			v.Help(),
 			nil,
-			nil),
+			constLabels),
 		vt: vt}
 
 	// Will panic if it fails
@@ -75,7 +75,7 @@ func newCountersWithSingleLabelCollector(c *stats.CountersWithSingleLabel, name
 			name,
 			c.Help(),
 			[]string{labelName},
-			nil),
+			constLabels),
 		vt: vt}
 
 	prometheus.MustRegister(collector)
@@ -112,7 +112,7 @@ func newGaugesWithSingleLabelCollector(g *stats.GaugesWithSingleLabel, name stri
 			name,
 			g.Help(),
 			[]string{labelName},
-			nil),
+			constLabels),
 		vt: vt}
 
 	prometheus.MustRegister(collector)
@@ -147,7 +147,7 @@ func newMetricWithMultiLabelsCollector(cml *stats.CountersWithMultiLabels, name
 			name,
 			cml.Help(),
 			labelsToSnake(cml.Labels()),
-			nil),
+			constLabels),
 	}
 
 	prometheus.MustRegister(c)
@@ -184,7 +184,7 @@ func newGaugesWithMultiLabelsCollector(gml *stats.GaugesWithMultiLabels, name st
 			name,
 			gml.Help(),
 			labelsToSnake(gml.Labels()),
-			nil),
+			constLabels),
 	}
 
 	prometheus.MustRegister(c)
@@ -222,7 +222,7 @@ func newMetricsFuncWithMultiLabelsCollector(cfml *stats.CountersFuncWithMultiLab
 			name,
 			cfml.Help(),
 			labelsToSnake(cfml.Labels()),
-			nil),
+			constLabels),
 		vt: vt,
 	}
 
@@ -266,8 +266,8 @@ func newTimingsCollector(t *stats.Timings, name string) {
 		desc: prometheus.NewDesc(
 			name,
 			t.Help(),
-			[]string{t.Label()},
-			nil),
+			[]string{labelName(t.Label())},
+			constLabels),
 	}
 
 	prometheus.MustRegister(collector)
@@ -324,7 +324,7 @@ func newMultiTimingsCollector(mt *stats.MultiTimings, name string) {
 			name,
 			mt.Help(),
 			labelsToSnake(mt.Labels()),
-			nil),
+			constLabels),
 	}
 
 	prometheus.MustRegister(collector)
@@ -372,7 +372,7 @@ func newHistogramCollector(h *stats.Histogram, name string) {
 			name,
 			h.Help(),
 			[]string{},
-			nil),
+			constLabels),
 	}
 
 	prometheus.MustRegister(collector)
@@ -18,6 +18,8 @@ package prometheusbackend
 
 import (
 	"expvar"
+	"flag"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -35,15 +37,60 @@ type PromBackend struct {
 
 var (
 	be PromBackend
+
+	snakeCaseLabels  = flag.Bool("prometheus_snake_case_labels", false, "snake case the label names of the metrics with a single label and of the timings, like the ones of the metrics with multiple labels; e.g. TableName is exported as table_name")
+	labelRenamesFlag = flag.String("prometheus_label_renames", "", "comma separated list of from:to renames applied to the exported label names, so that the same dimension has the same label in all metrics, e.g. table_name:table,plan_id:plan")
+	constLabelsFlag  = flag.String("prometheus_const_labels", "", "comma separated list of name=value labels added to all the exported metrics, e.g. keyspace=commerce,shard=-80")
+
+	// labelRenames and constLabels are set by Init from the flags.
+	labelRenames map[string]string
+	constLabels  prometheus.Labels
 )
 
 // Init initializes the Prometheus be with the given namespace.
 func Init(namespace string) {
+	var err error
+	if labelRenames, err = parseLabelRenames(*labelRenamesFlag); err != nil {
+		log.Fatalf("prometheus: invalid -prometheus_label_renames: %v", err)
+	}
+	if constLabels, err = parseConstLabels(*constLabelsFlag); err != nil {
+		log.Fatalf("prometheus: invalid -prometheus_const_labels: %v", err)
+	}
 	http.Handle("/metrics", promhttp.Handler())
 	be.namespace = namespace
 	stats.Register(be.publishPrometheusMetric)
 }
 
+func parseLabelRenames(value string) (map[string]string, error) {
+	renames := make(map[string]string)
+	if value == "" {
+		return renames, nil
+	}
+	for _, rename := range strings.Split(value, ",") {
+		parts := strings.Split(rename, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("expected from:to, got %q", rename)
+		}
+		renames[parts[0]] = parts[1]
+	}
+	return renames, nil
+}
+
+func parseConstLabels(value string) (prometheus.Labels, error) {
+	if value == "" {
+		return nil, nil
+	}
+	labels := make(prometheus.Labels)
+	for _, label := range strings.Split(value, ",") {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("expected name=value, got %q", label)
+		}
+		labels[parts[0]] = parts[1]
+	}
+	return labels, nil
+}
+
 // PublishPromMetric is used to publish the metric to Prometheus.
 func (be PromBackend) publishPrometheusMetric(name string, v expvar.Var) {
 	switch st := v.(type) {
@@ -58,7 +105,7 @@ func (be PromBackend) publishPrometheusMetric(name string, v expvar.Var) {
 	case stats.FloatFunc:
 		newMetricFuncCollector(st, be.buildPromName(name), prometheus.GaugeValue, func() float64 { return (st)() })
 	case *stats.CountersWithSingleLabel:
-		newCountersWithSingleLabelCollector(st, be.buildPromName(name), st.Label(), prometheus.CounterValue)
+		newCountersWithSingleLabelCollector(st, be.buildPromName(name), labelName(st.Label()), prometheus.CounterValue)
 	case *stats.CountersWithMultiLabels:
 		newMetricWithMultiLabelsCollector(st, be.buildPromName(name))
 	case *stats.CountersFuncWithMultiLabels:
@@ -66,7 +113,7 @@ func (be PromBackend) publishPrometheusMetric(name string, v expvar.Var) {
 	case *stats.GaugesFuncWithMultiLabels:
 		newMetricsFuncWithMultiLabelsCollector(&st.CountersFuncWithMultiLabels, be.buildPromName(name), prometheus.GaugeValue)
 	case *stats.GaugesWithSingleLabel:
-		newGaugesWithSingleLabelCollector(st, be.buildPromName(name), st.Label(), prometheus.GaugeValue)
+		newGaugesWithSingleLabelCollector(st, be.buildPromName(name), labelName(st.Label()), prometheus.GaugeValue)
 	case *stats.GaugesWithMultiLabels:
 		newGaugesWithMultiLabelsCollector(st, be.buildPromName(name))
 	case *stats.CounterDuration:
@@ -97,14 +144,32 @@ func (be PromBackend) buildPromName(name string) string {
 	return prometheus.BuildFQName("", be.namespace, s)
 }
 
+// labelName returns the exported name of the label of a metric with a
+// single label or of a timing. The label is exported as is, unless
+// -prometheus_snake_case_labels is set, and renamed as per
+// -prometheus_label_renames.
+func labelName(label string) string {
+	if *snakeCaseLabels {
+		label = normalizeMetric(label)
+	}
+	return renameLabel(label)
+}
+
 func labelsToSnake(labels []string) []string {
 	output := make([]string, len(labels))
 	for i, l := range labels {
-		output[i] = normalizeMetric(l)
+		output[i] = renameLabel(normalizeMetric(l))
 	}
 	return output
 }
 
+func renameLabel(name string) string {
+	if rename, ok := labelRenames[name]; ok {
+		return rename
+	}
+	return name
+}
+
 // normalizeMetricForPrometheus produces a compliant name by applying
 // special case conversions and then applying a camel case to snake case converter.
 func normalizeMetric(name string) string {
@@ -27,7 +27,10 @@ import (
 
 	"vitess.io/vitess/go/stats"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const namespace = "namespace"
@@ -340,6 +343,92 @@ func TestPrometheusHistogram(t *testing.T) {
 	}
 }
 
+func TestPrometheusSingleLabelNames(t *testing.T) {
+	// The label names of the metrics with a single label are exported
+	// as is by default.
+	name := "blah_singlelabelname"
+	g := stats.NewGaugesWithSingleLabel(name, "help", "TableName")
+	g.Set("t1", 2)
+	checkHandlerForMetricWithSingleLabel(t, name, "TableName", "t1", 2)
+
+	*snakeCaseLabels = true
+	defer func() { *snakeCaseLabels = false }()
+	name = "blah_snakecasedsinglelabelname"
+	g = stats.NewGaugesWithSingleLabel(name, "help", "TableName")
+	g.Set("t1", 2)
+	checkHandlerForMetricWithSingleLabel(t, name, "table_name", "t1", 2)
+}
+
+func TestPrometheusLabelRenames(t *testing.T) {
+	labelRenames = map[string]string{"table_name": "table", "plan_id": "plan", "plan_type": "plan"}
+	*snakeCaseLabels = true
+	defer func() {
+		labelRenames = nil
+		*snakeCaseLabels = false
+	}()
+
+	name := "blah_renamedlabels"
+	labels := []string{"PlanID", "TableName"}
+	labelValues := []string{"Select", "t1"}
+	c := stats.NewCountersWithMultiLabels(name, "help", labels)
+	c.Add(labelValues, 1)
+	checkHandlerForMetricWithMultiLabels(t, name, []string{"plan", "table"}, labelValues, 1)
+
+	name = "blah_renamedsinglelabel"
+	g := stats.NewGaugesWithSingleLabel(name, "help", "TableName")
+	g.Set("t1", 2)
+	checkHandlerForMetricWithSingleLabel(t, name, "table", "t1", 2)
+
+	name = "blah_renamedtimings"
+	timing := stats.NewTimings(name, "help", "plan_type")
+	timing.Add("Select", time.Millisecond)
+	response := testMetricsHandler(t)
+	expected := fmt.Sprintf("%s_%s_count{plan=\"Select\"} 1", namespace, name)
+	if !strings.Contains(response.Body.String(), expected) {
+		t.Fatalf("Expected %s got %s", expected, response.Body.String())
+	}
+}
+
+func TestPrometheusConstLabels(t *testing.T) {
+	constLabels = prometheus.Labels{"shard": "-80"}
+	defer func() { constLabels = nil }()
+
+	name := "blah_constlabels"
+	c := stats.NewCountersWithSingleLabel(name, "help", "label")
+	c.Add("tag1", 3)
+	response := testMetricsHandler(t)
+	expected := fmt.Sprintf("%s_%s{label=\"tag1\",shard=\"-80\"} 3", namespace, name)
+	if !strings.Contains(response.Body.String(), expected) {
+		t.Fatalf("Expected %s got %s", expected, response.Body.String())
+	}
+}
+
+func TestParseLabelRenames(t *testing.T) {
+	renames, err := parseLabelRenames("table_name:table,plan_id:plan")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"table_name": "table", "plan_id": "plan"}, renames)
+
+	renames, err = parseLabelRenames("")
+	require.NoError(t, err)
+	assert.Empty(t, renames)
+
+	_, err = parseLabelRenames("table_name")
+	require.Error(t, err)
+}
+
+func TestParseConstLabels(t *testing.T) {
+	labels, err := parseConstLabels("keyspace=commerce,shard=-80")
+	require.NoError(t, err)
+	assert.Equal(t, prometheus.Labels{"keyspace": "commerce", "shard": "-80"}, labels)
+
+	labels, err = parseConstLabels("")
+	require.NoError(t, err)
+	assert.Nil(t, labels)
+
+	_, err = parseConstLabels("keyspace")
+	require.Error(t, err)
+}
+
 func testMetricsHandler(t *testing.T) *httptest.ResponseRecorder {
 	req, _ := http.NewRequest("GET", "/metrics", nil)
 	response := httptest.NewRecorder()
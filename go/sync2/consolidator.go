@@ -30,6 +30,9 @@ type Consolidator struct {
 
 	mu      sync.Mutex
 	queries map[string]*Result
+	// waiting counts the duplicate queries currently waiting
+	// for the result of the original one.
+	waiting AtomicInt64
 }
 
 // NewConsolidator creates a new Consolidator
@@ -81,9 +84,17 @@ func (rs *Result) Broadcast() {
 // be invoked for duplicate queries.
 func (rs *Result) Wait() {
 	rs.consolidator.Record(rs.query)
+	rs.consolidator.waiting.Add(1)
+	defer rs.consolidator.waiting.Add(-1)
 	rs.executing.RLock()
 }
 
+// Waiting returns the number of duplicate queries currently
+// waiting for the original query to complete.
+func (co *Consolidator) Waiting() int64 {
+	return co.waiting.Get()
+}
+
 // ConsolidatorCache is a thread-safe object used for counting how often recent
 // queries have been consolidated.
 // It is also used by the txserializer package to count how often transactions
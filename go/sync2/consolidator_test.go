@@ -50,6 +50,9 @@ func TestConsolidator(t *testing.T) {
 		orig.Broadcast()
 	}()
 	dup.Wait()
+	if con.Waiting() != 0 {
+		t.Errorf("expected no waiting queries, got %d", con.Waiting())
+	}
 
 	if *orig.Result.(*int) != result {
 		t.Errorf("failed to pass result")
@@ -79,6 +79,9 @@ type LocalProcessCluster struct {
 	VtbackupProcess VtbackupProcess
 	VtorcProcess    *VtorcProcess
 
+	// vtgates started with StartCellVtgate, by cell
+	CellVtgates map[string]*VtgateProcess
+
 	nextPortForProcess int
 
 	//Extra arguments for vtTablet
@@ -533,6 +536,12 @@ func (cluster *LocalProcessCluster) Teardown() {
 		log.Errorf("Error in vtgate teardown: %v", err)
 	}
 
+	for cell, vtgate := range cluster.CellVtgates {
+		if err := vtgate.TearDown(); err != nil {
+			log.Errorf("Error in vtgate teardown in cell %s: %v", cell, err)
+		}
+	}
+
 	if cluster.VtorcProcess != nil {
 		if err := cluster.VtorcProcess.TearDown(); err != nil {
 			log.Errorf("Error in vtorc teardown: %v", err)
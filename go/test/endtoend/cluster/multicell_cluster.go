@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// CellTablets describes the tablets of every shard of a keyspace in a cell
+type CellTablets struct {
+	Cell string
+	// ReplicaCount is the number of replicas, excluding the master
+	ReplicaCount int
+	// Rdonly is whether the cell has a rdonly tablet
+	Rdonly bool
+}
+
+// AddCell creates a cell, in addition to the cell of the cluster which
+// is created by StartTopo
+func (cluster *LocalProcessCluster) AddCell(cell string) (err error) {
+	if *cluster.TopoFlavorString() == "etcd2" {
+		if err = cluster.TopoProcess.ManageTopoDir("mkdir", "/vitess/"+cell); err != nil {
+			log.Error(err.Error())
+			return
+		}
+	}
+	if !cluster.ReusingVTDATAROOT {
+		if err = cluster.VtctlProcess.AddCellInfo(cell); err != nil {
+			log.Error(err)
+			return
+		}
+	}
+	return nil
+}
+
+// StartMultiCellKeyspace starts the shards of a keyspace with tablets in
+// several cells. The master of every shard is in the first cell, in
+// addition to the tablets described for it.
+// customizers: functions like "func(*VttabletProcess)" that can modify
+// settings of the vttablets after they're created.
+func (cluster *LocalProcessCluster) StartMultiCellKeyspace(keyspace Keyspace, shardNames []string, cells []CellTablets, customizers ...interface{}) (err error) {
+	if len(cells) == 0 {
+		return fmt.Errorf("no cells for keyspace %v", keyspace.Name)
+	}
+	log.Infof("Starting keyspace: %v in cells %v", keyspace.Name, cells)
+	if !cluster.ReusingVTDATAROOT {
+		_ = cluster.VtctlProcess.CreateKeyspace(keyspace.Name)
+	}
+	for _, shardName := range shardNames {
+		shard := &Shard{
+			Name: shardName,
+		}
+		log.Infof("Starting shard: %v", shardName)
+		for i, cellTablets := range cells {
+			types := make([]string, cellTablets.ReplicaCount)
+			for j := range types {
+				types[j] = "replica"
+			}
+			if i == 0 {
+				types = append([]string{"master"}, types...)
+			}
+			if cellTablets.Rdonly {
+				types = append(types, "rdonly")
+			}
+			for _, tabletType := range types {
+				tablet := cluster.NewVttabletInstance(tabletType, 0, cellTablets.Cell)
+				tablet.MysqlctlProcess = *MysqlCtlProcessInstanceOptionalInit(tablet.TabletUID, tablet.MySQLPort, cluster.TmpDirectory, !cluster.ReusingVTDATAROOT)
+				tablet.VttabletProcess = VttabletProcessInstance(
+					tablet.HTTPPort,
+					tablet.GrpcPort,
+					tablet.TabletUID,
+					tablet.Cell,
+					shardName,
+					keyspace.Name,
+					cluster.VtctldProcess.Port,
+					tablet.Type,
+					cluster.TopoProcess.Port,
+					cluster.Hostname,
+					cluster.TmpDirectory,
+					cluster.VtTabletExtraArgs,
+					cluster.EnableSemiSync)
+				if cluster.ReusingVTDATAROOT {
+					tablet.VttabletProcess.ServingStatus = "SERVING"
+				}
+				for _, customizer := range customizers {
+					if f, ok := customizer.(func(*VttabletProcess)); ok {
+						f(tablet.VttabletProcess)
+					} else {
+						return fmt.Errorf("type mismatch on customizer: %T", customizer)
+					}
+				}
+				shard.Vttablets = append(shard.Vttablets, tablet)
+			}
+		}
+
+		var mysqlctlProcessList []*exec.Cmd
+		for _, tablet := range shard.Vttablets {
+			log.Infof("Starting mysqlctl for table uid %d, mysql port %d", tablet.TabletUID, tablet.MySQLPort)
+			proc, err := tablet.MysqlctlProcess.StartProcess()
+			if err != nil {
+				log.Errorf("error starting mysqlctl process: %v, %v", tablet.MysqlctlProcess, err)
+				return err
+			}
+			mysqlctlProcessList = append(mysqlctlProcessList, proc)
+		}
+		for _, proc := range mysqlctlProcessList {
+			if err = proc.Wait(); err != nil {
+				log.Errorf("unable to start mysql process %v: %v", proc, err)
+				return err
+			}
+		}
+		for _, tablet := range shard.Vttablets {
+			if !cluster.ReusingVTDATAROOT {
+				if _, err = tablet.VttabletProcess.QueryTablet(fmt.Sprintf("create database vt_%s", keyspace.Name), keyspace.Name, false); err != nil {
+					log.Errorf("error creating database for keyspace %v: %v", keyspace.Name, err)
+					return
+				}
+			}
+			log.Infof("Starting vttablet for tablet uid %d, grpc port %d", tablet.TabletUID, tablet.GrpcPort)
+			if err = tablet.VttabletProcess.Setup(); err != nil {
+				log.Errorf("error starting vttablet for tablet uid %d, grpc port %d: %v", tablet.TabletUID, tablet.GrpcPort, err)
+				return
+			}
+		}
+
+		master := shard.MasterTablet()
+		if err = cluster.VtctlclientProcess.InitShardMaster(keyspace.Name, shardName, master.Cell, master.TabletUID); err != nil {
+			log.Errorf("error running ISM on keyspace %v, shard %v: %v", keyspace.Name, shardName, err)
+			return
+		}
+		keyspace.Shards = append(keyspace.Shards, *shard)
+	}
+	cluster.Keyspaces = append(cluster.Keyspaces, keyspace)
+
+	if keyspace.SchemaSQL != "" {
+		if err = cluster.VtctlclientProcess.ApplySchema(keyspace.Name, keyspace.SchemaSQL); err != nil {
+			log.Errorf("error applying schema: %v, %v", keyspace.SchemaSQL, err)
+			return
+		}
+	}
+	if keyspace.VSchema != "" {
+		if err = cluster.VtctlclientProcess.ApplyVSchema(keyspace.Name, keyspace.VSchema); err != nil {
+			log.Errorf("error applying vschema: %v, %v", keyspace.VSchema, err)
+			return
+		}
+	}
+
+	log.Infof("Done creating keyspace: %v ", keyspace.Name)
+	return
+}
+
+// StartCellVtgate starts a vtgate in a cell, which watches the tablets
+// of cellsToWatch, or of its own cell if there are none. The vtgates of
+// the cells are torn down with the cluster.
+func (cluster *LocalProcessCluster) StartCellVtgate(cell string, cellsToWatch ...string) (*VtgateProcess, error) {
+	if len(cellsToWatch) == 0 {
+		cellsToWatch = []string{cell}
+	}
+	vtgate := VtgateProcessInstance(
+		cluster.GetAndReservePort(),
+		cluster.GetAndReservePort(),
+		cluster.GetAndReservePort(),
+		cell,
+		strings.Join(cellsToWatch, ","),
+		cluster.Hostname,
+		"MASTER,REPLICA",
+		cluster.TopoProcess.Port,
+		cluster.TmpDirectory,
+		cluster.VtGateExtraArgs)
+	// The vtgates of the cells share the directory of the cluster.
+	vtgate.Name = "vtgate-" + cell
+	vtgate.FileToLogQueries = path.Join(cluster.TmpDirectory, fmt.Sprintf("/vtgate_%s_querylog.txt", cell))
+	vtgate.MySQLServerSocketPath = path.Join(cluster.TmpDirectory, fmt.Sprintf("mysql_%s.sock", cell))
+	if cluster.CellVtgates == nil {
+		cluster.CellVtgates = make(map[string]*VtgateProcess)
+	}
+	cluster.CellVtgates[cell] = vtgate
+	log.Infof("Starting vtgate in cell %s on port %d", cell, vtgate.Port)
+	return vtgate, vtgate.Setup()
+}
+
+// CellVtgateParams returns the parameters to connect to the MySQL
+// server of the vtgate of a cell
+func (cluster *LocalProcessCluster) CellVtgateParams(cell string) mysql.ConnParams {
+	return mysql.ConnParams{
+		Host: cluster.Hostname,
+		Port: cluster.CellVtgates[cell].MySQLServerPort,
+	}
+}
+
+// RestartCellVtgate stops and starts again the vtgate of a cell
+func (cluster *LocalProcessCluster) RestartCellVtgate(cell string) error {
+	vtgate, ok := cluster.CellVtgates[cell]
+	if !ok {
+		return fmt.Errorf("no vtgate in cell %s", cell)
+	}
+	if err := vtgate.TearDown(); err != nil {
+		log.Errorf("error stopping vtgate %v: %v", vtgate, err)
+		return err
+	}
+	return vtgate.Setup()
+}
+
+// KillVttablet kills the vttablet of a tablet, as if it crashed.
+// Its MySQL is left running.
+func (cluster *LocalProcessCluster) KillVttablet(tablet *Vttablet) {
+	log.Infof("Killing vttablet %s", tablet.Alias)
+	tablet.VttabletProcess.Kill()
+}
+
+// RestartVttablet starts again the vttablet of a tablet, after it was
+// killed or torn down. It waits for the tablet to be serving if
+// servingStatus is not empty.
+func (cluster *LocalProcessCluster) RestartVttablet(tablet *Vttablet, servingStatus string) error {
+	log.Infof("Restarting vttablet %s", tablet.Alias)
+	tablet.VttabletProcess.ServingStatus = servingStatus
+	return tablet.VttabletProcess.Setup()
+}
+
+// StopMySQL stops the MySQL of a tablet, leaving its vttablet running
+func (cluster *LocalProcessCluster) StopMySQL(tablet *Vttablet) error {
+	log.Infof("Stopping MySQL of %s", tablet.Alias)
+	return tablet.MysqlctlProcess.Stop()
+}
+
+// RestartMySQL starts again the MySQL of a tablet, after it was stopped
+func (cluster *LocalProcessCluster) RestartMySQL(tablet *Vttablet) error {
+	log.Infof("Restarting MySQL of %s", tablet.Alias)
+	// The data directory was initialized on the first start.
+	tablet.MysqlctlProcess.InitMysql = false
+	return tablet.MysqlctlProcess.Start()
+}
@@ -94,7 +94,7 @@ func (vtgate *VtgateProcess) Setup() (err error) {
 
 	vtgate.proc.Args = append(vtgate.proc.Args, vtgate.ExtraArgs...)
 
-	errFile, _ := os.Create(path.Join(vtgate.LogDir, "vtgate-stderr.txt"))
+	errFile, _ := os.Create(path.Join(vtgate.LogDir, vtgate.Name+"-stderr.txt"))
 	vtgate.proc.Stderr = errFile
 
 	vtgate.proc.Env = append(vtgate.proc.Env, os.Environ()...)
@@ -210,6 +210,17 @@ func (vtgate *VtgateProcess) TearDown() error {
 	}
 }
 
+// Kill kills the running vtgate service with SIGKILL, without
+// letting it shut down gracefully
+func (vtgate *VtgateProcess) Kill() {
+	if vtgate.proc == nil || vtgate.exit == nil {
+		return
+	}
+	vtgate.proc.Process.Kill()
+	vtgate.proc = nil
+	<-vtgate.exit
+}
+
 // VtgateProcessInstance returns a Vtgate handle for vtgate process
 // configured with the given Config.
 // The process must be manually started by calling setup()
@@ -301,6 +301,17 @@ func (vttablet *VttabletProcess) TearDown() error {
 	}
 }
 
+// Kill kills the running vttablet service with SIGKILL, without
+// letting it shut down gracefully
+func (vttablet *VttabletProcess) Kill() {
+	if vttablet.proc == nil || vttablet.exit == nil {
+		return
+	}
+	vttablet.proc.Process.Kill()
+	vttablet.proc = nil
+	<-vttablet.exit
+}
+
 // CreateDB creates the database for keyspace
 func (vttablet *VttabletProcess) CreateDB(keyspace string) error {
 	_, _ = vttablet.QueryTablet(fmt.Sprintf("drop database IF EXISTS vt_%s", keyspace), keyspace, false)
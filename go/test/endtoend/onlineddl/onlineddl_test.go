@@ -17,7 +17,6 @@ limitations under the License.
 package onlineddl
 
 import (
-	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -188,6 +187,23 @@ func TestSchemaChange(t *testing.T) {
 		time.Sleep(2 * time.Second)
 		checkRecentMigrations(t, uuid, schema.OnlineDDLStatusFailed)
 	})
+	t.Run("singleton migrations", func(t *testing.T) {
+		tableName := fmt.Sprintf("vt_onlineddl_test_%02d", 3)
+		sqlQuery := fmt.Sprintf(alterTableThrottlingStatement, tableName)
+		uuid := vtgateExec(t, "gh-ost --singleton --max-load=Threads_running=1", sqlQuery, "").Named().Row().AsString("uuid", "")
+		// the first migration is pending, whether reviewed or not
+		_ = vtgateExec(t, "gh-ost --singleton", sqlQuery, "singleton migration rejected")
+		_ = vtgateExec(t, "gh-ost --singleton-context", sqlQuery, "singleton-context migration rejected")
+		time.Sleep(20 * time.Second)
+		checkRecentMigrations(t, uuid, schema.OnlineDDLStatusRunning)
+		_ = vtgateExec(t, "gh-ost --singleton", sqlQuery, "singleton migration rejected")
+		checkCancelMigration(t, uuid, true)
+		time.Sleep(2 * time.Second)
+		checkRecentMigrations(t, uuid, schema.OnlineDDLStatusFailed)
+		// no more pending migrations
+		uuid = testOnlineDDLStatement(t, alterTableTrivialStatement, "gh-ost --singleton", "vtgate", "ghost_col")
+		checkRecentMigrations(t, uuid, schema.OnlineDDLStatusComplete)
+	})
 	t.Run("failed migration", func(t *testing.T) {
 		uuid := testOnlineDDLStatement(t, alterTableFailedStatement, "gh-ost", "vtgate", "ghost_col")
 		checkRecentMigrations(t, uuid, schema.OnlineDDLStatusFailed)
@@ -395,22 +411,5 @@ func getCreateTableStatement(t *testing.T, tablet *cluster.Vttablet, tableName s
 
 func vtgateExec(t *testing.T, ddlStrategy string, query string, expectError string) *sqltypes.Result {
 	t.Helper()
-
-	ctx := context.Background()
-	conn, err := mysql.Connect(ctx, &vtParams)
-	require.Nil(t, err)
-	defer conn.Close()
-
-	setSession := fmt.Sprintf("set @@ddl_strategy='%s'", ddlStrategy)
-	_, err = conn.ExecuteFetch(setSession, 1000, true)
-	assert.NoError(t, err)
-
-	qr, err := conn.ExecuteFetch(query, 1000, true)
-	if expectError == "" {
-		require.NoError(t, err)
-	} else {
-		require.Error(t, err, "error should not be nil")
-		assert.Contains(t, err.Error(), expectError, "Unexpected error")
-	}
-	return qr
+	return VtgateExecDDL(t, &vtParams, ddlStrategy, query, expectError)
 }
@@ -529,6 +529,40 @@ func TestFlush(t *testing.T) {
 	exec(t, conn, "flush local tables t1, t2")
 }
 
+func TestMultiStatement(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	ctx := context.Background()
+	conn, err := mysql.Connect(ctx, &vtParams)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Every statement is routed on its own, and its result set is
+	// returned in order.
+	qr, more, err := conn.ExecuteFetchMulti("insert into t1(id1, id2) values(1, 10), (4, 40); select id2 from t1 where id1 = 1; select id2 from t1 where id1 = 4", 1000, true)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.EqualValues(t, 2, qr.RowsAffected)
+	qr, more, _, err = conn.ReadQueryResult(1000, true)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, "[[INT64(10)]]", fmt.Sprintf("%v", qr.Rows))
+	qr, more, _, err = conn.ReadQueryResult(1000, true)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, "[[INT64(40)]]", fmt.Sprintf("%v", qr.Rows))
+
+	// The statements after a failed one are not executed.
+	_, more, err = conn.ExecuteFetchMulti("delete from t1 where id1 = 1; select * from no_such_table; delete from t1 where id1 = 4", 1000, true)
+	require.NoError(t, err)
+	assert.True(t, more)
+	_, more, _, err = conn.ReadQueryResult(1000, true)
+	require.Error(t, err)
+	assert.False(t, more)
+	assertMatches(t, conn, "select id1 from t1 order by id1", "[[INT64(4)]]")
+
+	exec(t, conn, "delete from t1")
+}
+
 func assertMatches(t *testing.T, conn *mysql.Conn, query, expected string) {
 	t.Helper()
 	qr := exec(t, conn, query)
@@ -63,13 +63,17 @@ func TestSetSysVarSingle(t *testing.T) {
 		expr:     "INNODB",
 		expected: `[[VARCHAR("InnoDB")]]`,
 	}, {
-		name:     "character_set_client", // check and ignored
+		name:     "character_set_client", // same as the tablet, ignored
 		expr:     "utf8",
 		expected: `[[VARCHAR("utf8")]]`,
 	}, {
 		name:     "character_set_client", // ignored so will keep the actual value
 		expr:     "@charvar",
 		expected: `[[VARCHAR("utf8")]]`,
+	}, {
+		name:     "character_set_results", // use reserved conn
+		expr:     "latin1",
+		expected: `[[VARCHAR("latin1")]]`,
 	}, {
 		name:     "sql_mode", // use reserved conn
 		expr:     "''",
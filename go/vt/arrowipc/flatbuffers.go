@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arrowipc
+
+import "encoding/binary"
+
+// The Arrow messages are flatbuffers. This file has the few flatbuffers
+// types the messages need. Unlike the flatbuffers builders, which write
+// from the end of the buffer, fbBuilder writes the objects front to back:
+// a table is written before the objects it refers to, so that its offsets
+// to them are positive, and the vtable of a table is written right before
+// it.
+
+// fbObject is a flatbuffers table, vector or string.
+type fbObject interface {
+	// writeTo writes the object and returns its position in the buffer.
+	writeTo(b *fbBuilder) int
+}
+
+// fbField is a field of a table: a little endian scalar of size bytes,
+// or an offset to an object if ref is set. The fields with no size nor
+// ref are absent, and have their default value.
+type fbField struct {
+	size  int
+	value uint64
+	ref   fbObject
+}
+
+func (f fbField) width() int {
+	if f.ref != nil {
+		return 4
+	}
+	return f.size
+}
+
+func fbInt8(v uint8) fbField     { return fbField{size: 1, value: uint64(v)} }
+func fbInt16(v int16) fbField    { return fbField{size: 2, value: uint64(v)} }
+func fbInt32(v int32) fbField    { return fbField{size: 4, value: uint64(v)} }
+func fbInt64(v int64) fbField    { return fbField{size: 8, value: uint64(v)} }
+func fbRef(ref fbObject) fbField { return fbField{ref: ref} }
+
+func fbBool(v bool) fbField {
+	if v {
+		return fbInt8(1)
+	}
+	return fbInt8(0)
+}
+
+// fbTable is a table, with its fields in the order of their ids.
+type fbTable []fbField
+
+func (t fbTable) writeTo(b *fbBuilder) int {
+	// The fields follow the offset to the vtable, the largest ones first,
+	// so that they are aligned since the table itself is 8-byte aligned.
+	offsets := make([]int, len(t))
+	size := 4
+	for _, width := range []int{8, 4, 2, 1} {
+		for i, f := range t {
+			if f.width() == width {
+				size = (size + width - 1) / width * width
+				offsets[i] = size
+				size += width
+			}
+		}
+	}
+
+	b.pad(2, 0)
+	vtable := len(b.buf)
+	b.appendUint16(uint16(4 + 2*len(t)))
+	b.appendUint16(uint16(size))
+	for _, offset := range offsets {
+		b.appendUint16(uint16(offset))
+	}
+
+	b.pad(8, 0)
+	table := len(b.buf)
+	b.buf = append(b.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(b.buf[table:], uint32(table-vtable))
+	for i, f := range t {
+		if f.ref == nil {
+			b.putUint(table+offsets[i], f.size, f.value)
+		}
+	}
+	for i, f := range t {
+		if f.ref != nil {
+			pos := table + offsets[i]
+			b.putUint(pos, 4, uint64(f.ref.writeTo(b)-pos))
+		}
+	}
+	return table
+}
+
+// fbVector is a vector of tables.
+type fbVector []fbObject
+
+func (v fbVector) writeTo(b *fbBuilder) int {
+	b.pad(4, 0)
+	vector := len(b.buf)
+	b.appendUint32(uint32(len(v)))
+	b.buf = append(b.buf, make([]byte, 4*len(v))...)
+	for i, elem := range v {
+		pos := vector + 4 + 4*i
+		b.putUint(pos, 4, uint64(elem.writeTo(b)-pos))
+	}
+	return vector
+}
+
+// fbStructVector is a vector of structs made of int64s.
+type fbStructVector struct {
+	count int
+	data  []int64
+}
+
+func (v fbStructVector) writeTo(b *fbBuilder) int {
+	// The structs must be 8-byte aligned, after the length.
+	b.pad(8, 4)
+	vector := len(b.buf)
+	b.appendUint32(uint32(v.count))
+	for _, value := range v.data {
+		b.appendUint64(uint64(value))
+	}
+	return vector
+}
+
+// fbString is a string.
+type fbString string
+
+func (s fbString) writeTo(b *fbBuilder) int {
+	b.pad(4, 0)
+	str := len(b.buf)
+	b.appendUint32(uint32(len(s)))
+	b.buf = append(b.buf, s...)
+	b.buf = append(b.buf, 0)
+	return str
+}
+
+type fbBuilder struct {
+	buf []byte
+}
+
+// fbFinish returns the flatbuffer of the root table.
+func fbFinish(root fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4)}
+	binary.LittleEndian.PutUint32(b.buf, uint32(root.writeTo(b)))
+	return b.buf
+}
+
+// pad pads the buffer so that its length plus offset is a multiple of align.
+func (b *fbBuilder) pad(align, offset int) {
+	for (len(b.buf)+offset)%align != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+func (b *fbBuilder) appendUint16(v uint16) {
+	b.buf = append(b.buf, 0, 0)
+	b.putUint(len(b.buf)-2, 2, uint64(v))
+}
+
+func (b *fbBuilder) appendUint32(v uint32) {
+	b.buf = append(b.buf, 0, 0, 0, 0)
+	b.putUint(len(b.buf)-4, 4, uint64(v))
+}
+
+func (b *fbBuilder) appendUint64(v uint64) {
+	b.buf = append(b.buf, 0, 0, 0, 0, 0, 0, 0, 0)
+	b.putUint(len(b.buf)-8, 8, v)
+}
+
+func (b *fbBuilder) putUint(pos, size int, v uint64) {
+	switch size {
+	case 1:
+		b.buf[pos] = byte(v)
+	case 2:
+		binary.LittleEndian.PutUint16(b.buf[pos:], uint16(v))
+	case 4:
+		binary.LittleEndian.PutUint32(b.buf[pos:], uint32(v))
+	case 8:
+		binary.LittleEndian.PutUint64(b.buf[pos:], v)
+	}
+}
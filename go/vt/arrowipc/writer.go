@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package arrowipc encodes the results of the streaming queries in the
+// Apache Arrow IPC streaming format, so that the analytics clients can
+// read them as columnar record batches.
+package arrowipc
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The values of the Arrow format, from Schema.fbs and Message.fbs.
+const (
+	metadataV5 = 4
+
+	headerSchema      = 1
+	headerRecordBatch = 3
+
+	typeInt           = 2
+	typeFloatingPoint = 3
+	typeBinary        = 4
+	typeUtf8          = 5
+
+	precisionSingle = 1
+	precisionDouble = 2
+
+	continuation = 0xFFFFFFFF
+)
+
+// column is the Arrow encoding of a column.
+type column struct {
+	typeID uint8
+	typ    fbTable
+	// width is the size of the values of the fixed width types,
+	// 0 for the variable width ones.
+	width int
+	// parse returns the little endian value of the fixed width types.
+	parse func(raw []byte) (uint64, error)
+}
+
+func intColumn(width int, signed bool) column {
+	parse := func(raw []byte) (uint64, error) {
+		return strconv.ParseUint(string(raw), 10, 8*width)
+	}
+	if signed {
+		parse = func(raw []byte) (uint64, error) {
+			v, err := strconv.ParseInt(string(raw), 10, 8*width)
+			return uint64(v), err
+		}
+	}
+	return column{
+		typeID: typeInt,
+		typ:    fbTable{fbInt32(int32(8 * width)), fbBool(signed)},
+		width:  width,
+		parse:  parse,
+	}
+}
+
+// isUTF8Collation returns true if the values of a text column with the
+// given collation are valid UTF-8: the utf8, utf8mb4 and ascii ones. The
+// collation is unknown, and assumed to be utf8, if it is 0.
+func isUTF8Collation(collation uint32) bool {
+	switch {
+	case collation == 0,
+		collation == 11, collation == 65, // ascii
+		collation == 33, collation == 76, collation == 83, collation >= 192 && collation <= 215, collation == 223, // utf8
+		collation == 45, collation == 46, collation >= 224 && collation <= 247, collation >= 255 && collation <= 323: // utf8mb4
+		return true
+	}
+	return false
+}
+
+// columnOf returns the encoding of a column. The integers and the floats
+// map to the Arrow types of the same width, the binary types and the text
+// types of the other character sets than UTF-8, like latin1, to Binary,
+// and the other types to Utf8, with the MySQL format of their values.
+func columnOf(field *querypb.Field) column {
+	isText := sqltypes.IsText(field.Type) || field.Type == sqltypes.Enum || field.Type == sqltypes.Set
+	if isText && !isUTF8Collation(field.Charset) {
+		return column{typeID: typeBinary}
+	}
+	switch field.Type {
+	case sqltypes.Int8:
+		return intColumn(1, true)
+	case sqltypes.Uint8:
+		return intColumn(1, false)
+	case sqltypes.Int16:
+		return intColumn(2, true)
+	case sqltypes.Uint16, sqltypes.Year:
+		return intColumn(2, false)
+	case sqltypes.Int24, sqltypes.Int32:
+		return intColumn(4, true)
+	case sqltypes.Uint24, sqltypes.Uint32:
+		return intColumn(4, false)
+	case sqltypes.Int64:
+		return intColumn(8, true)
+	case sqltypes.Uint64:
+		return intColumn(8, false)
+	case sqltypes.Float32:
+		return column{
+			typeID: typeFloatingPoint,
+			typ:    fbTable{fbInt16(precisionSingle)},
+			width:  4,
+			parse: func(raw []byte) (uint64, error) {
+				v, err := strconv.ParseFloat(string(raw), 32)
+				return uint64(math.Float32bits(float32(v))), err
+			},
+		}
+	case sqltypes.Float64:
+		return column{
+			typeID: typeFloatingPoint,
+			typ:    fbTable{fbInt16(precisionDouble)},
+			width:  8,
+			parse: func(raw []byte) (uint64, error) {
+				v, err := strconv.ParseFloat(string(raw), 64)
+				return math.Float64bits(v), err
+			},
+		}
+	case sqltypes.VarBinary, sqltypes.Binary, sqltypes.Blob, sqltypes.Bit, sqltypes.Geometry:
+		return column{typeID: typeBinary}
+	}
+	return column{typeID: typeUtf8}
+}
+
+// Writer encodes the results of a streaming query as an Arrow IPC stream:
+// the schema of the fields, a record batch for every result with rows,
+// and the end of the stream.
+type Writer struct {
+	fields  []*querypb.Field
+	columns []column
+}
+
+// NewWriter returns a Writer for a new stream.
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Write returns the encoding of qr, to append to the stream. The first
+// result with fields starts the stream with the schema.
+func (w *Writer) Write(qr *sqltypes.Result) ([]byte, error) {
+	var buf []byte
+	if w.columns == nil && qr.Fields != nil {
+		buf = w.schema(qr.Fields)
+	}
+	if len(qr.Rows) == 0 {
+		return buf, nil
+	}
+	if w.columns == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "arrow: the rows were streamed before the fields")
+	}
+	batch, err := w.recordBatch(qr.Rows)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, batch...), nil
+}
+
+// Close returns the end of the stream.
+func (w *Writer) Close() []byte {
+	var buf []byte
+	if w.columns == nil {
+		buf = w.schema(nil)
+	}
+	return append(buf, 0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0)
+}
+
+func (w *Writer) schema(fields []*querypb.Field) []byte {
+	w.fields = fields
+	w.columns = make([]column, len(fields))
+	arrowFields := make(fbVector, len(fields))
+	for i, field := range fields {
+		w.columns[i] = columnOf(field)
+		arrowFields[i] = fbTable{
+			fbRef(fbString(field.Name)),
+			fbBool(true),
+			fbInt8(w.columns[i].typeID),
+			fbRef(w.columns[i].typ),
+			{},
+			fbRef(fbVector{}),
+		}
+	}
+	return message(headerSchema, fbTable{{}, fbRef(arrowFields)}, nil)
+}
+
+func (w *Writer) recordBatch(rows [][]sqltypes.Value) ([]byte, error) {
+	var nodes, buffers []int64
+	var body []byte
+	addBuffer := func(buf []byte) {
+		buffers = append(buffers, int64(len(body)), int64(len(buf)))
+		body = append(body, buf...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+
+	for _, row := range rows {
+		if len(row) != len(w.columns) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "arrow: row has %d values, want %d", len(row), len(w.columns))
+		}
+	}
+	for i, col := range w.columns {
+		validity := make([]byte, (len(rows)+7)/8)
+		nulls := 0
+		var values, offsets []byte
+		if col.width == 0 {
+			offsets = make([]byte, 4*(len(rows)+1))
+		} else {
+			values = make([]byte, col.width*len(rows))
+		}
+		for j, row := range rows {
+			v := row[i]
+			if v.IsNull() {
+				nulls++
+			} else {
+				validity[j/8] |= 1 << uint(j%8)
+			}
+			if col.width == 0 {
+				if !v.IsNull() {
+					values = append(values, v.Raw()...)
+				}
+				binary.LittleEndian.PutUint32(offsets[4*(j+1):], uint32(len(values)))
+				continue
+			}
+			if v.IsNull() {
+				continue
+			}
+			value, err := col.parse(v.Raw())
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "arrow: invalid value for column %s: %v", w.fields[i].Name, err)
+			}
+			for k := 0; k < col.width; k++ {
+				values[col.width*j+k] = byte(value >> uint(8*k))
+			}
+		}
+		nodes = append(nodes, int64(len(rows)), int64(nulls))
+		addBuffer(validity)
+		if col.width == 0 {
+			addBuffer(offsets)
+		}
+		addBuffer(values)
+	}
+
+	header := fbTable{
+		fbInt64(int64(len(rows))),
+		fbRef(fbStructVector{count: len(nodes) / 2, data: nodes}),
+		fbRef(fbStructVector{count: len(buffers) / 2, data: buffers}),
+	}
+	return message(headerRecordBatch, header, body), nil
+}
+
+// message returns the encapsulated message with the header and the body:
+// the continuation marker, the size of the metadata, the metadata and the
+// body, all 8-byte aligned.
+func message(headerType uint8, header fbTable, body []byte) []byte {
+	metadata := fbFinish(fbTable{
+		fbInt16(metadataV5),
+		fbInt8(headerType),
+		fbRef(header),
+		fbInt64(int64(len(body))),
+	})
+	for len(metadata)%8 != 0 {
+		metadata = append(metadata, 0)
+	}
+	buf := make([]byte, 8, 8+len(metadata)+len(body))
+	binary.LittleEndian.PutUint32(buf, continuation)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(metadata)))
+	buf = append(buf, metadata...)
+	return append(buf, body...)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arrowipc
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// splitMessage checks the framing of the message at the start of buf,
+// and returns its metadata, its body and the rest of buf.
+func splitMessage(t *testing.T, buf []byte, bodyLen int) (metadata, body, rest []byte) {
+	t.Helper()
+	require.GreaterOrEqual(t, len(buf), 8)
+	assert.Equal(t, uint32(continuation), binary.LittleEndian.Uint32(buf))
+	size := int(binary.LittleEndian.Uint32(buf[4:]))
+	assert.Zero(t, size%8, "metadata is not 8-byte aligned")
+	require.GreaterOrEqual(t, len(buf), 8+size+bodyLen)
+	return buf[8 : 8+size], buf[8+size : 8+size+bodyLen], buf[8+size+bodyLen:]
+}
+
+func TestWriter(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name|f", "int64|varchar|float32")
+	w := NewWriter()
+
+	buf, err := w.Write(&sqltypes.Result{Fields: fields})
+	require.NoError(t, err)
+	metadata, _, rest := splitMessage(t, buf, 0)
+	assert.Empty(t, rest)
+	assert.Contains(t, string(metadata), "id\x00")
+	assert.Contains(t, string(metadata), "name\x00")
+
+	buf, err = w.Write(sqltypes.MakeTestResult(fields, "1|a|0.5", "null|bc|null"))
+	require.NoError(t, err)
+	_, body, rest := splitMessage(t, buf, 72)
+	assert.Empty(t, rest)
+	want := []byte{
+		// id: validity, values
+		0x01, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		// name: validity, offsets, data
+		0x03, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 1, 0, 0, 0,
+		3, 0, 0, 0, 0, 0, 0, 0,
+		'a', 'b', 'c', 0, 0, 0, 0, 0,
+		// f: validity, values
+		0x01, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x3f, 0, 0, 0, 0,
+	}
+	assert.Equal(t, want, body)
+
+	// Results without rows add nothing to the stream.
+	buf, err = w.Write(&sqltypes.Result{})
+	require.NoError(t, err)
+	assert.Empty(t, buf)
+
+	assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}, w.Close())
+}
+
+func TestWriterEmpty(t *testing.T) {
+	// A stream with no results still has a schema.
+	buf := NewWriter().Close()
+	_, _, rest := splitMessage(t, buf, 0)
+	assert.Equal(t, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}, rest)
+}
+
+func TestWriterErrors(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id", "int8")
+
+	_, err := NewWriter().Write(&sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(1)}}})
+	assert.EqualError(t, err, "arrow: the rows were streamed before the fields")
+
+	_, err = NewWriter().Write(sqltypes.MakeTestResult(fields, "300"))
+	assert.Contains(t, err.Error(), "arrow: invalid value for column id")
+}
+
+// goldenFields are the fields of testdata/golden.arrow: the utf8mb4 text
+// is Utf8, and the latin1 text is Binary.
+func goldenFields() []*querypb.Field {
+	fields := sqltypes.MakeTestFields("i8|u64|f64|utf8|latin1|bin|dec|dt", "int8|uint64|float64|varchar|varchar|varbinary|decimal|datetime")
+	fields[3].Charset = 45 // utf8mb4_general_ci
+	fields[4].Charset = 8  // latin1_swedish_ci
+	return fields
+}
+
+// TestWriterGolden checks the writer against a stream that the Arrow Go
+// reader (github.com/apache/arrow/go/arrow/ipc) reads back as:
+//
+//	i8: type=int8, u64: type=uint64, f64: type=float64, utf8: type=utf8,
+//	latin1: type=binary, bin: type=binary, dec: type=utf8, dt: type=utf8
+//	rows: 2
+//	  [-1 (null)] [18446744073709551615 0] [1.5 (null)] ["héllo" (null)]
+//	  ["caf\xe9" (null)] ["\x00\x01" (null)] ["12.50" (null)]
+//	  ["2021-03-04 05:06:07" (null)]
+//	rows: 1
+//	  [127] [1] [-0.25] [""] ["x"] [""] ["0.00"] ["0000-00-00 00:00:00"]
+func TestWriterGolden(t *testing.T) {
+	want, err := ioutil.ReadFile("testdata/golden.arrow")
+	require.NoError(t, err)
+
+	fields := goldenFields()
+	w := NewWriter()
+	var got []byte
+	for _, qr := range []*sqltypes.Result{
+		{Fields: fields},
+		sqltypes.MakeTestResult(fields, "-1|18446744073709551615|1.5|héllo|caf\xe9|\x00\x01|12.50|2021-03-04 05:06:07", "null|0|null|null|null|null|null|null"),
+		sqltypes.MakeTestResult(fields, "127|1|-0.25||x||0.00|0000-00-00 00:00:00"),
+	} {
+		buf, err := w.Write(qr)
+		require.NoError(t, err)
+		got = append(got, buf...)
+	}
+	got = append(got, w.Close()...)
+	assert.Equal(t, want, got)
+}
+
+func TestColumnOfCharset(t *testing.T) {
+	testcases := []struct {
+		typ     querypb.Type
+		charset uint32
+		want    uint8
+	}{
+		{sqltypes.VarChar, 0, typeUtf8},
+		{sqltypes.VarChar, 33, typeUtf8},  // utf8_general_ci
+		{sqltypes.Text, 255, typeUtf8},    // utf8mb4_0900_ai_ci
+		{sqltypes.Char, 11, typeUtf8},     // ascii_general_ci
+		{sqltypes.VarChar, 8, typeBinary}, // latin1_swedish_ci
+		{sqltypes.Text, 28, typeBinary},   // gbk_chinese_ci
+		{sqltypes.Enum, 8, typeBinary},    // latin1_swedish_ci
+		{sqltypes.Decimal, 63, typeUtf8},  // binary
+		{sqltypes.Datetime, 63, typeUtf8}, // binary
+		{sqltypes.VarBinary, 63, typeBinary},
+	}
+	for _, tcase := range testcases {
+		got := columnOf(&querypb.Field{Type: tcase.typ, Charset: tcase.charset}).typeID
+		assert.Equal(t, tcase.want, got, "%v %d", tcase.typ, tcase.charset)
+	}
+}
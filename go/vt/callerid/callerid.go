@@ -87,6 +87,15 @@ func GetSubcomponent(ef *vtrpcpb.CallerID) string {
 	return ef.Subcomponent
 }
 
+// GetPriority returns the priority class of the request of the effective
+// caller, NORMAL if it has none.
+func GetPriority(ef *vtrpcpb.CallerID) vtrpcpb.Priority {
+	if ef == nil {
+		return vtrpcpb.Priority_NORMAL
+	}
+	return ef.Priority
+}
+
 // NewContext adds the provided EffectiveCallerID(vtrpcpb.CallerID) and ImmediateCallerID(querypb.VTGateCallerID)
 // into the Context
 func NewContext(ctx context.Context, ef *vtrpcpb.CallerID, im *querypb.VTGateCallerID) context.Context {
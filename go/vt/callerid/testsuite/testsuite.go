@@ -79,6 +79,9 @@ func RunTests(t *testing.T, im *querypb.VTGateCallerID, ef *vtrpcpb.CallerID, ne
 	if s := callerid.GetSubcomponent(ctxef); s != "" {
 		t.Errorf("Expect empty string from GetSubcomponent(nil), but got %v", s)
 	}
+	if p := callerid.GetPriority(ctxef); p != vtrpcpb.Priority_NORMAL {
+		t.Errorf("Expect NORMAL from GetPriority(nil), but got %v", p)
+	}
 
 	ctx = newContext(ctx, ef, im)
 	ctxim = callerid.ImmediateCallerIDFromContext(ctx)
@@ -83,7 +83,26 @@ func (fhc *FakeHealthCheck) GetHealthyTabletStats(target *querypb.Target) []*Tab
 	fhc.mu.Lock()
 	defer fhc.mu.Unlock()
 	for _, item := range fhc.items {
-		if proto.Equal(item.ts.Target, target) && item.ts.Serving && item.ts.LastError == nil {
+		if proto.Equal(item.ts.Target, target) && item.ts.Serving && item.ts.LastError == nil && !IsDelayedReplica(item.ts.Tablet) {
+			result = append(result, item.ts)
+		}
+	}
+	return result
+}
+
+// GetServingTabletStats returns the serving tablets, like
+// GetHealthyTabletStats as the fake does not filter by cell.
+func (fhc *FakeHealthCheck) GetServingTabletStats(target *querypb.Target) []*TabletHealth {
+	return fhc.GetHealthyTabletStats(target)
+}
+
+// GetDelayedTabletStats returns the serving delayed replicas.
+func (fhc *FakeHealthCheck) GetDelayedTabletStats(target *querypb.Target) []*TabletHealth {
+	result := make([]*TabletHealth, 0)
+	fhc.mu.Lock()
+	defer fhc.mu.Unlock()
+	for _, item := range fhc.items {
+		if proto.Equal(item.ts.Target, target) && item.ts.Serving && item.ts.LastError == nil && IsDelayedReplica(item.ts.Tablet) {
 			result = append(result, item.ts)
 		}
 	}
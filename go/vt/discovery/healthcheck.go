@@ -190,6 +190,17 @@ type HealthCheck interface {
 	// synchronization
 	GetHealthyTabletStats(target *query.Target) []*TabletHealth
 
+	// GetDelayedTabletStats returns the serving delayed replicas,
+	// whatever their replication lag.
+	// The returned array is owned by the caller.
+	GetDelayedTabletStats(target *query.Target) []*TabletHealth
+
+	// GetServingTabletStats returns the serving tablets of all the
+	// watched cells, filtered by replication lag. The reads go to them
+	// when the cells of the healthy tablets are draining.
+	// The returned array is owned by the caller.
+	GetServingTabletStats(target *query.Target) []*TabletHealth
+
 	// Subscribe adds a listener. Used by vtgate buffer to learn about master changes.
 	Subscribe() chan *TabletHealth
 
@@ -472,7 +483,8 @@ func (hc *HealthCheckImpl) recomputeHealthy(key keyspaceShardTabletType) {
 	allArray := make([]*TabletHealth, 0, len(all))
 	for _, s := range all {
 		// Only tablets in same cell / cellAlias are included in healthy list.
-		if hc.isIncluded(s.Tablet.Type, s.Tablet.Alias) {
+		// The delayed replicas only serve the reads in the past.
+		if hc.isIncluded(s.Tablet.Type, s.Tablet.Alias) && !IsDelayedReplica(s.Tablet) {
 			allArray = append(allArray, s)
 		}
 	}
@@ -581,6 +593,43 @@ func (hc *HealthCheckImpl) GetHealthyTabletStats(target *query.Target) []*Tablet
 	return append(result, hc.healthy[hc.keyFromTarget(target)]...)
 }
 
+// GetDelayedTabletStats returns the serving delayed replicas of the
+// given target, whatever their replication lag.
+// The returned array is owned by the caller.
+func (hc *HealthCheckImpl) GetDelayedTabletStats(target *query.Target) []*TabletHealth {
+	var result []*TabletHealth
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if target.Shard == "" {
+		target.Shard = "0"
+	}
+	for _, th := range hc.healthData[hc.keyFromTarget(target)] {
+		if th.Serving && th.LastError == nil && IsDelayedReplica(th.Tablet) && hc.isIncluded(th.Tablet.Type, th.Tablet.Alias) {
+			result = append(result, th)
+		}
+	}
+	return result
+}
+
+// GetServingTabletStats returns the serving tablets of the given target
+// in all the watched cells, filtered by replication lag. The delayed
+// replicas are excluded.
+// The returned array is owned by the caller.
+func (hc *HealthCheckImpl) GetServingTabletStats(target *query.Target) []*TabletHealth {
+	var result []*TabletHealth
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if target.Shard == "" {
+		target.Shard = "0"
+	}
+	for _, th := range hc.healthData[hc.keyFromTarget(target)] {
+		if !IsDelayedReplica(th.Tablet) {
+			result = append(result, th)
+		}
+	}
+	return FilterStatsByReplicationLag(result)
+}
+
 // getTabletStats returns all tablets for the given target.
 // The returned array is owned by the caller.
 // For TabletType_MASTER, this will only return at most one entry,
@@ -693,7 +742,7 @@ func (hc *HealthCheckImpl) TabletConnection(alias *topodata.TabletAlias) (querys
 		//TODO: test that throws this error
 		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "tablet: %v is either down or nonexistent", alias)
 	}
-	return thc.Connection(), nil
+	return queryservice.Negotiate(thc.Connection(), thc.Capabilities), nil
 }
 
 // Target includes cell which we ignore here
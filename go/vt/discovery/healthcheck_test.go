@@ -40,15 +40,18 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/status"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 
 	"vitess.io/vitess/go/vt/proto/query"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var connMap map[string]*fakeConn
@@ -745,6 +748,109 @@ func TestGetHealthyTablets(t *testing.T) {
 	mustMatch(t, want, a, "unexpected result")
 }
 
+func TestGetDelayedTablets(t *testing.T) {
+	ts := memorytopo.NewServer("cell")
+	hc := createTestHc(ts)
+	defer hc.Close()
+	tablet := createTestTablet(0, "cell", "a")
+	tablet.Type = topodatapb.TabletType_RDONLY
+	tablet.Tags = map[string]string{DelayedReplicaTag: "true"}
+	input := make(chan *querypb.StreamHealthResponse)
+	createFakeConn(tablet, input)
+
+	resultChan := hc.Subscribe()
+	hc.AddTablet(tablet)
+	<-resultChan
+
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_RDONLY}
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:   tablet.Alias,
+		Target:        target,
+		Serving:       true,
+		RealtimeStats: &querypb.RealtimeStats{SecondsBehindMaster: 3600},
+	}
+	<-resultChan
+	// The delayed replica is not healthy, whatever its lag.
+	assert.Empty(t, hc.GetHealthyTabletStats(target))
+	want := []*TabletHealth{{
+		Tablet:  tablet,
+		Target:  target,
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{SecondsBehindMaster: 3600},
+	}}
+	mustMatch(t, want, hc.GetDelayedTabletStats(target), "unexpected result")
+
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:   tablet.Alias,
+		Target:        target,
+		Serving:       false,
+		RealtimeStats: &querypb.RealtimeStats{SecondsBehindMaster: 3600},
+	}
+	<-resultChan
+	assert.Empty(t, hc.GetDelayedTabletStats(target))
+}
+
+func TestHealthCheckNegotiatesCapabilities(t *testing.T) {
+	ts := memorytopo.NewServer("cell")
+	hc := createTestHc(ts)
+	defer hc.Close()
+	tablet := createTestTablet(0, "cell", "a")
+	input := make(chan *querypb.StreamHealthResponse)
+	createFakeConn(tablet, input)
+
+	resultChan := hc.Subscribe()
+	hc.AddTablet(tablet)
+	<-resultChan
+
+	// The tablet does not understand JSON bind variables.
+	var capabilities []string
+	for _, capability := range queryservice.LocalCapabilities() {
+		if capability != "bind_variable_type:JSON" {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:         tablet.Alias,
+		Target:              target,
+		Serving:             true,
+		RealtimeStats:       &querypb.RealtimeStats{},
+		QueryServiceVersion: 1,
+		Capabilities:        capabilities,
+	}
+	<-resultChan
+
+	conn, err := hc.TabletConnection(tablet.Alias)
+	require.NoError(t, err)
+	_, err = conn.Execute(context.Background(), target, "select 1", map[string]*querypb.BindVariable{
+		"v": {Type: querypb.Type_JSON, Value: []byte("{}")},
+	}, 0, 0, nil)
+	assert.EqualError(t, err, "the tablet does not support bind_variable_type JSON, it runs the version 1 of the query service")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	// The supported requests go to the tablet.
+	_, err = conn.Execute(context.Background(), target, "select 1", map[string]*querypb.BindVariable{
+		"v": sqltypes.Int64BindVariable(1),
+	}, 0, 0, nil)
+	assert.EqualError(t, err, "ErrorQueryService does not implement any method")
+
+	// Once upgraded, the tablet gets all the requests.
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:         tablet.Alias,
+		Target:              target,
+		Serving:             true,
+		RealtimeStats:       &querypb.RealtimeStats{},
+		QueryServiceVersion: queryservice.Version,
+		Capabilities:        queryservice.LocalCapabilities(),
+	}
+	<-resultChan
+	conn, err = hc.TabletConnection(tablet.Alias)
+	require.NoError(t, err)
+	_, err = conn.Execute(context.Background(), target, "select 1", map[string]*querypb.BindVariable{
+		"v": {Type: querypb.Type_JSON, Value: []byte("{}")},
+	}, 0, 0, nil)
+	assert.EqualError(t, err, "ErrorQueryService does not implement any method")
+}
+
 func TestMasterInOtherCell(t *testing.T) {
 	ts := memorytopo.NewServer("cell1", "cell2")
 	hc := NewHealthCheck(context.Background(), 1*time.Millisecond, time.Hour, ts, "cell1", "cell1, cell2")
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"sort"
 	"time"
+
+	"vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
@@ -43,6 +45,18 @@ func IsReplicationLagVeryHigh(tabletHealth *TabletHealth) bool {
 	return float64(tabletHealth.Stats.SecondsBehindMaster) > highReplicationLagMinServing.Seconds()
 }
 
+// DelayedReplicaTag is the tablet tag of the delayed replicas, the rdonly
+// tablets replicating with a delay (CHANGE MASTER TO MASTER_DELAY). They are
+// not part of the healthy tablets, and only serve the sessions that read the
+// data as of some time ago (see @@read_as_of in vtgate).
+const DelayedReplicaTag = "delayed_replica"
+
+// IsDelayedReplica returns true if the tablet is a delayed replica.
+func IsDelayedReplica(tablet *topodata.Tablet) bool {
+	_, ok := tablet.GetTags()[DelayedReplicaTag]
+	return ok
+}
+
 // FilterStatsByReplicationLag filters the list of TabletHealth by TabletHealth.Stats.SecondsBehindMaster.
 // Note that TabletHealth that is non-serving or has error is ignored.
 //
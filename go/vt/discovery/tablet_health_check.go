@@ -69,6 +69,10 @@ type tabletHealthCheck struct {
 	// LastError is the error we last saw when trying to get the
 	// tablet's healthcheck.
 	LastError error
+	// Capabilities are the query service capabilities advertised by
+	// the tablet in its StreamHealth RPC. Conn is negotiated with them
+	// when handed out.
+	Capabilities *queryservice.Capabilities
 	// possibly delete both these
 	loggedServingState    bool
 	lastResponseTimestamp time.Time // timestamp of the last healthcheck response
@@ -87,7 +91,7 @@ func (thc *tabletHealthCheck) SimpleCopy() *TabletHealth {
 	thc.connMu.Lock()
 	defer thc.connMu.Unlock()
 	return &TabletHealth{
-		Conn:                thc.Conn,
+		Conn:                queryservice.Negotiate(thc.Conn, thc.Capabilities),
 		Tablet:              thc.Tablet,
 		Target:              thc.Target,
 		Stats:               thc.Stats,
@@ -195,6 +199,9 @@ func (thc *tabletHealthCheck) processResponse(hc *HealthCheckImpl, shr *query.St
 	thc.MasterTermStartTime = shr.TabletExternallyReparentedTimestamp
 	thc.Stats = shr.RealtimeStats
 	thc.LastError = healthErr
+	if !thc.Capabilities.Matches(shr.QueryServiceVersion, shr.Capabilities) {
+		thc.Capabilities = queryservice.NewCapabilities(shr.QueryServiceVersion, shr.Capabilities)
+	}
 	reason := "healthCheck update"
 	if healthErr != nil {
 		reason = "healthCheck update error: " + healthErr.Error()
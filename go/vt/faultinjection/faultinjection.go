@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package faultinjection delays or fails a share of the operations of a
+// component, to test how the retries, buffering and failovers deal with
+// them. It is meant for tests only: it does nothing until rules are set,
+// with the flag of an injector or on /debug/fault_injection, which is only
+// served with -enable_fault_injection, to the ADMIN role.
+package faultinjection
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+var (
+	enabled = flag.Bool("enable_fault_injection", false, "serve /debug/fault_injection, where the ADMIN role can set the fault injection rules of the injectors. For tests only: the rules fail or delay the operations of the process.")
+	seed    = flag.Int64("fault_injection_seed", 0, "seed of the random choice of the operations to inject faults in, for reproducible tests. 0 uses the current time.")
+
+	faultsInjected = stats.NewCountersWithMultiLabels(
+		"FaultsInjected",
+		"Faults injected by the fault injection rules",
+		[]string{"Injector", "Fault"})
+)
+
+// Rule injects faults in the operations it matches.
+type Rule struct {
+	// Regexp matches the SQL or the path of the operations.
+	Regexp string `json:"regexp"`
+	// Percent is the share of the matching operations that
+	// get the faults, from 0 to 100.
+	Percent float64 `json:"percent"`
+	// DelayMS delays the operations, in milliseconds.
+	DelayMS int `json:"delay_ms,omitempty"`
+	// Fail fails the operations, after the delay if any.
+	Fail bool `json:"fail,omitempty"`
+}
+
+type rule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// Injector injects faults in the operations of a component. It also
+// implements flag.Value, with the rules as a JSON list.
+type Injector struct {
+	name     string
+	newError func(message string) error
+	// active is set if there are rules, so that the operations
+	// don't contend on mu if there are none.
+	active sync2.AtomicBool
+
+	mu    sync.Mutex
+	rules []*rule
+	// rand is seeded again when the rules change, so that the
+	// same rules inject faults in the same operations.
+	rand *rand.Rand
+}
+
+var (
+	injectorsMu sync.Mutex
+	injectors   = make(map[string]*Injector)
+)
+
+// New returns an Injector without rules. newError returns the error of
+// the failed operations, as the component would fail them.
+func New(name string, newError func(message string) error) *Injector {
+	injectorsMu.Lock()
+	defer injectorsMu.Unlock()
+	if _, ok := injectors[name]; ok {
+		panic(fmt.Sprintf("fault injector %s already exists", name))
+	}
+	inj := &Injector{
+		name:     name,
+		newError: newError,
+	}
+	injectors[name] = inj
+	return inj
+}
+
+// SetRules replaces the rules of the injector.
+func (inj *Injector) SetRules(rules []Rule) error {
+	compiled := make([]*rule, 0, len(rules))
+	for _, r := range rules {
+		re, err := regexp.Compile(r.Regexp)
+		if err != nil {
+			return fmt.Errorf("invalid fault injection regexp %q: %v", r.Regexp, err)
+		}
+		if r.Percent < 0 || r.Percent > 100 {
+			return fmt.Errorf("invalid fault injection percent %v, expected 0 to 100", r.Percent)
+		}
+		compiled = append(compiled, &rule{Rule: r, re: re})
+	}
+
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	inj.rules = compiled
+	inj.rand = nil
+	inj.active.Set(len(compiled) > 0)
+	if len(rules) > 0 {
+		log.Warningf("Fault injection rules of %s set to %v", inj.name, rules)
+	}
+	return nil
+}
+
+// Rules returns the rules of the injector.
+func (inj *Injector) Rules() []Rule {
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	rules := make([]Rule, 0, len(inj.rules))
+	for _, r := range inj.rules {
+		rules = append(rules, r.Rule)
+	}
+	return rules
+}
+
+// Inject injects the faults of the first rule that matches s, the SQL or
+// the path of an operation, if the operation is picked by its percent.
+// It returns the error the operation must fail with, if any.
+func (inj *Injector) Inject(ctx context.Context, s string) error {
+	if !inj.active.Get() {
+		return nil
+	}
+	r := inj.pick(s)
+	if r == nil {
+		return nil
+	}
+	if r.DelayMS > 0 {
+		faultsInjected.Add([]string{inj.name, "Delay"}, 1)
+		timer := time.NewTimer(time.Duration(r.DelayMS) * time.Millisecond)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if r.Fail {
+		faultsInjected.Add([]string{inj.name, "Error"}, 1)
+		return inj.newError(fmt.Sprintf("fault injected by the rule of regexp %s", r.Regexp))
+	}
+	return nil
+}
+
+func (inj *Injector) pick(s string) *rule {
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	for _, r := range inj.rules {
+		if !r.re.MatchString(s) {
+			continue
+		}
+		if inj.rand == nil {
+			seed := *seed
+			if seed == 0 {
+				seed = time.Now().UnixNano()
+			}
+			inj.rand = rand.New(rand.NewSource(seed))
+		}
+		if inj.rand.Float64()*100 < r.Percent {
+			return r
+		}
+		return nil
+	}
+	return nil
+}
+
+// String is part of the flag.Value interface.
+func (inj *Injector) String() string {
+	if inj == nil {
+		return ""
+	}
+	rules := inj.Rules()
+	if len(rules) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(rules)
+	return string(b)
+}
+
+// Set is part of the flag.Value interface.
+func (inj *Injector) Set(value string) error {
+	var rules []Rule
+	if value != "" {
+		if err := json.Unmarshal([]byte(value), &rules); err != nil {
+			return fmt.Errorf("invalid fault injection rules %q: %v", value, err)
+		}
+	}
+	return inj.SetRules(rules)
+}
+
+func init() {
+	servenv.OnRun(func() {
+		if *enabled {
+			http.HandleFunc("/debug/fault_injection", handleFaultInjection)
+		}
+	})
+}
+
+// handleFaultInjection lists the rules of the injectors on GET. On POST,
+// it replaces the rules of the injector in the name parameter with the
+// rules parameter.
+func handleFaultInjection(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	injectorsMu.Lock()
+	defer injectorsMu.Unlock()
+	if r.Method == http.MethodPost {
+		inj, ok := injectors[r.FormValue("name")]
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown fault injector %q", r.FormValue("name")), http.StatusBadRequest)
+			return
+		}
+		if err := inj.Set(r.FormValue("rules")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	all := make(map[string][]Rule, len(injectors))
+	for name, inj := range injectors {
+		all[name] = inj.Rules()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.MarshalIndent(all, "", "  ")
+	w.Write(b)
+}
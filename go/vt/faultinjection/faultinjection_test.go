@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faultinjection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjector(t *testing.T) {
+	defer func(s int64) { *seed = s }(*seed)
+	*seed = 1
+	inj := New("TestInjector", errors.New)
+	ctx := context.Background()
+
+	// No rules.
+	assert.NoError(t, inj.Inject(ctx, "select 1"))
+	assert.Equal(t, "", inj.String())
+
+	require.NoError(t, inj.Set(`[{"regexp": "^select", "percent": 100, "fail": true}, {"regexp": "^insert", "percent": 100, "delay_ms": 50}]`))
+	assert.Equal(t, `[{"regexp":"^select","percent":100,"fail":true},{"regexp":"^insert","percent":100,"delay_ms":50}]`, inj.String())
+	assert.EqualError(t, inj.Inject(ctx, "select 1"), "fault injected by the rule of regexp ^select")
+	assert.NoError(t, inj.Inject(ctx, "update t set a = 1"))
+
+	start := time.Now()
+	assert.NoError(t, inj.Inject(ctx, "insert into t values (1)"))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+
+	// The delay ends with the context.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, context.Canceled, inj.Inject(ctx, "insert into t values (1)"))
+
+	assert.Error(t, inj.Set(`[{"regexp": "(", "percent": 100}]`))
+	assert.Error(t, inj.Set(`[{"regexp": "", "percent": 200}]`))
+	assert.Error(t, inj.Set(`{}`))
+
+	require.NoError(t, inj.Set(""))
+	assert.NoError(t, inj.Inject(context.Background(), "select 1"))
+}
+
+func TestInjectorPercent(t *testing.T) {
+	defer func(s int64) { *seed = s }(*seed)
+	*seed = 1
+	inj := New("TestInjectorPercent", errors.New)
+
+	failures := func() []int {
+		var failed []int
+		for i := 0; i < 1000; i++ {
+			if inj.Inject(context.Background(), "select 1") != nil {
+				failed = append(failed, i)
+			}
+		}
+		return failed
+	}
+	require.NoError(t, inj.SetRules([]Rule{{Regexp: "select", Percent: 10, Fail: true}}))
+	first := failures()
+	assert.InDelta(t, 100, len(first), 30)
+
+	// The same rules fail the same operations.
+	require.NoError(t, inj.SetRules([]Rule{{Regexp: "select", Percent: 10, Fail: true}}))
+	assert.Equal(t, first, failures())
+}
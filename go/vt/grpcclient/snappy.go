@@ -23,10 +23,14 @@ import (
 	"github.com/golang/snappy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
+
+	// Register the gzip compressor, for -grpc_vstream_compression.
+	_ "google.golang.org/grpc/encoding/gzip"
 )
 
 var (
-	compression = flag.String("grpc_compression", "", "Which protocol to use for compressing gRPC. Default: nothing. Supported: snappy")
+	compression        = flag.String("grpc_compression", "", "Which protocol to use for compressing gRPC. Default: nothing. Supported: snappy")
+	vstreamCompression = flag.String("grpc_vstream_compression", "", "Which protocol to use for compressing the gRPC VStream calls only, to reduce the bandwidth used by replication streams without compressing the other calls. Default: nothing. Supported: snappy, gzip")
 )
 
 // SnappyCompressor is a gRPC compressor using the Snappy algorithm.
@@ -56,6 +60,16 @@ func appendCompression(opts []grpc.DialOption) ([]grpc.DialOption, error) {
 	return opts, nil
 }
 
+// VStreamCallOptions returns the call options of the VStream calls,
+// which are compressed according to -grpc_vstream_compression. The
+// server answers with the compressor used by the client.
+func VStreamCallOptions() []grpc.CallOption {
+	if *vstreamCompression == "" {
+		return nil
+	}
+	return []grpc.CallOption{grpc.UseCompressor(*vstreamCompression)}
+}
+
 func init() {
 	encoding.RegisterCompressor(SnappyCompressor{})
 	RegisterGRPCDialOptions(appendCompression)
@@ -0,0 +1,38 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestVStreamCallOptions(t *testing.T) {
+	assert.Empty(t, VStreamCallOptions())
+
+	for _, name := range []string{"snappy", "gzip"} {
+		// The compressor must be known to both the client and the server.
+		assert.NotNil(t, encoding.GetCompressor(name), name)
+
+		*vstreamCompression = name
+		assert.Equal(t, []grpc.CallOption{grpc.UseCompressor(name)}, VStreamCallOptions())
+	}
+	*vstreamCompression = ""
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logutil
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// aggregatedMaxMessages is how many distinct messages an AggregatedLogger
+// logs per interval. The other ones are only counted.
+const aggregatedMaxMessages = 100
+
+// AggregatedLogger logs the first of identical messages per interval,
+// and counts the other ones. At the end of the interval, it logs how
+// many of each message it did not log. It keeps the logs readable
+// when the same error repeats many times, e.g. for every execution of
+// a bad query, and it logs at most aggregatedMaxMessages distinct messages
+// per interval. The Keyed methods aggregate the messages by a key
+// instead, e.g. to aggregate the errors of the same query that only
+// differ by their values.
+type AggregatedLogger struct {
+	// set at construction
+	name     string
+	interval time.Duration
+
+	// mu protects the following members
+	mu sync.Mutex
+	// entries are the messages logged in the interval, by key.
+	entries map[string]*aggregatedEntry
+	// overflow is how many messages were not logged because there
+	// were already aggregatedMaxMessages entries in the interval.
+	overflow int
+}
+
+type aggregatedEntry struct {
+	logF logFunc
+	// message is the message logged for the key, as a sample of the
+	// messages that were not logged.
+	message string
+	// count is how many times a message of the key was not logged.
+	count int
+}
+
+// NewAggregatedLogger will create an AggregatedLogger with the given
+// name and aggregation interval.
+func NewAggregatedLogger(name string, interval time.Duration) *AggregatedLogger {
+	return &AggregatedLogger{
+		name:     name,
+		interval: interval,
+		entries:  make(map[string]*aggregatedEntry),
+	}
+}
+
+func (al *AggregatedLogger) log(logF logFunc, key, message string) {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	if entry, ok := al.entries[key]; ok {
+		entry.count++
+		return
+	}
+	if len(al.entries) == 0 && al.overflow == 0 {
+		time.AfterFunc(al.interval, al.flush)
+	}
+	if len(al.entries) >= aggregatedMaxMessages {
+		al.overflow++
+		return
+	}
+	al.entries[key] = &aggregatedEntry{logF: logF, message: message}
+	logF(2, al.name+": "+message)
+}
+
+// flush logs the counts of the interval, and starts a new one.
+func (al *AggregatedLogger) flush() {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	keys := make([]string, 0, len(al.entries))
+	for key := range al.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	// Because of the timer, we lose the stack trace,
+	// so we just use the current line for this.
+	for _, key := range keys {
+		if entry := al.entries[key]; entry.count > 0 {
+			entry.logF(0, fmt.Sprintf("%v: %v more in the last %v: %v", al.name, entry.count, al.interval, entry.message))
+		}
+	}
+	if al.overflow > 0 {
+		warningDepth(0, fmt.Sprintf("%v: skipped %v log messages", al.name, al.overflow))
+	}
+	al.entries = make(map[string]*aggregatedEntry)
+	al.overflow = 0
+}
+
+// Infof logs an info if it was not logged in the interval.
+func (al *AggregatedLogger) Infof(format string, v ...interface{}) {
+	message := fmt.Sprintf(format, v...)
+	al.log(infoDepth, message, message)
+}
+
+// Warningf logs a warning if it was not logged in the interval.
+func (al *AggregatedLogger) Warningf(format string, v ...interface{}) {
+	message := fmt.Sprintf(format, v...)
+	al.log(warningDepth, message, message)
+}
+
+// Errorf logs an error if it was not logged in the interval.
+func (al *AggregatedLogger) Errorf(format string, v ...interface{}) {
+	message := fmt.Sprintf(format, v...)
+	al.log(errorDepth, message, message)
+}
+
+// KeyedInfof logs an info if no message with the same key was logged
+// in the interval.
+func (al *AggregatedLogger) KeyedInfof(key, format string, v ...interface{}) {
+	al.log(infoDepth, key, fmt.Sprintf(format, v...))
+}
+
+// KeyedWarningf logs a warning if no message with the same key was
+// logged in the interval.
+func (al *AggregatedLogger) KeyedWarningf(key, format string, v ...interface{}) {
+	al.log(warningDepth, key, fmt.Sprintf(format, v...))
+}
+
+// KeyedErrorf logs an error if no message with the same key was logged
+// in the interval.
+func (al *AggregatedLogger) KeyedErrorf(key, format string, v ...interface{}) {
+	al.log(errorDepth, key, fmt.Sprintf(format, v...))
+}
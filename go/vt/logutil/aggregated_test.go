@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logutil
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregatedLogger(t *testing.T) {
+	// Install fake log funcs for testing.
+	log := make(chan string, 2*aggregatedMaxMessages)
+	savedInfo, savedWarning := infoDepth, warningDepth
+	defer func() { infoDepth, warningDepth = savedInfo, savedWarning }()
+	infoDepth = func(depth int, args ...interface{}) {
+		log <- "I " + fmt.Sprint(args...)
+	}
+	warningDepth = func(depth int, args ...interface{}) {
+		log <- "W " + fmt.Sprint(args...)
+	}
+	interval := 100 * time.Millisecond
+	al := NewAggregatedLogger("name", interval)
+
+	start := time.Now()
+	al.Infof("error %v", 1)
+	al.Infof("error %v", 1)
+	al.Infof("error %v", 1)
+	al.Warningf("error %v", 2)
+	assert.Equal(t, "I name: error 1", <-log)
+	assert.Equal(t, "W name: error 2", <-log)
+
+	// The summary only has the messages that were not logged.
+	assert.Equal(t, "I name: 2 more in the last 100ms: error 1", <-log)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(interval))
+
+	// A new interval logs the message again.
+	al.Infof("error %v", 1)
+	assert.Equal(t, "I name: error 1", <-log)
+
+	// Too many distinct messages are only counted.
+	for i := 2; i < aggregatedMaxMessages+3; i++ {
+		al.Infof("error %v", i)
+	}
+	for i := 2; i < aggregatedMaxMessages+1; i++ {
+		assert.Equal(t, fmt.Sprintf("I name: error %v", i), <-log)
+	}
+	assert.Equal(t, "W name: skipped 2 log messages", <-log)
+}
+
+func TestAggregatedLoggerKeyed(t *testing.T) {
+	log := make(chan string, 10)
+	savedInfo := infoDepth
+	defer func() { infoDepth = savedInfo }()
+	infoDepth = func(depth int, args ...interface{}) {
+		log <- "I " + fmt.Sprint(args...)
+	}
+	al := NewAggregatedLogger("name", 100*time.Millisecond)
+
+	// The messages of a key are aggregated, and the first one is the
+	// sample of the summary.
+	al.KeyedInfof("query 1", "error for %v", 1)
+	al.KeyedInfof("query 1", "error for %v", 2)
+	al.KeyedInfof("query 2", "error for %v", 1)
+	assert.Equal(t, "I name: error for 1", <-log)
+	assert.Equal(t, "I name: error for 1", <-log)
+	assert.Equal(t, "I name: 1 more in the last 100ms: error for 1", <-log)
+}
@@ -48,10 +48,12 @@ func NewThrottledLogger(name string, maxInterval time.Duration) *ThrottledLogger
 
 type logFunc func(int, ...interface{})
 
+// The functions of the log package are looked up at each call, so that
+// the tests can replace them.
 var (
-	infoDepth    = log.InfoDepth
-	warningDepth = log.WarningDepth
-	errorDepth   = log.ErrorDepth
+	infoDepth    logFunc = func(depth int, args ...interface{}) { log.InfoDepth(depth+1, args...) }
+	warningDepth logFunc = func(depth int, args ...interface{}) { log.WarningDepth(depth+1, args...) }
+	errorDepth   logFunc = func(depth int, args ...interface{}) { log.ErrorDepth(depth+1, args...) }
 )
 
 func (tl *ThrottledLogger) log(logF logFunc, format string, v ...interface{}) {
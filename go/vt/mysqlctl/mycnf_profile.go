@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// This file handles the tuning profiles, which size the MySQL settings
+// that depend on the workload from the memory and CPUs of the host.
+
+var (
+	mycnfProfileName     = flag.String("mysqlctl_mycnf_profile", "", fmt.Sprintf("tuning profile added to the generated my.cnf, sized from the memory and CPUs of the host: one of %s. Empty for none.", strings.Join(mycnfProfileNames(), ", ")))
+	mycnfProfileMemoryMB = flag.Int64("mysqlctl_mycnf_profile_memory_mb", 0, "memory in MB available to mysqld, used to size the -mysqlctl_mycnf_profile settings. 0 detects it from the host and its cgroup limit.")
+	mycnfProfileCPUs     = flag.Int("mysqlctl_mycnf_profile_cpus", 0, "number of CPUs available to mysqld, used to size the -mysqlctl_mycnf_profile settings. 0 detects it from the host.")
+	mycnfProfileTablets  = flag.Int("mysqlctl_mycnf_profile_tablets_per_host", 1, "number of tablets sharing the host, whose detected memory and CPUs are split evenly between them to size the -mysqlctl_mycnf_profile settings. It doesn't apply to -mysqlctl_mycnf_profile_memory_mb and -mysqlctl_mycnf_profile_cpus, which are per tablet.")
+
+	innodbBufferPoolSizeMB = flag.Int64("mysqlctl_innodb_buffer_pool_size_mb", 0, "if set, overrides the innodb_buffer_pool_size of -mysqlctl_mycnf_profile, in MB")
+	innodbLogFileSizeMB    = flag.Int64("mysqlctl_innodb_log_file_size_mb", 0, "if set, overrides the innodb_log_file_size of -mysqlctl_mycnf_profile, in MB")
+	mysqlMaxConnections    = flag.Int64("mysqlctl_max_connections", 0, "if set, overrides the max_connections of -mysqlctl_mycnf_profile")
+)
+
+// mycnfProfile describes how a tuning profile sizes the settings.
+// The sizes are in MB.
+type mycnfProfile struct {
+	// bufferPoolPercent is the share of the memory given to the
+	// InnoDB buffer pool.
+	bufferPoolPercent int64
+	// Each of the two redo log files is a fraction of the buffer pool,
+	// within bounds. Bigger redo logs absorb more writes before a
+	// checkpoint, at the expense of the crash recovery time.
+	logFileDivisor, minLogFileMB, maxLogFileMB int64
+	// max_connections grows with the CPUs, within bounds.
+	connectionsPerCPU, minConnections, maxConnections int64
+	// settings are added as is.
+	settings []string
+}
+
+var mycnfProfiles = map[string]*mycnfProfile{
+	// oltp-small is for small tablets, usually several of them sharing
+	// a host with -mysqlctl_mycnf_profile_tablets_per_host: the buffer
+	// pool leaves room for the connections and the other processes.
+	"oltp-small": {
+		bufferPoolPercent: 50,
+		logFileDivisor:    8,
+		minLogFileMB:      48,
+		maxLogFileMB:      512,
+		connectionsPerCPU: 100,
+		minConnections:    200,
+		maxConnections:    1000,
+	},
+	// oltp-large is for a tablet that has the host to itself.
+	"oltp-large": {
+		bufferPoolPercent: 70,
+		logFileDivisor:    4,
+		minLogFileMB:      256,
+		maxLogFileMB:      4096,
+		connectionsPerCPU: 200,
+		minConnections:    500,
+		maxConnections:    5000,
+		settings: []string{
+			"innodb_flush_neighbors = 0",
+		},
+	},
+	// batch is for few connections running big reads and writes,
+	// like the ones of analytics or backfills.
+	"batch": {
+		bufferPoolPercent: 60,
+		logFileDivisor:    2,
+		minLogFileMB:      512,
+		maxLogFileMB:      8192,
+		connectionsPerCPU: 10,
+		minConnections:    100,
+		maxConnections:    500,
+		settings: []string{
+			"sort_buffer_size = 8M",
+			"join_buffer_size = 8M",
+			"read_rnd_buffer_size = 4M",
+			"tmp_table_size = 256M",
+			"max_heap_table_size = 256M",
+		},
+	},
+}
+
+func mycnfProfileNames() []string {
+	names := make([]string, 0, len(mycnfProfiles))
+	for name := range mycnfProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// mycnf returns the my.cnf lines of the profile for a host
+// with memoryMB of memory and cpus CPUs.
+func (p *mycnfProfile) mycnf(memoryMB int64, cpus int) string {
+	bufferPoolMB := memoryMB * p.bufferPoolPercent / 100
+	if *innodbBufferPoolSizeMB > 0 {
+		bufferPoolMB = *innodbBufferPoolSizeMB
+	}
+	logFileMB := clamp(bufferPoolMB/p.logFileDivisor, p.minLogFileMB, p.maxLogFileMB)
+	if *innodbLogFileSizeMB > 0 {
+		logFileMB = *innodbLogFileSizeMB
+	}
+	maxConnections := clamp(int64(cpus)*p.connectionsPerCPU, p.minConnections, p.maxConnections)
+	if *mysqlMaxConnections > 0 {
+		maxConnections = *mysqlMaxConnections
+	}
+	// Buffer pool instances of less than 1GB are not worth
+	// the overhead, and more than one per CPU doesn't help.
+	instances := clamp(bufferPoolMB/1024, 1, int64(cpus))
+	ioThreads := clamp(int64(cpus), 4, 16)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "innodb_buffer_pool_size = %dM\n", bufferPoolMB)
+	fmt.Fprintf(&b, "innodb_buffer_pool_instances = %d\n", instances)
+	fmt.Fprintf(&b, "innodb_log_file_size = %dM\n", logFileMB)
+	fmt.Fprintf(&b, "innodb_log_files_in_group = 2\n")
+	fmt.Fprintf(&b, "innodb_read_io_threads = %d\n", ioThreads)
+	fmt.Fprintf(&b, "innodb_write_io_threads = %d\n", ioThreads)
+	fmt.Fprintf(&b, "max_connections = %d\n", maxConnections)
+	for _, setting := range p.settings {
+		fmt.Fprintf(&b, "%s\n", setting)
+	}
+	return b.String()
+}
+
+func clamp(v, min, max int64) int64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// getMycnfProfile returns the my.cnf lines of the -mysqlctl_mycnf_profile
+// tuning profile, or "" if there is none.
+func getMycnfProfile() (string, error) {
+	if *mycnfProfileName == "" {
+		return "", nil
+	}
+	profile, ok := mycnfProfiles[*mycnfProfileName]
+	if !ok {
+		return "", fmt.Errorf("unknown my.cnf profile %q, expected one of %s", *mycnfProfileName, strings.Join(mycnfProfileNames(), ", "))
+	}
+	tablets := *mycnfProfileTablets
+	if tablets < 1 {
+		return "", fmt.Errorf("invalid -mysqlctl_mycnf_profile_tablets_per_host %d, expected at least 1", tablets)
+	}
+	memoryMB := *mycnfProfileMemoryMB
+	if memoryMB <= 0 {
+		memory, err := detectMemory()
+		if err != nil {
+			return "", fmt.Errorf("cannot detect the memory of the host, set -mysqlctl_mycnf_profile_memory_mb: %v", err)
+		}
+		memoryMB = memory / (1024 * 1024) / int64(tablets)
+	}
+	cpus := *mycnfProfileCPUs
+	if cpus <= 0 {
+		cpus = runtime.NumCPU() / tablets
+		if cpus < 1 {
+			cpus = 1
+		}
+	}
+	return fmt.Sprintf("## profile %s for %dMB of memory and %d CPUs\n%s", *mycnfProfileName, memoryMB, cpus, profile.mycnf(memoryMB, cpus)), nil
+}
+
+// Overridden in tests.
+var (
+	meminfoFile      = "/proc/meminfo"
+	cgroupLimitFiles = []string{
+		"/sys/fs/cgroup/memory.max",
+		"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+	}
+)
+
+// detectMemory returns the memory of the host in bytes, or the memory
+// limit of the cgroup of the process if it's lower.
+func detectMemory() (int64, error) {
+	f, err := os.Open(meminfoFile)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var memory int64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// MemTotal:       16318508 kB
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 3 && fields[0] == "MemTotal:" && fields[2] == "kB" {
+			kb, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid MemTotal in %s: %v", meminfoFile, err)
+			}
+			memory = kb * 1024
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if memory == 0 {
+		return 0, fmt.Errorf("no MemTotal in %s", meminfoFile)
+	}
+
+	for _, file := range cgroupLimitFiles {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		// The limit is "max", or a huge number in cgroup v1, if there is none.
+		limit, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err == nil && limit > 0 && limit < memory {
+			memory = limit
+		}
+	}
+	return memory, nil
+}
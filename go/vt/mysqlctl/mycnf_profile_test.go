@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMycnfProfile(t *testing.T) {
+	defer func() {
+		*mycnfProfileName = ""
+		*mycnfProfileMemoryMB = 0
+		*mycnfProfileCPUs = 0
+		*mycnfProfileTablets = 1
+		*mysqlMaxConnections = 0
+	}()
+
+	got, err := getMycnfProfile()
+	require.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	*mycnfProfileName = "oltp-large"
+	*mycnfProfileMemoryMB = 16384
+	*mycnfProfileCPUs = 8
+	got, err = getMycnfProfile()
+	require.NoError(t, err)
+	assert.Equal(t, `## profile oltp-large for 16384MB of memory and 8 CPUs
+innodb_buffer_pool_size = 11468M
+innodb_buffer_pool_instances = 8
+innodb_log_file_size = 2867M
+innodb_log_files_in_group = 2
+innodb_read_io_threads = 8
+innodb_write_io_threads = 8
+max_connections = 1600
+innodb_flush_neighbors = 0
+`, got)
+
+	*mycnfProfileName = "oltp-small"
+	*mycnfProfileMemoryMB = 2048
+	*mycnfProfileCPUs = 1
+	*mysqlMaxConnections = 300
+	got, err = getMycnfProfile()
+	require.NoError(t, err)
+	assert.Equal(t, `## profile oltp-small for 2048MB of memory and 1 CPUs
+innodb_buffer_pool_size = 1024M
+innodb_buffer_pool_instances = 1
+innodb_log_file_size = 128M
+innodb_log_files_in_group = 2
+innodb_read_io_threads = 4
+innodb_write_io_threads = 4
+max_connections = 300
+`, got)
+
+	// The detected memory is split between the tablets of the host.
+	defer func(meminfo string, limits []string) {
+		meminfoFile, cgroupLimitFiles = meminfo, limits
+	}(meminfoFile, cgroupLimitFiles)
+	dir, err := ioutil.TempDir("", "mycnf_profile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	meminfoFile = path.Join(dir, "meminfo")
+	cgroupLimitFiles = nil
+	require.NoError(t, ioutil.WriteFile(meminfoFile, []byte("MemTotal:       16777216 kB\n"), 0644))
+	*mycnfProfileMemoryMB = 0
+	*mycnfProfileCPUs = 2
+	*mycnfProfileTablets = 4
+	*mysqlMaxConnections = 0
+	got, err = getMycnfProfile()
+	require.NoError(t, err)
+	assert.Equal(t, `## profile oltp-small for 4096MB of memory and 2 CPUs
+innodb_buffer_pool_size = 2048M
+innodb_buffer_pool_instances = 2
+innodb_log_file_size = 256M
+innodb_log_files_in_group = 2
+innodb_read_io_threads = 4
+innodb_write_io_threads = 4
+max_connections = 200
+`, got)
+
+	*mycnfProfileTablets = 0
+	_, err = getMycnfProfile()
+	assert.EqualError(t, err, "invalid -mysqlctl_mycnf_profile_tablets_per_host 0, expected at least 1")
+	*mycnfProfileTablets = 1
+
+	*mycnfProfileName = "oltp"
+	_, err = getMycnfProfile()
+	assert.EqualError(t, err, `unknown my.cnf profile "oltp", expected one of batch, oltp-large, oltp-small`)
+}
+
+func TestDetectMemory(t *testing.T) {
+	defer func(meminfo string, limits []string) {
+		meminfoFile, cgroupLimitFiles = meminfo, limits
+	}(meminfoFile, cgroupLimitFiles)
+
+	dir, err := ioutil.TempDir("", "mycnf_profile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	meminfoFile = path.Join(dir, "meminfo")
+	require.NoError(t, ioutil.WriteFile(meminfoFile, []byte("MemTotal:       16777216 kB\nMemFree:         1024 kB\n"), 0644))
+	limitFile := path.Join(dir, "memory.max")
+	cgroupLimitFiles = []string{limitFile, path.Join(dir, "missing")}
+
+	require.NoError(t, ioutil.WriteFile(limitFile, []byte("max\n"), 0644))
+	memory, err := detectMemory()
+	require.NoError(t, err)
+	assert.EqualValues(t, 16<<30, memory)
+
+	require.NoError(t, ioutil.WriteFile(limitFile, []byte("4294967296\n"), 0644))
+	memory, err = detectMemory()
+	require.NoError(t, err)
+	assert.EqualValues(t, 4<<30, memory)
+
+	require.NoError(t, ioutil.WriteFile(meminfoFile, []byte("MemFree:         1024 kB\n"), 0644))
+	_, err = detectMemory()
+	assert.Error(t, err)
+}
@@ -826,6 +826,13 @@ func (mysqld *Mysqld) getMycnfTemplate() string {
 	}
 	myTemplateSource.Write(b)
 
+	// The tuning profile comes before EXTRA_MY_CNF, which can override it.
+	profile, err := getMycnfProfile()
+	if err != nil {
+		log.Fatalf("could not generate the my.cnf profile: %v", err)
+	}
+	myTemplateSource.WriteString(profile)
+
 	if extraCnf := os.Getenv("EXTRA_MY_CNF"); extraCnf != "" {
 		parts := strings.Split(extraCnf, ":")
 		for _, path := range parts {
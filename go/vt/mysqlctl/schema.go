@@ -138,6 +138,23 @@ func (mysqld *Mysqld) GetSchema(ctx context.Context, dbName string, tables, excl
 		}()
 	}
 
+	// Get partitions concurrently.
+	partMap := map[string]*tablePartitions{}
+	if len(tableNames) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var err error
+			partMap, err = mysqld.getPartitions(ctx, dbName, tableNames...)
+			if err != nil {
+				allErrors.RecordError(err)
+				cancel()
+				return
+			}
+		}()
+	}
+
 	wg.Wait()
 	if err := allErrors.AggrError(vterrors.Aggregate); err != nil {
 		return nil, err
@@ -145,6 +162,10 @@ func (mysqld *Mysqld) GetSchema(ctx context.Context, dbName string, tables, excl
 
 	for _, td := range tds {
 		td.PrimaryKeyColumns = colMap[td.Name]
+		if tp, ok := partMap[td.Name]; ok {
+			td.Partitions = tp.names
+			td.PartitionExpression = tp.expression
+		}
 	}
 
 	sd.TableDefinitions = tds
@@ -333,6 +354,60 @@ func (mysqld *Mysqld) getPrimaryKeyColumns(ctx context.Context, dbName string, t
 	return colMap, err
 }
 
+// tablePartitions are the partitions of a partitioned table.
+type tablePartitions struct {
+	names []string
+	// expression is the partitioning method and expression,
+	// e.g. "RANGE (`id`)".
+	expression string
+}
+
+func (mysqld *Mysqld) getPartitions(ctx context.Context, dbName string, tables ...string) (map[string]*tablePartitions, error) {
+	conn, err := getPoolReconnect(ctx, mysqld.dbaPool)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	tableList, err := tableListSQL(tables)
+	if err != nil {
+		return nil, err
+	}
+	// sql uses column name aliases to guarantee lower case sensitivity.
+	// Only the first subpartition of each partition is listed.
+	sql := fmt.Sprintf(`
+		SELECT
+			table_name AS table_name,
+			partition_name AS partition_name,
+			partition_method AS partition_method,
+			partition_expression AS partition_expression
+		FROM information_schema.partitions
+		WHERE table_schema = '%s'
+			AND table_name IN %s
+			AND partition_name IS NOT NULL
+			AND (subpartition_ordinal_position IS NULL OR subpartition_ordinal_position = 1)
+		ORDER BY table_name, partition_ordinal_position`, dbName, tableList)
+	qr, err := conn.ExecuteFetch(sql, len(tables)*8192, true)
+	if err != nil {
+		return nil, err
+	}
+
+	named := qr.Named()
+	partMap := map[string]*tablePartitions{}
+	for _, row := range named.Rows {
+		tableName := row.AsString("table_name", "")
+		tp, ok := partMap[tableName]
+		if !ok {
+			tp = &tablePartitions{
+				expression: fmt.Sprintf("%s (%s)", row.AsString("partition_method", ""), row.AsString("partition_expression", "")),
+			}
+			partMap[tableName] = tp
+		}
+		tp.names = append(tp.names, row.AsString("partition_name", ""))
+	}
+	return partMap, nil
+}
+
 // PreflightSchemaChange checks the schema changes in "changes" by applying them
 // to an intermediate database that has the same schema as the target database.
 func (mysqld *Mysqld) PreflightSchemaChange(ctx context.Context, dbName string, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
@@ -1977,6 +1977,89 @@ func (m *VStreamResultsResponse) GetRows() []*query.Row {
 	return nil
 }
 
+// ExportTableRequest is the payload for ExportTable
+// The ids match VStreamRows.
+type ExportTableRequest struct {
+	EffectiveCallerId *vtrpc.CallerID       `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *query.VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *query.Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Table             string                `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// lastpk resumes the export after the row with this primary key.
+	Lastpk *query.QueryResult `protobuf:"bytes,5,opt,name=lastpk,proto3" json:"lastpk,omitempty"`
+	// endpk stops the export after the row with this primary key.
+	Endpk                *query.QueryResult `protobuf:"bytes,6,opt,name=endpk,proto3" json:"endpk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExportTableRequest) Reset()         { *m = ExportTableRequest{} }
+func (m *ExportTableRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTableRequest) ProtoMessage()    {}
+func (*ExportTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{27}
+}
+
+func (m *ExportTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTableRequest.Unmarshal(m, b)
+}
+func (m *ExportTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTableRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTableRequest.Merge(m, src)
+}
+func (m *ExportTableRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTableRequest.Size(m)
+}
+func (m *ExportTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTableRequest proto.InternalMessageInfo
+
+func (m *ExportTableRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ExportTableRequest) GetImmediateCallerId() *query.VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ExportTableRequest) GetTarget() *query.Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ExportTableRequest) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *ExportTableRequest) GetLastpk() *query.QueryResult {
+	if m != nil {
+		return m.Lastpk
+	}
+	return nil
+}
+
+func (m *ExportTableRequest) GetEndpk() *query.QueryResult {
+	if m != nil {
+		return m.Endpk
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("binlogdata.OnDDLAction", OnDDLAction_name, OnDDLAction_value)
 	proto.RegisterEnum("binlogdata.VEventType", VEventType_name, VEventType_value)
@@ -2011,130 +2094,133 @@ func init() {
 	proto.RegisterType((*TableLastPK)(nil), "binlogdata.TableLastPK")
 	proto.RegisterType((*VStreamResultsRequest)(nil), "binlogdata.VStreamResultsRequest")
 	proto.RegisterType((*VStreamResultsResponse)(nil), "binlogdata.VStreamResultsResponse")
+	proto.RegisterType((*ExportTableRequest)(nil), "binlogdata.ExportTableRequest")
 }
 
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0xe3, 0xc6,
-	0x11, 0x5e, 0xbe, 0xc9, 0x06, 0x45, 0x41, 0xa3, 0x47, 0x98, 0x2d, 0xdb, 0x25, 0xa3, 0xb2, 0x5e,
-	0x59, 0x55, 0xa1, 0x1c, 0x26, 0xde, 0x5c, 0x62, 0x3b, 0x7c, 0x60, 0xb5, 0x5c, 0xf1, 0xa1, 0x1d,
-	0x62, 0xb5, 0x2e, 0x5f, 0x50, 0x58, 0x70, 0x24, 0x21, 0x02, 0x08, 0x2c, 0x30, 0x94, 0xcc, 0x1f,
-	0x90, 0xaa, 0xdc, 0xf3, 0x2b, 0x72, 0xce, 0x31, 0xc9, 0x35, 0xf9, 0x13, 0xb9, 0xe6, 0x94, 0x5f,
-	0x90, 0x5b, 0x6a, 0x1e, 0x78, 0x69, 0xed, 0x95, 0xd6, 0x55, 0x39, 0xc4, 0x17, 0xd6, 0x4c, 0x4f,
-	0x77, 0x4f, 0xbf, 0xbe, 0x46, 0x73, 0x40, 0x7d, 0xed, 0x2c, 0x5d, 0xff, 0x62, 0x61, 0x51, 0xab,
-	0x13, 0x84, 0x3e, 0xf5, 0x11, 0xa4, 0x94, 0x87, 0xca, 0x35, 0x0d, 0x03, 0x5b, 0x1c, 0x3c, 0x54,
-	0xde, 0xac, 0x48, 0xb8, 0x96, 0x9b, 0x16, 0xf5, 0x03, 0x3f, 0x95, 0xd2, 0x26, 0x50, 0x1b, 0x5c,
-	0x5a, 0x61, 0x44, 0x28, 0xda, 0x83, 0xaa, 0xed, 0x3a, 0x64, 0x49, 0xdb, 0x85, 0xfd, 0xc2, 0x41,
-	0x05, 0xcb, 0x1d, 0x42, 0x50, 0xb6, 0xfd, 0xe5, 0xb2, 0x5d, 0xe4, 0x54, 0xbe, 0x66, 0xbc, 0x11,
-	0x09, 0xaf, 0x49, 0xd8, 0x2e, 0x09, 0x5e, 0xb1, 0xd3, 0xfe, 0x55, 0x82, 0xad, 0x3e, 0xb7, 0xc3,
-	0x08, 0xad, 0x65, 0x64, 0xd9, 0xd4, 0xf1, 0x97, 0xe8, 0x18, 0x20, 0xa2, 0x16, 0x25, 0x1e, 0x59,
-	0xd2, 0xa8, 0x5d, 0xd8, 0x2f, 0x1d, 0x28, 0xdd, 0xc7, 0x9d, 0x8c, 0x07, 0x6f, 0x89, 0x74, 0xe6,
-	0x31, 0x3f, 0xce, 0x88, 0xa2, 0x2e, 0x28, 0xe4, 0x9a, 0x2c, 0xa9, 0x49, 0xfd, 0x2b, 0xb2, 0x6c,
-	0x97, 0xf7, 0x0b, 0x07, 0x4a, 0x77, 0xab, 0x23, 0x1c, 0xd4, 0xd9, 0x89, 0xc1, 0x0e, 0x30, 0x90,
-	0x64, 0xfd, 0xf0, 0xef, 0x45, 0x68, 0x24, 0xda, 0xd0, 0x18, 0xea, 0xb6, 0x45, 0xc9, 0x85, 0x1f,
-	0xae, 0xb9, 0x9b, 0xad, 0xee, 0x67, 0xf7, 0x34, 0xa4, 0x33, 0x90, 0x72, 0x38, 0xd1, 0x80, 0x7e,
-	0x0e, 0x35, 0x5b, 0x44, 0x8f, 0x47, 0x47, 0xe9, 0x6e, 0x67, 0x95, 0xc9, 0xc0, 0xe2, 0x98, 0x07,
-	0xa9, 0x50, 0x8a, 0xde, 0xb8, 0x3c, 0x64, 0x4d, 0xcc, 0x96, 0xda, 0x9f, 0x0a, 0x50, 0x8f, 0xf5,
-	0xa2, 0x6d, 0xd8, 0xec, 0x8f, 0xcd, 0x97, 0x53, 0xac, 0x0f, 0x66, 0xc7, 0xd3, 0xd1, 0x37, 0xfa,
-	0x50, 0x7d, 0x80, 0x9a, 0x50, 0xef, 0x8f, 0xcd, 0xbe, 0x7e, 0x3c, 0x9a, 0xaa, 0x05, 0xb4, 0x01,
-	0x8d, 0xfe, 0xd8, 0x1c, 0xcc, 0x26, 0x93, 0x91, 0xa1, 0x16, 0xd1, 0x26, 0x28, 0xfd, 0xb1, 0x89,
-	0x67, 0xe3, 0x71, 0xbf, 0x37, 0x38, 0x51, 0x4b, 0x68, 0x17, 0xb6, 0xfa, 0x63, 0x73, 0x38, 0x19,
-	0x9b, 0x43, 0xfd, 0x14, 0xeb, 0x83, 0x9e, 0xa1, 0x0f, 0xd5, 0x32, 0x02, 0xa8, 0x32, 0xf2, 0x70,
-	0xac, 0x56, 0xe4, 0x7a, 0xae, 0x1b, 0x6a, 0x55, 0xaa, 0x1b, 0x4d, 0xe7, 0x3a, 0x36, 0xd4, 0x9a,
-	0xdc, 0xbe, 0x3c, 0x1d, 0xf6, 0x0c, 0x5d, 0xad, 0xcb, 0xed, 0x50, 0x1f, 0xeb, 0x86, 0xae, 0x36,
-	0x9e, 0x97, 0xeb, 0x45, 0xb5, 0xf4, 0xbc, 0x5c, 0x2f, 0xa9, 0x65, 0xed, 0x8f, 0x05, 0xd8, 0x9d,
-	0xd3, 0x90, 0x58, 0xde, 0x09, 0x59, 0x63, 0x6b, 0x79, 0x41, 0x30, 0x79, 0xb3, 0x22, 0x11, 0x45,
-	0x0f, 0xa1, 0x1e, 0xf8, 0x91, 0xc3, 0x62, 0xc7, 0x03, 0xdc, 0xc0, 0xc9, 0x1e, 0x1d, 0x41, 0xe3,
-	0x8a, 0xac, 0xcd, 0x90, 0xf1, 0xcb, 0x80, 0xa1, 0x4e, 0x52, 0x90, 0x89, 0xa6, 0xfa, 0x95, 0x5c,
-	0x65, 0xe3, 0x5b, 0xba, 0x3b, 0xbe, 0xda, 0x39, 0xec, 0xdd, 0x36, 0x2a, 0x0a, 0xfc, 0x65, 0x44,
-	0xd0, 0x18, 0x90, 0x10, 0x34, 0x69, 0x9a, 0x5b, 0x6e, 0x9f, 0xd2, 0xfd, 0xf0, 0x9d, 0x05, 0x80,
-	0xb7, 0x5e, 0xdf, 0x26, 0x69, 0xdf, 0xc2, 0xb6, 0xb8, 0xc7, 0xb0, 0x5e, 0xbb, 0x24, 0xba, 0x8f,
-	0xeb, 0x7b, 0x50, 0xa5, 0x9c, 0xb9, 0x5d, 0xdc, 0x2f, 0x1d, 0x34, 0xb0, 0xdc, 0xbd, 0xaf, 0x87,
-	0x0b, 0xd8, 0xc9, 0xdf, 0xfc, 0x3f, 0xf1, 0xef, 0x57, 0x50, 0xc6, 0x2b, 0x97, 0xa0, 0x1d, 0xa8,
-	0x78, 0x16, 0xb5, 0x2f, 0xa5, 0x37, 0x62, 0xc3, 0x5c, 0x39, 0x77, 0x5c, 0x4a, 0x42, 0x9e, 0xc2,
-	0x06, 0x96, 0x3b, 0xed, 0xcf, 0x05, 0xa8, 0x3e, 0xe5, 0x4b, 0xf4, 0x09, 0x54, 0xc2, 0x15, 0x73,
-	0x56, 0x60, 0x5d, 0xcd, 0x5a, 0xc0, 0x34, 0x63, 0x71, 0x8c, 0x46, 0xd0, 0x3a, 0x77, 0x88, 0xbb,
-	0xe0, 0xd0, 0x9d, 0xf8, 0x0b, 0x51, 0x15, 0xad, 0xee, 0xc7, 0x59, 0x01, 0xa1, 0xb3, 0xf3, 0x34,
-	0xc7, 0x88, 0x6f, 0x09, 0x6a, 0x4f, 0xa0, 0x95, 0xe7, 0x60, 0x70, 0xd2, 0x31, 0x36, 0x67, 0x53,
-	0x73, 0x32, 0x9a, 0x4f, 0x7a, 0xc6, 0xe0, 0x99, 0xfa, 0x80, 0x23, 0x46, 0x9f, 0x1b, 0xa6, 0xfe,
-	0xf4, 0xe9, 0x0c, 0x1b, 0x6a, 0x41, 0xfb, 0x77, 0x11, 0x9a, 0x22, 0x28, 0x73, 0x7f, 0x15, 0xda,
-	0x84, 0x65, 0xf1, 0x8a, 0xac, 0xa3, 0xc0, 0xb2, 0x49, 0x9c, 0xc5, 0x78, 0xcf, 0x02, 0x12, 0x5d,
-	0x5a, 0xe1, 0x42, 0x7a, 0x2e, 0x36, 0xe8, 0x73, 0x50, 0x78, 0x36, 0xa9, 0x49, 0xd7, 0x01, 0xe1,
-	0x79, 0x6c, 0x75, 0x77, 0xd2, 0xc2, 0xe6, 0xb9, 0xa2, 0xc6, 0x3a, 0x20, 0x18, 0x68, 0xb2, 0xce,
-	0xa3, 0xa1, 0x7c, 0x0f, 0x34, 0xa4, 0x35, 0x54, 0xc9, 0xd5, 0xd0, 0x61, 0x92, 0x90, 0xaa, 0xd4,
-	0xf2, 0x56, 0xf4, 0xe2, 0x24, 0xa1, 0x0e, 0x54, 0xfd, 0xa5, 0xb9, 0x58, 0xb8, 0xed, 0x1a, 0x37,
-	0xf3, 0x27, 0x59, 0xde, 0xd9, 0x72, 0x38, 0x1c, 0xf7, 0x44, 0x59, 0x54, 0xfc, 0xe5, 0x70, 0xe1,
-	0xa2, 0x47, 0xd0, 0x22, 0xdf, 0x52, 0x12, 0x2e, 0x2d, 0xd7, 0xf4, 0xd6, 0xac, 0x7b, 0xd5, 0xb9,
-	0xeb, 0x1b, 0x31, 0x75, 0xc2, 0x88, 0xe8, 0x13, 0xd8, 0x8c, 0xa8, 0x1f, 0x98, 0xd6, 0x39, 0x25,
-	0xa1, 0x69, 0xfb, 0xc1, 0xba, 0xdd, 0xd8, 0x2f, 0x1c, 0xd4, 0xf1, 0x06, 0x23, 0xf7, 0x18, 0x75,
-	0xe0, 0x07, 0x6b, 0xed, 0x05, 0x34, 0xb0, 0x7f, 0x33, 0xb8, 0xe4, 0xfe, 0x68, 0x50, 0x7d, 0x4d,
-	0xce, 0xfd, 0x90, 0xc8, 0x42, 0x05, 0xd9, 0xc8, 0xb1, 0x7f, 0x83, 0xe5, 0x09, 0xda, 0x87, 0x0a,
-	0xd7, 0x29, 0xdb, 0x45, 0x96, 0x45, 0x1c, 0x68, 0x16, 0xd4, 0xb1, 0x7f, 0xc3, 0xd3, 0x8e, 0x3e,
-	0x04, 0x11, 0x60, 0x73, 0x69, 0x79, 0x71, 0xf6, 0x1a, 0x9c, 0x32, 0xb5, 0x3c, 0x82, 0x9e, 0x80,
-	0x12, 0xfa, 0x37, 0xa6, 0xcd, 0xaf, 0x17, 0x48, 0x54, 0xba, 0xbb, 0xb9, 0xe2, 0x8c, 0x8d, 0xc3,
-	0x10, 0xc6, 0xcb, 0x48, 0x7b, 0x01, 0x90, 0xd6, 0xd6, 0x5d, 0x97, 0xfc, 0x8c, 0x65, 0x83, 0xb8,
-	0x8b, 0x58, 0x7f, 0x53, 0x9a, 0xcc, 0x35, 0x60, 0x79, 0xa6, 0xfd, 0xa1, 0x00, 0x8d, 0x39, 0xab,
-	0x9e, 0x63, 0xea, 0x2c, 0x7e, 0x40, 0xcd, 0x21, 0x28, 0x5f, 0x50, 0x67, 0xc1, 0x8b, 0xad, 0x81,
-	0xf9, 0x1a, 0x7d, 0x1e, 0x1b, 0x16, 0x98, 0x57, 0x51, 0xbb, 0xcc, 0x6f, 0xcf, 0xe5, 0x97, 0x17,
-	0xe2, 0xd8, 0x8a, 0xe8, 0xe9, 0x09, 0xae, 0x73, 0xd6, 0xd3, 0x93, 0x48, 0xfb, 0x0a, 0x2a, 0x67,
-	0xdc, 0x8a, 0x27, 0xa0, 0x70, 0xe5, 0x26, 0xd3, 0x16, 0x63, 0x37, 0x17, 0x9e, 0xc4, 0x62, 0x0c,
-	0x51, 0xbc, 0x8c, 0xb4, 0x1e, 0x6c, 0x9c, 0x48, 0x6b, 0x39, 0xc3, 0xfb, 0xbb, 0xa3, 0xfd, 0xb5,
-	0x08, 0xb5, 0xe7, 0xfe, 0x8a, 0x15, 0x14, 0x6a, 0x41, 0xd1, 0x59, 0x70, 0xb9, 0x12, 0x2e, 0x3a,
-	0x0b, 0xf4, 0x5b, 0x68, 0x79, 0xce, 0x45, 0x68, 0xb1, 0xb2, 0x14, 0x08, 0x13, 0x4d, 0xe2, 0xa7,
-	0x59, 0xcb, 0x26, 0x31, 0x07, 0x87, 0xd9, 0x86, 0x97, 0xdd, 0x66, 0x80, 0x53, 0xca, 0x01, 0xe7,
-	0x11, 0xb4, 0x5c, 0xdf, 0xb6, 0x5c, 0x33, 0x69, 0xdb, 0x65, 0x51, 0xdc, 0x9c, 0x7a, 0x1a, 0xf7,
-	0xee, 0x5b, 0x71, 0xa9, 0xdc, 0x33, 0x2e, 0xe8, 0x0b, 0x68, 0x06, 0x56, 0x48, 0x1d, 0xdb, 0x09,
-	0x2c, 0x36, 0xf8, 0x54, 0xb9, 0x60, 0xce, 0xec, 0x5c, 0xdc, 0x70, 0x8e, 0x1d, 0x7d, 0x0a, 0x6a,
-	0xc4, 0x5b, 0x92, 0x79, 0xe3, 0x87, 0x57, 0xe7, 0xae, 0x7f, 0x13, 0xb5, 0x6b, 0xdc, 0xfe, 0x4d,
-	0x41, 0x7f, 0x15, 0x93, 0xb5, 0xbf, 0x94, 0xa0, 0x7a, 0x26, 0xaa, 0xf3, 0x10, 0xca, 0x3c, 0x46,
-	0x62, 0xb8, 0xd9, 0xcb, 0x5e, 0x26, 0x38, 0x78, 0x80, 0x38, 0x0f, 0xfa, 0x00, 0x1a, 0xd4, 0xf1,
-	0x48, 0x44, 0x2d, 0x2f, 0xe0, 0x41, 0x2d, 0xe1, 0x94, 0xf0, 0x9d, 0x25, 0xf6, 0x01, 0x34, 0x92,
-	0x71, 0x4c, 0x06, 0x2b, 0x25, 0xa0, 0x5f, 0x40, 0x83, 0xe1, 0x8b, 0x0f, 0x5f, 0xed, 0x0a, 0x07,
-	0xec, 0xce, 0x2d, 0x74, 0x71, 0x13, 0x70, 0x3d, 0x8c, 0x11, 0xfb, 0x6b, 0x50, 0x38, 0x22, 0xa4,
-	0x90, 0x68, 0x60, 0x7b, 0xf9, 0x06, 0x16, 0x23, 0x0f, 0x43, 0xda, 0xf3, 0xd1, 0x63, 0xa8, 0x5c,
-	0x73, 0xf3, 0x6a, 0x72, 0x08, 0xcc, 0x3a, 0xca, 0x53, 0x21, 0xce, 0xd9, 0x17, 0xf6, 0x77, 0xa2,
-	0xb2, 0x78, 0xeb, 0xba, 0xf5, 0x85, 0x95, 0x45, 0x87, 0x63, 0x1e, 0x36, 0xa3, 0x2d, 0x3c, 0x97,
-	0x77, 0xaf, 0x06, 0x66, 0x4b, 0xf4, 0x31, 0x34, 0xed, 0x55, 0x18, 0xf2, 0xb1, 0xd3, 0xf1, 0x48,
-	0x7b, 0x87, 0x07, 0x4a, 0x91, 0x34, 0xc3, 0xf1, 0x08, 0xfa, 0x0d, 0xb4, 0x5c, 0x2b, 0xa2, 0x0c,
-	0x78, 0xd2, 0x91, 0x5d, 0x7e, 0x55, 0x0e, 0x7d, 0x02, 0x78, 0xc2, 0x13, 0xc5, 0x4d, 0x37, 0xda,
-	0x25, 0x34, 0x27, 0xce, 0xd2, 0xf1, 0x2c, 0x97, 0x03, 0x94, 0x05, 0x3e, 0xd3, 0x5a, 0xf8, 0xfa,
-	0x7e, 0x5d, 0x05, 0x7d, 0x04, 0x0a, 0x33, 0xc1, 0xf6, 0xdd, 0x95, 0xb7, 0x14, 0xd5, 0x5e, 0xc2,
-	0x8d, 0xe0, 0x64, 0x20, 0x08, 0x0c, 0xa9, 0xf2, 0xa6, 0xb9, 0x7d, 0x49, 0x3c, 0x0b, 0x7d, 0x96,
-	0x20, 0x43, 0xa0, 0xbd, 0x9d, 0xc7, 0x54, 0x6a, 0x54, 0x8c, 0x19, 0xed, 0x1f, 0x45, 0x68, 0x9d,
-	0x89, 0x19, 0x24, 0x9e, 0x7b, 0xbe, 0x82, 0x6d, 0x72, 0x7e, 0x4e, 0x6c, 0xea, 0x5c, 0x13, 0xd3,
-	0xb6, 0x5c, 0x97, 0x84, 0xa6, 0x44, 0xb0, 0xd2, 0xdd, 0xec, 0x88, 0xff, 0x22, 0x03, 0x4e, 0x1f,
-	0x0d, 0xf1, 0x56, 0xc2, 0x2b, 0x49, 0x0b, 0xa4, 0xc3, 0xb6, 0xe3, 0x79, 0x64, 0xe1, 0x58, 0x34,
-	0xab, 0x40, 0xb4, 0xfc, 0x5d, 0xe9, 0xe9, 0x99, 0x71, 0x6c, 0x51, 0x92, 0xaa, 0x49, 0x24, 0x12,
-	0x35, 0x8f, 0x98, 0x33, 0xe1, 0x45, 0x32, 0x4a, 0x6d, 0x48, 0x49, 0x83, 0x13, 0xb1, 0x3c, 0xcc,
-	0x8d, 0x69, 0xe5, 0x5b, 0x63, 0x5a, 0xfa, 0x29, 0xad, 0xdc, 0xf9, 0x29, 0xfd, 0x12, 0x36, 0x45,
-	0xbb, 0x8d, 0x53, 0x1f, 0x23, 0xfc, 0x7b, 0x7b, 0x6e, 0x93, 0xa6, 0x9b, 0x48, 0xfb, 0x02, 0x36,
-	0x93, 0x40, 0xca, 0x31, 0xee, 0x10, 0xaa, 0xbc, 0x7c, 0xe2, 0x74, 0xa0, 0xb7, 0xe1, 0x8b, 0x25,
-	0x87, 0xf6, 0xfb, 0x22, 0xa0, 0x58, 0xde, 0xbf, 0x89, 0xfe, 0x4f, 0x93, 0xb1, 0x03, 0x15, 0x4e,
-	0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4, 0xe0, 0x2a, 0x49, 0x83, 0x10, 0x7e, 0xc1, 0x7e,
-	0x31, 0x89, 0x56, 0x2e, 0xc5, 0x92, 0x43, 0xfb, 0x5b, 0x01, 0xb6, 0x73, 0x71, 0x90, 0xb1, 0x4c,
-	0x11, 0x53, 0x78, 0x07, 0x62, 0x0e, 0xa0, 0x1e, 0x5c, 0xbd, 0x03, 0x59, 0xc9, 0xe9, 0x77, 0xb6,
-	0xc3, 0x8f, 0xa0, 0x1c, 0xb2, 0xb6, 0x2c, 0xbe, 0xb5, 0xd9, 0xe1, 0x84, 0xd3, 0xd9, 0x84, 0x93,
-	0xf3, 0x23, 0x37, 0xe1, 0x48, 0xfb, 0x1d, 0x50, 0x32, 0x9d, 0x81, 0xb5, 0x92, 0x7c, 0x55, 0xc9,
-	0xd4, 0x7d, 0x6f, 0x51, 0x29, 0x99, 0xa2, 0x62, 0xfd, 0xd9, 0xf6, 0xbd, 0xc0, 0x25, 0x94, 0x88,
-	0x94, 0xd5, 0x71, 0x4a, 0xd0, 0xbe, 0x06, 0x25, 0x23, 0x79, 0xd7, 0x20, 0x93, 0x26, 0xa1, 0x74,
-	0x67, 0x12, 0xfe, 0x59, 0x80, 0xdd, 0xb4, 0x98, 0x57, 0x2e, 0xfd, 0x51, 0xd5, 0xa3, 0x16, 0xc2,
-	0xde, 0x6d, 0xef, 0xde, 0xab, 0xca, 0x7e, 0x40, 0xed, 0x1c, 0x7e, 0x09, 0x4a, 0x66, 0x1e, 0x67,
-	0x7f, 0xdb, 0x47, 0xc7, 0xd3, 0x19, 0xd6, 0xd5, 0x07, 0xa8, 0x0e, 0xe5, 0xb9, 0x31, 0x3b, 0x55,
-	0x0b, 0x6c, 0xa5, 0x7f, 0xad, 0x0f, 0xc4, 0x53, 0x00, 0x5b, 0x99, 0x92, 0xa9, 0x74, 0xf8, 0x9f,
-	0x02, 0x40, 0xfa, 0xc5, 0x47, 0x0a, 0xd4, 0x5e, 0x4e, 0x4f, 0xa6, 0xb3, 0x57, 0x53, 0xa1, 0xe0,
-	0xd8, 0x18, 0x0d, 0xd5, 0x02, 0x6a, 0x40, 0x45, 0xbc, 0x2d, 0x14, 0xd9, 0x0d, 0xf2, 0x61, 0xa1,
-	0x84, 0x9a, 0x50, 0x4f, 0x5e, 0x15, 0xca, 0xa8, 0x06, 0xa5, 0xe4, 0xed, 0x40, 0x3e, 0x16, 0x54,
-	0x99, 0x42, 0xac, 0x9f, 0x8e, 0x7b, 0x03, 0x5d, 0xad, 0xb1, 0x83, 0xe4, 0xd9, 0x00, 0xa0, 0x1a,
-	0xbf, 0x19, 0x30, 0xc9, 0xb9, 0x6e, 0xa8, 0xc0, 0xee, 0x99, 0x19, 0xcf, 0x74, 0xac, 0x2a, 0x8c,
-	0x86, 0x67, 0xaf, 0xd4, 0x26, 0xa3, 0x3d, 0x1d, 0xe9, 0xe3, 0xa1, 0xba, 0x81, 0x36, 0xa0, 0xf1,
-	0x4c, 0xef, 0x61, 0xa3, 0xaf, 0xf7, 0x0c, 0xb5, 0xc5, 0x4e, 0xce, 0xb8, 0x81, 0x9b, 0xec, 0x9a,
-	0xe7, 0xb3, 0x97, 0x78, 0xda, 0x1b, 0xab, 0x2a, 0xdb, 0x9c, 0xe9, 0x78, 0x3e, 0x9a, 0x4d, 0xd5,
-	0x2d, 0x76, 0xcf, 0xb8, 0x37, 0x37, 0x4e, 0x4f, 0x54, 0xc4, 0xe4, 0xe7, 0xbd, 0x33, 0xfd, 0x74,
-	0x36, 0x9a, 0x1a, 0xea, 0xf6, 0xe1, 0x63, 0xf6, 0x9d, 0xcb, 0x4e, 0x80, 0x00, 0x55, 0xa3, 0xd7,
-	0x1f, 0xeb, 0x73, 0xf5, 0x01, 0x5b, 0xcf, 0x9f, 0xf5, 0xf0, 0x70, 0xae, 0x16, 0xfa, 0x9f, 0x7e,
-	0xf3, 0xf8, 0xda, 0xa1, 0x24, 0x8a, 0x3a, 0x8e, 0x7f, 0x24, 0x56, 0x47, 0x17, 0xfe, 0xd1, 0x35,
-	0x3d, 0xe2, 0xcf, 0x63, 0x47, 0x29, 0xe6, 0x5e, 0x57, 0x39, 0xe5, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x23, 0xf6, 0xf5, 0x62, 0x7a, 0x13, 0x00, 0x00,
+	// 1946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x49, 0x73, 0x1b, 0xc7,
+	0xf5, 0x17, 0x76, 0xe0, 0x0d, 0x09, 0x0e, 0x9b, 0xcb, 0x1f, 0x7f, 0x95, 0xed, 0xa2, 0xa7, 0x22,
+	0x8b, 0x66, 0x55, 0x40, 0x07, 0x89, 0x95, 0x4b, 0x6c, 0x07, 0xcb, 0x88, 0x82, 0x88, 0x85, 0x6a,
+	0x8c, 0x28, 0x97, 0x2f, 0x53, 0xa3, 0x41, 0x93, 0x9c, 0x70, 0x36, 0xcd, 0x34, 0x48, 0xe1, 0x03,
+	0xa4, 0x2a, 0xf7, 0x7c, 0x8a, 0x9c, 0x72, 0xc8, 0x31, 0xc9, 0x35, 0xf9, 0x12, 0xb9, 0xe6, 0x94,
+	0x4f, 0x90, 0x5b, 0xaa, 0x97, 0x59, 0x40, 0x5a, 0x22, 0xe5, 0xaa, 0x1c, 0x9c, 0x0b, 0xaa, 0xfb,
+	0xf5, 0x7b, 0xaf, 0xdf, 0xf6, 0x7b, 0xf3, 0xd0, 0xa0, 0xbe, 0x76, 0x7c, 0x37, 0x38, 0x9f, 0x5b,
+	0xd4, 0x6a, 0x87, 0x51, 0x40, 0x03, 0x04, 0x19, 0xe5, 0xa1, 0x72, 0x45, 0xa3, 0xd0, 0x16, 0x07,
+	0x0f, 0x95, 0x37, 0x0b, 0x12, 0x2d, 0xe5, 0xa6, 0x49, 0x83, 0x30, 0xc8, 0xa4, 0xb4, 0x31, 0xd4,
+	0xfa, 0x17, 0x56, 0x14, 0x13, 0x8a, 0x76, 0xa1, 0x6a, 0xbb, 0x0e, 0xf1, 0x69, 0xab, 0xb0, 0x57,
+	0xd8, 0xaf, 0x60, 0xb9, 0x43, 0x08, 0xca, 0x76, 0xe0, 0xfb, 0xad, 0x22, 0xa7, 0xf2, 0x35, 0xe3,
+	0x8d, 0x49, 0x74, 0x45, 0xa2, 0x56, 0x49, 0xf0, 0x8a, 0x9d, 0xf6, 0xcf, 0x12, 0x6c, 0xf6, 0xb8,
+	0x1d, 0x46, 0x64, 0xf9, 0xb1, 0x65, 0x53, 0x27, 0xf0, 0xd1, 0x11, 0x40, 0x4c, 0x2d, 0x4a, 0x3c,
+	0xe2, 0xd3, 0xb8, 0x55, 0xd8, 0x2b, 0xed, 0x2b, 0x9d, 0xc7, 0xed, 0x9c, 0x07, 0xb7, 0x44, 0xda,
+	0xb3, 0x84, 0x1f, 0xe7, 0x44, 0x51, 0x07, 0x14, 0x72, 0x45, 0x7c, 0x6a, 0xd2, 0xe0, 0x92, 0xf8,
+	0xad, 0xf2, 0x5e, 0x61, 0x5f, 0xe9, 0x6c, 0xb6, 0x85, 0x83, 0x3a, 0x3b, 0x31, 0xd8, 0x01, 0x06,
+	0x92, 0xae, 0x1f, 0xfe, 0xad, 0x08, 0x8d, 0x54, 0x1b, 0x1a, 0x41, 0xdd, 0xb6, 0x28, 0x39, 0x0f,
+	0xa2, 0x25, 0x77, 0xb3, 0xd9, 0xf9, 0xe2, 0x9e, 0x86, 0xb4, 0xfb, 0x52, 0x0e, 0xa7, 0x1a, 0xd0,
+	0x4f, 0xa1, 0x66, 0x8b, 0xe8, 0xf1, 0xe8, 0x28, 0x9d, 0xad, 0xbc, 0x32, 0x19, 0x58, 0x9c, 0xf0,
+	0x20, 0x15, 0x4a, 0xf1, 0x1b, 0x97, 0x87, 0x6c, 0x0d, 0xb3, 0xa5, 0xf6, 0x87, 0x02, 0xd4, 0x13,
+	0xbd, 0x68, 0x0b, 0x36, 0x7a, 0x23, 0xf3, 0xe5, 0x04, 0xeb, 0xfd, 0xe9, 0xd1, 0x64, 0xf8, 0x9d,
+	0x3e, 0x50, 0x1f, 0xa0, 0x35, 0xa8, 0xf7, 0x46, 0x66, 0x4f, 0x3f, 0x1a, 0x4e, 0xd4, 0x02, 0x5a,
+	0x87, 0x46, 0x6f, 0x64, 0xf6, 0xa7, 0xe3, 0xf1, 0xd0, 0x50, 0x8b, 0x68, 0x03, 0x94, 0xde, 0xc8,
+	0xc4, 0xd3, 0xd1, 0xa8, 0xd7, 0xed, 0x1f, 0xab, 0x25, 0xb4, 0x03, 0x9b, 0xbd, 0x91, 0x39, 0x18,
+	0x8f, 0xcc, 0x81, 0x7e, 0x82, 0xf5, 0x7e, 0xd7, 0xd0, 0x07, 0x6a, 0x19, 0x01, 0x54, 0x19, 0x79,
+	0x30, 0x52, 0x2b, 0x72, 0x3d, 0xd3, 0x0d, 0xb5, 0x2a, 0xd5, 0x0d, 0x27, 0x33, 0x1d, 0x1b, 0x6a,
+	0x4d, 0x6e, 0x5f, 0x9e, 0x0c, 0xba, 0x86, 0xae, 0xd6, 0xe5, 0x76, 0xa0, 0x8f, 0x74, 0x43, 0x57,
+	0x1b, 0xcf, 0xcb, 0xf5, 0xa2, 0x5a, 0x7a, 0x5e, 0xae, 0x97, 0xd4, 0xb2, 0xf6, 0xfb, 0x02, 0xec,
+	0xcc, 0x68, 0x44, 0x2c, 0xef, 0x98, 0x2c, 0xb1, 0xe5, 0x9f, 0x13, 0x4c, 0xde, 0x2c, 0x48, 0x4c,
+	0xd1, 0x43, 0xa8, 0x87, 0x41, 0xec, 0xb0, 0xd8, 0xf1, 0x00, 0x37, 0x70, 0xba, 0x47, 0x87, 0xd0,
+	0xb8, 0x24, 0x4b, 0x33, 0x62, 0xfc, 0x32, 0x60, 0xa8, 0x9d, 0x16, 0x64, 0xaa, 0xa9, 0x7e, 0x29,
+	0x57, 0xf9, 0xf8, 0x96, 0xee, 0x8e, 0xaf, 0x76, 0x06, 0xbb, 0x37, 0x8d, 0x8a, 0xc3, 0xc0, 0x8f,
+	0x09, 0x1a, 0x01, 0x12, 0x82, 0x26, 0xcd, 0x72, 0xcb, 0xed, 0x53, 0x3a, 0x1f, 0xbf, 0xb7, 0x00,
+	0xf0, 0xe6, 0xeb, 0x9b, 0x24, 0xed, 0x2d, 0x6c, 0x89, 0x7b, 0x0c, 0xeb, 0xb5, 0x4b, 0xe2, 0xfb,
+	0xb8, 0xbe, 0x0b, 0x55, 0xca, 0x99, 0x5b, 0xc5, 0xbd, 0xd2, 0x7e, 0x03, 0xcb, 0xdd, 0x87, 0x7a,
+	0x38, 0x87, 0xed, 0xd5, 0x9b, 0xff, 0x2b, 0xfe, 0xfd, 0x02, 0xca, 0x78, 0xe1, 0x12, 0xb4, 0x0d,
+	0x15, 0xcf, 0xa2, 0xf6, 0x85, 0xf4, 0x46, 0x6c, 0x98, 0x2b, 0x67, 0x8e, 0x4b, 0x49, 0xc4, 0x53,
+	0xd8, 0xc0, 0x72, 0xa7, 0xfd, 0xa9, 0x00, 0xd5, 0xa7, 0x7c, 0x89, 0x3e, 0x83, 0x4a, 0xb4, 0x70,
+	0x49, 0x82, 0x75, 0x35, 0x6f, 0x01, 0xd3, 0x8c, 0xc5, 0x31, 0x1a, 0x42, 0xf3, 0xcc, 0x21, 0xee,
+	0x9c, 0x43, 0x77, 0x1c, 0xcc, 0x45, 0x55, 0x34, 0x3b, 0x9f, 0xe6, 0x05, 0x84, 0xce, 0xf6, 0xd3,
+	0x15, 0x46, 0x7c, 0x43, 0x50, 0x7b, 0x02, 0xcd, 0x55, 0x0e, 0x06, 0x27, 0x1d, 0x63, 0x73, 0x3a,
+	0x31, 0xc7, 0xc3, 0xd9, 0xb8, 0x6b, 0xf4, 0x9f, 0xa9, 0x0f, 0x38, 0x62, 0xf4, 0x99, 0x61, 0xea,
+	0x4f, 0x9f, 0x4e, 0xb1, 0xa1, 0x16, 0xb4, 0x7f, 0x15, 0x61, 0x4d, 0x04, 0x65, 0x16, 0x2c, 0x22,
+	0x9b, 0xb0, 0x2c, 0x5e, 0x92, 0x65, 0x1c, 0x5a, 0x36, 0x49, 0xb2, 0x98, 0xec, 0x59, 0x40, 0xe2,
+	0x0b, 0x2b, 0x9a, 0x4b, 0xcf, 0xc5, 0x06, 0x7d, 0x09, 0x0a, 0xcf, 0x26, 0x35, 0xe9, 0x32, 0x24,
+	0x3c, 0x8f, 0xcd, 0xce, 0x76, 0x56, 0xd8, 0x3c, 0x57, 0xd4, 0x58, 0x86, 0x04, 0x03, 0x4d, 0xd7,
+	0xab, 0x68, 0x28, 0xdf, 0x03, 0x0d, 0x59, 0x0d, 0x55, 0x56, 0x6a, 0xe8, 0x20, 0x4d, 0x48, 0x55,
+	0x6a, 0xb9, 0x15, 0xbd, 0x24, 0x49, 0xa8, 0x0d, 0xd5, 0xc0, 0x37, 0xe7, 0x73, 0xb7, 0x55, 0xe3,
+	0x66, 0xfe, 0x5f, 0x9e, 0x77, 0xea, 0x0f, 0x06, 0xa3, 0xae, 0x28, 0x8b, 0x4a, 0xe0, 0x0f, 0xe6,
+	0x2e, 0x7a, 0x04, 0x4d, 0xf2, 0x96, 0x92, 0xc8, 0xb7, 0x5c, 0xd3, 0x5b, 0xb2, 0xee, 0x55, 0xe7,
+	0xae, 0xaf, 0x27, 0xd4, 0x31, 0x23, 0xa2, 0xcf, 0x60, 0x23, 0xa6, 0x41, 0x68, 0x5a, 0x67, 0x94,
+	0x44, 0xa6, 0x1d, 0x84, 0xcb, 0x56, 0x63, 0xaf, 0xb0, 0x5f, 0xc7, 0xeb, 0x8c, 0xdc, 0x65, 0xd4,
+	0x7e, 0x10, 0x2e, 0xb5, 0x17, 0xd0, 0xc0, 0xc1, 0x75, 0xff, 0x82, 0xfb, 0xa3, 0x41, 0xf5, 0x35,
+	0x39, 0x0b, 0x22, 0x22, 0x0b, 0x15, 0x64, 0x23, 0xc7, 0xc1, 0x35, 0x96, 0x27, 0x68, 0x0f, 0x2a,
+	0x5c, 0x67, 0xab, 0x78, 0x8b, 0x45, 0x1c, 0x68, 0x16, 0xd4, 0x71, 0x70, 0xcd, 0xd3, 0x8e, 0x3e,
+	0x06, 0x11, 0x60, 0xd3, 0xb7, 0xbc, 0x24, 0x7b, 0x0d, 0x4e, 0x99, 0x58, 0x1e, 0x41, 0x4f, 0x40,
+	0x89, 0x82, 0x6b, 0xd3, 0xe6, 0xd7, 0x0b, 0x24, 0x2a, 0x9d, 0x9d, 0x95, 0xe2, 0x4c, 0x8c, 0xc3,
+	0x10, 0x25, 0xcb, 0x58, 0x7b, 0x01, 0x90, 0xd5, 0xd6, 0x5d, 0x97, 0xfc, 0x84, 0x65, 0x83, 0xb8,
+	0xf3, 0x44, 0xff, 0x9a, 0x34, 0x99, 0x6b, 0xc0, 0xf2, 0x4c, 0xfb, 0x5d, 0x01, 0x1a, 0x33, 0x56,
+	0x3d, 0x47, 0xd4, 0x99, 0xff, 0x80, 0x9a, 0x43, 0x50, 0x3e, 0xa7, 0xce, 0x9c, 0x17, 0x5b, 0x03,
+	0xf3, 0x35, 0xfa, 0x32, 0x31, 0x2c, 0x34, 0x2f, 0xe3, 0x56, 0x99, 0xdf, 0xbe, 0x92, 0x5f, 0x5e,
+	0x88, 0x23, 0x2b, 0xa6, 0x27, 0xc7, 0xb8, 0xce, 0x59, 0x4f, 0x8e, 0x63, 0xed, 0x1b, 0xa8, 0x9c,
+	0x72, 0x2b, 0x9e, 0x80, 0xc2, 0x95, 0x9b, 0x4c, 0x5b, 0x82, 0xdd, 0x95, 0xf0, 0xa4, 0x16, 0x63,
+	0x88, 0x93, 0x65, 0xac, 0x75, 0x61, 0xfd, 0x58, 0x5a, 0xcb, 0x19, 0x3e, 0xdc, 0x1d, 0xed, 0x2f,
+	0x45, 0xa8, 0x3d, 0x0f, 0x16, 0xac, 0xa0, 0x50, 0x13, 0x8a, 0xce, 0x9c, 0xcb, 0x95, 0x70, 0xd1,
+	0x99, 0xa3, 0x5f, 0x43, 0xd3, 0x73, 0xce, 0x23, 0x8b, 0x95, 0xa5, 0x40, 0x98, 0x68, 0x12, 0xff,
+	0x9f, 0xb7, 0x6c, 0x9c, 0x70, 0x70, 0x98, 0xad, 0x7b, 0xf9, 0x6d, 0x0e, 0x38, 0xa5, 0x15, 0xe0,
+	0x3c, 0x82, 0xa6, 0x1b, 0xd8, 0x96, 0x6b, 0xa6, 0x6d, 0xbb, 0x2c, 0x8a, 0x9b, 0x53, 0x4f, 0x24,
+	0xf1, 0x66, 0x5c, 0x2a, 0xf7, 0x8c, 0x0b, 0xfa, 0x0a, 0xd6, 0x42, 0x2b, 0xa2, 0x8e, 0xed, 0x84,
+	0x16, 0x1b, 0x7c, 0xaa, 0x5c, 0x70, 0xc5, 0xec, 0x95, 0xb8, 0xe1, 0x15, 0x76, 0xf4, 0x39, 0xa8,
+	0x31, 0x6f, 0x49, 0xe6, 0x75, 0x10, 0x5d, 0x9e, 0xb9, 0xc1, 0x75, 0xdc, 0xaa, 0x71, 0xfb, 0x37,
+	0x04, 0xfd, 0x55, 0x42, 0xd6, 0xfe, 0x5c, 0x82, 0xea, 0xa9, 0xa8, 0xce, 0x03, 0x28, 0xf3, 0x18,
+	0x89, 0xe1, 0x66, 0x37, 0x7f, 0x99, 0xe0, 0xe0, 0x01, 0xe2, 0x3c, 0xe8, 0x23, 0x68, 0x50, 0xc7,
+	0x23, 0x31, 0xb5, 0xbc, 0x90, 0x07, 0xb5, 0x84, 0x33, 0xc2, 0xf7, 0x96, 0xd8, 0x47, 0xd0, 0x48,
+	0xc7, 0x31, 0x19, 0xac, 0x8c, 0x80, 0x7e, 0x06, 0x0d, 0x86, 0x2f, 0x3e, 0x7c, 0xb5, 0x2a, 0x1c,
+	0xb0, 0xdb, 0x37, 0xd0, 0xc5, 0x4d, 0xc0, 0xf5, 0x48, 0xae, 0xd0, 0x2f, 0x41, 0xe1, 0x88, 0x90,
+	0x42, 0xa2, 0x81, 0xed, 0xae, 0x36, 0xb0, 0x04, 0x79, 0x18, 0xb2, 0x9e, 0x8f, 0x1e, 0x43, 0xe5,
+	0x8a, 0x9b, 0x57, 0x93, 0x43, 0x60, 0xde, 0x51, 0x9e, 0x0a, 0x71, 0xce, 0xbe, 0xb0, 0xbf, 0x11,
+	0x95, 0xd5, 0xaa, 0xdf, 0xfe, 0xc2, 0xca, 0xa2, 0xc3, 0x09, 0x0f, 0x9b, 0xd1, 0xe6, 0x9e, 0xcb,
+	0xbb, 0x57, 0x03, 0xb3, 0x25, 0xfa, 0x14, 0xd6, 0xec, 0x45, 0x14, 0xf1, 0xb1, 0xd3, 0xf1, 0x48,
+	0x6b, 0x9b, 0x07, 0x4a, 0x91, 0x34, 0xc3, 0xf1, 0x08, 0xfa, 0x15, 0x34, 0x5d, 0x2b, 0xa6, 0x0c,
+	0x78, 0xd2, 0x91, 0x9d, 0xbd, 0xc2, 0x4d, 0xf4, 0x09, 0xe0, 0x09, 0x4f, 0x14, 0x37, 0xdb, 0x68,
+	0x17, 0xb0, 0x36, 0x76, 0x7c, 0xc7, 0xb3, 0x5c, 0x0e, 0x50, 0x16, 0xf8, 0x5c, 0x6b, 0x29, 0xfb,
+	0xf7, 0xee, 0x2a, 0xe8, 0x13, 0x50, 0x98, 0x09, 0x76, 0xe0, 0x2e, 0x3c, 0x5f, 0x54, 0x7b, 0x09,
+	0x37, 0xc2, 0xe3, 0xbe, 0x20, 0x30, 0xa4, 0xca, 0x9b, 0x66, 0xf6, 0x05, 0xf1, 0x2c, 0xf4, 0x45,
+	0x8a, 0x0c, 0x81, 0xf6, 0xd6, 0x2a, 0xa6, 0x32, 0xa3, 0x12, 0xcc, 0x68, 0x7f, 0x2f, 0x42, 0xf3,
+	0x54, 0xcc, 0x20, 0xc9, 0xdc, 0xf3, 0x0d, 0x6c, 0x91, 0xb3, 0x33, 0x62, 0x53, 0xe7, 0x8a, 0x98,
+	0xb6, 0xe5, 0xba, 0x24, 0x32, 0x25, 0x82, 0x95, 0xce, 0x46, 0x5b, 0xfc, 0x17, 0xe9, 0x73, 0xfa,
+	0x70, 0x80, 0x37, 0x53, 0x5e, 0x49, 0x9a, 0x23, 0x1d, 0xb6, 0x1c, 0xcf, 0x23, 0x73, 0xc7, 0xa2,
+	0x79, 0x05, 0xa2, 0xe5, 0xef, 0x48, 0x4f, 0x4f, 0x8d, 0x23, 0x8b, 0x92, 0x4c, 0x4d, 0x2a, 0x91,
+	0xaa, 0x79, 0xc4, 0x9c, 0x89, 0xce, 0xd3, 0x51, 0x6a, 0x5d, 0x4a, 0x1a, 0x9c, 0x88, 0xe5, 0xe1,
+	0xca, 0x98, 0x56, 0xbe, 0x31, 0xa6, 0x65, 0x9f, 0xd2, 0xca, 0x9d, 0x9f, 0xd2, 0xaf, 0x61, 0x43,
+	0xb4, 0xdb, 0x24, 0xf5, 0x09, 0xc2, 0xdf, 0xd9, 0x73, 0xd7, 0x68, 0xb6, 0x89, 0xb5, 0xaf, 0x60,
+	0x23, 0x0d, 0xa4, 0x1c, 0xe3, 0x0e, 0xa0, 0xca, 0xcb, 0x27, 0x49, 0x07, 0xba, 0x0d, 0x5f, 0x2c,
+	0x39, 0xb4, 0xdf, 0x16, 0x01, 0x25, 0xf2, 0xc1, 0x75, 0xfc, 0x23, 0x4d, 0xc6, 0x36, 0x54, 0x38,
+	0x5d, 0x66, 0x42, 0x6c, 0x58, 0x1c, 0x58, 0x50, 0xc3, 0xcb, 0x34, 0x0d, 0x42, 0xf8, 0x05, 0xfb,
+	0xc5, 0x24, 0x5e, 0xb8, 0x14, 0x4b, 0x0e, 0xed, 0xaf, 0x05, 0xd8, 0x5a, 0x89, 0x83, 0x8c, 0x65,
+	0x86, 0x98, 0xc2, 0x7b, 0x10, 0xb3, 0x0f, 0xf5, 0xf0, 0xf2, 0x3d, 0xc8, 0x4a, 0x4f, 0xbf, 0xb7,
+	0x1d, 0x7e, 0x02, 0xe5, 0x28, 0xb8, 0x4e, 0xbe, 0xb5, 0xf9, 0xe1, 0x84, 0xd3, 0xd9, 0x84, 0xb3,
+	0xe2, 0x47, 0x9e, 0x23, 0xb1, 0xdf, 0x01, 0x25, 0xd7, 0x19, 0x58, 0x2b, 0x59, 0xad, 0x2a, 0x99,
+	0xba, 0x77, 0x16, 0x95, 0x92, 0x2b, 0x2a, 0xd6, 0x9f, 0xed, 0xc0, 0x0b, 0x5d, 0x42, 0x89, 0x48,
+	0x59, 0x1d, 0x67, 0x04, 0xed, 0x5b, 0x50, 0x72, 0x92, 0x77, 0x0d, 0x32, 0x59, 0x12, 0x4a, 0x77,
+	0x26, 0xe1, 0x1f, 0x05, 0xd8, 0xc9, 0x8a, 0x79, 0xe1, 0xd2, 0xff, 0xa9, 0x7a, 0xd4, 0x22, 0xd8,
+	0xbd, 0xe9, 0xdd, 0x07, 0x55, 0xd9, 0x0f, 0xa8, 0x1d, 0xed, 0x8f, 0x45, 0x40, 0xfa, 0xdb, 0x30,
+	0x88, 0xa8, 0x68, 0xc0, 0x3f, 0xda, 0x78, 0xf2, 0x8a, 0x4a, 0xe2, 0xc9, 0x37, 0x1f, 0x82, 0x6f,
+	0xb4, 0x0f, 0x15, 0xe2, 0xcf, 0xc3, 0xcb, 0x56, 0xf5, 0x9d, 0xac, 0x82, 0xe1, 0xe0, 0x6b, 0x50,
+	0x72, 0xff, 0x60, 0xd8, 0x43, 0xc7, 0xf0, 0x68, 0x32, 0xc5, 0xba, 0xfa, 0x00, 0xd5, 0xa1, 0x3c,
+	0x33, 0xa6, 0x27, 0x6a, 0x81, 0xad, 0xf4, 0x6f, 0xf5, 0xbe, 0x78, 0x3c, 0x61, 0x2b, 0x53, 0x32,
+	0x95, 0x0e, 0xfe, 0x5d, 0x00, 0xc8, 0x66, 0x24, 0xa4, 0x40, 0xed, 0xe5, 0xe4, 0x78, 0x32, 0x7d,
+	0x35, 0x11, 0x0a, 0x8e, 0x8c, 0xe1, 0x40, 0x2d, 0xa0, 0x06, 0x54, 0xc4, 0x6b, 0x4c, 0x91, 0xdd,
+	0x20, 0x9f, 0x62, 0x4a, 0xec, 0x9d, 0x26, 0x7d, 0x87, 0x29, 0xa3, 0x1a, 0x94, 0xd2, 0xd7, 0x16,
+	0xf9, 0xbc, 0x52, 0x65, 0x0a, 0xb1, 0x7e, 0x32, 0xea, 0xf6, 0x75, 0xb5, 0xc6, 0x0e, 0xd2, 0x87,
+	0x16, 0x80, 0x6a, 0xf2, 0xca, 0xc2, 0x24, 0xd9, 0xdb, 0x0c, 0xb0, 0x7b, 0xa6, 0xc6, 0x33, 0x1d,
+	0xab, 0x0a, 0xa3, 0xe1, 0xe9, 0x2b, 0x75, 0x8d, 0xd1, 0x9e, 0x0e, 0xf5, 0xd1, 0x40, 0x5d, 0x67,
+	0x8f, 0x33, 0xcf, 0xf4, 0x2e, 0x36, 0x7a, 0x7a, 0xd7, 0x50, 0x9b, 0xec, 0xe4, 0x94, 0x1b, 0xb8,
+	0xc1, 0xae, 0x79, 0x3e, 0x7d, 0x89, 0x27, 0xdd, 0x91, 0xaa, 0xb2, 0xcd, 0xa9, 0x8e, 0x67, 0xc3,
+	0xe9, 0x44, 0xdd, 0x64, 0xf7, 0x8c, 0xba, 0x33, 0xe3, 0xe4, 0x58, 0x45, 0x4c, 0x7e, 0xd6, 0x3d,
+	0xd5, 0x4f, 0xa6, 0xc3, 0x89, 0xa1, 0x6e, 0x1d, 0x3c, 0x66, 0x93, 0x41, 0x7e, 0x66, 0x06, 0xa8,
+	0x1a, 0xdd, 0xde, 0x48, 0x9f, 0xa9, 0x0f, 0xd8, 0x7a, 0xf6, 0xac, 0x8b, 0x07, 0x33, 0xb5, 0xd0,
+	0xfb, 0xfc, 0xbb, 0xc7, 0x57, 0x0e, 0x25, 0x71, 0xdc, 0x76, 0x82, 0x43, 0xb1, 0x3a, 0x3c, 0x0f,
+	0x0e, 0xaf, 0xe8, 0x21, 0x7f, 0x50, 0x3c, 0xcc, 0xba, 0xd4, 0xeb, 0x2a, 0xa7, 0xfc, 0xfc, 0x3f,
+	0x03, 0x00, 0x9e, 0xf9, 0x6f, 0xa6, 0xac, 0x14, 0x00, 0x00,
 }
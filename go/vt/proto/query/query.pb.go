@@ -359,6 +359,35 @@ func (TransactionState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{3}
 }
 
+// ResultFormat is the format of the results of the streaming queries.
+type ResultFormat int32
+
+const (
+	// ROWS streams the results as QueryResults.
+	ResultFormat_ROWS ResultFormat = 0
+	// ARROW streams the results as an Apache Arrow IPC stream, split
+	// across the responses.
+	ResultFormat_ARROW ResultFormat = 1
+)
+
+var ResultFormat_name = map[int32]string{
+	0: "ROWS",
+	1: "ARROW",
+}
+
+var ResultFormat_value = map[string]int32{
+	"ROWS":  0,
+	"ARROW": 1,
+}
+
+func (x ResultFormat) String() string {
+	return proto.EnumName(ResultFormat_name, int32(x))
+}
+
+func (ResultFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{4}
+}
+
 type ExecuteOptions_IncludedFields int32
 
 const (
@@ -1729,15 +1758,17 @@ func (m *ExecuteBatchResponse) GetResults() []*QueryResult {
 
 // StreamExecuteRequest is the payload to StreamExecute
 type StreamExecuteRequest struct {
-	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
-	ImmediateCallerId    *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
-	Target               *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Query                *BoundQuery     `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
-	Options              *ExecuteOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
-	TransactionId        int64           `protobuf:"varint,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Query             *BoundQuery     `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Options           *ExecuteOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	TransactionId     int64           `protobuf:"varint,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// result_format is the format of the results, ROWS by default.
+	ResultFormat         ResultFormat `protobuf:"varint,7,opt,name=result_format,json=resultFormat,proto3,enum=query.ResultFormat" json:"result_format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *StreamExecuteRequest) Reset()         { *m = StreamExecuteRequest{} }
@@ -1807,12 +1838,22 @@ func (m *StreamExecuteRequest) GetTransactionId() int64 {
 	return 0
 }
 
+func (m *StreamExecuteRequest) GetResultFormat() ResultFormat {
+	if m != nil {
+		return m.ResultFormat
+	}
+	return ResultFormat_ROWS
+}
+
 // StreamExecuteResponse is the returned value from StreamExecute
 type StreamExecuteResponse struct {
-	Result               *QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Result *QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// arrow is the next part of the Arrow IPC stream of the results,
+	// when the request asked for the ARROW format.
+	Arrow                []byte   `protobuf:"bytes,2,opt,name=arrow,proto3" json:"arrow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamExecuteResponse) Reset()         { *m = StreamExecuteResponse{} }
@@ -1847,6 +1888,13 @@ func (m *StreamExecuteResponse) GetResult() *QueryResult {
 	return nil
 }
 
+func (m *StreamExecuteResponse) GetArrow() []byte {
+	if m != nil {
+		return m.Arrow
+	}
+	return nil
+}
+
 // BeginRequest is the payload to Begin
 type BeginRequest struct {
 	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
@@ -3982,7 +4030,13 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// transaction_pool_utilization is the fraction (0 to 1) of the
+	// transaction pool connections currently in use.
+	TransactionPoolUtilization float64 `protobuf:"fixed64,7,opt,name=transaction_pool_utilization,json=transactionPoolUtilization,proto3" json:"transaction_pool_utilization,omitempty"`
+	// cell_drain_percent is the drain percentage of the cell of the
+	// tablet (see CellInfo.drain_percent).
+	CellDrainPercent     int32    `protobuf:"varint,8,opt,name=cell_drain_percent,json=cellDrainPercent,proto3" json:"cell_drain_percent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4055,6 +4109,20 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTransactionPoolUtilization() float64 {
+	if m != nil {
+		return m.TransactionPoolUtilization
+	}
+	return 0
+}
+
+func (m *RealtimeStats) GetCellDrainPercent() int32 {
+	if m != nil {
+		return m.CellDrainPercent
+	}
+	return 0
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4177,10 +4245,17 @@ type StreamHealthResponse struct {
 	// code uses it to verify that it's talking to the correct tablet and that it
 	// hasn't changed in the meantime e.g. due to tablet restarts where ports or
 	// ips have been reused but assigned differently.
-	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,5,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,5,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	// query_service_version is the version of the query service API of
+	// the tablet. It is 0 for the tablets older than the negotiation.
+	QueryServiceVersion int32 `protobuf:"varint,7,opt,name=query_service_version,json=queryServiceVersion,proto3" json:"query_service_version,omitempty"`
+	// capabilities are the optional features of the query service of the
+	// tablet, like the bind variable types it understands. The vtgates do
+	// not send to a tablet the requests using features it does not list.
+	Capabilities         []string `protobuf:"bytes,8,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamHealthResponse) Reset()         { *m = StreamHealthResponse{} }
@@ -4243,6 +4318,20 @@ func (m *StreamHealthResponse) GetTabletAlias() *topodata.TabletAlias {
 	return nil
 }
 
+func (m *StreamHealthResponse) GetQueryServiceVersion() int32 {
+	if m != nil {
+		return m.QueryServiceVersion
+	}
+	return 0
+}
+
+func (m *StreamHealthResponse) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 // TransactionMetadata contains the metadata for a distributed transaction.
 type TransactionMetadata struct {
 	Dtid                 string           `protobuf:"bytes,1,opt,name=dtid,proto3" json:"dtid,omitempty"`
@@ -4312,6 +4401,7 @@ func init() {
 	proto.RegisterEnum("query.Flag", Flag_name, Flag_value)
 	proto.RegisterEnum("query.Type", Type_name, Type_value)
 	proto.RegisterEnum("query.TransactionState", TransactionState_name, TransactionState_value)
+	proto.RegisterEnum("query.ResultFormat", ResultFormat_name, ResultFormat_value)
 	proto.RegisterEnum("query.ExecuteOptions_IncludedFields", ExecuteOptions_IncludedFields_name, ExecuteOptions_IncludedFields_value)
 	proto.RegisterEnum("query.ExecuteOptions_Workload", ExecuteOptions_Workload_name, ExecuteOptions_Workload_value)
 	proto.RegisterEnum("query.ExecuteOptions_TransactionIsolation", ExecuteOptions_TransactionIsolation_name, ExecuteOptions_TransactionIsolation_value)
@@ -4384,211 +4474,219 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3283 bytes of a gzipped FileDescriptorProto
+	// 3423 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4b, 0x90, 0x1b, 0x49,
-	0x5a, 0x76, 0x95, 0x1e, 0x2d, 0xfd, 0x6a, 0xa9, 0xb3, 0xb3, 0xbb, 0x6d, 0x4d, 0x7b, 0x1e, 0xbd,
-	0xb5, 0x3b, 0xbb, 0xc6, 0x40, 0xdb, 0xd3, 0xf6, 0x18, 0x33, 0xbb, 0xc0, 0x54, 0xab, 0xab, 0x7b,
-	0x64, 0x4b, 0x25, 0x39, 0x55, 0x6a, 0xaf, 0x27, 0x88, 0xa8, 0xa8, 0x96, 0xd2, 0xea, 0x8a, 0x2e,
-	0x55, 0xa9, 0xab, 0xaa, 0xdb, 0xa3, 0x9b, 0x61, 0x59, 0x96, 0x37, 0xcb, 0x9b, 0x65, 0x83, 0x0d,
-	0x22, 0x38, 0x10, 0x5c, 0x88, 0xe0, 0xc6, 0x99, 0xc3, 0x1c, 0x38, 0x10, 0xc1, 0x11, 0x38, 0x00,
-	0x07, 0x02, 0x4e, 0x1b, 0x04, 0x07, 0x0e, 0x1c, 0x08, 0x22, 0x1f, 0x55, 0x92, 0xba, 0x35, 0x76,
-	0xaf, 0x97, 0x09, 0xc2, 0x9e, 0xb9, 0xe5, 0xff, 0xc8, 0xc7, 0xff, 0xe5, 0x9f, 0xff, 0xff, 0x57,
-	0x2a, 0x05, 0xa5, 0xe3, 0x13, 0x1a, 0x8e, 0x37, 0x47, 0x61, 0x10, 0x07, 0x38, 0xc7, 0x89, 0xf5,
-	0x4a, 0x1c, 0x8c, 0x82, 0xbe, 0x13, 0x3b, 0x82, 0xbd, 0x5e, 0x3a, 0x8d, 0xc3, 0x51, 0x4f, 0x10,
-	0xda, 0x37, 0x15, 0xc8, 0x5b, 0x4e, 0x38, 0xa0, 0x31, 0x5e, 0x87, 0xc2, 0x11, 0x1d, 0x47, 0x23,
-	0xa7, 0x47, 0xab, 0xca, 0x86, 0x72, 0xad, 0x48, 0x52, 0x1a, 0xaf, 0x42, 0x2e, 0x3a, 0x74, 0xc2,
-	0x7e, 0x55, 0xe5, 0x02, 0x41, 0xe0, 0x77, 0xa1, 0x14, 0x3b, 0x07, 0x1e, 0x8d, 0xed, 0x78, 0x3c,
-	0xa2, 0xd5, 0xcc, 0x86, 0x72, 0xad, 0xb2, 0xb5, 0xba, 0x99, 0xce, 0x67, 0x71, 0xa1, 0x35, 0x1e,
-	0x51, 0x02, 0x71, 0xda, 0xc6, 0x18, 0xb2, 0x3d, 0xea, 0x79, 0xd5, 0x2c, 0x1f, 0x8b, 0xb7, 0xb5,
-	0x1d, 0xa8, 0xec, 0x5b, 0x7b, 0x4e, 0x4c, 0x6b, 0x8e, 0xe7, 0xd1, 0xb0, 0xbe, 0xc3, 0x96, 0x73,
-	0x12, 0xd1, 0xd0, 0x77, 0x86, 0xe9, 0x72, 0x12, 0x1a, 0x5f, 0x86, 0xfc, 0x20, 0x0c, 0x4e, 0x46,
-	0x51, 0x55, 0xdd, 0xc8, 0x5c, 0x2b, 0x12, 0x49, 0x69, 0x3f, 0x0b, 0x60, 0x9c, 0x52, 0x3f, 0xb6,
-	0x82, 0x23, 0xea, 0xe3, 0xd7, 0xa1, 0x18, 0xbb, 0x43, 0x1a, 0xc5, 0xce, 0x70, 0xc4, 0x87, 0xc8,
-	0x90, 0x09, 0xe3, 0x13, 0x4c, 0x5a, 0x87, 0xc2, 0x28, 0x88, 0xdc, 0xd8, 0x0d, 0x7c, 0x6e, 0x4f,
-	0x91, 0xa4, 0xb4, 0xf6, 0xd3, 0x90, 0xdb, 0x77, 0xbc, 0x13, 0x8a, 0xdf, 0x82, 0x2c, 0x37, 0x58,
-	0xe1, 0x06, 0x97, 0x36, 0x05, 0xe8, 0xdc, 0x4e, 0x2e, 0x60, 0x63, 0x9f, 0x32, 0x4d, 0x3e, 0xf6,
-	0x22, 0x11, 0x84, 0x76, 0x04, 0x8b, 0xdb, 0xae, 0xdf, 0xdf, 0x77, 0x42, 0x97, 0x81, 0xf1, 0x82,
-	0xc3, 0xe0, 0x2f, 0x41, 0x9e, 0x37, 0xa2, 0x6a, 0x66, 0x23, 0x73, 0xad, 0xb4, 0xb5, 0x28, 0x3b,
-	0xf2, 0xb5, 0x11, 0x29, 0xd3, 0xfe, 0x5a, 0x01, 0xd8, 0x0e, 0x4e, 0xfc, 0xfe, 0x03, 0x26, 0xc4,
-	0x08, 0x32, 0xd1, 0xb1, 0x27, 0x81, 0x64, 0x4d, 0x7c, 0x1f, 0x2a, 0x07, 0xae, 0xdf, 0xb7, 0x4f,
-	0xe5, 0x72, 0x04, 0x96, 0xa5, 0xad, 0x2f, 0xc9, 0xe1, 0x26, 0x9d, 0x37, 0xa7, 0x57, 0x1d, 0x19,
-	0x7e, 0x1c, 0x8e, 0x49, 0xf9, 0x60, 0x9a, 0xb7, 0xde, 0x05, 0x7c, 0x5e, 0x89, 0x4d, 0x7a, 0x44,
-	0xc7, 0xc9, 0xa4, 0x47, 0x74, 0x8c, 0x7f, 0x64, 0xda, 0xa2, 0xd2, 0xd6, 0x4a, 0x32, 0xd7, 0x54,
-	0x5f, 0x69, 0xe6, 0x7b, 0xea, 0x5d, 0x45, 0xfb, 0xcb, 0x05, 0xa8, 0x18, 0x1f, 0xd1, 0xde, 0x49,
-	0x4c, 0x5b, 0x23, 0xb6, 0x07, 0x11, 0x6e, 0xc2, 0x92, 0xeb, 0xf7, 0xbc, 0x93, 0x3e, 0xed, 0xdb,
-	0x8f, 0x5d, 0xea, 0xf5, 0x23, 0xee, 0x47, 0x95, 0x74, 0xdd, 0xb3, 0xfa, 0x9b, 0x75, 0xa9, 0xbc,
-	0xcb, 0x75, 0x49, 0xc5, 0x9d, 0xa1, 0xf1, 0x75, 0x58, 0xee, 0x79, 0x2e, 0xf5, 0x63, 0xfb, 0x31,
-	0xb3, 0xd7, 0x0e, 0x83, 0x27, 0x51, 0x35, 0xb7, 0xa1, 0x5c, 0x2b, 0x90, 0x25, 0x21, 0xd8, 0x65,
-	0x7c, 0x12, 0x3c, 0x89, 0xf0, 0x7b, 0x50, 0x78, 0x12, 0x84, 0x47, 0x5e, 0xe0, 0xf4, 0xab, 0x79,
-	0x3e, 0xe7, 0x9b, 0xf3, 0xe7, 0x7c, 0x28, 0xb5, 0x48, 0xaa, 0x8f, 0xaf, 0x01, 0x8a, 0x8e, 0x3d,
-	0x3b, 0xa2, 0x1e, 0xed, 0xc5, 0xb6, 0xe7, 0x0e, 0xdd, 0xb8, 0x5a, 0xe0, 0x2e, 0x59, 0x89, 0x8e,
-	0xbd, 0x0e, 0x67, 0x37, 0x18, 0x17, 0xdb, 0xb0, 0x16, 0x87, 0x8e, 0x1f, 0x39, 0x3d, 0x36, 0x98,
-	0xed, 0x46, 0x81, 0xe7, 0x70, 0x77, 0x2c, 0xf2, 0x29, 0xaf, 0xcf, 0x9f, 0xd2, 0x9a, 0x74, 0xa9,
-	0x27, 0x3d, 0xc8, 0x6a, 0x3c, 0x87, 0x8b, 0xdf, 0x81, 0xb5, 0xe8, 0xc8, 0x1d, 0xd9, 0x7c, 0x1c,
-	0x7b, 0xe4, 0x39, 0xbe, 0xdd, 0x73, 0x7a, 0x87, 0xb4, 0x0a, 0xdc, 0x6c, 0xcc, 0x84, 0x7c, 0xdf,
-	0xdb, 0x9e, 0xe3, 0xd7, 0x98, 0x84, 0x81, 0xce, 0xf4, 0x7c, 0x1a, 0xda, 0xa7, 0x34, 0x8c, 0xd8,
-	0x6a, 0x4a, 0xcf, 0x02, 0xbd, 0x2d, 0x94, 0xf7, 0x85, 0x2e, 0xa9, 0x8c, 0x66, 0x68, 0xfc, 0x2e,
-	0x5c, 0x39, 0x74, 0x22, 0xbb, 0x17, 0x52, 0x27, 0xa6, 0x7d, 0x3b, 0xa6, 0xc3, 0x91, 0x1d, 0x0b,
-	0x1f, 0x5c, 0xe4, 0x6b, 0x58, 0x3d, 0x74, 0xa2, 0x9a, 0x90, 0x5a, 0x74, 0x38, 0xe2, 0x71, 0x24,
-	0xd2, 0xbe, 0x0a, 0x95, 0xd9, 0xdd, 0xc4, 0xcb, 0x50, 0xb6, 0x1e, 0xb5, 0x0d, 0x5b, 0x37, 0x77,
-	0x6c, 0x53, 0x6f, 0x1a, 0xe8, 0x12, 0x2e, 0x43, 0x91, 0xb3, 0x5a, 0x66, 0xe3, 0x11, 0x52, 0xf0,
-	0x02, 0x64, 0xf4, 0x46, 0x03, 0xa9, 0xda, 0x5d, 0x28, 0x24, 0xdb, 0x82, 0x97, 0xa0, 0xd4, 0x35,
-	0x3b, 0x6d, 0xa3, 0x56, 0xdf, 0xad, 0x1b, 0x3b, 0xe8, 0x12, 0x2e, 0x40, 0xb6, 0xd5, 0xb0, 0xda,
-	0x48, 0x11, 0x2d, 0xbd, 0x8d, 0x54, 0xd6, 0x73, 0x67, 0x5b, 0x47, 0x19, 0xed, 0xcf, 0x14, 0x58,
-	0x9d, 0x07, 0x2f, 0x2e, 0xc1, 0xc2, 0x8e, 0xb1, 0xab, 0x77, 0x1b, 0x16, 0xba, 0x84, 0x57, 0x60,
-	0x89, 0x18, 0x6d, 0x43, 0xb7, 0xf4, 0xed, 0x86, 0x61, 0x13, 0x43, 0xdf, 0x41, 0x0a, 0xc6, 0x50,
-	0x61, 0x2d, 0xbb, 0xd6, 0x6a, 0x36, 0xeb, 0x96, 0x65, 0xec, 0x20, 0x15, 0xaf, 0x02, 0xe2, 0xbc,
-	0xae, 0x39, 0xe1, 0x66, 0x30, 0x82, 0xc5, 0x8e, 0x41, 0xea, 0x7a, 0xa3, 0xfe, 0x21, 0x1b, 0x00,
-	0x65, 0xf1, 0x17, 0xe0, 0x8d, 0x5a, 0xcb, 0xec, 0xd4, 0x3b, 0x96, 0x61, 0x5a, 0x76, 0xc7, 0xd4,
-	0xdb, 0x9d, 0x0f, 0x5a, 0x16, 0x1f, 0x59, 0x18, 0x97, 0xc3, 0x15, 0x00, 0xbd, 0x6b, 0xb5, 0xc4,
-	0x38, 0x28, 0xaf, 0x1d, 0x43, 0x65, 0x16, 0x79, 0xb6, 0x2a, 0xb9, 0x44, 0xbb, 0xdd, 0xd0, 0x4d,
-	0xd3, 0x20, 0xe8, 0x12, 0xce, 0x83, 0xba, 0x7f, 0x4b, 0xd8, 0xba, 0x47, 0xfd, 0xdb, 0x48, 0x65,
-	0x03, 0xb1, 0xd6, 0x5e, 0x48, 0x69, 0x7f, 0x8c, 0x32, 0x6c, 0xdd, 0x8c, 0x6e, 0xd0, 0xc7, 0xf1,
-	0x16, 0x71, 0x07, 0x87, 0x31, 0xca, 0xb2, 0x75, 0x33, 0xde, 0x43, 0x37, 0x3e, 0xdc, 0x75, 0x3c,
-	0xef, 0xc0, 0xe9, 0x1d, 0xa1, 0xdc, 0xbd, 0x6c, 0x41, 0x41, 0xea, 0xbd, 0x6c, 0x41, 0x45, 0x99,
-	0x7b, 0xd9, 0x42, 0x06, 0x65, 0xb5, 0xbf, 0x52, 0x21, 0xc7, 0xb7, 0x87, 0xc5, 0xf9, 0xa9, 0xe8,
-	0xcd, 0xdb, 0x69, 0xcc, 0x53, 0x9f, 0x11, 0xf3, 0xb8, 0x2b, 0xc8, 0xe8, 0x2b, 0x08, 0x7c, 0x15,
-	0x8a, 0x41, 0x38, 0x10, 0x4e, 0x22, 0xf3, 0x46, 0x21, 0x08, 0x07, 0xdc, 0x31, 0x58, 0xcc, 0x66,
-	0xe9, 0xe6, 0xc0, 0x89, 0x28, 0x3f, 0xba, 0x45, 0x92, 0xd2, 0xf8, 0x35, 0x60, 0x7a, 0x36, 0x5f,
-	0x47, 0x9e, 0xcb, 0x16, 0x82, 0x70, 0x60, 0xb2, 0xa5, 0x7c, 0x11, 0xca, 0xbd, 0xc0, 0x3b, 0x19,
-	0xfa, 0xb6, 0x47, 0xfd, 0x41, 0x7c, 0x58, 0x5d, 0xd8, 0x50, 0xae, 0x95, 0xc9, 0xa2, 0x60, 0x36,
-	0x38, 0x0f, 0x57, 0x61, 0xa1, 0x77, 0xe8, 0x84, 0x11, 0x15, 0xc7, 0xb5, 0x4c, 0x12, 0x92, 0xcf,
-	0x4a, 0x7b, 0xee, 0xd0, 0xf1, 0x22, 0x7e, 0x34, 0xcb, 0x24, 0xa5, 0x99, 0x11, 0x8f, 0x3d, 0x67,
-	0x10, 0xf1, 0x23, 0x55, 0x26, 0x82, 0xc0, 0x6f, 0x41, 0x49, 0x4e, 0xc8, 0x21, 0x28, 0xf1, 0xe5,
-	0x80, 0x60, 0x31, 0x04, 0xb4, 0x9f, 0x80, 0x0c, 0x09, 0x9e, 0xb0, 0x39, 0xc5, 0x8a, 0xa2, 0xaa,
-	0xb2, 0x91, 0xb9, 0x86, 0x49, 0x42, 0xb2, 0xbc, 0x27, 0x43, 0xbf, 0xc8, 0x08, 0x49, 0xb0, 0xff,
-	0xae, 0x02, 0x25, 0x7e, 0x64, 0x09, 0x8d, 0x4e, 0xbc, 0x98, 0xa5, 0x08, 0x19, 0x1b, 0x95, 0x99,
-	0x14, 0xc1, 0xf7, 0x85, 0x48, 0x19, 0x03, 0x80, 0x85, 0x3b, 0xdb, 0x79, 0xfc, 0x98, 0xf6, 0x62,
-	0x2a, 0x32, 0x61, 0x96, 0x2c, 0x32, 0xa6, 0x2e, 0x79, 0x0c, 0x79, 0xd7, 0x8f, 0x68, 0x18, 0xdb,
-	0x6e, 0x9f, 0xef, 0x49, 0x96, 0x14, 0x04, 0xa3, 0xde, 0xc7, 0x6f, 0x42, 0x96, 0x07, 0xcc, 0x2c,
-	0x9f, 0x05, 0xe4, 0x2c, 0x24, 0x78, 0x42, 0x38, 0xff, 0x5e, 0xb6, 0x90, 0x43, 0x79, 0xed, 0x6b,
-	0xb0, 0xc8, 0x17, 0xf7, 0xd0, 0x09, 0x7d, 0xd7, 0x1f, 0xf0, 0xfc, 0x1f, 0xf4, 0x85, 0x5f, 0x94,
-	0x09, 0x6f, 0x33, 0x9b, 0x87, 0x34, 0x8a, 0x9c, 0x01, 0x95, 0xf9, 0x38, 0x21, 0xb5, 0x3f, 0xc9,
-	0x40, 0xa9, 0x13, 0x87, 0xd4, 0x19, 0xf2, 0xd4, 0x8e, 0xbf, 0x06, 0x10, 0xc5, 0x4e, 0x4c, 0x87,
-	0xd4, 0x8f, 0x13, 0xfb, 0x5e, 0x97, 0x33, 0x4f, 0xe9, 0x6d, 0x76, 0x12, 0x25, 0x32, 0xa5, 0x8f,
-	0xb7, 0xa0, 0x44, 0x99, 0xd8, 0x8e, 0x59, 0x89, 0x20, 0xd3, 0xd0, 0x72, 0x12, 0xc5, 0xd2, 0xda,
-	0x81, 0x00, 0x4d, 0xdb, 0xeb, 0xdf, 0x53, 0xa1, 0x98, 0x8e, 0x86, 0x75, 0x28, 0xf4, 0x9c, 0x98,
-	0x0e, 0x82, 0x70, 0x2c, 0x33, 0xf7, 0xdb, 0xcf, 0x9a, 0x7d, 0xb3, 0x26, 0x95, 0x49, 0xda, 0x0d,
-	0xbf, 0x01, 0xa2, 0x1c, 0x12, 0x6e, 0x29, 0xec, 0x2d, 0x72, 0x0e, 0x77, 0xcc, 0xf7, 0x00, 0x8f,
-	0x42, 0x77, 0xe8, 0x84, 0x63, 0xfb, 0x88, 0x8e, 0x93, 0x2c, 0x97, 0x99, 0xb3, 0x93, 0x48, 0xea,
-	0xdd, 0xa7, 0x63, 0x19, 0x11, 0xef, 0xce, 0xf6, 0x95, 0xde, 0x72, 0x7e, 0x7f, 0xa6, 0x7a, 0xf2,
-	0xba, 0x21, 0x4a, 0x2a, 0x84, 0x1c, 0x77, 0x2c, 0xd6, 0xd4, 0xbe, 0x02, 0x85, 0x64, 0xf1, 0xb8,
-	0x08, 0x39, 0x23, 0x0c, 0x83, 0x10, 0x5d, 0xe2, 0x81, 0xb1, 0xd9, 0x10, 0xb1, 0x75, 0x67, 0x87,
-	0xc5, 0xd6, 0x7f, 0x51, 0xd3, 0x34, 0x4d, 0xe8, 0xf1, 0x09, 0x8d, 0x62, 0xfc, 0x33, 0xb0, 0x42,
-	0xb9, 0x0b, 0xb9, 0xa7, 0xd4, 0xee, 0xf1, 0x9a, 0x8e, 0x39, 0x90, 0xc2, 0xf1, 0x5e, 0xda, 0x14,
-	0x25, 0x68, 0x52, 0xeb, 0x91, 0xe5, 0x54, 0x57, 0xb2, 0xfa, 0xd8, 0x80, 0x15, 0x77, 0x38, 0xa4,
-	0x7d, 0xd7, 0x89, 0xa7, 0x07, 0x10, 0x1b, 0xb6, 0x96, 0x94, 0x3c, 0x33, 0x25, 0x23, 0x59, 0x4e,
-	0x7b, 0xa4, 0xc3, 0xbc, 0x0d, 0xf9, 0x98, 0x97, 0xb7, 0xdc, 0x77, 0x4b, 0x5b, 0xe5, 0x24, 0xe2,
-	0x70, 0x26, 0x91, 0x42, 0xfc, 0x15, 0x10, 0xc5, 0x32, 0x8f, 0x2d, 0x13, 0x87, 0x98, 0xd4, 0x40,
-	0x44, 0xc8, 0xf1, 0xdb, 0x50, 0x99, 0xc9, 0xce, 0x7d, 0x0e, 0x58, 0x86, 0x94, 0xa7, 0x53, 0x6d,
-	0x1f, 0xdf, 0x80, 0x85, 0x40, 0xe4, 0x42, 0x1e, 0x75, 0x26, 0x2b, 0x9e, 0x4d, 0x94, 0x24, 0xd1,
-	0x62, 0xb1, 0x21, 0xa4, 0x11, 0x0d, 0x4f, 0x69, 0x9f, 0x0d, 0xba, 0xc0, 0x07, 0x85, 0x84, 0x55,
-	0xef, 0x6b, 0x3f, 0x05, 0x4b, 0x29, 0xc4, 0xd1, 0x28, 0xf0, 0x23, 0x8a, 0xaf, 0x43, 0x3e, 0xe4,
-	0xe7, 0x5d, 0xc2, 0x8a, 0xe5, 0x1c, 0x53, 0x91, 0x80, 0x48, 0x0d, 0xad, 0x0f, 0x4b, 0x82, 0xc3,
-	0xe2, 0x37, 0xdf, 0x49, 0xfc, 0x36, 0xe4, 0x28, 0x6b, 0x9c, 0xd9, 0x14, 0xd2, 0xae, 0x71, 0x39,
-	0x11, 0xd2, 0xa9, 0x59, 0xd4, 0xe7, 0xce, 0xf2, 0x1f, 0x2a, 0xac, 0xc8, 0x55, 0x6e, 0x3b, 0x71,
-	0xef, 0xf0, 0x25, 0xf5, 0x86, 0x1f, 0x85, 0x05, 0xc6, 0x77, 0xd3, 0x93, 0x33, 0xc7, 0x1f, 0x12,
-	0x0d, 0xe6, 0x11, 0x4e, 0x64, 0x4f, 0x6d, 0xbf, 0x2c, 0x1f, 0xcb, 0x4e, 0x34, 0x55, 0x35, 0xcc,
-	0x71, 0x9c, 0xfc, 0x73, 0x1c, 0x67, 0xe1, 0x22, 0x8e, 0xa3, 0xed, 0xc0, 0xea, 0x2c, 0xe2, 0xd2,
-	0x39, 0x7e, 0x0c, 0x16, 0xc4, 0xa6, 0x24, 0x31, 0x72, 0xde, 0xbe, 0x25, 0x2a, 0xda, 0xc7, 0x2a,
-	0xac, 0xca, 0xf0, 0xf5, 0xd9, 0x38, 0xc7, 0x53, 0x38, 0xe7, 0x2e, 0x74, 0x40, 0x2f, 0xb6, 0x7f,
-	0x5a, 0x0d, 0xd6, 0xce, 0xe0, 0xf8, 0x02, 0x87, 0xf5, 0xfb, 0x0a, 0x2c, 0x6e, 0xd3, 0x81, 0xeb,
-	0xbf, 0xa4, 0xbb, 0x30, 0x05, 0x6e, 0xf6, 0x42, 0x4e, 0x3c, 0x82, 0xb2, 0xb4, 0x57, 0xa2, 0x75,
-	0x1e, 0x6d, 0x65, 0xde, 0x69, 0xb9, 0x0b, 0x8b, 0xf2, 0x02, 0xc2, 0xf1, 0x5c, 0x27, 0x4a, 0xed,
-	0x39, 0x73, 0x03, 0xa1, 0x33, 0x21, 0x91, 0x77, 0x15, 0x9c, 0xd0, 0xfe, 0x55, 0x81, 0x72, 0x2d,
-	0x18, 0x0e, 0xdd, 0xf8, 0x25, 0xc5, 0xf8, 0x3c, 0x42, 0xd9, 0x79, 0xfe, 0xf8, 0x0e, 0x54, 0x12,
-	0x33, 0x25, 0xb4, 0x67, 0x32, 0x8d, 0x72, 0x2e, 0xd3, 0xfc, 0x9b, 0x02, 0x4b, 0x24, 0x10, 0x15,
-	0xfe, 0xab, 0x0d, 0xce, 0x2d, 0x40, 0x13, 0x43, 0x2f, 0x0a, 0xcf, 0x7f, 0x2b, 0x50, 0x69, 0x87,
-	0x74, 0xe4, 0x84, 0xf4, 0x95, 0x46, 0x87, 0x95, 0xe9, 0xfd, 0x58, 0x16, 0x38, 0x45, 0xc2, 0xdb,
-	0xda, 0x32, 0x2c, 0xa5, 0xb6, 0x0b, 0xc0, 0xb4, 0x7f, 0x50, 0x60, 0x4d, 0xb8, 0x98, 0x94, 0xf4,
-	0x5f, 0x52, 0x58, 0x12, 0x7b, 0xb3, 0x53, 0xf6, 0x56, 0xe1, 0xf2, 0x59, 0xdb, 0xa4, 0xd9, 0xdf,
-	0x50, 0xe1, 0x4a, 0xe2, 0x3c, 0x2f, 0xb9, 0xe1, 0x3f, 0x84, 0x3f, 0xac, 0x43, 0xf5, 0x3c, 0x08,
-	0x12, 0xa1, 0x6f, 0xab, 0x50, 0x15, 0x97, 0x38, 0x53, 0x75, 0xd0, 0xab, 0xe3, 0x1b, 0xf8, 0x1d,
-	0x58, 0x1c, 0x39, 0x61, 0xec, 0xf6, 0xdc, 0x91, 0xc3, 0x3e, 0x45, 0x73, 0xbc, 0xcc, 0x3a, 0x33,
-	0xc0, 0x8c, 0x8a, 0x76, 0x15, 0x5e, 0x9b, 0x83, 0x88, 0xc4, 0xeb, 0x7f, 0x14, 0xc0, 0x9d, 0xd8,
-	0x09, 0xe3, 0xcf, 0x40, 0x5e, 0x9a, 0xeb, 0x4c, 0x6b, 0xb0, 0x32, 0x63, 0xff, 0x34, 0x2e, 0x34,
-	0xfe, 0x4c, 0xa4, 0xa4, 0x4f, 0xc4, 0x65, 0xda, 0x7e, 0x89, 0xcb, 0x3f, 0x29, 0xb0, 0x5e, 0x0b,
-	0xc4, 0x85, 0xe8, 0x2b, 0x79, 0xc2, 0xb4, 0x37, 0xe0, 0xea, 0x5c, 0x03, 0x25, 0x00, 0xff, 0xa8,
-	0xc0, 0x65, 0x42, 0x9d, 0xfe, 0xab, 0x69, 0xfc, 0x03, 0xb8, 0x72, 0xce, 0x38, 0x59, 0xa3, 0xdc,
-	0x81, 0xc2, 0x90, 0xc6, 0x0e, 0xab, 0x70, 0xa5, 0x49, 0xeb, 0xc9, 0xb8, 0x13, 0xed, 0xa6, 0xd4,
-	0x20, 0xa9, 0xae, 0xf6, 0xcf, 0x2a, 0xac, 0xf0, 0x3a, 0xfb, 0xf3, 0x8f, 0xbc, 0x0b, 0xdd, 0xc2,
-	0xe4, 0xcf, 0x16, 0x7f, 0x4c, 0x61, 0x14, 0x52, 0x3b, 0xb9, 0x1d, 0x58, 0xe0, 0xbf, 0x3e, 0xc2,
-	0x28, 0xa4, 0x0f, 0x04, 0x47, 0xfb, 0x1b, 0x05, 0x56, 0x67, 0x21, 0x4e, 0xbf, 0x68, 0xfe, 0xaf,
-	0x6f, 0x5b, 0xe6, 0x84, 0x94, 0xcc, 0x45, 0x3e, 0x92, 0xb2, 0x17, 0xfe, 0x48, 0xfa, 0x5b, 0x15,
-	0xaa, 0xd3, 0xc6, 0x7c, 0x7e, 0xa7, 0x33, 0x7b, 0xa7, 0xf3, 0x83, 0xde, 0xf2, 0x69, 0x7f, 0xa7,
-	0xc0, 0x6b, 0x73, 0x00, 0xfd, 0xc1, 0x5c, 0x64, 0xea, 0x66, 0x47, 0x7d, 0xee, 0xcd, 0xce, 0xa7,
-	0xef, 0x24, 0x7f, 0xaf, 0xc0, 0x6a, 0x53, 0xdc, 0xd5, 0x8b, 0x9b, 0x8f, 0x97, 0x37, 0x06, 0xf3,
-	0xeb, 0xf8, 0xec, 0xe4, 0xd7, 0x2a, 0xad, 0x06, 0x6b, 0x67, 0x4c, 0x7b, 0x81, 0xdb, 0x9c, 0xff,
-	0x52, 0x60, 0x59, 0x8e, 0xa2, 0xbf, 0xb4, 0xe5, 0xcb, 0x1c, 0x74, 0xf0, 0x9b, 0x90, 0x71, 0xfb,
-	0x49, 0xdd, 0x3b, 0xfb, 0x0a, 0x81, 0x09, 0xb4, 0xf7, 0x01, 0x4f, 0xdb, 0xfd, 0x02, 0xd0, 0xfd,
-	0xbb, 0x0a, 0x6b, 0x44, 0x44, 0xdf, 0xcf, 0x7f, 0x5f, 0xf8, 0x61, 0x7f, 0x5f, 0x78, 0x76, 0xe2,
-	0xfa, 0x98, 0x17, 0x53, 0xb3, 0x50, 0x7f, 0x7a, 0xa9, 0xeb, 0x4c, 0xa2, 0xcd, 0x9c, 0x4b, 0xb4,
-	0x2f, 0x1e, 0x8f, 0x3e, 0x56, 0x61, 0x5d, 0x1a, 0xf2, 0x79, 0xad, 0x73, 0x71, 0x8f, 0xc8, 0x9f,
-	0xf3, 0x88, 0xff, 0x54, 0xe0, 0xea, 0x5c, 0x20, 0xff, 0xdf, 0x2b, 0x9a, 0x33, 0xde, 0x93, 0x7d,
-	0xae, 0xf7, 0xe4, 0x2e, 0xec, 0x3d, 0xdf, 0x52, 0xa1, 0x42, 0xa8, 0x47, 0x9d, 0xe8, 0x15, 0xbf,
-	0xdd, 0x3b, 0x83, 0x61, 0xee, 0xdc, 0x3d, 0xe7, 0x32, 0x2c, 0xa5, 0x40, 0xc8, 0x0f, 0x2e, 0xfe,
-	0x81, 0xce, 0xf2, 0xe0, 0x07, 0xd4, 0xf1, 0xe2, 0xa4, 0x12, 0xd4, 0xfe, 0x54, 0x85, 0x32, 0x61,
-	0x1c, 0x77, 0x48, 0x3b, 0xb1, 0x13, 0x47, 0xf8, 0x0b, 0xb0, 0x78, 0xc8, 0x55, 0xec, 0x89, 0x87,
-	0x14, 0x49, 0x49, 0xf0, 0xc4, 0xaf, 0x8f, 0x5b, 0xb0, 0x16, 0xd1, 0x5e, 0xe0, 0xf7, 0x23, 0xfb,
-	0x80, 0x1e, 0xba, 0x7e, 0xdf, 0x1e, 0x3a, 0x51, 0x4c, 0x43, 0x0e, 0x4b, 0x99, 0xac, 0x48, 0xe1,
-	0x36, 0x97, 0x35, 0xb9, 0x08, 0xdf, 0x84, 0xd5, 0x03, 0xd7, 0xf7, 0x82, 0x81, 0x3d, 0xf2, 0x9c,
-	0x31, 0x0d, 0x23, 0xbb, 0x17, 0x9c, 0xf8, 0x02, 0x8f, 0x1c, 0xc1, 0x42, 0xd6, 0x16, 0xa2, 0x1a,
-	0x93, 0xe0, 0x0f, 0xe1, 0xfa, 0xdc, 0x59, 0xec, 0xc7, 0xae, 0x17, 0xd3, 0x90, 0xf6, 0xed, 0x90,
-	0x8e, 0x3c, 0xb7, 0x27, 0x5e, 0x58, 0x09, 0xa0, 0xbe, 0x3c, 0x67, 0xea, 0x5d, 0xa9, 0x4e, 0x26,
-	0xda, 0xf8, 0x2a, 0x14, 0x7b, 0xa3, 0x13, 0xfb, 0x84, 0x3f, 0x5a, 0x60, 0xf8, 0x29, 0xa4, 0xd0,
-	0x1b, 0x9d, 0x74, 0x19, 0x8d, 0x11, 0x64, 0x8e, 0x47, 0x22, 0x38, 0x2b, 0x84, 0x35, 0xb5, 0xef,
-	0x2b, 0x50, 0xd1, 0x07, 0x83, 0x90, 0x0e, 0x9c, 0x58, 0xc2, 0x74, 0x13, 0x56, 0x05, 0x24, 0x63,
-	0x5b, 0xba, 0xab, 0xb0, 0x47, 0x11, 0xf6, 0x48, 0x99, 0xf0, 0x55, 0x61, 0xcf, 0x6d, 0xb8, 0x7c,
-	0xe2, 0xcf, 0xed, 0xa3, 0xf2, 0x3e, 0xab, 0xa9, 0x74, 0xba, 0xd7, 0x4f, 0xc2, 0x6b, 0xf3, 0x51,
-	0x18, 0xba, 0xe2, 0x95, 0x63, 0x99, 0x5c, 0x9e, 0x63, 0x74, 0xd3, 0xf5, 0x9f, 0xd1, 0xd5, 0xf9,
-	0x88, 0xe3, 0xf5, 0x09, 0x5d, 0x9d, 0x8f, 0xb4, 0x3f, 0x4f, 0x7f, 0x53, 0x4c, 0xdc, 0x25, 0x0d,
-	0x1c, 0x89, 0x23, 0x2b, 0xcf, 0x72, 0xe4, 0x2a, 0x2c, 0x30, 0x67, 0x74, 0xfd, 0x01, 0x37, 0xae,
-	0x40, 0x12, 0x12, 0x77, 0xe0, 0xcb, 0xd2, 0x76, 0xfa, 0x51, 0x4c, 0x43, 0xdf, 0xf1, 0xbc, 0xb1,
-	0x2d, 0xae, 0x1f, 0x7d, 0xfe, 0xa0, 0x2c, 0x7d, 0xf5, 0x29, 0xc2, 0xc7, 0x17, 0x85, 0xb6, 0x91,
-	0x2a, 0x93, 0x54, 0xd7, 0x4a, 0xdf, 0x83, 0x7e, 0x15, 0x2a, 0xa1, 0x74, 0x62, 0x3b, 0x62, 0xdb,
-	0x23, 0x43, 0xee, 0x6a, 0xf2, 0x6a, 0x62, 0xda, 0xc3, 0x49, 0x39, 0x9c, 0x71, 0xf8, 0x17, 0x0e,
-	0x38, 0xf7, 0xb2, 0x85, 0x3c, 0x5a, 0xd0, 0xfe, 0x42, 0x81, 0x95, 0x39, 0xdf, 0xee, 0xe9, 0xc5,
-	0x80, 0x32, 0x75, 0xef, 0xf8, 0xe3, 0x90, 0xe3, 0x0f, 0x5a, 0xe4, 0x1b, 0xaa, 0x2b, 0xe7, 0x3f,
-	0xfd, 0xf9, 0xe3, 0x13, 0x22, 0xb4, 0xd8, 0x59, 0xe4, 0x36, 0xc9, 0xd7, 0x76, 0x12, 0x92, 0x12,
-	0xe3, 0xc9, 0x27, 0x76, 0xe7, 0x6e, 0x32, 0xb3, 0xcf, 0xbd, 0xc9, 0xbc, 0xfe, 0xdb, 0x19, 0x28,
-	0x36, 0xc7, 0x9d, 0x63, 0x6f, 0xd7, 0x73, 0x06, 0xfc, 0x75, 0x48, 0xb3, 0x6d, 0x3d, 0x42, 0x97,
-	0xf0, 0x32, 0x94, 0xcd, 0x96, 0x65, 0x9b, 0xdd, 0x46, 0xc3, 0xde, 0x6d, 0xe8, 0x7b, 0x48, 0xc1,
-	0x08, 0x16, 0xdb, 0xa4, 0x6e, 0xdf, 0x37, 0x1e, 0x09, 0x8e, 0x8a, 0x57, 0x60, 0xa9, 0x6b, 0xd6,
-	0x1f, 0x74, 0x8d, 0x09, 0x33, 0x8b, 0xd7, 0x60, 0xb9, 0xd9, 0x6d, 0x58, 0xf5, 0x76, 0x63, 0x8a,
-	0x5d, 0xc0, 0x65, 0x28, 0x6e, 0x37, 0x5a, 0xdb, 0x82, 0x44, 0x6c, 0xfc, 0xae, 0xd9, 0xa9, 0xef,
-	0x99, 0xc6, 0x8e, 0x60, 0x6d, 0x30, 0xd6, 0x87, 0x06, 0x69, 0xed, 0xd6, 0x93, 0x29, 0xdf, 0xc7,
-	0x08, 0x4a, 0xdb, 0x75, 0x53, 0x27, 0x72, 0x94, 0xa7, 0x0a, 0xae, 0x40, 0xd1, 0x30, 0xbb, 0x4d,
-	0x49, 0xab, 0xb8, 0x0a, 0x2b, 0x7a, 0xd7, 0x6a, 0xd9, 0x75, 0xb3, 0x46, 0x8c, 0xa6, 0x61, 0x5a,
-	0x52, 0x92, 0xc5, 0x2b, 0x50, 0xb1, 0xea, 0x4d, 0xa3, 0x63, 0xe9, 0xcd, 0xb6, 0x64, 0xb2, 0x55,
-	0x14, 0x3a, 0x46, 0xa2, 0x83, 0xf0, 0x3a, 0xac, 0x99, 0x2d, 0x3b, 0x79, 0x5a, 0xb7, 0xaf, 0x37,
-	0xba, 0x86, 0x94, 0x6d, 0xe0, 0x2b, 0x80, 0x5b, 0xa6, 0xdd, 0x6d, 0xef, 0xe8, 0x96, 0x61, 0x9b,
-	0xad, 0x87, 0x52, 0xf0, 0x3e, 0xae, 0x40, 0x61, 0xb2, 0x82, 0xa7, 0x0c, 0x85, 0x72, 0x5b, 0x27,
-	0xd6, 0xc4, 0xd8, 0xa7, 0x4f, 0x19, 0x58, 0xb0, 0x47, 0x5a, 0xdd, 0xf6, 0x44, 0x6d, 0x19, 0x4a,
-	0x12, 0x2c, 0xc9, 0xca, 0x32, 0xd6, 0x76, 0xdd, 0xac, 0xa5, 0xeb, 0x7b, 0x5a, 0x58, 0x57, 0x91,
-	0x72, 0xfd, 0x08, 0xb2, 0x7c, 0x3b, 0x0a, 0x90, 0x35, 0x5b, 0xa6, 0x81, 0x2e, 0xe1, 0x25, 0x80,
-	0x7a, 0xa7, 0x6e, 0x5a, 0xc6, 0x1e, 0xd1, 0x1b, 0xcc, 0x6c, 0xce, 0x48, 0x00, 0x64, 0xd6, 0x2e,
-	0xc2, 0x42, 0xbd, 0xb3, 0xdb, 0x68, 0xe9, 0x96, 0x34, 0xb3, 0xde, 0x79, 0xd0, 0x6d, 0x59, 0x4c,
-	0x88, 0x70, 0x09, 0xf2, 0xf5, 0x8e, 0x65, 0x7c, 0xdd, 0x62, 0x76, 0x71, 0x99, 0x40, 0x15, 0x3d,
-	0x7d, 0xff, 0xfa, 0x77, 0x32, 0x90, 0xe5, 0xcf, 0xb9, 0xcb, 0x50, 0xe4, 0xbb, 0x6d, 0x3d, 0x6a,
-	0xb3, 0x29, 0x8b, 0x90, 0xad, 0x9b, 0xd6, 0x5d, 0xf4, 0x73, 0x2a, 0x06, 0xc8, 0x75, 0x79, 0xfb,
-	0xe7, 0xf3, 0xac, 0x5d, 0x37, 0xad, 0x77, 0xee, 0xa0, 0x6f, 0xa8, 0x6c, 0xd8, 0xae, 0x20, 0x7e,
-	0x21, 0x11, 0x6c, 0xdd, 0x46, 0xdf, 0x4c, 0x05, 0x5b, 0xb7, 0xd1, 0x2f, 0x26, 0x82, 0x5b, 0x5b,
-	0xe8, 0x5b, 0xa9, 0xe0, 0xd6, 0x16, 0xfa, 0xa5, 0x44, 0x70, 0xe7, 0x36, 0xfa, 0xe5, 0x54, 0x70,
-	0xe7, 0x36, 0xfa, 0x95, 0x3c, 0xb3, 0x85, 0x5b, 0x72, 0x6b, 0x0b, 0xfd, 0x6a, 0x21, 0xa5, 0xee,
-	0xdc, 0x46, 0xbf, 0x56, 0x60, 0xfb, 0x9f, 0xee, 0x2a, 0xfa, 0x75, 0xc4, 0x96, 0xc9, 0x36, 0x08,
-	0xfd, 0x06, 0x6f, 0x32, 0x11, 0xfa, 0x4d, 0xc4, 0x6c, 0x64, 0x5c, 0x4e, 0x7e, 0x9b, 0x4b, 0x1e,
-	0x19, 0x3a, 0x41, 0xbf, 0x95, 0x17, 0x8f, 0x3d, 0x6b, 0xf5, 0xa6, 0xde, 0x40, 0x98, 0xf7, 0x60,
-	0xa8, 0xfc, 0xce, 0x4d, 0xd6, 0x64, 0xee, 0x89, 0x7e, 0xb7, 0xcd, 0x26, 0xdc, 0xd7, 0x49, 0xed,
-	0x03, 0x9d, 0xa0, 0xdf, 0xbb, 0xc9, 0x26, 0xdc, 0xd7, 0x89, 0xc4, 0xeb, 0xf7, 0xdb, 0x4c, 0x91,
-	0x8b, 0xfe, 0xe0, 0x26, 0x5b, 0xb4, 0xe4, 0xff, 0x61, 0x1b, 0x17, 0x20, 0xb3, 0x5d, 0xb7, 0xd0,
-	0x77, 0xf8, 0x6c, 0xcc, 0x45, 0xd1, 0x1f, 0x21, 0xc6, 0xec, 0x18, 0x16, 0xfa, 0x2e, 0x63, 0xe6,
-	0xac, 0x6e, 0xbb, 0x61, 0xa0, 0xd7, 0xd9, 0xe2, 0xf6, 0x8c, 0x56, 0xd3, 0xb0, 0xc8, 0x23, 0xf4,
-	0xc7, 0x5c, 0xfd, 0x5e, 0xa7, 0x65, 0xa2, 0xef, 0x21, 0x5c, 0x01, 0x30, 0xbe, 0xde, 0x26, 0x46,
-	0xa7, 0x53, 0x6f, 0x99, 0xe8, 0xad, 0xeb, 0xbb, 0x80, 0xce, 0x86, 0x03, 0x66, 0x40, 0xd7, 0xbc,
-	0x6f, 0xb6, 0x1e, 0x9a, 0xe8, 0x12, 0x23, 0xda, 0xc4, 0x68, 0xeb, 0xc4, 0x40, 0x0a, 0x06, 0xc8,
-	0xcb, 0x27, 0xa4, 0x2a, 0x5e, 0x84, 0x02, 0x69, 0x35, 0x1a, 0xdb, 0x7a, 0xed, 0x3e, 0xca, 0x6c,
-	0xbf, 0x0b, 0x4b, 0x6e, 0xb0, 0x79, 0xea, 0xc6, 0x34, 0x8a, 0xc4, 0x1f, 0x06, 0x3e, 0xd4, 0x24,
-	0xe5, 0x06, 0x37, 0x44, 0xeb, 0xc6, 0x20, 0xb8, 0x71, 0x1a, 0xdf, 0xe0, 0xd2, 0x1b, 0x3c, 0x62,
-	0x1c, 0xe4, 0x39, 0x71, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x0c, 0x52, 0xdd, 0x8e,
-	0x30, 0x00, 0x00,
+	0x5a, 0x76, 0xe9, 0xad, 0x5f, 0x8f, 0xce, 0xce, 0xee, 0xb6, 0x35, 0x3d, 0xaf, 0xde, 0x9a, 0x9d,
+	0x5d, 0xd3, 0x2c, 0x6d, 0x4f, 0xdb, 0x63, 0xcc, 0xec, 0x02, 0xae, 0x56, 0x57, 0x7b, 0x64, 0x4b,
+	0x25, 0x39, 0x55, 0xb2, 0xd7, 0x13, 0x44, 0x54, 0x54, 0x4b, 0x69, 0x75, 0x45, 0x97, 0xaa, 0xe4,
+	0xaa, 0x52, 0x7b, 0x9a, 0x53, 0x2f, 0xcb, 0xb2, 0xbc, 0x59, 0x58, 0x5e, 0xcb, 0x06, 0x1b, 0xdc,
+	0xb8, 0x11, 0xc1, 0x8d, 0x33, 0x87, 0x3d, 0x70, 0x20, 0x82, 0x23, 0x70, 0x00, 0x0e, 0x04, 0x9c,
+	0x08, 0x82, 0x03, 0x07, 0x0e, 0x04, 0x91, 0x8f, 0x2a, 0x49, 0xdd, 0x1a, 0xbb, 0xc7, 0xcb, 0xc4,
+	0x86, 0x3d, 0xbe, 0xe5, 0xff, 0xc8, 0xc7, 0xff, 0xfd, 0x7f, 0xfe, 0x99, 0xca, 0xfa, 0x05, 0xa5,
+	0xc7, 0x13, 0x1a, 0x1c, 0x6f, 0x8d, 0x03, 0x3f, 0xf2, 0x71, 0x96, 0x13, 0xeb, 0xd5, 0xc8, 0x1f,
+	0xfb, 0x03, 0x3b, 0xb2, 0x05, 0x7b, 0xbd, 0x74, 0x14, 0x05, 0xe3, 0xbe, 0x20, 0xd4, 0x6f, 0x29,
+	0x90, 0x33, 0xed, 0x60, 0x48, 0x23, 0xbc, 0x0e, 0x85, 0x43, 0x7a, 0x1c, 0x8e, 0xed, 0x3e, 0xad,
+	0x29, 0x1b, 0xca, 0xe5, 0x22, 0x49, 0x68, 0xbc, 0x0a, 0xd9, 0xf0, 0xc0, 0x0e, 0x06, 0xb5, 0x14,
+	0x17, 0x08, 0x02, 0xbf, 0x0f, 0xa5, 0xc8, 0xde, 0x77, 0x69, 0x64, 0x45, 0xc7, 0x63, 0x5a, 0x4b,
+	0x6f, 0x28, 0x97, 0xab, 0xdb, 0xab, 0x5b, 0xc9, 0x7c, 0x26, 0x17, 0x9a, 0xc7, 0x63, 0x4a, 0x20,
+	0x4a, 0xda, 0x18, 0x43, 0xa6, 0x4f, 0x5d, 0xb7, 0x96, 0xe1, 0x63, 0xf1, 0xb6, 0xba, 0x0b, 0xd5,
+	0xfb, 0xe6, 0x6d, 0x3b, 0xa2, 0x75, 0xdb, 0x75, 0x69, 0xd0, 0xd8, 0x65, 0xcb, 0x99, 0x84, 0x34,
+	0xf0, 0xec, 0x51, 0xb2, 0x9c, 0x98, 0xc6, 0x17, 0x21, 0x37, 0x0c, 0xfc, 0xc9, 0x38, 0xac, 0xa5,
+	0x36, 0xd2, 0x97, 0x8b, 0x44, 0x52, 0xea, 0x2f, 0x00, 0xe8, 0x47, 0xd4, 0x8b, 0x4c, 0xff, 0x90,
+	0x7a, 0xf8, 0x0d, 0x28, 0x46, 0xce, 0x88, 0x86, 0x91, 0x3d, 0x1a, 0xf3, 0x21, 0xd2, 0x64, 0xca,
+	0xf8, 0x04, 0x93, 0xd6, 0xa1, 0x30, 0xf6, 0x43, 0x27, 0x72, 0x7c, 0x8f, 0xdb, 0x53, 0x24, 0x09,
+	0xad, 0xfe, 0x1c, 0x64, 0xef, 0xdb, 0xee, 0x84, 0xe2, 0xb7, 0x21, 0xc3, 0x0d, 0x56, 0xb8, 0xc1,
+	0xa5, 0x2d, 0x01, 0x3a, 0xb7, 0x93, 0x0b, 0xd8, 0xd8, 0x47, 0x4c, 0x93, 0x8f, 0x5d, 0x26, 0x82,
+	0x50, 0x0f, 0xa1, 0xbc, 0xe3, 0x78, 0x83, 0xfb, 0x76, 0xe0, 0x30, 0x30, 0x9e, 0x73, 0x18, 0xfc,
+	0x45, 0xc8, 0xf1, 0x46, 0x58, 0x4b, 0x6f, 0xa4, 0x2f, 0x97, 0xb6, 0xcb, 0xb2, 0x23, 0x5f, 0x1b,
+	0x91, 0x32, 0xf5, 0xaf, 0x15, 0x80, 0x1d, 0x7f, 0xe2, 0x0d, 0xee, 0x31, 0x21, 0x46, 0x90, 0x0e,
+	0x1f, 0xbb, 0x12, 0x48, 0xd6, 0xc4, 0x77, 0xa1, 0xba, 0xef, 0x78, 0x03, 0xeb, 0x48, 0x2e, 0x47,
+	0x60, 0x59, 0xda, 0xfe, 0xa2, 0x1c, 0x6e, 0xda, 0x79, 0x6b, 0x76, 0xd5, 0xa1, 0xee, 0x45, 0xc1,
+	0x31, 0xa9, 0xec, 0xcf, 0xf2, 0xd6, 0x7b, 0x80, 0xcf, 0x2a, 0xb1, 0x49, 0x0f, 0xe9, 0x71, 0x3c,
+	0xe9, 0x21, 0x3d, 0xc6, 0x3f, 0x31, 0x6b, 0x51, 0x69, 0x7b, 0x25, 0x9e, 0x6b, 0xa6, 0xaf, 0x34,
+	0xf3, 0x83, 0xd4, 0x4d, 0x45, 0xfd, 0xcb, 0x3c, 0x54, 0xf5, 0x8f, 0x69, 0x7f, 0x12, 0xd1, 0xf6,
+	0x98, 0xf9, 0x20, 0xc4, 0x2d, 0x58, 0x72, 0xbc, 0xbe, 0x3b, 0x19, 0xd0, 0x81, 0xf5, 0xc8, 0xa1,
+	0xee, 0x20, 0xe4, 0x71, 0x54, 0x4d, 0xd6, 0x3d, 0xaf, 0xbf, 0xd5, 0x90, 0xca, 0x7b, 0x5c, 0x97,
+	0x54, 0x9d, 0x39, 0x1a, 0x6f, 0xc2, 0x72, 0xdf, 0x75, 0xa8, 0x17, 0x59, 0x8f, 0x98, 0xbd, 0x56,
+	0xe0, 0x3f, 0x09, 0x6b, 0xd9, 0x0d, 0xe5, 0x72, 0x81, 0x2c, 0x09, 0xc1, 0x1e, 0xe3, 0x13, 0xff,
+	0x49, 0x88, 0x3f, 0x80, 0xc2, 0x13, 0x3f, 0x38, 0x74, 0x7d, 0x7b, 0x50, 0xcb, 0xf1, 0x39, 0xdf,
+	0x5a, 0x3c, 0xe7, 0x03, 0xa9, 0x45, 0x12, 0x7d, 0x7c, 0x19, 0x50, 0xf8, 0xd8, 0xb5, 0x42, 0xea,
+	0xd2, 0x7e, 0x64, 0xb9, 0xce, 0xc8, 0x89, 0x6a, 0x05, 0x1e, 0x92, 0xd5, 0xf0, 0xb1, 0xdb, 0xe5,
+	0xec, 0x26, 0xe3, 0x62, 0x0b, 0xd6, 0xa2, 0xc0, 0xf6, 0x42, 0xbb, 0xcf, 0x06, 0xb3, 0x9c, 0xd0,
+	0x77, 0x6d, 0xd6, 0xaa, 0x15, 0xf9, 0x94, 0x9b, 0x8b, 0xa7, 0x34, 0xa7, 0x5d, 0x1a, 0x71, 0x0f,
+	0xb2, 0x1a, 0x2d, 0xe0, 0xe2, 0xf7, 0x60, 0x2d, 0x3c, 0x74, 0xc6, 0x16, 0x1f, 0xc7, 0x1a, 0xbb,
+	0xb6, 0x67, 0xf5, 0xed, 0xfe, 0x01, 0xad, 0x01, 0x37, 0x1b, 0x33, 0x21, 0xf7, 0x7b, 0xc7, 0xb5,
+	0xbd, 0x3a, 0x93, 0x30, 0xd0, 0x99, 0x9e, 0x47, 0x03, 0xeb, 0x88, 0x06, 0x21, 0x5b, 0x4d, 0xe9,
+	0x69, 0xa0, 0x77, 0x84, 0xf2, 0x7d, 0xa1, 0x4b, 0xaa, 0xe3, 0x39, 0x1a, 0xbf, 0x0f, 0x97, 0x0e,
+	0xec, 0xd0, 0xea, 0x07, 0xd4, 0x8e, 0xe8, 0xc0, 0x8a, 0xe8, 0x68, 0x6c, 0x45, 0x22, 0x06, 0xcb,
+	0x7c, 0x0d, 0xab, 0x07, 0x76, 0x58, 0x17, 0x52, 0x93, 0x8e, 0xc6, 0x3c, 0x8f, 0x84, 0xea, 0x57,
+	0xa1, 0x3a, 0xef, 0x4d, 0xbc, 0x0c, 0x15, 0xf3, 0x61, 0x47, 0xb7, 0x34, 0x63, 0xd7, 0x32, 0xb4,
+	0x96, 0x8e, 0x2e, 0xe0, 0x0a, 0x14, 0x39, 0xab, 0x6d, 0x34, 0x1f, 0x22, 0x05, 0xe7, 0x21, 0xad,
+	0x35, 0x9b, 0x28, 0xa5, 0xde, 0x84, 0x42, 0xec, 0x16, 0xbc, 0x04, 0xa5, 0x9e, 0xd1, 0xed, 0xe8,
+	0xf5, 0xc6, 0x5e, 0x43, 0xdf, 0x45, 0x17, 0x70, 0x01, 0x32, 0xed, 0xa6, 0xd9, 0x41, 0x8a, 0x68,
+	0x69, 0x1d, 0x94, 0x62, 0x3d, 0x77, 0x77, 0x34, 0x94, 0x56, 0xff, 0x5c, 0x81, 0xd5, 0x45, 0xf0,
+	0xe2, 0x12, 0xe4, 0x77, 0xf5, 0x3d, 0xad, 0xd7, 0x34, 0xd1, 0x05, 0xbc, 0x02, 0x4b, 0x44, 0xef,
+	0xe8, 0x9a, 0xa9, 0xed, 0x34, 0x75, 0x8b, 0xe8, 0xda, 0x2e, 0x52, 0x30, 0x86, 0x2a, 0x6b, 0x59,
+	0xf5, 0x76, 0xab, 0xd5, 0x30, 0x4d, 0x7d, 0x17, 0xa5, 0xf0, 0x2a, 0x20, 0xce, 0xeb, 0x19, 0x53,
+	0x6e, 0x1a, 0x23, 0x28, 0x77, 0x75, 0xd2, 0xd0, 0x9a, 0x8d, 0x8f, 0xd8, 0x00, 0x28, 0x83, 0xbf,
+	0x00, 0x6f, 0xd6, 0xdb, 0x46, 0xb7, 0xd1, 0x35, 0x75, 0xc3, 0xb4, 0xba, 0x86, 0xd6, 0xe9, 0x7e,
+	0xd8, 0x36, 0xf9, 0xc8, 0xc2, 0xb8, 0x2c, 0xae, 0x02, 0x68, 0x3d, 0xb3, 0x2d, 0xc6, 0x41, 0x39,
+	0xf5, 0x31, 0x54, 0xe7, 0x91, 0x67, 0xab, 0x92, 0x4b, 0xb4, 0x3a, 0x4d, 0xcd, 0x30, 0x74, 0x82,
+	0x2e, 0xe0, 0x1c, 0xa4, 0xee, 0x5f, 0x13, 0xb6, 0xde, 0xa6, 0xde, 0x75, 0x94, 0x62, 0x03, 0xb1,
+	0xd6, 0xed, 0x80, 0xd2, 0xc1, 0x31, 0x4a, 0xb3, 0x75, 0x33, 0xba, 0x49, 0x1f, 0x45, 0xdb, 0xc4,
+	0x19, 0x1e, 0x44, 0x28, 0xc3, 0xd6, 0xcd, 0x78, 0x0f, 0x9c, 0xe8, 0x60, 0xcf, 0x76, 0xdd, 0x7d,
+	0xbb, 0x7f, 0x88, 0xb2, 0x77, 0x32, 0x05, 0x05, 0xa5, 0xee, 0x64, 0x0a, 0x29, 0x94, 0xbe, 0x93,
+	0x29, 0xa4, 0x51, 0x46, 0xfd, 0xab, 0x14, 0x64, 0xb9, 0x7b, 0x58, 0x9e, 0x9f, 0xc9, 0xde, 0xbc,
+	0x9d, 0xe4, 0xbc, 0xd4, 0x53, 0x72, 0x1e, 0x0f, 0x05, 0x99, 0x7d, 0x05, 0x81, 0x5f, 0x87, 0xa2,
+	0x1f, 0x0c, 0x45, 0x90, 0xc8, 0x73, 0xa3, 0xe0, 0x07, 0x43, 0x1e, 0x18, 0x2c, 0x67, 0xb3, 0xe3,
+	0x66, 0xdf, 0x0e, 0x29, 0xdf, 0xba, 0x45, 0x92, 0xd0, 0xf8, 0x35, 0x60, 0x7a, 0x16, 0x5f, 0x47,
+	0x8e, 0xcb, 0xf2, 0x7e, 0x30, 0x34, 0xd8, 0x52, 0xde, 0x81, 0x4a, 0xdf, 0x77, 0x27, 0x23, 0xcf,
+	0x72, 0xa9, 0x37, 0x8c, 0x0e, 0x6a, 0xf9, 0x0d, 0xe5, 0x72, 0x85, 0x94, 0x05, 0xb3, 0xc9, 0x79,
+	0xb8, 0x06, 0xf9, 0xfe, 0x81, 0x1d, 0x84, 0x54, 0x6c, 0xd7, 0x0a, 0x89, 0x49, 0x3e, 0x2b, 0xed,
+	0x3b, 0x23, 0xdb, 0x0d, 0xf9, 0xd6, 0xac, 0x90, 0x84, 0x66, 0x46, 0x3c, 0x72, 0xed, 0x61, 0xc8,
+	0xb7, 0x54, 0x85, 0x08, 0x02, 0xbf, 0x0d, 0x25, 0x39, 0x21, 0x87, 0xa0, 0xc4, 0x97, 0x03, 0x82,
+	0xc5, 0x10, 0x50, 0x7f, 0x1a, 0xd2, 0xc4, 0x7f, 0xc2, 0xe6, 0x14, 0x2b, 0x0a, 0x6b, 0xca, 0x46,
+	0xfa, 0x32, 0x26, 0x31, 0xc9, 0xce, 0x3d, 0x99, 0xfa, 0xc5, 0x89, 0x10, 0x27, 0xfb, 0xef, 0x2b,
+	0x50, 0xe2, 0x5b, 0x96, 0xd0, 0x70, 0xe2, 0x46, 0xec, 0x88, 0x90, 0xb9, 0x51, 0x99, 0x3b, 0x22,
+	0xb8, 0x5f, 0x88, 0x94, 0x31, 0x00, 0x58, 0xba, 0xb3, 0xec, 0x47, 0x8f, 0x68, 0x3f, 0xa2, 0xe2,
+	0x24, 0xcc, 0x90, 0x32, 0x63, 0x6a, 0x92, 0xc7, 0x90, 0x77, 0xbc, 0x90, 0x06, 0x91, 0xe5, 0x0c,
+	0xb8, 0x4f, 0x32, 0xa4, 0x20, 0x18, 0x8d, 0x01, 0x7e, 0x0b, 0x32, 0x3c, 0x61, 0x66, 0xf8, 0x2c,
+	0x20, 0x67, 0x21, 0xfe, 0x13, 0xc2, 0xf9, 0x77, 0x32, 0x85, 0x2c, 0xca, 0xa9, 0x5f, 0x83, 0x32,
+	0x5f, 0xdc, 0x03, 0x3b, 0xf0, 0x1c, 0x6f, 0xc8, 0xcf, 0x7f, 0x7f, 0x20, 0xe2, 0xa2, 0x42, 0x78,
+	0x9b, 0xd9, 0x3c, 0xa2, 0x61, 0x68, 0x0f, 0xa9, 0x3c, 0x8f, 0x63, 0x52, 0xfd, 0xb3, 0x34, 0x94,
+	0xba, 0x51, 0x40, 0xed, 0x11, 0x3f, 0xda, 0xf1, 0xd7, 0x00, 0xc2, 0xc8, 0x8e, 0xe8, 0x88, 0x7a,
+	0x51, 0x6c, 0xdf, 0x1b, 0x72, 0xe6, 0x19, 0xbd, 0xad, 0x6e, 0xac, 0x44, 0x66, 0xf4, 0xf1, 0x36,
+	0x94, 0x28, 0x13, 0x5b, 0x11, 0xbb, 0x22, 0xc8, 0x63, 0x68, 0x39, 0xce, 0x62, 0xc9, 0xdd, 0x81,
+	0x00, 0x4d, 0xda, 0xeb, 0x3f, 0x48, 0x41, 0x31, 0x19, 0x0d, 0x6b, 0x50, 0xe8, 0xdb, 0x11, 0x1d,
+	0xfa, 0xc1, 0xb1, 0x3c, 0xb9, 0xdf, 0x7d, 0xda, 0xec, 0x5b, 0x75, 0xa9, 0x4c, 0x92, 0x6e, 0xf8,
+	0x4d, 0x10, 0xd7, 0x21, 0x11, 0x96, 0xc2, 0xde, 0x22, 0xe7, 0xf0, 0xc0, 0xfc, 0x00, 0xf0, 0x38,
+	0x70, 0x46, 0x76, 0x70, 0x6c, 0x1d, 0xd2, 0xe3, 0xf8, 0x94, 0x4b, 0x2f, 0xf0, 0x24, 0x92, 0x7a,
+	0x77, 0xe9, 0xb1, 0xcc, 0x88, 0x37, 0xe7, 0xfb, 0xca, 0x68, 0x39, 0xeb, 0x9f, 0x99, 0x9e, 0xfc,
+	0xde, 0x10, 0xc6, 0x37, 0x84, 0x2c, 0x0f, 0x2c, 0xd6, 0x54, 0xbf, 0x0c, 0x85, 0x78, 0xf1, 0xb8,
+	0x08, 0x59, 0x3d, 0x08, 0xfc, 0x00, 0x5d, 0xe0, 0x89, 0xb1, 0xd5, 0x14, 0xb9, 0x75, 0x77, 0x97,
+	0xe5, 0xd6, 0x7f, 0x49, 0x25, 0xc7, 0x34, 0xa1, 0x8f, 0x27, 0x34, 0x8c, 0xf0, 0xcf, 0xc3, 0x0a,
+	0xe5, 0x21, 0xe4, 0x1c, 0x51, 0xab, 0xcf, 0xef, 0x74, 0x2c, 0x80, 0x14, 0x8e, 0xf7, 0xd2, 0x96,
+	0xb8, 0x82, 0xc6, 0x77, 0x3d, 0xb2, 0x9c, 0xe8, 0x4a, 0xd6, 0x00, 0xeb, 0xb0, 0xe2, 0x8c, 0x46,
+	0x74, 0xe0, 0xd8, 0xd1, 0xec, 0x00, 0xc2, 0x61, 0x6b, 0xf1, 0x95, 0x67, 0xee, 0xca, 0x48, 0x96,
+	0x93, 0x1e, 0xc9, 0x30, 0xef, 0x42, 0x2e, 0xe2, 0xd7, 0x5b, 0x1e, 0xbb, 0xa5, 0xed, 0x4a, 0x9c,
+	0x71, 0x38, 0x93, 0x48, 0x21, 0xfe, 0x32, 0x88, 0xcb, 0x32, 0xcf, 0x2d, 0xd3, 0x80, 0x98, 0xde,
+	0x81, 0x88, 0x90, 0xe3, 0x77, 0xa1, 0x3a, 0x77, 0x3a, 0x0f, 0x38, 0x60, 0x69, 0x52, 0x99, 0xe1,
+	0x36, 0x06, 0xf8, 0x0a, 0xe4, 0x7d, 0x71, 0x16, 0xd6, 0x72, 0x73, 0x2b, 0x9e, 0x3f, 0x28, 0x49,
+	0xac, 0xc5, 0x72, 0x43, 0x40, 0x43, 0x1a, 0x1c, 0xd1, 0x01, 0x1b, 0x34, 0xcf, 0x07, 0x85, 0x98,
+	0xd5, 0x18, 0xa8, 0x3f, 0x0b, 0x4b, 0x09, 0xc4, 0xe1, 0xd8, 0xf7, 0x42, 0x8a, 0x37, 0x21, 0x17,
+	0xf0, 0xfd, 0x2e, 0x61, 0xc5, 0x72, 0x8e, 0x99, 0x4c, 0x40, 0xa4, 0x86, 0x3a, 0x80, 0x25, 0xc1,
+	0x61, 0xf9, 0x9b, 0x7b, 0x12, 0xbf, 0x0b, 0x59, 0xca, 0x1a, 0xa7, 0x9c, 0x42, 0x3a, 0x75, 0x2e,
+	0x27, 0x42, 0x3a, 0x33, 0x4b, 0xea, 0x99, 0xb3, 0xfc, 0x67, 0x0a, 0x56, 0xe4, 0x2a, 0x77, 0xec,
+	0xa8, 0x7f, 0xf0, 0x82, 0x46, 0xc3, 0x4f, 0x42, 0x9e, 0xf1, 0x9d, 0x64, 0xe7, 0x2c, 0x88, 0x87,
+	0x58, 0x83, 0x45, 0x84, 0x1d, 0x5a, 0x33, 0xee, 0x97, 0xd7, 0xc7, 0x8a, 0x1d, 0xce, 0xdc, 0x1a,
+	0x16, 0x04, 0x4e, 0xee, 0x19, 0x81, 0x93, 0x3f, 0x4f, 0xe0, 0xa8, 0xbb, 0xb0, 0x3a, 0x8f, 0xb8,
+	0x0c, 0x8e, 0xaf, 0x40, 0x5e, 0x38, 0x25, 0xce, 0x91, 0x8b, 0xfc, 0x16, 0xab, 0xa8, 0x27, 0x69,
+	0x58, 0x95, 0xe9, 0xeb, 0xf3, 0xb1, 0x8f, 0x67, 0x70, 0xce, 0x9e, 0x6b, 0x83, 0x9e, 0xd3, 0x7f,
+	0x37, 0xa1, 0x22, 0x30, 0xb5, 0x1e, 0xf9, 0xc1, 0xc8, 0x8e, 0xb8, 0x17, 0xab, 0xc9, 0x0f, 0x1d,
+	0x81, 0xfb, 0x1e, 0x17, 0x91, 0x72, 0x30, 0x43, 0xa9, 0x0f, 0x61, 0xed, 0x94, 0x07, 0x3e, 0xfd,
+	0x36, 0x67, 0x17, 0x0f, 0x3b, 0x08, 0xfc, 0x27, 0xf1, 0x2f, 0x46, 0x4e, 0xa8, 0xff, 0xa1, 0x40,
+	0x79, 0x87, 0x0e, 0x1d, 0xef, 0x05, 0xf5, 0xea, 0x8c, 0xb3, 0x32, 0xe7, 0xda, 0x14, 0x63, 0xa8,
+	0x48, 0x7b, 0x25, 0x86, 0x67, 0xbd, 0xa7, 0x2c, 0xf6, 0x5e, 0x59, 0x3e, 0x68, 0xd8, 0xae, 0x63,
+	0x87, 0x89, 0x3d, 0xa7, 0x5e, 0x34, 0x34, 0x26, 0x24, 0xa5, 0x68, 0x4a, 0xa8, 0xff, 0xaa, 0x40,
+	0xa5, 0xee, 0x8f, 0x46, 0x4e, 0xf4, 0x82, 0x62, 0x7c, 0x16, 0xa1, 0xcc, 0x02, 0x84, 0xd4, 0xf7,
+	0xa0, 0x1a, 0x9b, 0x29, 0xa1, 0x3d, 0x75, 0x72, 0x29, 0x67, 0x4e, 0xae, 0x7f, 0x53, 0x60, 0x89,
+	0xf8, 0xe2, 0x17, 0xc3, 0xcb, 0x0d, 0xce, 0x35, 0x40, 0x53, 0x43, 0xcf, 0x0b, 0xcf, 0xff, 0x28,
+	0x50, 0xed, 0x04, 0x74, 0x6c, 0x07, 0xf4, 0xa5, 0x46, 0x87, 0x5d, 0xfb, 0x07, 0x91, 0xbc, 0x30,
+	0x15, 0x09, 0x6f, 0xab, 0xcb, 0xb0, 0x94, 0xd8, 0x2e, 0x00, 0x53, 0xff, 0x41, 0x81, 0x35, 0x11,
+	0x62, 0x52, 0x32, 0x78, 0x41, 0x61, 0x89, 0xed, 0xcd, 0xcc, 0xd8, 0x5b, 0x83, 0x8b, 0xa7, 0x6d,
+	0x93, 0x66, 0x7f, 0x33, 0x05, 0x97, 0xe2, 0xe0, 0x79, 0xc1, 0x0d, 0xff, 0x11, 0xe2, 0x61, 0x1d,
+	0x6a, 0x67, 0x41, 0x90, 0x08, 0x7d, 0x27, 0x05, 0x35, 0xf1, 0x28, 0x34, 0x73, 0xaf, 0x7a, 0x79,
+	0x62, 0x03, 0xbf, 0x07, 0xe5, 0xb1, 0x1d, 0x44, 0x4e, 0xdf, 0x19, 0xdb, 0xec, 0xa7, 0x6d, 0x76,
+	0x23, 0x7d, 0x76, 0x80, 0x39, 0x15, 0xf5, 0x75, 0x78, 0x6d, 0x01, 0x22, 0x12, 0xaf, 0xff, 0x55,
+	0x00, 0x77, 0x23, 0x3b, 0x88, 0x3e, 0x07, 0xe7, 0xd2, 0xc2, 0x60, 0x5a, 0x83, 0x95, 0x39, 0xfb,
+	0x67, 0x71, 0xa1, 0xd1, 0xe7, 0xe2, 0x48, 0xfa, 0x44, 0x5c, 0x66, 0xed, 0x97, 0xb8, 0xfc, 0x93,
+	0x02, 0xeb, 0x75, 0x5f, 0x3c, 0xb0, 0xbe, 0x94, 0x3b, 0x4c, 0x7d, 0x13, 0x5e, 0x5f, 0x68, 0xa0,
+	0x04, 0xe0, 0x1f, 0x15, 0xb8, 0x48, 0xa8, 0x3d, 0x78, 0x39, 0x8d, 0xbf, 0x07, 0x97, 0xce, 0x18,
+	0x27, 0xef, 0x28, 0x37, 0xa0, 0x30, 0xa2, 0x91, 0x3d, 0xb0, 0x23, 0x5b, 0x9a, 0xb4, 0x1e, 0x8f,
+	0x3b, 0xd5, 0x6e, 0x49, 0x0d, 0x92, 0xe8, 0xaa, 0xff, 0x9c, 0x82, 0x15, 0x7e, 0xcf, 0x7e, 0xf5,
+	0xa3, 0xf1, 0x5c, 0xaf, 0x3a, 0xb9, 0xd3, 0x97, 0x3f, 0xa6, 0x30, 0x0e, 0xa8, 0x15, 0xbf, 0x36,
+	0xe4, 0xf9, 0xd7, 0x4c, 0x18, 0x07, 0xf4, 0x9e, 0xe0, 0xa8, 0x7f, 0xa3, 0xc0, 0xea, 0x3c, 0xc4,
+	0xc9, 0x2f, 0x9a, 0xff, 0xef, 0xd7, 0x9b, 0x05, 0x29, 0x25, 0x7d, 0x9e, 0x1f, 0x49, 0x99, 0x73,
+	0xff, 0x48, 0xfa, 0xdb, 0x14, 0xd4, 0x66, 0x8d, 0x79, 0xf5, 0x46, 0x34, 0xff, 0x46, 0xf4, 0x69,
+	0x5f, 0x0d, 0xd5, 0xbf, 0x53, 0xe0, 0xb5, 0x05, 0x80, 0x7e, 0xba, 0x10, 0x99, 0x79, 0x29, 0x4a,
+	0x3d, 0xf3, 0xa5, 0xe8, 0xb3, 0x0f, 0x92, 0xbf, 0x57, 0x60, 0xb5, 0x25, 0xde, 0xfe, 0xc5, 0x7b,
+	0xc8, 0x8b, 0x9b, 0x83, 0xf9, 0xf3, 0x7e, 0x66, 0xfa, 0xf5, 0x4b, 0xad, 0xc3, 0xda, 0x29, 0xd3,
+	0x9e, 0xe3, 0x29, 0xf7, 0xbf, 0x15, 0x58, 0x96, 0xa3, 0x68, 0xfd, 0xc3, 0x97, 0x07, 0x1d, 0xfc,
+	0x16, 0xa4, 0x9d, 0x41, 0x7c, 0xef, 0x9d, 0xaf, 0x6a, 0x60, 0x02, 0xf5, 0x16, 0xe0, 0x59, 0xbb,
+	0x9f, 0x03, 0xba, 0x7f, 0x4f, 0xc1, 0x1a, 0x11, 0xd9, 0xf7, 0xd5, 0xf7, 0x8a, 0x1f, 0xf5, 0x7b,
+	0xc5, 0xd3, 0x0f, 0xae, 0x1f, 0xf2, 0xcb, 0xd4, 0x3c, 0xd4, 0x9f, 0xdd, 0xd1, 0x75, 0xea, 0xa0,
+	0x4d, 0x9f, 0x39, 0x68, 0x9f, 0x3f, 0x1f, 0xfd, 0x30, 0x05, 0xeb, 0xd2, 0x90, 0x57, 0x77, 0x9d,
+	0xf3, 0x47, 0x44, 0xee, 0x4c, 0x44, 0xfc, 0x97, 0x02, 0xaf, 0x2f, 0x04, 0xf2, 0xc7, 0x7e, 0xa3,
+	0x39, 0x15, 0x3d, 0x99, 0x67, 0x46, 0x4f, 0xf6, 0xdc, 0xd1, 0xf3, 0xed, 0x14, 0x54, 0x09, 0x75,
+	0xa9, 0x1d, 0xbe, 0xe4, 0xaf, 0x7b, 0xa7, 0x30, 0xcc, 0x9e, 0x79, 0xe7, 0x5c, 0x86, 0xa5, 0x04,
+	0x08, 0xf9, 0x83, 0x8b, 0xff, 0x40, 0x67, 0xe7, 0xe0, 0x87, 0xd4, 0x76, 0xa3, 0xf8, 0x26, 0xa8,
+	0x7e, 0x37, 0x0d, 0x15, 0xc2, 0x38, 0xce, 0x88, 0xb2, 0xef, 0xe8, 0x21, 0xfe, 0x02, 0x94, 0x0f,
+	0xb8, 0x8a, 0x35, 0x8d, 0x90, 0x22, 0x29, 0x09, 0x9e, 0xf8, 0x9a, 0xb9, 0x0d, 0x6b, 0x21, 0xed,
+	0xfb, 0xde, 0x20, 0xb4, 0xf6, 0xe9, 0x01, 0x2b, 0x6c, 0x1b, 0xd9, 0x61, 0x44, 0x03, 0x0e, 0x4b,
+	0x85, 0xac, 0x48, 0xe1, 0x0e, 0x97, 0xb5, 0xb8, 0x08, 0x5f, 0x85, 0xd5, 0x7d, 0xc7, 0x73, 0xfd,
+	0x21, 0xab, 0x82, 0x3a, 0xa6, 0x41, 0x68, 0xf5, 0xfd, 0x89, 0x27, 0xf0, 0xc8, 0x12, 0x2c, 0x64,
+	0x1d, 0x21, 0xaa, 0x33, 0x09, 0xfe, 0x08, 0x36, 0x17, 0xce, 0x62, 0x3d, 0x72, 0xdc, 0x88, 0x06,
+	0x74, 0x60, 0x05, 0x74, 0xec, 0x3a, 0x7d, 0x51, 0xb1, 0x25, 0x80, 0xfa, 0xd2, 0x82, 0xa9, 0xf7,
+	0xa4, 0x3a, 0x99, 0x6a, 0xb3, 0x4a, 0x8b, 0xfe, 0x78, 0x62, 0x4d, 0x78, 0x11, 0x04, 0xc3, 0x4f,
+	0x21, 0x85, 0xfe, 0x78, 0xd2, 0x63, 0x34, 0xfb, 0x3a, 0xff, 0x78, 0x2c, 0x92, 0xb3, 0x42, 0x58,
+	0x13, 0xdf, 0x82, 0x37, 0x66, 0xfd, 0x32, 0xf6, 0x7d, 0xd7, 0x9a, 0x44, 0x8e, 0xeb, 0xfc, 0xa2,
+	0x98, 0x3c, 0xcf, 0x55, 0xd7, 0x67, 0x74, 0x3a, 0xbe, 0xef, 0xf6, 0xa6, 0x1a, 0xf8, 0x2b, 0x80,
+	0x59, 0xed, 0xa5, 0x35, 0x08, 0x6c, 0xc7, 0xb3, 0xc6, 0x34, 0xe8, 0x53, 0x4f, 0x94, 0xb9, 0x64,
+	0x09, 0x62, 0x92, 0x5d, 0x26, 0xe8, 0x08, 0x3e, 0xfb, 0x88, 0x54, 0xd5, 0x86, 0xc3, 0x80, 0x0e,
+	0xed, 0x48, 0xba, 0xe5, 0x2a, 0xac, 0x0a, 0x17, 0x1c, 0x5b, 0x72, 0x7b, 0x08, 0xfc, 0x14, 0x81,
+	0x9f, 0x94, 0x89, 0xbd, 0x21, 0xf0, 0xbb, 0x0e, 0x17, 0x27, 0xde, 0xc2, 0x3e, 0x29, 0xde, 0x67,
+	0x75, 0xe2, 0x2d, 0xe8, 0xf5, 0x33, 0xf0, 0xda, 0x62, 0xd4, 0x47, 0x8e, 0xa8, 0xd2, 0xac, 0x90,
+	0x8b, 0x0b, 0x40, 0x6e, 0x39, 0xde, 0x53, 0xba, 0xda, 0x1f, 0xd7, 0x32, 0x9f, 0xdc, 0xd5, 0xfe,
+	0x58, 0xfd, 0x46, 0xf2, 0x4d, 0x34, 0x0e, 0xcf, 0x24, 0x51, 0xc5, 0x1b, 0x47, 0x79, 0xda, 0xc6,
+	0xa9, 0x41, 0x9e, 0x05, 0xbf, 0xe3, 0x0d, 0xb9, 0x71, 0x05, 0x12, 0x93, 0xb8, 0x0b, 0x5f, 0x92,
+	0xb6, 0xd3, 0x8f, 0x23, 0x1a, 0x78, 0xb6, 0xeb, 0x1e, 0x5b, 0xe2, 0xb9, 0xd3, 0xe3, 0x05, 0x71,
+	0x49, 0xd5, 0xaa, 0x48, 0x57, 0xef, 0x08, 0x6d, 0x3d, 0x51, 0x26, 0x89, 0xae, 0x19, 0xab, 0xe2,
+	0xaf, 0x42, 0x35, 0x90, 0x9b, 0xc6, 0x0a, 0x99, 0x7b, 0x64, 0x8a, 0x5f, 0x4d, 0x3e, 0x3d, 0xce,
+	0xec, 0x28, 0x52, 0x09, 0x66, 0xc9, 0xe7, 0x4f, 0x70, 0x6c, 0xdf, 0xf1, 0xf1, 0x2d, 0x6e, 0x5c,
+	0x9f, 0x26, 0x05, 0x82, 0x79, 0xee, 0xd0, 0x15, 0x2e, 0xec, 0x0a, 0x59, 0x5c, 0x95, 0xa6, 0x42,
+	0xb9, 0x6f, 0x8f, 0xed, 0x7d, 0xc7, 0x75, 0x22, 0x76, 0x56, 0x14, 0xf8, 0x59, 0x31, 0xc7, 0xbb,
+	0x93, 0x29, 0xe4, 0x50, 0x5e, 0xfd, 0x0b, 0x05, 0x56, 0x16, 0xbc, 0x41, 0x24, 0x0f, 0x1c, 0xca,
+	0xcc, 0xfb, 0xe9, 0x4f, 0x41, 0x96, 0xd9, 0x1d, 0xd7, 0x96, 0x5d, 0x3a, 0xfb, 0x84, 0xc1, 0x6c,
+	0xa5, 0x44, 0x68, 0xb1, 0x9c, 0xc2, 0xb1, 0x92, 0x55, 0x88, 0x12, 0xea, 0x12, 0xe3, 0xc9, 0xd2,
+	0xc3, 0x33, 0x2f, 0xb2, 0x99, 0x67, 0xbe, 0xc8, 0x6e, 0xfe, 0x5e, 0x1a, 0x8a, 0xad, 0xe3, 0xee,
+	0x63, 0x77, 0xcf, 0xb5, 0x87, 0xbc, 0x6a, 0xa6, 0xd5, 0x31, 0x1f, 0xa2, 0x0b, 0xac, 0x54, 0xd1,
+	0x68, 0x9b, 0x96, 0xd1, 0x6b, 0x36, 0xad, 0xbd, 0xa6, 0x76, 0x1b, 0x29, 0xac, 0xe6, 0xaf, 0x43,
+	0x1a, 0xd6, 0x5d, 0xfd, 0xa1, 0xe0, 0xa4, 0x58, 0xb9, 0x5e, 0xcf, 0x68, 0xdc, 0xeb, 0xe9, 0x53,
+	0x66, 0x06, 0xaf, 0xc1, 0x72, 0xab, 0xd7, 0x34, 0x1b, 0x9d, 0xe6, 0x0c, 0xbb, 0xc0, 0x0a, 0x1d,
+	0x77, 0x9a, 0xed, 0x1d, 0x41, 0x22, 0x36, 0x7e, 0xcf, 0xe8, 0x36, 0x6e, 0x1b, 0xfa, 0xae, 0x60,
+	0x6d, 0x30, 0xd6, 0x47, 0x3a, 0x69, 0xef, 0x35, 0xe2, 0x29, 0x6f, 0x61, 0x04, 0xa5, 0x9d, 0x86,
+	0xa1, 0x11, 0x39, 0xca, 0x89, 0x82, 0xab, 0x50, 0xd4, 0x8d, 0x5e, 0x4b, 0xd2, 0x29, 0x5c, 0x83,
+	0x15, 0x56, 0x53, 0x68, 0x35, 0x8c, 0x3a, 0xd1, 0x5b, 0xac, 0xf4, 0x50, 0x48, 0x32, 0x78, 0x05,
+	0xaa, 0x66, 0xa3, 0xa5, 0x77, 0x4d, 0xad, 0xd5, 0x91, 0x4c, 0xb6, 0x8a, 0x42, 0x57, 0x8f, 0x75,
+	0x10, 0x5e, 0x87, 0x35, 0xa3, 0x6d, 0xc5, 0x25, 0x87, 0xf7, 0xb5, 0x66, 0x4f, 0x97, 0xb2, 0x0d,
+	0x7c, 0x09, 0x70, 0xdb, 0xb0, 0x7a, 0x9d, 0x5d, 0xcd, 0xd4, 0x2d, 0xa3, 0xfd, 0x40, 0x0a, 0x6e,
+	0xe1, 0x2a, 0x14, 0xa6, 0x2b, 0x38, 0x61, 0x28, 0x54, 0x3a, 0x1a, 0x31, 0xa7, 0xc6, 0x9e, 0x9c,
+	0x30, 0xb0, 0xe0, 0x36, 0x69, 0xf7, 0x3a, 0x53, 0xb5, 0x65, 0x28, 0x49, 0xb0, 0x24, 0x2b, 0xc3,
+	0x58, 0x3b, 0x0d, 0xa3, 0x9e, 0xac, 0xef, 0xa4, 0xb0, 0x9e, 0x42, 0xca, 0xe6, 0x21, 0x64, 0xb8,
+	0x3b, 0x0a, 0x90, 0x31, 0xda, 0x06, 0xab, 0x12, 0x5d, 0x02, 0x68, 0x74, 0x1b, 0x86, 0xa9, 0xdf,
+	0x26, 0x5a, 0x93, 0x99, 0xcd, 0x19, 0x31, 0x80, 0xcc, 0xda, 0x32, 0xe4, 0x1b, 0xdd, 0xbd, 0x66,
+	0x5b, 0x33, 0xa5, 0x99, 0x8d, 0xee, 0xbd, 0x5e, 0x9b, 0x15, 0x6b, 0x9e, 0x20, 0x5c, 0x82, 0x1c,
+	0xab, 0xcb, 0xfc, 0xba, 0xc9, 0xec, 0xe2, 0x32, 0x81, 0x2a, 0x3a, 0xb9, 0xb5, 0xf9, 0xbd, 0x34,
+	0x64, 0x78, 0x99, 0x7b, 0x05, 0x8a, 0xdc, 0xdb, 0xac, 0x1c, 0x15, 0x5d, 0xc0, 0x45, 0xc8, 0x34,
+	0x0c, 0xf3, 0x26, 0xfa, 0x46, 0x0a, 0x03, 0x64, 0x7b, 0xbc, 0xfd, 0x4b, 0x39, 0xd6, 0x6e, 0x18,
+	0xe6, 0x7b, 0x37, 0xd0, 0x37, 0x53, 0x6c, 0xd8, 0x9e, 0x20, 0x7e, 0x39, 0x16, 0x6c, 0x5f, 0x47,
+	0xdf, 0x4a, 0x04, 0xdb, 0xd7, 0xd1, 0xaf, 0xc4, 0x82, 0x6b, 0xdb, 0xe8, 0xdb, 0x89, 0xe0, 0xda,
+	0x36, 0xfa, 0xd5, 0x58, 0x70, 0xe3, 0x3a, 0xfa, 0xb5, 0x44, 0x70, 0xe3, 0x3a, 0xfa, 0xf5, 0x1c,
+	0xb3, 0x85, 0x5b, 0x72, 0x6d, 0x1b, 0xfd, 0x46, 0x21, 0xa1, 0x6e, 0x5c, 0x47, 0xbf, 0x59, 0x60,
+	0xfe, 0x4f, 0xbc, 0x8a, 0x7e, 0x0b, 0xb1, 0x65, 0x32, 0x07, 0xa1, 0xdf, 0xe6, 0x4d, 0x26, 0x42,
+	0xbf, 0x83, 0x98, 0x8d, 0x8c, 0xcb, 0xc9, 0xef, 0x70, 0xc9, 0x43, 0x5d, 0x23, 0xe8, 0x77, 0x73,
+	0xa2, 0x08, 0xb6, 0xde, 0x68, 0x69, 0x4d, 0x84, 0x79, 0x0f, 0x86, 0xca, 0x77, 0xaf, 0xb2, 0x26,
+	0x0b, 0x4f, 0xf4, 0xfb, 0x1d, 0x36, 0xe1, 0x7d, 0x8d, 0xd4, 0x3f, 0xd4, 0x08, 0xfa, 0x83, 0xab,
+	0x6c, 0xc2, 0xfb, 0x1a, 0x91, 0x78, 0xfd, 0x61, 0x87, 0x29, 0x72, 0xd1, 0x1f, 0x5d, 0x65, 0x8b,
+	0x96, 0xfc, 0x3f, 0xee, 0xe0, 0x02, 0xa4, 0x77, 0x1a, 0x26, 0xfa, 0x1e, 0x9f, 0x8d, 0x85, 0x28,
+	0xfa, 0x13, 0xc4, 0x98, 0x5d, 0xdd, 0x44, 0xdf, 0x67, 0xcc, 0xac, 0xd9, 0xeb, 0x34, 0x75, 0xf4,
+	0x06, 0x5b, 0xdc, 0x6d, 0xbd, 0xdd, 0xd2, 0x4d, 0xf2, 0x10, 0xfd, 0x29, 0x57, 0xbf, 0xd3, 0x6d,
+	0x1b, 0xe8, 0x07, 0x88, 0xd5, 0xb5, 0xea, 0x5f, 0xef, 0x10, 0xbd, 0xdb, 0x6d, 0xb4, 0x0d, 0xf4,
+	0xf6, 0xe6, 0x1e, 0xa0, 0xd3, 0xe9, 0x80, 0x19, 0xd0, 0x33, 0xee, 0x1a, 0xed, 0x07, 0x06, 0xba,
+	0xc0, 0x88, 0x0e, 0xd1, 0x3b, 0x1a, 0xd1, 0x91, 0x82, 0x01, 0x72, 0xb2, 0xb4, 0x36, 0x85, 0xcb,
+	0x50, 0x20, 0xed, 0x66, 0x73, 0x47, 0xab, 0xdf, 0x45, 0xe9, 0xcd, 0x77, 0xa0, 0x3c, 0x5b, 0xc9,
+	0xc1, 0x02, 0x8b, 0xb4, 0x1f, 0x74, 0xb9, 0x97, 0xb3, 0x1a, 0x21, 0xed, 0x07, 0x48, 0xd9, 0x79,
+	0x1f, 0x96, 0x1c, 0x7f, 0xeb, 0xc8, 0x89, 0x68, 0x18, 0x8a, 0x7f, 0x5b, 0x7c, 0xa4, 0x4a, 0xca,
+	0xf1, 0xaf, 0x88, 0xd6, 0x95, 0xa1, 0x7f, 0xe5, 0x28, 0xba, 0xc2, 0xa5, 0x57, 0x78, 0x5a, 0xd9,
+	0xcf, 0x71, 0xe2, 0xda, 0xff, 0x0d, 0x00, 0xf3, 0xb2, 0x32, 0x4a, 0xcb, 0x31, 0x00, 0x00,
 }
@@ -30,45 +30,46 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x85, 0x43, 0x1b, 0xb4, 0x09, 0xa1, 0x6c, 0x29, 0x50, 0x27, 0xa4, 0x4d, 0x6e, 0x08, 0x29,
-	0x41, 0x80, 0x84, 0x54, 0x89, 0x43, 0x13, 0x51, 0x81, 0x10, 0x5f, 0x2e, 0x54, 0x08, 0x24, 0xa4,
-	0x8d, 0x33, 0x0a, 0x56, 0x1d, 0x6f, 0xea, 0x5d, 0xa7, 0xf0, 0xdb, 0xb9, 0x54, 0xb1, 0x3d, 0xe3,
-	0xdd, 0x8d, 0x9d, 0x5b, 0xe7, 0xbd, 0x99, 0xd7, 0xc9, 0x8c, 0xe7, 0x2d, 0xe3, 0x57, 0x29, 0x24,
-	0xff, 0x14, 0x24, 0xab, 0x30, 0x80, 0xe1, 0x32, 0x91, 0x5a, 0xf2, 0x96, 0x89, 0x79, 0xcd, 0x2c,
-	0xca, 0x29, 0x6f, 0x6f, 0x1a, 0xc6, 0x91, 0x9c, 0xcf, 0x84, 0x16, 0x39, 0xf2, 0xe2, 0x7f, 0x9b,
-	0xed, 0x7c, 0x5d, 0x67, 0xf0, 0x13, 0xd6, 0x78, 0xfb, 0x17, 0x82, 0x54, 0x03, 0x3f, 0x18, 0xe6,
-	0x45, 0x45, 0xec, 0xc3, 0x55, 0x0a, 0x4a, 0x7b, 0x0f, 0x5d, 0x58, 0x2d, 0x65, 0xac, 0x60, 0x70,
-	0x8b, 0xbf, 0x67, 0xad, 0x02, 0x1c, 0x0b, 0x1d, 0xfc, 0xe1, 0x9e, 0x9d, 0x99, 0x81, 0xa8, 0xd2,
-	0xa9, 0xe4, 0x48, 0xea, 0x13, 0xbb, 0x7b, 0xae, 0x13, 0x10, 0x0b, 0x6c, 0x06, 0xf3, 0x2d, 0x14,
-	0xc5, 0xba, 0xd5, 0x24, 0xaa, 0x3d, 0xbf, 0xcd, 0x5f, 0xb1, 0x9d, 0x31, 0xcc, 0xc3, 0x98, 0xef,
-	0x17, 0xa9, 0x59, 0x84, 0xf5, 0x0f, 0x6c, 0x90, 0xba, 0x78, 0xcd, 0x76, 0x27, 0x72, 0xb1, 0x08,
-	0x35, 0xc7, 0x8c, 0x3c, 0xc4, 0xba, 0x03, 0x07, 0xa5, 0xc2, 0x37, 0xec, 0x8e, 0x2f, 0xa3, 0x68,
-	0x2a, 0x82, 0x4b, 0x8e, 0xf3, 0x42, 0x00, 0x8b, 0x1f, 0x6d, 0xe0, 0x54, 0x7e, 0xc2, 0x1a, 0x5f,
-	0x12, 0x58, 0x8a, 0xa4, 0x5c, 0x42, 0x11, 0xbb, 0x4b, 0x20, 0x98, 0x6a, 0x3f, 0xb3, 0x76, 0xde,
-	0x4e, 0x41, 0xcd, 0x78, 0xd7, 0xea, 0x12, 0x61, 0x54, 0x7a, 0x52, 0xc3, 0x92, 0xe0, 0x77, 0xb6,
-	0x87, 0x2d, 0x92, 0x64, 0xcf, 0xe9, 0xdd, 0x15, 0x3d, 0xaa, 0xe5, 0x49, 0xf6, 0x07, 0xbb, 0x3f,
-	0x49, 0x40, 0x68, 0xf8, 0x96, 0x88, 0x58, 0x89, 0x40, 0x87, 0x32, 0xe6, 0x58, 0xb7, 0xc1, 0xa0,
-	0xf0, 0x71, 0x7d, 0x02, 0x29, 0x9f, 0xb1, 0xe6, 0xb9, 0x16, 0x89, 0x2e, 0x56, 0x77, 0x48, 0x1f,
-	0x07, 0x61, 0xa8, 0xe6, 0x55, 0x51, 0x96, 0x0e, 0x68, 0xda, 0x23, 0xe9, 0x94, 0xd8, 0x86, 0x8e,
-	0x49, 0x91, 0xce, 0x6f, 0xb6, 0x3f, 0x91, 0x71, 0x10, 0xa5, 0x33, 0xeb, 0xb7, 0xf6, 0x69, 0xf0,
-	0x1b, 0x1c, 0xea, 0x0e, 0xb6, 0xa5, 0x90, 0xbe, 0xcf, 0xee, 0xf9, 0x20, 0x66, 0xa6, 0x36, 0x2e,
-	0xd5, 0xc1, 0x51, 0xb7, 0x57, 0x47, 0x9b, 0xa7, 0x9c, 0x1d, 0x03, 0x9e, 0x9f, 0x67, 0x5e, 0x88,
-	0x73, 0x7d, 0x9d, 0x4a, 0xce, 0x5c, 0xb4, 0xc9, 0xe4, 0xd6, 0x70, 0x54, 0x51, 0x63, 0xf9, 0xc3,
-	0x71, 0x7d, 0x82, 0x69, 0x12, 0x1f, 0x41, 0x29, 0x31, 0x87, 0xfc, 0xf0, 0xc9, 0x24, 0x2c, 0xd4,
-	0x35, 0x09, 0x87, 0x34, 0x4c, 0x62, 0xc2, 0x58, 0x41, 0x9e, 0x06, 0x97, 0xfc, 0xb1, 0x9d, 0x7f,
-	0x5a, 0xae, 0xfb, 0xb0, 0x82, 0x31, 0xef, 0xcf, 0x87, 0xb5, 0xed, 0x02, 0xce, 0xae, 0x4b, 0xd3,
-	0x36, 0x61, 0xf7, 0xfe, 0x5c, 0xd6, 0xfc, 0x7c, 0x0a, 0xce, 0xda, 0x48, 0xdf, 0xae, 0xab, 0x5a,
-	0xcc, 0x60, 0x5b, 0x8a, 0x69, 0x36, 0x3e, 0x44, 0x20, 0x54, 0x69, 0x36, 0x45, 0xec, 0x9a, 0x0d,
-	0xc1, 0x54, 0xfb, 0x81, 0xb5, 0xf2, 0x39, 0xbe, 0x03, 0x11, 0xe9, 0xd2, 0xf1, 0x4d, 0xd0, 0xfd,
-	0x4c, 0x6c, 0xce, 0x18, 0xff, 0x19, 0x6b, 0x5c, 0x14, 0x8b, 0xf4, 0x86, 0xc6, 0x13, 0x75, 0x61,
-	0xef, 0xb1, 0x53, 0xc9, 0x19, 0x3a, 0x3e, 0x6b, 0x22, 0x2c, 0xaf, 0x15, 0xef, 0x55, 0xe5, 0xcb,
-	0x6b, 0x55, 0x7a, 0x55, 0x1d, 0x6f, 0x68, 0xfe, 0x62, 0xed, 0xf2, 0x5f, 0xa5, 0x91, 0x56, 0xbc,
-	0x5f, 0xdd, 0xc6, 0x9a, 0x2b, 0xe7, 0xbf, 0x25, 0xa5, 0x14, 0x1f, 0x3f, 0xfb, 0xf9, 0x74, 0x15,
-	0x6a, 0x50, 0x6a, 0x18, 0xca, 0x51, 0xfe, 0xd7, 0x68, 0x2e, 0x47, 0x2b, 0x3d, 0xca, 0x5e, 0xe7,
-	0x91, 0xf9, 0x92, 0x4f, 0x77, 0x33, 0xec, 0xe5, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x30,
-	0x29, 0x02, 0xf4, 0x07, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x85, 0x43, 0x5b, 0xb4, 0x09, 0x6d, 0xd9, 0x52, 0xa0, 0x4e, 0x48, 0x9b, 0xdc, 0x10, 0x52,
+	0x82, 0x00, 0x09, 0xa9, 0x12, 0x87, 0x26, 0x6a, 0x05, 0x42, 0x7c, 0xb9, 0xa5, 0x42, 0x20, 0x21,
+	0x6d, 0x9c, 0x51, 0xb0, 0xea, 0x78, 0x53, 0xef, 0x3a, 0x2d, 0xbf, 0x92, 0xbf, 0x84, 0x62, 0x7b,
+	0xc6, 0xbb, 0x1b, 0x3b, 0x12, 0xb7, 0xcc, 0x7b, 0x33, 0x2f, 0x93, 0x19, 0xcf, 0x73, 0x18, 0xbf,
+	0x4e, 0x21, 0xf9, 0xa3, 0x20, 0x59, 0x84, 0x01, 0xf4, 0xe7, 0x89, 0xd4, 0x92, 0x37, 0x4d, 0xcc,
+	0x6b, 0x64, 0x51, 0x4e, 0x79, 0xbb, 0xe3, 0x30, 0x8e, 0xe4, 0x74, 0x22, 0xb4, 0xc8, 0x91, 0x97,
+	0x7f, 0x77, 0xd8, 0xc6, 0xd7, 0x65, 0x06, 0x3f, 0x66, 0x5b, 0xa7, 0xb7, 0x10, 0xa4, 0x1a, 0xf8,
+	0x7e, 0x3f, 0x2f, 0x2a, 0x62, 0x1f, 0xae, 0x53, 0x50, 0xda, 0x7b, 0xe4, 0xc2, 0x6a, 0x2e, 0x63,
+	0x05, 0xbd, 0x3b, 0xfc, 0x3d, 0x6b, 0x16, 0xe0, 0x50, 0xe8, 0xe0, 0x37, 0xf7, 0xec, 0xcc, 0x0c,
+	0x44, 0x95, 0x56, 0x25, 0x47, 0x52, 0x9f, 0xd8, 0xfd, 0x73, 0x9d, 0x80, 0x98, 0x61, 0x33, 0x98,
+	0x6f, 0xa1, 0x28, 0xd6, 0xae, 0x26, 0x51, 0xed, 0xc5, 0x5d, 0xfe, 0x9a, 0x6d, 0x0c, 0x61, 0x1a,
+	0xc6, 0x7c, 0xaf, 0x48, 0xcd, 0x22, 0xac, 0x7f, 0x68, 0x83, 0xd4, 0xc5, 0x1b, 0xb6, 0x39, 0x92,
+	0xb3, 0x59, 0xa8, 0x39, 0x66, 0xe4, 0x21, 0xd6, 0xed, 0x3b, 0x28, 0x15, 0xbe, 0x65, 0xf7, 0x7c,
+	0x19, 0x45, 0x63, 0x11, 0x5c, 0x71, 0x9c, 0x17, 0x02, 0x58, 0xfc, 0x78, 0x05, 0xa7, 0xf2, 0x63,
+	0xb6, 0xf5, 0x25, 0x81, 0xb9, 0x48, 0xca, 0x25, 0x14, 0xb1, 0xbb, 0x04, 0x82, 0xa9, 0xf6, 0x33,
+	0xdb, 0xce, 0xdb, 0x29, 0xa8, 0x09, 0x6f, 0x5b, 0x5d, 0x22, 0x8c, 0x4a, 0x4f, 0x6b, 0x58, 0x12,
+	0xfc, 0xc6, 0x76, 0xb1, 0x45, 0x92, 0xec, 0x38, 0xbd, 0xbb, 0xa2, 0x87, 0xb5, 0x3c, 0xc9, 0x7e,
+	0x67, 0x0f, 0x46, 0x09, 0x08, 0x0d, 0x17, 0x89, 0x88, 0x95, 0x08, 0x74, 0x28, 0x63, 0x8e, 0x75,
+	0x2b, 0x0c, 0x0a, 0x1f, 0xd5, 0x27, 0x90, 0xf2, 0x19, 0x6b, 0x9c, 0x6b, 0x91, 0xe8, 0x62, 0x75,
+	0x07, 0xf4, 0x70, 0x10, 0x86, 0x6a, 0x5e, 0x15, 0x65, 0xe9, 0x80, 0xa6, 0x3d, 0x92, 0x4e, 0x89,
+	0xad, 0xe8, 0x98, 0x14, 0xe9, 0xfc, 0x62, 0x7b, 0x23, 0x19, 0x07, 0x51, 0x3a, 0xb1, 0x7e, 0x6b,
+	0x97, 0x06, 0xbf, 0xc2, 0xa1, 0x6e, 0x6f, 0x5d, 0x0a, 0xe9, 0xfb, 0x6c, 0xc7, 0x07, 0x31, 0x31,
+	0xb5, 0x71, 0xa9, 0x0e, 0x8e, 0xba, 0x9d, 0x3a, 0xda, 0x3c, 0xe5, 0xec, 0x18, 0xf0, 0xfc, 0x3c,
+	0xf3, 0x42, 0x9c, 0xeb, 0x6b, 0x55, 0x72, 0xe6, 0xa2, 0x4d, 0x26, 0xb7, 0x86, 0xc3, 0x8a, 0x1a,
+	0xcb, 0x1f, 0x8e, 0xea, 0x13, 0x4c, 0x93, 0xf8, 0x08, 0x4a, 0x89, 0x29, 0xe4, 0x87, 0x4f, 0x26,
+	0x61, 0xa1, 0xae, 0x49, 0x38, 0xa4, 0x61, 0x12, 0x23, 0xc6, 0x0a, 0xf2, 0x24, 0xb8, 0xe2, 0x4f,
+	0xec, 0xfc, 0x93, 0x72, 0xdd, 0x07, 0x15, 0x8c, 0x79, 0x7f, 0x3e, 0x2c, 0x6d, 0x17, 0x70, 0x76,
+	0x6d, 0x9a, 0xb6, 0x09, 0xbb, 0xf7, 0xe7, 0xb2, 0xe6, 0xe3, 0x53, 0x70, 0xd6, 0x46, 0xba, 0x76,
+	0x5d, 0xd5, 0x62, 0x7a, 0xeb, 0x52, 0x4c, 0xb3, 0xf1, 0x21, 0x02, 0xa1, 0x4a, 0xb3, 0x29, 0x62,
+	0xd7, 0x6c, 0x08, 0xa6, 0xda, 0x0f, 0xac, 0x99, 0xcf, 0xf1, 0x1d, 0x88, 0x48, 0x97, 0x8e, 0x6f,
+	0x82, 0xee, 0x63, 0x62, 0x73, 0xc6, 0xf8, 0xcf, 0xd8, 0xd6, 0x65, 0xb1, 0x48, 0xaf, 0x6f, 0xbc,
+	0xa2, 0x2e, 0xed, 0x3d, 0xb6, 0x2a, 0x39, 0x43, 0xc7, 0x67, 0x0d, 0x84, 0xe5, 0x8d, 0xe2, 0x9d,
+	0xaa, 0x7c, 0x79, 0xa3, 0x4a, 0xaf, 0xaa, 0xe3, 0x0d, 0xcd, 0x9f, 0x6c, 0xbb, 0xfc, 0xaa, 0x34,
+	0xd2, 0x8a, 0x77, 0xab, 0xdb, 0x58, 0x72, 0xe5, 0xfc, 0xd7, 0xa4, 0xd8, 0x0d, 0x9f, 0xde, 0xce,
+	0x65, 0xa2, 0x2f, 0xc4, 0x38, 0x02, 0xbb, 0x61, 0x83, 0xf8, 0x9f, 0x86, 0x87, 0xcf, 0x7f, 0x3c,
+	0x5b, 0x84, 0x1a, 0x94, 0xea, 0x87, 0x72, 0x90, 0x7f, 0x1a, 0x4c, 0xe5, 0x60, 0xa1, 0x07, 0xd9,
+	0x1b, 0x7f, 0x60, 0xfe, 0x3b, 0x18, 0x6f, 0x66, 0xd8, 0xab, 0x7f, 0x03, 0x00, 0xf7, 0xb3, 0x94,
+	0x89, 0x48, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -136,6 +137,9 @@ type QueryClient interface {
 	VStreamRows(ctx context.Context, in *binlogdata.VStreamRowsRequest, opts ...grpc.CallOption) (Query_VStreamRowsClient, error)
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, in *binlogdata.VStreamResultsRequest, opts ...grpc.CallOption) (Query_VStreamResultsClient, error)
+	// ExportTable streams the rows of a table, or of a primary key range of it,
+	// from a consistent snapshot.
+	ExportTable(ctx context.Context, in *binlogdata.ExportTableRequest, opts ...grpc.CallOption) (Query_ExportTableClient, error)
 }
 
 type queryClient struct {
@@ -509,6 +513,38 @@ func (x *queryVStreamResultsClient) Recv() (*binlogdata.VStreamResultsResponse,
 	return m, nil
 }
 
+func (c *queryClient) ExportTable(ctx context.Context, in *binlogdata.ExportTableRequest, opts ...grpc.CallOption) (Query_ExportTableClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[6], "/queryservice.Query/ExportTable", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryExportTableClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ExportTableClient interface {
+	Recv() (*binlogdata.VStreamRowsResponse, error)
+	grpc.ClientStream
+}
+
+type queryExportTableClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryExportTableClient) Recv() (*binlogdata.VStreamRowsResponse, error) {
+	m := new(binlogdata.VStreamRowsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Execute executes the specified SQL query (might be in a
@@ -564,6 +600,9 @@ type QueryServer interface {
 	VStreamRows(*binlogdata.VStreamRowsRequest, Query_VStreamRowsServer) error
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(*binlogdata.VStreamResultsRequest, Query_VStreamResultsServer) error
+	// ExportTable streams the rows of a table, or of a primary key range of it,
+	// from a consistent snapshot.
+	ExportTable(*binlogdata.ExportTableRequest, Query_ExportTableServer) error
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -645,6 +684,9 @@ func (*UnimplementedQueryServer) VStreamRows(req *binlogdata.VStreamRowsRequest,
 func (*UnimplementedQueryServer) VStreamResults(req *binlogdata.VStreamResultsRequest, srv Query_VStreamResultsServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStreamResults not implemented")
 }
+func (*UnimplementedQueryServer) ExportTable(req *binlogdata.ExportTableRequest, srv Query_ExportTableServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTable not implemented")
+}
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1118,6 +1160,27 @@ func (x *queryVStreamResultsServer) Send(m *binlogdata.VStreamResultsResponse) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_ExportTable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(binlogdata.ExportTableRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ExportTable(m, &queryExportTableServer{stream})
+}
+
+type Query_ExportTableServer interface {
+	Send(*binlogdata.VStreamRowsResponse) error
+	grpc.ServerStream
+}
+
+type queryExportTableServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryExportTableServer) Send(m *binlogdata.VStreamRowsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "queryservice.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1230,6 +1293,11 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_VStreamResults_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportTable",
+			Handler:       _Query_ExportTable_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "queryservice.proto",
 }
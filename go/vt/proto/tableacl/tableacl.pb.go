@@ -95,10 +95,15 @@ func (m *TableGroupSpec) GetAdmins() []string {
 }
 
 type Config struct {
-	TableGroups          []*TableGroupSpec `protobuf:"bytes,1,rep,name=table_groups,json=tableGroups,proto3" json:"table_groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	TableGroups []*TableGroupSpec `protobuf:"bytes,1,rep,name=table_groups,json=tableGroups,proto3" json:"table_groups,omitempty"`
+	// admins are exempt from all the table ACL checks.
+	Admins []string `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`
+	// dry_run makes the tablets log and count the table ACL violations
+	// instead of rejecting the queries.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -133,6 +138,20 @@ func (m *Config) GetTableGroups() []*TableGroupSpec {
 	return nil
 }
 
+func (m *Config) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *Config) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*TableGroupSpec)(nil), "tableacl.TableGroupSpec")
 	proto.RegisterType((*Config)(nil), "tableacl.Config")
@@ -141,20 +160,21 @@ func init() {
 func init() { proto.RegisterFile("tableacl.proto", fileDescriptor_7d0bedb248a1632e) }
 
 var fileDescriptor_7d0bedb248a1632e = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x89, 0x9d, 0xd5, 0xbd, 0xc9, 0x0e, 0x41, 0x34, 0xc7, 0x32, 0x10, 0x7b, 0x6a, 0x40,
-	0xf1, 0xe4, 0x4d, 0x11, 0x6f, 0x2a, 0xd5, 0x93, 0x97, 0x92, 0x6d, 0x6f, 0x25, 0xb0, 0x35, 0xe1,
-	0xbd, 0x38, 0xfd, 0x8f, 0xfc, 0x37, 0x25, 0x69, 0x3b, 0xf0, 0xf6, 0xfd, 0xf8, 0x25, 0xe1, 0xfb,
-	0x02, 0xf3, 0x60, 0x96, 0x5b, 0x34, 0xab, 0x6d, 0xe5, 0xc9, 0x05, 0x27, 0x4f, 0x47, 0x5e, 0xfc,
-	0x0a, 0x98, 0x7f, 0x44, 0x78, 0x26, 0xf7, 0xe5, 0xdf, 0x3d, 0xae, 0xa4, 0x84, 0x49, 0x67, 0x76,
-	0xa8, 0x44, 0x21, 0xca, 0x69, 0x9d, 0xb2, 0xbc, 0x83, 0xcb, 0x74, 0xa5, 0x89, 0xc4, 0x8d, 0xa3,
-	0xc6, 0x13, 0x6e, 0xec, 0x0f, 0xb2, 0x3a, 0x2a, 0xb2, 0x72, 0x5a, 0x9f, 0x27, 0xfd, 0x12, 0xed,
-	0x2b, 0xbd, 0x0d, 0x4e, 0x2a, 0x38, 0x21, 0x34, 0x6b, 0x24, 0x56, 0x59, 0x3a, 0x36, 0x62, 0x34,
-	0xdf, 0x64, 0x43, 0x34, 0x93, 0xde, 0x0c, 0x28, 0x2f, 0x20, 0x37, 0xeb, 0x9d, 0xed, 0x58, 0x1d,
-	0x27, 0x31, 0xd0, 0xe2, 0x09, 0xf2, 0x47, 0xd7, 0x6d, 0x6c, 0x2b, 0xef, 0xe1, 0xac, 0x2f, 0xd3,
-	0xc6, 0xce, 0xac, 0x44, 0x91, 0x95, 0xb3, 0x1b, 0x55, 0x1d, 0x46, 0xfe, 0x1f, 0x54, 0xcf, 0xc2,
-	0x81, 0xf9, 0xe1, 0xfa, 0xf3, 0x6a, 0x6f, 0x03, 0x32, 0x57, 0xd6, 0xe9, 0x3e, 0xe9, 0xd6, 0xe9,
-	0x7d, 0xd0, 0xe9, 0x6b, 0xf4, 0xf8, 0xc8, 0x32, 0x4f, 0x7c, 0xfb, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x09, 0x82, 0xf5, 0x82, 0x3c, 0x01, 0x00, 0x00,
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0xc7, 0xc9, 0x3a, 0xbb, 0xed, 0x4d, 0x76, 0x08, 0xe2, 0x72, 0x2c, 0x03, 0xb1, 0xa7, 0x05,
+	0x14, 0x4f, 0xde, 0xf4, 0xe0, 0x4d, 0x25, 0x7a, 0xf2, 0x52, 0xb2, 0x35, 0x2b, 0x81, 0x2d, 0x29,
+	0x2f, 0x69, 0x75, 0x9f, 0xc8, 0xaf, 0x29, 0x49, 0xdb, 0x31, 0x6f, 0xef, 0xc7, 0x2f, 0x79, 0xf9,
+	0xff, 0x03, 0x0b, 0x2f, 0x37, 0x7b, 0x25, 0xb7, 0xfb, 0x75, 0x8d, 0xd6, 0x5b, 0x3a, 0x1d, 0x78,
+	0xf5, 0x4b, 0x60, 0xf1, 0x19, 0xe0, 0x05, 0x6d, 0x53, 0x7f, 0xd4, 0x6a, 0x4b, 0x29, 0x8c, 0x8d,
+	0x3c, 0x28, 0x46, 0x32, 0x92, 0xcf, 0x44, 0x9c, 0xe9, 0x03, 0x2c, 0xe3, 0x95, 0x22, 0x90, 0x2b,
+	0x2c, 0x16, 0x35, 0xaa, 0x9d, 0xfe, 0x51, 0x8e, 0x8d, 0xb2, 0x24, 0x9f, 0x89, 0xab, 0xa8, 0x5f,
+	0x83, 0x7d, 0xc3, 0xf7, 0xde, 0x51, 0x06, 0x13, 0x54, 0xb2, 0x54, 0xe8, 0x58, 0x12, 0x8f, 0x0d,
+	0x18, 0xcc, 0x37, 0x6a, 0x1f, 0xcc, 0xb8, 0x33, 0x3d, 0xd2, 0x6b, 0x48, 0x65, 0x79, 0xd0, 0xc6,
+	0xb1, 0x8b, 0x28, 0x7a, 0x5a, 0xb5, 0x90, 0x3e, 0x5b, 0xb3, 0xd3, 0x15, 0x7d, 0x84, 0xcb, 0x2e,
+	0x4c, 0x15, 0x32, 0x3b, 0x46, 0xb2, 0x24, 0x9f, 0xdf, 0xb1, 0xf5, 0xa9, 0xe4, 0xff, 0x42, 0x62,
+	0xee, 0x4f, 0x7c, 0xbe, 0x7e, 0x74, 0xbe, 0x9e, 0x2e, 0x61, 0x52, 0xe2, 0xb1, 0xc0, 0xc6, 0xb0,
+	0x24, 0x23, 0xf9, 0x54, 0xa4, 0x25, 0x1e, 0x45, 0x63, 0x9e, 0x6e, 0xbf, 0x6e, 0x5a, 0xed, 0x95,
+	0x73, 0x6b, 0x6d, 0x79, 0x37, 0xf1, 0xca, 0xf2, 0xd6, 0xf3, 0xf8, 0x97, 0x7c, 0x78, 0x75, 0x93,
+	0x46, 0xbe, 0xff, 0x1b, 0x00, 0x31, 0xe7, 0xd4, 0x16, 0x6d, 0x01, 0x00, 0x00,
 }
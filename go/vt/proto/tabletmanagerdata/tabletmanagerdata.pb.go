@@ -42,10 +42,15 @@ type TableDefinition struct {
 	RowCount uint64 `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
 	// column names along with their types.
 	// NOTE: this is a superset of columns.
-	Fields               []*query.Field `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Fields []*query.Field `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+	// the partitions of a partitioned table, in their order.
+	Partitions []string `protobuf:"bytes,9,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// the partitioning method and expression of a partitioned table,
+	// e.g. "RANGE (`id`)".
+	PartitionExpression  string   `protobuf:"bytes,10,opt,name=partition_expression,json=partitionExpression,proto3" json:"partition_expression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TableDefinition) Reset()         { *m = TableDefinition{} }
@@ -129,6 +134,20 @@ func (m *TableDefinition) GetFields() []*query.Field {
 	return nil
 }
 
+func (m *TableDefinition) GetPartitions() []string {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *TableDefinition) GetPartitionExpression() string {
+	if m != nil {
+		return m.PartitionExpression
+	}
+	return ""
+}
+
 type SchemaDefinition struct {
 	DatabaseSchema       string             `protobuf:"bytes,1,opt,name=database_schema,json=databaseSchema,proto3" json:"database_schema,omitempty"`
 	TableDefinitions     []*TableDefinition `protobuf:"bytes,2,rep,name=table_definitions,json=tableDefinitions,proto3" json:"table_definitions,omitempty"`
@@ -673,9 +692,13 @@ func (m *ExecuteHookResponse) GetStderr() string {
 }
 
 type GetSchemaRequest struct {
-	Tables               []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
-	IncludeViews         bool     `protobuf:"varint,2,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
-	ExcludeTables        []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	Tables        []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	IncludeViews  bool     `protobuf:"varint,2,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	ExcludeTables []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	// position, if set, requests the schema tracked by the tablet
+	// as of this replication position, instead of the current one.
+	// It requires -track_schema_versions.
+	Position             string   `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -727,11 +750,21 @@ func (m *GetSchemaRequest) GetExcludeTables() []string {
 	return nil
 }
 
+func (m *GetSchemaRequest) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
 type GetSchemaResponse struct {
-	SchemaDefinition     *SchemaDefinition `protobuf:"bytes,1,opt,name=schema_definition,json=schemaDefinition,proto3" json:"schema_definition,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	SchemaDefinition *SchemaDefinition `protobuf:"bytes,1,opt,name=schema_definition,json=schemaDefinition,proto3" json:"schema_definition,omitempty"`
+	// position is the position of the request, if the tablet returned
+	// the schema as of that position.
+	Position             string   `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
@@ -766,6 +799,13 @@ func (m *GetSchemaResponse) GetSchemaDefinition() *SchemaDefinition {
 	return nil
 }
 
+func (m *GetSchemaResponse) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
 type GetPermissionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -3907,142 +3947,145 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0xdb, 0x72, 0xdb, 0xc6,
-	0x75, 0x40, 0x4a, 0x32, 0x75, 0x78, 0x11, 0x05, 0x52, 0x22, 0x44, 0xd7, 0xb2, 0x0c, 0x3b, 0x89,
-	0x27, 0x99, 0x52, 0x89, 0x9c, 0x64, 0x32, 0x49, 0xdb, 0xa9, 0x6c, 0x4b, 0x76, 0x62, 0x39, 0x56,
-	0x20, 0x5f, 0x3a, 0x99, 0x4e, 0x31, 0x20, 0xb0, 0x22, 0x31, 0x02, 0xb1, 0xf0, 0xee, 0x82, 0x14,
-	0x5f, 0xfa, 0x09, 0xed, 0x1f, 0xf4, 0xa5, 0x33, 0xed, 0x7b, 0x3f, 0xa2, 0x9f, 0x90, 0x7e, 0x4a,
-	0x1f, 0xfa, 0xd0, 0xce, 0x5e, 0x40, 0x02, 0x04, 0x64, 0xcb, 0x1a, 0x77, 0x26, 0x2f, 0x1a, 0x9c,
-	0xfb, 0x65, 0xcf, 0x9e, 0x73, 0x96, 0x82, 0x0e, 0x73, 0xfa, 0x01, 0x62, 0x23, 0x27, 0x74, 0x06,
-	0x88, 0x78, 0x0e, 0x73, 0x7a, 0x11, 0xc1, 0x0c, 0xeb, 0xeb, 0x39, 0x42, 0xb7, 0xfa, 0x3a, 0x46,
-	0x64, 0x2a, 0xe9, 0xdd, 0x06, 0xc3, 0x11, 0x9e, 0xf3, 0x77, 0x37, 0x08, 0x8a, 0x02, 0xdf, 0x75,
-	0x98, 0x8f, 0xc3, 0x14, 0xba, 0x1e, 0xe0, 0x41, 0xcc, 0xfc, 0x40, 0x82, 0xe6, 0x7f, 0x35, 0x58,
-	0x7b, 0xce, 0x15, 0x3f, 0x44, 0xa7, 0x7e, 0xe8, 0x73, 0x66, 0x5d, 0x87, 0xa5, 0xd0, 0x19, 0x21,
-	0x43, 0xdb, 0xd1, 0xee, 0xae, 0x5a, 0xe2, 0x5b, 0xdf, 0x84, 0x15, 0xea, 0x0e, 0xd1, 0xc8, 0x31,
-	0x4a, 0x02, 0xab, 0x20, 0xdd, 0x80, 0x6b, 0x2e, 0x0e, 0xe2, 0x51, 0x48, 0x8d, 0xf2, 0x4e, 0xf9,
-	0xee, 0xaa, 0x95, 0x80, 0x7a, 0x0f, 0x5a, 0x11, 0xf1, 0x47, 0x0e, 0x99, 0xda, 0x67, 0x68, 0x6a,
-	0x27, 0x5c, 0x4b, 0x82, 0x6b, 0x5d, 0x91, 0x9e, 0xa0, 0xe9, 0x03, 0xc5, 0xaf, 0xc3, 0x12, 0x9b,
-	0x46, 0xc8, 0x58, 0x96, 0x56, 0xf9, 0xb7, 0x7e, 0x13, 0xaa, 0xdc, 0x75, 0x3b, 0x40, 0xe1, 0x80,
-	0x0d, 0x8d, 0x95, 0x1d, 0xed, 0xee, 0x92, 0x05, 0x1c, 0x75, 0x24, 0x30, 0xfa, 0x75, 0x58, 0x25,
-	0x78, 0x62, 0xbb, 0x38, 0x0e, 0x99, 0x71, 0x4d, 0x90, 0x2b, 0x04, 0x4f, 0x1e, 0x70, 0x58, 0xbf,
-	0x03, 0x2b, 0xa7, 0x3e, 0x0a, 0x3c, 0x6a, 0x54, 0x76, 0xca, 0x77, 0xab, 0x7b, 0xb5, 0x9e, 0xcc,
-	0xd7, 0x21, 0x47, 0x5a, 0x8a, 0x66, 0xfe, 0x4d, 0x83, 0xe6, 0x89, 0x08, 0x26, 0x95, 0x82, 0x8f,
-	0x60, 0x8d, 0x5b, 0xe9, 0x3b, 0x14, 0xd9, 0x2a, 0x6e, 0x99, 0x8d, 0x46, 0x82, 0x96, 0x22, 0xfa,
-	0x33, 0x90, 0xe7, 0x62, 0x7b, 0x33, 0x61, 0x6a, 0x94, 0x84, 0x39, 0xb3, 0x97, 0x3f, 0xca, 0x85,
-	0x54, 0x5b, 0x4d, 0x96, 0x45, 0x50, 0x9e, 0xd0, 0x31, 0x22, 0xd4, 0xc7, 0xa1, 0x51, 0x16, 0x16,
-	0x13, 0x90, 0x3b, 0xaa, 0x4b, 0xab, 0x0f, 0x86, 0x4e, 0x38, 0x40, 0x16, 0xa2, 0x71, 0xc0, 0xf4,
-	0xc7, 0x50, 0xef, 0xa3, 0x53, 0x4c, 0x32, 0x8e, 0x56, 0xf7, 0x6e, 0x17, 0x58, 0x5f, 0x0c, 0xd3,
-	0xaa, 0x49, 0x49, 0x15, 0xcb, 0x21, 0xd4, 0x9c, 0x53, 0x86, 0x88, 0x9d, 0x3a, 0xe9, 0x4b, 0x2a,
-	0xaa, 0x0a, 0x41, 0x89, 0x36, 0xff, 0xad, 0x41, 0xe3, 0x05, 0x45, 0xe4, 0x18, 0x91, 0x91, 0x4f,
-	0xa9, 0x2a, 0xa9, 0x21, 0xa6, 0x2c, 0x29, 0x29, 0xfe, 0xcd, 0x71, 0x31, 0x45, 0x44, 0x15, 0x94,
-	0xf8, 0xd6, 0x3f, 0x81, 0xf5, 0xc8, 0xa1, 0x74, 0x82, 0x89, 0x67, 0xbb, 0x43, 0xe4, 0x9e, 0xd1,
-	0x78, 0x24, 0xf2, 0xb0, 0x64, 0x35, 0x13, 0xc2, 0x03, 0x85, 0xd7, 0x7f, 0x00, 0x88, 0x88, 0x3f,
-	0xf6, 0x03, 0x34, 0x40, 0xb2, 0xb0, 0xaa, 0x7b, 0x9f, 0x15, 0x78, 0x9b, 0xf5, 0xa5, 0x77, 0x3c,
-	0x93, 0x39, 0x08, 0x19, 0x99, 0x5a, 0x29, 0x25, 0xdd, 0x5f, 0xc3, 0xda, 0x02, 0x59, 0x6f, 0x42,
-	0xf9, 0x0c, 0x4d, 0x95, 0xe7, 0xfc, 0x53, 0x6f, 0xc3, 0xf2, 0xd8, 0x09, 0x62, 0xa4, 0x3c, 0x97,
-	0xc0, 0xd7, 0xa5, 0xaf, 0x34, 0xf3, 0x27, 0x0d, 0x6a, 0x0f, 0xfb, 0x6f, 0x89, 0xbb, 0x01, 0x25,
-	0xaf, 0xaf, 0x64, 0x4b, 0x5e, 0x7f, 0x96, 0x87, 0x72, 0x2a, 0x0f, 0xcf, 0x0a, 0x42, 0xdb, 0x2d,
-	0x08, 0x2d, 0x6d, 0xec, 0xff, 0x19, 0xd8, 0x5f, 0x35, 0xa8, 0xce, 0x2d, 0x51, 0xfd, 0x08, 0x9a,
-	0xdc, 0x4f, 0x3b, 0x9a, 0xe3, 0x0c, 0x4d, 0x78, 0x79, 0xeb, 0xad, 0x07, 0x60, 0xad, 0xc5, 0x19,
-	0x98, 0xea, 0x87, 0xd0, 0xf0, 0xfa, 0x19, 0x5d, 0xf2, 0x06, 0xdd, 0x7c, 0x4b, 0xc4, 0x56, 0xdd,
-	0x4b, 0x41, 0xd4, 0xfc, 0x08, 0xaa, 0xc7, 0x7e, 0x38, 0xb0, 0xd0, 0xeb, 0x18, 0x51, 0xc6, 0xaf,
-	0x52, 0xe4, 0x4c, 0x03, 0xec, 0x78, 0x2a, 0xc8, 0x04, 0x34, 0xef, 0x42, 0x4d, 0x32, 0xd2, 0x08,
-	0x87, 0x14, 0xbd, 0x81, 0xf3, 0x63, 0xa8, 0x9d, 0x04, 0x08, 0x45, 0x89, 0xce, 0x2e, 0x54, 0xbc,
-	0x98, 0x88, 0xa6, 0x2a, 0x58, 0xcb, 0xd6, 0x0c, 0x36, 0xd7, 0xa0, 0xae, 0x78, 0xa5, 0x5a, 0xf3,
-	0x5f, 0x1a, 0xe8, 0x07, 0xe7, 0xc8, 0x8d, 0x19, 0x7a, 0x8c, 0xf1, 0x59, 0xa2, 0xa3, 0xa8, 0xbf,
-	0x6e, 0x03, 0x44, 0x0e, 0x71, 0x46, 0x88, 0x21, 0x22, 0xc3, 0x5f, 0xb5, 0x52, 0x18, 0xfd, 0x18,
-	0x56, 0xd1, 0x39, 0x23, 0x8e, 0x8d, 0xc2, 0xb1, 0xe8, 0xb4, 0xd5, 0xbd, 0x7b, 0x05, 0xd9, 0xc9,
-	0x5b, 0xeb, 0x1d, 0x70, 0xb1, 0x83, 0x70, 0x2c, 0x6b, 0xa2, 0x82, 0x14, 0xd8, 0xfd, 0x06, 0xea,
-	0x19, 0xd2, 0x3b, 0xd5, 0xc3, 0x29, 0xb4, 0x32, 0xa6, 0x54, 0x1e, 0x6f, 0x42, 0x15, 0x9d, 0xfb,
-	0xcc, 0xa6, 0xcc, 0x61, 0x31, 0x55, 0x09, 0x02, 0x8e, 0x3a, 0x11, 0x18, 0x31, 0x46, 0x98, 0x87,
-	0x63, 0x36, 0x1b, 0x23, 0x02, 0x52, 0x78, 0x44, 0x92, 0x5b, 0xa0, 0x20, 0x73, 0x0c, 0xcd, 0x47,
-	0x88, 0xc9, 0xbe, 0x92, 0xa4, 0x6f, 0x13, 0x56, 0x44, 0xe0, 0xb2, 0xe2, 0x56, 0x2d, 0x05, 0xe9,
-	0xb7, 0xa1, 0xee, 0x87, 0x6e, 0x10, 0x7b, 0xc8, 0x1e, 0xfb, 0x68, 0x42, 0x85, 0x89, 0x8a, 0x55,
-	0x53, 0xc8, 0x97, 0x1c, 0xa7, 0x7f, 0x00, 0x0d, 0x74, 0x2e, 0x99, 0x94, 0x12, 0x39, 0xb6, 0xea,
-	0x0a, 0x2b, 0x1a, 0x34, 0x35, 0x11, 0xac, 0xa7, 0xec, 0xaa, 0xe8, 0x8e, 0x61, 0x5d, 0x76, 0xc6,
-	0x54, 0xb3, 0x7f, 0x97, 0x6e, 0xdb, 0xa4, 0x0b, 0x18, 0xb3, 0x03, 0x1b, 0x8f, 0x10, 0x4b, 0x95,
-	0xb0, 0x8a, 0xd1, 0xfc, 0x11, 0x36, 0x17, 0x09, 0xca, 0x89, 0xdf, 0x42, 0x35, 0x7b, 0xe9, 0xb8,
-	0xf9, 0xed, 0x02, 0xf3, 0x69, 0xe1, 0xb4, 0x88, 0xd9, 0x06, 0xfd, 0x04, 0x31, 0x0b, 0x39, 0xde,
-	0xb3, 0x30, 0x98, 0x26, 0x16, 0x37, 0xa0, 0x95, 0xc1, 0xaa, 0x12, 0x9e, 0xa3, 0x5f, 0x11, 0x9f,
-	0xa1, 0x84, 0x7b, 0x13, 0xda, 0x59, 0xb4, 0x62, 0xff, 0x0e, 0xd6, 0xe5, 0x70, 0x7a, 0x3e, 0x8d,
-	0x12, 0x66, 0xfd, 0x0b, 0xa8, 0x4a, 0xf7, 0x6c, 0x31, 0xe0, 0xb9, 0xcb, 0x8d, 0xbd, 0x76, 0x6f,
-	0xb6, 0xaf, 0x88, 0x9c, 0x33, 0x21, 0x01, 0x6c, 0xf6, 0xcd, 0xfd, 0x4c, 0xeb, 0x9a, 0x3b, 0x64,
-	0xa1, 0x53, 0x82, 0xe8, 0x90, 0x97, 0x54, 0xda, 0xa1, 0x2c, 0x5a, 0xb1, 0x77, 0x60, 0xc3, 0x8a,
-	0xc3, 0xc7, 0xc8, 0x09, 0xd8, 0x50, 0x0c, 0x8e, 0x44, 0xc0, 0x80, 0xcd, 0x45, 0x82, 0x12, 0xf9,
-	0x1c, 0x8c, 0x6f, 0x07, 0x21, 0x26, 0x48, 0x12, 0x0f, 0x08, 0xc1, 0x24, 0xd3, 0x52, 0x18, 0x43,
-	0x24, 0x9c, 0x37, 0x0a, 0x01, 0x9a, 0xd7, 0x61, 0xab, 0x40, 0x4a, 0xa9, 0xfc, 0x9a, 0x3b, 0xcd,
-	0xfb, 0x49, 0xb6, 0x92, 0x6f, 0x43, 0x7d, 0xe2, 0xf8, 0xcc, 0x8e, 0x30, 0x9d, 0x17, 0xd3, 0xaa,
-	0x55, 0xe3, 0xc8, 0x63, 0x85, 0x93, 0x91, 0xa5, 0x65, 0x95, 0xce, 0x3d, 0xd8, 0x3c, 0x26, 0xe8,
-	0x34, 0xf0, 0x07, 0xc3, 0x85, 0x0b, 0xc2, 0x77, 0x32, 0x91, 0xb8, 0xe4, 0x86, 0x24, 0xa0, 0x39,
-	0x80, 0x4e, 0x4e, 0x46, 0xd5, 0xd5, 0x11, 0x34, 0x24, 0x97, 0x4d, 0xc4, 0x5e, 0x91, 0xf4, 0xf3,
-	0x0f, 0x2e, 0xac, 0xec, 0xf4, 0x16, 0x62, 0xd5, 0xdd, 0x14, 0x44, 0xcd, 0xff, 0x68, 0xa0, 0xef,
-	0x47, 0x51, 0x30, 0xcd, 0x7a, 0xd6, 0x84, 0x32, 0x7d, 0x1d, 0x24, 0x2d, 0x86, 0xbe, 0x0e, 0x78,
-	0x8b, 0x39, 0xc5, 0xc4, 0x45, 0xea, 0xb2, 0x4a, 0x80, 0xaf, 0x01, 0x4e, 0x10, 0xe0, 0x89, 0x9d,
-	0xda, 0x61, 0x45, 0x67, 0xa8, 0x58, 0x4d, 0x41, 0xb0, 0xe6, 0xf8, 0xfc, 0x02, 0xb4, 0xf4, 0xbe,
-	0x16, 0xa0, 0xe5, 0x2b, 0x2e, 0x40, 0x7f, 0xd7, 0xa0, 0x95, 0x89, 0x5e, 0xe5, 0xf8, 0xe7, 0xb7,
-	0xaa, 0xb5, 0x60, 0xfd, 0x08, 0xbb, 0x67, 0xb2, 0xeb, 0x25, 0x57, 0xa3, 0x0d, 0x7a, 0x1a, 0x39,
-	0xbf, 0x78, 0x2f, 0xc2, 0x20, 0xc7, 0xbc, 0x09, 0xed, 0x2c, 0x5a, 0xb1, 0xff, 0x43, 0x03, 0x43,
-	0x8d, 0x88, 0x43, 0xc4, 0xdc, 0xe1, 0x3e, 0x7d, 0xd8, 0x9f, 0xd5, 0x41, 0x1b, 0x96, 0xc5, 0x2a,
-	0x2e, 0x12, 0x50, 0xb3, 0x24, 0xa0, 0x77, 0xe0, 0x9a, 0xd7, 0xb7, 0xc5, 0x68, 0x54, 0xd3, 0xc1,
-	0xeb, 0x7f, 0xcf, 0x87, 0xe3, 0x16, 0x54, 0x46, 0xce, 0xb9, 0x4d, 0xf0, 0x84, 0xaa, 0x65, 0xf0,
-	0xda, 0xc8, 0x39, 0xb7, 0xf0, 0x84, 0x8a, 0x45, 0xdd, 0xa7, 0x62, 0x03, 0xef, 0xfb, 0x61, 0x80,
-	0x07, 0x54, 0x1c, 0x7f, 0xc5, 0x6a, 0x28, 0xf4, 0x7d, 0x89, 0xe5, 0x77, 0x8d, 0x88, 0x6b, 0x94,
-	0x3e, 0xdc, 0x8a, 0x55, 0x23, 0xa9, 0xbb, 0x65, 0x3e, 0x82, 0xad, 0x02, 0x9f, 0xd5, 0xe9, 0x7d,
-	0x0c, 0x2b, 0xf2, 0x6a, 0xa8, 0x63, 0xd3, 0xd5, 0x73, 0xe2, 0x07, 0xfe, 0x57, 0x5d, 0x03, 0xc5,
-	0x61, 0xfe, 0x49, 0x83, 0x1b, 0x59, 0x4d, 0xfb, 0x41, 0xc0, 0x17, 0x30, 0xfa, 0xfe, 0x53, 0x90,
-	0x8b, 0x6c, 0xa9, 0x20, 0xb2, 0x23, 0xd8, 0xbe, 0xc8, 0x9f, 0x2b, 0x84, 0xf7, 0x64, 0xf1, 0x6c,
-	0xf7, 0xa3, 0xe8, 0xcd, 0x81, 0xa5, 0xfd, 0x2f, 0x65, 0xfc, 0xcf, 0x27, 0x5d, 0x28, 0xbb, 0x82,
-	0x57, 0x5d, 0x30, 0x52, 0x7d, 0x41, 0x6e, 0x1c, 0x49, 0x99, 0x1e, 0xc1, 0x56, 0x01, 0x4d, 0x19,
-	0xd9, 0xe5, 0xdb, 0xc7, 0x6c, 0x63, 0xa9, 0xee, 0x75, 0x7a, 0x8b, 0x6f, 0x67, 0x25, 0xa0, 0xd8,
-	0xf8, 0x5d, 0x78, 0xea, 0x50, 0x7e, 0x8d, 0x32, 0x46, 0x9e, 0x42, 0x3b, 0x8b, 0x56, 0xfa, 0xbf,
-	0x58, 0xd0, 0x7f, 0x23, 0xa7, 0x3f, 0x23, 0x96, 0x58, 0xe9, 0xc0, 0x86, 0xc4, 0x27, 0xb3, 0x20,
-	0xb1, 0xf3, 0x39, 0x6c, 0x2e, 0x12, 0x94, 0xa5, 0x2e, 0x54, 0x16, 0x86, 0xc9, 0x0c, 0xe6, 0x52,
-	0xaf, 0x1c, 0x9f, 0x1d, 0xe2, 0x45, 0x7d, 0x6f, 0x94, 0xda, 0x82, 0x4e, 0x4e, 0x4a, 0x5d, 0x71,
-	0x03, 0x36, 0x4f, 0x18, 0x8e, 0x52, 0x79, 0x4d, 0x1c, 0xdc, 0x82, 0x4e, 0x8e, 0xa2, 0x84, 0xfe,
-	0x00, 0x37, 0x16, 0x48, 0x4f, 0xfd, 0xd0, 0x1f, 0xc5, 0xa3, 0x4b, 0x38, 0xa3, 0xdf, 0x02, 0x31,
-	0x1b, 0x6d, 0xe6, 0x8f, 0x50, 0xb2, 0x44, 0x96, 0xad, 0x2a, 0xc7, 0x3d, 0x97, 0x28, 0xf3, 0x57,
-	0xb0, 0x7d, 0x91, 0xfe, 0x4b, 0xe4, 0x48, 0x38, 0xee, 0x10, 0x56, 0x10, 0x53, 0x17, 0x8c, 0x3c,
-	0x49, 0x05, 0xd5, 0x87, 0x5b, 0x8b, 0xb4, 0x17, 0x21, 0xf3, 0x83, 0x7d, 0xde, 0x6a, 0xdf, 0x53,
-	0x60, 0x77, 0xc0, 0x7c, 0x93, 0x0d, 0xe5, 0x49, 0x1b, 0xf4, 0x47, 0x28, 0xe1, 0x99, 0x15, 0xe6,
-	0x27, 0xd0, 0xca, 0x60, 0x55, 0x26, 0xda, 0xb0, 0xec, 0x78, 0x1e, 0x49, 0xd6, 0x04, 0x09, 0xf0,
-	0x1c, 0x58, 0x88, 0xa2, 0x0b, 0x72, 0x90, 0x27, 0x29, 0xcb, 0xbb, 0xd0, 0x79, 0x99, 0xc2, 0xf3,
-	0x2b, 0x5d, 0xd8, 0x12, 0x56, 0x55, 0x4b, 0x30, 0x0f, 0xc1, 0xc8, 0x0b, 0x5c, 0xa9, 0x19, 0xdd,
-	0x48, 0xeb, 0x99, 0x57, 0x6b, 0x62, 0xbe, 0x01, 0x25, 0xdf, 0x53, 0x8f, 0x91, 0x92, 0xef, 0x65,
-	0x0e, 0xa2, 0xb4, 0x50, 0x00, 0x3b, 0xb0, 0x7d, 0x91, 0x32, 0x15, 0x67, 0x0b, 0xd6, 0xbf, 0x0d,
-	0x7d, 0x26, 0x2f, 0x60, 0x92, 0x98, 0x4f, 0x41, 0x4f, 0x23, 0x2f, 0x51, 0x69, 0x3f, 0x69, 0xb0,
-	0x7d, 0x8c, 0xa3, 0x38, 0x10, 0xdb, 0x6a, 0xe4, 0x10, 0x14, 0xb2, 0xef, 0x70, 0x4c, 0x42, 0x27,
-	0x48, 0xfc, 0xfe, 0x10, 0xd6, 0x78, 0x3d, 0xd8, 0x2e, 0x41, 0x0e, 0x43, 0x9e, 0x1d, 0x26, 0x2f,
-	0xaa, 0x3a, 0x47, 0x3f, 0x90, 0xd8, 0xef, 0x29, 0x7f, 0x75, 0x39, 0x2e, 0x57, 0x9a, 0x1e, 0x1c,
-	0x20, 0x51, 0x62, 0x78, 0x7c, 0x05, 0xb5, 0x91, 0xf0, 0xcc, 0x76, 0x02, 0xdf, 0x91, 0x03, 0xa4,
-	0xba, 0xb7, 0xb1, 0xb8, 0x81, 0xef, 0x73, 0xa2, 0x55, 0x95, 0xac, 0x02, 0xd0, 0x3f, 0x83, 0x76,
-	0xaa, 0x55, 0xcd, 0x17, 0xd5, 0x25, 0x61, 0xa3, 0x95, 0xa2, 0xcd, 0xf6, 0xd5, 0x5b, 0x70, 0xf3,
-	0xc2, 0xb8, 0x54, 0x0a, 0xff, 0xa2, 0xc9, 0x74, 0xa9, 0x44, 0x27, 0xf1, 0xfe, 0x12, 0x56, 0x24,
-	0xbf, 0x3a, 0xf4, 0x0b, 0x1c, 0x54, 0x4c, 0x17, 0xfa, 0x56, 0xba, 0xd0, 0xb7, 0xa2, 0x8c, 0x96,
-	0x0b, 0x32, 0xca, 0xfb, 0x7b, 0xc6, 0xbf, 0xf9, 0x0a, 0xf4, 0x10, 0x8d, 0x30, 0x43, 0xd9, 0xc3,
-	0xff, 0xb3, 0x06, 0xed, 0x2c, 0x5e, 0x9d, 0xff, 0x3d, 0x68, 0x79, 0x28, 0x22, 0xc8, 0x15, 0xc6,
-	0xb2, 0xa5, 0x70, 0xbf, 0x64, 0x68, 0x96, 0x3e, 0x27, 0xcf, 0x7c, 0xbc, 0x0f, 0x75, 0x75, 0x58,
-	0x6a, 0x66, 0x94, 0x2e, 0x33, 0x33, 0xd4, 0x01, 0x4b, 0x88, 0x5f, 0xe1, 0x17, 0xa1, 0x87, 0x8b,
-	0x9c, 0xed, 0x82, 0x91, 0x27, 0xa9, 0xf8, 0xae, 0xcf, 0x86, 0xe4, 0x2b, 0x87, 0x1e, 0x13, 0xcc,
-	0x59, 0xbc, 0x44, 0xf0, 0x17, 0xd0, 0x2d, 0x22, 0x2a, 0xd1, 0x7f, 0x6a, 0xd0, 0x3c, 0x41, 0xd9,
-	0x5b, 0xf1, 0xae, 0x07, 0x5a, 0x70, 0x3a, 0xa5, 0xa2, 0x7a, 0xff, 0x12, 0x3a, 0xe2, 0x99, 0xc0,
-	0x13, 0x44, 0x58, 0xc1, 0x1b, 0x61, 0x43, 0x90, 0x17, 0xbb, 0x65, 0xfe, 0xb9, 0xb5, 0x54, 0xf0,
-	0xdc, 0x6a, 0xc1, 0x7a, 0x2a, 0x0e, 0x15, 0xdd, 0x93, 0x74, 0xec, 0x16, 0x12, 0x76, 0x67, 0x99,
-	0x79, 0xc7, 0x30, 0xcd, 0x1b, 0x70, 0xbd, 0x50, 0x99, 0xb2, 0xf5, 0x47, 0xde, 0xe7, 0x33, 0x03,
-	0x6c, 0x3f, 0xf4, 0x1e, 0x21, 0x96, 0x59, 0x35, 0xf4, 0xdf, 0xc1, 0x06, 0x65, 0x38, 0x4a, 0x07,
-	0x6f, 0x8f, 0xb0, 0x97, 0xbc, 0xae, 0xef, 0x14, 0x6c, 0x30, 0xd9, 0xa1, 0x88, 0x3d, 0x64, 0xb5,
-	0x68, 0x1e, 0xc9, 0x1f, 0x2f, 0xb7, 0xdf, 0xe8, 0xc0, 0xec, 0x87, 0x88, 0xfa, 0x70, 0xda, 0x27,
-	0xbe, 0x67, 0x5f, 0x6a, 0x77, 0x12, 0xf5, 0x5e, 0x93, 0x12, 0xea, 0xc7, 0xa0, 0xdf, 0xcc, 0xd6,
-	0x22, 0x59, 0xe2, 0x1f, 0xbe, 0xcd, 0xe9, 0xfc, 0x7e, 0xa4, 0xea, 0x30, 0xdb, 0x48, 0xf8, 0xa6,
-	0xb3, 0x48, 0xb8, 0x44, 0x47, 0x3e, 0x81, 0xfa, 0x7d, 0xc7, 0x3d, 0x8b, 0x67, 0x9b, 0xec, 0x0e,
-	0x54, 0x5d, 0x1c, 0xba, 0x31, 0x21, 0x28, 0x74, 0xa7, 0xaa, 0xf7, 0xa6, 0x51, 0x9c, 0x43, 0x3c,
-	0x47, 0x65, 0xb9, 0xa8, 0x37, 0x6c, 0x1a, 0x65, 0x7e, 0x09, 0x8d, 0x44, 0xa9, 0x72, 0xe1, 0x0e,
-	0x2c, 0xa3, 0xf1, 0xbc, 0x58, 0x1a, 0xbd, 0xe4, 0x1f, 0x32, 0x07, 0x1c, 0x6b, 0x49, 0xa2, 0x9a,
-	0xb4, 0x0c, 0x13, 0x74, 0x48, 0xf0, 0x28, 0xe3, 0x97, 0xb9, 0xcf, 0xaf, 0x69, 0x8e, 0xf6, 0x4e,
-	0xea, 0x7f, 0x0f, 0xb5, 0x97, 0x6f, 0x9d, 0xd0, 0x3c, 0x5b, 0x13, 0x4c, 0xce, 0x4e, 0x03, 0x3c,
-	0x49, 0x06, 0x65, 0x02, 0x73, 0xda, 0x19, 0x9a, 0xd2, 0xc8, 0x71, 0x91, 0xfa, 0xcd, 0x6e, 0x06,
-	0x9b, 0xdf, 0x40, 0xfd, 0xe5, 0x55, 0xc7, 0xf9, 0xfd, 0x4f, 0x7f, 0xec, 0x8d, 0x7d, 0x86, 0x28,
-	0xed, 0xf9, 0x78, 0x57, 0x7e, 0xed, 0x0e, 0xf0, 0xee, 0x98, 0xed, 0x8a, 0xff, 0x58, 0xed, 0xe6,
-	0x9e, 0xb8, 0xfd, 0x15, 0x41, 0xb8, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x46, 0xf8,
-	0x40, 0x3b, 0x1b, 0x00, 0x00,
+	// 2229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x6e, 0xdc, 0xc6,
+	0x15, 0x06, 0x57, 0x3f, 0x96, 0xce, 0xfe, 0x48, 0xe2, 0xae, 0xb4, 0xd4, 0xba, 0x96, 0x65, 0xda,
+	0x49, 0x8c, 0x04, 0x5d, 0x25, 0xb2, 0x13, 0x04, 0x49, 0x5b, 0x54, 0xb6, 0x25, 0x3b, 0xb1, 0x1c,
+	0x2b, 0x94, 0x7f, 0x8a, 0xa0, 0x28, 0xc1, 0x5d, 0x8e, 0x56, 0x84, 0xb8, 0x1c, 0x7a, 0x66, 0xa8,
+	0xd5, 0xde, 0x14, 0xe8, 0x0b, 0xb4, 0x40, 0x1f, 0xa0, 0x37, 0x05, 0xda, 0xfb, 0x3e, 0x44, 0x1f,
+	0xa0, 0x17, 0xe9, 0xa3, 0xf4, 0xa2, 0x37, 0xc5, 0xfc, 0x71, 0x49, 0x2e, 0x25, 0xcb, 0x82, 0x51,
+	0xe4, 0x46, 0xe0, 0xf9, 0x99, 0x33, 0xdf, 0x39, 0x73, 0xe6, 0x9c, 0x33, 0x2b, 0x68, 0x33, 0xaf,
+	0x17, 0x22, 0x36, 0xf4, 0x22, 0x6f, 0x80, 0x88, 0xef, 0x31, 0xaf, 0x1b, 0x13, 0xcc, 0xb0, 0xb9,
+	0x32, 0x25, 0xe8, 0x54, 0xdf, 0x24, 0x88, 0x8c, 0xa5, 0xbc, 0xd3, 0x60, 0x38, 0xc6, 0x13, 0xfd,
+	0xce, 0x2a, 0x41, 0x71, 0x18, 0xf4, 0x3d, 0x16, 0xe0, 0x28, 0xc3, 0xae, 0x87, 0x78, 0x90, 0xb0,
+	0x20, 0x94, 0xa4, 0xfd, 0xaf, 0x0a, 0x2c, 0xbd, 0xe0, 0x86, 0x1f, 0xa1, 0xa3, 0x20, 0x0a, 0xb8,
+	0xb2, 0x69, 0xc2, 0x6c, 0xe4, 0x0d, 0x91, 0x65, 0x6c, 0x1a, 0x77, 0x17, 0x1d, 0xf1, 0x6d, 0xae,
+	0xc1, 0x3c, 0xed, 0x1f, 0xa3, 0xa1, 0x67, 0x55, 0x04, 0x57, 0x51, 0xa6, 0x05, 0xd7, 0xfa, 0x38,
+	0x4c, 0x86, 0x11, 0xb5, 0x66, 0x36, 0x67, 0xee, 0x2e, 0x3a, 0x9a, 0x34, 0xbb, 0xd0, 0x8c, 0x49,
+	0x30, 0xf4, 0xc8, 0xd8, 0x3d, 0x41, 0x63, 0x57, 0x6b, 0xcd, 0x0a, 0xad, 0x15, 0x25, 0x7a, 0x8a,
+	0xc6, 0x0f, 0x95, 0xbe, 0x09, 0xb3, 0x6c, 0x1c, 0x23, 0x6b, 0x4e, 0xee, 0xca, 0xbf, 0xcd, 0x9b,
+	0x50, 0xe5, 0xd0, 0xdd, 0x10, 0x45, 0x03, 0x76, 0x6c, 0xcd, 0x6f, 0x1a, 0x77, 0x67, 0x1d, 0xe0,
+	0xac, 0x7d, 0xc1, 0x31, 0xaf, 0xc3, 0x22, 0xc1, 0x23, 0xb7, 0x8f, 0x93, 0x88, 0x59, 0xd7, 0x84,
+	0x78, 0x81, 0xe0, 0xd1, 0x43, 0x4e, 0x9b, 0x77, 0x60, 0xfe, 0x28, 0x40, 0xa1, 0x4f, 0xad, 0x85,
+	0xcd, 0x99, 0xbb, 0xd5, 0xed, 0x5a, 0x57, 0xc6, 0x6b, 0x8f, 0x33, 0x1d, 0x25, 0x33, 0x37, 0x00,
+	0x62, 0x8f, 0x30, 0xe1, 0x3a, 0xb5, 0x16, 0x05, 0xbc, 0x0c, 0xc7, 0xfc, 0x0c, 0x5a, 0x29, 0xe5,
+	0xa2, 0xb3, 0x98, 0x20, 0x4a, 0x03, 0x1c, 0x59, 0x20, 0x70, 0x36, 0x53, 0xd9, 0x6e, 0x2a, 0xb2,
+	0xff, 0x66, 0xc0, 0xf2, 0xa1, 0x88, 0x4f, 0x26, 0xaa, 0x1f, 0xc1, 0x12, 0x07, 0xde, 0xf3, 0x28,
+	0x72, 0x55, 0x28, 0x65, 0x80, 0x1b, 0x9a, 0x2d, 0x97, 0x98, 0xcf, 0x41, 0x1e, 0xb5, 0xeb, 0xa7,
+	0x8b, 0xa9, 0x55, 0x11, 0x1e, 0xd8, 0xdd, 0xe9, 0xec, 0x28, 0x9c, 0x9e, 0xb3, 0xcc, 0xf2, 0x0c,
+	0xca, 0xcf, 0xe8, 0x14, 0x11, 0x01, 0x7a, 0x46, 0xec, 0xa8, 0x49, 0x0e, 0xd4, 0x94, 0xbb, 0x3e,
+	0x3c, 0xf6, 0xa2, 0x01, 0x72, 0x10, 0x4d, 0x42, 0x66, 0x3e, 0x81, 0x7a, 0x0f, 0x1d, 0x61, 0x92,
+	0x03, 0x5a, 0xdd, 0xbe, 0x5d, 0xb2, 0x7b, 0xd1, 0x4d, 0xa7, 0x26, 0x57, 0x2a, 0x5f, 0xf6, 0xa0,
+	0xe6, 0x1d, 0x31, 0x44, 0xdc, 0x4c, 0xf2, 0x5c, 0xd2, 0x50, 0x55, 0x2c, 0x94, 0x6c, 0xfb, 0x3f,
+	0x06, 0x34, 0x5e, 0x52, 0x44, 0x0e, 0x10, 0x19, 0x06, 0x22, 0xc8, 0x3c, 0x5f, 0x8e, 0x31, 0x65,
+	0x3a, 0x4b, 0xf9, 0x37, 0xe7, 0x25, 0x14, 0x11, 0x95, 0xa3, 0xe2, 0xdb, 0xfc, 0x04, 0x56, 0x62,
+	0x8f, 0xd2, 0x11, 0x26, 0xbe, 0xdb, 0x3f, 0x46, 0xfd, 0x13, 0x9a, 0x0c, 0x45, 0x1c, 0x66, 0x9d,
+	0x65, 0x2d, 0x78, 0xa8, 0xf8, 0xe6, 0xf7, 0x00, 0x31, 0x09, 0x4e, 0x83, 0x10, 0x0d, 0x90, 0xcc,
+	0xd5, 0xea, 0xf6, 0x67, 0x25, 0x68, 0xf3, 0x58, 0xba, 0x07, 0xe9, 0x9a, 0xdd, 0x88, 0x91, 0xb1,
+	0x93, 0x31, 0xd2, 0xf9, 0x25, 0x2c, 0x15, 0xc4, 0xe6, 0x32, 0xcc, 0x9c, 0xa0, 0xb1, 0x42, 0xce,
+	0x3f, 0xcd, 0x16, 0xcc, 0x9d, 0x7a, 0x61, 0x82, 0x14, 0x72, 0x49, 0x7c, 0x55, 0xf9, 0xd2, 0xb0,
+	0x7f, 0x34, 0xa0, 0xf6, 0xa8, 0xf7, 0x16, 0xbf, 0x1b, 0x50, 0xf1, 0x7b, 0x6a, 0x6d, 0xc5, 0xef,
+	0xa5, 0x71, 0x98, 0xc9, 0xc4, 0xe1, 0x79, 0x89, 0x6b, 0x5b, 0x25, 0xae, 0x3d, 0xea, 0xfd, 0x7f,
+	0x1c, 0xfb, 0xab, 0x01, 0xd5, 0xc9, 0x4e, 0xd4, 0xdc, 0x87, 0x65, 0x8e, 0xd3, 0x8d, 0x27, 0x3c,
+	0xcb, 0x10, 0x28, 0x6f, 0xbd, 0xf5, 0x00, 0x9c, 0xa5, 0x24, 0x47, 0x53, 0x73, 0x0f, 0x1a, 0x7e,
+	0x2f, 0x67, 0x4b, 0xde, 0xa0, 0x9b, 0x6f, 0xf1, 0xd8, 0xa9, 0xfb, 0x19, 0x8a, 0xda, 0x1f, 0x41,
+	0xf5, 0x20, 0x88, 0x06, 0x0e, 0x7a, 0x93, 0x20, 0xca, 0xf8, 0x55, 0x8a, 0xbd, 0x71, 0x88, 0x3d,
+	0x5f, 0x39, 0xa9, 0x49, 0xfb, 0x2e, 0xd4, 0xa4, 0x22, 0x8d, 0x71, 0x44, 0xd1, 0x05, 0x9a, 0x1f,
+	0x43, 0xed, 0x30, 0x44, 0x28, 0xd6, 0x36, 0x3b, 0xb0, 0xe0, 0x27, 0x44, 0xd4, 0x69, 0xa1, 0x3a,
+	0xe3, 0xa4, 0xb4, 0xbd, 0x04, 0x75, 0xa5, 0x2b, 0xcd, 0xda, 0xff, 0x36, 0xc0, 0xdc, 0x3d, 0x43,
+	0xfd, 0x84, 0xa1, 0x27, 0x18, 0x9f, 0x68, 0x1b, 0x65, 0x25, 0x5b, 0x16, 0x36, 0x6f, 0x88, 0x18,
+	0x22, 0xd2, 0xfd, 0x45, 0x27, 0xc3, 0x31, 0x0f, 0x60, 0x11, 0x9d, 0x31, 0xe2, 0xb9, 0x28, 0x3a,
+	0x15, 0xc5, 0xbb, 0xba, 0x7d, 0xaf, 0x24, 0x3a, 0xd3, 0xbb, 0x75, 0x77, 0xf9, 0xb2, 0xdd, 0xe8,
+	0x54, 0xe6, 0xc4, 0x02, 0x52, 0x64, 0xe7, 0x6b, 0xa8, 0xe7, 0x44, 0xef, 0x94, 0x0f, 0x47, 0xd0,
+	0xcc, 0x6d, 0xa5, 0xe2, 0x78, 0x13, 0xaa, 0xe8, 0x2c, 0x60, 0x2e, 0x65, 0x1e, 0x4b, 0xa8, 0x0a,
+	0x10, 0x70, 0xd6, 0xa1, 0xe0, 0x88, 0xce, 0xc4, 0x7c, 0x9c, 0xb0, 0xb4, 0x33, 0x09, 0x4a, 0xf1,
+	0x11, 0xd1, 0xb7, 0x40, 0x51, 0xf6, 0x9f, 0x0d, 0x58, 0x7e, 0x8c, 0x98, 0x2c, 0x2c, 0x3a, 0x7e,
+	0x6b, 0x30, 0x2f, 0x3c, 0x97, 0x29, 0xb7, 0xe8, 0x28, 0xca, 0xbc, 0x0d, 0xf5, 0x20, 0xea, 0x87,
+	0x89, 0x8f, 0xdc, 0xd3, 0x00, 0x8d, 0xa8, 0xd8, 0x63, 0xc1, 0xa9, 0x29, 0xe6, 0x2b, 0xce, 0x33,
+	0x3f, 0x80, 0x06, 0x3a, 0x93, 0x4a, 0xca, 0x88, 0x6c, 0x85, 0x75, 0xc5, 0x7d, 0x21, 0x6d, 0x75,
+	0x60, 0x21, 0xc6, 0x54, 0x14, 0x37, 0x6b, 0x56, 0x40, 0x4a, 0x69, 0xfb, 0x0f, 0x06, 0xac, 0x64,
+	0x40, 0x29, 0xdf, 0x0f, 0x60, 0x45, 0xd6, 0xcd, 0x4c, 0x2b, 0x78, 0x97, 0x5a, 0xbc, 0x4c, 0x0b,
+	0x9c, 0x1c, 0x86, 0x4a, 0x01, 0x43, 0x1b, 0x56, 0x1f, 0x23, 0x96, 0x49, 0x7e, 0x15, 0x1c, 0xfb,
+	0x07, 0x58, 0x2b, 0x0a, 0x14, 0xc0, 0x5f, 0x43, 0x35, 0x7f, 0x5d, 0x39, 0xb4, 0x8d, 0x12, 0x68,
+	0xd9, 0xc5, 0xd9, 0x25, 0x76, 0x0b, 0xcc, 0x43, 0xc4, 0x1c, 0xe4, 0xf9, 0xcf, 0xa3, 0x70, 0xac,
+	0x77, 0x5c, 0x85, 0x66, 0x8e, 0xab, 0x92, 0x7f, 0xc2, 0x7e, 0x4d, 0x02, 0x86, 0xb4, 0xf6, 0x1a,
+	0xb4, 0xf2, 0x6c, 0xa5, 0xfe, 0x2d, 0xac, 0xc8, 0xb6, 0xf6, 0x62, 0x1c, 0x6b, 0x65, 0xf3, 0x73,
+	0xa8, 0x4a, 0x78, 0xae, 0x98, 0x36, 0x38, 0xe4, 0xc6, 0x76, 0xab, 0x9b, 0x0e, 0x4f, 0xe2, 0xb0,
+	0x98, 0x58, 0x01, 0x2c, 0xfd, 0xe6, 0x38, 0xb3, 0xb6, 0x26, 0x80, 0x1c, 0x74, 0x44, 0x10, 0x3d,
+	0xe6, 0xc9, 0x98, 0x05, 0x94, 0x67, 0x2b, 0xf5, 0x36, 0xac, 0x3a, 0x49, 0xf4, 0x04, 0x79, 0x21,
+	0x3b, 0x16, 0x2d, 0x47, 0x2f, 0xb0, 0x60, 0xad, 0x28, 0x50, 0x4b, 0xee, 0x83, 0xf5, 0xcd, 0x20,
+	0xc2, 0x04, 0x49, 0xe1, 0x2e, 0x21, 0x98, 0xe4, 0x8a, 0x11, 0x63, 0x88, 0x44, 0x93, 0x12, 0x23,
+	0x48, 0xfb, 0x3a, 0xac, 0x97, 0xac, 0x52, 0x26, 0xbf, 0xe2, 0xa0, 0x79, 0x25, 0xca, 0x5f, 0x81,
+	0xdb, 0x50, 0x1f, 0x79, 0x01, 0x73, 0xd3, 0xfc, 0x90, 0x36, 0x6b, 0x9c, 0x79, 0xa0, 0x73, 0x44,
+	0x78, 0x96, 0x5d, 0xab, 0x6c, 0x6e, 0xc3, 0xda, 0x01, 0x41, 0x47, 0x61, 0x30, 0x38, 0x2e, 0xdc,
+	0x2c, 0x3e, 0x20, 0x8a, 0xc0, 0xe9, 0xab, 0xa5, 0x49, 0x7b, 0x00, 0xed, 0xa9, 0x35, 0x2a, 0xaf,
+	0xf6, 0xa1, 0x21, 0xb5, 0x5c, 0x22, 0x26, 0x12, 0xdd, 0x09, 0x3e, 0x38, 0x37, 0xeb, 0xb3, 0xf3,
+	0x8b, 0x53, 0xef, 0x67, 0x28, 0x6a, 0xff, 0xd7, 0x00, 0x73, 0x27, 0x8e, 0xc3, 0x71, 0x1e, 0xd9,
+	0x32, 0xcc, 0xd0, 0x37, 0xa1, 0x2e, 0x4e, 0xf4, 0x4d, 0xc8, 0x8b, 0xd3, 0x11, 0x26, 0x7d, 0xa4,
+	0x6e, 0xb9, 0x24, 0xf8, 0x00, 0xe1, 0x85, 0x21, 0x1e, 0xb9, 0x99, 0x81, 0x5a, 0xd4, 0x94, 0x05,
+	0x67, 0x59, 0x08, 0x9c, 0x09, 0x7f, 0x7a, 0x74, 0x9a, 0x7d, 0x5f, 0xa3, 0xd3, 0xdc, 0x15, 0x47,
+	0xa7, 0xbf, 0x1b, 0xd0, 0xcc, 0x79, 0xaf, 0x62, 0xfc, 0xd3, 0x1b, 0xf2, 0x9a, 0xb0, 0xb2, 0x8f,
+	0xfb, 0x27, 0xb2, 0x5c, 0xea, 0xab, 0xd1, 0x02, 0x33, 0xcb, 0x9c, 0x5c, 0xbc, 0x97, 0x51, 0x38,
+	0xa5, 0xbc, 0x06, 0xad, 0x3c, 0x5b, 0xa9, 0xff, 0xc3, 0x00, 0x4b, 0x35, 0x97, 0x3d, 0xc4, 0xfa,
+	0xc7, 0x3b, 0xf4, 0x51, 0x2f, 0xcd, 0x83, 0x16, 0xcc, 0x89, 0x77, 0x81, 0x08, 0x40, 0xcd, 0x91,
+	0x84, 0xd9, 0x86, 0x6b, 0x7e, 0xcf, 0x15, 0x4d, 0x55, 0xf5, 0x15, 0xbf, 0xf7, 0x1d, 0x6f, 0xab,
+	0xeb, 0xb0, 0x30, 0xf4, 0xce, 0x5c, 0x82, 0x47, 0x54, 0x8d, 0x91, 0xd7, 0x86, 0xde, 0x99, 0x83,
+	0x47, 0x54, 0x8c, 0xf8, 0x01, 0x15, 0xb3, 0x7b, 0x2f, 0x88, 0x42, 0x3c, 0xa0, 0xe2, 0xf8, 0x17,
+	0x9c, 0x86, 0x62, 0x3f, 0x90, 0x5c, 0x7e, 0xd7, 0x88, 0xb8, 0x46, 0xd9, 0xc3, 0x5d, 0x70, 0x6a,
+	0x24, 0x73, 0xb7, 0xec, 0xc7, 0xb0, 0x5e, 0x82, 0x59, 0x9d, 0xde, 0xc7, 0x30, 0x2f, 0xaf, 0x86,
+	0x3a, 0x36, 0x53, 0xbd, 0x6d, 0xbe, 0xe7, 0x7f, 0xd5, 0x35, 0x50, 0x1a, 0xf6, 0x1f, 0x0d, 0xb8,
+	0x91, 0xb7, 0xb4, 0x13, 0x86, 0x7c, 0x74, 0xa3, 0xef, 0x3f, 0x04, 0x53, 0x9e, 0xcd, 0x96, 0x78,
+	0xb6, 0x0f, 0x1b, 0xe7, 0xe1, 0xb9, 0x82, 0x7b, 0x4f, 0x8b, 0x67, 0xbb, 0x13, 0xc7, 0x17, 0x3b,
+	0x96, 0xc5, 0x5f, 0xc9, 0xe1, 0x9f, 0x0e, 0xba, 0x30, 0x76, 0x05, 0x54, 0x1d, 0xb0, 0x32, 0x75,
+	0x41, 0xce, 0x2a, 0x3a, 0x4d, 0xf7, 0x61, 0xbd, 0x44, 0xa6, 0x36, 0xd9, 0xe2, 0x73, 0x4b, 0x3a,
+	0xeb, 0x54, 0xb7, 0xdb, 0xdd, 0xe2, 0x43, 0x5e, 0x2d, 0x50, 0x6a, 0xfc, 0x2e, 0x3c, 0xf3, 0x28,
+	0xbf, 0x46, 0xb9, 0x4d, 0x9e, 0x41, 0x2b, 0xcf, 0x56, 0xf6, 0x3f, 0x2f, 0xd8, 0xbf, 0x31, 0x65,
+	0x3f, 0xb7, 0x4c, 0xef, 0xd2, 0x86, 0x55, 0xc9, 0xd7, 0xbd, 0x40, 0xef, 0x73, 0x1f, 0xd6, 0x8a,
+	0x02, 0xb5, 0x53, 0x76, 0xd8, 0x30, 0x0a, 0xc3, 0xc6, 0x7d, 0x58, 0x7b, 0xed, 0x05, 0x6c, 0x0f,
+	0x17, 0xed, 0x5d, 0xb8, 0x6a, 0x1d, 0xda, 0x53, 0xab, 0xd4, 0x15, 0xb7, 0x60, 0xed, 0x90, 0xe1,
+	0x38, 0x13, 0x57, 0x0d, 0x70, 0x1d, 0xda, 0x53, 0x12, 0xb5, 0xe8, 0x77, 0x70, 0xa3, 0x20, 0x7a,
+	0x16, 0x44, 0xc1, 0x30, 0x19, 0x5e, 0x02, 0x8c, 0x79, 0x0b, 0x44, 0x6f, 0x74, 0x59, 0x30, 0x44,
+	0x7a, 0xfc, 0x9c, 0x71, 0xaa, 0x9c, 0xf7, 0x42, 0xb2, 0xec, 0x5f, 0xc0, 0xc6, 0x79, 0xf6, 0x2f,
+	0x11, 0x23, 0x01, 0xdc, 0x23, 0xac, 0xc4, 0xa7, 0x0e, 0x58, 0xd3, 0x22, 0xe5, 0x54, 0x0f, 0x6e,
+	0x15, 0x65, 0x2f, 0x23, 0x16, 0x84, 0x3b, 0xbc, 0xd4, 0xbe, 0x27, 0xc7, 0xee, 0x80, 0x7d, 0xd1,
+	0x1e, 0x0a, 0x49, 0x0b, 0xcc, 0xc7, 0x48, 0xeb, 0xa4, 0x89, 0xf9, 0x09, 0x34, 0x73, 0x5c, 0x15,
+	0x89, 0x16, 0xcc, 0x79, 0xbe, 0x4f, 0xf4, 0x98, 0x20, 0x09, 0x1e, 0x03, 0x07, 0x51, 0x74, 0x4e,
+	0x0c, 0xa6, 0x45, 0x6a, 0xe7, 0x2d, 0x68, 0xbf, 0xca, 0xf0, 0xf9, 0x95, 0x2e, 0x2d, 0x09, 0x8b,
+	0xaa, 0x24, 0xd8, 0x7b, 0x60, 0x4d, 0x2f, 0xb8, 0x52, 0x31, 0xba, 0x91, 0xb5, 0x33, 0xc9, 0x56,
+	0xbd, 0x7d, 0x03, 0x2a, 0x81, 0xaf, 0x9e, 0x31, 0x95, 0xc0, 0xbf, 0x70, 0x22, 0xdf, 0x84, 0x8d,
+	0xf3, 0x8c, 0x29, 0x3f, 0x9b, 0xb0, 0xf2, 0x4d, 0x14, 0x30, 0x79, 0x01, 0x75, 0x60, 0x3e, 0x05,
+	0x33, 0xcb, 0xbc, 0x44, 0xa6, 0xfd, 0x68, 0xc0, 0xc6, 0x01, 0x8e, 0x93, 0x50, 0x4c, 0xab, 0xb1,
+	0x47, 0x50, 0xc4, 0xbe, 0xc5, 0x09, 0x89, 0xbc, 0x50, 0xe3, 0xfe, 0x10, 0x96, 0x78, 0x3e, 0xb8,
+	0x7d, 0x82, 0x3c, 0x86, 0x7c, 0x37, 0xd2, 0x6f, 0xb1, 0x3a, 0x67, 0x3f, 0x94, 0xdc, 0xef, 0x28,
+	0x7f, 0xaf, 0x79, 0x7d, 0xf1, 0x5b, 0x59, 0xa6, 0x71, 0x80, 0x64, 0x89, 0xe6, 0xf1, 0x25, 0xd4,
+	0x86, 0x02, 0x99, 0xeb, 0x85, 0x81, 0x27, 0x1b, 0x48, 0x75, 0x7b, 0xb5, 0x38, 0x81, 0xef, 0x70,
+	0xa1, 0x53, 0x95, 0xaa, 0x82, 0xe0, 0xbf, 0xc4, 0x65, 0x4a, 0x95, 0x5b, 0x78, 0x4c, 0x35, 0x33,
+	0xb2, 0x74, 0x5e, 0xbd, 0x05, 0x37, 0xcf, 0xf5, 0x4b, 0x85, 0xf0, 0x2f, 0x86, 0x0c, 0x97, 0x0a,
+	0xb4, 0xf6, 0xf7, 0xe7, 0x30, 0x2f, 0xf5, 0x2d, 0xe3, 0x22, 0x80, 0x4a, 0xe9, 0x5c, 0x6c, 0x95,
+	0x73, 0xb1, 0x95, 0x45, 0x74, 0xa6, 0x24, 0xa2, 0xbc, 0xbe, 0xe7, 0xf0, 0x4d, 0x46, 0xa0, 0x47,
+	0x68, 0x88, 0x19, 0xca, 0x1f, 0xfe, 0x9f, 0x0c, 0x68, 0xe5, 0xf9, 0xea, 0xfc, 0xef, 0x41, 0xd3,
+	0x47, 0x31, 0x41, 0x7d, 0xb1, 0x59, 0x3e, 0x15, 0x1e, 0x54, 0x2c, 0xc3, 0x31, 0x27, 0xe2, 0x14,
+	0xe3, 0x03, 0xa8, 0xab, 0xc3, 0x52, 0x3d, 0xa3, 0x72, 0x99, 0x9e, 0x51, 0x1b, 0x66, 0x28, 0x7e,
+	0x85, 0x5f, 0x46, 0x3e, 0x2e, 0x03, 0xdb, 0x01, 0x6b, 0x5a, 0xa4, 0xfc, 0xbb, 0x9e, 0x36, 0xc9,
+	0xd7, 0x1e, 0x3d, 0x20, 0x98, 0xab, 0xf8, 0x7a, 0xe1, 0xcf, 0xa0, 0x53, 0x26, 0x54, 0x4b, 0xff,
+	0xc9, 0x7f, 0x7f, 0x45, 0xf9, 0x5b, 0xf1, 0xae, 0x07, 0x5a, 0x72, 0x3a, 0x95, 0xb2, 0x7c, 0xff,
+	0x02, 0xda, 0xe2, 0x99, 0xc0, 0x03, 0x44, 0x58, 0xc9, 0x1b, 0x61, 0x55, 0x88, 0x8b, 0xd5, 0x72,
+	0xfa, 0xb9, 0x35, 0x5b, 0xf2, 0xdc, 0x6a, 0xc2, 0x4a, 0xc6, 0x0f, 0xe5, 0xdd, 0xd3, 0xac, 0xef,
+	0x0e, 0x12, 0xfb, 0x22, 0xff, 0x6a, 0x6e, 0xda, 0x37, 0xe0, 0x7a, 0xa9, 0x31, 0xb5, 0xd7, 0xef,
+	0x79, 0x9d, 0xcf, 0x35, 0xb0, 0x9d, 0xc8, 0xe7, 0x3f, 0x54, 0x64, 0x47, 0x0d, 0xf3, 0x37, 0xb0,
+	0x4a, 0x19, 0x8e, 0xb3, 0xce, 0xbb, 0x43, 0xec, 0xeb, 0xd7, 0xf5, 0x9d, 0x92, 0x09, 0x26, 0xdf,
+	0x14, 0xb1, 0x8f, 0x9c, 0x26, 0x9d, 0x66, 0xf2, 0xc7, 0xcb, 0xed, 0x0b, 0x01, 0xa4, 0x3f, 0x44,
+	0xd4, 0x8f, 0xc7, 0x3d, 0x12, 0xf8, 0xee, 0xa5, 0x66, 0x27, 0x91, 0xef, 0x35, 0xb9, 0x42, 0x72,
+	0xcc, 0x5f, 0xa5, 0x63, 0x91, 0x4c, 0xf1, 0x0f, 0xdf, 0x06, 0x7a, 0x7a, 0x3e, 0x52, 0x79, 0x98,
+	0x2f, 0x24, 0x7c, 0xd2, 0x29, 0x0a, 0x2e, 0x51, 0x91, 0x0f, 0xa1, 0xfe, 0xc0, 0xeb, 0x9f, 0x24,
+	0xe9, 0x24, 0xbb, 0x09, 0xd5, 0x3e, 0x8e, 0xfa, 0x09, 0x21, 0x28, 0xea, 0x8f, 0x55, 0xed, 0xcd,
+	0xb2, 0xb8, 0x86, 0x78, 0x8e, 0xca, 0x74, 0x51, 0x6f, 0xd8, 0x2c, 0xcb, 0xfe, 0x02, 0x1a, 0xda,
+	0xa8, 0x82, 0x70, 0x07, 0xe6, 0xd0, 0xe9, 0x24, 0x59, 0x1a, 0x5d, 0xfd, 0xdf, 0xa1, 0x5d, 0xce,
+	0x75, 0xa4, 0x50, 0x75, 0x5a, 0x86, 0x09, 0xda, 0x23, 0x78, 0x98, 0xc3, 0x65, 0xef, 0xc0, 0x7a,
+	0x89, 0xec, 0x9d, 0xcc, 0xff, 0x16, 0x6a, 0xaf, 0xde, 0xda, 0xa1, 0x79, 0xb4, 0x46, 0x98, 0x9c,
+	0x1c, 0x85, 0x78, 0xa4, 0x1b, 0xa5, 0xa6, 0xb9, 0xec, 0x04, 0x8d, 0x69, 0xec, 0xf5, 0x91, 0xfa,
+	0xb5, 0x2f, 0xa5, 0xed, 0xaf, 0xa1, 0xfe, 0xea, 0xaa, 0xed, 0xfc, 0xc1, 0xa7, 0x3f, 0x74, 0x4f,
+	0x03, 0x86, 0x28, 0xed, 0x06, 0x78, 0x4b, 0x7e, 0x6d, 0x0d, 0xf0, 0xd6, 0x29, 0xdb, 0x12, 0xff,
+	0x3e, 0xdb, 0x9a, 0x7a, 0xe2, 0xf6, 0xe6, 0x85, 0xe0, 0xde, 0xff, 0x06, 0x00, 0xf8, 0x15, 0x58,
+	0x8b, 0xc8, 0x1b, 0x00, 0x00,
 }
@@ -1261,7 +1261,11 @@ type CellInfo struct {
 	ServerAddress string `protobuf:"bytes,1,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`
 	// Root is the path to store data in. It is only used when talking
 	// to server_address.
-	Root                 string   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// drain_percent is the percentage of the reads on the replicas of
+	// the cell that the vtgates send to the other cells, to drain the
+	// cell before a maintenance. 0 means the cell is not draining.
+	DrainPercent         int32    `protobuf:"varint,4,opt,name=drain_percent,json=drainPercent,proto3" json:"drain_percent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1306,6 +1310,13 @@ func (m *CellInfo) GetRoot() string {
 	return ""
 }
 
+func (m *CellInfo) GetDrainPercent() int32 {
+	if m != nil {
+		return m.DrainPercent
+	}
+	return 0
+}
+
 // CellsAlias
 type CellsAlias struct {
 	// Cells that map to this alias
@@ -1376,90 +1387,91 @@ func init() {
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
+	// 1366 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
 	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0x86, 0x8a,
-	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x6c, 0xcb,
-	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x52, 0xd9, 0x5d, 0x0b, 0x50,
+	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x62, 0xcb,
+	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x92, 0xd9, 0x5d, 0x0b, 0x50,
 	0x5f, 0xa1, 0x87, 0xf6, 0xdc, 0x37, 0xe8, 0xfb, 0xf4, 0xd8, 0x4b, 0xfb, 0x1c, 0x3d, 0x14, 0x3b,
-	0x4b, 0x52, 0x94, 0x14, 0xa7, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0xe6, 0xb7, 0xbf, 0x99,
-	0x95, 0xa0, 0x22, 0xc3, 0x49, 0x38, 0xf4, 0xa4, 0xd7, 0x98, 0xf0, 0x50, 0x86, 0xc4, 0x8c, 0xf5,
-	0xaa, 0x35, 0x95, 0xd2, 0x1f, 0x33, 0x6d, 0xaf, 0xed, 0x82, 0x79, 0xc8, 0x66, 0xd4, 0x0b, 0x2e,
-	0x18, 0xd9, 0x80, 0xbc, 0x90, 0x1e, 0x97, 0x8e, 0xb1, 0x65, 0xd4, 0x2d, 0xaa, 0x15, 0x62, 0x43,
-	0x96, 0x05, 0x43, 0x27, 0x83, 0x36, 0x25, 0xd6, 0x1e, 0x41, 0xa9, 0xef, 0x9d, 0x8d, 0x98, 0x6c,
-	0x8e, 0x7c, 0x4f, 0x10, 0x02, 0xb9, 0x01, 0x1b, 0x8d, 0x30, 0xaa, 0x48, 0x51, 0x56, 0x41, 0x57,
-	0xbe, 0x0e, 0x2a, 0x53, 0x25, 0xd6, 0xfe, 0xce, 0x41, 0x41, 0x47, 0x91, 0x4f, 0x21, 0xef, 0xa9,
-	0x48, 0x8c, 0x28, 0xed, 0xde, 0x6f, 0x24, 0xb5, 0xa6, 0xd2, 0x52, 0xed, 0x43, 0xaa, 0x60, 0xbe,
-	0x09, 0x85, 0x0c, 0xbc, 0x31, 0xc3, 0x74, 0x45, 0x9a, 0xe8, 0x64, 0x0f, 0xcc, 0x49, 0xc8, 0xa5,
-	0x3b, 0xf6, 0x26, 0x4e, 0x6e, 0x2b, 0x5b, 0x2f, 0xed, 0x3e, 0x58, 0xce, 0xd5, 0xe8, 0x86, 0x5c,
-	0x1e, 0x7b, 0x93, 0x76, 0x20, 0xf9, 0x8c, 0xae, 0x4d, 0xb4, 0xa6, 0xb2, 0x5e, 0xb2, 0x99, 0x98,
-	0x78, 0x03, 0xe6, 0xe4, 0x75, 0xd6, 0x58, 0x47, 0x18, 0xde, 0x78, 0x7c, 0xe8, 0x14, 0xf0, 0x40,
-	0x2b, 0x64, 0x07, 0x8a, 0x97, 0x6c, 0xe6, 0x72, 0x85, 0x94, 0xb3, 0x86, 0x85, 0x93, 0xf9, 0xc7,
-	0x62, 0x0c, 0x31, 0x8d, 0x46, 0xb3, 0x0e, 0x39, 0x39, 0x9b, 0x30, 0xc7, 0xdc, 0x32, 0xea, 0x95,
-	0xdd, 0x8d, 0xe5, 0xc2, 0xfa, 0xb3, 0x09, 0xa3, 0xe8, 0x41, 0xea, 0x60, 0x0f, 0xcf, 0x5c, 0xd5,
-	0x91, 0x1b, 0x4e, 0x19, 0xe7, 0xfe, 0x90, 0x39, 0x45, 0xfc, 0x76, 0x65, 0x78, 0xd6, 0xf1, 0xc6,
-	0xec, 0x24, 0xb2, 0x92, 0x06, 0xe4, 0xa4, 0x77, 0x21, 0x1c, 0xc0, 0x66, 0xab, 0x2b, 0xcd, 0xf6,
-	0xbd, 0x0b, 0xa1, 0x3b, 0x45, 0x3f, 0xf2, 0x10, 0x2a, 0xe3, 0x99, 0x78, 0x3b, 0x72, 0x13, 0x08,
-	0x2d, 0xcc, 0x5b, 0x46, 0xeb, 0xcb, 0x18, 0xc7, 0x07, 0x00, 0xda, 0x4d, 0xc1, 0xe3, 0x94, 0xb7,
-	0x8c, 0x7a, 0x9e, 0x16, 0xd1, 0xa2, 0xd0, 0x23, 0x4d, 0xd8, 0x1c, 0x7b, 0x42, 0x32, 0xee, 0x4a,
-	0xc6, 0xc7, 0x2e, 0xd2, 0xc2, 0x55, 0x1c, 0x72, 0x2a, 0x88, 0x83, 0xd5, 0x88, 0x28, 0xd5, 0xf7,
-	0xc7, 0x8c, 0xde, 0xd3, 0xbe, 0x7d, 0xc6, 0xc7, 0x3d, 0xe5, 0xa9, 0x8c, 0xd5, 0xa7, 0x60, 0xa5,
-	0x2f, 0x42, 0xf1, 0xe3, 0x92, 0xcd, 0x22, 0xca, 0x28, 0x51, 0xa1, 0x3e, 0xf5, 0x46, 0x57, 0xfa,
-	0x92, 0xf3, 0x54, 0x2b, 0x4f, 0x33, 0x7b, 0x46, 0xf5, 0x2b, 0x28, 0x26, 0x7d, 0xfd, 0x5b, 0x60,
-	0x31, 0x15, 0xf8, 0x2a, 0x67, 0x66, 0xed, 0xdc, 0xab, 0x9c, 0x59, 0xb2, 0xad, 0xda, 0xef, 0x05,
-	0xc8, 0xf7, 0xf0, 0x22, 0xf7, 0xc0, 0x8a, 0xba, 0xb9, 0x01, 0x09, 0x4b, 0xda, 0x55, 0x13, 0xfd,
-	0x7a, 0x1c, 0xcc, 0x1b, 0xe2, 0xb0, 0xc8, 0xa2, 0xcc, 0x0d, 0x58, 0xf4, 0x0d, 0x58, 0x82, 0xf1,
-	0x29, 0x1b, 0xba, 0x8a, 0x2a, 0xc2, 0xc9, 0x2e, 0xdf, 0x3c, 0x36, 0xd5, 0xe8, 0xa1, 0x0f, 0x72,
-	0xaa, 0x24, 0x12, 0x59, 0x90, 0x67, 0x50, 0x16, 0xe1, 0x15, 0x1f, 0x30, 0x17, 0x59, 0x2c, 0xa2,
-	0x31, 0xf9, 0xdf, 0x4a, 0x3c, 0x3a, 0xa1, 0x4c, 0x2d, 0x31, 0x57, 0x04, 0x79, 0x01, 0xeb, 0x12,
-	0x01, 0x71, 0x07, 0x61, 0x20, 0x79, 0x38, 0x12, 0x4e, 0x61, 0x79, 0xd4, 0x74, 0x0e, 0x8d, 0x5b,
-	0x4b, 0x7b, 0xd1, 0x8a, 0x4c, 0xab, 0x82, 0x6c, 0xc3, 0x5d, 0x5f, 0xb8, 0x11, 0x7e, 0xaa, 0x44,
-	0x3f, 0xb8, 0xc0, 0x39, 0x32, 0xe9, 0xba, 0x2f, 0x8e, 0xd1, 0xde, 0xd3, 0xe6, 0xea, 0x6b, 0x80,
-	0x79, 0x43, 0xe4, 0x09, 0x94, 0xa2, 0x0a, 0x70, 0x9e, 0x8c, 0xf7, 0xcc, 0x13, 0xc8, 0x44, 0x56,
-	0xbc, 0x50, 0xab, 0x48, 0x38, 0x99, 0xad, 0xac, 0xe2, 0x05, 0x2a, 0xd5, 0x5f, 0x0d, 0x28, 0xa5,
-	0x9a, 0x8d, 0x17, 0x95, 0x91, 0x2c, 0xaa, 0x85, 0xd5, 0x90, 0xb9, 0x6e, 0x35, 0x64, 0xaf, 0x5d,
-	0x0d, 0xb9, 0x1b, 0x5c, 0xea, 0x26, 0x14, 0xb0, 0x50, 0xe1, 0xe4, 0xb1, 0xb6, 0x48, 0xab, 0xfe,
-	0x66, 0x40, 0x79, 0x01, 0xc5, 0x5b, 0xed, 0x9d, 0x7c, 0x06, 0xe4, 0x6c, 0xe4, 0x0d, 0x2e, 0x47,
-	0xbe, 0x90, 0x8a, 0x50, 0xba, 0x84, 0x1c, 0xba, 0xdc, 0x4d, 0x9d, 0x60, 0x52, 0xa1, 0xaa, 0x3c,
-	0xe7, 0xe1, 0x8f, 0x2c, 0xc0, 0x0d, 0x69, 0xd2, 0x48, 0x4b, 0xc6, 0x2a, 0x6f, 0x17, 0x6a, 0x7f,
-	0x64, 0xf1, 0xfd, 0xd0, 0xe8, 0x7c, 0x0e, 0x1b, 0x08, 0x88, 0x1f, 0x5c, 0xb8, 0x83, 0x70, 0x74,
-	0x35, 0x0e, 0x70, 0xa9, 0x45, 0xc3, 0x4a, 0xe2, 0xb3, 0x16, 0x1e, 0xa9, 0xbd, 0x46, 0x5e, 0xad,
-	0x46, 0x60, 0x9f, 0x19, 0xec, 0xd3, 0x59, 0x00, 0x11, 0xbf, 0x71, 0xa0, 0x39, 0xbe, 0x94, 0x0b,
-	0x7b, 0x7e, 0x96, 0x4c, 0xca, 0x39, 0x0f, 0xc7, 0x62, 0xf5, 0x41, 0x88, 0x73, 0x44, 0xc3, 0xf2,
-	0x82, 0x87, 0xe3, 0x78, 0x58, 0x94, 0x2c, 0xc8, 0xd7, 0x50, 0x8e, 0x6f, 0x5a, 0x97, 0x91, 0xc7,
-	0x32, 0x36, 0x57, 0x53, 0x60, 0x11, 0xd6, 0x65, 0x4a, 0x23, 0x1f, 0x43, 0xf9, 0xcc, 0x13, 0xcc,
-	0x4d, 0xb8, 0xa3, 0x5f, 0x0f, 0x4b, 0x19, 0x13, 0x84, 0xbe, 0x80, 0xb2, 0x08, 0xbc, 0x89, 0x78,
-	0x13, 0x46, 0x8b, 0x63, 0xed, 0x1d, 0x8b, 0xc3, 0x8a, 0x5d, 0x70, 0x73, 0x5e, 0xc5, 0xb3, 0xa0,
-	0x6a, 0xbc, 0x5d, 0x3e, 0xa4, 0x99, 0x9e, 0x5d, 0x64, 0xba, 0xbe, 0xe4, 0xda, 0x4f, 0x06, 0xd8,
-	0x7a, 0x29, 0xb0, 0xc9, 0xc8, 0x1f, 0x78, 0xd2, 0x0f, 0x03, 0xf2, 0x04, 0xf2, 0x41, 0x38, 0x64,
-	0x6a, 0x73, 0x2a, 0x84, 0x3f, 0x5a, 0xda, 0x03, 0x29, 0xd7, 0x46, 0x27, 0x1c, 0x32, 0xaa, 0xbd,
-	0xab, 0xcf, 0x20, 0xa7, 0x54, 0xb5, 0x7f, 0xa3, 0x16, 0x6e, 0xb2, 0x7f, 0xe5, 0x5c, 0xa9, 0x9d,
-	0x42, 0x25, 0xfa, 0xc2, 0x39, 0xe3, 0x2c, 0x18, 0x30, 0xf5, 0xd3, 0x23, 0xc5, 0x30, 0x94, 0x3f,
-	0x78, 0xc5, 0xd6, 0x7e, 0x36, 0x80, 0x60, 0xde, 0xc5, 0xd1, 0xbb, 0x8d, 0xdc, 0xe4, 0x31, 0x6c,
-	0xbe, 0xbd, 0x62, 0x7c, 0xa6, 0x37, 0xde, 0x80, 0xb9, 0x43, 0x5f, 0xa8, 0xaf, 0xe8, 0x0d, 0x62,
-	0xd2, 0x0d, 0x3c, 0xed, 0xe9, 0xc3, 0xfd, 0xe8, 0xac, 0xf6, 0x57, 0x0e, 0x4a, 0x3d, 0x3e, 0x4d,
-	0x68, 0xf3, 0x2d, 0xc0, 0xc4, 0xe3, 0xd2, 0x57, 0x98, 0xc6, 0xb0, 0x7f, 0x92, 0x82, 0x7d, 0xee,
-	0x9a, 0x30, 0xb4, 0x1b, 0xfb, 0xd3, 0x54, 0xe8, 0xb5, 0x13, 0x9a, 0xf9, 0xe0, 0x09, 0xcd, 0xfe,
-	0x87, 0x09, 0x6d, 0x42, 0x29, 0x35, 0xa1, 0xd1, 0x80, 0x6e, 0xbd, 0xbb, 0x8f, 0xd4, 0x8c, 0xc2,
-	0x7c, 0x46, 0xab, 0x7f, 0x1a, 0x70, 0x77, 0xa5, 0x45, 0x35, 0x15, 0xa9, 0x47, 0xf2, 0xfd, 0x53,
-	0x31, 0x7f, 0x1d, 0x49, 0x0b, 0x6c, 0xac, 0xd2, 0xe5, 0x31, 0xa1, 0xf4, 0x80, 0x94, 0xd2, 0x7d,
-	0x2d, 0x32, 0x8e, 0xae, 0x8b, 0x05, 0x5d, 0x90, 0x2e, 0xdc, 0xd7, 0x49, 0x96, 0x5f, 0x49, 0xfd,
-	0x52, 0xff, 0x7f, 0x29, 0xd3, 0xe2, 0x23, 0x79, 0x4f, 0xac, 0xd8, 0x44, 0xd5, 0xbd, 0x8d, 0x89,
-	0x7f, 0xcf, 0x2b, 0x16, 0xad, 0xee, 0x43, 0x30, 0x5b, 0x6c, 0x34, 0x3a, 0x08, 0xce, 0x43, 0xf5,
-	0x3b, 0x11, 0x71, 0xe1, 0xae, 0x37, 0x1c, 0x72, 0x26, 0x44, 0xc4, 0xfa, 0xb2, 0xb6, 0x36, 0xb5,
-	0x51, 0x8d, 0x04, 0x0f, 0x43, 0x19, 0x25, 0x44, 0x39, 0x5a, 0x14, 0x35, 0x00, 0x95, 0x4c, 0xe8,
-	0x1f, 0x4a, 0xef, 0x5c, 0x37, 0xdb, 0x75, 0xb0, 0xd2, 0xfb, 0x93, 0x00, 0x14, 0x3a, 0x27, 0xf4,
-	0xb8, 0x79, 0x64, 0xdf, 0x21, 0x16, 0x98, 0xbd, 0x4e, 0xb3, 0xdb, 0x7b, 0x79, 0xd2, 0xb7, 0x8d,
-	0xed, 0x5d, 0xa8, 0x2c, 0xd2, 0x89, 0x14, 0x21, 0x7f, 0xda, 0xe9, 0xb5, 0xfb, 0xf6, 0x1d, 0x15,
-	0x76, 0x7a, 0xd0, 0xe9, 0x7f, 0xf9, 0xd8, 0x36, 0x94, 0xf9, 0xf9, 0xeb, 0x7e, 0xbb, 0x67, 0x67,
-	0xb6, 0x7f, 0x31, 0x00, 0xe6, 0x58, 0x90, 0x12, 0xac, 0x9d, 0x76, 0x0e, 0x3b, 0x27, 0xdf, 0x75,
-	0x74, 0xc8, 0x71, 0xb3, 0xd7, 0x6f, 0x53, 0xdb, 0x50, 0x07, 0xb4, 0xdd, 0x3d, 0x3a, 0x68, 0x35,
-	0xed, 0x8c, 0x3a, 0xa0, 0xfb, 0x27, 0x9d, 0xa3, 0xd7, 0x76, 0x16, 0x73, 0x35, 0xfb, 0xad, 0x97,
-	0x5a, 0xec, 0x75, 0x9b, 0xb4, 0x6d, 0xe7, 0x88, 0x0d, 0x56, 0xfb, 0xfb, 0x6e, 0x9b, 0x1e, 0x1c,
-	0xb7, 0x3b, 0xfd, 0xe6, 0x91, 0x9d, 0x57, 0x31, 0xcf, 0x9b, 0xad, 0xc3, 0xd3, 0xae, 0x5d, 0xd0,
-	0xc9, 0x7a, 0xfd, 0x13, 0xda, 0xb6, 0xd7, 0x94, 0xb2, 0x4f, 0x9b, 0x07, 0x9d, 0xf6, 0xbe, 0x6d,
-	0x56, 0x33, 0xb6, 0xf1, 0x7c, 0x0f, 0xd6, 0xfd, 0xb0, 0x31, 0xf5, 0x25, 0x13, 0x42, 0xff, 0xdd,
-	0xfa, 0xe1, 0x61, 0xa4, 0xf9, 0xe1, 0x8e, 0x96, 0x76, 0x2e, 0xc2, 0x9d, 0xa9, 0xdc, 0xc1, 0xd3,
-	0x9d, 0xf8, 0x52, 0xcf, 0x0a, 0xa8, 0x3f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0xac, 0x2b,
-	0xc1, 0xc6, 0x0d, 0x00, 0x00,
+	0x4b, 0x4a, 0x94, 0x14, 0xbb, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0x66, 0xf6, 0x37, 0xbf,
+	0x95, 0xa0, 0x22, 0xc3, 0x28, 0x1c, 0x7a, 0xd2, 0x6b, 0x44, 0x3c, 0x94, 0x21, 0x31, 0x13, 0xbd,
+	0x6a, 0x4d, 0xa4, 0xf4, 0xc7, 0x4c, 0xdb, 0x6b, 0xbb, 0x60, 0xbe, 0x66, 0x53, 0xea, 0x05, 0xe7,
+	0x8c, 0x6c, 0x40, 0x5e, 0x48, 0x8f, 0x4b, 0xc7, 0xd8, 0x32, 0xea, 0x16, 0xd5, 0x0a, 0xb1, 0x21,
+	0xcb, 0x82, 0xa1, 0x93, 0x41, 0x9b, 0x12, 0x6b, 0x8f, 0xa0, 0xd4, 0xf7, 0x4e, 0x47, 0x4c, 0x36,
+	0x47, 0xbe, 0x27, 0x08, 0x81, 0xdc, 0x80, 0x8d, 0x46, 0x18, 0x55, 0xa4, 0x28, 0xab, 0xa0, 0x4b,
+	0x5f, 0x07, 0x95, 0xa9, 0x12, 0x6b, 0x7f, 0xe7, 0xa0, 0xa0, 0xa3, 0xc8, 0xa7, 0x90, 0xf7, 0x54,
+	0x24, 0x46, 0x94, 0x76, 0xef, 0x37, 0x66, 0xb5, 0xa6, 0xd2, 0x52, 0xed, 0x43, 0xaa, 0x60, 0xbe,
+	0x0d, 0x85, 0x0c, 0xbc, 0x31, 0xc3, 0x74, 0x45, 0x3a, 0xd3, 0xc9, 0x1e, 0x98, 0x51, 0xc8, 0xa5,
+	0x3b, 0xf6, 0x22, 0x27, 0xb7, 0x95, 0xad, 0x97, 0x76, 0x1f, 0x2c, 0xe7, 0x6a, 0x74, 0x43, 0x2e,
+	0x8f, 0xbc, 0xa8, 0x1d, 0x48, 0x3e, 0xa5, 0x6b, 0x91, 0xd6, 0x54, 0xd6, 0x0b, 0x36, 0x15, 0x91,
+	0x37, 0x60, 0x4e, 0x5e, 0x67, 0x4d, 0x74, 0x1c, 0xc3, 0x5b, 0x8f, 0x0f, 0x9d, 0x02, 0x1e, 0x68,
+	0x85, 0xec, 0x40, 0xf1, 0x82, 0x4d, 0x5d, 0xae, 0x26, 0xe5, 0xac, 0x61, 0xe1, 0x64, 0xfe, 0xb1,
+	0x64, 0x86, 0x98, 0x06, 0x25, 0x52, 0x87, 0x9c, 0x9c, 0x46, 0xcc, 0x31, 0xb7, 0x8c, 0x7a, 0x65,
+	0x77, 0x63, 0xb9, 0xb0, 0xfe, 0x34, 0x62, 0x14, 0x3d, 0x48, 0x1d, 0xec, 0xe1, 0xa9, 0xab, 0x3a,
+	0x72, 0xc3, 0x09, 0xe3, 0xdc, 0x1f, 0x32, 0xa7, 0x88, 0xdf, 0xae, 0x0c, 0x4f, 0x3b, 0xde, 0x98,
+	0x1d, 0xc7, 0x56, 0xd2, 0x80, 0x9c, 0xf4, 0xce, 0x85, 0x03, 0xd8, 0x6c, 0x75, 0xa5, 0xd9, 0xbe,
+	0x77, 0x2e, 0x74, 0xa7, 0xe8, 0x47, 0x1e, 0x42, 0x65, 0x3c, 0x15, 0xef, 0x46, 0xee, 0x6c, 0x84,
+	0x16, 0xe6, 0x2d, 0xa3, 0xf5, 0x65, 0x32, 0xc7, 0x07, 0x00, 0xda, 0x4d, 0x8d, 0xc7, 0x29, 0x6f,
+	0x19, 0xf5, 0x3c, 0x2d, 0xa2, 0x45, 0x4d, 0x8f, 0x34, 0x61, 0x73, 0xec, 0x09, 0xc9, 0xb8, 0x2b,
+	0x19, 0x1f, 0xbb, 0x08, 0x0b, 0x57, 0x61, 0xc8, 0xa9, 0xe0, 0x1c, 0xac, 0x46, 0x0c, 0xa9, 0xbe,
+	0x3f, 0x66, 0xf4, 0x9e, 0xf6, 0xed, 0x33, 0x3e, 0xee, 0x29, 0x4f, 0x65, 0xac, 0x3e, 0x05, 0x2b,
+	0x7d, 0x11, 0x0a, 0x1f, 0x17, 0x6c, 0x1a, 0x43, 0x46, 0x89, 0x6a, 0xea, 0x13, 0x6f, 0x74, 0xa9,
+	0x2f, 0x39, 0x4f, 0xb5, 0xf2, 0x34, 0xb3, 0x67, 0x54, 0xbf, 0x82, 0xe2, 0xac, 0xaf, 0x7f, 0x0b,
+	0x2c, 0xa6, 0x02, 0x5f, 0xe5, 0xcc, 0xac, 0x9d, 0x7b, 0x95, 0x33, 0x4b, 0xb6, 0x55, 0xfb, 0xbd,
+	0x00, 0xf9, 0x1e, 0x5e, 0xe4, 0x1e, 0x58, 0x71, 0x37, 0x37, 0x00, 0x61, 0x49, 0xbb, 0xa2, 0x72,
+	0xcd, 0x1c, 0xcc, 0x1b, 0xce, 0x61, 0x11, 0x45, 0x99, 0x1b, 0xa0, 0xe8, 0x1b, 0xb0, 0x04, 0xe3,
+	0x13, 0x36, 0x74, 0x15, 0x54, 0x84, 0x93, 0x5d, 0xbe, 0x79, 0x6c, 0xaa, 0xd1, 0x43, 0x1f, 0xc4,
+	0x54, 0x49, 0xcc, 0x64, 0x41, 0x9e, 0x41, 0x59, 0x84, 0x97, 0x7c, 0xc0, 0x5c, 0x44, 0xb1, 0x88,
+	0xd7, 0xe4, 0x7f, 0x2b, 0xf1, 0xe8, 0x84, 0x32, 0xb5, 0xc4, 0x5c, 0x11, 0xe4, 0x05, 0xac, 0x4b,
+	0x1c, 0x88, 0x3b, 0x08, 0x03, 0xc9, 0xc3, 0x91, 0x70, 0x0a, 0xcb, 0xab, 0xa6, 0x73, 0xe8, 0xb9,
+	0xb5, 0xb4, 0x17, 0xad, 0xc8, 0xb4, 0x2a, 0xc8, 0x36, 0xdc, 0xf5, 0x85, 0x1b, 0xcf, 0x4f, 0x95,
+	0xe8, 0x07, 0xe7, 0xb8, 0x47, 0x26, 0x5d, 0xf7, 0xc5, 0x11, 0xda, 0x7b, 0xda, 0x5c, 0x7d, 0x03,
+	0x30, 0x6f, 0x88, 0x3c, 0x81, 0x52, 0x5c, 0x01, 0xee, 0x93, 0x71, 0xcd, 0x3e, 0x81, 0x9c, 0xc9,
+	0x0a, 0x17, 0x8a, 0x8a, 0x84, 0x93, 0xd9, 0xca, 0x2a, 0x5c, 0xa0, 0x52, 0xfd, 0xd5, 0x80, 0x52,
+	0xaa, 0xd9, 0x84, 0xa8, 0x8c, 0x19, 0x51, 0x2d, 0x50, 0x43, 0xe6, 0x2a, 0x6a, 0xc8, 0x5e, 0x49,
+	0x0d, 0xb9, 0x1b, 0x5c, 0xea, 0x26, 0x14, 0xb0, 0x50, 0xe1, 0xe4, 0xb1, 0xb6, 0x58, 0xab, 0xfe,
+	0x66, 0x40, 0x79, 0x61, 0x8a, 0xb7, 0xda, 0x3b, 0xf9, 0x0c, 0xc8, 0xe9, 0xc8, 0x1b, 0x5c, 0x8c,
+	0x7c, 0x21, 0x15, 0xa0, 0x74, 0x09, 0x39, 0x74, 0xb9, 0x9b, 0x3a, 0xc1, 0xa4, 0x42, 0x55, 0x79,
+	0xc6, 0xc3, 0x1f, 0x59, 0x80, 0x0c, 0x69, 0xd2, 0x58, 0x9b, 0xad, 0x55, 0xde, 0x2e, 0xd4, 0xfe,
+	0xc8, 0xe2, 0xfb, 0xa1, 0xa7, 0xf3, 0x39, 0x6c, 0xe0, 0x40, 0xfc, 0xe0, 0xdc, 0x1d, 0x84, 0xa3,
+	0xcb, 0x71, 0x80, 0xa4, 0x16, 0x2f, 0x2b, 0x49, 0xce, 0x5a, 0x78, 0xa4, 0x78, 0x8d, 0xbc, 0x5a,
+	0x8d, 0xc0, 0x3e, 0x33, 0xd8, 0xa7, 0xb3, 0x30, 0x44, 0xfc, 0xc6, 0x81, 0xc6, 0xf8, 0x52, 0x2e,
+	0xec, 0xf9, 0xd9, 0x6c, 0x53, 0xce, 0x78, 0x38, 0x16, 0xab, 0x0f, 0x42, 0x92, 0x23, 0x5e, 0x96,
+	0x17, 0x3c, 0x1c, 0x27, 0xcb, 0xa2, 0x64, 0x41, 0xbe, 0x86, 0x72, 0x72, 0xd3, 0xba, 0x8c, 0x3c,
+	0x96, 0xb1, 0xb9, 0x9a, 0x02, 0x8b, 0xb0, 0x2e, 0x52, 0x1a, 0xf9, 0x18, 0xca, 0xa7, 0x9e, 0x60,
+	0xee, 0x0c, 0x3b, 0xfa, 0xf5, 0xb0, 0x94, 0x71, 0x36, 0xa1, 0x2f, 0xa0, 0x2c, 0x02, 0x2f, 0x12,
+	0x6f, 0xc3, 0x98, 0x38, 0xd6, 0xde, 0x43, 0x1c, 0x56, 0xe2, 0x82, 0xcc, 0x79, 0x99, 0xec, 0x82,
+	0xaa, 0xf1, 0x76, 0xf1, 0x90, 0x46, 0x7a, 0x76, 0x11, 0xe9, 0xfa, 0x92, 0x6b, 0x3f, 0x19, 0x60,
+	0x6b, 0x52, 0x60, 0xd1, 0xc8, 0x1f, 0x78, 0xd2, 0x0f, 0x03, 0xf2, 0x04, 0xf2, 0x41, 0x38, 0x64,
+	0x8a, 0x39, 0xd5, 0x84, 0x3f, 0x5a, 0xe2, 0x81, 0x94, 0x6b, 0xa3, 0x13, 0x0e, 0x19, 0xd5, 0xde,
+	0xd5, 0x67, 0x90, 0x53, 0xaa, 0xe2, 0xdf, 0xb8, 0x85, 0x9b, 0xf0, 0xaf, 0x9c, 0x2b, 0xb5, 0x13,
+	0xa8, 0xc4, 0x5f, 0x38, 0x63, 0x9c, 0x05, 0x03, 0xa6, 0x7e, 0x7a, 0xa4, 0x10, 0x86, 0xf2, 0x07,
+	0x53, 0x6c, 0xed, 0x67, 0x03, 0x08, 0xe6, 0x5d, 0x5c, 0xbd, 0xdb, 0xc8, 0x4d, 0x1e, 0xc3, 0xe6,
+	0xbb, 0x4b, 0xc6, 0xa7, 0x9a, 0xf1, 0x06, 0xcc, 0x1d, 0xfa, 0x42, 0x7d, 0x45, 0x33, 0x88, 0x49,
+	0x37, 0xf0, 0xb4, 0xa7, 0x0f, 0xf7, 0xe3, 0xb3, 0xda, 0x5f, 0x39, 0x28, 0xf5, 0xf8, 0x64, 0x06,
+	0x9b, 0x6f, 0x01, 0x22, 0x8f, 0x4b, 0x5f, 0xcd, 0x34, 0x19, 0xfb, 0x27, 0xa9, 0xb1, 0xcf, 0x5d,
+	0x67, 0x08, 0xed, 0x26, 0xfe, 0x34, 0x15, 0x7a, 0xe5, 0x86, 0x66, 0x3e, 0x78, 0x43, 0xb3, 0xff,
+	0x61, 0x43, 0x9b, 0x50, 0x4a, 0x6d, 0x68, 0xbc, 0xa0, 0x5b, 0xef, 0xef, 0x23, 0xb5, 0xa3, 0x30,
+	0xdf, 0xd1, 0xea, 0x9f, 0x06, 0xdc, 0x5d, 0x69, 0x51, 0x6d, 0x45, 0xea, 0x91, 0xbc, 0x7e, 0x2b,
+	0xe6, 0xaf, 0x23, 0x69, 0x81, 0x8d, 0x55, 0xba, 0x3c, 0x01, 0x94, 0x5e, 0x90, 0x52, 0xba, 0xaf,
+	0x45, 0xc4, 0xd1, 0x75, 0xb1, 0xa0, 0x0b, 0xd2, 0x85, 0xfb, 0x3a, 0xc9, 0xf2, 0x2b, 0xa9, 0x5f,
+	0xea, 0xff, 0x2f, 0x65, 0x5a, 0x7c, 0x24, 0xef, 0x89, 0x15, 0x9b, 0xa8, 0xba, 0xb7, 0xb1, 0xf1,
+	0xd7, 0xbc, 0x62, 0x31, 0x75, 0x47, 0x60, 0xb6, 0xd8, 0x68, 0x74, 0x10, 0x9c, 0x85, 0xea, 0x77,
+	0x22, 0xce, 0x85, 0xbb, 0xde, 0x70, 0xc8, 0x99, 0x10, 0x31, 0xea, 0xcb, 0xda, 0xda, 0xd4, 0x46,
+	0xb5, 0x12, 0x3c, 0x0c, 0x65, 0x9c, 0x10, 0x65, 0xc5, 0x7b, 0x43, 0xee, 0xf9, 0x81, 0x1b, 0x31,
+	0x3e, 0x60, 0x81, 0xc4, 0x07, 0x30, 0x4f, 0x2d, 0x34, 0x76, 0xb5, 0x2d, 0x66, 0x93, 0x1a, 0x80,
+	0xfa, 0xa2, 0xd0, 0xbf, 0xa6, 0xde, 0xcb, 0x49, 0xdb, 0x75, 0xb0, 0xd2, 0x24, 0x4b, 0x00, 0x0a,
+	0x9d, 0x63, 0x7a, 0xd4, 0x3c, 0xb4, 0xef, 0x10, 0x0b, 0xcc, 0x5e, 0xa7, 0xd9, 0xed, 0xbd, 0x3c,
+	0xee, 0xdb, 0xc6, 0xf6, 0x2e, 0x54, 0x16, 0x31, 0x47, 0x8a, 0x90, 0x3f, 0xe9, 0xf4, 0xda, 0x7d,
+	0xfb, 0x8e, 0x0a, 0x3b, 0x39, 0xe8, 0xf4, 0xbf, 0x7c, 0x6c, 0x1b, 0xca, 0xfc, 0xfc, 0x4d, 0xbf,
+	0xdd, 0xb3, 0x33, 0xdb, 0xbf, 0x18, 0x00, 0xf3, 0x81, 0x91, 0x12, 0xac, 0x9d, 0x74, 0x5e, 0x77,
+	0x8e, 0xbf, 0xeb, 0xe8, 0x90, 0xa3, 0x66, 0xaf, 0xdf, 0xa6, 0xb6, 0xa1, 0x0e, 0x68, 0xbb, 0x7b,
+	0x78, 0xd0, 0x6a, 0xda, 0x19, 0x75, 0x40, 0xf7, 0x8f, 0x3b, 0x87, 0x6f, 0xec, 0x2c, 0xe6, 0x6a,
+	0xf6, 0x5b, 0x2f, 0xb5, 0xd8, 0xeb, 0x36, 0x69, 0xdb, 0xce, 0x11, 0x1b, 0xac, 0xf6, 0xf7, 0xdd,
+	0x36, 0x3d, 0x38, 0x6a, 0x77, 0xfa, 0xcd, 0x43, 0x3b, 0xaf, 0x62, 0x9e, 0x37, 0x5b, 0xaf, 0x4f,
+	0xba, 0x76, 0x41, 0x27, 0xeb, 0xf5, 0x8f, 0x69, 0xdb, 0x5e, 0x53, 0xca, 0x3e, 0x6d, 0x1e, 0x74,
+	0xda, 0xfb, 0xb6, 0x59, 0xcd, 0xd8, 0xc6, 0xf3, 0x3d, 0x58, 0xf7, 0xc3, 0xc6, 0xc4, 0x97, 0x4c,
+	0x08, 0xfd, 0x9f, 0xec, 0x87, 0x87, 0xb1, 0xe6, 0x87, 0x3b, 0x5a, 0xda, 0x39, 0x0f, 0x77, 0x26,
+	0x72, 0x07, 0x4f, 0x77, 0x92, 0x9b, 0x3f, 0x2d, 0xa0, 0xfe, 0xe8, 0x9f, 0x01, 0x00, 0xf5, 0x08,
+	0xaf, 0x97, 0xeb, 0x0d, 0x00, 0x00,
 }
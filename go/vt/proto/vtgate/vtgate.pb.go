@@ -158,7 +158,15 @@ type Session struct {
 	// Session UUID
 	SessionUUID string `protobuf:"bytes,22,opt,name=SessionUUID,proto3" json:"SessionUUID,omitempty"`
 	// enable_system_settings defines if we can use reserved connections.
-	EnableSystemSettings bool     `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	EnableSystemSettings bool `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	// consistent_snapshot makes the transactions of the session start
+	// consistent snapshots on all the shards of the target keyspace,
+	// at coordinated positions.
+	ConsistentSnapshot bool `protobuf:"varint,24,opt,name=consistent_snapshot,json=consistentSnapshot,proto3" json:"consistent_snapshot,omitempty"`
+	// read_as_of makes the reads of the session on rdonly tablets go to
+	// the delayed replicas, to read the data as of about that many
+	// seconds ago. 0 reads the current data.
+	ReadAsOf             int64    `protobuf:"varint,25,opt,name=read_as_of,json=readAsOf,proto3" json:"read_as_of,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -343,6 +351,20 @@ func (m *Session) GetEnableSystemSettings() bool {
 	return false
 }
 
+func (m *Session) GetConsistentSnapshot() bool {
+	if m != nil {
+		return m.ConsistentSnapshot
+	}
+	return false
+}
+
+func (m *Session) GetReadAsOf() int64 {
+	if m != nil {
+		return m.ReadAsOf
+	}
+	return 0
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -779,10 +801,12 @@ type StreamExecuteRequest struct {
 	KeyspaceShard string                `protobuf:"bytes,4,opt,name=keyspace_shard,json=keyspaceShard,proto3" json:"keyspace_shard,omitempty"`
 	Options       *query.ExecuteOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
 	// session carries the session state.
-	Session              *Session `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Session *Session `protobuf:"bytes,6,opt,name=session,proto3" json:"session,omitempty"`
+	// result_format is the format of the results, ROWS by default.
+	ResultFormat         query.ResultFormat `protobuf:"varint,7,opt,name=result_format,json=resultFormat,proto3,enum=query.ResultFormat" json:"result_format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *StreamExecuteRequest) Reset()         { *m = StreamExecuteRequest{} }
@@ -852,6 +876,13 @@ func (m *StreamExecuteRequest) GetSession() *Session {
 	return nil
 }
 
+func (m *StreamExecuteRequest) GetResultFormat() query.ResultFormat {
+	if m != nil {
+		return m.ResultFormat
+	}
+	return query.ResultFormat_ROWS
+}
+
 // StreamExecuteResponse is the returned value from StreamExecute.
 // The session is currently not returned because StreamExecute is
 // not expected to modify it.
@@ -859,10 +890,14 @@ type StreamExecuteResponse struct {
 	// result contains the result data.
 	// The first value contains only Fields information.
 	// The next values contain the actual rows, a few values per result.
-	Result               *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// arrow is the next part of the Arrow IPC stream of the results,
+	// when the request asked for the ARROW format. The responses then
+	// have no result.
+	Arrow                []byte   `protobuf:"bytes,2,opt,name=arrow,proto3" json:"arrow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamExecuteResponse) Reset()         { *m = StreamExecuteResponse{} }
@@ -897,6 +932,13 @@ func (m *StreamExecuteResponse) GetResult() *query.QueryResult {
 	return nil
 }
 
+func (m *StreamExecuteResponse) GetArrow() []byte {
+	if m != nil {
+		return m.Arrow
+	}
+	return nil
+}
+
 // ResolveTransactionRequest is the payload to ResolveTransaction.
 type ResolveTransactionRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -1110,92 +1152,96 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0xb7,
-	0x12, 0xce, 0xea, 0x5f, 0xa3, 0xbf, 0x35, 0x2d, 0x3b, 0x1b, 0x9f, 0x9c, 0x73, 0x04, 0x25, 0x41,
-	0x94, 0x9c, 0x03, 0xbb, 0x75, 0xff, 0x82, 0xa2, 0x45, 0x6b, 0xcb, 0x4a, 0xaa, 0xc0, 0x8e, 0x5c,
-	0x4a, 0xb6, 0x81, 0xa2, 0xc5, 0x62, 0xad, 0xa5, 0x65, 0xc2, 0xf2, 0x52, 0x21, 0x29, 0xb9, 0x7a,
-	0x8a, 0xde, 0xf7, 0x05, 0x7a, 0xd3, 0xfb, 0xbe, 0x43, 0xef, 0xf2, 0x46, 0x05, 0xc9, 0x5d, 0x69,
-	0xa5, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0xc2, 0x72, 0xbe, 0xe1, 0x70, 0x38, 0xdf, 0xfc, 0x50, 0x50,
-	0x9c, 0xc8, 0x81, 0x27, 0xc9, 0xe6, 0x88, 0x33, 0xc9, 0x50, 0xc6, 0xac, 0x36, 0xec, 0x53, 0x1a,
-	0x0c, 0xd9, 0xc0, 0xf7, 0xa4, 0x67, 0x90, 0x8d, 0xc2, 0xcb, 0x31, 0xe1, 0xd3, 0x70, 0x51, 0x96,
-	0x6c, 0xc4, 0xe2, 0xe0, 0x44, 0xf2, 0x51, 0xdf, 0x2c, 0xea, 0xaf, 0x0a, 0x90, 0xed, 0x12, 0x21,
-	0x28, 0x0b, 0xd0, 0x03, 0x28, 0xd3, 0xc0, 0x95, 0xdc, 0x0b, 0x84, 0xd7, 0x97, 0x94, 0x05, 0x8e,
-	0x55, 0xb3, 0x1a, 0x39, 0x5c, 0xa2, 0x41, 0x6f, 0x2e, 0x44, 0x4d, 0x28, 0x8b, 0x73, 0x8f, 0xfb,
-	0xae, 0x30, 0xfb, 0x84, 0x93, 0xa8, 0x25, 0x1b, 0x85, 0xed, 0xbb, 0x9b, 0xa1, 0x77, 0xa1, 0xbd,
-	0xcd, 0xae, 0xd2, 0x0a, 0x17, 0xb8, 0x24, 0x62, 0x2b, 0x81, 0xfe, 0x03, 0xe0, 0x8d, 0x25, 0xeb,
-	0xb3, 0xcb, 0x4b, 0x2a, 0x9d, 0x94, 0x3e, 0x27, 0x26, 0x41, 0xf7, 0xa0, 0x24, 0x3d, 0x3e, 0x20,
-	0xd2, 0x15, 0x92, 0xd3, 0x60, 0xe0, 0xa4, 0x6b, 0x56, 0x23, 0x8f, 0x8b, 0x46, 0xd8, 0xd5, 0x32,
-	0xb4, 0x05, 0x59, 0x36, 0x92, 0xda, 0x85, 0x4c, 0xcd, 0x6a, 0x14, 0xb6, 0xd7, 0x36, 0xcd, 0xc5,
-	0x5b, 0x3f, 0x93, 0xfe, 0x58, 0x92, 0x8e, 0x01, 0x71, 0xa4, 0x85, 0x76, 0xc1, 0x8e, 0x5d, 0xcf,
-	0xbd, 0x64, 0x3e, 0x71, 0xb2, 0x35, 0xab, 0x51, 0xde, 0xbe, 0x1d, 0x39, 0x1f, 0xbb, 0xe9, 0x01,
-	0xf3, 0x09, 0xae, 0xc8, 0x45, 0x01, 0xda, 0x82, 0xdc, 0x95, 0xc7, 0x03, 0x1a, 0x0c, 0x84, 0x93,
-	0xd3, 0x17, 0x5f, 0x0d, 0x4f, 0xfd, 0x5e, 0xfd, 0x9e, 0x18, 0x0c, 0xcf, 0x94, 0xd0, 0x37, 0x50,
-	0x1c, 0x71, 0x32, 0x8f, 0x56, 0xfe, 0x06, 0xd1, 0x2a, 0x8c, 0x38, 0x99, 0xc5, 0x6a, 0x07, 0x4a,
-	0x23, 0x26, 0xe4, 0xdc, 0x02, 0xdc, 0xc0, 0x42, 0x51, 0x6d, 0x99, 0x99, 0xb8, 0x0f, 0xe5, 0xa1,
-	0x27, 0xa4, 0x4b, 0x03, 0x41, 0xb8, 0x74, 0xa9, 0xef, 0x14, 0x6a, 0x56, 0x23, 0x85, 0x8b, 0x4a,
-	0xda, 0xd6, 0xc2, 0xb6, 0x8f, 0xfe, 0x0d, 0x70, 0xc6, 0xc6, 0x81, 0xef, 0x72, 0x76, 0x25, 0x9c,
-	0xa2, 0xd6, 0xc8, 0x6b, 0x09, 0x66, 0x57, 0x02, 0xb9, 0xb0, 0x3e, 0x16, 0x84, 0xbb, 0x3e, 0x39,
-	0xa3, 0x01, 0xf1, 0xdd, 0x89, 0xc7, 0xa9, 0x77, 0x3a, 0x24, 0xc2, 0x29, 0x69, 0x87, 0x1e, 0x2d,
-	0x3b, 0x74, 0x24, 0x08, 0xdf, 0x33, 0xca, 0xc7, 0x91, 0x6e, 0x2b, 0x90, 0x7c, 0x8a, 0xab, 0xe3,
-	0x6b, 0x20, 0xd4, 0x01, 0x5b, 0x4c, 0x85, 0x24, 0x97, 0x31, 0xd3, 0x65, 0x6d, 0xfa, 0xfe, 0x6b,
-	0x77, 0xd5, 0x7a, 0x4b, 0x56, 0x2b, 0x62, 0x51, 0x8a, 0xfe, 0x05, 0x79, 0xce, 0xae, 0xdc, 0x3e,
-	0x1b, 0x07, 0xd2, 0xa9, 0xd4, 0xac, 0x46, 0x12, 0xe7, 0x38, 0xbb, 0x6a, 0xaa, 0xb5, 0x4a, 0x41,
-	0xe1, 0x4d, 0xc8, 0x88, 0xd1, 0x40, 0x0a, 0xc7, 0xae, 0x25, 0x1b, 0x79, 0x1c, 0x93, 0xa0, 0x06,
-	0xd8, 0x34, 0x70, 0x39, 0x11, 0x84, 0x4f, 0x88, 0xef, 0xf6, 0x59, 0x10, 0x38, 0x2b, 0x3a, 0x51,
-	0xcb, 0x34, 0xc0, 0xa1, 0xb8, 0xc9, 0x82, 0x40, 0x31, 0x3c, 0x64, 0xfd, 0x8b, 0x88, 0x20, 0x07,
-	0xe9, 0x64, 0x7c, 0x03, 0xc3, 0x6a, 0x47, 0x54, 0x79, 0x9b, 0xb0, 0xaa, 0xe9, 0xd1, 0x56, 0xce,
-	0x89, 0xc7, 0xe5, 0x29, 0xf1, 0xa4, 0xb3, 0xaa, 0x3d, 0x5e, 0x51, 0xd0, 0x3e, 0xeb, 0x5f, 0x7c,
-	0x17, 0x01, 0xe8, 0x5b, 0xb0, 0x39, 0xf1, 0x7c, 0xd7, 0x3b, 0x93, 0x84, 0xbb, 0x57, 0x9c, 0x4a,
-	0xe2, 0x54, 0xf5, 0xa1, 0xeb, 0xd1, 0xa1, 0x98, 0x78, 0xfe, 0x8e, 0x82, 0x4f, 0x14, 0x8a, 0xcb,
-	0x7c, 0x61, 0x8d, 0x6a, 0x50, 0xd8, 0xdb, 0xdb, 0xef, 0x4a, 0xee, 0x49, 0x32, 0x98, 0x3a, 0x6b,
-	0xba, 0xba, 0xe2, 0x22, 0xa5, 0x11, 0xba, 0x77, 0x74, 0xd4, 0xde, 0x73, 0xd6, 0x8d, 0x46, 0x4c,
-	0x84, 0x3e, 0x85, 0x75, 0x12, 0xa8, 0x40, 0xbb, 0x21, 0x6b, 0x82, 0x48, 0xa9, 0xeb, 0xe2, 0xb6,
-	0x0e, 0x53, 0xd5, 0xa0, 0x86, 0xaa, 0x6e, 0x88, 0x6d, 0xfc, 0x61, 0x41, 0x31, 0x1e, 0x09, 0xf4,
-	0x00, 0x32, 0xa6, 0xaa, 0x75, 0xbb, 0x29, 0x6c, 0x97, 0xc2, 0x72, 0xea, 0x69, 0x21, 0x0e, 0x41,
-	0xd5, 0x9d, 0xe2, 0xb5, 0x4b, 0x7d, 0x27, 0xa1, 0xc3, 0x53, 0x8a, 0x49, 0xdb, 0x3e, 0x7a, 0x02,
-	0x45, 0xa9, 0x4e, 0x95, 0xae, 0x37, 0xa4, 0x9e, 0x70, 0x92, 0x61, 0x63, 0x98, 0x35, 0xc1, 0x9e,
-	0x46, 0x77, 0x14, 0x88, 0x0b, 0x72, 0xbe, 0x40, 0xff, 0x85, 0xc2, 0x8c, 0x6c, 0xea, 0xeb, 0x9e,
-	0x94, 0xc4, 0x10, 0x89, 0xda, 0xfe, 0xc6, 0x8f, 0x70, 0xe7, 0x6f, 0x33, 0x1a, 0xd9, 0x90, 0xbc,
-	0x20, 0x53, 0x7d, 0x85, 0x3c, 0x56, 0x9f, 0xe8, 0x11, 0xa4, 0x27, 0xde, 0x70, 0x4c, 0xb4, 0x9f,
-	0xf3, 0x2e, 0xb1, 0x4b, 0x83, 0xd9, 0x5e, 0x6c, 0x34, 0xbe, 0x4c, 0x3c, 0xb1, 0x36, 0x76, 0xa1,
-	0x7a, 0x5d, 0x52, 0x5f, 0x63, 0xb8, 0x1a, 0x37, 0x9c, 0x8f, 0xd9, 0x78, 0x9e, 0xca, 0x25, 0xed,
-	0x54, 0xfd, 0x77, 0x0b, 0xca, 0x8b, 0xf4, 0xa3, 0x8f, 0x61, 0x6d, 0x39, 0x61, 0xdc, 0x81, 0xa4,
-	0x7e, 0x68, 0x16, 0x2d, 0x66, 0xc7, 0x33, 0x49, 0x7d, 0xf4, 0x05, 0x38, 0xaf, 0x6d, 0x91, 0xf4,
-	0x92, 0xb0, 0xb1, 0xd4, 0x07, 0x5b, 0x78, 0x6d, 0x71, 0x57, 0xcf, 0x80, 0x2a, 0x99, 0xc3, 0x42,
-	0x50, 0xb3, 0xa4, 0x7f, 0xa1, 0x0f, 0x32, 0x44, 0xe4, 0xf0, 0x4a, 0x08, 0xf5, 0x14, 0xa2, 0xce,
-	0x11, 0xf5, 0xdf, 0x12, 0x50, 0x0e, 0x1b, 0x36, 0x26, 0x2f, 0xc7, 0x44, 0x48, 0xf4, 0x7f, 0xc8,
-	0xf7, 0xbd, 0xe1, 0x90, 0x70, 0x37, 0x74, 0xb1, 0xb0, 0x5d, 0xd9, 0x34, 0x63, 0xab, 0xa9, 0xe5,
-	0xed, 0x3d, 0x9c, 0x33, 0x1a, 0x6d, 0x1f, 0x3d, 0x82, 0x6c, 0x54, 0x79, 0x89, 0x99, 0x6e, 0xbc,
-	0xf2, 0x70, 0x84, 0xa3, 0x87, 0x90, 0xd6, 0x2c, 0x84, 0x69, 0xb1, 0x12, 0x71, 0xa2, 0x7a, 0x9c,
-	0x6e, 0xdf, 0xd8, 0xe0, 0xe8, 0x33, 0x08, 0x73, 0xc3, 0x95, 0xd3, 0x11, 0xd1, 0xc9, 0x50, 0xde,
-	0xae, 0x2e, 0x67, 0x51, 0x6f, 0x3a, 0x22, 0x18, 0xe4, 0xec, 0x5b, 0x25, 0xe9, 0x05, 0x99, 0x8a,
-	0x91, 0xd7, 0x27, 0xae, 0x1e, 0x78, 0x7a, 0x30, 0xe5, 0x71, 0x29, 0x92, 0xea, 0xcc, 0x8f, 0x0f,
-	0xae, 0xec, 0x4d, 0x06, 0xd7, 0xf3, 0x54, 0x2e, 0x6d, 0x67, 0xea, 0xbf, 0x58, 0x50, 0x99, 0x45,
-	0x4a, 0x8c, 0x58, 0x20, 0xd4, 0x89, 0x69, 0xc2, 0x39, 0xe3, 0x4b, 0x61, 0xc2, 0x87, 0xcd, 0x96,
-	0x12, 0x63, 0x83, 0xbe, 0x4d, 0x8c, 0x1e, 0x43, 0x86, 0x13, 0x31, 0x1e, 0xca, 0x30, 0x48, 0x28,
-	0x3e, 0xde, 0xb0, 0x46, 0x70, 0xa8, 0x51, 0x7f, 0x95, 0x80, 0xd5, 0xd0, 0xa3, 0x5d, 0x4f, 0xf6,
-	0xcf, 0x3f, 0x38, 0x81, 0xff, 0x83, 0xac, 0xf2, 0x86, 0x12, 0x95, 0x50, 0xc9, 0xeb, 0x29, 0x8c,
-	0x34, 0xde, 0x83, 0x44, 0x4f, 0x2c, 0xbc, 0x83, 0xd2, 0xe6, 0x1d, 0xe4, 0x89, 0xf8, 0x3b, 0xe8,
-	0x03, 0x71, 0x5d, 0xff, 0xd5, 0x82, 0xea, 0x62, 0x4c, 0x3f, 0x18, 0xd5, 0x1f, 0x41, 0xd6, 0x10,
-	0x19, 0x45, 0x73, 0x3d, 0xf4, 0xcd, 0xd0, 0x7c, 0x42, 0xe5, 0xb9, 0x31, 0x1d, 0xa9, 0xa9, 0x62,
-	0xad, 0x76, 0x25, 0x27, 0xde, 0xe5, 0x7b, 0x95, 0xec, 0xac, 0x0e, 0x13, 0x6f, 0x57, 0x87, 0xc9,
-	0x77, 0xae, 0xc3, 0xd4, 0x1b, 0xb8, 0x49, 0xdf, 0xe8, 0x01, 0x19, 0x8b, 0x6d, 0xe6, 0x9f, 0x63,
-	0x5b, 0x6f, 0xc2, 0xda, 0x52, 0xa0, 0x42, 0x1a, 0xe7, 0xf5, 0x65, 0xbd, 0xb1, 0xbe, 0x7e, 0x82,
-	0x3b, 0x98, 0x08, 0x36, 0x9c, 0x90, 0x58, 0xe6, 0xbd, 0x5b, 0xc8, 0x11, 0xa4, 0x7c, 0x19, 0x4e,
-	0xcd, 0x3c, 0xd6, 0xdf, 0xf5, 0xbb, 0xb0, 0x71, 0x9d, 0x79, 0xe3, 0x68, 0xfd, 0x4f, 0x0b, 0xca,
-	0xc7, 0xe6, 0x0e, 0xef, 0x76, 0xe4, 0x12, 0x79, 0x89, 0x1b, 0x92, 0xf7, 0x10, 0xd2, 0x13, 0x3d,
-	0x9c, 0xa2, 0x26, 0x1d, 0xfb, 0x7f, 0x73, 0xac, 0x66, 0x06, 0x36, 0xb8, 0x8a, 0xe4, 0x19, 0x1d,
-	0x4a, 0xc2, 0x35, 0xbb, 0x2a, 0x92, 0x31, 0xcd, 0xa7, 0x1a, 0xc1, 0xa1, 0x46, 0xfd, 0x6b, 0xa8,
-	0xcc, 0xee, 0x32, 0x27, 0x82, 0x4c, 0x88, 0x7a, 0xfc, 0x59, 0x3a, 0xf9, 0x17, 0xb6, 0x1f, 0xb7,
-	0x14, 0x84, 0x43, 0x8d, 0xc7, 0x7b, 0x50, 0x59, 0xfa, 0x67, 0x80, 0x2a, 0x50, 0x38, 0x7a, 0xd1,
-	0x3d, 0x6c, 0x35, 0xdb, 0x4f, 0xdb, 0xad, 0x3d, 0xfb, 0x16, 0x02, 0xc8, 0x74, 0xdb, 0x2f, 0x9e,
-	0xed, 0xb7, 0x6c, 0x0b, 0xe5, 0x21, 0x7d, 0x70, 0xb4, 0xdf, 0x6b, 0xdb, 0x09, 0xf5, 0xd9, 0x3b,
-	0xe9, 0x1c, 0x36, 0xed, 0xe4, 0xe3, 0xaf, 0xa0, 0xd0, 0xd4, 0xff, 0x6f, 0x3a, 0xdc, 0x27, 0x5c,
-	0x6d, 0x78, 0xd1, 0xc1, 0x07, 0x3b, 0xfb, 0xf6, 0x2d, 0x94, 0x85, 0xe4, 0x21, 0x56, 0x3b, 0x73,
-	0x90, 0x3a, 0xec, 0x74, 0x7b, 0x76, 0x02, 0x95, 0x01, 0x76, 0x8e, 0x7a, 0x9d, 0x66, 0xe7, 0xe0,
-	0xa0, 0xdd, 0xb3, 0x93, 0xbb, 0x9f, 0x43, 0x85, 0xb2, 0xcd, 0x09, 0x95, 0x44, 0x08, 0xf3, 0xf7,
-	0xed, 0x87, 0x7b, 0xe1, 0x8a, 0xb2, 0x2d, 0xf3, 0xb5, 0x35, 0x60, 0x5b, 0x13, 0xb9, 0xa5, 0xd1,
-	0x2d, 0x93, 0x9a, 0xa7, 0x19, 0xbd, 0xfa, 0xe4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x85,
-	0xad, 0x4f, 0x3e, 0x0e, 0x00, 0x00,
+	// 1456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xf6, 0xf0, 0xcd, 0xe2, 0x6b, 0xd4, 0xa2, 0xe4, 0xb1, 0xe2, 0x24, 0x04, 0x6d, 0xc3, 0xb4,
+	0x13, 0x88, 0x89, 0xf2, 0x32, 0x82, 0x04, 0x89, 0x44, 0xc9, 0x0e, 0x0d, 0xc9, 0x54, 0x9a, 0x94,
+	0x84, 0x04, 0x09, 0x06, 0x2d, 0x4e, 0x8b, 0x1a, 0x88, 0x9a, 0xa6, 0xbb, 0x9b, 0x54, 0xf8, 0x2b,
+	0x72, 0xcf, 0x29, 0xb7, 0xbd, 0xec, 0x7d, 0xff, 0xc3, 0xde, 0xf6, 0x27, 0xec, 0x3f, 0x59, 0xf4,
+	0x83, 0xe4, 0x90, 0xd6, 0x42, 0xb2, 0x0d, 0x5f, 0x88, 0xa9, 0xfa, 0xaa, 0xab, 0xeb, 0x5d, 0x4d,
+	0x28, 0x4e, 0xe4, 0x80, 0x48, 0xba, 0x3d, 0xe2, 0x4c, 0x32, 0x94, 0x31, 0xd4, 0x96, 0x7b, 0x1e,
+	0x46, 0x43, 0x36, 0x08, 0x88, 0x24, 0x06, 0xd9, 0x2a, 0xbc, 0x1f, 0x53, 0x3e, 0xb5, 0x44, 0x59,
+	0xb2, 0x11, 0x8b, 0x83, 0x13, 0xc9, 0x47, 0x7d, 0x43, 0xd4, 0xff, 0x5f, 0x84, 0x6c, 0x97, 0x0a,
+	0x11, 0xb2, 0x08, 0x3d, 0x83, 0x72, 0x18, 0xf9, 0x92, 0x93, 0x48, 0x90, 0xbe, 0x0c, 0x59, 0xe4,
+	0x39, 0x35, 0xa7, 0x91, 0xc3, 0xa5, 0x30, 0xea, 0x2d, 0x98, 0xa8, 0x05, 0x65, 0x71, 0x49, 0x78,
+	0xe0, 0x0b, 0x73, 0x4e, 0x78, 0x89, 0x5a, 0xb2, 0x51, 0xd8, 0x79, 0xbc, 0x6d, 0xad, 0xb3, 0xfa,
+	0xb6, 0xbb, 0x4a, 0xca, 0x12, 0xb8, 0x24, 0x62, 0x94, 0x40, 0x3f, 0x03, 0x20, 0x63, 0xc9, 0xfa,
+	0xec, 0xfa, 0x3a, 0x94, 0x5e, 0x4a, 0xdf, 0x13, 0xe3, 0xa0, 0x27, 0x50, 0x92, 0x84, 0x0f, 0xa8,
+	0xf4, 0x85, 0xe4, 0x61, 0x34, 0xf0, 0xd2, 0x35, 0xa7, 0x91, 0xc7, 0x45, 0xc3, 0xec, 0x6a, 0x1e,
+	0x6a, 0x42, 0x96, 0x8d, 0xa4, 0x36, 0x21, 0x53, 0x73, 0x1a, 0x85, 0x9d, 0x8d, 0x6d, 0xe3, 0xf8,
+	0xc1, 0x7f, 0x68, 0x7f, 0x2c, 0x69, 0xc7, 0x80, 0x78, 0x26, 0x85, 0xf6, 0xc0, 0x8d, 0xb9, 0xe7,
+	0x5f, 0xb3, 0x80, 0x7a, 0xd9, 0x9a, 0xd3, 0x28, 0xef, 0x3c, 0x9c, 0x19, 0x1f, 0xf3, 0xf4, 0x88,
+	0x05, 0x14, 0x57, 0xe4, 0x32, 0x03, 0x35, 0x21, 0x77, 0x43, 0x78, 0x14, 0x46, 0x03, 0xe1, 0xe5,
+	0xb4, 0xe3, 0xeb, 0xf6, 0xd6, 0xbf, 0xab, 0xdf, 0x33, 0x83, 0xe1, 0xb9, 0x10, 0xfa, 0x0b, 0x14,
+	0x47, 0x9c, 0x2e, 0xa2, 0x95, 0xbf, 0x47, 0xb4, 0x0a, 0x23, 0x4e, 0xe7, 0xb1, 0xda, 0x85, 0xd2,
+	0x88, 0x09, 0xb9, 0xd0, 0x00, 0xf7, 0xd0, 0x50, 0x54, 0x47, 0xe6, 0x2a, 0x9e, 0x42, 0x79, 0x48,
+	0x84, 0xf4, 0xc3, 0x48, 0x50, 0x2e, 0xfd, 0x30, 0xf0, 0x0a, 0x35, 0xa7, 0x91, 0xc2, 0x45, 0xc5,
+	0x6d, 0x6b, 0x66, 0x3b, 0x40, 0x3f, 0x05, 0xb8, 0x60, 0xe3, 0x28, 0xf0, 0x39, 0xbb, 0x11, 0x5e,
+	0x51, 0x4b, 0xe4, 0x35, 0x07, 0xb3, 0x1b, 0x81, 0x7c, 0xd8, 0x1c, 0x0b, 0xca, 0xfd, 0x80, 0x5e,
+	0x84, 0x11, 0x0d, 0xfc, 0x09, 0xe1, 0x21, 0x39, 0x1f, 0x52, 0xe1, 0x95, 0xb4, 0x41, 0x2f, 0x56,
+	0x0d, 0x3a, 0x11, 0x94, 0xef, 0x1b, 0xe1, 0xd3, 0x99, 0xec, 0x41, 0x24, 0xf9, 0x14, 0x57, 0xc7,
+	0xb7, 0x40, 0xa8, 0x03, 0xae, 0x98, 0x0a, 0x49, 0xaf, 0x63, 0xaa, 0xcb, 0x5a, 0xf5, 0xd3, 0x0f,
+	0x7c, 0xd5, 0x72, 0x2b, 0x5a, 0x2b, 0x62, 0x99, 0x8b, 0x7e, 0x02, 0x79, 0xce, 0x6e, 0xfc, 0x3e,
+	0x1b, 0x47, 0xd2, 0xab, 0xd4, 0x9c, 0x46, 0x12, 0xe7, 0x38, 0xbb, 0x69, 0x29, 0x5a, 0x95, 0xa0,
+	0x20, 0x13, 0x3a, 0x62, 0x61, 0x24, 0x85, 0xe7, 0xd6, 0x92, 0x8d, 0x3c, 0x8e, 0x71, 0x50, 0x03,
+	0xdc, 0x30, 0xf2, 0x39, 0x15, 0x94, 0x4f, 0x68, 0xe0, 0xf7, 0x59, 0x14, 0x79, 0x6b, 0xba, 0x50,
+	0xcb, 0x61, 0x84, 0x2d, 0xbb, 0xc5, 0xa2, 0x48, 0x65, 0x78, 0xc8, 0xfa, 0x57, 0xb3, 0x04, 0x79,
+	0xa8, 0xe6, 0xdc, 0x99, 0x9f, 0x82, 0x3a, 0x61, 0x09, 0xb4, 0x0d, 0xeb, 0x3a, 0x3d, 0x5a, 0xcb,
+	0x25, 0x25, 0x5c, 0x9e, 0x53, 0x22, 0xbd, 0x75, 0x6d, 0xf1, 0x9a, 0x82, 0x0e, 0x59, 0xff, 0xea,
+	0x6f, 0x33, 0x00, 0xfd, 0x15, 0x5c, 0x4e, 0x49, 0xe0, 0x93, 0x0b, 0x49, 0xb9, 0x7f, 0xc3, 0x43,
+	0x49, 0xbd, 0xaa, 0xbe, 0x74, 0x73, 0x76, 0x29, 0xa6, 0x24, 0xd8, 0x55, 0xf0, 0x99, 0x42, 0x71,
+	0x99, 0x2f, 0xd1, 0xa8, 0x06, 0x85, 0xfd, 0xfd, 0xc3, 0xae, 0xe4, 0x44, 0xd2, 0xc1, 0xd4, 0xdb,
+	0xd0, 0xdd, 0x15, 0x67, 0x29, 0x09, 0x6b, 0xde, 0xc9, 0x49, 0x7b, 0xdf, 0xdb, 0x34, 0x12, 0x31,
+	0x16, 0xfa, 0x2d, 0x6c, 0xd2, 0x48, 0x05, 0xda, 0xb7, 0x59, 0x13, 0x54, 0x4a, 0xdd, 0x17, 0x0f,
+	0x75, 0x98, 0xaa, 0x06, 0x35, 0xa9, 0xea, 0x5a, 0x0c, 0x35, 0x61, 0xbd, 0xcf, 0x22, 0x11, 0x0a,
+	0x49, 0x23, 0xe9, 0x8b, 0x88, 0x8c, 0xc4, 0x25, 0x93, 0x9e, 0xa7, 0x8f, 0xa0, 0x05, 0xd4, 0xb5,
+	0x08, 0x7a, 0x0c, 0x60, 0x9c, 0x15, 0x3e, 0xbb, 0xf0, 0x1e, 0xd9, 0x2c, 0x2a, 0x77, 0x44, 0xe7,
+	0x62, 0xeb, 0x1b, 0x07, 0x8a, 0xf1, 0xc0, 0xa2, 0x67, 0x90, 0x31, 0x43, 0x42, 0x4f, 0xaf, 0xc2,
+	0x4e, 0xc9, 0x76, 0x67, 0x4f, 0x33, 0xb1, 0x05, 0xd5, 0xb0, 0x8b, 0x8f, 0x82, 0x30, 0xf0, 0x12,
+	0x5a, 0x73, 0x29, 0xc6, 0x6d, 0x07, 0xe8, 0x15, 0x14, 0xa5, 0x72, 0x42, 0xfa, 0x64, 0x18, 0x12,
+	0xe1, 0x25, 0xed, 0x9c, 0x99, 0xcf, 0xd4, 0x9e, 0x46, 0x77, 0x15, 0x88, 0x0b, 0x72, 0x41, 0xa0,
+	0x9f, 0x43, 0x61, 0x5e, 0x3b, 0x61, 0xa0, 0x47, 0x5c, 0x12, 0xc3, 0x8c, 0xd5, 0x0e, 0xb6, 0xfe,
+	0x05, 0x8f, 0x7e, 0xb4, 0x41, 0x90, 0x0b, 0xc9, 0x2b, 0x3a, 0xd5, 0x2e, 0xe4, 0xb1, 0xfa, 0x44,
+	0x2f, 0x20, 0x3d, 0x21, 0xc3, 0x31, 0xd5, 0x76, 0x2e, 0x86, 0xce, 0x5e, 0x18, 0xcd, 0xcf, 0x62,
+	0x23, 0xf1, 0xc7, 0xc4, 0x2b, 0x67, 0x6b, 0x0f, 0xaa, 0xb7, 0xf5, 0xc8, 0x2d, 0x8a, 0xab, 0x71,
+	0xc5, 0xf9, 0x98, 0x8e, 0xb7, 0xa9, 0x5c, 0xd2, 0x4d, 0xd5, 0xbf, 0x76, 0xa0, 0xbc, 0x5c, 0x4d,
+	0xe8, 0xd7, 0xb0, 0xb1, 0x5a, 0x7f, 0xfe, 0x40, 0x86, 0x81, 0x55, 0x8b, 0x96, 0x8b, 0xed, 0x8d,
+	0x0c, 0x03, 0xf4, 0x07, 0xf0, 0x3e, 0x38, 0x22, 0xc3, 0x6b, 0xca, 0xc6, 0x52, 0x5f, 0xec, 0xe0,
+	0x8d, 0xe5, 0x53, 0x3d, 0x03, 0xaa, 0xde, 0xb0, 0x7d, 0xa5, 0x56, 0x53, 0xff, 0x4a, 0x5f, 0x64,
+	0x12, 0x91, 0xc3, 0x6b, 0x16, 0xea, 0x29, 0x44, 0xdd, 0x23, 0xea, 0x5f, 0x25, 0xa0, 0x6c, 0xe7,
+	0x3f, 0xa6, 0xef, 0xc7, 0x54, 0x48, 0xf4, 0x4b, 0xc8, 0xf7, 0xc9, 0x70, 0x48, 0xb9, 0x6f, 0x4d,
+	0x2c, 0xec, 0x54, 0xb6, 0xcd, 0x16, 0x6c, 0x69, 0x7e, 0x7b, 0x1f, 0xe7, 0x8c, 0x44, 0x3b, 0x40,
+	0x2f, 0x20, 0x3b, 0x6b, 0xe4, 0xc4, 0x5c, 0x36, 0xde, 0xc8, 0x78, 0x86, 0xa3, 0xe7, 0x90, 0xd6,
+	0x59, 0xb0, 0x65, 0xb1, 0x36, 0xcb, 0x89, 0x1a, 0x99, 0x7a, 0x1b, 0x60, 0x83, 0xa3, 0xdf, 0x81,
+	0xad, 0x0d, 0x5f, 0x4e, 0x47, 0x54, 0x17, 0x43, 0x79, 0xa7, 0xba, 0x5a, 0x45, 0xbd, 0xe9, 0x88,
+	0x62, 0x90, 0xf3, 0x6f, 0x55, 0xa4, 0x57, 0x74, 0x2a, 0x46, 0xa4, 0x4f, 0x7d, 0xbd, 0x3f, 0xf5,
+	0x9e, 0xcb, 0xe3, 0xd2, 0x8c, 0xab, 0x2b, 0x3f, 0xbe, 0x07, 0xb3, 0xf7, 0xd9, 0x83, 0x6f, 0x53,
+	0xb9, 0xb4, 0x9b, 0xa9, 0xff, 0xd7, 0x81, 0xca, 0x3c, 0x52, 0x62, 0xc4, 0x22, 0xa1, 0x6e, 0x4c,
+	0x53, 0xce, 0x19, 0x5f, 0x09, 0x13, 0x3e, 0x6e, 0x1d, 0x28, 0x36, 0x36, 0xe8, 0xc7, 0xc4, 0xe8,
+	0x25, 0x64, 0x38, 0x15, 0xe3, 0xa1, 0xb4, 0x41, 0x42, 0xf1, 0x6d, 0x89, 0x35, 0x82, 0xad, 0x44,
+	0xfd, 0xbb, 0x04, 0xac, 0x5b, 0x8b, 0xf6, 0x88, 0xec, 0x5f, 0x7e, 0xf1, 0x04, 0xfe, 0x02, 0xb2,
+	0xca, 0x9a, 0x90, 0xaa, 0x82, 0x4a, 0xde, 0x9e, 0xc2, 0x99, 0xc4, 0x67, 0x24, 0x91, 0x88, 0xa5,
+	0x67, 0x55, 0xda, 0x3c, 0xab, 0x88, 0x88, 0x3f, 0xab, 0xbe, 0x50, 0xae, 0xeb, 0xff, 0x73, 0xa0,
+	0xba, 0x1c, 0xd3, 0x2f, 0x96, 0xea, 0x5f, 0x41, 0xd6, 0x24, 0x72, 0x16, 0xcd, 0x4d, 0x6b, 0x9b,
+	0x49, 0xf3, 0x59, 0x28, 0x2f, 0x8d, 0xea, 0x99, 0x58, 0xfd, 0xfb, 0x04, 0x54, 0xbb, 0x92, 0x53,
+	0x72, 0xfd, 0x59, 0x2d, 0x3b, 0xef, 0xc3, 0xc4, 0xc7, 0xf5, 0x61, 0xf2, 0x93, 0xfb, 0x30, 0x75,
+	0x47, 0x6e, 0xd2, 0xf7, 0x7a, 0x8f, 0xc6, 0x62, 0x9b, 0xb9, 0x23, 0xb6, 0xaf, 0xa0, 0x64, 0x82,
+	0xe6, 0x5f, 0x30, 0x7e, 0x4d, 0xa4, 0x7d, 0xb7, 0xae, 0x2f, 0x45, 0xf8, 0xb5, 0x86, 0x70, 0x91,
+	0xc7, 0xa8, 0xfa, 0x3f, 0x60, 0x63, 0x25, 0xc4, 0xb6, 0x00, 0x16, 0x9d, 0xe9, 0xdc, 0xd5, 0x99,
+	0x6a, 0x49, 0x10, 0xce, 0xd9, 0x8d, 0x8e, 0x70, 0x11, 0x1b, 0xa2, 0xfe, 0x6f, 0x78, 0x84, 0xa9,
+	0x60, 0xc3, 0x09, 0x8d, 0x55, 0xf2, 0xa7, 0xa5, 0x10, 0x41, 0x2a, 0x90, 0x76, 0x0b, 0xe7, 0xb1,
+	0xfe, 0xae, 0x3f, 0x86, 0xad, 0xdb, 0xd4, 0x1b, 0xf3, 0xeb, 0xdf, 0x3a, 0x50, 0x3e, 0x35, 0x9e,
+	0x7d, 0xda, 0x95, 0x2b, 0xc5, 0x90, 0xb8, 0x67, 0x31, 0x3c, 0x87, 0xf4, 0x44, 0x2f, 0xbb, 0xd9,
+	0xd0, 0x8f, 0xfd, 0xfd, 0x3a, 0x55, 0x3b, 0x08, 0x1b, 0x5c, 0xc5, 0xf7, 0x22, 0x1c, 0x4a, 0xca,
+	0xbd, 0x94, 0x8d, 0x6f, 0x4c, 0xf2, 0xb5, 0x46, 0xb0, 0x95, 0xa8, 0xff, 0x19, 0x2a, 0x73, 0x5f,
+	0x16, 0xe9, 0xa1, 0x13, 0xaa, 0xde, 0xa6, 0x4e, 0x2d, 0xb9, 0x7a, 0xfc, 0xf4, 0x40, 0x41, 0xd8,
+	0x4a, 0xbc, 0xdc, 0x87, 0xca, 0xca, 0x1f, 0x17, 0x54, 0x81, 0xc2, 0xc9, 0xbb, 0xee, 0xf1, 0x41,
+	0xab, 0xfd, 0xba, 0x7d, 0xb0, 0xef, 0x3e, 0x40, 0x00, 0x99, 0x6e, 0xfb, 0xdd, 0x9b, 0xc3, 0x03,
+	0xd7, 0x41, 0x79, 0x48, 0x1f, 0x9d, 0x1c, 0xf6, 0xda, 0x6e, 0x42, 0x7d, 0xf6, 0xce, 0x3a, 0xc7,
+	0x2d, 0x37, 0xf9, 0xf2, 0x4f, 0x50, 0x68, 0xe9, 0xbf, 0x5f, 0x1d, 0x1e, 0x50, 0xae, 0x0e, 0xbc,
+	0xeb, 0xe0, 0xa3, 0xdd, 0x43, 0xf7, 0x01, 0xca, 0x42, 0xf2, 0x18, 0xab, 0x93, 0x39, 0x48, 0x1d,
+	0x77, 0xba, 0x3d, 0x37, 0x81, 0xca, 0x00, 0xbb, 0x27, 0xbd, 0x4e, 0xab, 0x73, 0x74, 0xd4, 0xee,
+	0xb9, 0xc9, 0xbd, 0xdf, 0x43, 0x25, 0x64, 0xdb, 0x93, 0x50, 0x52, 0x21, 0xcc, 0xbf, 0xcb, 0x7f,
+	0x3e, 0xb1, 0x54, 0xc8, 0x9a, 0xe6, 0xab, 0x39, 0x60, 0xcd, 0x89, 0x6c, 0x6a, 0xb4, 0x69, 0x4a,
+	0xfd, 0x3c, 0xa3, 0xa9, 0xdf, 0xfc, 0x30, 0x00, 0xea, 0x64, 0x2d, 0x13, 0xdd, 0x0e, 0x00, 0x00,
 }
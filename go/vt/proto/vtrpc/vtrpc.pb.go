@@ -286,6 +286,40 @@ func (LegacyErrorCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_750b4cf641561858, []int{1}
 }
 
+// Priority is the priority class of a request. When a tablet is under
+// pressure, it rejects the batch requests first, then the normal ones,
+// and keeps admitting the critical ones.
+type Priority int32
+
+const (
+	// NORMAL is the default priority.
+	Priority_NORMAL Priority = 0
+	// CRITICAL requests are admitted even under pressure.
+	Priority_CRITICAL Priority = 1
+	// BATCH requests are the first ones rejected under pressure.
+	Priority_BATCH Priority = 2
+)
+
+var Priority_name = map[int32]string{
+	0: "NORMAL",
+	1: "CRITICAL",
+	2: "BATCH",
+}
+
+var Priority_value = map[string]int32{
+	"NORMAL":   0,
+	"CRITICAL": 1,
+	"BATCH":    2,
+}
+
+func (x Priority) String() string {
+	return proto.EnumName(Priority_name, int32(x))
+}
+
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_750b4cf641561858, []int{2}
+}
+
 // CallerID is passed along RPCs to identify the originating client
 // for a request. It is not meant to be secure, but only
 // informational.  The client can put whatever info they want in these
@@ -308,7 +342,9 @@ type CallerID struct {
 	// subcomponent describes a component inisde the immediate caller which
 	// is responsible for generating is request. Suggested values are a
 	// servlet name or an API endpoint name.
-	Subcomponent         string   `protobuf:"bytes,3,opt,name=subcomponent,proto3" json:"subcomponent,omitempty"`
+	Subcomponent string `protobuf:"bytes,3,opt,name=subcomponent,proto3" json:"subcomponent,omitempty"`
+	// priority is the priority class of the request, NORMAL by default.
+	Priority             Priority `protobuf:"varint,4,opt,name=priority,proto3,enum=vtrpc.Priority" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -360,6 +396,13 @@ func (m *CallerID) GetSubcomponent() string {
 	return ""
 }
 
+func (m *CallerID) GetPriority() Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return Priority_NORMAL
+}
+
 // RPCError is an application-level error structure returned by
 // VtTablet (and passed along by VtGate if appropriate).
 // We use this so the clients don't have to parse the error messages,
@@ -422,6 +465,7 @@ func (m *RPCError) GetCode() Code {
 func init() {
 	proto.RegisterEnum("vtrpc.Code", Code_name, Code_value)
 	proto.RegisterEnum("vtrpc.LegacyErrorCode", LegacyErrorCode_name, LegacyErrorCode_value)
+	proto.RegisterEnum("vtrpc.Priority", Priority_name, Priority_value)
 	proto.RegisterType((*CallerID)(nil), "vtrpc.CallerID")
 	proto.RegisterType((*RPCError)(nil), "vtrpc.RPCError")
 }
@@ -429,43 +473,47 @@ func init() {
 func init() { proto.RegisterFile("vtrpc.proto", fileDescriptor_750b4cf641561858) }
 
 var fileDescriptor_750b4cf641561858 = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0x4d, 0x4f, 0x1b, 0x3b,
-	0x14, 0x86, 0xc9, 0x07, 0xf9, 0x38, 0x13, 0x88, 0x31, 0x5f, 0xe1, 0x5e, 0xae, 0xee, 0x55, 0x56,
-	0x57, 0x2c, 0x88, 0xd4, 0xaa, 0xea, 0xda, 0x19, 0x1f, 0x82, 0xc5, 0xe0, 0x49, 0x3d, 0x36, 0x25,
-	0xdd, 0x58, 0x21, 0x8c, 0x50, 0xaa, 0xc0, 0x44, 0x93, 0x14, 0xa9, 0x9b, 0xfe, 0xac, 0xfe, 0xa6,
-	0xfe, 0x8c, 0xca, 0x4e, 0xa6, 0x28, 0xb0, 0x9b, 0xf3, 0x3e, 0xc7, 0xc7, 0xef, 0x79, 0x9d, 0x40,
-	0xf0, 0xbc, 0xcc, 0xe7, 0x93, 0xf3, 0x79, 0x9e, 0x2d, 0x33, 0xba, 0xed, 0x8b, 0xee, 0x57, 0x68,
-	0x84, 0xe3, 0xd9, 0x2c, 0xcd, 0x05, 0xa7, 0xa7, 0xd0, 0x9c, 0xe7, 0xd3, 0xa7, 0xc9, 0x74, 0x3e,
-	0x9e, 0x75, 0x4a, 0xff, 0x95, 0xfe, 0x6f, 0xaa, 0x17, 0xc1, 0xd1, 0x49, 0xf6, 0x38, 0xcf, 0x9e,
-	0xd2, 0xa7, 0x65, 0xa7, 0xbc, 0xa2, 0x7f, 0x04, 0xda, 0x85, 0xd6, 0xe2, 0xdb, 0xdd, 0x4b, 0x43,
-	0xc5, 0x37, 0x6c, 0x68, 0xdd, 0x1f, 0xd0, 0x50, 0xc3, 0x10, 0xf3, 0x3c, 0xcb, 0xe9, 0x47, 0x08,
-	0x66, 0xe9, 0xc3, 0x78, 0xf2, 0xdd, 0x4e, 0xb2, 0xfb, 0xd4, 0xdf, 0xb6, 0xfb, 0xee, 0xe8, 0x7c,
-	0xe5, 0x30, 0xf2, 0xc4, 0x37, 0x86, 0xd9, 0x7d, 0xaa, 0x60, 0xd5, 0xea, 0xbe, 0x69, 0x07, 0xea,
-	0x8f, 0xe9, 0x62, 0x31, 0x7e, 0x48, 0xd7, 0x26, 0x8a, 0x92, 0xfe, 0x0b, 0x55, 0x3f, 0xab, 0xe2,
-	0x67, 0x05, 0xeb, 0x59, 0x7e, 0x80, 0x07, 0x67, 0x3f, 0xcb, 0x50, 0xf5, 0x33, 0x6a, 0x50, 0x8e,
-	0xaf, 0xc8, 0x16, 0x6d, 0x41, 0x23, 0x64, 0x32, 0xc4, 0x08, 0x39, 0x29, 0xd1, 0x00, 0xea, 0x46,
-	0x5e, 0xc9, 0xf8, 0xb3, 0x24, 0x65, 0x7a, 0x00, 0x44, 0xc8, 0x1b, 0x16, 0x09, 0x6e, 0x99, 0x1a,
-	0x98, 0x6b, 0x94, 0x9a, 0x54, 0xe8, 0x21, 0xec, 0x71, 0x64, 0x3c, 0x12, 0x12, 0x2d, 0xde, 0x86,
-	0x88, 0x1c, 0x39, 0xa9, 0xd2, 0x1d, 0x68, 0xca, 0x58, 0xdb, 0x8b, 0xd8, 0x48, 0x4e, 0xb6, 0x29,
-	0x85, 0x5d, 0x16, 0x29, 0x64, 0x7c, 0x64, 0xf1, 0x56, 0x24, 0x3a, 0x21, 0x35, 0x77, 0x72, 0x88,
-	0xea, 0x5a, 0x24, 0x89, 0x88, 0xa5, 0xe5, 0x28, 0x05, 0x72, 0x52, 0xa7, 0xfb, 0xd0, 0x36, 0x92,
-	0x19, 0x7d, 0x89, 0x52, 0x8b, 0x90, 0x69, 0xe4, 0x84, 0xd0, 0x23, 0xa0, 0x0a, 0x93, 0xd8, 0xa8,
-	0xd0, 0xdd, 0x72, 0xc9, 0x4c, 0xe2, 0xf4, 0x06, 0x3d, 0x86, 0xfd, 0x0b, 0x26, 0x22, 0xe4, 0x76,
-	0xa8, 0x30, 0x8c, 0x25, 0x17, 0x5a, 0xc4, 0x92, 0x34, 0x9d, 0x73, 0xd6, 0x8f, 0x95, 0xeb, 0x02,
-	0x4a, 0xa0, 0x15, 0x1b, 0x6d, 0xe3, 0x0b, 0xab, 0x98, 0x1c, 0x20, 0x09, 0xe8, 0x1e, 0xec, 0x18,
-	0x29, 0xae, 0x87, 0x11, 0xba, 0x35, 0x90, 0x93, 0x96, 0xdb, 0x5c, 0x48, 0x8d, 0x4a, 0xb2, 0x88,
-	0xec, 0xd0, 0x36, 0x04, 0x46, 0xb2, 0x1b, 0x26, 0x22, 0xd6, 0x8f, 0x90, 0xec, 0xba, 0x85, 0x38,
-	0xd3, 0xcc, 0x46, 0x71, 0x92, 0x90, 0xf6, 0xd9, 0xaf, 0x32, 0xb4, 0x5f, 0xbd, 0x89, 0x5b, 0x32,
-	0x31, 0x61, 0x88, 0x49, 0x62, 0x23, 0x1c, 0xb0, 0x70, 0x44, 0xb6, 0x5c, 0x68, 0xab, 0x3c, 0x9d,
-	0xc7, 0xb5, 0x5a, 0xa2, 0x1d, 0x38, 0x58, 0xe7, 0x6a, 0x51, 0xa9, 0x58, 0x15, 0xc4, 0x87, 0xdc,
-	0x67, 0xdc, 0x0a, 0x39, 0x34, 0xba, 0x50, 0x2b, 0xf4, 0x14, 0x3a, 0x6f, 0x42, 0x2e, 0x68, 0x95,
-	0xfe, 0x05, 0x47, 0xce, 0xf9, 0x40, 0x09, 0x3d, 0xda, 0x9c, 0xb7, 0xed, 0x4e, 0xbe, 0x09, 0xb9,
-	0xa0, 0x35, 0xfa, 0x0f, 0x9c, 0xbc, 0x8d, 0xb5, 0xc0, 0x75, 0xfa, 0x37, 0x1c, 0x7f, 0x32, 0xa8,
-	0x46, 0xd6, 0x3d, 0x65, 0x82, 0xea, 0xe6, 0x05, 0x36, 0x9c, 0x53, 0x27, 0x0b, 0x69, 0xf5, 0x6d,
-	0xa1, 0x36, 0xe9, 0x09, 0x1c, 0x16, 0x29, 0x6e, 0x5a, 0x01, 0x67, 0x53, 0x2b, 0x26, 0x13, 0x81,
-	0x52, 0x6f, 0xb2, 0xc0, 0xb1, 0x57, 0x8f, 0x5e, 0xb0, 0x56, 0xff, 0x03, 0xb4, 0xa7, 0xd9, 0xf9,
-	0xf3, 0x74, 0x99, 0x2e, 0x16, 0xab, 0x7f, 0xea, 0x97, 0xee, 0xba, 0x9a, 0x66, 0xbd, 0xd5, 0x57,
-	0xef, 0x21, 0xeb, 0x3d, 0x2f, 0x7b, 0x9e, 0xf6, 0xfc, 0xaf, 0xfc, 0xae, 0xe6, 0x8b, 0xf7, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x27, 0xae, 0x20, 0x34, 0xe3, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x54, 0x5d, 0x4f, 0xe3, 0x38,
+	0x14, 0xa5, 0xdf, 0xed, 0x6d, 0x69, 0x8d, 0xf9, 0x2a, 0xbb, 0xac, 0x16, 0xf5, 0x09, 0xb1, 0x12,
+	0x95, 0x58, 0x8d, 0xe6, 0xd9, 0x8d, 0x2f, 0xc5, 0x22, 0x75, 0x3a, 0x8e, 0xc3, 0xd0, 0x79, 0xb1,
+	0x4a, 0x89, 0x50, 0xa4, 0x42, 0xaa, 0xb4, 0x83, 0xc4, 0xcb, 0xfc, 0x8d, 0xf9, 0x27, 0xf3, 0x9b,
+	0xe6, 0x67, 0x8c, 0x9c, 0x26, 0xa0, 0xc2, 0x9b, 0xef, 0x39, 0xc7, 0xb7, 0xe7, 0x9e, 0x5b, 0x07,
+	0x9a, 0xcf, 0xab, 0x64, 0x31, 0x3b, 0x5f, 0x24, 0xf1, 0x2a, 0xa6, 0x95, 0xb4, 0xe8, 0xfd, 0x2c,
+	0x40, 0xdd, 0x99, 0xce, 0xe7, 0x61, 0x22, 0x38, 0x3d, 0x86, 0xc6, 0x22, 0x89, 0x9e, 0x66, 0xd1,
+	0x62, 0x3a, 0xef, 0x16, 0x4e, 0x0a, 0xa7, 0x0d, 0xf5, 0x06, 0x58, 0x76, 0x16, 0x3f, 0x2e, 0xe2,
+	0xa7, 0xf0, 0x69, 0xd5, 0x2d, 0xae, 0xd9, 0x57, 0x80, 0xf6, 0xa0, 0xb5, 0xfc, 0x7e, 0xf7, 0x26,
+	0x28, 0xa5, 0x82, 0x0d, 0x8c, 0xfe, 0x07, 0xf5, 0x45, 0x12, 0xc5, 0x49, 0xb4, 0x7a, 0xe9, 0x96,
+	0x4f, 0x0a, 0xa7, 0xed, 0x8b, 0xce, 0xf9, 0xda, 0xd3, 0x38, 0x83, 0xd5, 0xab, 0xa0, 0xf7, 0x03,
+	0xea, 0x6a, 0xec, 0x60, 0x92, 0xc4, 0x09, 0xfd, 0x0c, 0xcd, 0x79, 0xf8, 0x30, 0x9d, 0xbd, 0x98,
+	0x59, 0x7c, 0x1f, 0xa6, 0xd6, 0xda, 0x17, 0x07, 0xd9, 0x5d, 0x37, 0x65, 0x52, 0xa1, 0x13, 0xdf,
+	0x87, 0x0a, 0xd6, 0x52, 0x7b, 0xa6, 0x5d, 0xa8, 0x3d, 0x86, 0xcb, 0xe5, 0xf4, 0x21, 0xcc, 0x1c,
+	0xe7, 0x25, 0xfd, 0x17, 0xca, 0x69, 0xaf, 0x52, 0xda, 0xab, 0x99, 0xf5, 0x4a, 0x1b, 0xa4, 0xc4,
+	0xd9, 0xaf, 0x22, 0x94, 0xd3, 0x1e, 0x55, 0x28, 0x7a, 0xd7, 0x64, 0x8b, 0xb6, 0xa0, 0xee, 0x30,
+	0xe9, 0xa0, 0x8b, 0x9c, 0x14, 0x68, 0x13, 0x6a, 0x81, 0xbc, 0x96, 0xde, 0x57, 0x49, 0x8a, 0x74,
+	0x0f, 0x88, 0x90, 0x37, 0xcc, 0x15, 0xdc, 0x30, 0x35, 0x0c, 0x46, 0x28, 0x35, 0x29, 0xd1, 0x7d,
+	0xd8, 0xe1, 0xc8, 0xb8, 0x2b, 0x24, 0x1a, 0xbc, 0x75, 0x10, 0x39, 0x72, 0x52, 0xa6, 0xdb, 0xd0,
+	0x90, 0x9e, 0x36, 0x97, 0x5e, 0x20, 0x39, 0xa9, 0x50, 0x0a, 0x6d, 0xe6, 0x2a, 0x64, 0x7c, 0x62,
+	0xf0, 0x56, 0xf8, 0xda, 0x27, 0x55, 0x7b, 0x73, 0x8c, 0x6a, 0x24, 0x7c, 0x5f, 0x78, 0xd2, 0x70,
+	0x94, 0x02, 0x39, 0xa9, 0xd1, 0x5d, 0xe8, 0x04, 0x92, 0x05, 0xfa, 0x0a, 0xa5, 0x16, 0x0e, 0xd3,
+	0xc8, 0x09, 0xa1, 0x07, 0x40, 0x15, 0xfa, 0x5e, 0xa0, 0x1c, 0xfb, 0x2b, 0x57, 0x2c, 0xf0, 0x2d,
+	0x5e, 0xa7, 0x87, 0xb0, 0x7b, 0xc9, 0x84, 0x8b, 0xdc, 0x8c, 0x15, 0x3a, 0x9e, 0xe4, 0x42, 0x0b,
+	0x4f, 0x92, 0x86, 0x75, 0xce, 0x06, 0x9e, 0xb2, 0x2a, 0xa0, 0x04, 0x5a, 0x5e, 0xa0, 0x8d, 0x77,
+	0x69, 0x14, 0x93, 0x43, 0x24, 0x4d, 0xba, 0x03, 0xdb, 0x81, 0x14, 0xa3, 0xb1, 0x8b, 0x76, 0x0c,
+	0xe4, 0xa4, 0x65, 0x27, 0x17, 0x52, 0xa3, 0x92, 0xcc, 0x25, 0xdb, 0xb4, 0x03, 0xcd, 0x40, 0xb2,
+	0x1b, 0x26, 0x5c, 0x36, 0x70, 0x91, 0xb4, 0xed, 0x40, 0x9c, 0x69, 0x66, 0x5c, 0xcf, 0xf7, 0x49,
+	0xe7, 0xec, 0x77, 0x11, 0x3a, 0xef, 0x76, 0x62, 0x87, 0xf4, 0x03, 0xc7, 0x41, 0xdf, 0x37, 0x2e,
+	0x0e, 0x99, 0x33, 0x21, 0x5b, 0x36, 0xb4, 0x75, 0x9e, 0xd6, 0x63, 0x86, 0x16, 0x68, 0x17, 0xf6,
+	0xb2, 0x5c, 0x0d, 0x2a, 0xe5, 0xa9, 0x9c, 0x49, 0x43, 0x1e, 0x30, 0x6e, 0x84, 0x1c, 0x07, 0x3a,
+	0x47, 0x4b, 0xf4, 0x18, 0xba, 0x1f, 0x42, 0xce, 0xd9, 0x32, 0xfd, 0x0b, 0x0e, 0xac, 0xf3, 0xa1,
+	0x12, 0x7a, 0xb2, 0xd9, 0xaf, 0x62, 0x6f, 0x7e, 0x08, 0x39, 0x67, 0xab, 0xf4, 0x1f, 0x38, 0xfa,
+	0x18, 0x6b, 0x4e, 0xd7, 0xe8, 0xdf, 0x70, 0xf8, 0x25, 0x40, 0x35, 0x31, 0x76, 0x95, 0x3e, 0xaa,
+	0x9b, 0x37, 0xb2, 0x6e, 0x9d, 0x5a, 0x58, 0x48, 0xa3, 0x6f, 0x73, 0xb4, 0x41, 0x8f, 0x60, 0x3f,
+	0x4f, 0x71, 0xd3, 0x0a, 0x58, 0x9b, 0x5a, 0x31, 0xe9, 0x0b, 0x94, 0x7a, 0x93, 0x6b, 0x5a, 0xee,
+	0xdd, 0xd2, 0x73, 0xae, 0x75, 0xd6, 0x87, 0x7a, 0xfe, 0x72, 0x28, 0x40, 0x55, 0x7a, 0x6a, 0xc4,
+	0xdc, 0xec, 0xaf, 0xaa, 0x84, 0x95, 0xbb, 0xa4, 0x40, 0x1b, 0x50, 0x19, 0x30, 0xed, 0x5c, 0x91,
+	0xe2, 0xe0, 0x13, 0x74, 0xa2, 0xf8, 0xfc, 0x39, 0x5a, 0x85, 0xcb, 0xe5, 0xfa, 0x43, 0xf0, 0xad,
+	0x97, 0x55, 0x51, 0xdc, 0x5f, 0x9f, 0xfa, 0x0f, 0x71, 0xff, 0x79, 0xd5, 0x4f, 0xd9, 0x7e, 0xfa,
+	0x2c, 0xee, 0xaa, 0x69, 0xf1, 0xff, 0x9f, 0x01, 0x00, 0xb1, 0x26, 0xa2, 0x9e, 0x42, 0x04, 0x00,
+	0x00,
 }
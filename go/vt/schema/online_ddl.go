@@ -24,6 +24,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/shlex"
+
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 )
@@ -185,6 +187,62 @@ func NewOnlineDDL(keyspace string, table string, sql string, strategy DDLStrateg
 	}, nil
 }
 
+const (
+	// singletonFlag is an option that allows the migration only if
+	// there is no other pending migration in the keyspace.
+	singletonFlag = "singleton"
+	// singletonContextFlag is an option that allows the migration only
+	// if all the pending migrations of the keyspace were submitted in
+	// the same context as this one.
+	singletonContextFlag = "singleton-context"
+)
+
+// isFlag returns true if the option is the given flag, as -flag or --flag.
+func isFlag(option, name string) bool {
+	return strings.HasPrefix(option, "-") && strings.TrimLeft(option, "-") == name
+}
+
+// isVitessFlag returns true if the option is one of the flags handled
+// by Vitess, as opposed to the ones passed to the migration tool.
+func isVitessFlag(option string) bool {
+	return isFlag(option, singletonFlag) || isFlag(option, singletonContextFlag)
+}
+
+func (onlineDDL *OnlineDDL) hasFlag(name string) bool {
+	opts, _ := shlex.Split(onlineDDL.Options)
+	for _, opt := range opts {
+		if isFlag(opt, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSingleton returns true if the migration was submitted with the
+// --singleton flag.
+func (onlineDDL *OnlineDDL) IsSingleton() bool {
+	return onlineDDL.hasFlag(singletonFlag)
+}
+
+// IsSingletonContext returns true if the migration was submitted with
+// the --singleton-context flag.
+func (onlineDDL *OnlineDDL) IsSingletonContext() bool {
+	return onlineDDL.hasFlag(singletonContextFlag)
+}
+
+// RuntimeOptions returns the options to pass to the migration tool,
+// which are the options without the flags handled by Vitess.
+func (onlineDDL *OnlineDDL) RuntimeOptions() []string {
+	opts, _ := shlex.Split(onlineDDL.Options)
+	runtimeOptions := make([]string, 0, len(opts))
+	for _, opt := range opts {
+		if !isVitessFlag(opt) {
+			runtimeOptions = append(runtimeOptions, opt)
+		}
+	}
+	return runtimeOptions
+}
+
 // RequestTimeSeconds converts request time to seconds (losing nano precision)
 func (onlineDDL *OnlineDDL) RequestTimeSeconds() int64 {
 	return onlineDDL.RequestTime / int64(time.Second)
@@ -79,6 +79,41 @@ func TestParseDDLStrategy(t *testing.T) {
 	}
 }
 
+func TestSingletonFlags(t *testing.T) {
+	tt := []struct {
+		options          string
+		singleton        bool
+		singletonContext bool
+		runtimeOptions   []string
+	}{
+		{
+			runtimeOptions: []string{},
+		},
+		{
+			options:        "--singleton",
+			singleton:      true,
+			runtimeOptions: []string{},
+		},
+		{
+			options:          "-singleton-context --max-load=Threads_running=100",
+			singletonContext: true,
+			runtimeOptions:   []string{"--max-load=Threads_running=100"},
+		},
+		{
+			options:        "--allow-master --singleton --singleton-contexts",
+			singleton:      true,
+			runtimeOptions: []string{"--allow-master", "--singleton-contexts"},
+		},
+	}
+	for _, ts := range tt {
+		onlineDDL, err := NewOnlineDDL("ks", "t", "alter table t engine=innodb", DDLStrategyGhost, ts.options, "")
+		assert.NoError(t, err)
+		assert.Equal(t, ts.singleton, onlineDDL.IsSingleton(), ts.options)
+		assert.Equal(t, ts.singletonContext, onlineDDL.IsSingletonContext(), ts.options)
+		assert.Equal(t, ts.runtimeOptions, onlineDDL.RuntimeOptions(), ts.options)
+	}
+}
+
 func TestIsOnlineDDLUUID(t *testing.T) {
 	for i := 0; i < 20; i++ {
 		uuid, err := createUUID("_")
@@ -19,6 +19,7 @@ package servenv
 import (
 	"expvar"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -635,6 +636,23 @@ func (tw *TimingsWrapper) Counts() map[string]int64 {
 	return tw.timings.Counts()
 }
 
+// Histograms behaves like Timings.Histograms. Only the histograms
+// of the exporter are returned.
+func (tw *TimingsWrapper) Histograms() map[string]*stats.Histogram {
+	histograms := tw.timings.Histograms()
+	if tw.name == "" {
+		return histograms
+	}
+	prefix := strings.Replace(tw.name, ".", "_", -1) + "."
+	result := make(map[string]*stats.Histogram, len(histograms))
+	for name, h := range histograms {
+		if strings.HasPrefix(name, prefix) {
+			result[name[len(prefix):]] = h
+		}
+	}
+	return result
+}
+
 // Reset will clear histograms: used during testing
 func (tw *TimingsWrapper) Reset() {
 	tw.timings.Reset()
@@ -513,6 +513,9 @@ func TestTimings(t *testing.T) {
 		"i2.a": 1,
 	}
 	assert.Equal(t, want, g.Counts())
+	histograms := g.Histograms()
+	assert.Len(t, histograms, 1)
+	assert.EqualValues(t, 1, histograms["a"].Count())
 }
 
 func TestMultiTimings(t *testing.T) {
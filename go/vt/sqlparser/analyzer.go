@@ -61,6 +61,8 @@ const (
 	StmtUnlockTables
 	StmtFlush
 	StmtCallProc
+	StmtKill
+	StmtPurgeResultCache
 )
 
 //ASTToStatementType returns a StatementType from an AST stmt
@@ -82,7 +84,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtDDL
 	case *Use:
 		return StmtUse
-	case *OtherRead, *OtherAdmin, *Load:
+	case *OtherRead, *OtherAdmin, *Load, *LoadData:
 		return StmtOther
 	case Explain:
 		return StmtExplain
@@ -106,6 +108,10 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtFlush
 	case *CallProc:
 		return StmtCallProc
+	case *Kill:
+		return StmtKill
+	case *PurgeResultCache:
+		return StmtPurgeResultCache
 	default:
 		return StmtUnknown
 	}
@@ -197,13 +203,17 @@ func Preview(sql string) StatementType {
 		return StmtDDL
 	case "flush":
 		return StmtFlush
+	case "kill":
+		return StmtKill
+	case "purge":
+		return StmtPurgeResultCache
 	case "set":
 		return StmtSet
 	case "show":
 		return StmtShow
 	case "use":
 		return StmtUse
-	case "describe", "desc", "explain":
+	case "describe", "desc", "explain", "vexplain":
 		return StmtExplain
 	case "analyze", "repair", "optimize":
 		return StmtOther
@@ -267,6 +277,10 @@ func (s StatementType) String() string {
 		return "FLUSH"
 	case StmtCallProc:
 		return "CALL_PROC"
+	case StmtKill:
+		return "KILL"
+	case StmtPurgeResultCache:
+		return "PURGE_RESULT_CACHE"
 	default:
 		return "UNKNOWN"
 	}
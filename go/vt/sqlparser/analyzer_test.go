@@ -72,6 +72,9 @@ func TestPreview(t *testing.T) {
 		{"describe", StmtExplain},
 		{"desc", StmtExplain},
 		{"explain", StmtExplain},
+		{"vexplain", StmtExplain},
+		{"kill query 1", StmtKill},
+		{"purge vitess_result_cache", StmtPurgeResultCache},
 		{"repair", StmtOther},
 		{"optimize", StmtOther},
 		{"grant", StmtPriv},
@@ -198,6 +198,7 @@ type (
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With             *With
 		Cache            *bool // a reference here so it can be nil
 		Distinct         bool
 		StraightJoinHint bool
@@ -208,6 +209,7 @@ type (
 		Where            *Where
 		GroupBy          GroupBy
 		Having           *Where
+		Windows          WindowDefinitions
 		OrderBy          OrderBy
 		Limit            *Limit
 		Lock             Lock
@@ -228,6 +230,20 @@ type (
 	// SelectIntoType is an enum for SelectInto.Type
 	SelectIntoType int8
 
+	// With represents the WITH clause of a SELECT or UNION statement.
+	With struct {
+		Recursive bool
+		CTEs      []*CommonTableExpr
+	}
+
+	// CommonTableExpr represents a common table expression of a WITH clause.
+	// If Columns is set, it renames the columns of Subquery.
+	CommonTableExpr struct {
+		Name     TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
+
 	// Lock is an enum for the type of lock in the statement
 	Lock int8
 
@@ -238,6 +254,7 @@ type (
 	}
 	// Union represents a UNION statement.
 	Union struct {
+		With           *With
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
 		OrderBy        OrderBy
@@ -474,6 +491,26 @@ type (
 	Load struct {
 	}
 
+	// LoadData represents a LOAD DATA LOCAL INFILE statement, which vtgate
+	// executes as inserts. The other LOAD DATA statements are Load.
+	LoadData struct {
+		FileName string
+		Replace  bool
+		Ignore   bool
+		Table    TableName
+		Charset  string
+
+		FieldsTerminatedBy       string
+		FieldsEnclosedBy         string
+		FieldsOptionallyEnclosed bool
+		FieldsEscapedBy          string
+		LinesStartingBy          string
+		LinesTerminatedBy        string
+
+		IgnoreLines int
+		Columns     Columns
+	}
+
 	// ParenSelect is a parenthesized SELECT statement.
 	ParenSelect struct {
 		Select SelectStatement
@@ -519,6 +556,23 @@ type (
 		Params Exprs
 	}
 
+	// KillType is an enum for Kill.Type
+	KillType int8
+
+	// Kill represents a KILL statement. ConnectionID is the connection
+	// id the clients got from vtgate.
+	Kill struct {
+		Type         KillType
+		ConnectionID uint64
+	}
+
+	// PurgeResultCache represents a PURGE VITESS_RESULT_CACHE statement,
+	// which drops the results vtgate cached for the CACHE_TTL directive.
+	// An empty Keyspace purges the results of all the keyspaces.
+	PurgeResultCache struct {
+		Keyspace TableIdent
+	}
+
 	// LockType is an enum for Lock Types
 	LockType int8
 
@@ -548,6 +602,17 @@ type (
 		Statement Statement
 	}
 
+	// VExplainType is an enum for VExplainStmt.Type
+	VExplainType int8
+
+	// VExplainStmt represents a VEXPLAIN statement. It is answered by
+	// vtgate and vttablet with their own plans for Statement, which is
+	// not executed.
+	VExplainStmt struct {
+		Type      VExplainType
+		Statement Statement
+	}
+
 	// ExplainTab represents the Explain table
 	ExplainTab struct {
 		Table TableName
@@ -591,6 +656,7 @@ func (*Select) iSelectStatement()      {}
 func (*Union) iSelectStatement()       {}
 func (*ParenSelect) iSelectStatement() {}
 func (*Load) iStatement()              {}
+func (*LoadData) iStatement()          {}
 func (*CreateDatabase) iStatement()    {}
 func (*AlterDatabase) iStatement()     {}
 func (*CreateTable) iStatement()       {}
@@ -605,8 +671,11 @@ func (*DropView) iStatement()          {}
 func (*TruncateTable) iStatement()     {}
 func (*RenameTable) iStatement()       {}
 func (*CallProc) iStatement()          {}
+func (*Kill) iStatement()              {}
+func (*PurgeResultCache) iStatement()  {}
 func (*ExplainStmt) iStatement()       {}
 func (*ExplainTab) iStatement()        {}
+func (*VExplainStmt) iStatement()      {}
 
 func (*CreateView) iDDLStatement()    {}
 func (*AlterView) iDDLStatement()     {}
@@ -637,8 +706,9 @@ func (*RenameIndex) iAlterOption()             {}
 func (*Validation) iAlterOption()              {}
 func (TableOptions) iAlterOption()             {}
 
-func (*ExplainStmt) iExplain() {}
-func (*ExplainTab) iExplain()  {}
+func (*ExplainStmt) iExplain()  {}
+func (*ExplainTab) iExplain()   {}
+func (*VExplainStmt) iExplain() {}
 
 // IsFullyParsed implements the DDLStatement interface
 func (*TruncateTable) IsFullyParsed() bool {
@@ -1078,6 +1148,9 @@ func (node *AlterTable) AffectedTables() TableNames {
 			affectedTables = append(affectedTables, altOption.Table)
 		}
 	}
+	if node.PartitionSpec != nil && node.PartitionSpec.Action == ExchangeAction {
+		affectedTables = append(affectedTables, node.PartitionSpec.TableName)
+	}
 	return affectedTables
 }
 
@@ -1468,11 +1541,49 @@ type (
 	ParenTableExpr struct {
 		Exprs TableExprs
 	}
+
+	// JSONTableExpr represents a JSON_TABLE table function. It produces
+	// a table from the JSON document Expr, with a row for each match of Path.
+	JSONTableExpr struct {
+		Expr    Expr
+		Path    Expr
+		Columns JSONTableColumns
+		Alias   TableIdent
+	}
+
+	// JSONTableColumn represents a column definition of JSON_TABLE.
+	// Nested path definitions have no name, and define Columns instead.
+	JSONTableColumn struct {
+		Type    JSONTableColumnType
+		Name    ColIdent
+		ColType ColumnType
+		Path    Expr
+		OnEmpty *JSONTableOnResponse
+		OnError *JSONTableOnResponse
+		Columns JSONTableColumns
+	}
+
+	// JSONTableColumns represents the COLUMNS clause of JSON_TABLE.
+	JSONTableColumns []*JSONTableColumn
+
+	// JSONTableColumnType is an enum for JSONTableColumn.Type
+	JSONTableColumnType int8
+
+	// JSONTableOnResponse represents the ON EMPTY and ON ERROR clauses
+	// of a JSON_TABLE column.
+	JSONTableOnResponse struct {
+		Type JSONTableOnResponseType
+		Expr Expr
+	}
+
+	// JSONTableOnResponseType is an enum for JSONTableOnResponse.Type
+	JSONTableOnResponseType int8
 )
 
 func (*AliasedTableExpr) iTableExpr() {}
 func (*ParenTableExpr) iTableExpr()   {}
 func (*JoinTableExpr) iTableExpr()    {}
+func (*JSONTableExpr) iTableExpr()    {}
 
 type (
 	// SimpleTableExpr represents a simple table expression.
@@ -1678,8 +1789,56 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		// Over is set for window function calls, like ROW_NUMBER() OVER (...).
+		Over *OverClause
+	}
+
+	// OverClause represents the OVER clause of a window function call,
+	// which either names a window of the WINDOW clause, or specifies one.
+	OverClause struct {
+		WindowName ColIdent
+		WindowSpec *WindowSpecification
+	}
+
+	// WindowSpecification represents the specification of a window:
+	// OVER ([window_name] [PARTITION BY ...] [ORDER BY ...] [frame_clause])
+	WindowSpecification struct {
+		Name            ColIdent
+		PartitionClause Exprs
+		OrderClause     OrderBy
+		FrameClause     *FrameClause
 	}
 
+	// FrameClause represents the frame of a window.
+	// End is nil if the frame has no BETWEEN ... AND ... form.
+	FrameClause struct {
+		Unit  FrameUnitType
+		Start *FramePoint
+		End   *FramePoint
+	}
+
+	// FrameUnitType is an enum for FrameClause.Unit
+	FrameUnitType int8
+
+	// FramePoint represents the start or the end of a window frame.
+	// Expr is only set for the ExprPreceding and ExprFollowing types.
+	FramePoint struct {
+		Type FramePointType
+		Expr Expr
+	}
+
+	// FramePointType is an enum for FramePoint.Type
+	FramePointType int8
+
+	// WindowDefinition represents a named window of the WINDOW clause.
+	WindowDefinition struct {
+		Name       ColIdent
+		WindowSpec *WindowSpecification
+	}
+
+	// WindowDefinitions represents the WINDOW clause of a select.
+	WindowDefinitions []*WindowDefinition
+
 	// GroupConcatExpr represents a call to GROUP_CONCAT
 	GroupConcatExpr struct {
 		Distinct  bool
@@ -1891,13 +2050,35 @@ func (node *Select) Format(buf *TrackedBuffer) {
 	addIf(node.StraightJoinHint, StraightJoinHint)
 	addIf(node.SQLCalcFoundRows, SQLCalcFoundRowsStr)
 
-	buf.astPrintf(node, "select %v%s%v from %v%v%v%v%v%v%s%v",
-		node.Comments, options, node.SelectExprs,
+	buf.astPrintf(node, "%vselect %v%s%v from %v%v%v%v%v%v%v%s%v",
+		node.With, node.Comments, options, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *ParenSelect) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "(%v)", node.Select)
@@ -1905,7 +2086,7 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v", node.FirstStatement)
+	buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 	for _, us := range node.UnionSelects {
 		buf.astPrintf(node, "%v", us)
 	}
@@ -2440,7 +2621,7 @@ func (node *Show) Format(buf *TrackedBuffer) {
 func (node *ShowLegacy) Format(buf *TrackedBuffer) {
 	nodeType := strings.ToLower(node.Type)
 	if (nodeType == "tables" || nodeType == "columns" || nodeType == "fields" || nodeType == "index" || nodeType == "keys" || nodeType == "indexes" ||
-		nodeType == "databases" || nodeType == "schemas" || nodeType == "keyspaces" || nodeType == "vitess_keyspaces" || nodeType == "vitess_shards" || nodeType == "vitess_tablets") && node.ShowTablesOpt != nil {
+		nodeType == "databases" || nodeType == "schemas" || nodeType == "keyspaces" || nodeType == "vitess_keyspaces" || nodeType == "vitess_shards" || nodeType == "vitess_tablets" || nodeType == "processlist") && node.ShowTablesOpt != nil {
 		opt := node.ShowTablesOpt
 		if node.Extended != "" {
 			buf.astPrintf(node, "show %s%s", node.Extended, nodeType)
@@ -2542,6 +2723,11 @@ func (node *ExplainStmt) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "explain %s%v", format, node.Statement)
 }
 
+// Format formats the node.
+func (node *VExplainStmt) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "vexplain %s %v", node.Type.ToString(), node.Statement)
+}
+
 // Format formats the node.
 func (node *ExplainTab) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "explain %v", node.Table)
@@ -2555,6 +2741,19 @@ func (node *CallProc) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "call %v(%v)", node.Name, node.Params)
 }
 
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "kill %s %s", node.Type.ToString(), fmt.Sprint(node.ConnectionID))
+}
+
+// Format formats the node.
+func (node *PurgeResultCache) Format(buf *TrackedBuffer) {
+	buf.WriteString("purge vitess_result_cache")
+	if !node.Keyspace.IsEmpty() {
+		buf.astPrintf(node, " from %v", node.Keyspace)
+	}
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
@@ -2689,6 +2888,49 @@ func (node *JoinTableExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "%v %s %v%v", node.LeftExpr, node.Join.ToString(), node.RightExpr, node.Condition)
 }
 
+// Format formats the node.
+func (node *JSONTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "json_table(%v, %v %v) as %v", node.Expr, node.Path, node.Columns, node.Alias)
+}
+
+// Format formats the node.
+func (node JSONTableColumns) Format(buf *TrackedBuffer) {
+	prefix := "columns("
+	for _, col := range node {
+		buf.astPrintf(node, "%s%v", prefix, col)
+		prefix = ", "
+	}
+	buf.WriteString(")")
+}
+
+// Format formats the node.
+func (node *JSONTableColumn) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case JSONTableOrdinalityType:
+		buf.astPrintf(node, "%v for ordinality", node.Name)
+	case JSONTablePathType:
+		buf.astPrintf(node, "%v %v path %v", node.Name, &node.ColType, node.Path)
+		if node.OnEmpty != nil {
+			buf.astPrintf(node, " %v on empty", node.OnEmpty)
+		}
+		if node.OnError != nil {
+			buf.astPrintf(node, " %v on error", node.OnError)
+		}
+	case JSONTableExistsType:
+		buf.astPrintf(node, "%v %v exists path %v", node.Name, &node.ColType, node.Path)
+	case JSONTableNestedType:
+		buf.astPrintf(node, "nested path %v %v", node.Path, node.Columns)
+	}
+}
+
+// Format formats the node.
+func (node *JSONTableOnResponse) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s", node.Type.ToString())
+	if node.Type == JSONTableDefaultType {
+		buf.astPrintf(node, " %v", node.Expr)
+	}
+}
+
 // Format formats the node.
 func (node *IndexHints) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, " %sindex ", node.Type.ToString())
@@ -2880,7 +3122,76 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	} else {
 		buf.WriteString(funcName)
 	}
-	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	buf.astPrintf(node, "(%s%v)%v", distinct, node.Exprs, node.Over)
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec != nil {
+		buf.astPrintf(node, " over (%v)", node.WindowSpec)
+		return
+	}
+	buf.astPrintf(node, " over %v", node.WindowName)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	var prefix string
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionClause)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		for i, order := range node.OrderClause {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", order)
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.FrameClause)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v ", node.Expr)
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, def := range node {
+		buf.astPrintf(node, "%s%v", prefix, def)
+		prefix = ", "
+	}
 }
 
 // Format formats the node
@@ -3129,6 +3440,58 @@ func (node *Load) Format(buf *TrackedBuffer) {
 	buf.WriteString("AST node missing for Load type")
 }
 
+// Format formats the node. The options that have their default value
+// are omitted.
+func (node *LoadData) Format(buf *TrackedBuffer) {
+	buf.WriteString("load data local infile ")
+	encodeSQLString(buf, node.FileName)
+	switch {
+	case node.Replace:
+		buf.WriteString(" replace")
+	case node.Ignore:
+		buf.WriteString(" ignore")
+	}
+	buf.astPrintf(node, " into table %v", node.Table)
+	if node.Charset != "" {
+		buf.astPrintf(node, " character set %s", node.Charset)
+	}
+	if node.FieldsTerminatedBy != "\t" || node.FieldsEnclosedBy != "" || node.FieldsEscapedBy != "\\" {
+		buf.WriteString(" fields")
+		if node.FieldsTerminatedBy != "\t" {
+			buf.WriteString(" terminated by ")
+			encodeSQLString(buf, node.FieldsTerminatedBy)
+		}
+		if node.FieldsEnclosedBy != "" {
+			if node.FieldsOptionallyEnclosed {
+				buf.WriteString(" optionally")
+			}
+			buf.WriteString(" enclosed by ")
+			encodeSQLString(buf, node.FieldsEnclosedBy)
+		}
+		if node.FieldsEscapedBy != "\\" {
+			buf.WriteString(" escaped by ")
+			encodeSQLString(buf, node.FieldsEscapedBy)
+		}
+	}
+	if node.LinesStartingBy != "" || node.LinesTerminatedBy != "\n" {
+		buf.WriteString(" lines")
+		if node.LinesStartingBy != "" {
+			buf.WriteString(" starting by ")
+			encodeSQLString(buf, node.LinesStartingBy)
+		}
+		if node.LinesTerminatedBy != "\n" {
+			buf.WriteString(" terminated by ")
+			encodeSQLString(buf, node.LinesTerminatedBy)
+		}
+	}
+	if node.IgnoreLines > 0 {
+		buf.astPrintf(node, " ignore %s lines", fmt.Sprint(node.IgnoreLines))
+	}
+	if len(node.Columns) > 0 {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+}
+
 // Format formats the node.
 func (node *ShowBasic) Format(buf *TrackedBuffer) {
 	buf.WriteString("show")
@@ -19,6 +19,7 @@ package sqlparser
 import (
 	"encoding/hex"
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -200,29 +201,40 @@ func (ct *ColumnType) DescribeType() string {
 
 // SQLType returns the sqltypes type code for the given column
 func (ct *ColumnType) SQLType() querypb.Type {
-	switch strings.ToLower(ct.Type) {
+	typ := SQLTypeToQueryType(ct.Type, ct.Unsigned)
+	if typ == sqltypes.Null {
+		panic("unimplemented type " + ct.Type)
+	}
+	return typ
+}
+
+// SQLTypeToQueryType returns the sqltypes type code for the given MySQL
+// type name, as found in a column definition or in the DATA_TYPE column
+// of information_schema.columns. It returns sqltypes.Null for unknown types.
+func SQLTypeToQueryType(typeName string, unsigned bool) querypb.Type {
+	switch strings.ToLower(typeName) {
 	case keywordStrings[TINYINT]:
-		if ct.Unsigned {
+		if unsigned {
 			return sqltypes.Uint8
 		}
 		return sqltypes.Int8
 	case keywordStrings[SMALLINT]:
-		if ct.Unsigned {
+		if unsigned {
 			return sqltypes.Uint16
 		}
 		return sqltypes.Int16
 	case keywordStrings[MEDIUMINT]:
-		if ct.Unsigned {
+		if unsigned {
 			return sqltypes.Uint24
 		}
 		return sqltypes.Int24
 	case keywordStrings[INT], keywordStrings[INTEGER]:
-		if ct.Unsigned {
+		if unsigned {
 			return sqltypes.Uint32
 		}
 		return sqltypes.Int32
 	case keywordStrings[BIGINT]:
-		if ct.Unsigned {
+		if unsigned {
 			return sqltypes.Uint64
 		}
 		return sqltypes.Int64
@@ -293,7 +305,7 @@ func (ct *ColumnType) SQLType() querypb.Type {
 	case keywordStrings[MULTIPOLYGON]:
 		return sqltypes.Geometry
 	}
-	panic("unimplemented type " + ct.Type)
+	return sqltypes.Null
 }
 
 // ParseParams parses the vindex parameter list, pulling out the special-case
@@ -348,7 +360,7 @@ func (node *AliasedTableExpr) RemoveHints() *AliasedTableExpr {
 	return &noHints
 }
 
-//TableName returns a TableName pointing to this table expr
+// TableName returns a TableName pointing to this table expr
 func (node *AliasedTableExpr) TableName() (TableName, error) {
 	if !node.As.IsEmpty() {
 		return TableName{Name: node.As}, nil
@@ -451,6 +463,24 @@ func NewIntLiteral(in []byte) *Literal {
 	return &Literal{Type: IntVal, Val: in}
 }
 
+// parseConnectionID parses the connection id of a KILL statement.
+func parseConnectionID(in []byte) (uint64, bool) {
+	id, err := strconv.ParseUint(string(in), 10, 64)
+	return id, err == nil
+}
+
+// parseIgnoreLines parses the number of lines of the IGNORE clause of
+// LOAD DATA.
+func parseIgnoreLines(in []byte) (int, bool) {
+	lines, err := strconv.Atoi(string(in))
+	return lines, err == nil
+}
+
+// encodeSQLString writes val as a quoted SQL string.
+func encodeSQLString(buf *TrackedBuffer, val string) {
+	sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(val)).EncodeSQL(buf)
+}
+
 // NewFloatLiteral builds a new FloatVal.
 func NewFloatLiteral(in []byte) *Literal {
 	return &Literal{Type: FloatVal, Val: in}
@@ -516,8 +546,10 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregates used as window functions, like SUM(a) OVER (...),
+// don't aggregate rows, and aren't considered aggregates.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
 }
 
 // NewColIdent makes a new ColIdent.
@@ -545,7 +577,7 @@ func NewColNameWithQualifier(identifier string, table TableName) *ColName {
 	}
 }
 
-//NewSelect is used to create a select statement
+// NewSelect is used to create a select statement
 func NewSelect(comments Comments, exprs SelectExprs, selectOptions []string, from TableExprs, where *Where, groupBy GroupBy, having *Where) *Select {
 	var cache *bool
 	var distinct, straightJoinHint, sqlFoundRows bool
@@ -852,7 +884,7 @@ func (node *Union) MakeDistinct() {
 	node.UnionSelects[len(node.UnionSelects)-1].Distinct = true
 }
 
-//Unionize returns a UNION, either creating one or adding SELECT to an existing one
+// Unionize returns a UNION, either creating one or adding SELECT to an existing one
 func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit, lock Lock) *Union {
 	union, isUnion := lhs.(*Union)
 	if isUnion {
@@ -866,6 +898,17 @@ func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit,
 	return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
 }
 
+// SetWith sets the WITH clause of a SELECT or UNION statement, and returns the statement.
+func SetWith(stmt SelectStatement, with *With) SelectStatement {
+	switch stmt := stmt.(type) {
+	case *Select:
+		stmt.With = with
+	case *Union:
+		stmt.With = with
+	}
+	return stmt
+}
+
 // ToString returns the string associated with the DDLAction Enum
 func (action DDLAction) ToString() string {
 	switch action {
@@ -1178,6 +1221,32 @@ func (ty ExplainType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty VExplainType) ToString() string {
+	switch ty {
+	case PlanVExplainType:
+		return PlanStr
+	case QueriesVExplainType:
+		return QueriesStr
+	case AllVExplainType:
+		return AllVExplainStr
+	default:
+		return "Unknown VExplainType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty KillType) ToString() string {
+	switch ty {
+	case ConnectionType:
+		return ConnectionStr
+	case QueryType:
+		return QueryStr
+	default:
+		return "Unknown KillType"
+	}
+}
+
 // ToString returns the type as a string
 func (sel SelectIntoType) ToString() string {
 	switch sel {
@@ -1192,6 +1261,50 @@ func (sel SelectIntoType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case FrameRowsType:
+		return FrameRowsStr
+	case FrameRangeType:
+		return FrameRangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (typ FramePointType) ToString() string {
+	switch typ {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
+// ToString returns the type as a string
+func (typ JSONTableOnResponseType) ToString() string {
+	switch typ {
+	case JSONTableErrorType:
+		return JSONTableErrorStr
+	case JSONTableNullType:
+		return JSONTableNullStr
+	case JSONTableDefaultType:
+		return JSONTableDefaultStr
+	default:
+		return "Unknown JSONTableOnResponseType"
+	}
+}
+
 // ToString returns the type as a string
 func (node CollateAndCharsetType) ToString() string {
 	switch node {
@@ -240,7 +240,9 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 		sysvars.ReadAfterWriteTimeOut.Name,
 		sysvars.Version.Name,
 		sysvars.VersionComment.Name,
-		sysvars.SessionTrackGTIDs.Name:
+		sysvars.SessionTrackGTIDs.Name,
+		sysvars.ConsistentSnapshot.Name,
+		sysvars.ReadAsOf.Name:
 		cursor.Replace(bindVarExpression("__vt" + lowered))
 		er.bindVars.AddSysVar(lowered)
 	}
@@ -232,6 +232,18 @@ func TestDDL(t *testing.T) {
 			},
 		},
 		affected: []string{"a", "b", "c", "d"},
+	}, {
+		query: "alter table a exchange partition p0 with table b",
+		output: &AlterTable{
+			Table: TableName{Name: NewTableIdent("a")},
+			PartitionSpec: &PartitionSpec{
+				Action:    ExchangeAction,
+				Names:     Partitions{NewColIdent("p0")},
+				TableName: TableName{Name: NewTableIdent("b")},
+			},
+			FullyParsed: true,
+		},
+		affected: []string{"a", "b"},
 	}, {
 		query: "drop table a",
 		output: &DropTable{
@@ -19,7 +19,11 @@ package sqlparser
 import (
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
 )
 
 const (
@@ -36,6 +40,8 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveCacheTTL caches the result of a select in vtgate for the given duration.
+	DirectiveCacheTTL = "CACHE_TTL"
 )
 
 func isNonSpace(r rune) bool {
@@ -347,3 +353,38 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// CacheTTLDirective returns the duration for which the result of a select
+// can be cached, as set by the CACHE_TTL directive. A bare integer is a
+// number of seconds. It returns 0 if the directive is not set.
+func CacheTTLDirective(stmt Statement) (time.Duration, error) {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Union:
+		if sel, ok := stmt.FirstStatement.(*Select); ok {
+			comments = sel.Comments
+		}
+	}
+	val, ok := ExtractCommentDirectives(comments)[DirectiveCacheTTL]
+	if !ok {
+		return 0, nil
+	}
+	var ttl time.Duration
+	switch val := val.(type) {
+	case int:
+		ttl = time.Duration(val) * time.Second
+	case string:
+		var err error
+		if ttl, err = time.ParseDuration(val); err != nil {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s value: %s", DirectiveCacheTTL, val)
+		}
+	default:
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s value: %v", DirectiveCacheTTL, val)
+	}
+	if ttl < 0 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s value: %v", DirectiveCacheTTL, val)
+	}
+	return ttl, nil
+}
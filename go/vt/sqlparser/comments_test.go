@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -440,3 +442,34 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheTTLDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected time.Duration
+		err      string
+	}{
+		{"select /*vt+ CACHE_TTL=30s */ * from users", 30 * time.Second, ""},
+		{"select /*vt+ CACHE_TTL=1m30s */ * from users", 90 * time.Second, ""},
+		{"select /*vt+ CACHE_TTL=10 */ * from users", 10 * time.Second, ""},
+		{"select /*vt+ CACHE_TTL=30s */ a from users union select b from customers", 30 * time.Second, ""},
+		{"select * from users", 0, ""},
+		{"update /*vt+ CACHE_TTL=30s */ users set name=1", 0, ""},
+		{"select /*vt+ CACHE_TTL=abc */ * from users", 0, "invalid CACHE_TTL value: abc"},
+		{"select /*vt+ CACHE_TTL=-5s */ * from users", 0, "invalid CACHE_TTL value: -5s"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			got, err := CacheTTLDirective(stmt)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
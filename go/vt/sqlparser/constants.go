@@ -182,6 +182,22 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// FrameClause.Unit
+	FrameRowsStr  = "rows"
+	FrameRangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = "preceding"
+	ExprFollowingStr      = "following"
+
+	// JSONTableOnResponse.Type
+	JSONTableErrorStr   = "error"
+	JSONTableNullStr    = "null"
+	JSONTableDefaultStr = "default"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -203,6 +219,15 @@ const (
 	TraditionalStr = "traditional"
 	AnalyzeStr     = "analyze"
 
+	// VExplain types
+	PlanStr        = "plan"
+	QueriesStr     = "queries"
+	AllVExplainStr = "all"
+
+	// Kill types
+	ConnectionStr = "connection"
+	QueryStr      = "query"
+
 	// Lock Types
 	ReadStr             = "read"
 	ReadLocalStr        = "read local"
@@ -255,7 +280,7 @@ const (
 	ReadWrite
 )
 
-//Constants for Enum type - IsolationLevel
+// Constants for Enum type - IsolationLevel
 const (
 	ReadUncommitted IsolationLevel = iota
 	ReadCommitted
@@ -440,6 +465,19 @@ const (
 	AnalyzeType
 )
 
+// Constant for Enum Type - VExplainType
+const (
+	PlanVExplainType VExplainType = iota
+	QueriesVExplainType
+	AllVExplainType
+)
+
+// Constant for Enum Type - KillType
+const (
+	ConnectionType KillType = iota
+	QueryType
+)
+
 // Constant for Enum Type - SelectIntoType
 const (
 	IntoOutfile SelectIntoType = iota
@@ -447,6 +485,36 @@ const (
 	IntoDumpfile
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	FrameRowsType FrameUnitType = iota
+	FrameRangeType
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
+// Constant for Enum Type - JSONTableColumnType
+const (
+	JSONTableOrdinalityType JSONTableColumnType = iota
+	JSONTablePathType
+	JSONTableExistsType
+	JSONTableNestedType
+)
+
+// Constant for Enum Type - JSONTableOnResponseType
+const (
+	JSONTableErrorType JSONTableOnResponseType = iota
+	JSONTableNullType
+	JSONTableDefaultType
+)
+
 // Constant for Enum Type - CollateAndCharsetType
 const (
 	CollateType CollateAndCharsetType = iota
@@ -1399,8 +1399,7 @@ var (
 		input:  "show processlist",
 		output: "show processlist",
 	}, {
-		input:  "show full processlist",
-		output: "show processlist",
+		input: "show full processlist",
 	}, {
 		input:  "show profile cpu for query 1",
 		output: "show profile",
@@ -1504,6 +1503,8 @@ var (
 		input: "show vitess_tablets like '%'",
 	}, {
 		input: "show vitess_tablets where hostname = 'some-tablet'",
+	}, {
+		input: "show vitess_tablet queues",
 	}, {
 		input: "show vschema tables",
 	}, {
@@ -1573,6 +1574,46 @@ var (
 		input: "explain insert into t(col1, col2) values (1, 2)",
 	}, {
 		input: "explain update t set col = 2",
+	}, {
+		input:  "vexplain select * from t",
+		output: "vexplain plan select * from t",
+	}, {
+		input: "vexplain plan select * from t",
+	}, {
+		input: "vexplain queries select * from t where id = 1",
+	}, {
+		input: "vexplain all insert into t(col1, col2) values (1, 2)",
+	}, {
+		input:  "VEXPLAIN QUERIES update t set col = 2",
+		output: "vexplain queries update t set col = 2",
+	}, {
+		input: "vexplain plan delete from t",
+	}, {
+		input:  "select plan, queries, vexplain from t",
+		output: "select `plan`, `queries`, `vexplain` from t",
+	}, {
+		input:  "kill 18",
+		output: "kill connection 18",
+	}, {
+		input: "kill connection 18",
+	}, {
+		input: "kill query 18",
+	}, {
+		input:  "select kill from t",
+		output: "select `kill` from t",
+	}, {
+		input: "purge vitess_result_cache",
+	}, {
+		input:  "PURGE VITESS_RESULT_CACHE FROM ks",
+		output: "purge vitess_result_cache from ks",
+	}, {
+		input: "purge vitess_result_cache from `ks-1`",
+	}, {
+		input:  "select purge, vitess_result_cache from t",
+		output: "select `purge`, `vitess_result_cache` from t",
+	}, {
+		input:  "select concurrent from t",
+		output: "select `concurrent` from t",
 	}, {
 		input:  "truncate table foo",
 		output: "truncate table foo",
@@ -1703,6 +1744,55 @@ var (
 	}, {
 		input:  "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
 		output: "select `name`, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by `name`",
+	}, {
+		input: "select id, row_number() over () from t",
+	}, {
+		input: "select id, rank() over (partition by dept order by salary desc) from t",
+	}, {
+		input: "select id, lag(salary, 1) over (partition by dept, team order by id asc), lead(salary) over (order by id asc) from t",
+	}, {
+		input: "select id, sum(salary) over (partition by dept order by id asc rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select id, avg(salary) over (order by id asc rows 2 preceding) from t",
+	}, {
+		input: "select id, avg(salary) over (order by hired asc range between interval 1 day preceding and interval 2 day following) from t",
+	}, {
+		input: "select id, count(*) over (rows between :a preceding and unbounded following) from t",
+	}, {
+		input:  "select id, row_number() over w, rank() over (w order by id) from t window w as (partition by dept)",
+		output: "select id, row_number() over w, rank() over (w order by id asc) from t window w as (partition by dept)",
+	}, {
+		input: "select id, row_number() over w1 from t where a = 1 group by id having count(*) > 1 window w1 as (order by id asc), w2 as (w1 rows current row) order by id asc limit 1",
+	}, {
+		input: "select `over`, `window`, `rows`, `range` from t",
+	}, {
+		input:  "select current, row, rows from t",
+		output: "select `current`, `row`, `rows` from t",
+	}, {
+		input: "with x as (select a from t) select a from x",
+	}, {
+		input:  "WITH x(c1, c2) AS (SELECT a, b FROM t), y AS (SELECT c1 FROM x) SELECT * FROM y",
+		output: "with x(c1, c2) as (select a, b from t), y as (select c1 from x) select * from y",
+	}, {
+		input: "with recursive seq(n) as (select 1 from dual union all select n + 1 from seq where n < 10) select n from seq",
+	}, {
+		input: "with x as (select a from t) select a from x union select b from u order by a asc limit 1",
+	}, {
+		input: "select * from t where a in (with x as (select b from u) select b from x)",
+	}, {
+		input: "select `recursive` from t",
+	}, {
+		input: "select * from t, json_table(t.doc, '$[*]' columns(id for ordinality, a int path '$.a', b varchar(10) path '$.b' default '1' on empty null on error, c int exists path '$.c', nested path '$.d[*]' columns(e int path '$.e'))) as jt",
+	}, {
+		input:  "SELECT jt.a FROM t JOIN JSON_TABLE(t.doc, '$' COLUMNS (a JSON PATH '$.a' ERROR ON ERROR, NESTED '$.b[*]' COLUMNS (b INT PATH '$')) ) jt ON true",
+		output: "select jt.a from t join json_table(t.doc, '$' columns(a JSON path '$.a' error on error, nested path '$.b[*]' columns(b INT path '$'))) as jt on true",
+	}, {
+		input: "select * from json_table('[1, 2]', '$[*]' columns(a int path '$')) as jt",
+	}, {
+		input: "select a -> '$.b', a ->> '$.c' from t",
+	}, {
+		input:  "select nested, ordinality, path, `empty` from t",
+		output: "select `nested`, `ordinality`, `path`, `empty` from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -1941,6 +2031,18 @@ func TestInvalid(t *testing.T) {
 	}, {
 		input: "/*!*/",
 		err:   "empty statement",
+	}, {
+		input: "select row_number() over (rows between 1 preceding) from t",
+		err:   "syntax error",
+	}, {
+		input: "select sum(a) over (rows a preceding) from t",
+		err:   "syntax error",
+	}, {
+		input: "with x as select a from t select a from x",
+		err:   "syntax error",
+	}, {
+		input: "with x as (select a from t) insert into t values (1)",
+		err:   "syntax error",
 	}}
 
 	for _, tcase := range invalidSQL {
@@ -2250,6 +2352,18 @@ func TestConvert(t *testing.T) {
 	}, {
 		input:  "set transaction isolation level 12345",
 		output: "syntax error at position 38 near '12345'",
+	}, {
+		input:  "kill query",
+		output: "syntax error at position 11",
+	}, {
+		input:  "kill query 99999999999999999999",
+		output: "invalid connection id at position 32 near '99999999999999999999'",
+	}, {
+		input:  "purge vitess_result_cache ks",
+		output: "syntax error at position 29 near 'ks'",
+	}, {
+		input:  "purge vitess_result_cache from",
+		output: "syntax error at position 31",
 	}}
 
 	for _, tcase := range invalidSQL {
@@ -2417,10 +2531,100 @@ func TestLoadData(t *testing.T) {
 		"load data from s3 manifest 'x.txt'",
 		"load data from s3 file 'x.txt'",
 		"load data infile 'x.txt' into table 'c'",
+		"load data low_priority infile 'x.txt' into table x partition (p0) set a = 1",
 		"load data from s3 'x.txt' into table x"}
 	for _, tcase := range validSQL {
-		_, err := Parse(tcase)
+		tree, err := Parse(tcase)
 		require.NoError(t, err)
+		assert.IsType(t, &Load{}, tree)
+	}
+}
+
+func TestLoadDataLocal(t *testing.T) {
+	testcases := []struct {
+		in     string
+		want   *LoadData
+		output string
+		err    string
+	}{{
+		in: "load data local infile 't.csv' into table t",
+		want: &LoadData{
+			FileName:           "t.csv",
+			Table:              TableName{Name: NewTableIdent("t")},
+			FieldsTerminatedBy: "\t",
+			FieldsEscapedBy:    "\\",
+			LinesTerminatedBy:  "\n",
+		},
+		output: "load data local infile 't.csv' into table t",
+	}, {
+		in: "/* comment */ LOAD DATA LOW_PRIORITY LOCAL INFILE 't.csv' REPLACE INTO TABLE ks.`table` " +
+			"CHARACTER SET utf8mb4 FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' ESCAPED BY '' " +
+			"LINES STARTING BY '>' TERMINATED BY '\\r\\n' IGNORE 1 LINES (a, `b`, status);",
+		want: &LoadData{
+			FileName:                 "t.csv",
+			Replace:                  true,
+			Table:                    TableName{Qualifier: NewTableIdent("ks"), Name: NewTableIdent("table")},
+			Charset:                  "utf8mb4",
+			FieldsTerminatedBy:       ",",
+			FieldsEnclosedBy:         "\"",
+			FieldsOptionallyEnclosed: true,
+			FieldsEscapedBy:          "",
+			LinesStartingBy:          ">",
+			LinesTerminatedBy:        "\r\n",
+			IgnoreLines:              1,
+			Columns:                  Columns{NewColIdent("a"), NewColIdent("b"), NewColIdent("status")},
+		},
+		output: "load data local infile 't.csv' replace into table ks.`table` character set utf8mb4 " +
+			"fields terminated by ',' optionally enclosed by '\\\"' escaped by '' " +
+			"lines starting by '>' terminated by '\\r\\n' ignore 1 lines (a, b, `status`)",
+	}, {
+		// The options can be in any order.
+		in: "load data concurrent local infile 't.csv' ignore into table t columns escaped by '!' enclosed by '\\'' lines terminated by ';' starting by '#' ignore 2 rows",
+		want: &LoadData{
+			FileName:           "t.csv",
+			Ignore:             true,
+			Table:              TableName{Name: NewTableIdent("t")},
+			FieldsTerminatedBy: "\t",
+			FieldsEnclosedBy:   "'",
+			FieldsEscapedBy:    "!",
+			LinesStartingBy:    "#",
+			LinesTerminatedBy:  ";",
+			IgnoreLines:        2,
+		},
+		output: "load data local infile 't.csv' ignore into table t fields enclosed by '\\'' escaped by '!' lines starting by '#' terminated by ';' ignore 2 lines",
+	}, {
+		in:  "load data local infile t.csv into table t",
+		err: "syntax error at position 25 near 't'",
+	}, {
+		in:  "load data local infile 't.csv' into table t fields",
+		err: "syntax error at position 51",
+	}, {
+		in:  "load data local infile 't.csv' into table t (a) set b = 1",
+		err: "unsupported: SET clause in LOAD DATA LOCAL INFILE",
+	}, {
+		in:  "load data local infile 't.csv' into table t partition (p0)",
+		err: "syntax error at position 54 near 'partition'",
+	}, {
+		in:  "load data local infile 't.csv' into table t ignore 99999999999999999999 lines",
+		err: "invalid number of lines",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tree, err := Parse(tcase.in)
+			if tcase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, tree)
+			assert.Equal(t, tcase.output, String(tree))
+
+			// The output parses back to the same statement.
+			tree, err = Parse(tcase.output)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, tree)
+		})
 	}
 }
 
@@ -2915,6 +3119,9 @@ var (
 		output       string
 		excludeMulti bool // Don't use in the ParseNext multi-statement parsing tests.
 	}{{
+		input:  "select * from json_table('[]', '$' columns(a int path '$'))",
+		output: "syntax error at position 60",
+	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
 	}, {
@@ -230,6 +230,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -388,6 +400,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -396,6 +420,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -476,6 +504,56 @@ func replaceIsExprExpr(newNode, parent SQLNode) {
 	parent.(*IsExpr).Expr = newNode.(Expr)
 }
 
+func replaceJSONTableColumnColumns(newNode, parent SQLNode) {
+	parent.(*JSONTableColumn).Columns = newNode.(JSONTableColumns)
+}
+
+func replaceJSONTableColumnName(newNode, parent SQLNode) {
+	parent.(*JSONTableColumn).Name = newNode.(ColIdent)
+}
+
+func replaceJSONTableColumnOnEmpty(newNode, parent SQLNode) {
+	parent.(*JSONTableColumn).OnEmpty = newNode.(*JSONTableOnResponse)
+}
+
+func replaceJSONTableColumnOnError(newNode, parent SQLNode) {
+	parent.(*JSONTableColumn).OnError = newNode.(*JSONTableOnResponse)
+}
+
+func replaceJSONTableColumnPath(newNode, parent SQLNode) {
+	parent.(*JSONTableColumn).Path = newNode.(Expr)
+}
+
+type replaceJSONTableColumnsItems int
+
+func (r *replaceJSONTableColumnsItems) replace(newNode, container SQLNode) {
+	container.(JSONTableColumns)[int(*r)] = newNode.(*JSONTableColumn)
+}
+
+func (r *replaceJSONTableColumnsItems) inc() {
+	*r++
+}
+
+func replaceJSONTableExprAlias(newNode, parent SQLNode) {
+	parent.(*JSONTableExpr).Alias = newNode.(TableIdent)
+}
+
+func replaceJSONTableExprColumns(newNode, parent SQLNode) {
+	parent.(*JSONTableExpr).Columns = newNode.(JSONTableColumns)
+}
+
+func replaceJSONTableExprExpr(newNode, parent SQLNode) {
+	parent.(*JSONTableExpr).Expr = newNode.(Expr)
+}
+
+func replaceJSONTableExprPath(newNode, parent SQLNode) {
+	parent.(*JSONTableExpr).Path = newNode.(Expr)
+}
+
+func replaceJSONTableOnResponseExpr(newNode, parent SQLNode) {
+	parent.(*JSONTableOnResponse).Expr = newNode.(Expr)
+}
+
 func replaceJoinConditionOn(newNode, parent SQLNode) {
 	tmp := parent.(JoinCondition)
 	tmp.On = newNode.(Expr)
@@ -506,6 +584,14 @@ func replaceLimitRowcount(newNode, parent SQLNode) {
 	parent.(*Limit).Rowcount = newNode.(Expr)
 }
 
+func replaceLoadDataColumns(newNode, parent SQLNode) {
+	parent.(*LoadData).Columns = newNode.(Columns)
+}
+
+func replaceLoadDataTable(newNode, parent SQLNode) {
+	parent.(*LoadData).Table = newNode.(TableName)
+}
+
 func replaceMatchExprColumns(newNode, parent SQLNode) {
 	parent.(*MatchExpr).Columns = newNode.(SelectExprs)
 }
@@ -575,6 +661,14 @@ func replaceOrderByOptionCols(newNode, parent SQLNode) {
 	parent.(*OrderByOption).Cols = newNode.(Columns)
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+}
+
 func replaceParenSelectSelect(newNode, parent SQLNode) {
 	parent.(*ParenSelect).Select = newNode.(SelectStatement)
 }
@@ -623,6 +717,10 @@ func (r *replacePartitionsItems) inc() {
 	*r++
 }
 
+func replacePurgeResultCacheKeyspace(newNode, parent SQLNode) {
+	parent.(*PurgeResultCache).Keyspace = newNode.(TableIdent)
+}
+
 func replaceRangeCondFrom(newNode, parent SQLNode) {
 	parent.(*RangeCond).From = newNode.(Expr)
 }
@@ -687,6 +785,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(WindowDefinitions)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -907,6 +1013,10 @@ func (r *replaceUnionUnionSelects) inc() {
 	*r++
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUnionSelectStatement(newNode, parent SQLNode) {
 	parent.(*UnionSelect).Statement = newNode.(SelectStatement)
 }
@@ -957,6 +1067,10 @@ func replaceUseDBName(newNode, parent SQLNode) {
 	parent.(*Use).DBName = newNode.(TableIdent)
 }
 
+func replaceVExplainStmtStatement(newNode, parent SQLNode) {
+	parent.(*VExplainStmt).Statement = newNode.(Statement)
+}
+
 func replaceVStreamComments(newNode, parent SQLNode) {
 	parent.(*VStream).Comments = newNode.(Comments)
 }
@@ -1036,6 +1150,50 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowDefinitionName(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).Name = newNode.(ColIdent)
+}
+
+func replaceWindowDefinitionWindowSpec(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).WindowSpec = newNode.(*WindowSpecification)
+}
+
+type replaceWindowDefinitionsItems int
+
+func (r *replaceWindowDefinitionsItems) replace(newNode, container SQLNode) {
+	container.(WindowDefinitions)[int(*r)] = newNode.(*WindowDefinition)
+}
+
+func (r *replaceWindowDefinitionsItems) inc() {
+	*r++
+}
+
+func replaceWindowSpecificationFrameClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).FrameClause = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecificationName(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecificationOrderClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).OrderClause = newNode.(OrderBy)
+}
+
+func replaceWindowSpecificationPartitionClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).PartitionClause = newNode.(Exprs)
+}
+
+type replaceWithCTEs int
+
+func (r *replaceWithCTEs) replace(newNode, container SQLNode) {
+	container.(*With).CTEs[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCTEs) inc() {
+	*r++
+}
+
 func replaceXorExprLeft(newNode, parent SQLNode) {
 	parent.(*XorExpr).Left = newNode.(Expr)
 }
@@ -1199,6 +1357,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -1290,9 +1453,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1339,6 +1510,30 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case IsolationLevel:
 
+	case *JSONTableColumn:
+		a.apply(node, n.Columns, replaceJSONTableColumnColumns)
+		a.apply(node, n.Name, replaceJSONTableColumnName)
+		a.apply(node, n.OnEmpty, replaceJSONTableColumnOnEmpty)
+		a.apply(node, n.OnError, replaceJSONTableColumnOnError)
+		a.apply(node, n.Path, replaceJSONTableColumnPath)
+
+	case JSONTableColumns:
+		replacer := replaceJSONTableColumnsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
+	case *JSONTableExpr:
+		a.apply(node, n.Alias, replaceJSONTableExprAlias)
+		a.apply(node, n.Columns, replaceJSONTableExprColumns)
+		a.apply(node, n.Expr, replaceJSONTableExprExpr)
+		a.apply(node, n.Path, replaceJSONTableExprPath)
+
+	case *JSONTableOnResponse:
+		a.apply(node, n.Expr, replaceJSONTableOnResponseExpr)
+
 	case JoinCondition:
 		a.apply(node, n.On, replaceJoinConditionOn)
 		a.apply(node, n.Using, replaceJoinConditionUsing)
@@ -1350,6 +1545,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *KeyState:
 
+	case *Kill:
+
 	case *Limit:
 		a.apply(node, n.Offset, replaceLimitOffset)
 		a.apply(node, n.Rowcount, replaceLimitRowcount)
@@ -1360,6 +1557,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Load:
 
+	case *LoadData:
+		a.apply(node, n.Columns, replaceLoadDataColumns)
+		a.apply(node, n.Table, replaceLoadDataTable)
+
 	case *LockOption:
 
 	case *LockTables:
@@ -1414,6 +1615,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenSelect:
 		a.apply(node, n.Select, replaceParenSelectSelect)
 
@@ -1443,6 +1648,9 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			replacerRef.inc()
 		}
 
+	case *PurgeResultCache:
+		a.apply(node, n.Keyspace, replacePurgeResultCacheKeyspace)
+
 	case *RangeCond:
 		a.apply(node, n.From, replaceRangeCondFrom)
 		a.apply(node, n.Left, replaceRangeCondLeft)
@@ -1478,6 +1686,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1616,6 +1826,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			a.apply(node, item, replacerUnionSelectsB.replace)
 			replacerUnionSelectsB.inc()
 		}
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *UnionSelect:
 		a.apply(node, n.Statement, replaceUnionSelectStatement)
@@ -1645,6 +1856,9 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Use:
 		a.apply(node, n.DBName, replaceUseDBName)
 
+	case *VExplainStmt:
+		a.apply(node, n.Statement, replaceVExplainStmtStatement)
+
 	case *VStream:
 		a.apply(node, n.Comments, replaceVStreamComments)
 		a.apply(node, n.Limit, replaceVStreamLimit)
@@ -1693,6 +1907,32 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowDefinition:
+		a.apply(node, n.Name, replaceWindowDefinitionName)
+		a.apply(node, n.WindowSpec, replaceWindowDefinitionWindowSpec)
+
+	case WindowDefinitions:
+		replacer := replaceWindowDefinitionsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
+	case *WindowSpecification:
+		a.apply(node, n.FrameClause, replaceWindowSpecificationFrameClause)
+		a.apply(node, n.Name, replaceWindowSpecificationName)
+		a.apply(node, n.OrderClause, replaceWindowSpecificationOrderClause)
+		a.apply(node, n.PartitionClause, replaceWindowSpecificationPartitionClause)
+
+	case *With:
+		replacerCTEs := replaceWithCTEs(0)
+		replacerCTEsB := &replacerCTEs
+		for _, item := range n.CTEs {
+			a.apply(node, item, replacerCTEsB.replace)
+			replacerCTEsB.inc()
+		}
+
 	case *XorExpr:
 		a.apply(node, n.Left, replaceXorExprLeft)
 		a.apply(node, n.Right, replaceXorExprRight)
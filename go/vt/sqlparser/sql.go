@@ -76,6 +76,19 @@ type yySymType struct {
 	orderBy                OrderBy
 	order                  *Order
 	limit                  *Limit
+	overClause             *OverClause
+	windowSpec             *WindowSpecification
+	frameClause            *FrameClause
+	frameUnit              FrameUnitType
+	framePoint             *FramePoint
+	windowDef              *WindowDefinition
+	windowDefs             WindowDefinitions
+	with                   *With
+	cte                    *CommonTableExpr
+	ctes                   []*CommonTableExpr
+	jtColumn               *JSONTableColumn
+	jtColumns              JSONTableColumns
+	jtOnResponse           *JSONTableOnResponse
 	updateExprs            UpdateExprs
 	setExprs               SetExprs
 	updateExpr             *UpdateExpr
@@ -121,6 +134,8 @@ type yySymType struct {
 	matchExprOption        MatchExprOption
 	orderDirection         OrderDirection
 	explainType            ExplainType
+	vexplainType           VExplainType
+	killType               KillType
 	selectInto             *SelectInto
 	createDatabase         *CreateDatabase
 	alterDatabase          *AlterDatabase
@@ -137,6 +152,7 @@ type yySymType struct {
 	tableOptions           TableOptions
 	renameTablePairs       []*RenameTablePair
 	columnTypeOptions      *ColumnTypeOptions
+	loadData               *LoadData
 }
 
 const LEX_ERROR = 57346
@@ -416,176 +432,190 @@ const KEYSPACES = 57619
 const VITESS_KEYSPACES = 57620
 const VITESS_SHARDS = 57621
 const VITESS_TABLETS = 57622
-const CODE = 57623
-const PRIVILEGES = 57624
-const FUNCTION = 57625
-const OPEN = 57626
-const TRIGGERS = 57627
-const EVENT = 57628
-const USER = 57629
-const NAMES = 57630
-const CHARSET = 57631
-const GLOBAL = 57632
-const SESSION = 57633
-const ISOLATION = 57634
-const LEVEL = 57635
-const READ = 57636
-const WRITE = 57637
-const ONLY = 57638
-const REPEATABLE = 57639
-const COMMITTED = 57640
-const UNCOMMITTED = 57641
-const SERIALIZABLE = 57642
-const CURRENT_TIMESTAMP = 57643
-const DATABASE = 57644
-const CURRENT_DATE = 57645
-const CURRENT_TIME = 57646
-const LOCALTIME = 57647
-const LOCALTIMESTAMP = 57648
-const CURRENT_USER = 57649
-const UTC_DATE = 57650
-const UTC_TIME = 57651
-const UTC_TIMESTAMP = 57652
-const REPLACE = 57653
-const CONVERT = 57654
-const CAST = 57655
-const SUBSTR = 57656
-const SUBSTRING = 57657
-const GROUP_CONCAT = 57658
-const SEPARATOR = 57659
-const TIMESTAMPADD = 57660
-const TIMESTAMPDIFF = 57661
-const MATCH = 57662
-const AGAINST = 57663
-const BOOLEAN = 57664
-const LANGUAGE = 57665
-const WITH = 57666
-const QUERY = 57667
-const EXPANSION = 57668
-const WITHOUT = 57669
-const VALIDATION = 57670
-const UNUSED = 57671
-const ARRAY = 57672
-const CUME_DIST = 57673
-const DESCRIPTION = 57674
-const DENSE_RANK = 57675
-const EMPTY = 57676
-const EXCEPT = 57677
-const FIRST_VALUE = 57678
-const GROUPING = 57679
-const GROUPS = 57680
-const JSON_TABLE = 57681
-const LAG = 57682
-const LAST_VALUE = 57683
-const LATERAL = 57684
-const LEAD = 57685
-const MEMBER = 57686
-const NTH_VALUE = 57687
-const NTILE = 57688
-const OF = 57689
-const OVER = 57690
-const PERCENT_RANK = 57691
-const RANK = 57692
-const RECURSIVE = 57693
-const ROW_NUMBER = 57694
-const SYSTEM = 57695
-const WINDOW = 57696
-const ACTIVE = 57697
-const ADMIN = 57698
-const BUCKETS = 57699
-const CLONE = 57700
-const COMPONENT = 57701
-const DEFINITION = 57702
-const ENFORCED = 57703
-const EXCLUDE = 57704
-const FOLLOWING = 57705
-const GEOMCOLLECTION = 57706
-const GET_MASTER_PUBLIC_KEY = 57707
-const HISTOGRAM = 57708
-const HISTORY = 57709
-const INACTIVE = 57710
-const INVISIBLE = 57711
-const LOCKED = 57712
-const MASTER_COMPRESSION_ALGORITHMS = 57713
-const MASTER_PUBLIC_KEY_PATH = 57714
-const MASTER_TLS_CIPHERSUITES = 57715
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57716
-const NESTED = 57717
-const NETWORK_NAMESPACE = 57718
-const NOWAIT = 57719
-const NULLS = 57720
-const OJ = 57721
-const OLD = 57722
-const OPTIONAL = 57723
-const ORDINALITY = 57724
-const ORGANIZATION = 57725
-const OTHERS = 57726
-const PATH = 57727
-const PERSIST = 57728
-const PERSIST_ONLY = 57729
-const PRECEDING = 57730
-const PRIVILEGE_CHECKS_USER = 57731
-const PROCESS = 57732
-const RANDOM = 57733
-const REFERENCE = 57734
-const REQUIRE_ROW_FORMAT = 57735
-const RESOURCE = 57736
-const RESPECT = 57737
-const RESTART = 57738
-const RETAIN = 57739
-const REUSE = 57740
-const ROLE = 57741
-const SECONDARY = 57742
-const SECONDARY_ENGINE = 57743
-const SECONDARY_LOAD = 57744
-const SECONDARY_UNLOAD = 57745
-const SKIP = 57746
-const SRID = 57747
-const THREAD_PRIORITY = 57748
-const TIES = 57749
-const UNBOUNDED = 57750
-const VCPU = 57751
-const VISIBLE = 57752
-const FORMAT = 57753
-const TREE = 57754
-const VITESS = 57755
-const TRADITIONAL = 57756
-const LOCAL = 57757
-const LOW_PRIORITY = 57758
-const NO_WRITE_TO_BINLOG = 57759
-const LOGS = 57760
-const ERROR = 57761
-const GENERAL = 57762
-const HOSTS = 57763
-const OPTIMIZER_COSTS = 57764
-const USER_RESOURCES = 57765
-const SLOW = 57766
-const CHANNEL = 57767
-const RELAY = 57768
-const EXPORT = 57769
-const AVG_ROW_LENGTH = 57770
-const CONNECTION = 57771
-const CHECKSUM = 57772
-const DELAY_KEY_WRITE = 57773
-const ENCRYPTION = 57774
-const ENGINE = 57775
-const INSERT_METHOD = 57776
-const MAX_ROWS = 57777
-const MIN_ROWS = 57778
-const PACK_KEYS = 57779
-const PASSWORD = 57780
-const FIXED = 57781
-const DYNAMIC = 57782
-const COMPRESSED = 57783
-const REDUNDANT = 57784
-const COMPACT = 57785
-const ROW_FORMAT = 57786
-const STATS_AUTO_RECALC = 57787
-const STATS_PERSISTENT = 57788
-const STATS_SAMPLE_PAGES = 57789
-const STORAGE = 57790
-const MEMORY = 57791
-const DISK = 57792
+const VITESS_TABLET = 57623
+const QUEUES = 57624
+const CODE = 57625
+const PRIVILEGES = 57626
+const FUNCTION = 57627
+const OPEN = 57628
+const TRIGGERS = 57629
+const EVENT = 57630
+const USER = 57631
+const NAMES = 57632
+const CHARSET = 57633
+const GLOBAL = 57634
+const SESSION = 57635
+const ISOLATION = 57636
+const LEVEL = 57637
+const READ = 57638
+const WRITE = 57639
+const ONLY = 57640
+const REPEATABLE = 57641
+const COMMITTED = 57642
+const UNCOMMITTED = 57643
+const SERIALIZABLE = 57644
+const CURRENT_TIMESTAMP = 57645
+const DATABASE = 57646
+const CURRENT_DATE = 57647
+const CURRENT_TIME = 57648
+const LOCALTIME = 57649
+const LOCALTIMESTAMP = 57650
+const CURRENT_USER = 57651
+const UTC_DATE = 57652
+const UTC_TIME = 57653
+const UTC_TIMESTAMP = 57654
+const REPLACE = 57655
+const CONVERT = 57656
+const CAST = 57657
+const SUBSTR = 57658
+const SUBSTRING = 57659
+const GROUP_CONCAT = 57660
+const SEPARATOR = 57661
+const TIMESTAMPADD = 57662
+const TIMESTAMPDIFF = 57663
+const MATCH = 57664
+const AGAINST = 57665
+const BOOLEAN = 57666
+const LANGUAGE = 57667
+const WITH = 57668
+const QUERY = 57669
+const EXPANSION = 57670
+const WITHOUT = 57671
+const VALIDATION = 57672
+const UNUSED = 57673
+const ARRAY = 57674
+const CUME_DIST = 57675
+const DESCRIPTION = 57676
+const DENSE_RANK = 57677
+const EMPTY = 57678
+const EXCEPT = 57679
+const FIRST_VALUE = 57680
+const GROUPING = 57681
+const GROUPS = 57682
+const JSON_TABLE = 57683
+const LAG = 57684
+const LAST_VALUE = 57685
+const LATERAL = 57686
+const LEAD = 57687
+const MEMBER = 57688
+const NTH_VALUE = 57689
+const NTILE = 57690
+const OF = 57691
+const OVER = 57692
+const PERCENT_RANK = 57693
+const RANK = 57694
+const RECURSIVE = 57695
+const ROW_NUMBER = 57696
+const SYSTEM = 57697
+const WINDOW = 57698
+const ACTIVE = 57699
+const ADMIN = 57700
+const BUCKETS = 57701
+const CLONE = 57702
+const COMPONENT = 57703
+const DEFINITION = 57704
+const ENFORCED = 57705
+const EXCLUDE = 57706
+const FOLLOWING = 57707
+const GEOMCOLLECTION = 57708
+const GET_MASTER_PUBLIC_KEY = 57709
+const HISTOGRAM = 57710
+const HISTORY = 57711
+const INACTIVE = 57712
+const INVISIBLE = 57713
+const LOCKED = 57714
+const MASTER_COMPRESSION_ALGORITHMS = 57715
+const MASTER_PUBLIC_KEY_PATH = 57716
+const MASTER_TLS_CIPHERSUITES = 57717
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57718
+const NESTED = 57719
+const NETWORK_NAMESPACE = 57720
+const NOWAIT = 57721
+const NULLS = 57722
+const OJ = 57723
+const OLD = 57724
+const OPTIONAL = 57725
+const ORDINALITY = 57726
+const ORGANIZATION = 57727
+const OTHERS = 57728
+const PATH = 57729
+const PERSIST = 57730
+const PERSIST_ONLY = 57731
+const PRECEDING = 57732
+const PRIVILEGE_CHECKS_USER = 57733
+const PROCESS = 57734
+const RANDOM = 57735
+const REFERENCE = 57736
+const REQUIRE_ROW_FORMAT = 57737
+const RESOURCE = 57738
+const RESPECT = 57739
+const RESTART = 57740
+const RETAIN = 57741
+const REUSE = 57742
+const ROLE = 57743
+const SECONDARY = 57744
+const SECONDARY_ENGINE = 57745
+const SECONDARY_LOAD = 57746
+const SECONDARY_UNLOAD = 57747
+const SKIP = 57748
+const SRID = 57749
+const THREAD_PRIORITY = 57750
+const TIES = 57751
+const UNBOUNDED = 57752
+const VCPU = 57753
+const VISIBLE = 57754
+const CURRENT = 57755
+const RANGE = 57756
+const ROW = 57757
+const ROWS = 57758
+const FORMAT = 57759
+const TREE = 57760
+const VITESS = 57761
+const TRADITIONAL = 57762
+const VEXPLAIN = 57763
+const PLAN = 57764
+const QUERIES = 57765
+const KILL = 57766
+const PURGE = 57767
+const VITESS_RESULT_CACHE = 57768
+const INFILE = 57769
+const CONCURRENT = 57770
+const LOCAL = 57771
+const LOW_PRIORITY = 57772
+const NO_WRITE_TO_BINLOG = 57773
+const LOGS = 57774
+const ERROR = 57775
+const GENERAL = 57776
+const HOSTS = 57777
+const OPTIMIZER_COSTS = 57778
+const USER_RESOURCES = 57779
+const SLOW = 57780
+const CHANNEL = 57781
+const RELAY = 57782
+const EXPORT = 57783
+const AVG_ROW_LENGTH = 57784
+const CONNECTION = 57785
+const CHECKSUM = 57786
+const DELAY_KEY_WRITE = 57787
+const ENCRYPTION = 57788
+const ENGINE = 57789
+const INSERT_METHOD = 57790
+const MAX_ROWS = 57791
+const MIN_ROWS = 57792
+const PACK_KEYS = 57793
+const PASSWORD = 57794
+const FIXED = 57795
+const DYNAMIC = 57796
+const COMPRESSED = 57797
+const REDUNDANT = 57798
+const COMPACT = 57799
+const ROW_FORMAT = 57800
+const STATS_AUTO_RECALC = 57801
+const STATS_PERSISTENT = 57802
+const STATS_SAMPLE_PAGES = 57803
+const STORAGE = 57804
+const MEMORY = 57805
+const DISK = 57806
 
 var yyToknames = [...]string{
 	"$end",
@@ -885,6 +915,8 @@ var yyToknames = [...]string{
 	"VITESS_KEYSPACES",
 	"VITESS_SHARDS",
 	"VITESS_TABLETS",
+	"VITESS_TABLET",
+	"QUEUES",
 	"CODE",
 	"PRIVILEGES",
 	"FUNCTION",
@@ -1015,10 +1047,22 @@ var yyToknames = [...]string{
 	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"CURRENT",
+	"RANGE",
+	"ROW",
+	"ROWS",
 	"FORMAT",
 	"TREE",
 	"VITESS",
 	"TRADITIONAL",
+	"VEXPLAIN",
+	"PLAN",
+	"QUERIES",
+	"KILL",
+	"PURGE",
+	"VITESS_RESULT_CACHE",
+	"INFILE",
+	"CONCURRENT",
 	"LOCAL",
 	"LOW_PRIORITY",
 	"NO_WRITE_TO_BINLOG",
@@ -1069,593 +1113,663 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 43,
-	163, 923,
-	-2, 90,
-	-1, 44,
-	1, 111,
-	468, 111,
-	-2, 117,
-	-1, 45,
-	143, 117,
-	254, 117,
-	306, 117,
-	-2, 324,
-	-1, 52,
-	34, 465,
-	164, 465,
-	176, 465,
-	209, 479,
-	210, 479,
-	-2, 467,
-	-1, 57,
-	166, 489,
-	-2, 487,
-	-1, 82,
-	56, 556,
-	-2, 564,
-	-1, 107,
-	1, 112,
-	468, 112,
-	-2, 117,
-	-1, 117,
-	169, 229,
-	170, 229,
-	-2, 318,
-	-1, 136,
-	143, 117,
-	254, 117,
-	306, 117,
-	-2, 333,
-	-1, 570,
-	150, 944,
-	-2, 940,
-	-1, 571,
-	150, 945,
-	-2, 941,
-	-1, 589,
-	56, 557,
-	-2, 569,
-	-1, 590,
-	56, 558,
-	-2, 570,
-	-1, 610,
-	118, 1283,
-	-2, 83,
-	-1, 611,
-	118, 1166,
-	-2, 84,
-	-1, 617,
-	118, 1216,
-	-2, 917,
-	-1, 754,
-	118, 1104,
-	-2, 914,
-	-1, 789,
-	175, 37,
-	180, 37,
-	-2, 240,
-	-1, 868,
-	1, 371,
-	468, 371,
-	-2, 117,
-	-1, 1104,
-	1, 267,
-	468, 267,
-	-2, 117,
-	-1, 1182,
-	169, 229,
-	170, 229,
-	-2, 318,
-	-1, 1191,
-	175, 38,
-	180, 38,
-	-2, 241,
-	-1, 1399,
-	150, 947,
-	-2, 943,
-	-1, 1491,
-	74, 65,
-	82, 65,
-	-2, 69,
-	-1, 1512,
-	1, 268,
-	468, 268,
-	-2, 117,
-	-1, 1920,
-	5, 811,
-	18, 811,
-	20, 811,
-	32, 811,
-	83, 811,
-	-2, 595,
-	-1, 2132,
-	46, 885,
-	-2, 883,
+	-1, 47,
+	163, 1011,
+	-2, 127,
+	-1, 48,
+	1, 148,
+	482, 148,
+	-2, 154,
+	-1, 49,
+	143, 154,
+	254, 154,
+	308, 154,
+	-2, 361,
+	-1, 56,
+	34, 503,
+	164, 503,
+	176, 503,
+	209, 517,
+	210, 517,
+	-2, 505,
+	-1, 61,
+	166, 527,
+	-2, 525,
+	-1, 86,
+	46, 973,
+	-2, 55,
+	-1, 95,
+	56, 599,
+	-2, 607,
+	-1, 403,
+	1, 149,
+	482, 149,
+	-2, 154,
+	-1, 413,
+	169, 266,
+	170, 266,
+	-2, 355,
+	-1, 432,
+	143, 154,
+	254, 154,
+	308, 154,
+	-2, 370,
+	-1, 604,
+	150, 1038,
+	-2, 1034,
+	-1, 605,
+	150, 1039,
+	-2, 1035,
+	-1, 639,
+	56, 600,
+	-2, 612,
+	-1, 640,
+	56, 601,
+	-2, 613,
+	-1, 664,
+	118, 1388,
+	-2, 120,
+	-1, 665,
+	118, 1264,
+	-2, 121,
+	-1, 671,
+	118, 1315,
+	-2, 1005,
+	-1, 810,
+	118, 1200,
+	-2, 1002,
+	-1, 845,
+	175, 40,
+	180, 40,
+	-2, 277,
+	-1, 924,
+	1, 408,
+	482, 408,
+	-2, 154,
+	-1, 1185,
+	1, 304,
+	482, 304,
+	-2, 154,
+	-1, 1263,
+	169, 266,
+	170, 266,
+	-2, 355,
+	-1, 1272,
+	175, 41,
+	180, 41,
+	-2, 278,
+	-1, 1480,
+	150, 1041,
+	-2, 1037,
+	-1, 1596,
+	74, 102,
+	82, 102,
+	-2, 106,
+	-1, 1617,
+	1, 305,
+	482, 305,
+	-2, 154,
+	-1, 2044,
+	5, 899,
+	18, 899,
+	20, 899,
+	32, 899,
+	83, 899,
+	-2, 656,
+	-1, 2270,
+	46, 973,
+	-2, 971,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 27976
+const yyLast = 30794
 
 var yyAct = [...]int{
-	570, 2213, 2200, 1972, 2177, 1833, 1802, 1723, 514, 2141,
-	2132, 2083, 2061, 926, 81, 3, 1900, 1575, 543, 1690,
-	1509, 1901, 582, 1436, 1969, 1059, 1724, 1007, 529, 1897,
-	1542, 1806, 1710, 512, 1527, 1166, 1547, 758, 1787, 1488,
-	1788, 1912, 1859, 145, 1650, 1786, 880, 1625, 176, 1393,
-	1573, 188, 1300, 477, 188, 907, 1052, 1189, 79, 493,
-	819, 188, 131, 1385, 1549, 1096, 1780, 1089, 1477, 188,
-	784, 1470, 1062, 1057, 1438, 1082, 1045, 1419, 615, 576,
-	591, 1362, 32, 516, 943, 1080, 797, 1161, 765, 762,
-	493, 1165, 505, 493, 188, 493, 1196, 1079, 770, 790,
-	1279, 1453, 1086, 612, 766, 1538, 785, 786, 1095, 1069,
-	1493, 77, 924, 787, 1093, 1305, 1207, 774, 874, 861,
-	1181, 500, 8, 114, 115, 7, 108, 109, 148, 76,
-	6, 1825, 1824, 1604, 2085, 1847, 1848, 1351, 175, 1020,
-	1266, 1433, 1434, 1528, 1350, 1349, 1021, 177, 178, 179,
-	1348, 82, 1347, 1346, 503, 1339, 504, 597, 601, 1688,
-	577, 2129, 509, 759, 2169, 2040, 116, 2107, 2106, 1946,
-	823, 188, 2056, 110, 822, 2057, 2219, 2174, 2212, 1167,
-	824, 188, 78, 873, 501, 2152, 188, 84, 85, 86,
-	87, 88, 89, 944, 821, 2203, 453, 177, 178, 179,
-	1552, 1973, 1592, 1640, 609, 801, 2173, 835, 836, 1876,
-	839, 840, 841, 842, 2004, 776, 845, 846, 847, 848,
-	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
-	859, 832, 800, 616, 778, 777, 110, 944, 555, 2151,
-	561, 562, 559, 560, 102, 558, 557, 556, 1097, 174,
-	1098, 825, 826, 827, 779, 563, 564, 470, 954, 1927,
-	1928, 1504, 1505, 1435, 34, 1494, 469, 70, 38, 39,
-	1611, 1689, 1754, 1926, 1610, 1753, 467, 1846, 1755, 1551,
-	169, 105, 1638, 182, 183, 1503, 914, 900, 916, 177,
-	178, 179, 837, 481, 893, 887, 888, 899, 574, 105,
-	838, 97, 954, 876, 110, 111, 100, 133, 922, 99,
-	98, 573, 1771, 780, 1521, 464, 153, 2154, 1835, 1340,
-	1341, 1342, 1995, 1993, 475, 913, 915, 491, 1338, 495,
-	489, 1256, 1807, 942, 105, 170, 1396, 885, 103, 69,
-	1574, 886, 887, 888, 1607, 480, 1829, 143, 950, 1280,
-	2202, 1288, 132, 1289, 1830, 1290, 103, 862, 904, 905,
-	902, 903, 1285, 920, 906, 1619, 869, 481, 1838, 844,
-	150, 843, 151, 1257, 2103, 1258, 901, 120, 121, 142,
-	141, 168, 1837, 894, 2170, 1282, 781, 921, 2051, 808,
-	1836, 1576, 950, 1284, 454, 456, 457, 1175, 473, 474,
-	482, 806, 1471, 818, 471, 472, 483, 458, 459, 487,
-	486, 481, 463, 460, 462, 468, 1286, 817, 816, 480,
-	466, 484, 815, 912, 481, 814, 911, 917, 2220, 137,
-	118, 144, 125, 117, 1283, 138, 139, 1945, 104, 154,
-	813, 812, 910, 811, 810, 805, 1553, 2052, 1494, 159,
-	126, 1624, 763, 188, 897, 2189, 104, 793, 173, 481,
-	763, 1195, 1194, 480, 129, 127, 122, 123, 124, 128,
-	107, 918, 1768, 1763, 119, 792, 480, 875, 493, 493,
-	493, 809, 775, 130, 949, 946, 947, 948, 953, 955,
-	952, 104, 951, 807, 603, 1839, 493, 493, 919, 945,
-	2217, 883, 1598, 889, 890, 891, 892, 1293, 2150, 930,
-	1609, 480, 936, 799, 828, 763, 1764, 1796, 2142, 761,
-	1606, 1691, 1693, 923, 1639, 799, 2155, 1885, 949, 946,
-	947, 948, 953, 955, 952, 1884, 951, 1883, 1766, 773,
-	772, 1761, 771, 945, 1627, 485, 1817, 1627, 872, 1626,
-	769, 146, 1626, 1762, 452, 180, 1860, 1618, 992, 993,
-	1617, 2136, 1510, 478, 2024, 1925, 1268, 1267, 1269, 1270,
-	1271, 834, 1715, 1658, 188, 1584, 1669, 799, 479, 1499,
-	1073, 1594, 1005, 878, 896, 866, 71, 980, 1750, 1449,
-	884, 927, 928, 1050, 970, 990, 898, 980, 799, 1862,
-	493, 908, 1049, 188, 140, 188, 188, 1666, 493, 177,
-	178, 179, 1769, 1767, 493, 799, 134, 1692, 1335, 135,
-	939, 612, 882, 937, 960, 2111, 92, 1008, 938, 820,
-	2119, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 799, 1078, 980, 868, 2215, 798, 1046,
-	2216, 1910, 2214, 1306, 802, 792, 1281, 1864, 1099, 1868,
-	798, 1863, 940, 1861, 803, 863, 1878, 864, 1866, 1776,
-	865, 93, 1063, 867, 177, 178, 179, 1865, 1387, 1420,
-	994, 995, 996, 997, 998, 999, 1000, 1001, 1002, 1003,
-	1867, 1869, 957, 1591, 1043, 1665, 1023, 1025, 1027, 1029,
-	1031, 1033, 1034, 1024, 1026, 1593, 1030, 1032, 960, 1035,
-	1172, 1420, 798, 1676, 833, 992, 993, 909, 1589, 1765,
-	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 1051, 808, 798, 1388, 881, 164, 165, 166, 167,
-	792, 795, 796, 806, 763, 1369, 992, 993, 789, 793,
-	798, 616, 1586, 1586, 1454, 1455, 802, 792, 188, 1367,
-	1368, 1366, 1157, 1451, 2204, 1930, 803, 788, 2039, 1307,
-	172, 2221, 1168, 1169, 1170, 1171, 1590, 1588, 798, 958,
-	959, 957, 959, 957, 804, 792, 795, 796, 493, 763,
-	1191, 69, 2205, 789, 793, 1066, 1664, 960, 1200, 960,
-	2038, 1951, 1204, 1365, 1663, 493, 493, 1784, 493, 1201,
-	493, 493, 1783, 493, 493, 493, 493, 493, 493, 973,
-	974, 975, 976, 977, 970, 1187, 1450, 980, 493, 958,
-	959, 957, 188, 1240, 1235, 1236, 958, 959, 957, 2222,
-	1173, 1174, 2194, 2120, 1556, 1276, 1180, 960, 1253, 602,
-	1261, 958, 959, 957, 960, 1199, 958, 959, 957, 493,
-	958, 959, 957, 1094, 1880, 1237, 768, 188, 1260, 960,
-	2195, 1643, 1644, 1645, 960, 188, 1259, 1299, 960, 188,
-	1275, 1887, 1197, 1197, 1357, 1359, 1360, 1164, 1163, 1251,
-	177, 178, 179, 1198, 1757, 188, 1358, 1273, 1243, 1244,
-	1156, 1245, 188, 1177, 1249, 1250, 1178, 1176, 599, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 493, 493,
-	493, 1263, 1209, 1242, 1210, 1061, 1212, 1214, 1190, 1888,
-	1218, 1220, 1222, 1224, 1226, 1785, 1241, 604, 605, 1274,
-	1310, 1308, 1309, 188, 177, 178, 179, 1314, 1568, 1316,
-	1317, 1318, 1319, 607, 1321, 1313, 1272, 1302, 1216, 958,
-	959, 957, 1320, 2207, 971, 972, 973, 974, 975, 976,
-	977, 970, 1238, 506, 980, 2206, 2196, 960, 2185, 1832,
-	1262, 1386, 1363, 532, 531, 534, 535, 536, 537, 2074,
-	1389, 1294, 533, 2036, 538, 778, 777, 110, 177, 178,
-	179, 2012, 1566, 1933, 493, 177, 178, 179, 1889, 1254,
-	1793, 1312, 177, 178, 179, 1781, 78, 1634, 1602, 1397,
-	1601, 1303, 1408, 1411, 1264, 1252, 1390, 1391, 1421, 1248,
-	1331, 1332, 1333, 1247, 1403, 1246, 586, 493, 493, 1958,
-	2188, 1958, 2148, 1958, 2137, 1345, 2101, 1364, 188, 1958,
-	586, 1958, 2109, 2054, 586, 1586, 586, 1495, 1398, 2022,
-	586, 493, 1958, 1963, 1943, 1942, 1939, 1940, 188, 1939,
-	1938, 493, 1444, 2100, 1008, 188, 1443, 188, 1462, 586,
-	1494, 1826, 1456, 1495, 1399, 188, 188, 1397, 1711, 1427,
-	1428, 1971, 493, 1711, 586, 493, 1160, 1811, 34, 1489,
-	1804, 1805, 1474, 586, 1809, 612, 493, 1898, 612, 956,
-	586, 1160, 1159, 1105, 1104, 34, 1909, 80, 1400, 1496,
-	1795, 2041, 1587, 1718, 1518, 1361, 1468, 1498, 1370, 1371,
-	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 1384, 1514, 1464, 1496, 1719, 1463, 1513, 1909,
-	1744, 2019, 1399, 1494, 1529, 1530, 1531, 1474, 1494, 1473,
-	956, 493, 1909, 2090, 2110, 188, 1231, 34, 493, 2042,
-	2043, 2044, 1958, 69, 1565, 1567, 1517, 1586, 1941, 1474,
-	1466, 1502, 1681, 1462, 1680, 1423, 1544, 493, 1492, 1462,
-	69, 579, 1586, 493, 1569, 1550, 1452, 1200, 1497, 1200,
-	1522, 1501, 1523, 1524, 1525, 1526, 1500, 1585, 1431, 1343,
-	1474, 1292, 1516, 1515, 1232, 1233, 1234, 1462, 1534, 1535,
-	1536, 1537, 1091, 783, 1572, 782, 2140, 69, 2063, 1970,
-	2030, 1162, 1543, 1831, 1579, 616, 1539, 493, 616, 1386,
-	1533, 1532, 69, 1278, 1386, 1386, 1545, 1192, 1188, 1158,
-	94, 1790, 2045, 174, 1582, 1789, 1583, 1834, 1540, 1541,
-	1561, 1562, 1563, 1555, 1557, 1554, 69, 1913, 1914, 1228,
-	2064, 571, 801, 1167, 1595, 2209, 2201, 1916, 1898, 188,
-	1545, 1578, 1197, 188, 188, 188, 188, 188, 1596, 1800,
-	1577, 1799, 1581, 188, 188, 188, 188, 2046, 2047, 800,
-	1790, 1798, 1597, 1559, 1336, 1295, 188, 1599, 1600, 1919,
-	1918, 1735, 1732, 188, 1229, 1230, 1736, 1479, 1482, 1483,
-	1484, 1480, 189, 1481, 1485, 189, 1733, 1913, 1914, 1731,
-	494, 1734, 189, 2191, 2172, 1060, 1890, 188, 493, 1737,
-	189, 1483, 1484, 1700, 2023, 1961, 1404, 1405, 1709, 1708,
-	1410, 1413, 1414, 2160, 2157, 1629, 1630, 2193, 2176, 2178,
-	1632, 494, 96, 2184, 494, 189, 494, 1633, 101, 2183,
-	1479, 1482, 1483, 1484, 1480, 1426, 1481, 1485, 1429, 1430,
-	2133, 1605, 2131, 1291, 572, 1363, 978, 979, 971, 972,
-	973, 974, 975, 976, 977, 970, 592, 1622, 980, 969,
-	968, 978, 979, 971, 972, 973, 974, 975, 976, 977,
-	970, 593, 181, 980, 1698, 171, 1794, 961, 184, 830,
-	829, 1053, 1699, 1982, 1789, 1845, 1660, 929, 1637, 592,
-	1416, 2088, 188, 1054, 1064, 1065, 595, 2017, 594, 1422,
-	188, 1819, 189, 1818, 593, 1417, 111, 1935, 1646, 1934,
-	1364, 1580, 189, 506, 1206, 1205, 1193, 189, 1651, 1454,
-	1455, 1564, 1018, 1447, 188, 1298, 2102, 589, 590, 595,
-	2058, 594, 1487, 580, 581, 188, 188, 188, 188, 188,
-	1659, 1707, 1725, 577, 1642, 1720, 583, 188, 2198, 1706,
-	2197, 188, 1055, 1058, 188, 188, 1675, 2181, 188, 188,
-	188, 1697, 1716, 1713, 2161, 1742, 2016, 1046, 1687, 1957,
-	1570, 1756, 584, 1704, 1695, 80, 2015, 1893, 1711, 2211,
-	2210, 585, 1670, 1667, 1074, 1067, 1703, 2211, 579, 1775,
-	1647, 1648, 1649, 1745, 2134, 1712, 1932, 1747, 1448, 78,
-	83, 75, 1, 465, 1432, 1714, 1044, 476, 1774, 2199,
-	1777, 1778, 1779, 1265, 1738, 1255, 1759, 1974, 1772, 1773,
-	188, 1727, 1728, 1743, 1730, 2060, 1748, 1964, 1751, 1548,
-	791, 493, 1726, 136, 1511, 1729, 1512, 493, 1302, 2144,
-	493, 91, 1200, 1808, 1550, 1760, 1812, 493, 756, 90,
-	794, 895, 1571, 2055, 1770, 1520, 1111, 1109, 1814, 1823,
-	1782, 586, 1110, 1108, 1113, 1112, 1107, 188, 1337, 490,
-	1486, 1100, 1792, 1791, 1068, 831, 455, 1944, 1822, 1334,
-	1603, 461, 988, 1705, 1752, 188, 613, 542, 1821, 606,
-	1904, 2182, 1180, 2158, 2156, 2130, 2084, 1398, 2159, 1813,
-	2128, 2192, 2175, 1519, 1446, 1056, 1820, 969, 968, 978,
-	979, 971, 972, 973, 974, 975, 976, 977, 970, 493,
-	2014, 980, 1892, 1399, 1674, 1386, 1017, 1418, 1083, 1841,
-	1840, 515, 1856, 1442, 1356, 530, 527, 528, 187, 1457,
-	1717, 488, 962, 513, 1843, 1857, 507, 1844, 187, 1075,
-	1478, 1476, 1849, 1475, 1296, 493, 187, 1087, 1915, 1877,
-	1911, 1081, 1461, 1608, 1828, 941, 188, 1855, 1871, 588,
-	502, 95, 600, 600, 1415, 2118, 493, 1858, 1641, 2003,
-	587, 187, 493, 493, 189, 60, 1899, 1725, 1870, 1856,
-	37, 1655, 1656, 497, 2168, 932, 596, 31, 1902, 1896,
-	30, 29, 28, 23, 22, 188, 21, 20, 19, 494,
-	494, 494, 1673, 25, 18, 17, 1908, 16, 106, 47,
-	44, 42, 113, 112, 45, 41, 870, 494, 494, 27,
-	26, 1917, 15, 14, 13, 12, 1921, 11, 1923, 10,
-	1924, 9, 5, 4, 1922, 935, 24, 1006, 2, 0,
-	0, 1304, 0, 1936, 1937, 1952, 0, 188, 187, 188,
-	188, 188, 1929, 0, 0, 493, 0, 0, 187, 0,
-	0, 1886, 0, 187, 1851, 1852, 1960, 0, 188, 0,
-	0, 0, 0, 0, 0, 0, 0, 1948, 1947, 1872,
-	1873, 0, 1874, 1875, 1965, 1975, 493, 493, 493, 1907,
-	188, 0, 1959, 1881, 1882, 189, 0, 1550, 1962, 1983,
-	1949, 1950, 1968, 1967, 2007, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1352, 1353, 1354, 1355, 0,
-	0, 494, 0, 0, 189, 0, 189, 189, 0, 494,
-	1986, 0, 0, 0, 0, 494, 0, 0, 0, 0,
-	1980, 1981, 0, 0, 0, 1991, 0, 0, 0, 0,
-	0, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 0, 0, 980, 0, 0, 1725, 0,
-	1406, 1407, 0, 2013, 0, 0, 1931, 0, 0, 2018,
-	0, 0, 0, 0, 2026, 0, 2027, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2032, 1988, 1989,
-	0, 1990, 0, 0, 1992, 2033, 1994, 506, 493, 493,
-	169, 2034, 0, 0, 2049, 0, 0, 0, 0, 0,
-	0, 493, 0, 2035, 493, 2037, 0, 2059, 2048, 0,
-	0, 0, 0, 0, 0, 111, 0, 2062, 0, 0,
-	2067, 0, 0, 0, 0, 0, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1508, 493,
-	493, 493, 188, 1984, 0, 2077, 2079, 2080, 0, 0,
-	0, 0, 0, 493, 2066, 493, 2073, 0, 0, 189,
-	0, 493, 0, 0, 0, 2081, 2091, 2096, 1902, 0,
-	2093, 2089, 1902, 2065, 2087, 0, 0, 2082, 0, 2095,
-	150, 0, 151, 188, 0, 2097, 2098, 0, 2099, 494,
-	0, 168, 0, 0, 493, 188, 0, 1546, 0, 0,
-	2112, 0, 2105, 2108, 0, 0, 494, 494, 0, 494,
-	187, 494, 494, 0, 494, 494, 494, 494, 494, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 494,
-	0, 2127, 0, 189, 0, 0, 0, 2135, 0, 1902,
-	0, 493, 493, 0, 0, 0, 0, 2138, 0, 154,
-	0, 0, 0, 2143, 2062, 2145, 0, 0, 0, 159,
-	494, 0, 0, 0, 0, 0, 0, 493, 189, 0,
-	2153, 493, 2162, 1725, 0, 0, 189, 2167, 2164, 0,
-	189, 0, 0, 0, 2171, 0, 0, 0, 0, 0,
-	2179, 2068, 2069, 2070, 2071, 2072, 189, 2180, 0, 2075,
-	2076, 0, 0, 189, 2001, 2190, 0, 0, 0, 0,
-	189, 189, 189, 189, 189, 189, 189, 189, 189, 494,
-	494, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 2208, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2218, 189, 2006, 600, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 964, 0, 967, 0,
-	187, 146, 187, 1090, 981, 982, 983, 984, 985, 986,
-	987, 0, 965, 966, 963, 969, 968, 978, 979, 971,
-	972, 973, 974, 975, 976, 977, 970, 0, 0, 980,
-	0, 0, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 494, 980, 0, 0, 0,
-	0, 169, 0, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 2165, 0, 0, 0, 111, 0, 494, 494,
-	0, 0, 0, 0, 0, 0, 0, 153, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1677,
-	0, 0, 494, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 494, 0, 0, 0, 189, 0, 189, 0,
-	0, 0, 0, 0, 0, 0, 189, 189, 1758, 1701,
-	1702, 1058, 2000, 494, 0, 0, 494, 0, 0, 0,
-	0, 150, 0, 151, 0, 0, 0, 494, 0, 0,
-	0, 0, 168, 0, 0, 187, 968, 978, 979, 971,
-	972, 973, 974, 975, 976, 977, 970, 0, 0, 980,
-	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 0, 0, 0, 0, 0, 164, 165, 166, 167,
-	0, 0, 0, 0, 0, 0, 0, 0, 1203, 0,
-	0, 0, 494, 0, 0, 0, 189, 0, 0, 494,
-	154, 0, 0, 0, 0, 0, 1401, 1402, 0, 0,
-	159, 0, 0, 1203, 1203, 0, 0, 0, 494, 187,
-	0, 0, 0, 0, 494, 0, 0, 0, 0, 0,
-	0, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 1999, 0, 980, 0, 0, 0, 1850,
-	1445, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 1301, 0, 494, 969,
-	968, 978, 979, 971, 972, 973, 974, 975, 976, 977,
-	970, 0, 187, 980, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 1322, 1323, 187, 187,
-	187, 187, 187, 187, 187, 0, 0, 0, 0, 0,
-	189, 0, 146, 0, 189, 189, 189, 189, 189, 0,
-	541, 0, 0, 0, 189, 189, 189, 189, 1998, 0,
-	187, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 189, 1879, 0, 0, 0, 0,
-	0, 0, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 0, 980, 0, 189, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
-	1894, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 600, 1301, 0, 0, 0, 600, 600, 0,
-	0, 600, 600, 600, 0, 0, 0, 1203, 0, 0,
-	614, 0, 0, 760, 0, 767, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 600, 600, 600, 600,
-	600, 0, 0, 0, 0, 1440, 0, 969, 968, 978,
-	979, 971, 972, 973, 974, 975, 976, 977, 970, 0,
-	0, 980, 0, 0, 0, 187, 0, 0, 0, 0,
-	0, 1301, 187, 189, 187, 0, 0, 0, 0, 0,
-	0, 189, 187, 187, 0, 0, 0, 0, 0, 0,
-	0, 147, 152, 149, 155, 156, 157, 158, 160, 161,
-	162, 163, 0, 0, 0, 189, 0, 164, 165, 166,
-	167, 0, 0, 0, 0, 0, 189, 189, 189, 189,
-	189, 0, 1652, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 189, 0, 0, 189, 189, 0, 0, 189,
-	189, 189, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 0, 980, 0, 0, 2005,
-	0, 0, 187, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 506, 0, 0, 0, 0, 0, 0, 2028,
-	0, 0, 2029, 0, 0, 2031, 0, 0, 0, 1653,
-	0, 189, 0, 1654, 0, 0, 0, 0, 0, 0,
-	0, 0, 494, 0, 1661, 1662, 0, 0, 494, 0,
-	1668, 494, 0, 1671, 1672, 0, 0, 0, 494, 0,
-	0, 1678, 0, 1679, 0, 0, 1682, 1683, 1684, 1685,
-	1686, 0, 0, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 1696, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	187, 187, 187, 187, 187, 0, 0, 0, 0, 0,
-	187, 187, 187, 187, 2086, 506, 0, 0, 1740, 1741,
-	494, 0, 0, 187, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 494, 0, 0,
-	0, 0, 0, 494, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 600, 600, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 614,
-	614, 0, 0, 600, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 931, 933, 0, 187,
-	0, 0, 0, 0, 0, 0, 0, 1440, 189, 0,
-	189, 189, 189, 0, 0, 0, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	600, 187, 0, 0, 0, 1853, 1854, 0, 0, 0,
-	0, 1203, 187, 187, 187, 187, 187, 494, 494, 494,
-	0, 189, 0, 0, 1739, 0, 0, 0, 187, 0,
-	0, 187, 187, 0, 0, 187, 1749, 1301, 0, 0,
-	0, 0, 0, 0, 1047, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1071, 1905, 0, 0, 0, 0, 0, 0, 614, 0,
-	0, 0, 0, 0, 1101, 0, 0, 0, 0, 0,
-	0, 0, 1920, 0, 0, 186, 0, 187, 0, 0,
-	0, 0, 0, 0, 0, 496, 0, 0, 0, 0,
-	0, 0, 1203, 575, 0, 0, 0, 0, 0, 0,
-	0, 0, 1301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 764, 494,
-	494, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 494, 0, 0, 494, 0, 0, 0, 0,
-	0, 0, 187, 544, 33, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	494, 494, 494, 189, 0, 600, 0, 33, 0, 0,
-	0, 0, 0, 0, 494, 1985, 494, 0, 0, 1987,
-	0, 0, 494, 0, 0, 860, 0, 0, 0, 0,
-	1996, 1997, 0, 0, 0, 871, 0, 0, 0, 0,
-	877, 0, 0, 0, 189, 0, 2011, 0, 0, 0,
-	0, 578, 0, 187, 0, 494, 189, 0, 0, 0,
-	0, 0, 0, 2020, 2021, 0, 1203, 2025, 760, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1202, 0, 0, 0, 1208, 1208, 0, 1208, 0,
-	1208, 1208, 187, 1217, 1208, 1208, 1208, 1208, 1208, 0,
-	0, 0, 494, 494, 0, 0, 1202, 1202, 760, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2053, 0, 0, 0, 494, 0,
-	0, 0, 494, 0, 0, 0, 0, 0, 0, 1277,
-	0, 0, 0, 0, 187, 0, 187, 187, 187, 0,
-	0, 0, 0, 0, 0, 1203, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 2078, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 614,
-	614, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2114, 2115,
-	2116, 2117, 0, 2121, 0, 2122, 2123, 2124, 0, 2125,
-	2126, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2149, 0,
-	0, 0, 0, 0, 1392, 0, 614, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1202, 0, 0, 0, 0, 0, 0, 879, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1424, 1425, 0,
-	0, 0, 0, 0, 0, 2186, 2187, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 169, 0, 0,
-	0, 1458, 0, 0, 0, 0, 0, 0, 1801, 0,
-	0, 1071, 0, 0, 614, 1128, 0, 0, 0, 1440,
-	0, 0, 111, 0, 133, 0, 0, 0, 0, 0,
-	0, 0, 614, 153, 0, 614, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 760, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 143, 0, 0, 0, 0, 132,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 150, 0, 151,
-	0, 0, 0, 0, 1183, 1184, 142, 141, 168, 0,
-	0, 767, 0, 0, 0, 0, 0, 0, 1560, 0,
-	0, 925, 925, 925, 0, 0, 0, 1077, 0, 0,
-	1088, 0, 0, 0, 0, 0, 0, 760, 1116, 0,
-	0, 33, 0, 767, 0, 0, 0, 0, 0, 0,
-	0, 0, 1203, 0, 989, 991, 137, 1185, 144, 0,
-	1182, 0, 138, 139, 0, 0, 154, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
-	0, 1129, 0, 0, 0, 1004, 0, 760, 0, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 0, 1019, 1022,
-	1022, 1022, 1028, 1022, 1022, 1028, 1022, 1036, 1037, 1038,
-	1039, 1040, 1041, 1042, 0, 0, 0, 0, 0, 1048,
-	0, 0, 33, 0, 0, 0, 0, 0, 1142, 1145,
-	1146, 1147, 1148, 1149, 1150, 0, 1151, 1152, 1153, 1154,
-	1155, 1130, 1131, 1132, 1133, 1114, 1115, 1143, 1084, 1117,
-	0, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 1106, 0, 0, 0, 0, 0, 1636, 0,
+	604, 2406, 2159, 2356, 2380, 2311, 2411, 2193, 2230, 2095,
+	1188, 2270, 2273, 2333, 1952, 2283, 1590, 623, 1064, 2216,
+	1632, 2189, 2027, 1795, 1804, 981, 1680, 563, 649, 2028,
+	2024, 1134, 1526, 577, 1796, 1907, 548, 1925, 1127, 101,
+	1647, 546, 1247, 814, 1908, 1652, 543, 2036, 441, 1144,
+	1517, 1474, 472, 1466, 1755, 101, 1980, 507, 101, 1906,
+	1381, 1678, 1110, 523, 936, 101, 1730, 92, 1592, 427,
+	1288, 1900, 1614, 669, 1270, 101, 1170, 1654, 1177, 1581,
+	632, 1830, 840, 1137, 94, 3, 1574, 1528, 539, 91,
+	1132, 1161, 1159, 961, 641, 1158, 1501, 1108, 534, 101,
+	1443, 550, 999, 1167, 843, 853, 89, 35, 1277, 821,
+	626, 1360, 818, 822, 846, 1554, 841, 479, 842, 1176,
+	1598, 1174, 1148, 1262, 1062, 830, 979, 526, 1643, 471,
+	404, 405, 99, 1386, 917, 930, 95, 611, 1246, 410,
+	411, 990, 2218, 1944, 1943, 1709, 1077, 88, 1347, 1000,
+	103, 104, 105, 1078, 403, 614, 1966, 1967, 2409, 444,
+	103, 104, 105, 1633, 2428, 2424, 535, 1523, 1524, 1432,
+	1431, 1430, 1429, 412, 1428, 1427, 380, 381, 382, 383,
+	384, 385, 1000, 1101, 537, 2319, 538, 1513, 1802, 1514,
+	1794, 615, 2325, 2267, 2324, 1519, 2384, 627, 2388, 2160,
+	2386, 533, 2376, 2161, 2397, 2374, 2170, 1420, 406, 1175,
+	499, 2220, 656, 2071, 1010, 1973, 2242, 468, 2361, 498,
+	482, 2362, 2241, 2387, 2361, 2385, 2408, 2362, 619, 496,
+	2185, 879, 878, 2186, 465, 589, 2418, 595, 596, 593,
+	594, 2357, 592, 591, 590, 2329, 1745, 1010, 2405, 90,
+	2296, 2390, 597, 598, 2096, 1248, 629, 1697, 2328, 407,
+	2295, 1997, 2425, 2129, 832, 2052, 2053, 1178, 493, 1179,
+	449, 613, 398, 2135, 1803, 1242, 1657, 504, 2363, 1716,
+	1609, 1610, 1874, 1715, 2363, 1873, 894, 2051, 1875, 998,
+	37, 1965, 1743, 78, 42, 43, 1608, 954, 103, 104,
+	105, 1525, 511, 977, 947, 968, 1006, 970, 1599, 620,
+	621, 1878, 2231, 976, 470, 953, 401, 941, 475, 476,
+	511, 942, 620, 621, 446, 610, 447, 401, 609, 393,
+	1891, 836, 1626, 2299, 396, 464, 1954, 395, 394, 1006,
+	2120, 2118, 521, 875, 967, 969, 1419, 483, 485, 486,
+	525, 502, 503, 512, 519, 1656, 510, 500, 501, 513,
+	487, 488, 517, 516, 505, 77, 1712, 492, 489, 491,
+	497, 1926, 1337, 399, 510, 495, 514, 1421, 1422, 1423,
+	1679, 1361, 2382, 1369, 399, 1370, 523, 1371, 955, 523,
+	101, 523, 975, 450, 826, 948, 1948, 511, 918, 401,
+	466, 958, 959, 455, 1949, 956, 957, 974, 1955, 960,
+	925, 666, 1366, 1957, 1338, 1724, 1339, 900, 899, 2320,
+	1365, 1956, 1363, 2256, 1025, 1024, 1034, 1035, 1027, 1028,
+	1029, 1030, 1031, 1032, 1033, 1026, 2134, 2238, 1036, 2180,
+	864, 862, 511, 2250, 966, 1681, 1575, 965, 971, 873,
+	872, 510, 820, 871, 1005, 1002, 1003, 1004, 1009, 1011,
+	1008, 1364, 1007, 964, 651, 655, 1367, 101, 511, 1001,
+	870, 815, 869, 868, 101, 400, 929, 867, 866, 101,
+	861, 880, 101, 2070, 837, 1256, 400, 1005, 1002, 1003,
+	1004, 1009, 1011, 1008, 874, 1007, 510, 2181, 951, 819,
+	972, 2354, 1001, 2415, 849, 442, 2421, 663, 523, 523,
+	523, 819, 515, 819, 848, 2350, 931, 817, 831, 856,
+	857, 1714, 510, 469, 1658, 973, 523, 523, 657, 916,
+	508, 2294, 865, 863, 834, 833, 927, 608, 881, 882,
+	883, 933, 2300, 1599, 935, 509, 888, 1958, 939, 670,
+	943, 944, 945, 946, 1276, 1275, 2359, 1477, 400, 2358,
+	1805, 1807, 2359, 406, 1703, 2358, 1374, 985, 1729, 1744,
+	835, 978, 653, 884, 1916, 855, 1711, 855, 2407, 2011,
+	1888, 1883, 2375, 855, 2010, 1520, 2009, 2284, 1349, 1348,
+	1350, 1351, 1352, 38, 829, 661, 658, 659, 1050, 1051,
+	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 893, 824,
+	101, 828, 991, 932, 79, 991, 1103, 827, 1102, 531,
+	532, 2261, 1936, 928, 1884, 855, 924, 940, 950, 825,
+	481, 406, 473, 2138, 1699, 982, 983, 1119, 2257, 1732,
+	952, 2050, 540, 1775, 1731, 1772, 1886, 1046, 101, 1881,
+	2413, 523, 1836, 2414, 523, 2412, 1806, 101, 1124, 101,
+	101, 1882, 523, 1723, 1732, 1800, 1722, 1763, 523, 1731,
+	890, 922, 996, 1048, 1049, 1689, 855, 1604, 1152, 1061,
+	934, 1026, 1615, 1036, 1036, 1870, 1065, 666, 443, 448,
+	445, 451, 452, 453, 454, 456, 457, 458, 459, 1550,
+	962, 1109, 1416, 1016, 460, 461, 462, 463, 1387, 388,
+	854, 2245, 854, 1125, 1981, 1013, 858, 848, 854, 1156,
+	1889, 1887, 1169, 876, 858, 848, 859, 1157, 103, 104,
+	105, 1016, 1468, 2006, 859, 1362, 1138, 1080, 1082, 1084,
+	1086, 1088, 1090, 1091, 1081, 1083, 938, 1087, 1089, 1999,
+	1092, 919, 860, 920, 389, 855, 921, 1983, 1698, 1180,
+	854, 995, 103, 104, 105, 1502, 1126, 848, 851, 852,
+	923, 819, 1502, 877, 1782, 845, 849, 1253, 2403, 1696,
+	1142, 1694, 1048, 1049, 1048, 1049, 891, 892, 1469, 895,
+	896, 897, 898, 864, 844, 901, 902, 903, 904, 905,
+	906, 907, 908, 909, 910, 911, 912, 913, 914, 915,
+	1691, 854, 862, 889, 101, 1985, 963, 1989, 1238, 1984,
+	2055, 1982, 1896, 1691, 1388, 670, 1987, 1885, 1249, 1250,
+	1251, 1252, 1552, 1141, 1695, 1986, 1450, 1029, 1030, 1031,
+	1032, 1033, 1026, 2169, 523, 1036, 1272, 1693, 1988, 1990,
+	1448, 1449, 1447, 2391, 1281, 2367, 77, 1356, 1285, 937,
+	2014, 523, 523, 2168, 523, 2076, 523, 523, 1446, 523,
+	523, 523, 523, 523, 523, 1904, 1187, 2422, 1770, 1015,
+	1013, 2392, 1903, 2368, 523, 97, 1769, 1268, 101, 1321,
+	854, 1661, 1357, 1282, 1354, 1551, 1016, 848, 851, 852,
+	1342, 819, 2394, 2160, 1334, 845, 849, 2161, 2015, 1261,
+	1341, 1014, 1015, 1013, 1340, 523, 1355, 1344, 1316, 1317,
+	1014, 1015, 1013, 101, 1748, 1749, 1750, 1318, 1280, 1016,
+	1332, 101, 1290, 1380, 1291, 101, 1293, 1295, 1016, 1326,
+	1299, 1301, 1303, 1305, 1307, 2423, 1323, 1237, 1322, 101,
+	1320, 1245, 1297, 1353, 1112, 2045, 101, 1278, 1278, 1244,
+	1106, 1279, 1258, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 523, 523, 523, 1271, 1343, 2393, 1259, 1257,
+	1014, 1015, 1013, 2369, 648, 1368, 103, 104, 105, 2341,
+	1877, 2205, 2166, 1378, 2143, 1014, 1015, 1013, 1016, 101,
+	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1026, 1383, 2058,
+	1036, 1392, 1391, 1016, 103, 104, 105, 2016, 1396, 1395,
+	2013, 1397, 1398, 1399, 1400, 2004, 1402, 1405, 1406, 1407,
+	1408, 1409, 1410, 1411, 1913, 1389, 1390, 1467, 1901, 1816,
+	1815, 1555, 1556, 1771, 1813, 1812, 1470, 1394, 1319, 1014,
+	1015, 1013, 1811, 1444, 1401, 834, 833, 2001, 1739, 1707,
+	523, 1169, 1706, 1544, 1375, 1442, 1542, 1016, 1451, 1452,
+	1453, 1454, 1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462,
+	1463, 1464, 1465, 1393, 406, 1538, 1537, 1478, 1384, 1345,
+	1471, 1472, 1905, 1333, 523, 523, 1329, 1485, 1412, 1413,
+	1414, 1328, 1426, 1327, 1951, 636, 523, 1490, 1493, 1568,
+	2353, 101, 2236, 1503, 101, 1017, 1014, 1015, 1013, 2346,
+	636, 1445, 1479, 1014, 1015, 1013, 1505, 1014, 1015, 1013,
+	1533, 1438, 1440, 1441, 1016, 1480, 523, 2025, 103, 104,
+	105, 1016, 1673, 1439, 2235, 1016, 2005, 101, 1509, 1510,
+	523, 540, 1568, 2290, 101, 1065, 101, 103, 104, 105,
+	1075, 1671, 1568, 2277, 101, 1568, 636, 101, 2094, 1481,
+	1928, 1065, 90, 523, 2183, 636, 523, 1012, 1478, 1254,
+	1255, 566, 565, 568, 569, 570, 571, 523, 1483, 1915,
+	567, 1864, 572, 103, 104, 105, 1136, 1335, 666, 1599,
+	1515, 666, 1691, 636, 1623, 1545, 1130, 1133, 2005, 1569,
+	1799, 636, 2150, 1572, 1600, 1557, 1573, 37, 1576, 1568,
+	2087, 1634, 1635, 1636, 2068, 2067, 1480, 2064, 2065, 1596,
+	1619, 1831, 605, 1565, 1618, 2064, 2063, 1324, 1325, 1563,
+	636, 1831, 523, 1330, 1331, 1566, 101, 1600, 1593, 523,
+	636, 1599, 1945, 1241, 1930, 1670, 1672, 1923, 1924, 1578,
+	636, 1568, 1567, 1012, 636, 2225, 37, 93, 523, 1622,
+	1597, 102, 1570, 2171, 523, 1692, 1601, 1649, 1281, 1564,
+	1281, 1241, 1240, 1655, 1603, 1186, 1185, 102, 1690, 1602,
+	102, 1839, 77, 1606, 1605, 524, 1577, 102, 2306, 2244,
+	1578, 1568, 2066, 1621, 1578, 1620, 37, 102, 1663, 1601,
+	2005, 1677, 1607, 1787, 1840, 1786, 1563, 1599, 523, 1691,
+	1467, 2172, 2173, 2174, 1674, 1467, 1467, 1553, 1521, 1424,
+	1691, 102, 1373, 1563, 1172, 629, 670, 647, 839, 670,
+	838, 77, 77, 1312, 2282, 2191, 2427, 1578, 1910, 1563,
+	1650, 650, 1687, 2157, 1688, 1659, 1662, 1243, 1660, 1648,
+	101, 1700, 1645, 1646, 101, 101, 101, 101, 101, 1950,
+	1684, 856, 857, 1702, 101, 101, 101, 101, 1704, 1705,
+	1701, 77, 1278, 1683, 1650, 1686, 1682, 101, 1666, 1667,
+	1668, 1313, 1314, 1315, 101, 1644, 1638, 1637, 1025, 1024,
+	1034, 1035, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1026,
+	77, 1359, 1036, 1273, 1269, 1239, 390, 1909, 101, 523,
+	470, 1953, 1169, 2037, 2038, 2192, 1717, 1718, 1719, 1720,
+	1721, 2175, 1248, 2419, 2400, 2381, 1725, 1726, 1169, 1728,
+	1583, 1586, 1587, 1588, 1584, 2040, 1585, 1589, 1309, 1733,
+	2037, 2038, 636, 2025, 1734, 1735, 1736, 1756, 2364, 1737,
+	1920, 1919, 1910, 1710, 1918, 1664, 1738, 1417, 1376, 1627,
+	1854, 1628, 1629, 1630, 1631, 1855, 2176, 2177, 1852, 1727,
+	1740, 1444, 2043, 1853, 2132, 2042, 1851, 1639, 1640, 1641,
+	1642, 1850, 2327, 1310, 1311, 1752, 1753, 1754, 1025, 1024,
+	1034, 1035, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1026,
+	2017, 1385, 1036, 1583, 1586, 1587, 1588, 1584, 1820, 1585,
+	1589, 1856, 101, 1587, 1588, 1135, 2153, 1742, 2085, 1829,
+	101, 1025, 1024, 1034, 1035, 1027, 1028, 1029, 1030, 1031,
+	1032, 1033, 1026, 1118, 1120, 1036, 1828, 625, 1751, 1445,
+	2302, 2366, 625, 1797, 1024, 1034, 1035, 1027, 1028, 1029,
+	1030, 1031, 1032, 1033, 1026, 101, 1766, 1036, 1115, 523,
+	618, 2332, 392, 2340, 2334, 1540, 1114, 1122, 617, 101,
+	101, 101, 101, 101, 1817, 1433, 1434, 1435, 1436, 627,
+	1481, 101, 1764, 622, 624, 101, 1824, 1781, 1818, 101,
+	101, 1844, 625, 101, 101, 101, 1819, 397, 2339, 2271,
+	2269, 616, 1793, 1109, 1797, 1372, 1876, 1809, 524, 1801,
+	606, 524, 102, 524, 1486, 1487, 474, 1914, 1492, 1495,
+	1496, 1498, 886, 1833, 1895, 885, 1128, 2105, 1909, 1964,
+	1938, 1488, 1489, 1516, 1823, 984, 1499, 1832, 1129, 1892,
+	1893, 1937, 407, 1508, 2223, 1837, 1511, 1512, 467, 1841,
+	1834, 477, 1866, 2060, 2059, 101, 1879, 1865, 1685, 1857,
+	1287, 1867, 1846, 1847, 1845, 1849, 523, 1848, 1383, 1861,
+	1868, 1286, 523, 1863, 540, 523, 1871, 1281, 1274, 2148,
+	2208, 1548, 523, 1555, 1556, 1669, 1655, 1379, 1591, 102,
+	2307, 2237, 1880, 2187, 1942, 1894, 102, 1897, 1898, 1899,
+	1902, 102, 101, 1143, 102, 1933, 1927, 630, 631, 1747,
+	633, 2371, 2370, 93, 1827, 1922, 2337, 1917, 642, 2131,
+	101, 1911, 1826, 2020, 1931, 2305, 2126, 2292, 2147, 1940,
+	524, 524, 524, 643, 1261, 2082, 1814, 1675, 1543, 1541,
+	1479, 1539, 1536, 1613, 1535, 1123, 1121, 1117, 524, 524,
+	1116, 1932, 1939, 1480, 523, 634, 1139, 1140, 645, 2146,
+	644, 1467, 1831, 1776, 1946, 1941, 1025, 1024, 1034, 1035,
+	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1026, 1960, 1977,
+	1036, 1773, 1961, 2125, 1959, 1153, 1978, 2402, 2401, 2402,
+	523, 523, 1146, 1970, 1971, 1979, 1113, 1968, 2275, 523,
+	2057, 1998, 1651, 1549, 1976, 629, 90, 1063, 4, 1993,
+	1994, 96, 1995, 1996, 1991, 87, 101, 1962, 1992, 1,
+	1963, 994, 9, 2002, 2003, 992, 8, 523, 993, 7,
+	494, 1522, 102, 523, 523, 1025, 1024, 1034, 1035, 1027,
+	1028, 1029, 1030, 1031, 1032, 1033, 1026, 1107, 506, 1036,
+	2379, 1346, 1977, 2026, 1336, 101, 2097, 2188, 2088, 1653,
+	847, 432, 1616, 1617, 2029, 2286, 1797, 387, 2124, 2012,
+	102, 812, 386, 524, 850, 949, 524, 1676, 2018, 102,
+	2184, 102, 102, 2031, 524, 2123, 2041, 1890, 1625, 1192,
+	524, 1190, 1025, 1024, 1034, 1035, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1026, 2033, 2077, 1036, 101, 2023, 101,
+	101, 101, 1191, 1189, 523, 1194, 2034, 2054, 1193, 1418,
+	2056, 520, 1181, 2047, 1147, 887, 484, 101, 2069, 1415,
+	1708, 490, 1044, 1825, 2073, 2046, 1872, 2048, 667, 2049,
+	660, 2338, 2072, 2303, 2098, 523, 523, 523, 2301, 101,
+	2268, 2217, 2089, 2084, 2266, 2061, 2062, 2083, 2106, 2365,
+	2331, 1624, 1547, 1655, 523, 2093, 2086, 2092, 2310, 2078,
+	2091, 2079, 2080, 2081, 575, 1164, 2272, 1025, 1024, 1034,
+	1035, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1026, 2090,
+	1912, 1036, 2219, 2360, 1025, 1024, 1034, 1035, 1027, 1028,
+	1029, 1030, 1031, 1032, 1033, 1026, 2107, 2323, 1036, 2322,
+	2249, 2102, 642, 2194, 1972, 1131, 2116, 2103, 2104, 2145,
+	2019, 1780, 1074, 1760, 1761, 1500, 2144, 643, 1162, 549,
+	2136, 1532, 1797, 1437, 564, 561, 102, 522, 562, 1558,
+	1838, 1018, 547, 541, 1154, 1779, 1582, 2149, 1580, 2111,
+	639, 640, 645, 1579, 644, 1377, 2155, 1168, 2039, 2035,
+	1160, 1562, 1713, 1947, 997, 1783, 524, 638, 2162, 536,
+	391, 1497, 2255, 523, 2165, 2164, 2167, 1746, 2128, 637,
+	64, 41, 612, 524, 524, 530, 524, 523, 524, 524,
+	527, 524, 524, 524, 524, 524, 524, 2178, 2318, 2195,
+	987, 646, 1104, 607, 1100, 2198, 524, 2133, 84, 71,
+	102, 86, 2190, 83, 1821, 1822, 1133, 85, 34, 2163,
+	33, 32, 2179, 31, 30, 2197, 29, 24, 23, 523,
+	523, 523, 523, 101, 22, 21, 20, 524, 26, 19,
+	2196, 18, 17, 402, 51, 102, 48, 46, 523, 409,
+	408, 49, 45, 102, 2215, 926, 2222, 102, 1862, 28,
+	2199, 2200, 2201, 2202, 2203, 2214, 2227, 27, 2206, 2207,
+	2224, 102, 101, 16, 2029, 15, 14, 2029, 102, 2210,
+	2212, 2213, 523, 101, 13, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 524, 524, 524, 2229, 12, 2204,
+	2240, 1034, 1035, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
+	1026, 523, 11, 1036, 10, 6, 5, 25, 2, 0,
+	0, 102, 0, 0, 0, 0, 2228, 2226, 2233, 2265,
+	2234, 2246, 0, 2232, 2239, 523, 523, 0, 2276, 0,
+	0, 0, 0, 2074, 2075, 2247, 0, 2113, 2114, 2029,
+	2115, 0, 101, 2117, 0, 2119, 2285, 0, 0, 0,
+	2190, 2287, 2243, 0, 0, 0, 0, 0, 0, 0,
+	523, 2291, 0, 523, 0, 0, 2298, 0, 2304, 0,
+	0, 0, 524, 0, 0, 2309, 2314, 0, 0, 2308,
+	0, 0, 0, 0, 0, 2321, 0, 523, 0, 0,
+	101, 2326, 1797, 2336, 2335, 0, 2349, 0, 2330, 2342,
+	0, 0, 2280, 2348, 2297, 0, 524, 524, 2315, 0,
+	0, 2355, 2317, 0, 0, 0, 0, 0, 524, 0,
+	0, 0, 0, 102, 2195, 0, 102, 523, 0, 0,
+	0, 2372, 2373, 2378, 0, 2000, 0, 0, 2377, 0,
+	0, 2383, 0, 0, 0, 0, 0, 0, 524, 0,
+	668, 0, 0, 816, 0, 823, 0, 0, 2396, 102,
+	0, 0, 524, 0, 2399, 0, 102, 2398, 102, 0,
+	523, 0, 0, 0, 0, 0, 102, 0, 0, 102,
+	2410, 0, 0, 0, 2021, 524, 0, 2417, 524, 2416,
+	2389, 0, 2420, 0, 0, 0, 0, 465, 0, 524,
+	0, 0, 0, 0, 0, 0, 2426, 0, 1921, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1020, 0,
+	1023, 0, 407, 0, 429, 0, 1037, 1038, 1039, 1040,
+	1041, 1042, 1043, 449, 1021, 1022, 1019, 1025, 1024, 1034,
+	1035, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1026, 0,
+	0, 1036, 0, 0, 524, 0, 0, 0, 102, 0,
+	0, 524, 0, 0, 439, 0, 0, 0, 0, 428,
+	0, 0, 668, 668, 668, 0, 0, 0, 0, 0,
+	524, 0, 0, 0, 0, 0, 524, 446, 0, 447,
+	986, 988, 1969, 0, 1264, 1265, 438, 437, 464, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
+	0, 0, 1025, 1024, 1034, 1035, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1026, 0, 0, 1036, 1504, 0, 0,
+	524, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 449, 0, 433, 1266, 440, 0,
+	1263, 0, 434, 435, 2130, 0, 450, 0, 0, 2137,
+	0, 0, 0, 0, 0, 0, 455, 0, 0, 0,
+	0, 0, 102, 0, 0, 0, 102, 102, 102, 102,
+	102, 0, 0, 540, 0, 0, 102, 102, 102, 102,
+	2156, 0, 0, 2158, 0, 0, 0, 0, 446, 102,
+	447, 0, 0, 0, 0, 0, 102, 0, 0, 464,
+	0, 0, 0, 1757, 0, 1145, 576, 0, 1150, 0,
+	0, 0, 635, 0, 0, 0, 668, 0, 0, 0,
+	102, 524, 1182, 1025, 1024, 1034, 1035, 1027, 1028, 1029,
+	1030, 1031, 1032, 1033, 1026, 0, 0, 1036, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 450, 442, 0,
+	0, 480, 0, 0, 518, 0, 0, 455, 0, 0,
+	0, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 0, 0, 0, 0, 0, 0, 0, 2221,
+	540, 0, 1025, 1024, 1034, 1035, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1026, 0, 100, 1036, 0, 0, 0,
+	0, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 430, 0, 0, 431, 0,
+	0, 0, 102, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 0, 442,
+	0, 524, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 102, 102, 102, 102, 0, 0, 0, 0,
+	0, 0, 0, 102, 0, 0, 0, 102, 816, 0,
+	0, 102, 102, 0, 0, 102, 102, 102, 0, 0,
+	0, 1283, 0, 0, 0, 1289, 1289, 0, 1289, 0,
+	1289, 1289, 0, 1298, 1289, 1289, 1289, 1289, 1289, 0,
+	0, 0, 0, 0, 0, 540, 1283, 1283, 816, 0,
+	0, 443, 448, 445, 451, 452, 453, 454, 456, 457,
+	458, 459, 0, 0, 0, 0, 0, 460, 461, 462,
+	463, 0, 0, 0, 0, 0, 0, 102, 0, 1358,
+	0, 0, 0, 0, 0, 0, 0, 0, 524, 0,
+	0, 0, 0, 0, 524, 0, 0, 524, 0, 0,
+	0, 0, 0, 0, 524, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 668, 668, 0,
+	0, 0, 102, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 443, 448, 445, 451, 452, 453, 454, 456,
+	457, 458, 459, 0, 0, 0, 524, 0, 460, 461,
+	462, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 654, 654, 0,
+	0, 0, 0, 0, 0, 0, 480, 465, 0, 0,
+	0, 0, 524, 524, 0, 0, 0, 0, 0, 0,
+	0, 524, 0, 0, 1473, 0, 668, 0, 0, 0,
+	0, 0, 407, 0, 429, 0, 0, 0, 102, 0,
+	0, 1283, 0, 449, 0, 0, 0, 0, 0, 524,
+	0, 0, 0, 0, 0, 524, 524, 0, 1506, 1507,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1518, 0, 0, 0, 439, 0, 0, 102, 0, 428,
+	0, 0, 0, 480, 0, 0, 0, 0, 0, 0,
+	480, 0, 0, 0, 0, 480, 0, 446, 480, 447,
+	1559, 0, 0, 0, 416, 417, 438, 437, 464, 0,
+	0, 0, 0, 0, 1150, 0, 0, 668, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	0, 102, 102, 102, 0, 0, 524, 668, 0, 0,
+	668, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	0, 816, 0, 0, 0, 0, 433, 414, 440, 421,
+	413, 0, 434, 435, 0, 0, 450, 524, 524, 524,
+	0, 102, 0, 0, 0, 0, 455, 422, 0, 0,
+	0, 0, 0, 0, 0, 0, 524, 0, 0, 0,
+	0, 425, 423, 418, 419, 420, 424, 0, 0, 0,
+	0, 415, 0, 0, 0, 0, 823, 0, 0, 0,
+	426, 0, 0, 1665, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 480, 0, 0, 0,
+	0, 0, 816, 0, 0, 0, 0, 0, 823, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 654, 0, 0, 0, 0,
+	0, 0, 0, 0, 100, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 0, 480, 1171, 0, 442, 0,
+	0, 0, 816, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 524, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 524,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 430, 0, 0, 431, 0,
+	0, 524, 524, 524, 524, 102, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	524, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2347, 0, 1741, 0, 0, 0, 0, 0, 0,
+	0, 0, 1209, 0, 102, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 524, 102, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	480, 0, 0, 524, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 524, 524, 0,
+	0, 443, 448, 445, 451, 452, 453, 454, 456, 457,
+	458, 459, 0, 1284, 102, 0, 0, 460, 461, 462,
+	463, 1283, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 524, 0, 0, 524, 0, 0, 1284, 1284,
+	0, 0, 0, 0, 480, 1197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 524,
+	0, 0, 102, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1835, 0, 0, 0, 0, 0, 480,
+	0, 0, 1283, 0, 0, 0, 0, 480, 1210, 0,
+	0, 1382, 0, 0, 0, 0, 0, 0, 0, 524,
+	0, 0, 0, 0, 0, 480, 0, 0, 0, 0,
+	0, 0, 480, 0, 0, 0, 0, 0, 0, 1403,
+	1404, 480, 480, 480, 480, 480, 480, 480, 0, 0,
+	1482, 0, 1484, 0, 0, 1223, 1226, 1227, 1228, 1229,
+	1230, 1231, 524, 1232, 1233, 1234, 1235, 1236, 1211, 1212,
+	1213, 1214, 1195, 1196, 1224, 480, 1198, 0, 1199, 1200,
+	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 0, 0, 0, 0,
+	1145, 0, 0, 0, 1283, 0, 1929, 0, 0, 1145,
+	0, 0, 0, 1546, 668, 0, 1934, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 654, 1382, 0,
+	0, 0, 0, 654, 654, 0, 0, 654, 654, 654,
+	0, 0, 0, 1284, 0, 0, 0, 0, 0, 0,
+	0, 0, 1225, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 654, 654, 654, 654, 654, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1530, 668, 0,
+	1534, 37, 39, 40, 78, 42, 43, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 82, 0, 0, 0, 0, 44, 72, 73, 0,
+	69, 74, 0, 480, 2007, 2008, 0, 70, 0, 1382,
+	480, 0, 480, 1289, 0, 0, 0, 0, 0, 0,
+	480, 0, 0, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 0, 0, 0,
+	0, 668, 0, 0, 1283, 0, 77, 1518, 1289, 0,
+	0, 0, 578, 36, 0, 0, 0, 36, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 36,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 480, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 47, 50,
+	53, 52, 55, 0, 68, 0, 0, 0, 816, 0,
+	0, 1283, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 628, 0, 0, 0, 0, 0, 0, 56,
+	81, 80, 0, 0, 66, 67, 54, 0, 0, 2099,
+	2100, 2101, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 58, 59, 0, 60, 61, 62, 63, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1283, 0, 0, 0, 0, 0, 480, 0, 0, 0,
+	480, 480, 480, 480, 480, 0, 0, 0, 0, 0,
+	480, 480, 480, 480, 0, 0, 0, 0, 1758, 0,
+	0, 0, 1759, 480, 0, 0, 0, 0, 0, 0,
+	480, 1765, 0, 0, 1767, 1768, 0, 0, 0, 0,
+	1774, 0, 0, 1777, 1778, 0, 0, 0, 0, 0,
+	0, 1784, 0, 1785, 480, 0, 1788, 1789, 1790, 1791,
+	1792, 0, 0, 0, 0, 1798, 0, 1145, 0, 0,
+	0, 0, 0, 0, 0, 79, 0, 0, 0, 0,
+	1810, 668, 0, 0, 0, 0, 0, 0, 38, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 654, 654, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2209, 1145, 1145, 1145, 0, 0, 0,
+	0, 0, 0, 0, 654, 0, 0, 0, 0, 0,
+	1859, 1860, 1145, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1284, 0, 0, 0, 0, 480, 0,
+	0, 0, 0, 0, 0, 0, 1530, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1145, 0, 0, 0,
+	0, 0, 0, 65, 0, 0, 75, 76, 0, 0,
+	0, 0, 0, 0, 0, 0, 465, 0, 0, 0,
+	654, 480, 0, 0, 0, 2274, 0, 1260, 0, 0,
+	0, 0, 0, 0, 1284, 480, 480, 480, 480, 480,
+	0, 407, 0, 429, 0, 0, 0, 1858, 0, 668,
+	668, 480, 449, 0, 0, 480, 480, 0, 0, 480,
+	1869, 1382, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1283, 0, 0, 439, 2312, 0, 0, 1145, 428, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 446, 0, 447, 0,
+	0, 2274, 0, 1264, 1265, 438, 437, 464, 0, 0,
+	0, 480, 0, 0, 0, 0, 0, 0, 1974, 1975,
+	0, 0, 0, 0, 0, 0, 1284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1382, 0, 0, 0,
+	0, 2312, 0, 0, 0, 0, 0, 0, 0, 0,
+	980, 980, 980, 0, 0, 433, 1266, 440, 480, 1263,
+	0, 434, 435, 0, 0, 450, 0, 0, 0, 0,
+	36, 0, 0, 36, 0, 455, 480, 0, 0, 0,
+	0, 0, 0, 0, 2404, 0, 0, 1045, 1047, 0,
+	0, 0, 0, 0, 0, 1209, 0, 0, 0, 0,
+	2032, 0, 0, 0, 0, 0, 0, 0, 0, 654,
+	0, 0, 0, 0, 0, 0, 0, 0, 1060, 2044,
+	0, 0, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
+	0, 1076, 1079, 1079, 1079, 1085, 1079, 1079, 1085, 1079,
+	1093, 1094, 1095, 1096, 1097, 1098, 1099, 0, 0, 0,
+	1105, 0, 0, 0, 1111, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 36, 480, 0, 0, 0, 0, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 1284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1163, 0, 0, 0, 0, 0, 0, 1197, 0,
+	0, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 436, 0, 2110, 0, 0, 0, 2112, 0,
+	0, 0, 0, 0, 430, 0, 0, 431, 0, 2121,
+	2122, 1210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 0, 480, 480, 480, 0, 0,
+	2142, 0, 0, 1284, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 0, 0, 0, 0, 2151, 2152,
+	0, 2154, 0, 0, 0, 0, 0, 0, 1223, 1226,
+	1227, 1228, 1229, 1230, 1231, 480, 1232, 1233, 1234, 1235,
+	1236, 1211, 1212, 1213, 1214, 1195, 1196, 1224, 0, 1198,
+	0, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
+	1208, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 0,
+	0, 0, 0, 0, 0, 0, 2182, 0, 0, 0,
+	0, 0, 1284, 0, 0, 0, 0, 0, 0, 0,
+	443, 448, 445, 451, 452, 453, 454, 456, 457, 458,
+	459, 0, 0, 0, 0, 0, 460, 461, 462, 463,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2211, 0, 0,
+	0, 0, 0, 0, 0, 1225, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2248, 0, 0, 0, 0, 0, 2251, 2252, 2253,
+	2254, 0, 2258, 0, 2259, 2260, 0, 0, 2262, 0,
+	2263, 2264, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2278, 1530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2293, 980, 980, 980, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 480, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2345, 0,
+	0, 0, 0, 2351, 2352, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 480, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1284, 0, 0, 0, 0, 0, 0, 0,
+	2395, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2344, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1047, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1594, 0, 1595, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 140, 0, 1144, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 134, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1239, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1287, 0, 0, 0, 0, 0, 0, 0, 1297,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1311,
-	0, 0, 0, 0, 1202, 0, 1315, 0, 0, 0,
-	0, 0, 0, 0, 0, 1324, 1325, 1326, 1327, 1328,
-	1329, 1330, 0, 0, 0, 0, 0, 147, 152, 149,
-	155, 156, 157, 158, 160, 161, 162, 163, 0, 0,
-	0, 0, 0, 164, 165, 166, 167, 1088, 0, 0,
-	0, 34, 35, 36, 70, 38, 39, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 169, 0,
-	0, 74, 0, 0, 0, 0, 40, 66, 67, 1179,
-	64, 68, 0, 0, 0, 0, 0, 65, 0, 0,
-	0, 0, 0, 111, 0, 133, 0, 0, 0, 0,
-	0, 1803, 0, 0, 153, 1202, 0, 1810, 0, 0,
-	1803, 0, 0, 0, 0, 614, 53, 1815, 0, 0,
-	0, 0, 0, 0, 0, 0, 69, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 0, 0, 0, 0,
-	132, 925, 925, 925, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 150, 0,
-	151, 0, 0, 0, 0, 1183, 1184, 142, 141, 168,
-	0, 0, 1465, 0, 0, 0, 0, 0, 0, 1469,
-	0, 1472, 0, 0, 0, 0, 0, 0, 0, 614,
-	1491, 0, 0, 0, 0, 0, 0, 0, 43, 46,
-	49, 48, 51, 0, 63, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 137, 1185, 144,
-	0, 1182, 0, 138, 139, 1208, 0, 154, 0, 52,
-	73, 72, 0, 0, 61, 62, 50, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 0, 0, 1202,
-	0, 0, 1906, 1208, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1558,
-	0, 54, 55, 0, 56, 57, 58, 59, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1490, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 760, 0, 0, 1202, 146,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1976, 1977, 1978, 0,
-	0, 0, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1088, 0, 0, 0, 1612, 1613, 1614,
-	1615, 1616, 140, 0, 0, 0, 0, 1620, 1621, 1088,
-	1623, 0, 0, 0, 134, 0, 0, 135, 0, 0,
-	1628, 0, 0, 0, 0, 0, 0, 1631, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1202, 1635, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1803, 2050,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1803, 0, 0, 614, 0, 0, 0, 147, 152,
-	149, 155, 156, 157, 158, 160, 161, 162, 163, 0,
-	0, 0, 0, 0, 164, 165, 166, 167, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1803,
-	1803, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2092, 0, 2094, 0, 0, 0, 0,
-	0, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1762, 0, 0, 628, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1803, 0, 0, 0, 0, 1746,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1657, 0, 0, 578, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 614, 614, 0, 0, 0, 0, 0, 0, 0,
+	1808, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1694, 0, 0, 1797, 1202, 0, 2163, 0, 0,
-	0, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1084, 0, 0,
-	0, 0, 0, 0, 1721, 1722, 0, 0, 1084, 1084,
-	1084, 1084, 1084, 0, 0, 0, 0, 0, 0, 0,
-	0, 1827, 0, 0, 1490, 0, 0, 1084, 0, 0,
-	0, 1084, 0, 0, 0, 0, 0, 0, 0, 1842,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1163, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1842, 1843, 0,
+	0, 1163, 1163, 1163, 1163, 1163, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1594, 0, 0,
+	0, 1163, 0, 0, 0, 1163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1663,8 +1777,8 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1891, 1816, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1935, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1672,18 +1786,16 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1953, 0, 1954, 1955, 1956, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1966, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1903, 1979, 33, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1084, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2030, 0, 36, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1163, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1692,13 +1804,13 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2002, 0, 0, 0, 0, 0, 0, 2008, 2009, 2010,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2108, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2113,
+	2127, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2139, 2140, 2141, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1706,2299 +1818,2508 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1903, 0, 33, 0, 1903, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 33, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1903, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 738, 725, 33, 2139, 674, 741,
-	645, 663, 750, 665, 668, 708, 625, 687, 331, 660,
-	0, 649, 621, 656, 622, 647, 676, 241, 680, 644,
-	727, 690, 740, 289, 0, 627, 650, 345, 710, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 747, 293, 697, 0, 391, 316, 0,
-	0, 0, 678, 730, 685, 721, 673, 709, 634, 696,
-	742, 661, 705, 743, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 2146, 2147, 0,
-	0, 0, 0, 0, 217, 0, 223, 702, 737, 658,
-	704, 237, 277, 243, 236, 407, 707, 753, 620, 699,
-	0, 623, 626, 749, 733, 653, 654, 0, 0, 0,
-	0, 0, 0, 0, 677, 686, 718, 671, 0, 0,
-	0, 0, 0, 0, 0, 0, 651, 0, 695, 0,
-	0, 0, 630, 624, 0, 0, 0, 0, 675, 0,
-	0, 0, 633, 0, 652, 719, 0, 618, 263, 628,
-	317, 723, 732, 672, 438, 736, 670, 669, 739, 714,
-	631, 729, 664, 288, 629, 285, 191, 205, 0, 662,
-	327, 366, 372, 728, 648, 657, 228, 655, 370, 341,
-	424, 213, 253, 363, 346, 368, 694, 712, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 643, 724, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	716, 752, 340, 371, 219, 426, 390, 638, 642, 636,
-	637, 688, 689, 639, 744, 745, 746, 720, 632, 0,
-	640, 641, 0, 726, 734, 735, 693, 190, 203, 291,
-	748, 360, 256, 449, 433, 429, 619, 635, 234, 646,
-	0, 0, 659, 666, 667, 679, 681, 682, 683, 684,
-	692, 700, 701, 703, 711, 713, 715, 717, 722, 731,
-	751, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 691, 698, 301, 250, 267, 276, 706, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 738, 725, 0,
-	0, 674, 741, 645, 663, 750, 665, 668, 708, 625,
-	687, 331, 660, 0, 649, 621, 656, 622, 647, 676,
-	241, 680, 644, 727, 690, 740, 289, 0, 627, 650,
-	345, 710, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 747, 293, 697, 0,
-	391, 316, 0, 0, 0, 678, 730, 685, 721, 673,
-	709, 634, 696, 742, 661, 705, 743, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	702, 737, 658, 704, 237, 277, 243, 236, 407, 707,
-	753, 620, 699, 0, 623, 626, 749, 733, 653, 654,
-	0, 0, 0, 0, 0, 0, 0, 677, 686, 718,
-	671, 0, 0, 0, 0, 0, 0, 1895, 0, 651,
-	0, 695, 0, 0, 0, 630, 624, 0, 0, 0,
-	0, 675, 0, 0, 0, 633, 0, 652, 719, 0,
-	618, 263, 628, 317, 723, 732, 672, 438, 736, 670,
-	669, 739, 714, 631, 729, 664, 288, 629, 285, 191,
-	205, 0, 662, 327, 366, 372, 728, 648, 657, 228,
-	655, 370, 341, 424, 213, 253, 363, 346, 368, 694,
-	712, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 643, 724, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 716, 752, 340, 371, 219, 426, 390,
-	638, 642, 636, 637, 688, 689, 639, 744, 745, 746,
-	720, 632, 0, 640, 641, 0, 726, 734, 735, 693,
-	190, 203, 291, 748, 360, 256, 449, 433, 429, 619,
-	635, 234, 646, 0, 0, 659, 666, 667, 679, 681,
-	682, 683, 684, 692, 700, 701, 703, 711, 713, 715,
-	717, 722, 731, 751, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 691, 698, 301, 250, 267,
-	276, 706, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	738, 725, 0, 0, 674, 741, 645, 663, 750, 665,
-	668, 708, 625, 687, 331, 660, 0, 649, 621, 656,
-	622, 647, 676, 241, 680, 644, 727, 690, 740, 289,
-	0, 627, 650, 345, 710, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 747,
-	293, 697, 0, 391, 316, 0, 0, 0, 678, 730,
-	685, 721, 673, 709, 634, 696, 742, 661, 705, 743,
-	279, 225, 195, 328, 392, 255, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 223, 702, 737, 658, 704, 237, 277, 243,
-	236, 407, 707, 753, 620, 699, 0, 623, 626, 749,
-	733, 653, 654, 0, 0, 0, 0, 0, 0, 0,
-	677, 686, 718, 671, 0, 0, 0, 0, 0, 0,
-	1750, 0, 651, 0, 695, 0, 0, 0, 630, 624,
-	0, 0, 0, 0, 675, 0, 0, 0, 633, 0,
-	652, 719, 0, 618, 263, 628, 317, 723, 732, 672,
-	438, 736, 670, 669, 739, 714, 631, 729, 664, 288,
-	629, 285, 191, 205, 0, 662, 327, 366, 372, 728,
-	648, 657, 228, 655, 370, 341, 424, 213, 253, 363,
-	346, 368, 694, 712, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 643, 724, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 716, 752, 340, 371,
-	219, 426, 390, 638, 642, 636, 637, 688, 689, 639,
-	744, 745, 746, 720, 632, 0, 640, 641, 0, 726,
-	734, 735, 693, 190, 203, 291, 748, 360, 256, 449,
-	433, 429, 619, 635, 234, 646, 0, 0, 659, 666,
-	667, 679, 681, 682, 683, 684, 692, 700, 701, 703,
-	711, 713, 715, 717, 722, 731, 751, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 691, 698,
-	301, 250, 267, 276, 706, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 738, 725, 0, 0, 674, 741, 645,
-	663, 750, 665, 668, 708, 625, 687, 331, 660, 0,
-	649, 621, 656, 622, 647, 676, 241, 680, 644, 727,
-	690, 740, 289, 0, 627, 650, 345, 710, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 747, 293, 697, 0, 391, 316, 0, 0,
-	0, 678, 730, 685, 721, 673, 709, 634, 696, 742,
-	661, 705, 743, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 702, 737, 658, 704,
-	237, 277, 243, 236, 407, 707, 753, 620, 699, 0,
-	623, 626, 749, 733, 653, 654, 0, 0, 0, 0,
-	0, 0, 0, 677, 686, 718, 671, 0, 0, 0,
-	0, 0, 0, 1467, 0, 651, 0, 695, 0, 0,
-	0, 630, 624, 0, 0, 0, 0, 675, 0, 0,
-	0, 633, 0, 652, 719, 0, 618, 263, 628, 317,
-	723, 732, 672, 438, 736, 670, 669, 739, 714, 631,
-	729, 664, 288, 629, 285, 191, 205, 0, 662, 327,
-	366, 372, 728, 648, 657, 228, 655, 370, 341, 424,
-	213, 253, 363, 346, 368, 694, 712, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	643, 724, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 716,
-	752, 340, 371, 219, 426, 390, 638, 642, 636, 637,
-	688, 689, 639, 744, 745, 746, 720, 632, 0, 640,
-	641, 0, 726, 734, 735, 693, 190, 203, 291, 748,
-	360, 256, 449, 433, 429, 619, 635, 234, 646, 0,
-	0, 659, 666, 667, 679, 681, 682, 683, 684, 692,
-	700, 701, 703, 711, 713, 715, 717, 722, 731, 751,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 691, 698, 301, 250, 267, 276, 706, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 738, 725, 0, 0,
-	674, 741, 645, 663, 750, 665, 668, 708, 625, 687,
-	331, 660, 0, 649, 621, 656, 622, 647, 676, 241,
-	680, 644, 727, 690, 740, 289, 0, 627, 650, 345,
-	710, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 747, 293, 697, 0, 391,
-	316, 0, 0, 0, 678, 730, 685, 721, 673, 709,
-	634, 696, 742, 661, 705, 743, 279, 225, 195, 328,
-	392, 255, 69, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 702,
-	737, 658, 704, 237, 277, 243, 236, 407, 707, 753,
-	620, 699, 0, 623, 626, 749, 733, 653, 654, 0,
-	0, 0, 0, 0, 0, 0, 677, 686, 718, 671,
-	0, 0, 0, 0, 0, 0, 0, 0, 651, 0,
-	695, 0, 0, 0, 630, 624, 0, 0, 0, 0,
-	675, 0, 0, 0, 633, 0, 652, 719, 0, 618,
-	263, 628, 317, 723, 732, 672, 438, 736, 670, 669,
-	739, 714, 631, 729, 664, 288, 629, 285, 191, 205,
-	0, 662, 327, 366, 372, 728, 648, 657, 228, 655,
-	370, 341, 424, 213, 253, 363, 346, 368, 694, 712,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 643, 724, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 716, 752, 340, 371, 219, 426, 390, 638,
-	642, 636, 637, 688, 689, 639, 744, 745, 746, 720,
-	632, 0, 640, 641, 0, 726, 734, 735, 693, 190,
-	203, 291, 748, 360, 256, 449, 433, 429, 619, 635,
-	234, 646, 0, 0, 659, 666, 667, 679, 681, 682,
-	683, 684, 692, 700, 701, 703, 711, 713, 715, 717,
-	722, 731, 751, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 691, 698, 301, 250, 267, 276,
-	706, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 738,
-	725, 0, 0, 674, 741, 645, 663, 750, 665, 668,
-	708, 625, 687, 331, 660, 0, 649, 621, 656, 622,
-	647, 676, 241, 680, 644, 727, 690, 740, 289, 0,
-	627, 650, 345, 710, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 747, 293,
-	697, 0, 391, 316, 0, 0, 0, 678, 730, 685,
-	721, 673, 709, 634, 696, 742, 661, 705, 743, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 702, 737, 658, 704, 237, 277, 243, 236,
-	407, 707, 753, 620, 699, 0, 623, 626, 749, 733,
-	653, 654, 0, 0, 0, 0, 0, 0, 0, 677,
-	686, 718, 671, 0, 0, 0, 0, 0, 0, 0,
-	0, 651, 0, 695, 0, 0, 0, 630, 624, 0,
-	0, 0, 0, 675, 0, 0, 0, 633, 0, 652,
-	719, 0, 618, 263, 628, 317, 723, 732, 672, 438,
-	736, 670, 669, 739, 714, 631, 729, 664, 288, 629,
-	285, 191, 205, 0, 662, 327, 366, 372, 728, 648,
-	657, 228, 655, 370, 341, 424, 213, 253, 363, 346,
-	368, 694, 712, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 643, 724, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 716, 752, 340, 371, 219,
-	426, 390, 638, 642, 636, 637, 688, 689, 639, 744,
-	745, 746, 720, 632, 0, 640, 641, 0, 726, 734,
-	735, 693, 190, 203, 291, 748, 360, 256, 449, 433,
-	429, 619, 635, 234, 646, 0, 0, 659, 666, 667,
-	679, 681, 682, 683, 684, 692, 700, 701, 703, 711,
-	713, 715, 717, 722, 731, 751, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 691, 698, 301,
-	250, 267, 276, 706, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 738, 725, 0, 0, 674, 741, 645, 663,
-	750, 665, 668, 708, 625, 687, 331, 660, 0, 649,
-	621, 656, 622, 647, 676, 241, 680, 644, 727, 690,
-	740, 289, 0, 627, 650, 345, 710, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 747, 293, 697, 0, 391, 316, 0, 0, 0,
-	678, 730, 685, 721, 673, 709, 634, 696, 742, 661,
-	705, 743, 279, 225, 195, 328, 392, 255, 0, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 702, 737, 658, 704, 237,
-	277, 243, 236, 407, 707, 753, 620, 699, 0, 623,
-	626, 749, 733, 653, 654, 0, 0, 0, 0, 0,
-	0, 0, 677, 686, 718, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 651, 0, 695, 0, 0, 0,
-	630, 624, 0, 0, 0, 0, 675, 0, 0, 0,
-	633, 0, 652, 719, 0, 618, 263, 628, 317, 723,
-	732, 672, 438, 736, 670, 669, 739, 714, 631, 729,
-	664, 288, 629, 285, 191, 205, 0, 662, 327, 366,
-	372, 728, 648, 657, 228, 655, 370, 341, 424, 213,
-	253, 363, 346, 368, 694, 712, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 755, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 643,
-	724, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 617, 754, 611, 610, 286, 295, 716, 752,
-	340, 371, 219, 426, 390, 638, 642, 636, 637, 688,
-	689, 639, 744, 745, 746, 720, 632, 0, 640, 641,
-	0, 726, 734, 735, 693, 190, 203, 291, 748, 360,
-	256, 449, 433, 429, 619, 635, 234, 646, 0, 0,
-	659, 666, 667, 679, 681, 682, 683, 684, 692, 700,
-	701, 703, 711, 713, 715, 717, 722, 731, 751, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	691, 698, 301, 250, 267, 276, 706, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 738, 725, 0, 0, 674,
-	741, 645, 663, 750, 665, 668, 708, 625, 687, 331,
-	660, 0, 649, 621, 656, 622, 647, 676, 241, 680,
-	644, 727, 690, 740, 289, 0, 627, 650, 345, 710,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 747, 293, 697, 0, 391, 316,
-	0, 0, 0, 678, 730, 685, 721, 673, 709, 634,
-	696, 742, 661, 705, 743, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 702, 737,
-	658, 704, 237, 277, 243, 236, 407, 707, 753, 620,
-	699, 0, 623, 626, 749, 733, 653, 654, 0, 0,
-	0, 0, 0, 0, 0, 677, 686, 718, 671, 0,
-	0, 0, 0, 0, 0, 0, 0, 651, 0, 695,
-	0, 0, 0, 630, 624, 0, 0, 0, 0, 675,
-	0, 0, 0, 633, 0, 652, 719, 0, 618, 263,
-	628, 317, 723, 732, 672, 438, 736, 670, 669, 739,
-	714, 631, 729, 664, 288, 629, 285, 191, 205, 0,
-	662, 327, 366, 372, 728, 648, 657, 228, 655, 370,
-	341, 424, 213, 253, 363, 346, 368, 694, 712, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 1092, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 755, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 643, 724, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 617, 754, 611, 610, 286,
-	295, 716, 752, 340, 371, 219, 426, 390, 638, 642,
-	636, 637, 688, 689, 639, 744, 745, 746, 720, 632,
-	0, 640, 641, 0, 726, 734, 735, 693, 190, 203,
-	291, 748, 360, 256, 449, 433, 429, 619, 635, 234,
-	646, 0, 0, 659, 666, 667, 679, 681, 682, 683,
-	684, 692, 700, 701, 703, 711, 713, 715, 717, 722,
-	731, 751, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 691, 698, 301, 250, 267, 276, 706,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 738, 725,
-	0, 0, 674, 741, 645, 663, 750, 665, 668, 708,
-	625, 687, 331, 660, 0, 649, 621, 656, 622, 647,
-	676, 241, 680, 644, 727, 690, 740, 289, 0, 627,
-	650, 345, 710, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 747, 293, 697,
-	0, 391, 316, 0, 0, 0, 678, 730, 685, 721,
-	673, 709, 634, 696, 742, 661, 705, 743, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 702, 737, 658, 704, 237, 277, 243, 236, 407,
-	707, 753, 620, 699, 0, 623, 626, 749, 733, 653,
-	654, 0, 0, 0, 0, 0, 0, 0, 677, 686,
-	718, 671, 0, 0, 0, 0, 0, 0, 0, 0,
-	651, 0, 695, 0, 0, 0, 630, 624, 0, 0,
-	0, 0, 675, 0, 0, 0, 633, 0, 652, 719,
-	0, 618, 263, 628, 317, 723, 732, 672, 438, 736,
-	670, 669, 739, 714, 631, 729, 664, 288, 629, 285,
-	191, 205, 0, 662, 327, 366, 372, 728, 648, 657,
-	228, 655, 370, 341, 424, 213, 253, 363, 346, 368,
-	694, 712, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 608, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 755, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 643, 724, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 617, 754,
-	611, 610, 286, 295, 716, 752, 340, 371, 219, 426,
-	390, 638, 642, 636, 637, 688, 689, 639, 744, 745,
-	746, 720, 632, 0, 640, 641, 0, 726, 734, 735,
-	693, 190, 203, 291, 748, 360, 256, 449, 433, 429,
-	619, 635, 234, 646, 0, 0, 659, 666, 667, 679,
-	681, 682, 683, 684, 692, 700, 701, 703, 711, 713,
-	715, 717, 722, 731, 751, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 691, 698, 301, 250,
-	267, 276, 706, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 1394, 0, 511, 0, 0, 0,
-	241, 0, 510, 0, 0, 0, 289, 0, 0, 1395,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 554, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 545, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 69, 0, 0, 177, 178, 179, 532,
-	531, 534, 535, 536, 537, 0, 0, 217, 533, 223,
-	538, 539, 540, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 508, 525, 0, 553, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 522, 523, 598, 0, 0,
-	0, 568, 0, 524, 0, 0, 517, 518, 520, 519,
-	521, 526, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 567, 0, 0, 438, 0, 0,
-	565, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	555, 566, 561, 562, 559, 560, 0, 558, 557, 556,
-	569, 547, 548, 549, 550, 552, 0, 563, 564, 551,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 511, 0, 0, 0, 241,
-	0, 510, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 554, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 545, 546, 0, 0,
-	0, 0, 0, 0, 1506, 0, 279, 225, 195, 328,
-	392, 255, 69, 0, 0, 177, 178, 179, 532, 531,
-	534, 535, 536, 537, 0, 0, 217, 533, 223, 538,
-	539, 540, 1507, 237, 277, 243, 236, 407, 0, 0,
-	0, 508, 525, 0, 553, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 522, 523, 0, 0, 0, 0,
-	568, 0, 524, 0, 0, 517, 518, 520, 519, 521,
-	526, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 567, 0, 0, 438, 0, 0, 565,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 555,
-	566, 561, 562, 559, 560, 0, 558, 557, 556, 569,
-	547, 548, 549, 550, 552, 0, 563, 564, 551, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 511, 0, 0, 0, 241, 0,
-	510, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 554, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 545, 546, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 69, 0, 586, 177, 178, 179, 532, 531, 534,
-	535, 536, 537, 0, 0, 217, 533, 223, 538, 539,
-	540, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	508, 525, 0, 553, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 522, 523, 0, 0, 0, 0, 568,
-	0, 524, 0, 0, 517, 518, 520, 519, 521, 526,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 567, 0, 0, 438, 0, 0, 565, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 555, 566,
-	561, 562, 559, 560, 0, 558, 557, 556, 569, 547,
-	548, 549, 550, 552, 0, 563, 564, 551, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 511, 0, 0, 0, 241, 0, 510,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 554, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 545, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 532, 531, 534, 535,
-	536, 537, 0, 0, 217, 533, 223, 538, 539, 540,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 508,
-	525, 0, 553, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 523, 598, 0, 0, 0, 568, 0,
-	524, 0, 0, 517, 518, 520, 519, 521, 526, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 567, 0, 0, 438, 0, 0, 565, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 555, 566, 561,
-	562, 559, 560, 0, 558, 557, 556, 569, 547, 548,
-	549, 550, 552, 0, 563, 564, 551, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 511, 0, 0, 0, 241, 0, 510, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 554, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 545, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 69,
-	0, 0, 177, 178, 179, 532, 1412, 534, 535, 536,
-	537, 0, 0, 217, 533, 223, 538, 539, 540, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 508, 525,
-	0, 553, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 522, 523, 598, 0, 0, 0, 568, 0, 524,
-	0, 0, 517, 518, 520, 519, 521, 526, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	567, 0, 0, 438, 0, 0, 565, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 555, 566, 561, 562,
-	559, 560, 0, 558, 557, 556, 569, 547, 548, 549,
-	550, 552, 0, 563, 564, 551, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 511, 0, 0, 0, 241, 0, 510, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 554, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 545, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 69, 0,
-	0, 177, 178, 179, 532, 1409, 534, 535, 536, 537,
-	0, 0, 217, 533, 223, 538, 539, 540, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 508, 525, 0,
-	553, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	522, 523, 598, 0, 0, 0, 568, 0, 524, 0,
-	0, 517, 518, 520, 519, 521, 526, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 567,
-	0, 0, 438, 0, 0, 565, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 555, 566, 561, 562, 559,
-	560, 0, 558, 557, 556, 569, 547, 548, 549, 550,
-	552, 0, 563, 564, 551, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 579, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 0,
-	0, 0, 0, 511, 0, 0, 0, 241, 0, 510,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 554, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 545, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 532, 531, 534, 535,
-	536, 537, 0, 0, 217, 533, 223, 538, 539, 540,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 508,
-	525, 0, 553, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 523, 0, 0, 0, 0, 568, 0,
-	524, 0, 0, 517, 518, 520, 519, 521, 526, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 567, 0, 0, 438, 0, 0, 565, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 555, 566, 561,
-	562, 559, 560, 0, 558, 557, 556, 569, 547, 548,
-	549, 550, 552, 0, 563, 564, 551, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 511, 0, 0, 0, 241, 0, 510, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 554, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 545, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 69,
-	0, 0, 177, 178, 179, 532, 531, 534, 535, 536,
-	537, 0, 0, 217, 533, 223, 538, 539, 540, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 508, 525,
-	0, 553, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 522, 523, 0, 0, 0, 0, 568, 0, 524,
-	0, 0, 517, 518, 520, 519, 521, 526, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	567, 0, 0, 438, 0, 0, 565, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 555, 566, 561, 562,
-	559, 560, 0, 558, 557, 556, 569, 547, 548, 549,
-	550, 552, 0, 563, 564, 551, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 554, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 545, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 69, 0,
-	0, 177, 178, 179, 532, 531, 534, 535, 536, 537,
-	0, 0, 217, 533, 223, 538, 539, 540, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 525, 0,
-	553, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	522, 523, 0, 0, 0, 0, 568, 0, 524, 0,
-	0, 517, 518, 520, 519, 521, 526, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 567,
-	0, 0, 438, 0, 0, 565, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 2166, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 555, 566, 561, 562, 559,
-	560, 0, 558, 557, 556, 569, 547, 548, 549, 550,
-	552, 0, 563, 564, 551, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	289, 0, 0, 0, 345, 0, 382, 227, 298, 296,
-	410, 251, 244, 240, 226, 273, 304, 343, 400, 337,
-	554, 293, 0, 0, 391, 316, 0, 0, 0, 0,
-	0, 545, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 225, 195, 328, 392, 255, 69, 0, 586,
-	177, 178, 179, 532, 531, 534, 535, 536, 537, 0,
-	0, 217, 533, 223, 538, 539, 540, 0, 237, 277,
-	243, 236, 407, 0, 0, 0, 0, 525, 0, 553,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 522,
-	523, 0, 0, 0, 0, 568, 0, 524, 0, 0,
-	517, 518, 520, 519, 521, 526, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 317, 567, 0,
-	0, 438, 0, 0, 565, 0, 0, 0, 0, 0,
-	288, 0, 285, 191, 205, 0, 0, 327, 366, 372,
-	0, 0, 0, 228, 0, 370, 341, 424, 213, 253,
-	363, 346, 368, 0, 0, 369, 294, 412, 358, 422,
-	439, 440, 235, 321, 430, 404, 436, 448, 206, 232,
-	335, 397, 427, 388, 314, 408, 409, 284, 387, 261,
-	194, 292, 198, 399, 420, 218, 380, 0, 0, 0,
-	200, 418, 396, 311, 281, 282, 199, 0, 362, 239,
-	259, 230, 330, 415, 416, 229, 450, 208, 435, 202,
-	209, 434, 323, 411, 419, 312, 303, 201, 417, 310,
-	302, 287, 249, 269, 356, 297, 357, 270, 319, 318,
-	320, 0, 196, 0, 393, 428, 451, 215, 0, 0,
-	406, 444, 447, 0, 359, 216, 260, 248, 355, 258,
-	290, 443, 445, 446, 214, 353, 266, 334, 423, 252,
-	431, 322, 210, 272, 389, 286, 295, 0, 0, 340,
-	371, 219, 426, 390, 555, 566, 561, 562, 559, 560,
-	0, 558, 557, 556, 569, 547, 548, 549, 550, 552,
-	0, 563, 564, 551, 190, 203, 291, 0, 360, 256,
-	449, 433, 429, 0, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 193,
-	204, 212, 221, 233, 246, 254, 264, 268, 271, 274,
-	275, 278, 283, 300, 305, 306, 307, 308, 324, 325,
-	326, 329, 332, 333, 336, 338, 339, 342, 348, 349,
-	350, 351, 352, 354, 361, 365, 373, 374, 375, 376,
-	377, 378, 379, 383, 384, 385, 386, 394, 398, 413,
-	414, 425, 437, 441, 265, 421, 442, 0, 299, 0,
-	0, 301, 250, 267, 276, 0, 432, 395, 207, 367,
-	257, 197, 224, 211, 231, 245, 247, 280, 309, 315,
-	344, 347, 262, 242, 222, 364, 220, 381, 401, 402,
-	403, 405, 313, 238, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 289,
-	0, 0, 0, 345, 0, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 554,
-	293, 0, 0, 391, 316, 0, 0, 0, 0, 0,
-	545, 546, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 225, 195, 328, 392, 255, 69, 0, 0, 177,
-	178, 179, 532, 531, 534, 535, 536, 537, 0, 0,
-	217, 533, 223, 538, 539, 540, 0, 237, 277, 243,
-	236, 407, 0, 0, 0, 0, 525, 0, 553, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 522, 523,
-	0, 0, 0, 0, 568, 0, 524, 0, 0, 517,
-	518, 520, 519, 521, 526, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 317, 567, 0, 0,
-	438, 0, 0, 565, 0, 0, 0, 0, 0, 288,
-	0, 285, 191, 205, 0, 0, 327, 366, 372, 0,
-	0, 0, 228, 0, 370, 341, 424, 213, 253, 363,
-	346, 368, 0, 0, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 0, 0, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 0, 0, 340, 371,
-	219, 426, 390, 555, 566, 561, 562, 559, 560, 0,
-	558, 557, 556, 569, 547, 548, 549, 550, 552, 0,
-	563, 564, 551, 190, 203, 291, 0, 360, 256, 449,
-	433, 429, 0, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 0, 0,
-	301, 250, 267, 276, 0, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 799, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 798, 438, 0,
-	0, 0, 0, 0, 0, 795, 796, 288, 763, 285,
-	191, 205, 789, 793, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 1070, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	1072, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 958,
-	959, 957, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 960, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 69, 0, 586, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 0, 0, 1439, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 1441, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 1437, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 757, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 763, 285, 191,
-	205, 761, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 1439, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 1441,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 34,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 69, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 1459, 0, 0, 1460, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 1103, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 1102,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 586, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 1441, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 0, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 0, 0,
-	0, 177, 178, 179, 0, 1072, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 0, 0, 0, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	289, 0, 0, 0, 345, 0, 382, 227, 298, 296,
-	410, 251, 244, 240, 226, 273, 304, 343, 400, 337,
-	0, 293, 0, 0, 391, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 225, 195, 328, 392, 255, 0, 0, 0,
-	177, 178, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 223, 0, 0, 0, 0, 237, 277,
-	243, 236, 407, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 317, 0, 0,
-	0, 438, 0, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 191, 205, 0, 0, 327, 366, 372,
-	0, 0, 0, 228, 0, 370, 341, 424, 213, 253,
-	363, 346, 368, 0, 0, 369, 294, 412, 358, 422,
-	439, 440, 235, 321, 430, 404, 436, 448, 206, 232,
-	335, 397, 427, 388, 314, 408, 409, 284, 387, 261,
-	194, 292, 198, 399, 420, 218, 380, 0, 0, 0,
-	200, 418, 396, 311, 281, 282, 199, 0, 362, 239,
-	259, 230, 330, 415, 416, 229, 450, 208, 435, 202,
-	209, 434, 323, 411, 419, 312, 303, 201, 417, 310,
-	302, 287, 249, 269, 356, 297, 357, 270, 319, 318,
-	320, 0, 196, 0, 393, 428, 451, 215, 0, 0,
-	406, 444, 447, 0, 359, 216, 260, 248, 355, 258,
-	290, 443, 445, 446, 214, 353, 266, 334, 423, 252,
-	431, 322, 210, 272, 389, 286, 295, 0, 0, 340,
-	371, 219, 426, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 190, 203, 291, 1344, 360, 256,
-	449, 433, 429, 0, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 193,
-	204, 212, 221, 233, 246, 254, 264, 268, 271, 274,
-	275, 278, 283, 300, 305, 306, 307, 308, 324, 325,
-	326, 329, 332, 333, 336, 338, 339, 342, 348, 349,
-	350, 351, 352, 354, 361, 365, 373, 374, 375, 376,
-	377, 378, 379, 383, 384, 385, 386, 394, 398, 413,
-	414, 425, 437, 441, 265, 421, 442, 0, 299, 0,
-	0, 301, 250, 267, 276, 0, 432, 395, 207, 367,
-	257, 197, 224, 211, 231, 245, 247, 280, 309, 315,
-	344, 347, 262, 242, 222, 364, 220, 381, 401, 402,
-	403, 405, 313, 238, 331, 0, 1227, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 289,
-	0, 0, 0, 345, 0, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 0,
-	293, 0, 0, 391, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 225, 195, 328, 392, 255, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 223, 0, 0, 0, 0, 237, 277, 243,
-	236, 407, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 317, 0, 0, 0,
-	438, 0, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 191, 205, 0, 0, 327, 366, 372, 0,
-	0, 0, 228, 0, 370, 341, 424, 213, 253, 363,
-	346, 368, 0, 0, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 0, 0, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 0, 0, 340, 371,
-	219, 426, 390, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 190, 203, 291, 0, 360, 256, 449,
-	433, 429, 0, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 0, 0,
-	301, 250, 267, 276, 0, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 331, 0, 1225, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 1223, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 1221, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 1219, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 1215, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	1213, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 1211,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 0, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 1186, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 0, 0, 0, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 1085, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 1076,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 934,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 499, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 498, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 185, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2030, 0, 36, 2030, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	36, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2030, 0,
+	0, 0, 0, 0, 2279, 0, 0, 0, 0, 0,
+	36, 2281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 794, 781, 0, 0, 729, 797, 699,
+	718, 806, 720, 723, 763, 679, 742, 250, 715, 0,
+	703, 675, 711, 676, 701, 731, 159, 735, 698, 783,
+	745, 796, 207, 0, 681, 704, 264, 765, 307, 145,
+	217, 215, 335, 169, 162, 158, 143, 191, 223, 262,
+	325, 256, 803, 212, 752, 0, 316, 235, 0, 0,
+	2343, 733, 786, 740, 777, 728, 764, 688, 751, 798,
+	716, 760, 799, 197, 142, 111, 247, 317, 173, 0,
+	0, 0, 103, 104, 105, 0, 2288, 2289, 0, 0,
+	0, 0, 0, 133, 0, 139, 757, 793, 713, 759,
+	155, 195, 161, 154, 332, 762, 809, 674, 754, 0,
+	677, 680, 805, 789, 707, 709, 0, 0, 0, 0,
+	0, 0, 0, 732, 741, 774, 726, 0, 0, 0,
+	0, 0, 0, 0, 0, 705, 0, 750, 0, 0,
+	0, 684, 678, 0, 0, 0, 0, 730, 0, 0,
+	0, 687, 0, 706, 775, 0, 672, 181, 682, 236,
+	779, 788, 727, 364, 792, 725, 724, 795, 769, 685,
+	785, 719, 206, 683, 203, 107, 121, 0, 717, 246,
+	289, 295, 784, 702, 712, 146, 710, 293, 260, 349,
+	129, 171, 286, 265, 291, 749, 767, 292, 213, 337,
+	278, 347, 365, 366, 153, 240, 355, 329, 361, 376,
+	122, 150, 254, 322, 352, 313, 233, 333, 334, 202,
+	312, 179, 110, 211, 114, 324, 345, 134, 303, 0,
+	0, 0, 116, 343, 321, 230, 199, 200, 115, 0,
+	285, 157, 177, 148, 249, 340, 341, 147, 378, 124,
+	360, 118, 125, 359, 242, 336, 344, 231, 222, 117,
+	342, 229, 221, 205, 167, 187, 276, 216, 277, 188,
+	238, 237, 239, 0, 112, 0, 318, 353, 379, 131,
+	697, 780, 331, 370, 375, 0, 279, 132, 178, 166,
+	275, 176, 208, 369, 372, 374, 373, 282, 130, 272,
+	184, 253, 348, 170, 356, 241, 126, 190, 314, 204,
+	214, 772, 808, 259, 294, 135, 351, 315, 692, 696,
+	690, 691, 743, 744, 693, 800, 801, 802, 776, 686,
+	0, 694, 695, 0, 782, 790, 791, 748, 106, 119,
+	210, 804, 283, 174, 377, 358, 354, 673, 689, 152,
+	700, 708, 0, 714, 721, 722, 734, 736, 737, 738,
+	739, 747, 755, 756, 758, 766, 768, 771, 773, 778,
+	787, 807, 108, 109, 120, 128, 137, 151, 164, 172,
+	182, 186, 189, 192, 193, 196, 201, 219, 224, 225,
+	226, 227, 243, 244, 245, 248, 251, 252, 255, 257,
+	258, 261, 267, 268, 269, 270, 271, 273, 284, 288,
+	296, 297, 298, 299, 300, 301, 302, 308, 309, 310,
+	311, 319, 323, 338, 339, 350, 362, 367, 144, 770,
+	304, 305, 183, 346, 368, 0, 363, 274, 281, 209,
+	280, 371, 0, 140, 218, 746, 753, 220, 168, 185,
+	194, 761, 357, 320, 123, 290, 175, 113, 141, 127,
+	149, 163, 165, 198, 228, 234, 263, 266, 180, 160,
+	138, 287, 136, 306, 326, 327, 328, 330, 232, 156,
+	794, 781, 0, 0, 729, 797, 699, 718, 806, 720,
+	723, 763, 679, 742, 250, 715, 0, 703, 675, 711,
+	676, 701, 731, 159, 735, 698, 783, 745, 796, 207,
+	0, 681, 704, 264, 765, 307, 145, 217, 215, 335,
+	169, 162, 158, 143, 191, 223, 262, 325, 256, 803,
+	212, 752, 0, 316, 235, 0, 0, 0, 733, 786,
+	740, 777, 728, 764, 688, 751, 798, 716, 760, 799,
+	197, 142, 111, 247, 317, 173, 0, 0, 0, 103,
+	104, 105, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 0, 139, 757, 793, 713, 759, 155, 195, 161,
+	154, 332, 762, 809, 674, 754, 0, 677, 680, 805,
+	789, 707, 709, 0, 0, 0, 0, 0, 0, 0,
+	732, 741, 774, 726, 0, 0, 0, 0, 0, 0,
+	2022, 0, 705, 0, 750, 0, 0, 0, 684, 678,
+	0, 0, 0, 0, 730, 0, 0, 0, 687, 0,
+	706, 775, 0, 672, 181, 682, 236, 779, 788, 727,
+	364, 792, 725, 724, 795, 769, 685, 785, 719, 206,
+	683, 203, 107, 121, 0, 717, 246, 289, 295, 784,
+	702, 712, 146, 710, 293, 260, 349, 129, 171, 286,
+	265, 291, 749, 767, 292, 213, 337, 278, 347, 365,
+	366, 153, 240, 355, 329, 361, 376, 122, 150, 254,
+	322, 352, 313, 233, 333, 334, 202, 312, 179, 110,
+	211, 114, 324, 345, 134, 303, 0, 0, 0, 116,
+	343, 321, 230, 199, 200, 115, 0, 285, 157, 177,
+	148, 249, 340, 341, 147, 378, 124, 360, 118, 125,
+	359, 242, 336, 344, 231, 222, 117, 342, 229, 221,
+	205, 167, 187, 276, 216, 277, 188, 238, 237, 239,
+	0, 112, 0, 318, 353, 379, 131, 697, 780, 331,
+	370, 375, 0, 279, 132, 178, 166, 275, 176, 208,
+	369, 372, 374, 373, 282, 130, 272, 184, 253, 348,
+	170, 356, 241, 126, 190, 314, 204, 214, 772, 808,
+	259, 294, 135, 351, 315, 692, 696, 690, 691, 743,
+	744, 693, 800, 801, 802, 776, 686, 0, 694, 695,
+	0, 782, 790, 791, 748, 106, 119, 210, 804, 283,
+	174, 377, 358, 354, 673, 689, 152, 700, 708, 0,
+	714, 721, 722, 734, 736, 737, 738, 739, 747, 755,
+	756, 758, 766, 768, 771, 773, 778, 787, 807, 108,
+	109, 120, 128, 137, 151, 164, 172, 182, 186, 189,
+	192, 193, 196, 201, 219, 224, 225, 226, 227, 243,
+	244, 245, 248, 251, 252, 255, 257, 258, 261, 267,
+	268, 269, 270, 271, 273, 284, 288, 296, 297, 298,
+	299, 300, 301, 302, 308, 309, 310, 311, 319, 323,
+	338, 339, 350, 362, 367, 144, 770, 304, 305, 183,
+	346, 368, 0, 363, 274, 281, 209, 280, 371, 0,
+	140, 218, 746, 753, 220, 168, 185, 194, 761, 357,
+	320, 123, 290, 175, 113, 141, 127, 149, 163, 165,
+	198, 228, 234, 263, 266, 180, 160, 138, 287, 136,
+	306, 326, 327, 328, 330, 232, 156, 794, 781, 0,
+	0, 729, 797, 699, 718, 806, 720, 723, 763, 679,
+	742, 250, 715, 0, 703, 675, 711, 676, 701, 731,
+	159, 735, 698, 783, 745, 796, 207, 0, 681, 704,
+	264, 765, 307, 145, 217, 215, 335, 169, 162, 158,
+	143, 191, 223, 262, 325, 256, 803, 212, 752, 0,
+	316, 235, 0, 0, 0, 733, 786, 740, 777, 728,
+	764, 688, 751, 798, 716, 760, 799, 197, 142, 111,
+	247, 317, 173, 0, 0, 0, 103, 104, 105, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 0, 139,
+	757, 793, 713, 759, 155, 195, 161, 154, 332, 762,
+	809, 674, 754, 0, 677, 680, 805, 789, 707, 709,
+	0, 0, 0, 0, 0, 0, 0, 732, 741, 774,
+	726, 0, 0, 0, 0, 0, 0, 1870, 0, 705,
+	0, 750, 0, 0, 0, 684, 678, 0, 0, 0,
+	0, 730, 0, 0, 0, 687, 0, 706, 775, 0,
+	672, 181, 682, 236, 779, 788, 727, 364, 792, 725,
+	724, 795, 769, 685, 785, 719, 206, 683, 203, 107,
+	121, 0, 717, 246, 289, 295, 784, 702, 712, 146,
+	710, 293, 260, 349, 129, 171, 286, 265, 291, 749,
+	767, 292, 213, 337, 278, 347, 365, 366, 153, 240,
+	355, 329, 361, 376, 122, 150, 254, 322, 352, 313,
+	233, 333, 334, 202, 312, 179, 110, 211, 114, 324,
+	345, 134, 303, 0, 0, 0, 116, 343, 321, 230,
+	199, 200, 115, 0, 285, 157, 177, 148, 249, 340,
+	341, 147, 378, 124, 360, 118, 125, 359, 242, 336,
+	344, 231, 222, 117, 342, 229, 221, 205, 167, 187,
+	276, 216, 277, 188, 238, 237, 239, 0, 112, 0,
+	318, 353, 379, 131, 697, 780, 331, 370, 375, 0,
+	279, 132, 178, 166, 275, 176, 208, 369, 372, 374,
+	373, 282, 130, 272, 184, 253, 348, 170, 356, 241,
+	126, 190, 314, 204, 214, 772, 808, 259, 294, 135,
+	351, 315, 692, 696, 690, 691, 743, 744, 693, 800,
+	801, 802, 776, 686, 0, 694, 695, 0, 782, 790,
+	791, 748, 106, 119, 210, 804, 283, 174, 377, 358,
+	354, 673, 689, 152, 700, 708, 0, 714, 721, 722,
+	734, 736, 737, 738, 739, 747, 755, 756, 758, 766,
+	768, 771, 773, 778, 787, 807, 108, 109, 120, 128,
+	137, 151, 164, 172, 182, 186, 189, 192, 193, 196,
+	201, 219, 224, 225, 226, 227, 243, 244, 245, 248,
+	251, 252, 255, 257, 258, 261, 267, 268, 269, 270,
+	271, 273, 284, 288, 296, 297, 298, 299, 300, 301,
+	302, 308, 309, 310, 311, 319, 323, 338, 339, 350,
+	362, 367, 144, 770, 304, 305, 183, 346, 368, 0,
+	363, 274, 281, 209, 280, 371, 0, 140, 218, 746,
+	753, 220, 168, 185, 194, 761, 357, 320, 123, 290,
+	175, 113, 141, 127, 149, 163, 165, 198, 228, 234,
+	263, 266, 180, 160, 138, 287, 136, 306, 326, 327,
+	328, 330, 232, 156, 794, 781, 0, 0, 729, 797,
+	699, 718, 806, 720, 723, 763, 679, 742, 250, 715,
+	0, 703, 675, 711, 676, 701, 731, 159, 735, 698,
+	783, 745, 796, 207, 0, 681, 704, 264, 765, 307,
+	145, 217, 215, 335, 169, 162, 158, 143, 191, 223,
+	262, 325, 256, 803, 212, 752, 0, 316, 235, 0,
+	0, 0, 733, 786, 740, 777, 728, 764, 688, 751,
+	798, 716, 760, 799, 197, 142, 111, 247, 317, 173,
+	0, 0, 0, 103, 104, 105, 0, 0, 0, 0,
+	0, 0, 0, 0, 133, 0, 139, 757, 793, 713,
+	759, 155, 195, 161, 154, 332, 762, 809, 674, 754,
+	0, 677, 680, 805, 789, 707, 709, 0, 0, 0,
+	0, 0, 0, 0, 732, 741, 774, 726, 0, 0,
+	0, 0, 0, 0, 1571, 0, 705, 0, 750, 0,
+	0, 0, 684, 678, 0, 0, 0, 0, 730, 0,
+	0, 0, 687, 0, 706, 775, 0, 672, 181, 682,
+	236, 779, 788, 727, 364, 792, 725, 724, 795, 769,
+	685, 785, 719, 206, 683, 203, 107, 121, 0, 717,
+	246, 289, 295, 784, 702, 712, 146, 710, 293, 260,
+	349, 129, 171, 286, 265, 291, 749, 767, 292, 213,
+	337, 278, 347, 365, 366, 153, 240, 355, 329, 361,
+	376, 122, 150, 254, 322, 352, 313, 233, 333, 334,
+	202, 312, 179, 110, 211, 114, 324, 345, 134, 303,
+	0, 0, 0, 116, 343, 321, 230, 199, 200, 115,
+	0, 285, 157, 177, 148, 249, 340, 341, 147, 378,
+	124, 360, 118, 125, 359, 242, 336, 344, 231, 222,
+	117, 342, 229, 221, 205, 167, 187, 276, 216, 277,
+	188, 238, 237, 239, 0, 112, 0, 318, 353, 379,
+	131, 697, 780, 331, 370, 375, 0, 279, 132, 178,
+	166, 275, 176, 208, 369, 372, 374, 373, 282, 130,
+	272, 184, 253, 348, 170, 356, 241, 126, 190, 314,
+	204, 214, 772, 808, 259, 294, 135, 351, 315, 692,
+	696, 690, 691, 743, 744, 693, 800, 801, 802, 776,
+	686, 0, 694, 695, 0, 782, 790, 791, 748, 106,
+	119, 210, 804, 283, 174, 377, 358, 354, 673, 689,
+	152, 700, 708, 0, 714, 721, 722, 734, 736, 737,
+	738, 739, 747, 755, 756, 758, 766, 768, 771, 773,
+	778, 787, 807, 108, 109, 120, 128, 137, 151, 164,
+	172, 182, 186, 189, 192, 193, 196, 201, 219, 224,
+	225, 226, 227, 243, 244, 245, 248, 251, 252, 255,
+	257, 258, 261, 267, 268, 269, 270, 271, 273, 284,
+	288, 296, 297, 298, 299, 300, 301, 302, 308, 309,
+	310, 311, 319, 323, 338, 339, 350, 362, 367, 144,
+	770, 304, 305, 183, 346, 368, 0, 363, 274, 281,
+	209, 280, 371, 0, 140, 218, 746, 753, 220, 168,
+	185, 194, 761, 357, 320, 123, 290, 175, 113, 141,
+	127, 149, 163, 165, 198, 228, 234, 263, 266, 180,
+	160, 138, 287, 136, 306, 326, 327, 328, 330, 232,
+	156, 794, 781, 0, 0, 729, 797, 699, 718, 806,
+	720, 723, 763, 679, 742, 250, 715, 0, 703, 675,
+	711, 676, 701, 731, 159, 735, 698, 783, 745, 796,
+	207, 0, 681, 704, 264, 765, 307, 145, 217, 215,
+	335, 169, 162, 158, 143, 191, 223, 262, 325, 256,
+	803, 212, 752, 0, 316, 235, 0, 0, 0, 733,
+	786, 740, 777, 728, 764, 688, 751, 798, 716, 760,
+	799, 197, 142, 111, 247, 317, 173, 77, 0, 0,
+	103, 104, 105, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 0, 139, 757, 793, 713, 759, 155, 195,
+	161, 154, 332, 762, 809, 674, 754, 0, 677, 680,
+	805, 789, 707, 709, 0, 0, 0, 0, 0, 0,
+	0, 732, 741, 774, 726, 0, 0, 0, 0, 0,
+	0, 0, 0, 705, 0, 750, 0, 0, 0, 684,
+	678, 0, 0, 0, 0, 730, 0, 0, 0, 687,
+	0, 706, 775, 0, 672, 181, 682, 236, 779, 788,
+	727, 364, 792, 725, 724, 795, 769, 685, 785, 719,
+	206, 683, 203, 107, 121, 0, 717, 246, 289, 295,
+	784, 702, 712, 146, 710, 293, 260, 349, 129, 171,
+	286, 265, 291, 749, 767, 292, 213, 337, 278, 347,
+	365, 366, 153, 240, 355, 329, 361, 376, 122, 150,
+	254, 322, 352, 313, 233, 333, 334, 202, 312, 179,
+	110, 211, 114, 324, 345, 134, 303, 0, 0, 0,
+	116, 343, 321, 230, 199, 200, 115, 0, 285, 157,
+	177, 148, 249, 340, 341, 147, 378, 124, 360, 118,
+	125, 359, 242, 336, 344, 231, 222, 117, 342, 229,
+	221, 205, 167, 187, 276, 216, 277, 188, 238, 237,
+	239, 0, 112, 0, 318, 353, 379, 131, 697, 780,
+	331, 370, 375, 0, 279, 132, 178, 166, 275, 176,
+	208, 369, 372, 374, 373, 282, 130, 272, 184, 253,
+	348, 170, 356, 241, 126, 190, 314, 204, 214, 772,
+	808, 259, 294, 135, 351, 315, 692, 696, 690, 691,
+	743, 744, 693, 800, 801, 802, 776, 686, 0, 694,
+	695, 0, 782, 790, 791, 748, 106, 119, 210, 804,
+	283, 174, 377, 358, 354, 673, 689, 152, 700, 708,
+	0, 714, 721, 722, 734, 736, 737, 738, 739, 747,
+	755, 756, 758, 766, 768, 771, 773, 778, 787, 807,
+	108, 109, 120, 128, 137, 151, 164, 172, 182, 186,
+	189, 192, 193, 196, 201, 219, 224, 225, 226, 227,
+	243, 244, 245, 248, 251, 252, 255, 257, 258, 261,
+	267, 268, 269, 270, 271, 273, 284, 288, 296, 297,
+	298, 299, 300, 301, 302, 308, 309, 310, 311, 319,
+	323, 338, 339, 350, 362, 367, 144, 770, 304, 305,
+	183, 346, 368, 0, 363, 274, 281, 209, 280, 371,
+	0, 140, 218, 746, 753, 220, 168, 185, 194, 761,
+	357, 320, 123, 290, 175, 113, 141, 127, 149, 163,
+	165, 198, 228, 234, 263, 266, 180, 160, 138, 287,
+	136, 306, 326, 327, 328, 330, 232, 156, 794, 781,
+	0, 0, 729, 797, 699, 718, 806, 720, 723, 763,
+	679, 742, 250, 715, 0, 703, 675, 711, 676, 701,
+	731, 159, 735, 698, 783, 745, 796, 207, 0, 681,
+	704, 264, 765, 307, 145, 217, 215, 335, 169, 162,
+	158, 143, 191, 223, 262, 325, 256, 803, 212, 752,
+	0, 316, 235, 0, 0, 0, 733, 786, 740, 777,
+	728, 764, 688, 751, 798, 716, 760, 799, 197, 142,
+	111, 247, 317, 173, 0, 0, 0, 103, 104, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
+	139, 757, 793, 713, 759, 155, 195, 161, 154, 332,
+	762, 809, 674, 754, 0, 677, 680, 805, 789, 707,
+	709, 0, 0, 0, 0, 0, 0, 0, 732, 741,
+	774, 726, 0, 0, 0, 0, 0, 0, 0, 0,
+	705, 0, 750, 0, 0, 0, 684, 678, 0, 0,
+	0, 0, 730, 0, 0, 0, 687, 0, 706, 775,
+	0, 672, 181, 682, 236, 779, 788, 727, 364, 792,
+	725, 724, 795, 769, 685, 785, 719, 206, 683, 203,
+	107, 121, 0, 717, 246, 289, 295, 784, 702, 712,
+	146, 710, 293, 260, 349, 129, 171, 286, 265, 291,
+	749, 767, 292, 213, 337, 278, 347, 365, 366, 153,
+	240, 355, 329, 361, 376, 122, 150, 254, 322, 352,
+	313, 233, 333, 334, 202, 312, 179, 110, 211, 114,
+	324, 345, 134, 303, 0, 0, 0, 116, 343, 321,
+	230, 199, 200, 115, 0, 285, 157, 177, 148, 249,
+	340, 341, 147, 378, 124, 360, 118, 125, 359, 242,
+	336, 344, 231, 222, 117, 342, 229, 221, 205, 167,
+	187, 276, 216, 277, 188, 238, 237, 239, 0, 112,
+	0, 318, 353, 379, 131, 697, 780, 331, 370, 375,
+	0, 279, 132, 178, 166, 275, 176, 208, 369, 372,
+	374, 373, 282, 130, 272, 184, 253, 348, 170, 356,
+	241, 126, 190, 314, 204, 214, 772, 808, 259, 294,
+	135, 351, 315, 692, 696, 690, 691, 743, 744, 693,
+	800, 801, 802, 776, 686, 0, 694, 695, 0, 782,
+	790, 791, 748, 106, 119, 210, 804, 283, 174, 377,
+	358, 354, 673, 689, 152, 700, 708, 0, 714, 721,
+	722, 734, 736, 737, 738, 739, 747, 755, 756, 758,
+	766, 768, 771, 773, 778, 787, 807, 108, 109, 120,
+	128, 137, 151, 164, 172, 182, 186, 189, 192, 193,
+	196, 201, 219, 224, 225, 226, 227, 243, 244, 245,
+	248, 251, 252, 255, 257, 258, 261, 267, 268, 269,
+	270, 271, 273, 284, 288, 296, 297, 298, 299, 300,
+	301, 302, 308, 309, 310, 311, 319, 323, 338, 339,
+	350, 362, 367, 144, 770, 304, 305, 183, 346, 368,
+	0, 363, 274, 281, 209, 280, 371, 0, 140, 218,
+	746, 753, 220, 168, 185, 194, 761, 357, 320, 123,
+	290, 175, 113, 141, 127, 149, 163, 165, 198, 228,
+	234, 263, 266, 180, 160, 138, 287, 136, 306, 326,
+	327, 328, 330, 232, 156, 794, 781, 0, 0, 729,
+	797, 699, 718, 806, 720, 723, 763, 679, 742, 250,
+	715, 0, 703, 675, 711, 676, 701, 731, 159, 735,
+	698, 783, 745, 796, 207, 0, 681, 704, 264, 765,
+	307, 145, 217, 215, 335, 169, 162, 158, 143, 191,
+	223, 262, 325, 256, 803, 212, 752, 0, 316, 235,
+	0, 0, 0, 733, 786, 740, 777, 728, 764, 688,
+	751, 798, 716, 760, 799, 197, 142, 111, 247, 317,
+	173, 0, 0, 0, 103, 104, 105, 0, 0, 0,
+	0, 0, 0, 0, 0, 133, 0, 139, 757, 793,
+	713, 759, 155, 195, 161, 154, 332, 762, 809, 674,
+	754, 0, 677, 680, 805, 789, 707, 709, 0, 0,
+	0, 0, 0, 0, 0, 732, 741, 774, 726, 0,
+	0, 0, 0, 0, 0, 0, 0, 705, 0, 750,
+	0, 0, 0, 684, 678, 0, 0, 0, 0, 730,
+	0, 0, 0, 687, 0, 706, 775, 0, 672, 181,
+	682, 236, 779, 788, 727, 364, 792, 725, 724, 795,
+	769, 685, 785, 719, 206, 683, 203, 107, 121, 0,
+	717, 246, 289, 295, 784, 702, 712, 146, 710, 293,
+	260, 349, 129, 171, 286, 265, 291, 749, 767, 292,
+	213, 337, 278, 347, 365, 366, 153, 240, 355, 329,
+	361, 376, 122, 150, 254, 322, 352, 313, 233, 333,
+	334, 202, 312, 179, 110, 211, 114, 324, 345, 134,
+	303, 0, 0, 0, 116, 343, 321, 230, 199, 200,
+	115, 0, 285, 157, 177, 148, 249, 340, 341, 147,
+	378, 124, 360, 118, 811, 359, 242, 336, 344, 231,
+	222, 117, 342, 229, 221, 205, 167, 187, 276, 216,
+	277, 188, 238, 237, 239, 0, 112, 0, 318, 353,
+	379, 131, 697, 780, 331, 370, 375, 0, 279, 132,
+	178, 166, 275, 176, 208, 369, 372, 374, 373, 282,
+	130, 272, 184, 253, 348, 170, 356, 671, 810, 665,
+	664, 204, 214, 772, 808, 259, 294, 135, 351, 315,
+	692, 696, 690, 691, 743, 744, 693, 800, 801, 802,
+	776, 686, 0, 694, 695, 0, 782, 790, 791, 748,
+	106, 119, 210, 804, 283, 174, 377, 358, 354, 673,
+	689, 152, 700, 708, 0, 714, 721, 722, 734, 736,
+	737, 738, 739, 747, 755, 756, 758, 766, 768, 771,
+	773, 778, 787, 807, 108, 109, 120, 128, 137, 151,
+	164, 172, 182, 186, 189, 192, 193, 196, 201, 219,
+	224, 225, 226, 227, 243, 244, 245, 248, 251, 252,
+	255, 257, 258, 261, 267, 268, 269, 270, 271, 273,
+	284, 288, 296, 297, 298, 299, 300, 301, 302, 308,
+	309, 310, 311, 319, 323, 338, 339, 350, 362, 367,
+	144, 770, 304, 305, 183, 346, 368, 0, 363, 274,
+	281, 209, 280, 371, 0, 140, 218, 746, 753, 220,
+	168, 185, 194, 761, 357, 320, 123, 290, 175, 113,
+	141, 127, 149, 163, 165, 198, 228, 234, 263, 266,
+	180, 160, 138, 287, 136, 306, 326, 327, 328, 330,
+	232, 156, 794, 781, 0, 0, 729, 797, 699, 718,
+	806, 720, 723, 763, 679, 742, 250, 715, 0, 703,
+	675, 711, 676, 701, 731, 159, 735, 698, 783, 745,
+	796, 207, 0, 681, 704, 264, 765, 307, 145, 217,
+	215, 335, 169, 162, 158, 143, 191, 223, 262, 325,
+	256, 803, 212, 752, 0, 316, 235, 0, 0, 0,
+	733, 786, 740, 777, 728, 764, 688, 751, 798, 716,
+	760, 799, 197, 142, 111, 247, 317, 173, 0, 0,
+	0, 103, 104, 105, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 0, 139, 757, 793, 713, 759, 155,
+	195, 161, 154, 332, 762, 809, 674, 754, 0, 677,
+	680, 805, 789, 707, 709, 0, 0, 0, 0, 0,
+	0, 0, 732, 741, 774, 726, 0, 0, 0, 0,
+	0, 0, 0, 0, 705, 0, 750, 0, 0, 0,
+	684, 678, 0, 0, 0, 0, 730, 0, 0, 0,
+	687, 0, 706, 775, 0, 672, 181, 682, 236, 779,
+	788, 727, 364, 792, 725, 724, 795, 769, 685, 785,
+	719, 206, 683, 203, 107, 121, 0, 717, 246, 289,
+	295, 784, 702, 712, 146, 710, 293, 260, 349, 129,
+	171, 286, 265, 291, 749, 767, 292, 213, 337, 278,
+	347, 365, 366, 153, 240, 355, 329, 361, 376, 122,
+	150, 254, 322, 352, 313, 233, 333, 334, 202, 312,
+	179, 110, 211, 114, 324, 1173, 134, 303, 0, 0,
+	0, 116, 343, 321, 230, 199, 200, 115, 0, 285,
+	157, 177, 148, 249, 340, 341, 147, 378, 124, 360,
+	118, 811, 359, 242, 336, 344, 231, 222, 117, 342,
+	229, 221, 205, 167, 187, 276, 216, 277, 188, 238,
+	237, 239, 0, 112, 0, 318, 353, 379, 131, 697,
+	780, 331, 370, 375, 0, 279, 132, 178, 166, 275,
+	176, 208, 369, 372, 374, 373, 282, 130, 272, 184,
+	253, 348, 170, 356, 671, 810, 665, 664, 204, 214,
+	772, 808, 259, 294, 135, 351, 315, 692, 696, 690,
+	691, 743, 744, 693, 800, 801, 802, 776, 686, 0,
+	694, 695, 0, 782, 790, 791, 748, 106, 119, 210,
+	804, 283, 174, 377, 358, 354, 673, 689, 152, 700,
+	708, 0, 714, 721, 722, 734, 736, 737, 738, 739,
+	747, 755, 756, 758, 766, 768, 771, 773, 778, 787,
+	807, 108, 109, 120, 128, 137, 151, 164, 172, 182,
+	186, 189, 192, 193, 196, 201, 219, 224, 225, 226,
+	227, 243, 244, 245, 248, 251, 252, 255, 257, 258,
+	261, 267, 268, 269, 270, 271, 273, 284, 288, 296,
+	297, 298, 299, 300, 301, 302, 308, 309, 310, 311,
+	319, 323, 338, 339, 350, 362, 367, 144, 770, 304,
+	305, 183, 346, 368, 0, 363, 274, 281, 209, 280,
+	371, 0, 140, 218, 746, 753, 220, 168, 185, 194,
+	761, 357, 320, 123, 290, 175, 113, 141, 127, 149,
+	163, 165, 198, 228, 234, 263, 266, 180, 160, 138,
+	287, 136, 306, 326, 327, 328, 330, 232, 156, 794,
+	781, 0, 0, 729, 797, 699, 718, 806, 720, 723,
+	763, 679, 742, 250, 715, 0, 703, 675, 711, 676,
+	701, 731, 159, 735, 698, 783, 745, 796, 207, 0,
+	681, 704, 264, 765, 307, 145, 217, 215, 335, 169,
+	162, 158, 143, 191, 223, 262, 325, 256, 803, 212,
+	752, 0, 316, 235, 0, 0, 0, 733, 786, 740,
+	777, 728, 764, 688, 751, 798, 716, 760, 799, 197,
+	142, 111, 247, 317, 173, 0, 0, 0, 103, 104,
+	105, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	0, 139, 757, 793, 713, 759, 155, 195, 161, 154,
+	332, 762, 809, 674, 754, 0, 677, 680, 805, 789,
+	707, 709, 0, 0, 0, 0, 0, 0, 0, 732,
+	741, 774, 726, 0, 0, 0, 0, 0, 0, 0,
+	0, 705, 0, 750, 0, 0, 0, 684, 678, 0,
+	0, 0, 0, 730, 0, 0, 0, 687, 0, 706,
+	775, 0, 672, 181, 682, 236, 779, 788, 727, 364,
+	792, 725, 724, 795, 769, 685, 785, 719, 206, 683,
+	203, 107, 121, 0, 717, 246, 289, 295, 784, 702,
+	712, 146, 710, 293, 260, 349, 129, 171, 286, 265,
+	291, 749, 767, 292, 213, 337, 278, 347, 365, 366,
+	153, 240, 355, 329, 361, 376, 122, 150, 254, 322,
+	352, 313, 233, 333, 334, 202, 312, 179, 110, 211,
+	114, 324, 662, 134, 303, 0, 0, 0, 116, 343,
+	321, 230, 199, 200, 115, 0, 285, 157, 177, 148,
+	249, 340, 341, 147, 378, 124, 360, 118, 811, 359,
+	242, 336, 344, 231, 222, 117, 342, 229, 221, 205,
+	167, 187, 276, 216, 277, 188, 238, 237, 239, 0,
+	112, 0, 318, 353, 379, 131, 697, 780, 331, 370,
+	375, 0, 279, 132, 178, 166, 275, 176, 208, 369,
+	372, 374, 373, 282, 130, 272, 184, 253, 348, 170,
+	356, 671, 810, 665, 664, 204, 214, 772, 808, 259,
+	294, 135, 351, 315, 692, 696, 690, 691, 743, 744,
+	693, 800, 801, 802, 776, 686, 0, 694, 695, 0,
+	782, 790, 791, 748, 106, 119, 210, 804, 283, 174,
+	377, 358, 354, 673, 689, 152, 700, 708, 0, 714,
+	721, 722, 734, 736, 737, 738, 739, 747, 755, 756,
+	758, 766, 768, 771, 773, 778, 787, 807, 108, 109,
+	120, 128, 137, 151, 164, 172, 182, 186, 189, 192,
+	193, 196, 201, 219, 224, 225, 226, 227, 243, 244,
+	245, 248, 251, 252, 255, 257, 258, 261, 267, 268,
+	269, 270, 271, 273, 284, 288, 296, 297, 298, 299,
+	300, 301, 302, 308, 309, 310, 311, 319, 323, 338,
+	339, 350, 362, 367, 144, 770, 304, 305, 183, 346,
+	368, 0, 363, 274, 281, 209, 280, 371, 0, 140,
+	218, 746, 753, 220, 168, 185, 194, 761, 357, 320,
+	123, 290, 175, 113, 141, 127, 149, 163, 165, 198,
+	228, 234, 263, 266, 180, 160, 138, 287, 136, 306,
+	326, 327, 328, 330, 232, 156, 250, 0, 0, 1475,
+	0, 545, 0, 0, 0, 159, 0, 544, 0, 0,
+	0, 207, 0, 0, 1476, 264, 0, 307, 145, 217,
+	215, 335, 169, 162, 158, 143, 191, 223, 262, 325,
+	256, 588, 212, 0, 0, 316, 235, 0, 0, 0,
+	0, 0, 579, 580, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 142, 111, 247, 317, 173, 77, 0,
+	0, 103, 104, 105, 566, 565, 568, 569, 570, 571,
+	0, 0, 133, 567, 139, 572, 573, 574, 0, 155,
+	195, 161, 154, 332, 0, 0, 0, 542, 559, 0,
+	587, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	556, 557, 652, 0, 0, 0, 602, 0, 558, 0,
+	0, 551, 552, 554, 553, 555, 560, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 0, 236, 601,
+	0, 0, 364, 0, 0, 599, 0, 0, 0, 0,
+	0, 206, 0, 203, 107, 121, 0, 0, 246, 289,
+	295, 0, 0, 0, 146, 0, 293, 260, 349, 129,
+	171, 286, 265, 291, 0, 0, 292, 213, 337, 278,
+	347, 365, 366, 153, 240, 355, 329, 361, 376, 122,
+	150, 254, 322, 352, 313, 233, 333, 334, 202, 312,
+	179, 110, 211, 114, 324, 345, 134, 303, 0, 0,
+	0, 116, 343, 321, 230, 199, 200, 115, 0, 285,
+	157, 177, 148, 249, 340, 341, 147, 378, 124, 360,
+	118, 125, 359, 242, 336, 344, 231, 222, 117, 342,
+	229, 221, 205, 167, 187, 276, 216, 277, 188, 238,
+	237, 239, 0, 112, 0, 318, 353, 379, 131, 0,
+	0, 331, 370, 375, 0, 279, 132, 178, 166, 275,
+	176, 208, 369, 372, 374, 373, 282, 130, 272, 184,
+	253, 348, 170, 356, 241, 126, 190, 314, 204, 214,
+	0, 0, 259, 294, 135, 351, 315, 589, 600, 595,
+	596, 593, 594, 0, 592, 591, 590, 603, 581, 582,
+	583, 584, 586, 0, 597, 598, 585, 106, 119, 210,
+	0, 283, 174, 377, 358, 354, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 108, 109, 120, 128, 137, 151, 164, 172, 182,
+	186, 189, 192, 193, 196, 201, 219, 224, 225, 226,
+	227, 243, 244, 245, 248, 251, 252, 255, 257, 258,
+	261, 267, 268, 269, 270, 271, 273, 284, 288, 296,
+	297, 298, 299, 300, 301, 302, 308, 309, 310, 311,
+	319, 323, 338, 339, 350, 362, 367, 144, 0, 304,
+	305, 183, 346, 368, 0, 363, 274, 281, 209, 280,
+	371, 0, 140, 218, 0, 0, 220, 168, 185, 194,
+	0, 357, 320, 123, 290, 175, 113, 141, 127, 149,
+	163, 165, 198, 228, 234, 263, 266, 180, 160, 138,
+	287, 136, 306, 326, 327, 328, 330, 232, 156, 250,
+	0, 0, 0, 0, 545, 0, 0, 0, 159, 0,
+	544, 0, 0, 0, 207, 0, 0, 0, 264, 0,
+	307, 145, 217, 215, 335, 169, 162, 158, 143, 191,
+	223, 262, 325, 256, 588, 212, 0, 0, 316, 235,
+	0, 0, 0, 0, 0, 579, 580, 0, 0, 0,
+	0, 0, 0, 1611, 0, 197, 142, 111, 247, 317,
+	173, 77, 0, 0, 103, 104, 105, 566, 565, 568,
+	569, 570, 571, 0, 0, 133, 567, 139, 572, 573,
+	574, 1612, 155, 195, 161, 154, 332, 0, 0, 0,
+	542, 559, 0, 587, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 556, 557, 0, 0, 0, 0, 602,
+	0, 558, 0, 0, 551, 552, 554, 553, 555, 560,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
+	0, 236, 601, 0, 0, 364, 0, 0, 599, 0,
+	0, 0, 0, 0, 206, 0, 203, 107, 121, 0,
+	0, 246, 289, 295, 0, 0, 0, 146, 0, 293,
+	260, 349, 129, 171, 286, 265, 291, 0, 0, 292,
+	213, 337, 278, 347, 365, 366, 153, 240, 355, 329,
+	361, 376, 122, 150, 254, 322, 352, 313, 233, 333,
+	334, 202, 312, 179, 110, 211, 114, 324, 345, 134,
+	303, 0, 0, 0, 116, 343, 321, 230, 199, 200,
+	115, 0, 285, 157, 177, 148, 249, 340, 341, 147,
+	378, 124, 360, 118, 125, 359, 242, 336, 344, 231,
+	222, 117, 342, 229, 221, 205, 167, 187, 276, 216,
+	277, 188, 238, 237, 239, 0, 112, 0, 318, 353,
+	379, 131, 0, 0, 331, 370, 375, 0, 279, 132,
+	178, 166, 275, 176, 208, 369, 372, 374, 373, 282,
+	130, 272, 184, 253, 348, 170, 356, 241, 126, 190,
+	314, 204, 214, 0, 0, 259, 294, 135, 351, 315,
+	589, 600, 595, 596, 593, 594, 0, 592, 591, 590,
+	603, 581, 582, 583, 584, 586, 0, 597, 598, 585,
+	106, 119, 210, 0, 283, 174, 377, 358, 354, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 109, 120, 128, 137, 151,
+	164, 172, 182, 186, 189, 192, 193, 196, 201, 219,
+	224, 225, 226, 227, 243, 244, 245, 248, 251, 252,
+	255, 257, 258, 261, 267, 268, 269, 270, 271, 273,
+	284, 288, 296, 297, 298, 299, 300, 301, 302, 308,
+	309, 310, 311, 319, 323, 338, 339, 350, 362, 367,
+	144, 0, 304, 305, 183, 346, 368, 0, 363, 274,
+	281, 209, 280, 371, 0, 140, 218, 0, 0, 220,
+	168, 185, 194, 0, 357, 320, 123, 290, 175, 113,
+	141, 127, 149, 163, 165, 198, 228, 234, 263, 266,
+	180, 160, 138, 287, 136, 306, 326, 327, 328, 330,
+	232, 156, 629, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	545, 0, 0, 0, 159, 0, 544, 0, 0, 0,
+	207, 0, 0, 0, 264, 0, 307, 145, 217, 215,
+	335, 169, 162, 158, 143, 191, 223, 262, 325, 256,
+	588, 212, 0, 0, 316, 235, 0, 0, 0, 0,
+	0, 579, 580, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 142, 111, 247, 317, 173, 77, 0, 0,
+	103, 104, 105, 566, 565, 568, 569, 570, 571, 0,
+	0, 133, 567, 139, 572, 573, 574, 0, 155, 195,
+	161, 154, 332, 0, 0, 0, 542, 559, 0, 587,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 556,
+	557, 0, 0, 0, 0, 602, 0, 558, 0, 0,
+	551, 552, 554, 553, 555, 560, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 0, 236, 601, 0,
+	0, 364, 0, 0, 599, 0, 0, 0, 0, 0,
+	206, 0, 203, 107, 121, 0, 0, 246, 289, 295,
+	0, 0, 0, 146, 0, 293, 260, 349, 129, 171,
+	286, 265, 291, 0, 0, 292, 213, 337, 278, 347,
+	365, 366, 153, 240, 355, 329, 361, 376, 122, 150,
+	254, 322, 352, 313, 233, 333, 334, 202, 312, 179,
+	110, 211, 114, 324, 345, 134, 303, 0, 0, 0,
+	116, 343, 321, 230, 199, 200, 115, 0, 285, 157,
+	177, 148, 249, 340, 341, 147, 378, 124, 360, 118,
+	125, 359, 242, 336, 344, 231, 222, 117, 342, 229,
+	221, 205, 167, 187, 276, 216, 277, 188, 238, 237,
+	239, 0, 112, 0, 318, 353, 379, 131, 0, 0,
+	331, 370, 375, 0, 279, 132, 178, 166, 275, 176,
+	208, 369, 372, 374, 373, 282, 130, 272, 184, 253,
+	348, 170, 356, 241, 126, 190, 314, 204, 214, 0,
+	0, 259, 294, 135, 351, 315, 589, 600, 595, 596,
+	593, 594, 0, 592, 591, 590, 603, 581, 582, 583,
+	584, 586, 0, 597, 598, 585, 106, 119, 210, 38,
+	283, 174, 377, 358, 354, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	108, 109, 120, 128, 137, 151, 164, 172, 182, 186,
+	189, 192, 193, 196, 201, 219, 224, 225, 226, 227,
+	243, 244, 245, 248, 251, 252, 255, 257, 258, 261,
+	267, 268, 269, 270, 271, 273, 284, 288, 296, 297,
+	298, 299, 300, 301, 302, 308, 309, 310, 311, 319,
+	323, 338, 339, 350, 362, 367, 144, 0, 304, 305,
+	183, 346, 368, 0, 363, 274, 281, 209, 280, 371,
+	0, 140, 218, 0, 0, 220, 168, 185, 194, 0,
+	357, 320, 123, 290, 175, 113, 141, 127, 149, 163,
+	165, 198, 228, 234, 263, 266, 180, 160, 138, 287,
+	136, 306, 326, 327, 328, 330, 232, 156, 250, 0,
+	0, 0, 0, 545, 0, 0, 0, 159, 0, 544,
+	0, 0, 0, 207, 0, 0, 0, 264, 0, 307,
+	145, 217, 215, 335, 169, 162, 158, 143, 191, 223,
+	262, 325, 256, 588, 212, 0, 0, 316, 235, 0,
+	0, 0, 0, 0, 579, 580, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 142, 111, 247, 317, 173,
+	77, 0, 636, 103, 104, 105, 566, 565, 568, 569,
+	570, 571, 0, 0, 133, 567, 139, 572, 573, 574,
+	0, 155, 195, 161, 154, 332, 0, 0, 0, 542,
+	559, 0, 587, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 556, 557, 0, 0, 0, 0, 602, 0,
+	558, 0, 0, 551, 552, 554, 553, 555, 560, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
+	236, 601, 0, 0, 364, 0, 0, 599, 0, 0,
+	0, 0, 0, 206, 0, 203, 107, 121, 0, 0,
+	246, 289, 295, 0, 0, 0, 146, 0, 293, 260,
+	349, 129, 171, 286, 265, 291, 0, 0, 292, 213,
+	337, 278, 347, 365, 366, 153, 240, 355, 329, 361,
+	376, 122, 150, 254, 322, 352, 313, 233, 333, 334,
+	202, 312, 179, 110, 211, 114, 324, 345, 134, 303,
+	0, 0, 0, 116, 343, 321, 230, 199, 200, 115,
+	0, 285, 157, 177, 148, 249, 340, 341, 147, 378,
+	124, 360, 118, 125, 359, 242, 336, 344, 231, 222,
+	117, 342, 229, 221, 205, 167, 187, 276, 216, 277,
+	188, 238, 237, 239, 0, 112, 0, 318, 353, 379,
+	131, 0, 0, 331, 370, 375, 0, 279, 132, 178,
+	166, 275, 176, 208, 369, 372, 374, 373, 282, 130,
+	272, 184, 253, 348, 170, 356, 241, 126, 190, 314,
+	204, 214, 0, 0, 259, 294, 135, 351, 315, 589,
+	600, 595, 596, 593, 594, 0, 592, 591, 590, 603,
+	581, 582, 583, 584, 586, 0, 597, 598, 585, 106,
+	119, 210, 0, 283, 174, 377, 358, 354, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 108, 109, 120, 128, 137, 151, 164,
+	172, 182, 186, 189, 192, 193, 196, 201, 219, 224,
+	225, 226, 227, 243, 244, 245, 248, 251, 252, 255,
+	257, 258, 261, 267, 268, 269, 270, 271, 273, 284,
+	288, 296, 297, 298, 299, 300, 301, 302, 308, 309,
+	310, 311, 319, 323, 338, 339, 350, 362, 367, 144,
+	0, 304, 305, 183, 346, 368, 0, 363, 274, 281,
+	209, 280, 371, 0, 140, 218, 0, 0, 220, 168,
+	185, 194, 0, 357, 320, 123, 290, 175, 113, 141,
+	127, 149, 163, 165, 198, 228, 234, 263, 266, 180,
+	160, 138, 287, 136, 306, 326, 327, 328, 330, 232,
+	156, 250, 0, 0, 0, 0, 545, 0, 0, 0,
+	159, 0, 544, 0, 0, 0, 207, 0, 0, 0,
+	264, 0, 307, 145, 217, 215, 335, 169, 162, 158,
+	143, 191, 223, 262, 325, 256, 588, 212, 0, 0,
+	316, 235, 0, 0, 0, 0, 0, 579, 580, 0,
+	0, 0, 0, 0, 0, 0, 0, 197, 142, 111,
+	247, 317, 173, 77, 0, 0, 103, 104, 105, 566,
+	565, 568, 569, 570, 571, 0, 0, 133, 567, 139,
+	572, 573, 574, 0, 155, 195, 161, 154, 332, 0,
+	0, 0, 542, 559, 0, 587, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 556, 557, 652, 0, 0,
+	0, 602, 0, 558, 0, 0, 551, 552, 554, 553,
+	555, 560, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 0, 236, 601, 0, 0, 364, 0, 0,
+	599, 0, 0, 0, 0, 0, 206, 0, 203, 107,
+	121, 0, 0, 246, 289, 295, 0, 0, 0, 146,
+	0, 293, 260, 349, 129, 171, 286, 265, 291, 0,
+	0, 292, 213, 337, 278, 347, 365, 366, 153, 240,
+	355, 329, 361, 376, 122, 150, 254, 322, 352, 313,
+	233, 333, 334, 202, 312, 179, 110, 211, 114, 324,
+	345, 134, 303, 0, 0, 0, 116, 343, 321, 230,
+	199, 200, 115, 0, 285, 157, 177, 148, 249, 340,
+	341, 147, 378, 124, 360, 118, 125, 359, 242, 336,
+	344, 231, 222, 117, 342, 229, 221, 205, 167, 187,
+	276, 216, 277, 188, 238, 237, 239, 0, 112, 0,
+	318, 353, 379, 131, 0, 0, 331, 370, 375, 0,
+	279, 132, 178, 166, 275, 176, 208, 369, 372, 374,
+	373, 282, 130, 272, 184, 253, 348, 170, 356, 241,
+	126, 190, 314, 204, 214, 0, 0, 259, 294, 135,
+	351, 315, 589, 600, 595, 596, 593, 594, 0, 592,
+	591, 590, 603, 581, 582, 583, 584, 586, 0, 597,
+	598, 585, 106, 119, 210, 0, 283, 174, 377, 358,
+	354, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 109, 120, 128,
+	137, 151, 164, 172, 182, 186, 189, 192, 193, 196,
+	201, 219, 224, 225, 226, 227, 243, 244, 245, 248,
+	251, 252, 255, 257, 258, 261, 267, 268, 269, 270,
+	271, 273, 284, 288, 296, 297, 298, 299, 300, 301,
+	302, 308, 309, 310, 311, 319, 323, 338, 339, 350,
+	362, 367, 144, 0, 304, 305, 183, 346, 368, 0,
+	363, 274, 281, 209, 280, 371, 0, 140, 218, 0,
+	0, 220, 168, 185, 194, 0, 357, 320, 123, 290,
+	175, 113, 141, 127, 149, 163, 165, 198, 228, 234,
+	263, 266, 180, 160, 138, 287, 136, 306, 326, 327,
+	328, 330, 232, 156, 250, 0, 0, 0, 0, 545,
+	0, 0, 0, 159, 0, 544, 0, 0, 0, 207,
+	0, 0, 0, 264, 0, 307, 145, 217, 215, 335,
+	169, 162, 158, 143, 191, 223, 262, 325, 256, 588,
+	212, 0, 0, 316, 235, 0, 0, 0, 0, 0,
+	579, 580, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 142, 111, 247, 317, 173, 77, 0, 0, 103,
+	104, 105, 566, 1494, 568, 569, 570, 571, 0, 0,
+	133, 567, 139, 572, 573, 574, 0, 155, 195, 161,
+	154, 332, 0, 0, 0, 542, 559, 0, 587, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 556, 557,
+	652, 0, 0, 0, 602, 0, 558, 0, 0, 551,
+	552, 554, 553, 555, 560, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 181, 0, 236, 601, 0, 0,
+	364, 0, 0, 599, 0, 0, 0, 0, 0, 206,
+	0, 203, 107, 121, 0, 0, 246, 289, 295, 0,
+	0, 0, 146, 0, 293, 260, 349, 129, 171, 286,
+	265, 291, 0, 0, 292, 213, 337, 278, 347, 365,
+	366, 153, 240, 355, 329, 361, 376, 122, 150, 254,
+	322, 352, 313, 233, 333, 334, 202, 312, 179, 110,
+	211, 114, 324, 345, 134, 303, 0, 0, 0, 116,
+	343, 321, 230, 199, 200, 115, 0, 285, 157, 177,
+	148, 249, 340, 341, 147, 378, 124, 360, 118, 125,
+	359, 242, 336, 344, 231, 222, 117, 342, 229, 221,
+	205, 167, 187, 276, 216, 277, 188, 238, 237, 239,
+	0, 112, 0, 318, 353, 379, 131, 0, 0, 331,
+	370, 375, 0, 279, 132, 178, 166, 275, 176, 208,
+	369, 372, 374, 373, 282, 130, 272, 184, 253, 348,
+	170, 356, 241, 126, 190, 314, 204, 214, 0, 0,
+	259, 294, 135, 351, 315, 589, 600, 595, 596, 593,
+	594, 0, 592, 591, 590, 603, 581, 582, 583, 584,
+	586, 0, 597, 598, 585, 106, 119, 210, 0, 283,
+	174, 377, 358, 354, 0, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 108,
+	109, 120, 128, 137, 151, 164, 172, 182, 186, 189,
+	192, 193, 196, 201, 219, 224, 225, 226, 227, 243,
+	244, 245, 248, 251, 252, 255, 257, 258, 261, 267,
+	268, 269, 270, 271, 273, 284, 288, 296, 297, 298,
+	299, 300, 301, 302, 308, 309, 310, 311, 319, 323,
+	338, 339, 350, 362, 367, 144, 0, 304, 305, 183,
+	346, 368, 0, 363, 274, 281, 209, 280, 371, 0,
+	140, 218, 0, 0, 220, 168, 185, 194, 0, 357,
+	320, 123, 290, 175, 113, 141, 127, 149, 163, 165,
+	198, 228, 234, 263, 266, 180, 160, 138, 287, 136,
+	306, 326, 327, 328, 330, 232, 156, 250, 0, 0,
+	0, 0, 545, 0, 0, 0, 159, 0, 544, 0,
+	0, 0, 207, 0, 0, 0, 264, 0, 307, 145,
+	217, 215, 335, 169, 162, 158, 143, 191, 223, 262,
+	325, 256, 588, 212, 0, 0, 316, 235, 0, 0,
+	0, 0, 0, 579, 580, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 142, 111, 247, 317, 173, 77,
+	0, 0, 103, 104, 105, 566, 1491, 568, 569, 570,
+	571, 0, 0, 133, 567, 139, 572, 573, 574, 0,
+	155, 195, 161, 154, 332, 0, 0, 0, 542, 559,
+	0, 587, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 556, 557, 652, 0, 0, 0, 602, 0, 558,
+	0, 0, 551, 552, 554, 553, 555, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 0, 236,
+	601, 0, 0, 364, 0, 0, 599, 0, 0, 0,
+	0, 0, 206, 0, 203, 107, 121, 0, 0, 246,
+	289, 295, 0, 0, 0, 146, 0, 293, 260, 349,
+	129, 171, 286, 265, 291, 0, 0, 292, 213, 337,
+	278, 347, 365, 366, 153, 240, 355, 329, 361, 376,
+	122, 150, 254, 322, 352, 313, 233, 333, 334, 202,
+	312, 179, 110, 211, 114, 324, 345, 134, 303, 0,
+	0, 0, 116, 343, 321, 230, 199, 200, 115, 0,
+	285, 157, 177, 148, 249, 340, 341, 147, 378, 124,
+	360, 118, 125, 359, 242, 336, 344, 231, 222, 117,
+	342, 229, 221, 205, 167, 187, 276, 216, 277, 188,
+	238, 237, 239, 0, 112, 0, 318, 353, 379, 131,
+	0, 0, 331, 370, 375, 0, 279, 132, 178, 166,
+	275, 176, 208, 369, 372, 374, 373, 282, 130, 272,
+	184, 253, 348, 170, 356, 241, 126, 190, 314, 204,
+	214, 0, 0, 259, 294, 135, 351, 315, 589, 600,
+	595, 596, 593, 594, 0, 592, 591, 590, 603, 581,
+	582, 583, 584, 586, 0, 597, 598, 585, 106, 119,
+	210, 0, 283, 174, 377, 358, 354, 0, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 109, 120, 128, 137, 151, 164, 172,
+	182, 186, 189, 192, 193, 196, 201, 219, 224, 225,
+	226, 227, 243, 244, 245, 248, 251, 252, 255, 257,
+	258, 261, 267, 268, 269, 270, 271, 273, 284, 288,
+	296, 297, 298, 299, 300, 301, 302, 308, 309, 310,
+	311, 319, 323, 338, 339, 350, 362, 367, 144, 0,
+	304, 305, 183, 346, 368, 0, 363, 274, 281, 209,
+	280, 371, 0, 140, 218, 0, 0, 220, 168, 185,
+	194, 0, 357, 320, 123, 290, 175, 113, 141, 127,
+	149, 163, 165, 198, 228, 234, 263, 266, 180, 160,
+	138, 287, 136, 306, 326, 327, 328, 330, 232, 156,
+	250, 0, 0, 0, 0, 545, 0, 0, 0, 159,
+	0, 544, 0, 0, 0, 207, 0, 0, 0, 264,
+	0, 307, 145, 217, 215, 335, 169, 162, 158, 143,
+	191, 223, 262, 325, 256, 588, 212, 0, 0, 316,
+	235, 0, 0, 0, 0, 0, 579, 580, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 142, 111, 247,
+	317, 173, 77, 0, 0, 103, 104, 105, 566, 565,
+	568, 569, 570, 571, 0, 0, 133, 567, 139, 572,
+	573, 574, 0, 155, 195, 161, 154, 332, 0, 0,
+	0, 542, 559, 0, 587, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 556, 557, 0, 0, 0, 0,
+	602, 0, 558, 0, 0, 551, 552, 554, 553, 555,
+	560, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 0, 236, 601, 0, 0, 364, 0, 0, 599,
+	0, 0, 0, 0, 0, 206, 0, 203, 107, 121,
+	0, 0, 246, 289, 295, 0, 0, 0, 146, 0,
+	293, 260, 349, 129, 171, 286, 265, 291, 0, 0,
+	292, 213, 337, 278, 347, 365, 366, 153, 240, 355,
+	329, 361, 376, 122, 150, 254, 322, 352, 313, 233,
+	333, 334, 202, 312, 179, 110, 211, 114, 324, 345,
+	134, 303, 0, 0, 0, 116, 343, 321, 230, 199,
+	200, 115, 0, 285, 157, 177, 148, 249, 340, 341,
+	147, 378, 124, 360, 118, 125, 359, 242, 336, 344,
+	231, 222, 117, 342, 229, 221, 205, 167, 187, 276,
+	216, 277, 188, 238, 237, 239, 0, 112, 0, 318,
+	353, 379, 131, 0, 0, 331, 370, 375, 0, 279,
+	132, 178, 166, 275, 176, 208, 369, 372, 374, 373,
+	282, 130, 272, 184, 253, 348, 170, 356, 241, 126,
+	190, 314, 204, 214, 0, 0, 259, 294, 135, 351,
+	315, 589, 600, 595, 596, 593, 594, 0, 592, 591,
+	590, 603, 581, 582, 583, 584, 586, 0, 597, 598,
+	585, 106, 119, 210, 0, 283, 174, 377, 358, 354,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 109, 120, 128, 137,
+	151, 164, 172, 182, 186, 189, 192, 193, 196, 201,
+	219, 224, 225, 226, 227, 243, 244, 245, 248, 251,
+	252, 255, 257, 258, 261, 267, 268, 269, 270, 271,
+	273, 284, 288, 296, 297, 298, 299, 300, 301, 302,
+	308, 309, 310, 311, 319, 323, 338, 339, 350, 362,
+	367, 144, 0, 304, 305, 183, 346, 368, 0, 363,
+	274, 281, 209, 280, 371, 0, 140, 218, 0, 0,
+	220, 168, 185, 194, 0, 357, 320, 123, 290, 175,
+	113, 141, 127, 149, 163, 165, 198, 228, 234, 263,
+	266, 180, 160, 138, 287, 136, 306, 326, 327, 328,
+	330, 232, 156, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 264, 0, 307, 145, 217, 215, 335, 169,
+	162, 158, 143, 191, 223, 262, 325, 256, 588, 212,
+	0, 0, 316, 235, 0, 0, 0, 0, 0, 579,
+	580, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	142, 111, 247, 317, 173, 77, 0, 0, 103, 104,
+	105, 566, 565, 568, 569, 570, 571, 0, 0, 133,
+	567, 139, 572, 573, 574, 0, 155, 195, 161, 154,
+	332, 0, 0, 0, 0, 559, 0, 587, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 556, 557, 0,
+	0, 0, 0, 602, 0, 558, 0, 0, 551, 552,
+	554, 553, 555, 560, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 0, 236, 601, 0, 0, 364,
+	0, 0, 599, 0, 0, 0, 0, 0, 206, 0,
+	203, 107, 121, 0, 0, 246, 289, 295, 0, 0,
+	0, 146, 0, 293, 260, 349, 129, 171, 286, 265,
+	291, 2316, 0, 292, 213, 337, 278, 347, 365, 366,
+	153, 240, 355, 329, 361, 376, 122, 150, 254, 322,
+	352, 313, 233, 333, 334, 202, 312, 179, 110, 211,
+	114, 324, 345, 134, 303, 0, 0, 0, 116, 343,
+	321, 230, 199, 200, 115, 0, 285, 157, 177, 148,
+	249, 340, 341, 147, 378, 124, 360, 118, 125, 359,
+	242, 336, 344, 231, 222, 117, 342, 229, 221, 205,
+	167, 187, 276, 216, 277, 188, 238, 237, 239, 0,
+	112, 0, 318, 353, 379, 131, 0, 0, 331, 370,
+	375, 0, 279, 132, 178, 166, 275, 176, 208, 369,
+	372, 374, 373, 282, 130, 272, 184, 253, 348, 170,
+	356, 241, 126, 190, 314, 204, 214, 0, 0, 259,
+	294, 135, 351, 315, 589, 600, 595, 596, 593, 594,
+	0, 592, 591, 590, 603, 581, 582, 583, 584, 586,
+	0, 597, 598, 585, 106, 119, 210, 0, 283, 174,
+	377, 358, 354, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 108, 109,
+	120, 128, 137, 151, 164, 172, 182, 186, 189, 192,
+	193, 196, 201, 219, 224, 225, 226, 227, 243, 244,
+	245, 248, 251, 252, 255, 257, 258, 261, 267, 268,
+	269, 270, 271, 273, 284, 288, 296, 297, 298, 299,
+	300, 301, 302, 308, 309, 310, 311, 319, 323, 338,
+	339, 350, 362, 367, 144, 0, 304, 305, 183, 346,
+	368, 0, 363, 274, 281, 209, 280, 371, 0, 140,
+	218, 0, 0, 220, 168, 185, 194, 0, 357, 320,
+	123, 290, 175, 113, 141, 127, 149, 163, 165, 198,
+	228, 234, 263, 266, 180, 160, 138, 287, 136, 306,
+	326, 327, 328, 330, 232, 156, 250, 0, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
+	0, 207, 0, 0, 0, 264, 0, 307, 145, 217,
+	215, 335, 169, 162, 158, 143, 191, 223, 262, 325,
+	256, 588, 212, 0, 0, 316, 235, 0, 0, 0,
+	0, 0, 579, 580, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 142, 111, 247, 317, 173, 77, 0,
+	636, 103, 104, 105, 566, 565, 568, 569, 570, 571,
+	0, 0, 133, 567, 139, 572, 573, 574, 0, 155,
+	195, 161, 154, 332, 0, 0, 0, 0, 559, 0,
+	587, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	556, 557, 0, 0, 0, 0, 602, 0, 558, 0,
+	0, 551, 552, 554, 553, 555, 560, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 0, 236, 601,
+	0, 0, 364, 0, 0, 599, 0, 0, 0, 0,
+	0, 206, 0, 203, 107, 121, 0, 0, 246, 289,
+	295, 0, 0, 0, 146, 0, 293, 260, 349, 129,
+	171, 286, 265, 291, 0, 0, 292, 213, 337, 278,
+	347, 365, 366, 153, 240, 355, 329, 361, 376, 122,
+	150, 254, 322, 352, 313, 233, 333, 334, 202, 312,
+	179, 110, 211, 114, 324, 345, 134, 303, 0, 0,
+	0, 116, 343, 321, 230, 199, 200, 115, 0, 285,
+	157, 177, 148, 249, 340, 341, 147, 378, 124, 360,
+	118, 125, 359, 242, 336, 344, 231, 222, 117, 342,
+	229, 221, 205, 167, 187, 276, 216, 277, 188, 238,
+	237, 239, 0, 112, 0, 318, 353, 379, 131, 0,
+	0, 331, 370, 375, 0, 279, 132, 178, 166, 275,
+	176, 208, 369, 372, 374, 373, 282, 130, 272, 184,
+	253, 348, 170, 356, 241, 126, 190, 314, 204, 214,
+	0, 0, 259, 294, 135, 351, 315, 589, 600, 595,
+	596, 593, 594, 0, 592, 591, 590, 603, 581, 582,
+	583, 584, 586, 0, 597, 598, 585, 106, 119, 210,
+	0, 283, 174, 377, 358, 354, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 108, 109, 120, 128, 137, 151, 164, 172, 182,
+	186, 189, 192, 193, 196, 201, 219, 224, 225, 226,
+	227, 243, 244, 245, 248, 251, 252, 255, 257, 258,
+	261, 267, 268, 269, 270, 271, 273, 284, 288, 296,
+	297, 298, 299, 300, 301, 302, 308, 309, 310, 311,
+	319, 323, 338, 339, 350, 362, 367, 144, 0, 304,
+	305, 183, 346, 368, 0, 363, 274, 281, 209, 280,
+	371, 0, 140, 218, 0, 0, 220, 168, 185, 194,
+	0, 357, 320, 123, 290, 175, 113, 141, 127, 149,
+	163, 165, 198, 228, 234, 263, 266, 180, 160, 138,
+	287, 136, 306, 326, 327, 328, 330, 232, 156, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
+	0, 0, 0, 0, 207, 0, 0, 0, 264, 0,
+	307, 145, 217, 215, 335, 169, 162, 158, 143, 191,
+	223, 262, 325, 256, 588, 212, 0, 0, 316, 235,
+	0, 0, 0, 0, 0, 579, 580, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 142, 111, 247, 317,
+	173, 77, 0, 0, 103, 104, 105, 566, 565, 568,
+	569, 570, 571, 0, 0, 133, 567, 139, 572, 573,
+	574, 0, 155, 195, 161, 154, 332, 0, 0, 0,
+	0, 559, 0, 587, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 556, 557, 0, 0, 0, 0, 602,
+	0, 558, 0, 0, 551, 552, 554, 553, 555, 560,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
+	0, 236, 601, 0, 0, 364, 0, 0, 599, 0,
+	0, 0, 0, 0, 206, 0, 203, 107, 121, 0,
+	0, 246, 289, 295, 0, 0, 0, 146, 0, 293,
+	260, 349, 129, 171, 286, 265, 291, 0, 0, 292,
+	213, 337, 278, 347, 365, 366, 153, 240, 355, 329,
+	361, 376, 122, 150, 254, 322, 352, 313, 233, 333,
+	334, 202, 312, 179, 110, 211, 114, 324, 345, 134,
+	303, 0, 0, 0, 116, 343, 321, 230, 199, 200,
+	115, 0, 285, 157, 177, 148, 249, 340, 341, 147,
+	378, 124, 360, 118, 125, 359, 242, 336, 344, 231,
+	222, 117, 342, 229, 221, 205, 167, 187, 276, 216,
+	277, 188, 238, 237, 239, 0, 112, 0, 318, 353,
+	379, 131, 0, 0, 331, 370, 375, 0, 279, 132,
+	178, 166, 275, 176, 208, 369, 372, 374, 373, 282,
+	130, 272, 184, 253, 348, 170, 356, 241, 126, 190,
+	314, 204, 214, 0, 0, 259, 294, 135, 351, 315,
+	589, 600, 595, 596, 593, 594, 0, 592, 591, 590,
+	603, 581, 582, 583, 584, 586, 0, 597, 598, 585,
+	106, 119, 210, 0, 283, 174, 377, 358, 354, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 109, 120, 128, 137, 151,
+	164, 172, 182, 186, 189, 192, 193, 196, 201, 219,
+	224, 225, 226, 227, 243, 244, 245, 248, 251, 252,
+	255, 257, 258, 261, 267, 268, 269, 270, 271, 273,
+	284, 288, 296, 297, 298, 299, 300, 301, 302, 308,
+	309, 310, 311, 319, 323, 338, 339, 350, 362, 367,
+	144, 0, 304, 305, 183, 346, 368, 0, 363, 274,
+	281, 209, 280, 371, 0, 140, 218, 0, 0, 220,
+	168, 185, 194, 0, 357, 320, 123, 290, 175, 113,
+	141, 127, 149, 163, 165, 198, 228, 234, 263, 266,
+	180, 160, 138, 287, 136, 306, 326, 327, 328, 330,
+	232, 156, 250, 0, 0, 0, 0, 0, 0, 0,
+	0, 159, 0, 0, 0, 0, 0, 207, 0, 0,
+	0, 264, 0, 307, 145, 217, 215, 335, 169, 162,
+	158, 143, 191, 223, 262, 325, 256, 0, 212, 0,
+	0, 316, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 197, 142,
+	111, 247, 317, 173, 0, 0, 0, 103, 104, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
+	139, 0, 0, 0, 0, 155, 195, 161, 154, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1025, 1024, 1034, 1035, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1026, 0, 0, 1036, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 0, 236, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 206, 0, 203,
+	107, 121, 0, 0, 246, 289, 295, 0, 0, 0,
+	146, 0, 293, 260, 349, 129, 171, 286, 265, 291,
+	0, 0, 292, 213, 337, 278, 347, 365, 366, 153,
+	240, 355, 329, 361, 376, 122, 150, 254, 322, 352,
+	313, 233, 333, 334, 202, 312, 179, 110, 211, 114,
+	324, 345, 134, 303, 0, 0, 0, 116, 343, 321,
+	230, 199, 200, 115, 0, 285, 157, 177, 148, 249,
+	340, 341, 147, 378, 124, 360, 118, 125, 359, 242,
+	336, 344, 231, 222, 117, 342, 229, 221, 205, 167,
+	187, 276, 216, 277, 188, 238, 237, 239, 0, 112,
+	0, 318, 353, 379, 131, 0, 0, 331, 370, 375,
+	0, 279, 132, 178, 166, 275, 176, 208, 369, 372,
+	374, 373, 282, 130, 272, 184, 253, 348, 170, 356,
+	241, 126, 190, 314, 204, 214, 0, 0, 259, 294,
+	135, 351, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 106, 119, 210, 0, 283, 174, 377,
+	358, 354, 0, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 109, 120,
+	128, 137, 151, 164, 172, 182, 186, 189, 192, 193,
+	196, 201, 219, 224, 225, 226, 227, 243, 244, 245,
+	248, 251, 252, 255, 257, 258, 261, 267, 268, 269,
+	270, 271, 273, 284, 288, 296, 297, 298, 299, 300,
+	301, 302, 308, 309, 310, 311, 319, 323, 338, 339,
+	350, 362, 367, 144, 0, 304, 305, 183, 346, 368,
+	0, 363, 274, 281, 209, 280, 371, 0, 140, 218,
+	0, 0, 220, 168, 185, 194, 0, 357, 320, 123,
+	290, 175, 113, 141, 127, 149, 163, 165, 198, 228,
+	234, 263, 266, 180, 160, 138, 287, 136, 306, 326,
+	327, 328, 330, 232, 156, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 159, 855, 0, 0, 0, 0,
+	207, 0, 0, 0, 264, 0, 307, 145, 217, 215,
+	335, 169, 162, 158, 143, 191, 223, 262, 325, 256,
+	0, 212, 0, 0, 316, 235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 142, 111, 247, 317, 173, 0, 0, 0,
+	103, 104, 105, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 0, 139, 0, 0, 0, 0, 155, 195,
+	161, 154, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 0, 236, 0, 0,
+	854, 364, 0, 0, 0, 0, 0, 0, 851, 852,
+	206, 819, 203, 107, 121, 845, 849, 246, 289, 295,
+	0, 0, 0, 146, 0, 293, 260, 349, 129, 171,
+	286, 265, 291, 0, 0, 292, 213, 337, 278, 347,
+	365, 366, 153, 240, 355, 329, 361, 376, 122, 150,
+	254, 322, 352, 313, 233, 333, 334, 202, 312, 179,
+	110, 211, 114, 324, 345, 134, 303, 0, 0, 0,
+	116, 343, 321, 230, 199, 200, 115, 0, 285, 157,
+	177, 148, 249, 340, 341, 147, 378, 124, 360, 118,
+	125, 359, 242, 336, 344, 231, 222, 117, 342, 229,
+	221, 205, 167, 187, 276, 216, 277, 188, 238, 237,
+	239, 0, 112, 0, 318, 353, 379, 131, 0, 0,
+	331, 370, 375, 0, 279, 132, 178, 166, 275, 176,
+	208, 369, 372, 374, 373, 282, 130, 272, 184, 253,
+	348, 170, 356, 241, 126, 190, 314, 204, 214, 0,
+	0, 259, 294, 135, 351, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 106, 119, 210, 0,
+	283, 174, 377, 358, 354, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	108, 109, 120, 128, 137, 151, 164, 172, 182, 186,
+	189, 192, 193, 196, 201, 219, 224, 225, 226, 227,
+	243, 244, 245, 248, 251, 252, 255, 257, 258, 261,
+	267, 268, 269, 270, 271, 273, 284, 288, 296, 297,
+	298, 299, 300, 301, 302, 308, 309, 310, 311, 319,
+	323, 338, 339, 350, 362, 367, 144, 0, 304, 305,
+	183, 346, 368, 0, 363, 274, 281, 209, 280, 371,
+	0, 140, 218, 0, 0, 220, 168, 185, 194, 0,
+	357, 320, 123, 290, 175, 113, 141, 127, 149, 163,
+	165, 198, 228, 234, 263, 266, 180, 160, 138, 287,
+	136, 306, 326, 327, 328, 330, 232, 156, 250, 0,
+	0, 0, 1149, 0, 0, 0, 0, 159, 0, 0,
+	0, 0, 0, 207, 0, 0, 0, 264, 0, 307,
+	145, 217, 215, 335, 169, 162, 158, 143, 191, 223,
+	262, 325, 256, 0, 212, 0, 0, 316, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 142, 111, 247, 317, 173,
+	0, 0, 0, 103, 104, 105, 0, 1151, 0, 0,
+	0, 0, 0, 0, 133, 0, 139, 0, 0, 0,
+	0, 155, 195, 161, 154, 332, 1014, 1015, 1013, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1016, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
+	236, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 206, 0, 203, 107, 121, 0, 0,
+	246, 289, 295, 0, 0, 0, 146, 0, 293, 260,
+	349, 129, 171, 286, 265, 291, 0, 0, 292, 213,
+	337, 278, 347, 365, 366, 153, 240, 355, 329, 361,
+	376, 122, 150, 254, 322, 352, 313, 233, 333, 334,
+	202, 312, 179, 110, 211, 114, 324, 345, 134, 303,
+	0, 0, 0, 116, 343, 321, 230, 199, 200, 115,
+	0, 285, 157, 177, 148, 249, 340, 341, 147, 378,
+	124, 360, 118, 125, 359, 242, 336, 344, 231, 222,
+	117, 342, 229, 221, 205, 167, 187, 276, 216, 277,
+	188, 238, 237, 239, 0, 112, 0, 318, 353, 379,
+	131, 0, 0, 331, 370, 375, 0, 279, 132, 178,
+	166, 275, 176, 208, 369, 372, 374, 373, 282, 130,
+	272, 184, 253, 348, 170, 356, 241, 126, 190, 314,
+	204, 214, 0, 0, 259, 294, 135, 351, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 106,
+	119, 210, 0, 283, 174, 377, 358, 354, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 108, 109, 120, 128, 137, 151, 164,
+	172, 182, 186, 189, 192, 193, 196, 201, 219, 224,
+	225, 226, 227, 243, 244, 245, 248, 251, 252, 255,
+	257, 258, 261, 267, 268, 269, 270, 271, 273, 284,
+	288, 296, 297, 298, 299, 300, 301, 302, 308, 309,
+	310, 311, 319, 323, 338, 339, 350, 362, 367, 144,
+	0, 304, 305, 183, 346, 368, 0, 363, 274, 281,
+	209, 280, 371, 0, 140, 218, 0, 0, 220, 168,
+	185, 194, 0, 357, 320, 123, 290, 175, 113, 141,
+	127, 149, 163, 165, 198, 228, 234, 263, 266, 180,
+	160, 138, 287, 136, 306, 326, 327, 328, 330, 232,
+	156, 37, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 159, 0, 0, 0, 0, 0, 207,
+	0, 0, 0, 264, 0, 307, 145, 217, 215, 335,
+	169, 162, 158, 143, 191, 223, 262, 325, 256, 0,
+	212, 0, 0, 316, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 142, 111, 247, 317, 173, 77, 0, 636, 103,
+	104, 105, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 0, 139, 0, 0, 0, 0, 155, 195, 161,
+	154, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 181, 0, 236, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 206,
+	0, 203, 107, 121, 0, 0, 246, 289, 295, 0,
+	0, 0, 146, 0, 293, 260, 349, 129, 171, 286,
+	265, 291, 0, 0, 292, 213, 337, 278, 347, 365,
+	366, 153, 240, 355, 329, 361, 376, 122, 150, 254,
+	322, 352, 313, 233, 333, 334, 202, 312, 179, 110,
+	211, 114, 324, 345, 134, 303, 0, 0, 0, 116,
+	343, 321, 230, 199, 200, 115, 0, 285, 157, 177,
+	148, 249, 340, 341, 147, 378, 124, 360, 118, 125,
+	359, 242, 336, 344, 231, 222, 117, 342, 229, 221,
+	205, 167, 187, 276, 216, 277, 188, 238, 237, 239,
+	0, 112, 0, 318, 353, 379, 131, 0, 0, 331,
+	370, 375, 0, 279, 132, 178, 166, 275, 176, 208,
+	369, 372, 374, 373, 282, 130, 272, 184, 253, 348,
+	170, 356, 241, 126, 190, 314, 204, 214, 0, 0,
+	259, 294, 135, 351, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 106, 119, 210, 0, 283,
+	174, 377, 358, 354, 0, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 108,
+	109, 120, 128, 137, 151, 164, 172, 182, 186, 189,
+	192, 193, 196, 201, 219, 224, 225, 226, 227, 243,
+	244, 245, 248, 251, 252, 255, 257, 258, 261, 267,
+	268, 269, 270, 271, 273, 284, 288, 296, 297, 298,
+	299, 300, 301, 302, 308, 309, 310, 311, 319, 323,
+	338, 339, 350, 362, 367, 144, 0, 304, 305, 183,
+	346, 368, 0, 363, 274, 281, 209, 280, 371, 0,
+	140, 218, 0, 0, 220, 168, 185, 194, 0, 357,
+	320, 123, 290, 175, 113, 141, 127, 149, 163, 165,
+	198, 228, 234, 263, 266, 180, 160, 138, 287, 136,
+	306, 326, 327, 328, 330, 232, 156, 37, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 0, 0, 207, 0, 0, 0, 264,
+	0, 307, 145, 217, 215, 335, 169, 162, 158, 143,
+	191, 223, 262, 325, 256, 0, 212, 0, 0, 316,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 142, 111, 247,
+	317, 173, 77, 0, 0, 103, 104, 105, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 0, 139, 0,
+	0, 0, 0, 155, 195, 161, 154, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 0, 236, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 206, 0, 203, 107, 121,
+	0, 0, 246, 289, 295, 0, 0, 0, 146, 0,
+	293, 260, 349, 129, 171, 286, 265, 291, 0, 0,
+	292, 213, 337, 278, 347, 365, 366, 153, 240, 355,
+	329, 361, 376, 122, 150, 254, 322, 352, 313, 233,
+	333, 334, 202, 312, 179, 110, 211, 114, 324, 345,
+	134, 303, 0, 0, 0, 116, 343, 321, 230, 199,
+	200, 115, 0, 285, 157, 177, 148, 249, 340, 341,
+	147, 378, 124, 360, 118, 125, 359, 242, 336, 344,
+	231, 222, 117, 342, 229, 221, 205, 167, 187, 276,
+	216, 277, 188, 238, 237, 239, 0, 112, 0, 318,
+	353, 379, 131, 0, 0, 331, 370, 375, 0, 279,
+	132, 178, 166, 275, 176, 208, 369, 372, 374, 373,
+	282, 130, 272, 184, 253, 348, 170, 356, 241, 126,
+	190, 314, 204, 214, 0, 0, 259, 294, 135, 351,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 106, 119, 210, 0, 283, 174, 377, 358, 354,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 1165,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 109, 120, 128, 137,
+	151, 164, 172, 182, 186, 189, 192, 193, 196, 201,
+	219, 224, 225, 226, 227, 243, 244, 245, 248, 251,
+	252, 255, 257, 258, 261, 267, 268, 269, 270, 271,
+	273, 284, 288, 296, 297, 298, 299, 300, 301, 302,
+	308, 309, 310, 311, 319, 323, 338, 339, 350, 362,
+	367, 144, 0, 304, 305, 183, 346, 368, 0, 363,
+	274, 281, 209, 280, 371, 0, 140, 218, 0, 0,
+	220, 168, 185, 194, 0, 357, 320, 123, 290, 175,
+	113, 141, 127, 149, 163, 165, 198, 228, 234, 263,
+	266, 180, 160, 138, 287, 136, 306, 326, 327, 328,
+	330, 232, 156, 250, 0, 0, 0, 1529, 0, 0,
+	0, 0, 159, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 264, 0, 307, 145, 217, 215, 335, 169,
+	162, 158, 143, 191, 223, 262, 325, 256, 0, 212,
+	0, 0, 316, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	142, 111, 247, 317, 173, 0, 0, 0, 103, 104,
+	105, 0, 1531, 0, 0, 0, 0, 0, 0, 133,
+	0, 139, 0, 0, 0, 0, 155, 195, 161, 154,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 0, 236, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 0, 0, 0, 206, 0,
+	203, 107, 121, 0, 0, 246, 289, 295, 0, 0,
+	0, 146, 0, 293, 260, 349, 129, 171, 286, 265,
+	291, 0, 1527, 292, 213, 337, 278, 347, 365, 366,
+	153, 240, 355, 329, 361, 376, 122, 150, 254, 322,
+	352, 313, 233, 333, 334, 202, 312, 179, 110, 211,
+	114, 324, 345, 134, 303, 0, 0, 0, 116, 343,
+	321, 230, 199, 200, 115, 0, 285, 157, 177, 148,
+	249, 340, 341, 147, 378, 124, 360, 118, 125, 359,
+	242, 336, 344, 231, 222, 117, 342, 229, 221, 205,
+	167, 187, 276, 216, 277, 188, 238, 237, 239, 0,
+	112, 0, 318, 353, 379, 131, 0, 0, 331, 370,
+	375, 0, 279, 132, 178, 166, 275, 176, 208, 369,
+	372, 374, 373, 282, 130, 272, 184, 253, 348, 170,
+	356, 241, 126, 190, 314, 204, 214, 0, 0, 259,
+	294, 135, 351, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 106, 119, 210, 0, 283, 174,
+	377, 358, 354, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 108, 109,
+	120, 128, 137, 151, 164, 172, 182, 186, 189, 192,
+	193, 196, 201, 219, 224, 225, 226, 227, 243, 244,
+	245, 248, 251, 252, 255, 257, 258, 261, 267, 268,
+	269, 270, 271, 273, 284, 288, 296, 297, 298, 299,
+	300, 301, 302, 308, 309, 310, 311, 319, 323, 338,
+	339, 350, 362, 367, 144, 0, 304, 305, 183, 346,
+	368, 0, 363, 274, 281, 209, 280, 371, 0, 140,
+	218, 0, 0, 220, 168, 185, 194, 0, 357, 320,
+	123, 290, 175, 113, 141, 127, 149, 163, 165, 198,
+	228, 234, 263, 266, 180, 160, 138, 287, 136, 306,
+	326, 327, 328, 330, 232, 156, 250, 0, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
+	0, 207, 0, 0, 0, 264, 0, 307, 145, 217,
+	215, 335, 169, 162, 158, 143, 191, 223, 262, 325,
+	256, 0, 212, 0, 0, 316, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 142, 111, 247, 317, 173, 0, 0,
+	0, 103, 104, 105, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 0, 139, 0, 0, 0, 0, 155,
+	195, 161, 154, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 813, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 0, 236, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 0, 0,
+	0, 206, 819, 203, 107, 121, 817, 0, 246, 289,
+	295, 0, 0, 0, 146, 0, 293, 260, 349, 129,
+	171, 286, 265, 291, 0, 0, 292, 213, 337, 278,
+	347, 365, 366, 153, 240, 355, 329, 361, 376, 122,
+	150, 254, 322, 352, 313, 233, 333, 334, 202, 312,
+	179, 110, 211, 114, 324, 345, 134, 303, 0, 0,
+	0, 116, 343, 321, 230, 199, 200, 115, 0, 285,
+	157, 177, 148, 249, 340, 341, 147, 378, 124, 360,
+	118, 125, 359, 242, 336, 344, 231, 222, 117, 342,
+	229, 221, 205, 167, 187, 276, 216, 277, 188, 238,
+	237, 239, 0, 112, 0, 318, 353, 379, 131, 0,
+	0, 331, 370, 375, 0, 279, 132, 178, 166, 275,
+	176, 208, 369, 372, 374, 373, 282, 130, 272, 184,
+	253, 348, 170, 356, 241, 126, 190, 314, 204, 214,
+	0, 0, 259, 294, 135, 351, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 106, 119, 210,
+	0, 283, 174, 377, 358, 354, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 108, 109, 120, 128, 137, 151, 164, 172, 182,
+	186, 189, 192, 193, 196, 201, 219, 224, 225, 226,
+	227, 243, 244, 245, 248, 251, 252, 255, 257, 258,
+	261, 267, 268, 269, 270, 271, 273, 284, 288, 296,
+	297, 298, 299, 300, 301, 302, 308, 309, 310, 311,
+	319, 323, 338, 339, 350, 362, 367, 144, 0, 304,
+	305, 183, 346, 368, 0, 363, 274, 281, 209, 280,
+	371, 0, 140, 218, 0, 0, 220, 168, 185, 194,
+	0, 357, 320, 123, 290, 175, 113, 141, 127, 149,
+	163, 165, 198, 228, 234, 263, 266, 180, 160, 138,
+	287, 136, 306, 326, 327, 328, 330, 232, 156, 250,
+	0, 0, 0, 1529, 0, 0, 0, 0, 159, 0,
+	0, 0, 0, 0, 207, 0, 0, 0, 264, 0,
+	307, 145, 217, 215, 335, 169, 162, 158, 143, 191,
+	223, 262, 325, 256, 0, 212, 0, 0, 316, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 142, 111, 247, 317,
+	173, 0, 0, 0, 103, 104, 105, 0, 1531, 0,
+	0, 0, 0, 0, 0, 133, 0, 139, 0, 0,
+	0, 0, 155, 195, 161, 154, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
+	0, 236, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 206, 0, 203, 107, 121, 0,
+	0, 246, 289, 295, 0, 0, 0, 146, 0, 293,
+	260, 349, 129, 171, 286, 265, 291, 0, 0, 292,
+	213, 337, 278, 347, 365, 366, 153, 240, 355, 329,
+	361, 376, 122, 150, 254, 322, 352, 313, 233, 333,
+	334, 202, 312, 179, 110, 211, 114, 324, 345, 134,
+	303, 0, 0, 0, 116, 343, 321, 230, 199, 200,
+	115, 0, 285, 157, 177, 148, 249, 340, 341, 147,
+	378, 124, 360, 118, 125, 359, 242, 336, 344, 231,
+	222, 117, 342, 229, 221, 205, 167, 187, 276, 216,
+	277, 188, 238, 237, 239, 0, 112, 0, 318, 353,
+	379, 131, 0, 0, 331, 370, 375, 0, 279, 132,
+	178, 166, 275, 176, 208, 369, 372, 374, 373, 282,
+	130, 272, 184, 253, 348, 170, 356, 241, 126, 190,
+	314, 204, 214, 0, 0, 259, 294, 135, 351, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	106, 119, 210, 0, 283, 174, 377, 358, 354, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 109, 120, 128, 137, 151,
+	164, 172, 182, 186, 189, 192, 193, 196, 201, 219,
+	224, 225, 226, 227, 243, 244, 245, 248, 251, 252,
+	255, 257, 258, 261, 267, 268, 269, 270, 271, 273,
+	284, 288, 296, 297, 298, 299, 300, 301, 302, 308,
+	309, 310, 311, 319, 323, 338, 339, 350, 362, 367,
+	144, 0, 304, 305, 183, 346, 368, 0, 363, 274,
+	281, 209, 280, 371, 0, 140, 218, 0, 0, 220,
+	168, 185, 194, 0, 357, 320, 123, 290, 175, 113,
+	141, 127, 149, 163, 165, 198, 228, 234, 263, 266,
+	180, 160, 138, 287, 136, 306, 326, 327, 328, 330,
+	232, 156, 250, 0, 0, 0, 0, 0, 0, 0,
+	0, 159, 0, 0, 0, 0, 0, 207, 0, 0,
+	0, 264, 0, 307, 145, 217, 215, 335, 169, 162,
+	158, 143, 191, 223, 262, 325, 256, 0, 212, 0,
+	0, 316, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 197, 142,
+	111, 247, 317, 173, 77, 0, 0, 103, 104, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
+	139, 0, 0, 0, 0, 155, 195, 161, 154, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 0, 236, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 206, 0, 203,
+	107, 121, 0, 0, 246, 289, 295, 0, 0, 0,
+	146, 0, 293, 260, 349, 129, 171, 286, 265, 291,
+	0, 0, 292, 213, 337, 278, 347, 365, 366, 153,
+	240, 355, 329, 361, 376, 122, 150, 254, 322, 352,
+	313, 233, 333, 334, 202, 312, 179, 110, 211, 114,
+	324, 345, 134, 303, 0, 0, 0, 116, 343, 321,
+	230, 199, 200, 115, 0, 285, 157, 177, 148, 249,
+	340, 341, 147, 378, 124, 360, 118, 125, 359, 242,
+	336, 344, 231, 222, 117, 342, 229, 221, 205, 167,
+	187, 276, 216, 277, 188, 238, 237, 239, 0, 112,
+	0, 318, 353, 379, 131, 0, 0, 331, 370, 375,
+	0, 279, 132, 178, 166, 275, 176, 208, 369, 372,
+	374, 373, 282, 130, 272, 184, 253, 348, 170, 356,
+	241, 126, 190, 314, 204, 214, 0, 0, 259, 294,
+	135, 351, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 106, 119, 210, 0, 283, 174, 377,
+	358, 354, 0, 0, 152, 0, 0, 0, 0, 0,
+	0, 1165, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 109, 120,
+	128, 137, 151, 164, 172, 182, 186, 189, 192, 193,
+	196, 201, 219, 224, 225, 226, 227, 243, 244, 245,
+	248, 251, 252, 255, 257, 258, 261, 267, 268, 269,
+	270, 271, 273, 284, 288, 296, 297, 298, 299, 300,
+	301, 302, 308, 309, 310, 311, 319, 323, 338, 339,
+	350, 362, 367, 144, 0, 304, 305, 183, 346, 368,
+	0, 363, 274, 281, 209, 280, 371, 0, 140, 218,
+	0, 0, 220, 168, 185, 194, 0, 357, 320, 123,
+	290, 175, 113, 141, 127, 149, 163, 165, 198, 228,
+	234, 263, 266, 180, 160, 138, 287, 136, 306, 326,
+	327, 328, 330, 232, 156, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 159, 0, 0, 0, 0, 0,
+	207, 0, 0, 0, 264, 0, 307, 145, 217, 215,
+	335, 169, 162, 158, 143, 191, 223, 262, 325, 256,
+	0, 212, 0, 0, 316, 235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 142, 111, 247, 317, 173, 0, 0, 0,
+	103, 104, 105, 0, 0, 1560, 0, 0, 1561, 0,
+	0, 133, 0, 139, 0, 0, 0, 0, 155, 195,
+	161, 154, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 0, 236, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	206, 0, 203, 107, 121, 0, 0, 246, 289, 295,
+	0, 0, 0, 146, 0, 293, 260, 349, 129, 171,
+	286, 265, 291, 0, 0, 292, 213, 337, 278, 347,
+	365, 366, 153, 240, 355, 329, 361, 376, 122, 150,
+	254, 322, 352, 313, 233, 333, 334, 202, 312, 179,
+	110, 211, 114, 324, 345, 134, 303, 0, 0, 0,
+	116, 343, 321, 230, 199, 200, 115, 0, 285, 157,
+	177, 148, 249, 340, 341, 147, 378, 124, 360, 118,
+	125, 359, 242, 336, 344, 231, 222, 117, 342, 229,
+	221, 205, 167, 187, 276, 216, 277, 188, 238, 237,
+	239, 0, 112, 0, 318, 353, 379, 131, 0, 0,
+	331, 370, 375, 0, 279, 132, 178, 166, 275, 176,
+	208, 369, 372, 374, 373, 282, 130, 272, 184, 253,
+	348, 170, 356, 241, 126, 190, 314, 204, 214, 0,
+	0, 259, 294, 135, 351, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 106, 119, 210, 0,
+	283, 174, 377, 358, 354, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	108, 109, 120, 128, 137, 151, 164, 172, 182, 186,
+	189, 192, 193, 196, 201, 219, 224, 225, 226, 227,
+	243, 244, 245, 248, 251, 252, 255, 257, 258, 261,
+	267, 268, 269, 270, 271, 273, 284, 288, 296, 297,
+	298, 299, 300, 301, 302, 308, 309, 310, 311, 319,
+	323, 338, 339, 350, 362, 367, 144, 0, 304, 305,
+	183, 346, 368, 0, 363, 274, 281, 209, 280, 371,
+	0, 140, 218, 0, 0, 220, 168, 185, 194, 0,
+	357, 320, 123, 290, 175, 113, 141, 127, 149, 163,
+	165, 198, 228, 234, 263, 266, 180, 160, 138, 287,
+	136, 306, 326, 327, 328, 330, 232, 156, 250, 0,
+	0, 0, 0, 0, 0, 0, 0, 159, 0, 1184,
+	0, 0, 0, 207, 0, 0, 0, 264, 0, 307,
+	145, 217, 215, 335, 169, 162, 158, 143, 191, 223,
+	262, 325, 256, 0, 212, 0, 0, 316, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 142, 111, 247, 317, 173,
+	0, 0, 0, 103, 104, 105, 0, 1183, 0, 0,
+	0, 0, 0, 0, 133, 0, 139, 0, 0, 0,
+	0, 155, 195, 161, 154, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
+	236, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 206, 0, 203, 107, 121, 0, 0,
+	246, 289, 295, 0, 0, 0, 146, 0, 293, 260,
+	349, 129, 171, 286, 265, 291, 0, 0, 292, 213,
+	337, 278, 347, 365, 366, 153, 240, 355, 329, 361,
+	376, 122, 150, 254, 322, 352, 313, 233, 333, 334,
+	202, 312, 179, 110, 211, 114, 324, 345, 134, 303,
+	0, 0, 0, 116, 343, 321, 230, 199, 200, 115,
+	0, 285, 157, 177, 148, 249, 340, 341, 147, 378,
+	124, 360, 118, 125, 359, 242, 336, 344, 231, 222,
+	117, 342, 229, 221, 205, 167, 187, 276, 216, 277,
+	188, 238, 237, 239, 0, 112, 0, 318, 353, 379,
+	131, 0, 0, 331, 370, 375, 0, 279, 132, 178,
+	166, 275, 176, 208, 369, 372, 374, 373, 282, 130,
+	272, 184, 253, 348, 170, 356, 241, 126, 190, 314,
+	204, 214, 0, 0, 259, 294, 135, 351, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 106,
+	119, 210, 0, 283, 174, 377, 358, 354, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 108, 109, 120, 128, 137, 151, 164,
+	172, 182, 186, 189, 192, 193, 196, 201, 219, 224,
+	225, 226, 227, 243, 244, 245, 248, 251, 252, 255,
+	257, 258, 261, 267, 268, 269, 270, 271, 273, 284,
+	288, 296, 297, 298, 299, 300, 301, 302, 308, 309,
+	310, 311, 319, 323, 338, 339, 350, 362, 367, 144,
+	0, 304, 305, 183, 346, 368, 0, 363, 274, 281,
+	209, 280, 371, 0, 140, 218, 0, 0, 220, 168,
+	185, 194, 0, 357, 320, 123, 290, 175, 113, 141,
+	127, 149, 163, 165, 198, 228, 234, 263, 266, 180,
+	160, 138, 287, 136, 306, 326, 327, 328, 330, 232,
+	156, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	159, 0, 0, 0, 0, 0, 207, 0, 0, 0,
+	264, 0, 307, 145, 217, 215, 335, 169, 162, 158,
+	143, 191, 223, 262, 325, 256, 0, 212, 0, 0,
+	316, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 197, 142, 111,
+	247, 317, 173, 0, 0, 636, 103, 104, 105, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 0, 139,
+	0, 0, 0, 0, 155, 195, 161, 154, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 0, 236, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 206, 0, 203, 107,
+	121, 0, 0, 246, 289, 295, 0, 0, 0, 146,
+	0, 293, 260, 349, 129, 171, 286, 265, 291, 0,
+	0, 292, 213, 337, 278, 347, 365, 366, 153, 240,
+	355, 329, 361, 376, 122, 150, 254, 322, 352, 313,
+	233, 333, 334, 202, 312, 179, 110, 211, 114, 324,
+	345, 134, 303, 0, 0, 0, 116, 343, 321, 230,
+	199, 200, 115, 0, 285, 157, 177, 148, 249, 340,
+	341, 147, 378, 124, 360, 118, 125, 359, 242, 336,
+	344, 231, 222, 117, 342, 229, 221, 205, 167, 187,
+	276, 216, 277, 188, 238, 237, 239, 0, 112, 0,
+	318, 353, 379, 131, 0, 0, 331, 370, 375, 0,
+	279, 132, 178, 166, 275, 176, 208, 369, 372, 374,
+	373, 282, 130, 272, 184, 253, 348, 170, 356, 241,
+	126, 190, 314, 204, 214, 0, 0, 259, 294, 135,
+	351, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 106, 119, 210, 0, 283, 174, 377, 358,
+	354, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 109, 120, 128,
+	137, 151, 164, 172, 182, 186, 189, 192, 193, 196,
+	201, 219, 224, 225, 226, 227, 243, 244, 245, 248,
+	251, 252, 255, 257, 258, 261, 267, 268, 269, 270,
+	271, 273, 284, 288, 296, 297, 298, 299, 300, 301,
+	302, 308, 309, 310, 311, 319, 323, 338, 339, 350,
+	362, 367, 144, 0, 304, 305, 183, 346, 368, 0,
+	363, 274, 281, 209, 280, 371, 0, 140, 218, 0,
+	0, 220, 168, 185, 194, 0, 357, 320, 123, 290,
+	175, 113, 141, 127, 149, 163, 165, 198, 228, 234,
+	263, 266, 180, 160, 138, 287, 136, 306, 326, 327,
+	328, 330, 232, 156, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 159, 0, 0, 0, 0, 0, 207,
+	0, 0, 0, 264, 0, 307, 145, 217, 215, 335,
+	169, 162, 158, 143, 191, 223, 262, 325, 256, 0,
+	212, 0, 0, 316, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 142, 111, 247, 317, 173, 77, 0, 0, 103,
+	104, 105, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 0, 139, 0, 0, 0, 0, 155, 195, 161,
+	154, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 181, 0, 236, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 206,
+	0, 203, 107, 121, 0, 0, 246, 289, 295, 0,
+	0, 0, 146, 0, 293, 260, 349, 129, 171, 286,
+	265, 291, 0, 0, 292, 213, 337, 278, 347, 365,
+	366, 153, 240, 355, 329, 361, 376, 122, 150, 254,
+	322, 352, 313, 233, 333, 334, 202, 312, 179, 110,
+	211, 114, 324, 345, 134, 303, 0, 0, 0, 116,
+	343, 321, 230, 199, 200, 115, 0, 285, 157, 177,
+	148, 249, 340, 341, 147, 378, 124, 360, 118, 125,
+	359, 242, 336, 344, 231, 222, 117, 342, 229, 221,
+	205, 167, 187, 276, 216, 277, 188, 238, 237, 239,
+	0, 112, 0, 318, 353, 379, 131, 0, 0, 331,
+	370, 375, 0, 279, 132, 178, 166, 275, 176, 208,
+	369, 372, 374, 373, 282, 130, 272, 184, 253, 348,
+	170, 356, 241, 126, 190, 314, 204, 214, 0, 0,
+	259, 294, 135, 351, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 106, 119, 210, 0, 283,
+	174, 377, 358, 354, 0, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 108,
+	109, 120, 128, 137, 151, 164, 172, 182, 186, 189,
+	192, 193, 196, 201, 219, 224, 225, 226, 227, 243,
+	244, 245, 248, 251, 252, 255, 257, 258, 261, 267,
+	268, 269, 270, 271, 273, 284, 288, 296, 297, 298,
+	299, 300, 301, 302, 308, 309, 310, 311, 319, 323,
+	338, 339, 350, 362, 367, 144, 0, 304, 305, 183,
+	346, 368, 0, 363, 274, 281, 209, 280, 371, 0,
+	140, 218, 0, 0, 220, 168, 185, 194, 0, 357,
+	320, 123, 290, 175, 113, 141, 127, 149, 163, 165,
+	198, 228, 234, 263, 266, 180, 160, 138, 287, 136,
+	306, 326, 327, 328, 330, 232, 156, 250, 0, 0,
+	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 264, 0, 307, 145,
+	217, 215, 335, 169, 162, 158, 143, 191, 223, 262,
+	325, 256, 0, 212, 0, 0, 316, 235, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 142, 111, 247, 317, 173, 0,
+	0, 0, 103, 104, 105, 0, 1531, 0, 0, 0,
+	0, 0, 0, 133, 0, 139, 0, 0, 0, 0,
+	155, 195, 161, 154, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 0, 236,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 206, 0, 203, 107, 121, 0, 0, 246,
+	289, 295, 0, 0, 0, 146, 0, 293, 260, 349,
+	129, 171, 286, 265, 291, 0, 0, 292, 213, 337,
+	278, 347, 365, 366, 153, 240, 355, 329, 361, 376,
+	122, 150, 254, 322, 352, 313, 233, 333, 334, 202,
+	312, 179, 110, 211, 114, 324, 345, 134, 303, 0,
+	0, 0, 116, 343, 321, 230, 199, 200, 115, 0,
+	285, 157, 177, 148, 249, 340, 341, 147, 378, 124,
+	360, 118, 125, 359, 242, 336, 344, 231, 222, 117,
+	342, 229, 221, 205, 167, 187, 276, 216, 277, 188,
+	238, 237, 239, 0, 112, 0, 318, 353, 379, 131,
+	0, 0, 331, 370, 375, 0, 279, 132, 178, 166,
+	275, 176, 208, 369, 372, 374, 373, 282, 130, 272,
+	184, 253, 348, 170, 356, 241, 126, 190, 314, 204,
+	214, 0, 0, 259, 294, 135, 351, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 106, 119,
+	210, 0, 283, 174, 377, 358, 354, 0, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 109, 120, 128, 137, 151, 164, 172,
+	182, 186, 189, 192, 193, 196, 201, 219, 224, 225,
+	226, 227, 243, 244, 245, 248, 251, 252, 255, 257,
+	258, 261, 267, 268, 269, 270, 271, 273, 284, 288,
+	296, 297, 298, 299, 300, 301, 302, 308, 309, 310,
+	311, 319, 323, 338, 339, 350, 362, 367, 144, 0,
+	304, 305, 183, 346, 368, 0, 363, 274, 281, 209,
+	280, 371, 0, 140, 218, 0, 0, 220, 168, 185,
+	194, 0, 357, 320, 123, 290, 175, 113, 141, 127,
+	149, 163, 165, 198, 228, 234, 263, 266, 180, 160,
+	138, 287, 136, 306, 326, 327, 328, 330, 232, 156,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 0, 0, 207, 0, 0, 0, 264,
+	0, 307, 145, 217, 215, 335, 169, 162, 158, 143,
+	191, 223, 262, 325, 256, 0, 212, 0, 0, 316,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 142, 111, 247,
+	317, 173, 0, 0, 0, 103, 104, 105, 0, 1151,
+	0, 0, 0, 0, 0, 0, 133, 0, 139, 0,
+	0, 0, 0, 155, 195, 161, 154, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 0, 236, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 206, 0, 203, 107, 121,
+	0, 0, 246, 289, 295, 0, 0, 0, 146, 0,
+	293, 260, 349, 129, 171, 286, 265, 291, 0, 0,
+	292, 213, 337, 278, 347, 365, 366, 153, 240, 355,
+	329, 361, 376, 122, 150, 254, 322, 352, 313, 233,
+	333, 334, 202, 312, 179, 110, 211, 114, 324, 345,
+	134, 303, 0, 0, 0, 116, 343, 321, 230, 199,
+	200, 115, 0, 285, 157, 177, 148, 249, 340, 341,
+	147, 378, 124, 360, 118, 125, 359, 242, 336, 344,
+	231, 222, 117, 342, 229, 221, 205, 167, 187, 276,
+	216, 277, 188, 238, 237, 239, 0, 112, 0, 318,
+	353, 379, 131, 0, 0, 331, 370, 375, 0, 279,
+	132, 178, 166, 275, 176, 208, 369, 372, 374, 373,
+	282, 130, 272, 184, 253, 348, 170, 356, 241, 126,
+	190, 314, 204, 214, 0, 0, 259, 294, 135, 351,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 106, 119, 210, 0, 283, 174, 377, 358, 354,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 109, 120, 128, 137,
+	151, 164, 172, 182, 186, 189, 192, 193, 196, 201,
+	219, 224, 225, 226, 227, 243, 244, 245, 248, 251,
+	252, 255, 257, 258, 261, 267, 268, 269, 270, 271,
+	273, 284, 288, 296, 297, 298, 299, 300, 301, 302,
+	308, 309, 310, 311, 319, 323, 338, 339, 350, 362,
+	367, 144, 0, 304, 305, 183, 346, 368, 0, 363,
+	274, 281, 209, 280, 371, 0, 140, 218, 0, 0,
+	220, 168, 185, 194, 0, 357, 320, 123, 290, 175,
+	113, 141, 127, 149, 163, 165, 198, 228, 234, 263,
+	266, 180, 160, 138, 287, 136, 306, 326, 327, 328,
+	330, 232, 156, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 264, 0, 307, 145, 217, 215, 335, 169,
+	162, 158, 143, 191, 223, 262, 325, 256, 0, 212,
+	0, 0, 316, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	142, 111, 247, 317, 173, 0, 0, 0, 103, 104,
+	105, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	0, 139, 0, 0, 0, 0, 155, 195, 161, 154,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 0, 236, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 0, 0, 0, 206, 0,
+	203, 107, 121, 0, 0, 246, 289, 295, 0, 0,
+	0, 146, 0, 293, 260, 349, 129, 171, 286, 265,
+	291, 0, 0, 292, 213, 337, 278, 347, 365, 366,
+	153, 240, 355, 329, 361, 376, 122, 150, 254, 322,
+	352, 313, 233, 333, 334, 202, 312, 179, 110, 211,
+	114, 324, 345, 134, 303, 0, 0, 0, 116, 343,
+	321, 230, 199, 200, 115, 0, 285, 157, 177, 148,
+	249, 340, 341, 147, 378, 124, 360, 118, 125, 359,
+	242, 336, 344, 231, 222, 117, 342, 229, 221, 205,
+	167, 187, 276, 216, 277, 188, 238, 237, 239, 0,
+	112, 0, 318, 353, 379, 131, 0, 0, 331, 370,
+	375, 0, 279, 132, 178, 166, 275, 176, 208, 369,
+	372, 374, 373, 282, 130, 272, 184, 253, 348, 170,
+	356, 241, 126, 190, 314, 204, 214, 0, 0, 259,
+	294, 135, 351, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 106, 119, 210, 1425, 283, 174,
+	377, 358, 354, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 108, 109,
+	120, 128, 137, 151, 164, 172, 182, 186, 189, 192,
+	193, 196, 201, 219, 224, 225, 226, 227, 243, 244,
+	245, 248, 251, 252, 255, 257, 258, 261, 267, 268,
+	269, 270, 271, 273, 284, 288, 296, 297, 298, 299,
+	300, 301, 302, 308, 309, 310, 311, 319, 323, 338,
+	339, 350, 362, 367, 144, 0, 304, 305, 183, 346,
+	368, 0, 363, 274, 281, 209, 280, 371, 0, 140,
+	218, 0, 0, 220, 168, 185, 194, 0, 357, 320,
+	123, 290, 175, 113, 141, 127, 149, 163, 165, 198,
+	228, 234, 263, 266, 180, 160, 138, 287, 136, 306,
+	326, 327, 328, 330, 232, 156, 250, 0, 1308, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
+	0, 207, 0, 0, 0, 264, 0, 307, 145, 217,
+	215, 335, 169, 162, 158, 143, 191, 223, 262, 325,
+	256, 0, 212, 0, 0, 316, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 142, 111, 247, 317, 173, 0, 0,
+	0, 103, 104, 105, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 0, 139, 0, 0, 0, 0, 155,
+	195, 161, 154, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 0, 236, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 0, 0,
+	0, 206, 0, 203, 107, 121, 0, 0, 246, 289,
+	295, 0, 0, 0, 146, 0, 293, 260, 349, 129,
+	171, 286, 265, 291, 0, 0, 292, 213, 337, 278,
+	347, 365, 366, 153, 240, 355, 329, 361, 376, 122,
+	150, 254, 322, 352, 313, 233, 333, 334, 202, 312,
+	179, 110, 211, 114, 324, 345, 134, 303, 0, 0,
+	0, 116, 343, 321, 230, 199, 200, 115, 0, 285,
+	157, 177, 148, 249, 340, 341, 147, 378, 124, 360,
+	118, 125, 359, 242, 336, 344, 231, 222, 117, 342,
+	229, 221, 205, 167, 187, 276, 216, 277, 188, 238,
+	237, 239, 0, 112, 0, 318, 353, 379, 131, 0,
+	0, 331, 370, 375, 0, 279, 132, 178, 166, 275,
+	176, 208, 369, 372, 374, 373, 282, 130, 272, 184,
+	253, 348, 170, 356, 241, 126, 190, 314, 204, 214,
+	0, 0, 259, 294, 135, 351, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 106, 119, 210,
+	0, 283, 174, 377, 358, 354, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 108, 109, 120, 128, 137, 151, 164, 172, 182,
+	186, 189, 192, 193, 196, 201, 219, 224, 225, 226,
+	227, 243, 244, 245, 248, 251, 252, 255, 257, 258,
+	261, 267, 268, 269, 270, 271, 273, 284, 288, 296,
+	297, 298, 299, 300, 301, 302, 308, 309, 310, 311,
+	319, 323, 338, 339, 350, 362, 367, 144, 0, 304,
+	305, 183, 346, 368, 0, 363, 274, 281, 209, 280,
+	371, 0, 140, 218, 0, 0, 220, 168, 185, 194,
+	0, 357, 320, 123, 290, 175, 113, 141, 127, 149,
+	163, 165, 198, 228, 234, 263, 266, 180, 160, 138,
+	287, 136, 306, 326, 327, 328, 330, 232, 156, 250,
+	0, 1306, 0, 0, 0, 0, 0, 0, 159, 0,
+	0, 0, 0, 0, 207, 0, 0, 0, 264, 0,
+	307, 145, 217, 215, 335, 169, 162, 158, 143, 191,
+	223, 262, 325, 256, 0, 212, 0, 0, 316, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 142, 111, 247, 317,
+	173, 0, 0, 0, 103, 104, 105, 0, 0, 0,
+	0, 0, 0, 0, 0, 133, 0, 139, 0, 0,
+	0, 0, 155, 195, 161, 154, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
+	0, 236, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 206, 0, 203, 107, 121, 0,
+	0, 246, 289, 295, 0, 0, 0, 146, 0, 293,
+	260, 349, 129, 171, 286, 265, 291, 0, 0, 292,
+	213, 337, 278, 347, 365, 366, 153, 240, 355, 329,
+	361, 376, 122, 150, 254, 322, 352, 313, 233, 333,
+	334, 202, 312, 179, 110, 211, 114, 324, 345, 134,
+	303, 0, 0, 0, 116, 343, 321, 230, 199, 200,
+	115, 0, 285, 157, 177, 148, 249, 340, 341, 147,
+	378, 124, 360, 118, 125, 359, 242, 336, 344, 231,
+	222, 117, 342, 229, 221, 205, 167, 187, 276, 216,
+	277, 188, 238, 237, 239, 0, 112, 0, 318, 353,
+	379, 131, 0, 0, 331, 370, 375, 0, 279, 132,
+	178, 166, 275, 176, 208, 369, 372, 374, 373, 282,
+	130, 272, 184, 253, 348, 170, 356, 241, 126, 190,
+	314, 204, 214, 0, 0, 259, 294, 135, 351, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	106, 119, 210, 0, 283, 174, 377, 358, 354, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 109, 120, 128, 137, 151,
+	164, 172, 182, 186, 189, 192, 193, 196, 201, 219,
+	224, 225, 226, 227, 243, 244, 245, 248, 251, 252,
+	255, 257, 258, 261, 267, 268, 269, 270, 271, 273,
+	284, 288, 296, 297, 298, 299, 300, 301, 302, 308,
+	309, 310, 311, 319, 323, 338, 339, 350, 362, 367,
+	144, 0, 304, 305, 183, 346, 368, 0, 363, 274,
+	281, 209, 280, 371, 0, 140, 218, 0, 0, 220,
+	168, 185, 194, 0, 357, 320, 123, 290, 175, 113,
+	141, 127, 149, 163, 165, 198, 228, 234, 263, 266,
+	180, 160, 138, 287, 136, 306, 326, 327, 328, 330,
+	232, 156, 250, 0, 1304, 0, 0, 0, 0, 0,
+	0, 159, 0, 0, 0, 0, 0, 207, 0, 0,
+	0, 264, 0, 307, 145, 217, 215, 335, 169, 162,
+	158, 143, 191, 223, 262, 325, 256, 0, 212, 0,
+	0, 316, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 197, 142,
+	111, 247, 317, 173, 0, 0, 0, 103, 104, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
+	139, 0, 0, 0, 0, 155, 195, 161, 154, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 0, 236, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 206, 0, 203,
+	107, 121, 0, 0, 246, 289, 295, 0, 0, 0,
+	146, 0, 293, 260, 349, 129, 171, 286, 265, 291,
+	0, 0, 292, 213, 337, 278, 347, 365, 366, 153,
+	240, 355, 329, 361, 376, 122, 150, 254, 322, 352,
+	313, 233, 333, 334, 202, 312, 179, 110, 211, 114,
+	324, 345, 134, 303, 0, 0, 0, 116, 343, 321,
+	230, 199, 200, 115, 0, 285, 157, 177, 148, 249,
+	340, 341, 147, 378, 124, 360, 118, 125, 359, 242,
+	336, 344, 231, 222, 117, 342, 229, 221, 205, 167,
+	187, 276, 216, 277, 188, 238, 237, 239, 0, 112,
+	0, 318, 353, 379, 131, 0, 0, 331, 370, 375,
+	0, 279, 132, 178, 166, 275, 176, 208, 369, 372,
+	374, 373, 282, 130, 272, 184, 253, 348, 170, 356,
+	241, 126, 190, 314, 204, 214, 0, 0, 259, 294,
+	135, 351, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 106, 119, 210, 0, 283, 174, 377,
+	358, 354, 0, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 109, 120,
+	128, 137, 151, 164, 172, 182, 186, 189, 192, 193,
+	196, 201, 219, 224, 225, 226, 227, 243, 244, 245,
+	248, 251, 252, 255, 257, 258, 261, 267, 268, 269,
+	270, 271, 273, 284, 288, 296, 297, 298, 299, 300,
+	301, 302, 308, 309, 310, 311, 319, 323, 338, 339,
+	350, 362, 367, 144, 0, 304, 305, 183, 346, 368,
+	0, 363, 274, 281, 209, 280, 371, 0, 140, 218,
+	0, 0, 220, 168, 185, 194, 0, 357, 320, 123,
+	290, 175, 113, 141, 127, 149, 163, 165, 198, 228,
+	234, 263, 266, 180, 160, 138, 287, 136, 306, 326,
+	327, 328, 330, 232, 156, 250, 0, 1302, 0, 0,
+	0, 0, 0, 0, 159, 0, 0, 0, 0, 0,
+	207, 0, 0, 0, 264, 0, 307, 145, 217, 215,
+	335, 169, 162, 158, 143, 191, 223, 262, 325, 256,
+	0, 212, 0, 0, 316, 235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 142, 111, 247, 317, 173, 0, 0, 0,
+	103, 104, 105, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 0, 139, 0, 0, 0, 0, 155, 195,
+	161, 154, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 0, 236, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	206, 0, 203, 107, 121, 0, 0, 246, 289, 295,
+	0, 0, 0, 146, 0, 293, 260, 349, 129, 171,
+	286, 265, 291, 0, 0, 292, 213, 337, 278, 347,
+	365, 366, 153, 240, 355, 329, 361, 376, 122, 150,
+	254, 322, 352, 313, 233, 333, 334, 202, 312, 179,
+	110, 211, 114, 324, 345, 134, 303, 0, 0, 0,
+	116, 343, 321, 230, 199, 200, 115, 0, 285, 157,
+	177, 148, 249, 340, 341, 147, 378, 124, 360, 118,
+	125, 359, 242, 336, 344, 231, 222, 117, 342, 229,
+	221, 205, 167, 187, 276, 216, 277, 188, 238, 237,
+	239, 0, 112, 0, 318, 353, 379, 131, 0, 0,
+	331, 370, 375, 0, 279, 132, 178, 166, 275, 176,
+	208, 369, 372, 374, 373, 282, 130, 272, 184, 253,
+	348, 170, 356, 241, 126, 190, 314, 204, 214, 0,
+	0, 259, 294, 135, 351, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 106, 119, 210, 0,
+	283, 174, 377, 358, 354, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	108, 109, 120, 128, 137, 151, 164, 172, 182, 186,
+	189, 192, 193, 196, 201, 219, 224, 225, 226, 227,
+	243, 244, 245, 248, 251, 252, 255, 257, 258, 261,
+	267, 268, 269, 270, 271, 273, 284, 288, 296, 297,
+	298, 299, 300, 301, 302, 308, 309, 310, 311, 319,
+	323, 338, 339, 350, 362, 367, 144, 0, 304, 305,
+	183, 346, 368, 0, 363, 274, 281, 209, 280, 371,
+	0, 140, 218, 0, 0, 220, 168, 185, 194, 0,
+	357, 320, 123, 290, 175, 113, 141, 127, 149, 163,
+	165, 198, 228, 234, 263, 266, 180, 160, 138, 287,
+	136, 306, 326, 327, 328, 330, 232, 156, 250, 0,
+	1300, 0, 0, 0, 0, 0, 0, 159, 0, 0,
+	0, 0, 0, 207, 0, 0, 0, 264, 0, 307,
+	145, 217, 215, 335, 169, 162, 158, 143, 191, 223,
+	262, 325, 256, 0, 212, 0, 0, 316, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 142, 111, 247, 317, 173,
+	0, 0, 0, 103, 104, 105, 0, 0, 0, 0,
+	0, 0, 0, 0, 133, 0, 139, 0, 0, 0,
+	0, 155, 195, 161, 154, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
+	236, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 206, 0, 203, 107, 121, 0, 0,
+	246, 289, 295, 0, 0, 0, 146, 0, 293, 260,
+	349, 129, 171, 286, 265, 291, 0, 0, 292, 213,
+	337, 278, 347, 365, 366, 153, 240, 355, 329, 361,
+	376, 122, 150, 254, 322, 352, 313, 233, 333, 334,
+	202, 312, 179, 110, 211, 114, 324, 345, 134, 303,
+	0, 0, 0, 116, 343, 321, 230, 199, 200, 115,
+	0, 285, 157, 177, 148, 249, 340, 341, 147, 378,
+	124, 360, 118, 125, 359, 242, 336, 344, 231, 222,
+	117, 342, 229, 221, 205, 167, 187, 276, 216, 277,
+	188, 238, 237, 239, 0, 112, 0, 318, 353, 379,
+	131, 0, 0, 331, 370, 375, 0, 279, 132, 178,
+	166, 275, 176, 208, 369, 372, 374, 373, 282, 130,
+	272, 184, 253, 348, 170, 356, 241, 126, 190, 314,
+	204, 214, 0, 0, 259, 294, 135, 351, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 106,
+	119, 210, 0, 283, 174, 377, 358, 354, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 108, 109, 120, 128, 137, 151, 164,
+	172, 182, 186, 189, 192, 193, 196, 201, 219, 224,
+	225, 226, 227, 243, 244, 245, 248, 251, 252, 255,
+	257, 258, 261, 267, 268, 269, 270, 271, 273, 284,
+	288, 296, 297, 298, 299, 300, 301, 302, 308, 309,
+	310, 311, 319, 323, 338, 339, 350, 362, 367, 144,
+	0, 304, 305, 183, 346, 368, 0, 363, 274, 281,
+	209, 280, 371, 0, 140, 218, 0, 0, 220, 168,
+	185, 194, 0, 357, 320, 123, 290, 175, 113, 141,
+	127, 149, 163, 165, 198, 228, 234, 263, 266, 180,
+	160, 138, 287, 136, 306, 326, 327, 328, 330, 232,
+	156, 250, 0, 1296, 0, 0, 0, 0, 0, 0,
+	159, 0, 0, 0, 0, 0, 207, 0, 0, 0,
+	264, 0, 307, 145, 217, 215, 335, 169, 162, 158,
+	143, 191, 223, 262, 325, 256, 0, 212, 0, 0,
+	316, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 197, 142, 111,
+	247, 317, 173, 0, 0, 0, 103, 104, 105, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 0, 139,
+	0, 0, 0, 0, 155, 195, 161, 154, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 0, 236, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 206, 0, 203, 107,
+	121, 0, 0, 246, 289, 295, 0, 0, 0, 146,
+	0, 293, 260, 349, 129, 171, 286, 265, 291, 0,
+	0, 292, 213, 337, 278, 347, 365, 366, 153, 240,
+	355, 329, 361, 376, 122, 150, 254, 322, 352, 313,
+	233, 333, 334, 202, 312, 179, 110, 211, 114, 324,
+	345, 134, 303, 0, 0, 0, 116, 343, 321, 230,
+	199, 200, 115, 0, 285, 157, 177, 148, 249, 340,
+	341, 147, 378, 124, 360, 118, 125, 359, 242, 336,
+	344, 231, 222, 117, 342, 229, 221, 205, 167, 187,
+	276, 216, 277, 188, 238, 237, 239, 0, 112, 0,
+	318, 353, 379, 131, 0, 0, 331, 370, 375, 0,
+	279, 132, 178, 166, 275, 176, 208, 369, 372, 374,
+	373, 282, 130, 272, 184, 253, 348, 170, 356, 241,
+	126, 190, 314, 204, 214, 0, 0, 259, 294, 135,
+	351, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 106, 119, 210, 0, 283, 174, 377, 358,
+	354, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 109, 120, 128,
+	137, 151, 164, 172, 182, 186, 189, 192, 193, 196,
+	201, 219, 224, 225, 226, 227, 243, 244, 245, 248,
+	251, 252, 255, 257, 258, 261, 267, 268, 269, 270,
+	271, 273, 284, 288, 296, 297, 298, 299, 300, 301,
+	302, 308, 309, 310, 311, 319, 323, 338, 339, 350,
+	362, 367, 144, 0, 304, 305, 183, 346, 368, 0,
+	363, 274, 281, 209, 280, 371, 0, 140, 218, 0,
+	0, 220, 168, 185, 194, 0, 357, 320, 123, 290,
+	175, 113, 141, 127, 149, 163, 165, 198, 228, 234,
+	263, 266, 180, 160, 138, 287, 136, 306, 326, 327,
+	328, 330, 232, 156, 250, 0, 1294, 0, 0, 0,
+	0, 0, 0, 159, 0, 0, 0, 0, 0, 207,
+	0, 0, 0, 264, 0, 307, 145, 217, 215, 335,
+	169, 162, 158, 143, 191, 223, 262, 325, 256, 0,
+	212, 0, 0, 316, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 142, 111, 247, 317, 173, 0, 0, 0, 103,
+	104, 105, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 0, 139, 0, 0, 0, 0, 155, 195, 161,
+	154, 332, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 181, 0, 236, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 206,
+	0, 203, 107, 121, 0, 0, 246, 289, 295, 0,
+	0, 0, 146, 0, 293, 260, 349, 129, 171, 286,
+	265, 291, 0, 0, 292, 213, 337, 278, 347, 365,
+	366, 153, 240, 355, 329, 361, 376, 122, 150, 254,
+	322, 352, 313, 233, 333, 334, 202, 312, 179, 110,
+	211, 114, 324, 345, 134, 303, 0, 0, 0, 116,
+	343, 321, 230, 199, 200, 115, 0, 285, 157, 177,
+	148, 249, 340, 341, 147, 378, 124, 360, 118, 125,
+	359, 242, 336, 344, 231, 222, 117, 342, 229, 221,
+	205, 167, 187, 276, 216, 277, 188, 238, 237, 239,
+	0, 112, 0, 318, 353, 379, 131, 0, 0, 331,
+	370, 375, 0, 279, 132, 178, 166, 275, 176, 208,
+	369, 372, 374, 373, 282, 130, 272, 184, 253, 348,
+	170, 356, 241, 126, 190, 314, 204, 214, 0, 0,
+	259, 294, 135, 351, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 106, 119, 210, 0, 283,
+	174, 377, 358, 354, 0, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 108,
+	109, 120, 128, 137, 151, 164, 172, 182, 186, 189,
+	192, 193, 196, 201, 219, 224, 225, 226, 227, 243,
+	244, 245, 248, 251, 252, 255, 257, 258, 261, 267,
+	268, 269, 270, 271, 273, 284, 288, 296, 297, 298,
+	299, 300, 301, 302, 308, 309, 310, 311, 319, 323,
+	338, 339, 350, 362, 367, 144, 0, 304, 305, 183,
+	346, 368, 0, 363, 274, 281, 209, 280, 371, 0,
+	140, 218, 0, 0, 220, 168, 185, 194, 0, 357,
+	320, 123, 290, 175, 113, 141, 127, 149, 163, 165,
+	198, 228, 234, 263, 266, 180, 160, 138, 287, 136,
+	306, 326, 327, 328, 330, 232, 156, 250, 0, 1292,
+	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 264, 0, 307, 145,
+	217, 215, 335, 169, 162, 158, 143, 191, 223, 262,
+	325, 256, 0, 212, 0, 0, 316, 235, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 142, 111, 247, 317, 173, 0,
+	0, 0, 103, 104, 105, 0, 0, 0, 0, 0,
+	0, 0, 0, 133, 0, 139, 0, 0, 0, 0,
+	155, 195, 161, 154, 332, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 181, 0, 236,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 206, 0, 203, 107, 121, 0, 0, 246,
+	289, 295, 0, 0, 0, 146, 0, 293, 260, 349,
+	129, 171, 286, 265, 291, 0, 0, 292, 213, 337,
+	278, 347, 365, 366, 153, 240, 355, 329, 361, 376,
+	122, 150, 254, 322, 352, 313, 233, 333, 334, 202,
+	312, 179, 110, 211, 114, 324, 345, 134, 303, 0,
+	0, 0, 116, 343, 321, 230, 199, 200, 115, 0,
+	285, 157, 177, 148, 249, 340, 341, 147, 378, 124,
+	360, 118, 125, 359, 242, 336, 344, 231, 222, 117,
+	342, 229, 221, 205, 167, 187, 276, 216, 277, 188,
+	238, 237, 239, 0, 112, 0, 318, 353, 379, 131,
+	0, 0, 331, 370, 375, 0, 279, 132, 178, 166,
+	275, 176, 208, 369, 372, 374, 373, 282, 130, 272,
+	184, 253, 348, 170, 356, 241, 126, 190, 314, 204,
+	214, 0, 0, 259, 294, 135, 351, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 106, 119,
+	210, 0, 283, 174, 377, 358, 354, 0, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 109, 120, 128, 137, 151, 164, 172,
+	182, 186, 189, 192, 193, 196, 201, 219, 224, 225,
+	226, 227, 243, 244, 245, 248, 251, 252, 255, 257,
+	258, 261, 267, 268, 269, 270, 271, 273, 284, 288,
+	296, 297, 298, 299, 300, 301, 302, 308, 309, 310,
+	311, 319, 323, 338, 339, 350, 362, 367, 144, 0,
+	304, 305, 183, 346, 368, 0, 363, 274, 281, 209,
+	280, 371, 0, 140, 218, 0, 0, 220, 168, 185,
+	194, 0, 357, 320, 123, 290, 175, 113, 141, 127,
+	149, 163, 165, 198, 228, 234, 263, 266, 180, 160,
+	138, 287, 136, 306, 326, 327, 328, 330, 232, 156,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 0, 0, 207, 0, 0, 0, 264,
+	0, 307, 145, 217, 215, 335, 169, 162, 158, 143,
+	191, 223, 262, 325, 256, 0, 212, 0, 0, 316,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 142, 111, 247,
+	317, 173, 1267, 0, 0, 103, 104, 105, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 0, 139, 0,
+	0, 0, 0, 155, 195, 161, 154, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 0, 236, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 206, 0, 203, 107, 121,
+	0, 0, 246, 289, 295, 0, 0, 0, 146, 0,
+	293, 260, 349, 129, 171, 286, 265, 291, 0, 0,
+	292, 213, 337, 278, 347, 365, 366, 153, 240, 355,
+	329, 361, 376, 122, 150, 254, 322, 352, 313, 233,
+	333, 334, 202, 312, 179, 110, 211, 114, 324, 345,
+	134, 303, 0, 0, 0, 116, 343, 321, 230, 199,
+	200, 115, 0, 285, 157, 177, 148, 249, 340, 341,
+	147, 378, 124, 360, 118, 125, 359, 242, 336, 344,
+	231, 222, 117, 342, 229, 221, 205, 167, 187, 276,
+	216, 277, 188, 238, 237, 239, 0, 112, 0, 318,
+	353, 379, 131, 0, 0, 331, 370, 375, 0, 279,
+	132, 178, 166, 275, 176, 208, 369, 372, 374, 373,
+	282, 130, 272, 184, 253, 348, 170, 356, 241, 126,
+	190, 314, 204, 214, 0, 0, 259, 294, 135, 351,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 106, 119, 210, 0, 283, 174, 377, 358, 354,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 109, 120, 128, 137,
+	151, 164, 172, 182, 186, 189, 192, 193, 196, 201,
+	219, 224, 225, 226, 227, 243, 244, 245, 248, 251,
+	252, 255, 257, 258, 261, 267, 268, 269, 270, 271,
+	273, 284, 288, 296, 297, 298, 299, 300, 301, 302,
+	308, 309, 310, 311, 319, 323, 338, 339, 350, 362,
+	367, 144, 0, 304, 305, 183, 346, 368, 0, 363,
+	274, 281, 209, 280, 371, 0, 140, 218, 0, 0,
+	220, 168, 185, 194, 0, 357, 320, 123, 290, 175,
+	113, 141, 127, 149, 163, 165, 198, 228, 234, 263,
+	266, 180, 160, 138, 287, 136, 306, 326, 327, 328,
+	330, 232, 156, 1166, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 0, 0, 207, 0, 0, 0, 264,
+	0, 307, 145, 217, 215, 335, 169, 162, 158, 143,
+	191, 223, 262, 325, 256, 0, 212, 0, 0, 316,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 142, 111, 247,
+	317, 173, 0, 0, 0, 103, 104, 105, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 0, 139, 0,
+	0, 0, 0, 155, 195, 161, 154, 332, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	181, 0, 236, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 206, 0, 203, 107, 121,
+	0, 0, 246, 289, 295, 0, 0, 0, 146, 0,
+	293, 260, 349, 129, 171, 286, 265, 291, 0, 0,
+	292, 213, 337, 278, 347, 365, 366, 153, 240, 355,
+	329, 361, 376, 122, 150, 254, 322, 352, 313, 233,
+	333, 334, 202, 312, 179, 110, 211, 114, 324, 345,
+	134, 303, 0, 0, 0, 116, 343, 321, 230, 199,
+	200, 115, 0, 285, 157, 177, 148, 249, 340, 341,
+	147, 378, 124, 360, 118, 125, 359, 242, 336, 344,
+	231, 222, 117, 342, 229, 221, 205, 167, 187, 276,
+	216, 277, 188, 238, 237, 239, 0, 112, 0, 318,
+	353, 379, 131, 0, 0, 331, 370, 375, 0, 279,
+	132, 178, 166, 275, 176, 208, 369, 372, 374, 373,
+	282, 130, 272, 184, 253, 348, 170, 356, 241, 126,
+	190, 314, 204, 214, 0, 0, 259, 294, 135, 351,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 106, 119, 210, 0, 283, 174, 377, 358, 354,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 109, 120, 128, 137,
+	151, 164, 172, 182, 186, 189, 192, 193, 196, 201,
+	219, 224, 225, 226, 227, 243, 244, 245, 248, 251,
+	252, 255, 257, 258, 261, 267, 268, 269, 270, 271,
+	273, 284, 288, 296, 297, 298, 299, 300, 301, 302,
+	308, 309, 310, 311, 319, 323, 338, 339, 350, 362,
+	367, 144, 0, 304, 305, 183, 346, 368, 0, 363,
+	274, 281, 209, 280, 371, 0, 140, 218, 0, 0,
+	220, 168, 185, 194, 0, 357, 320, 123, 290, 175,
+	113, 141, 127, 149, 163, 165, 198, 228, 234, 263,
+	266, 180, 160, 138, 287, 136, 306, 326, 327, 328,
+	330, 232, 156, 250, 0, 0, 0, 0, 0, 0,
+	0, 1155, 159, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 264, 0, 307, 145, 217, 215, 335, 169,
+	162, 158, 143, 191, 223, 262, 325, 256, 0, 212,
+	0, 0, 316, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	142, 111, 247, 317, 173, 0, 0, 0, 103, 104,
+	105, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	0, 139, 0, 0, 0, 0, 155, 195, 161, 154,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 181, 0, 236, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 0, 0, 0, 206, 0,
+	203, 107, 121, 0, 0, 246, 289, 295, 0, 0,
+	0, 146, 0, 293, 260, 349, 129, 171, 286, 265,
+	291, 0, 0, 292, 213, 337, 278, 347, 365, 366,
+	153, 240, 355, 329, 361, 376, 122, 150, 254, 322,
+	352, 313, 233, 333, 334, 202, 312, 179, 110, 211,
+	114, 324, 345, 134, 303, 0, 0, 0, 116, 343,
+	321, 230, 199, 200, 115, 0, 285, 157, 177, 148,
+	249, 340, 341, 147, 378, 124, 360, 118, 125, 359,
+	242, 336, 344, 231, 222, 117, 342, 229, 221, 205,
+	167, 187, 276, 216, 277, 188, 238, 237, 239, 0,
+	112, 0, 318, 353, 379, 131, 0, 0, 331, 370,
+	375, 0, 279, 132, 178, 166, 275, 176, 208, 369,
+	372, 374, 373, 282, 130, 272, 184, 253, 348, 170,
+	356, 241, 126, 190, 314, 204, 214, 0, 0, 259,
+	294, 135, 351, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 106, 119, 210, 0, 283, 174,
+	377, 358, 354, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 108, 109,
+	120, 128, 137, 151, 164, 172, 182, 186, 189, 192,
+	193, 196, 201, 219, 224, 225, 226, 227, 243, 244,
+	245, 248, 251, 252, 255, 257, 258, 261, 267, 268,
+	269, 270, 271, 273, 284, 288, 296, 297, 298, 299,
+	300, 301, 302, 308, 309, 310, 311, 319, 323, 338,
+	339, 350, 362, 367, 144, 0, 304, 305, 183, 346,
+	368, 0, 363, 274, 281, 209, 280, 371, 0, 140,
+	218, 0, 0, 220, 168, 185, 194, 0, 357, 320,
+	123, 290, 175, 113, 141, 127, 149, 163, 165, 198,
+	228, 234, 263, 266, 180, 160, 138, 287, 136, 306,
+	326, 327, 328, 330, 232, 156, 250, 0, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
+	0, 207, 0, 0, 0, 264, 0, 307, 145, 217,
+	215, 335, 169, 162, 158, 143, 191, 223, 262, 325,
+	256, 0, 212, 0, 0, 316, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 142, 111, 247, 317, 173, 0, 0,
+	0, 103, 104, 105, 0, 989, 0, 0, 0, 0,
+	0, 0, 133, 0, 139, 0, 0, 0, 0, 155,
+	195, 161, 154, 332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 181, 0, 236, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 0, 0,
+	0, 206, 0, 203, 107, 121, 0, 0, 246, 289,
+	295, 0, 0, 0, 146, 0, 293, 260, 349, 129,
+	171, 286, 265, 291, 0, 0, 292, 213, 337, 278,
+	347, 365, 366, 153, 240, 355, 329, 361, 376, 122,
+	150, 254, 322, 352, 313, 233, 333, 334, 202, 312,
+	179, 110, 211, 114, 324, 345, 134, 303, 0, 0,
+	0, 116, 343, 321, 230, 199, 200, 115, 0, 285,
+	157, 177, 148, 249, 340, 341, 147, 378, 124, 360,
+	118, 125, 359, 242, 336, 344, 231, 222, 117, 342,
+	229, 221, 205, 167, 187, 276, 216, 277, 188, 238,
+	237, 239, 0, 112, 0, 318, 353, 379, 131, 0,
+	0, 331, 370, 375, 0, 279, 132, 178, 166, 275,
+	176, 208, 369, 372, 374, 373, 282, 130, 272, 184,
+	253, 348, 170, 356, 241, 126, 190, 314, 204, 214,
+	0, 0, 259, 294, 135, 351, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 106, 119, 210,
+	0, 283, 174, 377, 358, 354, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 108, 109, 120, 128, 137, 151, 164, 172, 182,
+	186, 189, 192, 193, 196, 201, 219, 224, 225, 226,
+	227, 243, 244, 245, 248, 251, 252, 255, 257, 258,
+	261, 267, 268, 269, 270, 271, 273, 284, 288, 296,
+	297, 298, 299, 300, 301, 302, 308, 309, 310, 311,
+	319, 323, 338, 339, 350, 362, 367, 144, 0, 304,
+	305, 183, 346, 368, 0, 363, 274, 281, 209, 280,
+	371, 0, 140, 218, 0, 0, 220, 168, 185, 194,
+	0, 357, 320, 123, 290, 175, 113, 141, 127, 149,
+	163, 165, 198, 228, 234, 263, 266, 180, 160, 138,
+	287, 136, 306, 326, 327, 328, 330, 232, 156, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
+	0, 0, 0, 0, 207, 0, 0, 0, 264, 0,
+	307, 145, 217, 215, 335, 169, 162, 158, 143, 191,
+	223, 262, 325, 256, 0, 212, 0, 0, 316, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 142, 111, 247, 317,
+	173, 0, 0, 0, 103, 104, 105, 0, 0, 0,
+	0, 0, 0, 0, 0, 133, 0, 139, 0, 0,
+	0, 0, 155, 195, 161, 154, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 0, 181,
+	0, 236, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 206, 0, 203, 107, 121, 0,
+	0, 246, 289, 295, 0, 0, 0, 146, 0, 293,
+	260, 349, 129, 171, 286, 265, 291, 0, 0, 292,
+	213, 337, 278, 347, 365, 366, 153, 240, 355, 329,
+	361, 376, 122, 150, 254, 322, 352, 313, 233, 333,
+	334, 202, 312, 179, 110, 211, 114, 324, 345, 134,
+	303, 0, 0, 0, 116, 343, 321, 230, 199, 200,
+	115, 0, 285, 157, 177, 148, 249, 340, 341, 147,
+	378, 124, 360, 118, 125, 359, 242, 336, 344, 231,
+	222, 117, 342, 229, 221, 205, 167, 187, 276, 216,
+	277, 188, 238, 237, 239, 0, 112, 0, 318, 353,
+	379, 131, 0, 0, 331, 370, 375, 0, 279, 132,
+	178, 166, 275, 176, 208, 369, 372, 374, 373, 282,
+	130, 272, 184, 253, 348, 170, 356, 241, 126, 190,
+	314, 204, 214, 0, 0, 259, 294, 135, 351, 315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	106, 119, 210, 0, 283, 174, 377, 358, 354, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 109, 120, 128, 137, 151,
+	164, 172, 182, 186, 189, 192, 193, 196, 201, 219,
+	224, 225, 226, 227, 243, 244, 245, 248, 251, 252,
+	255, 257, 258, 261, 267, 268, 269, 270, 271, 273,
+	284, 288, 296, 297, 298, 299, 300, 301, 302, 308,
+	309, 310, 311, 319, 323, 338, 339, 350, 362, 367,
+	144, 0, 304, 305, 528, 346, 368, 0, 363, 274,
+	281, 209, 280, 371, 0, 140, 218, 0, 0, 220,
+	168, 185, 194, 0, 357, 320, 123, 290, 175, 113,
+	141, 127, 149, 163, 165, 198, 228, 234, 263, 266,
+	180, 160, 138, 287, 136, 306, 326, 327, 328, 330,
+	232, 156, 250, 0, 0, 0, 0, 0, 0, 0,
+	0, 159, 0, 0, 0, 0, 0, 207, 0, 0,
+	0, 264, 0, 307, 145, 217, 215, 335, 169, 162,
+	158, 143, 191, 223, 262, 325, 256, 0, 212, 0,
+	0, 316, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 197, 142,
+	111, 247, 317, 173, 0, 0, 0, 103, 104, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
+	139, 0, 0, 0, 0, 155, 195, 161, 154, 332,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 181, 0, 236, 0, 478, 0, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 206, 0, 203,
+	107, 121, 0, 0, 246, 289, 295, 0, 0, 0,
+	146, 0, 293, 260, 349, 129, 171, 286, 265, 291,
+	0, 0, 292, 213, 337, 278, 347, 365, 366, 153,
+	240, 355, 329, 361, 376, 122, 150, 254, 322, 352,
+	313, 233, 333, 334, 202, 312, 179, 110, 211, 114,
+	324, 345, 134, 303, 0, 0, 0, 116, 343, 321,
+	230, 199, 200, 115, 0, 285, 157, 177, 148, 249,
+	340, 341, 147, 378, 124, 360, 118, 125, 359, 242,
+	336, 344, 231, 222, 117, 342, 229, 221, 205, 167,
+	187, 276, 216, 277, 188, 238, 237, 239, 0, 112,
+	0, 318, 353, 379, 131, 0, 0, 331, 370, 375,
+	0, 279, 132, 178, 166, 275, 176, 208, 369, 372,
+	374, 373, 282, 130, 272, 184, 253, 348, 170, 356,
+	241, 126, 190, 314, 204, 214, 0, 0, 259, 294,
+	135, 351, 315, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 106, 119, 210, 0, 283, 174, 377,
+	358, 354, 0, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 109, 120,
+	128, 137, 151, 164, 172, 182, 186, 189, 192, 193,
+	196, 201, 219, 224, 225, 226, 227, 243, 244, 245,
+	248, 251, 252, 255, 257, 258, 261, 267, 268, 269,
+	270, 271, 273, 284, 288, 296, 297, 298, 299, 300,
+	301, 302, 308, 309, 310, 311, 319, 323, 338, 339,
+	350, 362, 367, 144, 0, 304, 305, 183, 346, 368,
+	0, 363, 274, 281, 209, 280, 371, 0, 140, 218,
+	0, 0, 220, 168, 185, 194, 0, 357, 320, 123,
+	290, 175, 113, 141, 127, 149, 163, 165, 198, 228,
+	234, 263, 266, 180, 160, 138, 287, 136, 306, 326,
+	327, 328, 330, 232, 156, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 159, 0, 0, 0, 0, 0,
+	207, 0, 0, 0, 264, 0, 307, 145, 217, 215,
+	335, 169, 162, 158, 143, 191, 223, 262, 325, 256,
+	0, 212, 0, 0, 316, 235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 142, 111, 247, 317, 173, 0, 0, 0,
+	103, 104, 105, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 0, 139, 0, 0, 0, 0, 155, 195,
+	161, 154, 332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 181, 0, 236, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	206, 0, 203, 107, 121, 0, 0, 246, 289, 295,
+	0, 0, 0, 146, 0, 293, 260, 349, 129, 171,
+	286, 265, 291, 0, 0, 292, 213, 337, 278, 347,
+	365, 366, 153, 240, 355, 329, 361, 376, 122, 150,
+	254, 322, 352, 313, 233, 333, 334, 202, 312, 179,
+	110, 211, 114, 324, 345, 134, 303, 0, 0, 0,
+	116, 343, 321, 230, 199, 200, 115, 0, 285, 157,
+	177, 148, 249, 340, 341, 147, 378, 124, 360, 118,
+	125, 359, 242, 336, 344, 231, 222, 117, 342, 229,
+	221, 205, 167, 187, 276, 216, 277, 188, 238, 237,
+	239, 0, 112, 0, 318, 353, 379, 131, 0, 0,
+	331, 370, 375, 0, 279, 132, 178, 166, 275, 176,
+	208, 369, 372, 374, 373, 282, 130, 272, 184, 253,
+	348, 170, 356, 241, 126, 190, 314, 204, 214, 0,
+	0, 259, 294, 135, 351, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 106, 119, 210, 0,
+	283, 174, 377, 358, 354, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	108, 109, 120, 128, 137, 151, 164, 172, 182, 186,
+	189, 192, 193, 196, 201, 219, 224, 225, 226, 227,
+	243, 244, 245, 248, 251, 252, 255, 257, 258, 261,
+	267, 268, 269, 270, 271, 273, 284, 288, 296, 297,
+	298, 299, 300, 301, 302, 308, 309, 310, 311, 319,
+	323, 338, 339, 350, 362, 367, 144, 0, 304, 305,
+	183, 346, 368, 0, 363, 274, 281, 209, 280, 371,
+	0, 140, 218, 0, 0, 220, 168, 185, 194, 0,
+	357, 320, 123, 290, 175, 113, 141, 127, 149, 163,
+	165, 198, 228, 234, 263, 266, 180, 160, 138, 287,
+	136, 306, 326, 327, 328, 330, 232, 156, 250, 0,
+	0, 0, 0, 0, 0, 0, 0, 159, 0, 0,
+	0, 0, 0, 207, 0, 0, 0, 264, 0, 307,
+	145, 217, 215, 335, 169, 162, 158, 143, 191, 223,
+	262, 325, 256, 0, 212, 0, 0, 316, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 142, 111, 247, 317, 173,
+	0, 0, 0, 103, 104, 105, 0, 0, 0, 0,
+	0, 0, 0, 0, 133, 0, 139, 0, 0, 0,
+	0, 155, 195, 161, 154, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
+	236, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 206, 0, 203, 107, 121, 0, 0,
+	246, 289, 295, 0, 0, 0, 146, 0, 293, 260,
+	349, 129, 171, 286, 265, 291, 0, 0, 292, 213,
+	337, 278, 347, 365, 366, 153, 240, 355, 329, 361,
+	376, 122, 150, 254, 322, 352, 313, 233, 333, 334,
+	202, 312, 179, 110, 211, 114, 324, 345, 134, 303,
+	0, 0, 0, 116, 343, 321, 230, 199, 200, 115,
+	0, 285, 157, 177, 148, 249, 340, 341, 147, 378,
+	124, 360, 118, 125, 359, 242, 336, 344, 231, 222,
+	117, 342, 229, 221, 205, 167, 187, 276, 216, 277,
+	188, 238, 237, 239, 0, 112, 0, 318, 353, 379,
+	131, 0, 0, 331, 370, 375, 0, 279, 132, 178,
+	166, 275, 176, 208, 369, 372, 374, 373, 282, 130,
+	272, 184, 253, 348, 170, 356, 241, 126, 190, 314,
+	204, 214, 0, 0, 259, 294, 135, 351, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 106,
+	119, 210, 0, 283, 174, 377, 358, 354, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 108, 109, 120, 128, 137, 151, 164,
+	172, 182, 186, 189, 192, 193, 196, 201, 219, 224,
+	225, 226, 227, 2313, 244, 245, 248, 251, 252, 255,
+	257, 258, 261, 267, 268, 269, 270, 271, 273, 284,
+	288, 296, 297, 298, 299, 300, 301, 302, 308, 309,
+	310, 311, 319, 323, 338, 339, 350, 362, 367, 144,
+	0, 304, 305, 183, 346, 368, 0, 363, 274, 281,
+	209, 280, 371, 0, 140, 218, 0, 0, 220, 168,
+	185, 194, 0, 357, 320, 123, 290, 175, 113, 141,
+	127, 149, 163, 165, 198, 228, 234, 263, 266, 180,
+	160, 138, 287, 136, 306, 326, 327, 328, 330, 232,
+	156, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	159, 0, 0, 0, 0, 0, 207, 0, 0, 0,
+	264, 0, 307, 145, 217, 215, 335, 169, 162, 158,
+	143, 191, 223, 262, 325, 256, 0, 212, 0, 0,
+	316, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 197, 142, 111,
+	247, 317, 173, 0, 0, 0, 103, 104, 105, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 0, 139,
+	0, 0, 0, 0, 155, 195, 161, 154, 332, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 181, 0, 236, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 206, 0, 203, 107,
+	121, 0, 0, 246, 289, 295, 0, 0, 0, 146,
+	0, 293, 260, 349, 129, 171, 286, 265, 291, 0,
+	0, 292, 213, 337, 278, 347, 365, 366, 153, 240,
+	355, 329, 361, 376, 122, 150, 254, 322, 352, 313,
+	233, 333, 334, 202, 312, 179, 110, 211, 114, 324,
+	345, 134, 303, 0, 0, 0, 116, 343, 321, 230,
+	199, 200, 115, 0, 285, 157, 177, 148, 249, 340,
+	341, 147, 378, 124, 360, 118, 125, 359, 242, 336,
+	344, 231, 222, 117, 342, 229, 221, 205, 167, 187,
+	276, 216, 277, 188, 238, 237, 239, 0, 112, 0,
+	318, 353, 379, 131, 0, 0, 331, 370, 375, 0,
+	279, 132, 178, 166, 275, 176, 208, 369, 372, 374,
+	373, 282, 130, 272, 184, 253, 348, 170, 356, 241,
+	126, 190, 314, 204, 214, 0, 0, 259, 294, 135,
+	351, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 106, 119, 210, 0, 283, 174, 377, 358,
+	354, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 109, 120, 128,
+	137, 151, 164, 172, 182, 186, 189, 192, 193, 196,
+	201, 219, 224, 225, 226, 227, 243, 244, 245, 248,
+	251, 252, 255, 257, 258, 261, 267, 268, 269, 270,
+	271, 273, 284, 288, 296, 297, 298, 299, 300, 301,
+	302, 308, 309, 310, 311, 319, 323, 338, 339, 350,
+	362, 367, 144, 0, 304, 305, 183, 346, 368, 0,
+	363, 274, 281, 209, 280, 371, 0, 140, 218, 0,
+	0, 220, 168, 185, 194, 0, 357, 320, 123, 290,
+	175, 113, 141, 127, 149, 163, 165, 198, 228, 234,
+	263, 266, 180, 160, 138, 287, 136, 306, 326, 327,
+	328, 330, 232, 156,
 }
 
 var yyPact = [...]int{
-	4075, -1000, -339, 1534, -1000, -1000, -1000, -1000, -1000, -1000,
+	3745, -1000, -335, 1811, 1300, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1499, 1161, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 545, 1169, 137, 1416, 275, 172, 928, 392, 119,
-	27059, 391, 113, 27508, -1000, 102, -1000, 94, 27508, 98,
-	26610, -1000, -1000, -278, 12658, 1343, 27, 14, 27508, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1185, 1452, 1468,
-	1495, 1011, 1407, -1000, 10849, 10849, 327, 327, 327, 9053,
-	-1000, -1000, 16712, 27508, 27508, 1176, 387, 928, 378, 376,
-	375, 314, -113, -1000, -1000, -1000, -1000, 1416, -1000, -1000,
-	170, -1000, 190, 1143, -1000, 1141, -1000, 569, 586, 247,
-	295, 283, 246, 245, 243, 242, 227, 224, 220, 219,
-	208, -1000, 511, 511, -171, -175, 1955, 304, 304, 304,
-	348, 1386, 1385, -1000, 548, -1000, 511, 511, 157, 511,
-	511, 511, 511, 165, 163, 511, 511, 511, 511, 511,
-	511, 511, 511, 511, 511, 511, 511, 511, 511, 511,
-	27508, -1000, 144, 512, 555, 1416, 158, -1000, -1000, -1000,
-	27508, 385, 928, 309, 309, 27508, -1000, 433, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1707, 1300, -1000, 29386, -1000,
+	-1000, -1000, -1000, -1000, -1000, 628, 1335, 165, 1622, 3022,
+	237, 930, 469, 154, 28923, 467, 66, 30312, -1000, 126,
+	-1000, 109, 30312, 119, 28460, 180, -1000, -1000, -262, 13611,
+	1579, 370, 44, 41, 30312, -189, -252, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1568, 1526, 20, 1549, -1000, -1000, 1329,
+	1696, 1811, 1702, 1748, 1167, 2000, -1000, 1255, 30312, -1000,
+	1270, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4025,23 +4346,36 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 27508, 609, 609, 53, 609, 609, 609, 609,
-	85, 420, 13, -1000, 78, 151, 149, 155, 589, 123,
-	63, -1000, -1000, 153, 103, -1000, 609, 7201, 7201, 7201,
-	-1000, 1396, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	343, -1000, -1000, -1000, -1000, 27508, 26161, 258, 544, -1000,
-	-1000, -1000, 49, -1000, -1000, 1078, 753, -1000, 12658, 2116,
-	1146, 1146, -1000, -1000, 407, -1000, -1000, 14005, 14005, 14005,
-	14005, 14005, 14005, 14005, 14005, 14005, 14005, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1146, 432, -1000, 12209, 1146, 1146, 1146, 1146, 1146,
-	1146, 1146, 1146, 12658, 1146, 1146, 1146, 1146, 1146, 1146,
-	1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146,
-	-1000, -1000, -1000, 27508, -1000, 1146, 1499, -1000, 1161, -1000,
-	-1000, -1000, 1401, 12658, 12658, 1499, -1000, 1279, 10849, -1000,
-	-1000, 1374, -1000, -1000, -1000, -1000, 701, 1513, -1000, 15352,
-	430, 1512, 25712, -1000, 19419, 25263, 1140, 8590, -61, -1000,
-	-1000, -1000, 540, 18521, -1000, -1000, -1000, -1000, -1000, -1000,
+	12222, 12222, 361, 361, 361, 9894, -1000, -1000, 18267, 30312,
+	30312, 1343, 466, 930, 453, 447, 430, 350, -66, -1000,
+	-1000, -1000, -1000, 1622, -1000, -1000, 188, -1000, 288, 1258,
+	-1000, 1256, -1000, 596, 554, 282, 335, 334, 280, 279,
+	275, 274, 272, 255, 252, 251, 299, -1000, 605, 605,
+	-115, -116, 2523, 343, 343, 343, 407, 1601, 1598, -1000,
+	647, -1000, 605, 605, 143, 605, 605, 605, 605, 212,
+	211, 605, 605, 605, 605, 605, 605, 605, 605, 605,
+	605, 605, 605, 605, 605, 605, 30312, -1000, 185, 598,
+	652, 1622, 202, 30312, 460, 930, 348, 348, 30312, -1000,
+	530, 30312, 733, 733, 33, 733, 733, 733, 733, 95,
+	464, 31, -1000, 88, 196, 192, 200, 688, 142, 76,
+	-1000, -1000, 197, 108, -1000, 4, 733, 7986, 7986, 7986,
+	-1000, 1614, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	401, -1000, -1000, -1000, -1000, 30312, 27997, 284, 643, -1000,
+	284, -1000, -1000, -1000, -1000, -1000, 5, -1000, -1000, 1095,
+	888, -1000, 13611, 2328, 1261, 1261, -1000, -1000, 522, -1000,
+	-1000, 15000, 15000, 15000, 15000, 15000, 15000, 15000, 15000, 15000,
+	15000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1261, 529, -1000, 11296, 1261,
+	1261, 1261, 1261, 1261, 1261, 1261, 1261, 13611, 1261, 1261,
+	1261, 1261, 1261, 1261, 1261, 1261, 1261, 1261, 1261, 1261,
+	1261, 1261, 1261, 1261, -1000, -1000, 171, 1261, 871, 30312,
+	-1000, 1261, 865, -1000, -1000, 1794, 1524, 1743, 1740, 1499,
+	-1000, -1000, 1739, 1531, 1738, -1000, 1707, -1000, 1300, -1000,
+	-1000, -1000, 1616, 13611, 13611, 1707, -1000, 1469, 12222, -1000,
+	-1000, 1706, -1000, -1000, -1000, -1000, 739, 30312, 1255, 1690,
+	30312, 1790, -1000, 16389, 528, 1783, 27534, -1000, 19193, 27071,
+	1252, 9417, -44, -1000, -1000, -1000, 641, 20119, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4055,422 +4389,461 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1396, 1031, 27508, -1000, -1000,
-	3604, 928, -1000, 1168, -1000, 1029, -1000, 1150, 144, 314,
-	1199, 928, 928, 928, 928, 600, -1000, -1000, -1000, 511,
-	511, 202, 275, 4093, -1000, -1000, -1000, 24807, 1167, 928,
-	-1000, 1166, -1000, 1427, 292, 496, 496, 928, -1000, -1000,
-	27508, 928, 1426, 1425, 27508, 27508, -1000, 24358, -1000, 23909,
-	23460, 869, 27508, 23011, 22562, 22113, 21664, 21215, -1000, 1239,
-	-1000, 1136, -1000, -1000, -1000, 27508, 27508, 27508, 39, -1000,
-	-1000, 27508, 928, -1000, -1000, 847, 834, 511, 511, 812,
-	947, 945, 941, 511, 511, 800, 937, 921, 150, 787,
-	779, 761, 891, 936, 110, 867, 850, 756, 27508, 1162,
-	-1000, 134, 538, 181, 230, 199, 27508, 135, 1416, 1342,
-	1129, 341, 309, 1232, 27508, 1441, 928, -1000, 7664, -1000,
-	-1000, 933, 12658, -1000, 641, 589, 589, -1000, -1000, -1000,
-	-1000, -1000, -1000, 609, 27508, 641, -1000, -1000, -1000, 589,
-	609, 27508, 609, 609, 609, 609, 589, 609, 27508, 27508,
-	27508, 27508, 27508, 27508, 27508, 27508, 27508, 7201, 7201, 7201,
-	492, -1000, 1231, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	97, -1000, -1000, -1000, -1000, -1000, 1534, -1000, -1000, -1000,
-	-110, 1127, 20766, -1000, -282, -283, -285, -290, -1000, -1000,
-	-1000, -291, -298, -1000, -1000, -1000, 12658, 12658, 12658, 12658,
-	786, 499, 14005, 710, 633, 14005, 14005, 14005, 14005, 14005,
-	14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005,
-	590, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 928,
-	-1000, 1522, 896, 896, 444, 444, 444, 444, 444, 444,
-	444, 444, 444, 14454, 9502, 7664, 1011, 1027, 1499, 10849,
-	10849, 12658, 12658, 11747, 11298, 10849, 1408, 565, 753, 27508,
-	-1000, -1000, 13556, -1000, -1000, -1000, -1000, -1000, 953, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 27508, 27508, 10849, 10849,
-	10849, 10849, 10849, -1000, 1126, -1000, -170, 16263, 12658, 1468,
-	1011, 1374, 1436, 1528, 461, 744, 1114, -1000, 729, 1468,
-	18072, 1135, -1000, 1374, -1000, -1000, -1000, 27508, -1000, -1000,
-	20317, -1000, -1000, 6738, 27508, 204, 27508, -1000, 1128, 1307,
-	-1000, -1000, -1000, 1449, 17623, 27508, 1071, 1045, -1000, -1000,
-	429, 8127, -61, -1000, 8127, 1099, -1000, -25, -51, 9951,
-	419, -1000, -1000, -1000, 1955, 14903, 1041, -1000, 35, -1000,
-	-1000, -1000, 1150, -1000, 1150, 1150, 1150, 1150, 39, 39,
-	39, 39, -1000, -1000, -1000, -1000, -1000, 1160, 1159, -1000,
-	1150, 1150, 1150, 1150, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1155, 1155, 1155, 1151, 1151, 285, -1000, 12658, 105,
-	27508, 1434, 755, 134, 27508, 1230, -1000, 27508, 1199, 1199,
-	1199, -1000, 1437, 914, 860, -1000, 1112, -1000, -1000, 1493,
-	-1000, -1000, 614, 637, 626, 484, 27508, 117, 193, -1000,
-	277, -1000, 27508, 1153, 1422, 496, 928, -1000, 928, -1000,
-	-1000, -1000, -1000, 425, -1000, -1000, 928, 1110, -1000, 1095,
-	671, 612, 670, 587, 1110, -1000, -1000, -139, 1110, -1000,
-	1110, -1000, 1110, -1000, 1110, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 550, 27508, 117, 590, -1000,
-	336, -1000, -1000, 590, 590, -1000, -1000, -1000, -1000, 932,
-	930, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1614, 1203, 30312, -1000, -1000, 4354, 930, -1000, 1334,
+	-1000, 1199, -1000, 1276, 185, 350, 1358, 930, 930, 930,
+	930, 667, -1000, -1000, -1000, 605, 605, 290, 3022, 4191,
+	-1000, -1000, -1000, 26601, 1333, 930, -1000, 1332, -1000, 1659,
+	385, 548, 548, 930, -1000, -1000, 30312, 930, 1652, 1641,
+	30312, 30312, -1000, 26138, -1000, 25675, 25212, 863, 30312, 24749,
+	24286, 23823, 23360, 22897, -1000, 1418, -1000, 1313, -1000, -1000,
+	-1000, 30312, 30312, 30312, 48, -1000, -1000, 30312, 930, -1000,
+	-1000, 859, 857, 605, 605, 850, 1015, 1013, 1008, 605,
+	605, 841, 1005, 1109, 191, 825, 821, 811, 887, 1001,
+	118, 864, 827, 803, 30312, 1330, -1000, 166, 617, 218,
+	257, 249, 30312, 167, 1622, 1574, 1250, 400, 348, 1395,
+	30312, 1673, 930, -1000, 8463, -1000, -1000, 1000, 13611, -1000,
+	696, 688, 688, -1000, -1000, -1000, -1000, 733, 30312, 696,
+	-1000, -1000, -1000, 688, 733, 30312, 733, 733, 733, 733,
+	688, 733, 30312, 30312, 30312, 30312, 30312, 30312, 30312, 30312,
+	30312, 7986, 7986, 7986, 576, -1000, 1394, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 115, -1000, -1000, -1000, -1000,
+	-1000, 1811, -1000, -1000, -1000, -58, -1000, 1247, 22434, -1000,
+	-274, -275, -277, -278, -1000, -1000, -1000, -279, -280, -1000,
+	-1000, -1000, 13611, 13611, 13611, 13611, 1033, 578, 15000, 775,
+	724, 15000, 15000, 15000, 15000, 15000, 15000, 15000, 15000, 15000,
+	15000, 15000, 15000, 15000, 15000, 15000, 644, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 930, -1000, 250, 1094, 1094,
+	540, 540, 540, 540, 540, 540, 540, 540, 540, 15463,
+	10357, 8463, 1167, 1809, 1181, 1707, 12222, 12222, 13611, 13611,
+	13148, 12685, 12222, 1609, 651, 888, 30312, -1000, -1000, 14537,
+	-1000, -1000, -1000, -1000, -1000, 1022, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 30312, 30312, 12222, 12222, 12222, 12222, 12222,
+	-257, -1000, -1000, -1000, 1612, 30312, 152, 1246, -1000, -146,
+	17804, 13611, -1000, 30312, 1737, 1735, 998, 997, 1734, 1529,
+	1732, 978, 1731, 975, 1702, 1167, 1706, 1664, 1803, 571,
+	813, 1245, -1000, 1016, 1702, 19656, 1267, -1000, 1706, -1000,
+	-1000, -1000, -1000, 1261, 1179, -1000, 30312, -1000, -1000, 21971,
+	-1000, -1000, 7509, 30312, 248, 30312, -1000, 1265, 1450, -1000,
+	-1000, -1000, 1675, 17341, -1000, 1261, 30312, 1235, 1202, -1000,
+	-1000, 527, 8940, -44, -1000, 8940, 1230, -1000, -16, -34,
+	10820, 539, -1000, -1000, -1000, 2523, 15926, 1121, -1000, 53,
+	-1000, -1000, -1000, 1276, -1000, 1276, 1276, 1276, 1276, 48,
+	48, 48, 48, -1000, -1000, -1000, -1000, -1000, 1316, 1315,
+	-1000, 1276, 1276, 1276, 1276, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -332, 27508, 355,
-	122, 186, 27508, 27508, 27508, 27508, 27508, 402, -1000, -1000,
-	-1000, 159, 27508, 27508, 27508, 27508, 369, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 753, 27508, -1000, -1000, 609, 609,
-	-1000, -1000, 27508, 609, -1000, -1000, -1000, -1000, -1000, -1000,
-	609, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 929, 27508, 27508, -1000, -1000,
-	-1000, -1000, -1000, 93, -29, 183, -1000, -1000, -1000, -1000,
-	1464, -1000, 753, 499, 674, 583, -1000, -1000, 773, -1000,
-	-1000, 2664, -1000, -1000, -1000, -1000, 710, 14005, 14005, 14005,
-	1270, 2664, 2643, 1255, 2256, 444, 684, 684, 454, 454,
-	454, 454, 454, 831, 831, -1000, -1000, -1000, -1000, 953,
-	-1000, -1000, -1000, 953, 10849, 10849, 1107, 1146, 423, -1000,
-	1185, -1000, -1000, 1468, 996, 996, 722, 672, 595, 1511,
-	996, 564, 1510, 996, 996, 10849, -1000, -1000, 597, -1000,
-	12658, 953, -1000, 1518, 1102, 1100, 996, 953, 953, 996,
-	996, 27508, -1000, -273, -1000, -41, 450, 1146, -1000, 19868,
-	-1000, -1000, 953, 1078, 1401, -1000, -1000, 1375, -1000, 1285,
-	12658, 12658, 12658, -1000, -1000, -1000, 1401, 1469, -1000, 1295,
-	1294, 1505, 10849, 19419, 1374, -1000, -1000, -1000, 422, 1505,
-	1092, 1146, -1000, 27508, 19419, 19419, 19419, 19419, 19419, -1000,
-	1266, 1249, -1000, 1263, 1248, 1276, 27508, -1000, 1020, 1011,
-	17623, 204, 1076, 19419, 27508, -1000, -1000, 19419, 27508, 6275,
-	-1000, 1099, -61, -39, -1000, -1000, -1000, -1000, 753, -1000,
-	806, -1000, 2276, -1000, 340, -1000, -1000, -1000, -1000, 443,
-	32, -1000, -1000, 39, 39, -1000, -1000, 419, 525, 419,
-	419, 419, 927, 927, -1000, -1000, -1000, -1000, -1000, 723,
-	-1000, -1000, -1000, 718, -1000, -1000, 852, 1223, 105, -1000,
-	-1000, 511, 922, 1378, -1000, -1000, 1037, 352, -1000, 27508,
-	-1000, 1228, 1218, 1216, -1000, -1000, -1000, -1000, -1000, 3612,
-	27508, 1018, -1000, 108, 27508, 1021, 27508, -1000, 1014, 27508,
-	-1000, 928, -1000, -1000, 7664, -1000, 27508, 1146, -1000, -1000,
-	-1000, -1000, 383, 1413, 1411, 117, 108, 419, 928, -1000,
-	-1000, -1000, -1000, -1000, -335, 998, 27508, 133, -1000, 1152,
-	894, -1000, 1183, -1000, -1000, -1000, -1000, 114, 178, 161,
-	329, -1000, 366, 1223, 27508, -1000, -1000, -1000, 589, -1000,
-	-1000, 589, -1000, -1000, -1000, -1000, -1000, -1000, 1393, -34,
-	-309, -1000, -306, -1000, -1000, -1000, -1000, 1270, 2664, 2380,
-	-1000, 14005, 14005, -1000, -1000, 996, 996, 10849, 7664, 1499,
-	1401, -1000, -1000, 412, 590, 412, 14005, 14005, -1000, 14005,
-	14005, -1000, -129, 1101, 549, -1000, 12658, 749, -1000, -1000,
-	14005, 14005, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 373, 371, 363, 27508, -1000, -1000, -1000, 841, 920,
-	1277, 753, 753, -1000, -1000, 27508, -1000, -1000, -1000, -1000,
-	1503, 12658, -1000, 1097, -1000, 5812, 1468, 1205, 27508, 1146,
-	1534, 15814, 27508, 1080, -1000, 533, 1307, 1194, 1204, 1254,
-	-1000, -1000, -1000, -1000, 1247, -1000, 1246, -1000, -1000, -1000,
-	-1000, -1000, 1011, 1505, 19419, 1075, -1000, 1075, -1000, 415,
-	-1000, -1000, -1000, -38, -56, -1000, -1000, -1000, 1955, -1000,
-	-1000, -1000, 667, 14005, 1526, -1000, 915, 1420, -1000, 1418,
-	-1000, -1000, 419, 419, -1000, -1000, -1000, -1000, -1000, -1000,
-	987, -1000, 984, 1096, 982, 59, -1000, 1174, 1392, 511,
-	511, -1000, 712, -1000, 928, -1000, 27508, -1000, 27508, 27508,
-	27508, 1492, 1090, -1000, 27508, -1000, -1000, 27508, -1000, -1000,
-	1291, 105, 980, -1000, -1000, -1000, 193, 27508, -1000, 896,
-	108, -1000, -1000, -1000, -1000, -1000, -1000, 1148, -1000, -1000,
-	-1000, 1008, -1000, -140, 928, 27508, 27508, 27508, -1000, 27508,
-	-1000, -1000, -1000, 609, 609, -1000, 1391, -1000, 928, -1000,
-	14005, 2664, 2664, -1000, -1000, 953, -1000, 1468, -1000, 953,
-	1150, 1150, -1000, 1150, 1151, -1000, 1150, 84, 1150, 83,
-	953, 953, 2548, 2463, 2342, 2154, 1146, -120, -1000, 753,
-	12658, 2133, 1772, 1146, 1146, 1146, 973, 913, 39, -1000,
-	-1000, -1000, 1501, 1489, 753, -1000, -1000, -1000, 1409, 1034,
-	1069, -1000, -1000, 10400, 977, 1290, 414, 973, 1499, 27508,
-	12658, -1000, -1000, 12658, 1149, -1000, 12658, -1000, -1000, -1000,
-	1499, 1499, 1075, -1000, -1000, 453, -1000, -1000, -1000, -1000,
-	-1000, 2664, -80, -1000, -1000, -1000, -1000, -1000, 39, 905,
-	39, 711, -1000, 679, -1000, -1000, -213, -1000, -1000, 1091,
-	1222, -1000, -1000, 1148, -1000, -1000, -1000, 27508, 27508, -1000,
-	-1000, 188, -1000, 265, 971, -1000, -169, -1000, -1000, 1447,
-	27508, -1000, -1000, 7664, -1000, -1000, 1147, 1196, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2664, -1000, 1401, -1000, -1000, 205,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14005, 14005,
-	14005, 14005, 14005, 1468, 901, 753, 14005, 14005, 18970, 27508,
-	27508, 17161, 39, 6, -1000, 12658, 12658, 1402, -1000, 1146,
-	-1000, 1109, 27508, 1146, 27508, -1000, 1468, -1000, 753, 753,
-	27508, 753, 1468, -1000, -1000, 419, -1000, 419, 990, 963,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1443, 1090,
-	-1000, 173, 27508, -1000, 193, -1000, -177, -178, 1161, 969,
-	1082, -1000, 507, 27508, 27508, -1000, -1000, -1000, 1518, 1518,
-	1518, 1518, 502, 953, -1000, 1518, 1518, 967, -1000, 967,
-	967, 450, -267, -1000, 1339, 1336, 753, 1078, 1524, -1000,
-	1146, 1534, 411, 1069, -1000, -1000, 961, -1000, -1000, -1000,
-	-1000, -1000, 1161, 1146, 1145, -1000, -1000, -1000, 177, -1000,
-	7664, 5349, 959, -1000, -1000, -1000, -1000, -1000, 953, 169,
-	-157, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6, 269,
-	-1000, 1302, 1300, 1487, 27508, 1069, 27508, -1000, 177, 13107,
-	27508, -1000, -48, -1000, -1000, -1000, -1000, -1000, 1183, -1000,
-	1275, -134, -166, 1308, 1310, 1310, 1336, 1480, 1324, 1317,
-	-1000, 890, 1067, -1000, -1000, 1518, 953, 957, 280, -1000,
-	-1000, -140, -1000, 1274, -1000, 1306, 769, -1000, -1000, -1000,
-	-1000, 888, -1000, 1473, 1471, -1000, -1000, -1000, 1203, 136,
-	-1000, -146, -1000, 691, -1000, -1000, -1000, 887, 875, 1202,
-	-1000, 1509, -1000, -164, -1000, -1000, -1000, -1000, -1000, 1517,
-	469, 469, -167, -1000, -1000, -1000, 251, 741, -1000, -1000,
-	-1000, -1000, -1000,
+	-1000, -1000, 1314, 1314, 1314, 1278, 1278, 336, -1000, 13611,
+	181, 30312, 1668, 802, 166, 30312, 1392, -1000, 30312, 1358,
+	1358, 1358, -1000, 1671, 1073, 1054, -1000, 1242, -1000, -1000,
+	1730, -1000, -1000, 726, 706, 687, 546, 30312, 157, 247,
+	-1000, 324, -1000, 30312, 1289, 1639, 548, 930, -1000, 930,
+	-1000, -1000, -1000, -1000, 525, -1000, -1000, 930, 1237, -1000,
+	1248, 741, 675, 728, 673, 1237, -1000, -1000, -86, 1237,
+	-1000, 1237, -1000, 1237, -1000, 1237, -1000, 1237, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 603, 30312, 157, 644,
+	-1000, 398, -1000, -1000, 644, 644, -1000, -1000, -1000, -1000,
+	974, 971, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -334, 30312,
+	411, 144, 195, 30312, 30312, 30312, 30312, 30312, 508, -1000,
+	-1000, -1000, 209, 30312, 30312, 30312, 30312, 486, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 888, 30312, -1000, -1000, 733,
+	733, -1000, -1000, 30312, 733, -1000, -1000, -1000, -1000, -1000,
+	-1000, 733, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 970, 30312, 30312, -1000,
+	-1000, -1000, -1000, -1000, 38, -21, 226, -1000, -1000, -1000,
+	-1000, 1699, -1000, 888, 578, 771, 606, -1000, -1000, 826,
+	-1000, -1000, 2593, -1000, -1000, -1000, -1000, 775, 15000, 15000,
+	15000, 1269, 2593, 2524, 2080, 1424, 540, 702, 702, 541,
+	541, 541, 541, 541, 867, 867, -1000, -1000, -1000, -1000,
+	1022, -1000, -1000, -1000, 1022, 12222, 12222, 1234, 1261, 517,
+	-1000, 1329, -1000, 1167, -1000, 1702, 1157, 1157, 804, 1020,
+	633, 1779, 1157, 631, 1761, 1157, 1157, 12222, -1000, -1000,
+	658, -1000, 13611, 1022, -1000, 1369, 1233, 1231, 1157, 1022,
+	1022, 1157, 1157, -1000, -254, -1000, 30312, 1128, 515, -1000,
+	-1000, 30312, -1000, -258, -1000, -40, 489, 1261, -1000, 21508,
+	-1000, -1000, 1022, 1095, -1000, 964, 957, -1000, -1000, 956,
+	1729, 952, -1000, 951, -1000, 1616, -1000, -1000, 1559, -1000,
+	1460, 13611, 13611, 13611, -1000, -1000, -1000, 1616, 1712, -1000,
+	1492, 1475, 1759, 12222, 19193, 1706, -1000, -1000, 30312, -1000,
+	-1000, -1000, 502, 1759, 1260, 1261, -1000, 30312, 19193, 19193,
+	19193, 19193, 19193, -1000, 1428, 1423, -1000, 1415, 1407, 1458,
+	30312, -1000, 1177, 1167, 17341, 13611, 248, 1117, 19193, 30312,
+	-1000, -1000, 19193, 30312, 7032, -1000, 1230, -44, -31, -1000,
+	-1000, -1000, -1000, 888, -1000, 902, -1000, 229, -1000, 338,
+	-1000, -1000, -1000, -1000, 551, 50, -1000, -1000, 48, 48,
+	-1000, -1000, 539, 678, 539, 539, 539, 950, 950, -1000,
+	-1000, -1000, -1000, -1000, 793, -1000, -1000, -1000, 786, -1000,
+	-1000, 1009, 1385, 181, -1000, -1000, 605, 946, 1589, -1000,
+	-1000, 1106, 409, -1000, 30312, -1000, 1391, 1388, 1387, -1000,
+	-1000, -1000, -1000, -1000, 2412, 30312, 1175, -1000, 147, 30312,
+	1087, 30312, -1000, 1171, 30312, -1000, 930, -1000, -1000, 8463,
+	-1000, 30312, 1261, -1000, -1000, -1000, -1000, 459, 1621, 1610,
+	157, 147, 539, 930, -1000, -1000, -1000, -1000, -1000, -337,
+	1169, 30312, 183, -1000, 1288, 1019, -1000, 1347, -1000, -1000,
+	-1000, -1000, 132, 217, 206, 381, -1000, 461, 1385, 30312,
+	-1000, -1000, -1000, 688, -1000, -1000, 688, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1607, -22, -302, -1000, -299, -1000, -1000,
+	-1000, -1000, 1269, 2593, 2403, -1000, 15000, 15000, -1000, -152,
+	1157, 1157, 12222, 8463, 1707, -1000, 1616, -1000, -1000, 570,
+	644, 570, 15000, 15000, -1000, 15000, 15000, -1000, -79, 1251,
+	632, -1000, 13611, 942, -1000, -1000, 15000, 15000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 937, 1126, -1000, 615, -1000, 30312,
+	30312, -1000, -1000, -1000, -1000, 422, 420, 415, 30312, -1000,
+	-1000, -1000, -1000, -1000, 932, -1000, -1000, -1000, 820, 929,
+	1451, 888, 888, -1000, -1000, 30312, -1000, -1000, -1000, -1000,
+	1719, 13611, -1000, 1222, -1000, -1000, 6555, 1702, 1380, 30312,
+	1261, 1811, 16865, 30312, 1228, 1450, 1350, 1372, 1377, -1000,
+	-1000, -1000, -1000, 1422, -1000, 1419, -1000, -1000, -1000, -1000,
+	-1000, 1167, 873, 1759, 19193, 1218, -1000, 1218, -1000, 491,
+	-1000, -1000, -1000, -26, -52, -1000, -1000, -1000, 2523, -1000,
+	-1000, -1000, 722, 15000, 1800, -1000, 921, 1635, -1000, 1634,
+	-1000, -1000, 539, 539, -1000, -1000, -1000, -1000, -1000, -1000,
+	1153, -1000, 1145, 1220, 1142, 103, -1000, 1271, 1606, 605,
+	605, -1000, 776, -1000, 930, -1000, 30312, -1000, 30312, 30312,
+	30312, 1728, 1219, 30312, -1000, -1000, 30312, -1000, -1000, 1474,
+	181, 1137, -1000, -1000, -1000, 247, 30312, -1000, 1094, 147,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1270, -1000, -1000, -1000,
+	1085, -1000, -89, 930, 30312, 30312, 30312, -1000, 30312, -1000,
+	-1000, -1000, 733, 733, -1000, 1605, -1000, 930, -1000, 15000,
+	2593, 2593, -1000, 21045, -1000, -1000, 1022, -1000, 1702, -1000,
+	1022, 1276, 1276, -1000, 1276, 1278, -1000, 1276, 102, 1276,
+	101, 1022, 1022, 1875, 1858, 1773, 1716, 1261, -73, -1000,
+	888, 13611, 1647, 1402, 106, 30312, 13611, 483, -1000, 1261,
+	1261, 1261, 1120, -1000, 906, 48, -1000, -1000, -1000, 1754,
+	1721, 888, -1000, -1000, -1000, 1661, 1064, 1130, -1000, -1000,
+	11759, 1128, 1472, 1120, 1707, -1000, -1000, 13611, 1272, -1000,
+	13611, -1000, -1000, -1000, 1707, 815, 1707, 1218, -1000, -1000,
+	550, -1000, -1000, -1000, -1000, -1000, 2593, -85, -1000, -1000,
+	-1000, -1000, -1000, 48, 904, 48, 774, -1000, 754, -1000,
+	-1000, -174, -1000, -1000, 1243, 1401, -1000, -1000, 1270, -1000,
+	-1000, -1000, 30312, -1000, -1000, 239, -1000, 315, 1092, -1000,
+	-113, -1000, -1000, 1680, -1000, -1000, 8463, -1000, -1000, 1264,
+	1351, -1000, -1000, -1000, -1000, -1000, -1000, 2593, 930, -1000,
+	-1000, 1616, -1000, -1000, 214, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 15000, 15000, 15000, 15000, 15000, 1702, 903,
+	888, 15000, 15000, 1663, -1000, -1000, -1000, 888, 30312, 20582,
+	30312, 30312, 18730, 48, 20, -162, 13611, 13611, 1625, -1000,
+	1261, -1000, 1211, 1261, -1000, 1702, 888, 30312, 888, 23,
+	-1000, -1000, 1702, -1000, -1000, 539, -1000, 539, 1061, 1029,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1678, 1219,
+	236, 30312, -1000, 247, -1000, -125, -131, 1300, 1217, -1000,
+	593, 30312, 30312, 1022, 245, -1000, -1000, -1000, -1000, 1369,
+	1369, 1369, 1369, 295, 1022, -1000, 1369, 1369, 458, -1000,
+	1083, -1000, 1083, 1083, 489, -241, -1000, 1567, 1565, -1000,
+	30312, 888, 1095, 1798, -1000, 1261, 1811, 1130, -1000, 1080,
+	1022, 1261, -1000, -1000, -1000, -1000, -1000, 1300, 1261, 1263,
+	-1000, -1000, -1000, 244, 8463, 6078, 1070, -1000, -1000, 1707,
+	1720, -1000, -1000, -1000, -1000, 1022, 190, -94, -1000, -1000,
+	-1000, 30312, -1000, -1000, -1000, -1000, 20, 285, -1000, 1498,
+	1494, 1718, 1216, -1000, 1677, 30312, 1130, -1000, 1675, 29849,
+	244, 14074, 30312, -1000, -27, -1000, -1000, -1000, -1000, -1000,
+	1347, -239, 13611, -1000, 1433, -84, -100, 48, 1521, 1525,
+	1525, 1565, 1709, 1563, 1527, 901, 30312, 1261, 1126, 30312,
+	1037, -1000, 3381, 111, -1000, 1369, 1022, 1027, 326, -1000,
+	-1000, -89, -1000, 129, -1000, -1000, 1095, -1000, 1399, -1000,
+	-1000, 1500, 782, -1000, -1000, -1000, -1000, 895, -1000, 1705,
+	1704, -1000, -1000, 930, -1000, -1000, 29849, -196, 178, 23,
+	815, -1000, -1000, 1362, 168, -1000, -1000, 135, -236, -182,
+	-184, -1000, -1000, 15000, -92, -1000, 780, -1000, -1000, -1000,
+	889, 814, 1022, -1000, -1000, 815, -200, -1000, 23, 1361,
+	-1000, 1787, -1000, 669, -1000, -1000, -1000, -1000, -1000, 15463,
+	-96, -1000, -1000, -1000, -1000, -1000, 128, 815, -1000, -1000,
+	1789, 472, 472, 135, -1000, -109, 1360, -1000, -1000, 815,
+	-1000, -1000, -1000, -1000, 329, 847, -1000, -1000, -1000, -188,
+	-1000, -1000, -1000, -1000, 128, -1000, 1273, -286, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1788, 1787, 14, 82, 79, 1786, 1785, 1783, 1782,
-	130, 125, 122, 1781, 1779, 1777, 1775, 1774, 1773, 1772,
-	1770, 1769, 1766, 1765, 1764, 62, 120, 38, 40, 124,
-	1763, 1762, 45, 1761, 1760, 1759, 127, 126, 470, 1758,
-	128, 1757, 1755, 1754, 1753, 1748, 1747, 1746, 1744, 1743,
-	1742, 1741, 1740, 1737, 151, 1736, 1735, 9, 1734, 47,
-	1733, 1730, 1725, 1720, 1719, 84, 1718, 1715, 1714, 111,
-	1711, 1710, 44, 336, 49, 72, 1709, 1705, 80, 770,
-	1704, 100, 119, 1703, 908, 1702, 39, 97, 85, 1701,
-	41, 1700, 1698, 102, 1697, 1694, 1693, 68, 1691, 1690,
-	3134, 1689, 67, 75, 19, 32, 1686, 1683, 1682, 1680,
-	33, 162, 1679, 1677, 28, 1676, 1675, 146, 1674, 81,
-	27, 1673, 16, 18, 21, 1671, 83, 1668, 8, 50,
-	31, 1667, 77, 1666, 1664, 1662, 1660, 25, 1645, 73,
-	101, 22, 1644, 1643, 4, 11, 1642, 1641, 1640, 1638,
-	1636, 1635, 10, 1634, 1633, 1631, 56, 1630, 6, 24,
-	71, 116, 29, 7, 1629, 143, 1626, 26, 114, 65,
-	108, 1624, 1623, 1622, 849, 55, 134, 1621, 1620, 46,
-	1619, 118, 117, 1617, 1362, 1616, 1615, 78, 1271, 2560,
-	13, 109, 1614, 1611, 1627, 52, 74, 23, 1610, 1609,
-	1608, 121, 112, 63, 863, 42, 1606, 1605, 1604, 1603,
-	1602, 1597, 1596, 87, 34, 20, 105, 30, 1595, 1594,
-	1593, 66, 37, 1592, 107, 106, 70, 86, 1591, 115,
-	96, 57, 1590, 60, 1589, 1588, 1581, 1579, 43, 1576,
-	1574, 1573, 1570, 104, 88, 64, 35, 1569, 36, 91,
-	99, 89, 1567, 17, 123, 12, 1565, 3, 0, 1557,
-	5, 138, 1368, 113, 1555, 1553, 1, 1549, 2, 1547,
-	1546, 76, 1544, 1543, 1542, 1541, 3253, 1439, 110, 1540,
-	139,
+	0, 2228, 124, 84, 107, 110, 2227, 141, 2226, 2225,
+	1838, 1835, 1831, 2224, 2222, 2208, 2194, 2186, 2185, 2183,
+	2177, 2169, 2165, 2162, 2161, 69, 123, 35, 44, 140,
+	2160, 2159, 59, 2157, 2156, 2154, 131, 130, 154, 2153,
+	159, 2152, 2151, 2149, 2148, 2146, 2145, 2144, 2138, 2137,
+	2136, 2134, 2133, 2131, 2130, 2128, 2127, 2123, 2121, 2119,
+	2118, 2117, 2114, 2113, 2112, 136, 2111, 2110, 15, 2108,
+	66, 2100, 2095, 2092, 2091, 2090, 2089, 2088, 102, 2087,
+	2082, 2081, 106, 2080, 2079, 54, 557, 51, 83, 2077,
+	2074, 94, 217, 2073, 111, 134, 2072, 572, 2071, 68,
+	95, 92, 2070, 47, 2069, 2068, 103, 2067, 2065, 2063,
+	79, 2058, 2056, 62, 2054, 76, 91, 24, 81, 2053,
+	2052, 2051, 2050, 41, 46, 2049, 2048, 27, 2045, 2044,
+	153, 2043, 100, 18, 2041, 22, 33, 29, 2039, 101,
+	2038, 36, 61, 37, 2035, 96, 2032, 2031, 2030, 2029,
+	31, 2025, 2024, 7, 2023, 2020, 2019, 2017, 3, 2003,
+	2002, 1986, 1817, 132, 885, 1985, 5, 8, 1978, 1,
+	2, 12, 90, 115, 80, 1972, 1971, 13, 19, 1970,
+	1969, 1964, 17, 1961, 1960, 11, 1958, 1953, 1951, 38,
+	50, 49, 28, 86, 70, 30, 23, 1950, 163, 1948,
+	34, 121, 78, 119, 1946, 1943, 1942, 212, 93, 142,
+	1941, 1940, 64, 1939, 135, 125, 1938, 1572, 1936, 1935,
+	73, 1232, 1984, 25, 122, 1934, 1932, 2636, 60, 87,
+	32, 16, 1931, 1929, 98, 126, 53, 209, 56, 10,
+	1928, 1925, 1923, 1922, 1901, 1899, 275, 20, 72, 128,
+	40, 1898, 1897, 1890, 71, 43, 1887, 118, 116, 82,
+	105, 1885, 133, 108, 74, 1884, 343, 1882, 1881, 1877,
+	1875, 48, 1873, 1872, 1871, 1870, 113, 109, 77, 42,
+	1869, 45, 138, 114, 112, 1868, 26, 139, 21, 1867,
+	9, 0, 1866, 14, 129, 1607, 104, 1864, 1861, 6,
+	1860, 4, 1858, 1857, 97, 1841, 1840, 1829, 1825, 3832,
+	2547, 120, 1821, 146,
 }
 
 var yyR1 = [...]int{
-	0, 274, 275, 275, 1, 1, 1, 1, 1, 1,
+	0, 307, 308, 308, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 258, 258, 258, 261, 261, 21,
-	50, 3, 3, 3, 3, 2, 2, 8, 9, 4,
-	5, 5, 10, 10, 61, 61, 11, 12, 12, 12,
-	12, 278, 278, 95, 95, 93, 93, 94, 94, 160,
-	160, 13, 14, 14, 170, 170, 169, 169, 169, 171,
-	171, 171, 171, 204, 204, 15, 15, 15, 15, 15,
-	70, 70, 260, 260, 259, 257, 257, 256, 256, 255,
-	23, 24, 33, 33, 33, 33, 34, 35, 262, 262,
-	234, 39, 39, 38, 38, 38, 38, 40, 40, 37,
-	37, 36, 36, 236, 236, 223, 223, 235, 235, 235,
-	235, 235, 235, 235, 222, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 206, 206, 206, 206,
-	209, 209, 207, 207, 207, 207, 207, 207, 207, 207,
-	207, 208, 208, 208, 208, 208, 210, 210, 210, 210,
-	210, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 211, 211, 211, 212, 212, 212, 212,
-	212, 212, 212, 212, 221, 221, 213, 213, 216, 216,
-	217, 217, 217, 218, 218, 219, 219, 214, 214, 214,
-	215, 215, 215, 224, 248, 248, 247, 247, 245, 245,
-	245, 245, 233, 233, 242, 242, 242, 242, 242, 232,
-	232, 228, 228, 228, 229, 229, 230, 230, 227, 227,
-	231, 231, 244, 244, 243, 225, 225, 226, 226, 250,
-	250, 250, 250, 251, 267, 268, 266, 266, 266, 266,
-	266, 59, 59, 59, 183, 183, 183, 240, 240, 239,
-	239, 239, 241, 241, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 178, 178, 178, 265, 265, 265, 265,
-	265, 265, 264, 264, 264, 237, 237, 237, 263, 263,
-	129, 129, 130, 130, 30, 30, 30, 30, 30, 30,
-	29, 29, 29, 25, 25, 25, 25, 25, 25, 25,
+	1, 1, 1, 1, 1, 1, 1, 291, 291, 291,
+	294, 294, 21, 50, 50, 50, 50, 62, 62, 62,
+	56, 61, 61, 61, 57, 57, 58, 58, 58, 58,
+	58, 58, 59, 59, 60, 60, 60, 60, 63, 63,
+	63, 64, 64, 3, 3, 3, 3, 2, 2, 8,
+	9, 4, 162, 162, 164, 164, 163, 5, 5, 10,
+	10, 74, 74, 11, 12, 12, 12, 12, 311, 311,
+	108, 108, 106, 106, 107, 107, 193, 193, 13, 14,
+	14, 203, 203, 202, 202, 202, 204, 204, 204, 204,
+	237, 237, 15, 15, 15, 15, 15, 83, 83, 293,
+	293, 292, 290, 290, 289, 289, 288, 23, 24, 33,
+	33, 33, 33, 34, 35, 295, 295, 267, 39, 39,
+	38, 38, 38, 38, 40, 40, 37, 37, 36, 36,
+	269, 269, 256, 256, 268, 268, 268, 268, 268, 268,
+	268, 255, 176, 176, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 239, 239, 239, 239, 242, 242, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 241, 241,
+	241, 241, 241, 243, 243, 243, 243, 243, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 245, 245, 245, 245, 245, 245, 245,
+	245, 254, 254, 246, 246, 249, 249, 250, 250, 250,
+	251, 251, 252, 252, 247, 247, 247, 248, 248, 248,
+	257, 281, 281, 280, 280, 278, 278, 278, 278, 266,
+	266, 275, 275, 275, 275, 275, 265, 265, 261, 261,
+	261, 262, 262, 263, 263, 260, 260, 264, 264, 277,
+	277, 276, 258, 258, 259, 259, 283, 283, 283, 283,
+	284, 300, 301, 299, 299, 299, 299, 299, 70, 70,
+	70, 216, 216, 216, 273, 273, 272, 272, 272, 274,
+	274, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	211, 211, 211, 298, 298, 298, 298, 298, 298, 297,
+	297, 297, 270, 270, 270, 296, 296, 142, 142, 143,
+	143, 30, 30, 30, 30, 30, 30, 29, 29, 29,
+	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 31, 31, 26, 26, 26,
-	26, 26, 26, 26, 26, 26, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 220, 220, 220, 252, 252, 253, 253,
-	17, 22, 22, 18, 18, 18, 18, 19, 19, 41,
+	25, 25, 31, 31, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 287, 287,
+	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
+	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
+	253, 253, 253, 285, 285, 286, 286, 17, 22, 22,
+	18, 18, 18, 18, 19, 19, 41, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 269, 269, 177, 177, 185, 185, 176,
-	176, 175, 175, 175, 179, 179, 179, 180, 180, 273,
-	273, 273, 43, 43, 45, 45, 46, 47, 47, 199,
-	199, 200, 200, 48, 49, 60, 60, 60, 60, 60,
-	60, 62, 62, 62, 7, 7, 7, 7, 56, 56,
-	56, 6, 6, 44, 44, 51, 270, 270, 271, 272,
-	272, 272, 272, 52, 20, 20, 20, 20, 20, 20,
-	77, 77, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 71, 71, 71, 66, 66, 279,
-	54, 55, 55, 69, 69, 69, 63, 63, 63, 68,
-	68, 68, 74, 74, 76, 76, 76, 76, 76, 78,
-	78, 78, 78, 78, 78, 73, 73, 75, 75, 75,
-	75, 192, 192, 192, 191, 191, 85, 85, 86, 86,
-	87, 87, 88, 88, 88, 127, 103, 103, 159, 159,
-	158, 158, 161, 161, 89, 89, 89, 89, 90, 90,
-	91, 91, 92, 92, 198, 198, 197, 197, 197, 196,
-	196, 96, 96, 96, 98, 97, 97, 97, 97, 99,
-	99, 101, 101, 100, 100, 102, 104, 104, 104, 104,
-	104, 105, 105, 84, 84, 84, 84, 84, 84, 84,
-	84, 173, 173, 107, 107, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 118, 118, 118, 118, 118,
-	118, 108, 108, 108, 108, 108, 108, 108, 72, 72,
-	119, 119, 119, 126, 120, 120, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	115, 115, 115, 115, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 280, 280, 117, 116, 116, 116, 116,
-	116, 116, 116, 67, 67, 67, 67, 67, 203, 203,
-	203, 205, 205, 205, 205, 205, 205, 205, 205, 205,
-	205, 205, 205, 205, 133, 133, 64, 64, 131, 131,
-	132, 134, 134, 128, 128, 128, 110, 110, 110, 110,
-	110, 110, 110, 110, 112, 112, 112, 135, 135, 136,
-	136, 137, 137, 138, 138, 139, 140, 140, 140, 141,
-	141, 141, 141, 32, 32, 32, 32, 32, 27, 27,
-	27, 27, 28, 28, 28, 79, 79, 79, 79, 81,
-	81, 80, 80, 57, 57, 58, 58, 58, 82, 82,
-	83, 83, 83, 83, 156, 156, 156, 142, 142, 142,
-	142, 148, 148, 148, 144, 144, 146, 146, 146, 147,
-	147, 147, 145, 151, 151, 153, 153, 152, 152, 150,
-	150, 155, 155, 154, 154, 149, 149, 109, 109, 109,
-	109, 109, 157, 157, 157, 157, 162, 162, 122, 122,
-	124, 124, 123, 125, 163, 163, 167, 164, 164, 168,
-	168, 168, 168, 168, 165, 165, 166, 166, 193, 193,
-	193, 172, 172, 184, 184, 181, 181, 182, 182, 174,
-	174, 186, 186, 186, 53, 121, 121, 249, 249, 246,
-	189, 189, 190, 190, 194, 194, 195, 195, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 276, 277, 201, 202,
-	202, 202,
+	42, 302, 302, 210, 210, 218, 218, 209, 209, 208,
+	208, 208, 212, 212, 212, 213, 213, 306, 306, 306,
+	43, 43, 45, 45, 46, 47, 47, 232, 232, 233,
+	233, 48, 49, 71, 71, 71, 71, 71, 71, 72,
+	72, 72, 72, 75, 75, 75, 7, 7, 7, 7,
+	67, 67, 67, 6, 6, 6, 44, 44, 51, 303,
+	303, 304, 305, 305, 305, 305, 52, 20, 20, 20,
+	20, 20, 20, 90, 90, 78, 78, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 84, 84, 84,
+	79, 79, 312, 65, 66, 66, 82, 82, 82, 76,
+	76, 76, 81, 81, 81, 87, 87, 89, 89, 89,
+	89, 89, 91, 91, 91, 91, 91, 91, 86, 86,
+	88, 88, 88, 88, 225, 225, 225, 224, 224, 98,
+	98, 99, 99, 100, 100, 101, 101, 101, 101, 165,
+	167, 168, 168, 166, 166, 166, 166, 166, 166, 166,
+	166, 169, 169, 169, 170, 170, 140, 116, 116, 192,
+	192, 191, 191, 194, 194, 102, 102, 102, 102, 103,
+	103, 104, 104, 105, 105, 231, 231, 230, 230, 230,
+	229, 229, 109, 109, 109, 111, 110, 110, 110, 110,
+	112, 112, 114, 114, 113, 113, 115, 117, 117, 117,
+	117, 117, 118, 118, 97, 97, 97, 97, 97, 97,
+	97, 97, 206, 206, 120, 120, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 131, 131, 131, 131,
+	131, 131, 121, 121, 121, 121, 121, 121, 121, 85,
+	85, 132, 132, 132, 139, 139, 133, 133, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 128, 128, 128, 128, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 313, 313, 130, 129, 129,
+	129, 129, 129, 129, 129, 80, 80, 80, 80, 80,
+	236, 236, 236, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 146, 146, 77, 77,
+	144, 144, 145, 147, 147, 141, 141, 141, 123, 123,
+	123, 123, 123, 123, 123, 123, 125, 125, 125, 148,
+	148, 149, 149, 160, 160, 161, 161, 171, 152, 152,
+	152, 153, 154, 154, 155, 155, 156, 156, 156, 157,
+	157, 158, 158, 158, 158, 158, 159, 159, 159, 150,
+	150, 151, 151, 172, 173, 173, 173, 174, 174, 174,
+	174, 32, 32, 32, 32, 32, 27, 27, 27, 27,
+	28, 28, 28, 92, 92, 92, 92, 94, 94, 93,
+	93, 68, 68, 69, 69, 69, 95, 95, 96, 96,
+	96, 96, 189, 189, 189, 175, 175, 175, 175, 181,
+	181, 181, 177, 177, 179, 179, 179, 180, 180, 180,
+	178, 184, 184, 186, 186, 185, 185, 183, 183, 188,
+	188, 187, 187, 182, 182, 122, 122, 122, 122, 122,
+	190, 190, 190, 190, 195, 195, 135, 135, 137, 137,
+	136, 138, 196, 196, 200, 197, 197, 201, 201, 201,
+	201, 201, 198, 198, 199, 199, 226, 226, 226, 205,
+	205, 217, 217, 214, 214, 215, 215, 207, 207, 219,
+	219, 219, 53, 54, 73, 73, 73, 55, 55, 134,
+	134, 282, 282, 279, 222, 222, 223, 223, 227, 227,
+	228, 228, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 309, 310, 234, 235, 235, 235,
 }
 
 var yyR2 = [...]int{
-	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 0, 1, 2,
-	3, 5, 6, 6, 7, 4, 6, 5, 7, 8,
-	1, 3, 7, 8, 1, 1, 9, 9, 8, 7,
-	7, 1, 1, 1, 3, 1, 3, 1, 3, 0,
-	4, 3, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 6, 11, 2,
-	0, 2, 0, 2, 1, 0, 2, 1, 3, 3,
-	5, 3, 6, 7, 7, 7, 4, 2, 1, 1,
-	4, 0, 1, 1, 1, 2, 2, 0, 1, 4,
-	4, 4, 4, 2, 4, 1, 3, 1, 1, 3,
-	4, 3, 3, 3, 3, 0, 2, 3, 3, 4,
-	2, 3, 3, 2, 3, 2, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 4, 4, 2, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 1, 0, 1, 0, 2, 2,
-	0, 2, 2, 5, 0, 1, 1, 2, 1, 3,
-	2, 3, 0, 1, 3, 3, 3, 4, 2, 0,
-	2, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	0, 1, 1, 3, 3, 3, 1, 3, 1, 10,
-	11, 11, 12, 5, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 1, 0, 1, 2, 0, 1, 1,
-	3, 2, 1, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	0, 2, 0, 2, 0, 1, 5, 1, 3, 7,
-	1, 3, 3, 1, 2, 2, 2, 5, 5, 5,
-	6, 6, 5, 5, 2, 2, 2, 2, 3, 3,
-	3, 4, 1, 3, 5, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 4, 4, 2,
-	10, 3, 6, 7, 5, 5, 5, 12, 7, 5,
-	9, 5, 3, 7, 4, 4, 4, 4, 3, 3,
-	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 0, 2, 2, 1, 3, 8, 8,
-	3, 3, 5, 6, 6, 5, 4, 3, 2, 3,
-	3, 3, 7, 3, 3, 3, 3, 4, 7, 5,
-	2, 4, 4, 4, 4, 4, 5, 5, 4, 4,
-	4, 4, 4, 4, 4, 4, 4, 4, 4, 2,
-	4, 2, 4, 5, 4, 3, 3, 5, 2, 3,
-	3, 3, 3, 1, 1, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
-	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
-	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 3, 3, 2, 2, 3, 1, 3, 2, 1,
-	2, 1, 2, 2, 3, 3, 6, 4, 7, 6,
-	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
-	1, 1, 1, 3, 3, 3, 3, 7, 0, 3,
-	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
-	5, 0, 2, 1, 3, 3, 3, 2, 3, 1,
-	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
-	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 5, 6, 4, 4, 6, 6, 6, 8,
-	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	0, 1, 2, 5, 4, 3, 5, 0, 1, 1,
+	11, 0, 1, 1, 1, 1, 5, 6, 5, 4,
+	5, 4, 1, 1, 5, 5, 4, 4, 0, 3,
+	3, 0, 3, 5, 6, 6, 7, 4, 6, 5,
+	7, 9, 2, 3, 1, 3, 4, 1, 3, 7,
+	8, 1, 1, 9, 9, 8, 7, 7, 1, 1,
+	1, 3, 1, 3, 1, 3, 0, 4, 3, 5,
+	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 2, 6, 11, 2, 0, 2, 0,
+	2, 1, 0, 2, 1, 3, 3, 5, 3, 6,
+	7, 7, 7, 4, 2, 1, 1, 4, 0, 1,
+	1, 1, 2, 2, 0, 1, 4, 4, 4, 4,
+	2, 4, 1, 3, 1, 1, 3, 4, 3, 3,
+	3, 3, 0, 2, 3, 3, 4, 2, 3, 3,
+	2, 3, 2, 3, 1, 1, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	2, 2, 2, 1, 2, 2, 2, 2, 4, 4,
+	2, 2, 3, 3, 3, 3, 1, 1, 1, 1,
+	1, 6, 6, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 0, 3, 0, 5, 0, 3, 5,
+	0, 1, 0, 1, 0, 2, 2, 0, 2, 2,
+	5, 0, 1, 1, 2, 1, 3, 2, 3, 0,
+	1, 3, 3, 3, 4, 2, 0, 2, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 0, 1, 1,
+	3, 3, 3, 1, 3, 1, 10, 11, 11, 12,
+	5, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	1, 0, 1, 2, 0, 1, 1, 3, 2, 1,
+	2, 3, 3, 4, 4, 3, 3, 3, 3, 4,
+	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
+	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
+	2, 0, 1, 5, 1, 3, 7, 1, 3, 3,
+	1, 2, 2, 2, 5, 5, 5, 6, 6, 5,
+	5, 2, 2, 2, 2, 3, 3, 3, 4, 1,
+	3, 5, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 2, 2, 4, 4, 2, 10, 3, 6,
+	7, 5, 5, 5, 12, 7, 5, 9, 5, 3,
+	7, 4, 4, 4, 4, 3, 3, 3, 7, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	0, 2, 2, 1, 3, 8, 8, 3, 3, 5,
+	6, 6, 5, 4, 3, 2, 3, 3, 3, 7,
+	3, 3, 3, 3, 4, 7, 5, 2, 4, 4,
+	4, 4, 4, 5, 5, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 2, 4, 2, 4,
+	5, 4, 3, 3, 5, 2, 3, 3, 3, 3,
+	3, 1, 1, 0, 1, 0, 1, 1, 1, 0,
+	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
+	2, 1, 1, 2, 1, 1, 5, 0, 1, 0,
+	1, 2, 3, 0, 3, 3, 3, 3, 1, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 3, 3, 3, 2, 2, 3, 1,
+	3, 2, 1, 2, 1, 2, 2, 3, 3, 6,
+	4, 7, 6, 1, 3, 2, 2, 2, 2, 1,
+	1, 1, 3, 2, 1, 1, 1, 0, 1, 1,
+	0, 3, 0, 2, 0, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 1, 0, 1, 0, 1, 2,
+	3, 4, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
+	2, 1, 3, 1, 1, 1, 3, 3, 1, 9,
+	4, 1, 3, 3, 4, 7, 7, 10, 5, 3,
+	4, 1, 1, 2, 1, 1, 3, 3, 7, 0,
+	3, 1, 3, 1, 3, 4, 4, 4, 3, 2,
+	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
+	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 4, 1, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 3, 1, 1,
+	1, 1, 5, 5, 5, 6, 4, 4, 6, 6,
+	6, 8, 8, 8, 8, 9, 8, 5, 4, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 2, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 0, 3, 3, 3, 0,
-	3, 1, 1, 0, 4, 0, 1, 1, 0, 3,
-	1, 3, 2, 1, 0, 2, 4, 0, 9, 3,
-	5, 0, 3, 3, 0, 1, 0, 2, 2, 0,
-	2, 2, 2, 0, 3, 0, 3, 0, 3, 0,
-	4, 0, 3, 0, 4, 0, 1, 2, 1, 5,
-	4, 4, 1, 3, 3, 5, 0, 5, 1, 3,
-	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
-	3, 3, 3, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 0, 2, 0, 3, 0,
-	1, 0, 1, 1, 5, 0, 1, 0, 1, 2,
+	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
+	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
+	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
+	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
+	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
+	3, 0, 2, 0, 2, 1, 3, 5, 0, 4,
+	2, 4, 0, 1, 0, 3, 0, 2, 5, 1,
+	1, 2, 2, 2, 2, 2, 1, 1, 3, 0,
+	3, 1, 3, 2, 0, 1, 1, 0, 2, 4,
+	4, 0, 2, 2, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 0, 3, 3, 3, 0, 3, 1,
+	1, 0, 4, 0, 1, 1, 0, 3, 1, 3,
+	2, 1, 0, 2, 4, 0, 9, 3, 5, 0,
+	3, 3, 0, 1, 0, 2, 2, 0, 2, 2,
+	2, 0, 3, 0, 3, 0, 3, 0, 4, 0,
+	3, 0, 4, 0, 1, 2, 1, 5, 4, 4,
+	1, 3, 3, 5, 0, 5, 1, 3, 1, 2,
+	3, 1, 1, 3, 3, 1, 3, 3, 3, 3,
+	3, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 0, 2, 0, 3, 0, 1, 0,
+	1, 1, 5, 3, 0, 1, 1, 2, 4, 0,
+	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4511,266 +4884,267 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -274, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
-	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -4, -276, 6, 7, 8, -61, 10, 11,
-	31, -23, -33, 153, -34, -24, 154, -35, 156, 155,
-	191, 157, 184, 71, 226, 227, 229, 230, 231, 232,
-	-62, 189, 190, 159, 35, 42, 32, 33, 36, 81,
-	9, 328, 186, 185, 26, -275, 468, -69, 5, -137,
-	16, -3, -54, -279, -54, -54, -54, -54, -54, -54,
-	-234, -236, 81, 126, 81, -70, -184, 164, 173, 172,
-	169, -262, 107, 219, 319, 162, -39, -38, -37, -36,
-	-40, 30, -30, -31, -254, -29, -26, 158, 155, 199,
-	102, 103, 191, 192, 193, 157, 175, 190, 194, 189,
-	208, -25, 77, 32, 341, 344, -241, 154, 160, 161,
-	329, 105, 104, 72, 156, -238, 276, 445, -40, 447,
-	95, 97, 446, 41, 164, 448, 449, 450, 451, 174,
-	452, 453, 454, 455, 461, 462, 463, 464, 106, 5,
-	163, -262, -79, 286, 77, -261, -258, 84, 85, 86,
-	163, -184, 164, 165, -262, 163, -100, -194, -258, -188,
-	338, 177, 372, 373, 224, 77, 276, 445, 226, 240,
-	234, 261, 253, 339, 374, 178, 212, 442, 251, 254,
-	306, 447, 375, 192, 298, 281, 289, 95, 229, 315,
-	460, 376, 458, 97, 446, 76, 48, 41, 187, 249,
-	245, 448, 213, 377, 349, 206, 105, 102, 467, 243,
-	47, 28, 457, 104, 46, 449, 378, 450, 291, 266,
-	436, 45, 303, 193, 379, 80, 343, 444, 293, 244,
-	290, 223, 456, 159, 380, 428, 300, 437, 381, 267,
-	271, 382, 307, 49, 383, 384, 438, 103, 385, 75,
-	451, 238, 239, 386, 221, 176, 309, 265, 174, 34,
-	294, 340, 225, 55, 200, 310, 43, 269, 42, 432,
-	387, 435, 264, 260, 50, 388, 389, 390, 391, 452,
-	263, 237, 259, 466, 218, 453, 59, 161, 273, 272,
-	274, 207, 305, 256, 392, 393, 394, 181, 78, 395,
-	246, 19, 396, 397, 301, 214, 398, 53, 399, 400,
-	313, 190, 401, 51, 454, 38, 195, 455, 402, 403,
-	404, 405, 406, 299, 407, 292, 268, 270, 202, 288,
-	342, 408, 242, 194, 459, 409, 182, 443, 196, 199,
-	189, 314, 183, 410, 411, 412, 413, 414, 415, 416,
-	230, 461, 40, 417, 418, 419, 420, 222, 217, 308,
-	317, 58, 79, 278, 421, 441, 236, 215, 422, 227,
-	52, 462, 463, 464, 209, 465, 284, 106, 219, 220,
-	44, 257, 201, 423, 424, 247, 248, 262, 235, 258,
-	228, 429, 203, 302, 191, 425, 316, 216, 279, 346,
-	208, 304, 440, 345, 255, 252, 210, 426, 165, 204,
-	205, 427, 430, 295, 285, 296, 297, 286, 211, 344,
-	250, 280, 163, -165, 281, -185, 282, 283, 294, 295,
-	300, -177, 301, 299, 202, -273, 307, 163, 302, 153,
-	144, 291, 292, 285, 286, 211, -269, -258, 450, 465,
-	306, 254, 287, 293, 308, 432, 297, 296, -194, 228,
-	-199, 233, -189, -258, -188, 231, -100, -60, 428, 157,
-	-201, -201, -71, 432, 434, -120, -84, -106, 110, -111,
-	30, 24, -110, -107, -128, -125, -126, 144, 145, 147,
-	146, 148, 133, 134, 141, 111, 149, -115, -113, -114,
-	-116, 88, 87, 96, 89, 90, 91, 92, 98, 99,
-	100, -189, -194, -123, -276, 65, 66, 329, 330, 331,
-	332, 337, 333, 113, 54, 318, 327, 326, 325, 322,
-	323, 320, 321, 335, 336, 168, 319, 162, 139, 328,
-	-258, -188, 41, 284, 284, -100, -5, -4, -276, 6,
-	21, 22, -141, 18, 17, -277, 83, -63, -76, 60,
-	61, -78, 22, 37, 64, 62, -55, -75, 135, -84,
-	-194, -75, -174, 167, -174, -174, -164, -204, 228, -168,
-	308, 307, -190, -166, -189, -187, -165, 305, 158, 347,
-	109, 23, 25, 112, 144, 17, 113, 36, 160, 175,
-	143, 171, 329, 153, 69, 348, 320, 321, 318, 324,
-	331, 332, 319, 282, 30, 11, 350, 26, 185, 22,
-	37, 137, 155, 116, 117, 188, 24, 186, 100, 353,
-	20, 72, 180, 12, 173, 14, 354, 355, 15, 168,
-	167, 128, 164, 67, 9, 149, 27, 125, 63, 356,
-	29, 357, 358, 359, 360, 65, 126, 18, 322, 323,
-	32, 433, 361, 337, 197, 139, 70, 56, 434, 110,
-	362, 363, 98, 364, 101, 73, 439, 107, 16, 68,
-	39, 365, 198, 366, 170, 367, 311, 368, 127, 156,
-	328, 66, 369, 162, 283, 6, 334, 31, 184, 172,
-	64, 370, 163, 115, 335, 336, 166, 99, 5, 169,
-	33, 10, 71, 74, 325, 326, 327, 54, 341, 114,
-	13, 371, 312, 108, 306, 254, -235, 126, -222, -226,
-	-189, 179, -251, 175, -100, -244, -243, -189, -79, 163,
-	-258, 164, 164, 164, -182, 168, 328, -36, -37, -165,
-	143, 196, 82, 82, -226, -225, -224, -263, 198, 179,
-	-250, -242, 171, 180, -232, 172, 173, -227, 164, 29,
-	-263, -227, 170, 180, 198, 198, 106, 198, 106, 198,
-	198, 198, 198, 198, 198, 198, 198, 198, 195, -233,
-	118, -233, 345, 345, -238, -263, -263, -263, 166, 34,
-	34, -186, -227, 166, 23, -233, -233, -165, 143, -233,
-	-233, -233, -233, 206, 206, -233, -233, -233, -233, -233,
-	-233, -233, -233, -233, -233, -233, -233, -233, -233, -233,
-	-100, -82, 213, 153, 155, 158, 73, 118, -38, 208,
-	-22, -100, 163, -258, -181, 168, -181, -100, 150, -100,
-	-179, 126, 13, -179, -176, 284, 288, 289, 290, -179,
-	-179, -179, -179, 209, 298, -228, 164, 34, 176, 284,
-	209, 298, 209, 210, 209, 210, 209, -175, 12, 128,
-	319, 303, 300, 202, 163, 203, 165, 304, -258, 435,
-	210, 284, 205, -179, -202, -276, -190, -202, -202, 31,
-	166, -189, -56, -189, 88, -7, -3, -11, -10, -12,
-	118, -77, 284, -65, 144, 450, 436, 437, 438, 435,
-	299, 443, 441, 439, 209, 440, 82, 109, 107, 108,
-	125, -84, -108, 128, 110, 126, 127, 112, 130, 129,
-	140, 133, 134, 135, 136, 137, 138, 139, 131, 132,
-	143, 118, 119, 120, 121, 122, 123, 124, -173, -276,
-	-126, -276, 151, 152, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -276, 150, -2, -120, -4, -276,
-	-276, -276, -276, -276, -276, -276, -276, -133, -84, -276,
-	-280, -117, -276, -280, -117, -280, -117, -280, -276, -280,
-	-117, -280, -117, -280, -280, -117, -276, -276, -276, -276,
-	-276, -276, -276, -201, -270, -271, -103, -100, -276, -137,
-	-3, -54, -156, 20, 32, -84, -138, -139, -84, -137,
-	56, -73, -75, -78, 60, 61, 94, 12, -192, -191,
-	23, -189, 88, 150, 12, -101, 27, -100, -86, -87,
-	-88, -89, -103, -127, -276, 12, -93, -94, -100, -102,
-	-194, 82, 228, -168, -204, -170, -169, 309, 311, 118,
-	-193, -189, 88, 30, 83, 82, -100, -206, -209, -211,
-	-210, -212, -207, -208, 251, 252, 144, 255, 257, 258,
-	259, 260, 261, 262, 263, 264, 265, 266, 31, 187,
-	247, 248, 249, 250, 267, 268, 269, 270, 271, 272,
-	273, 274, 234, 253, 339, 235, 236, 237, 238, 239,
-	240, 242, 243, 244, 245, 246, -261, -258, 81, 83,
-	82, -213, 81, -82, -182, -249, -246, 74, -258, -258,
-	-258, -258, 110, -233, -233, 195, -29, -26, -254, 16,
-	-25, -26, 158, 102, 103, 155, 81, -222, 81, -231,
-	-261, -258, 81, 29, 170, 169, -230, -227, -230, -231,
-	-258, -128, -189, -194, -258, 29, 29, -161, -189, -161,
-	-161, 21, -161, 21, -161, 21, 89, -189, -161, 21,
-	-161, 21, -161, 21, -161, 21, -161, 21, 30, 75,
-	76, 30, 78, 79, 80, -128, -128, -222, -165, -100,
-	-258, 89, 89, -233, -233, 89, 88, 88, 88, -233,
-	-233, 89, 88, -258, 88, -264, 181, 223, 225, 89,
-	89, 89, 89, 30, 88, -265, 30, 457, 456, 458,
-	459, 460, 89, 30, 89, 30, 89, -189, 81, -81,
-	215, 118, 204, 204, 163, 163, 217, -100, 216, 218,
-	220, 41, 82, 166, -181, 73, -95, -100, 24, -258,
-	-195, -194, -187, 88, -84, -229, 12, 128, -175, -175,
-	-179, -100, -229, -175, -179, -100, -179, -179, -179, -179,
-	-175, -179, -194, -194, -100, -100, -100, -100, -100, -100,
-	-100, -202, -202, -202, -180, 126, 73, -200, 231, 265,
-	429, 430, 431, 82, 341, -93, 435, 435, 435, 435,
-	435, 435, -84, -84, -84, -84, -118, 98, 110, 99,
-	100, -111, -119, -123, -126, 93, 128, 126, 127, 112,
-	-111, -111, -111, -111, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -111, -203, -258, 88, 144, -258,
-	-110, -110, -189, -74, 22, 37, -73, -190, -195, -187,
-	-69, -277, -277, -137, -73, -73, -84, -84, -128, 88,
-	-73, -128, 88, -73, -73, -68, 22, 37, -131, -132,
-	114, -128, -277, -111, -189, -189, -73, -74, -74, -73,
-	-73, 82, -272, 311, 312, 433, -197, 198, -196, 23,
-	-194, 88, -121, -120, -141, -277, -142, 27, 10, 128,
-	82, 19, 82, -140, 25, 26, -141, -112, -189, 89,
-	92, -85, 82, 12, -78, -100, -191, 135, -195, -100,
-	-160, 198, -100, 31, 82, -96, -98, -97, -99, 63,
-	67, 69, 64, 65, 66, 70, -198, 23, -86, -3,
-	-276, -100, -93, -278, 82, 12, 74, -278, 82, 150,
-	-168, -170, 82, 310, 312, 313, 73, 101, -84, -215,
-	143, -240, -239, -238, -222, -224, -225, -226, 83, -143,
-	-218, 279, -213, -213, -213, -213, -213, -214, -165, -214,
-	-214, -214, 81, 81, -213, -213, -213, -213, -216, 81,
-	-216, -216, -217, 81, -217, -251, -84, -248, -247, -245,
-	-246, 174, 95, 341, -243, -140, 89, -81, -100, 73,
-	-189, -249, -249, -249, 24, -258, 88, -258, 88, 82,
-	17, -223, -222, -129, 223, -253, 198, -250, -244, 81,
-	29, -230, -231, -231, 150, -258, 82, 27, 106, 106,
-	106, 106, 341, 155, 31, -222, -129, -203, 166, -203,
-	-203, 88, 88, -178, 465, -93, 165, 222, -83, 324,
-	88, 84, -100, -100, -100, -100, -100, 158, 155, 206,
-	-100, -100, -93, -100, 82, -59, 183, 178, -100, -179,
-	-179, -100, -179, -179, 88, -100, -189, -65, 311, 341,
-	20, -66, 20, 98, 99, 100, -119, -111, -111, -111,
-	-72, 188, 109, -277, -277, -73, -73, -276, 150, -5,
-	-141, -277, -277, 82, 74, 23, 12, 12, -277, 12,
-	12, -277, -277, -73, -134, -132, 116, -84, -277, -277,
-	82, 82, -277, -277, -277, -277, -277, -271, 432, 312,
-	-104, 71, 167, 72, -276, -196, -277, -156, 39, 47,
-	58, -84, -84, -139, -156, -172, 20, 12, 54, 54,
-	-105, 13, -75, -86, -78, 150, -105, -109, 31, 54,
-	-3, -276, -276, -163, -167, -128, -87, -88, -88, -87,
-	-88, 63, 63, 63, 68, 63, 68, 63, -97, -194,
-	-277, -277, -3, -160, 74, -86, -100, -86, -102, -194,
-	135, -169, -171, 314, 311, 317, -258, 88, 82, -238,
-	-226, 98, 110, 30, 73, 276, 95, 170, 29, 169,
-	-219, 280, -214, -214, -215, -258, 144, -215, -215, -215,
-	-221, 88, -221, 89, 89, 83, -32, -27, -28, 32,
-	77, -245, -233, 88, 38, 83, 165, -100, 73, 73,
-	73, 16, -158, -189, 82, 83, -130, 224, -128, 83,
-	-189, 83, -158, -231, -190, -189, -276, 163, 30, 30,
-	-129, -130, -215, -258, 467, 466, 83, -100, -80, 213,
-	221, 81, 85, -260, 74, 204, 276, 204, 207, 166,
-	-59, -32, -100, -175, -175, 32, 311, 444, 442, -72,
-	109, -111, -111, -277, -277, -74, -190, -137, -156, -205,
-	144, 251, 187, 249, 245, 265, 256, 278, 247, 279,
-	-203, -205, -111, -111, -111, -111, 338, -137, 117, -84,
-	115, -111, -111, 164, 164, 164, -161, 40, 88, 88,
-	59, -100, -135, 14, -84, 135, -141, -162, 73, -163,
-	-122, -124, -123, -276, -157, -277, -189, -161, -105, 82,
-	118, -91, -90, 73, 74, -92, 73, -90, 63, 63,
-	-277, -105, -86, -105, -105, 150, 311, 315, 316, -238,
-	98, -111, 10, 88, 29, 29, -215, -215, 83, 82,
-	83, 82, 83, 82, -183, 378, 110, -28, -27, -233,
-	-233, 89, -258, -100, -100, -100, -100, 17, 82, -222,
-	-128, 54, -248, 83, -252, -253, -100, -110, -130, -159,
-	81, 83, -257, 341, -259, -258, -189, -189, -189, -100,
-	-179, -179, 32, -258, -111, -277, -141, -277, -213, -213,
-	-213, -217, -213, 239, -213, 239, -277, -277, 20, 20,
-	20, 20, -276, -64, 334, -84, 82, 82, -276, -276,
-	-276, -277, 88, -214, -136, 15, 17, 28, -162, 82,
-	-277, -277, 82, 54, 150, -277, -137, -167, -84, -84,
-	81, -84, -137, -105, -114, -214, 88, -214, 89, 89,
-	378, 30, 78, 79, 80, 30, 75, 76, -159, -158,
-	-189, 200, 182, -277, 82, -220, 341, 344, 23, -158,
-	-256, -255, -190, 81, 74, -156, -214, -258, -111, -111,
-	-111, -111, -111, -141, 88, -111, -111, -158, -277, -158,
-	-158, -197, -214, -145, -150, -176, -84, -120, 29, -124,
-	54, -3, -189, -122, -189, -141, -158, -141, -215, -215,
-	83, 83, 23, 201, -100, -253, 345, 345, -3, 83,
-	82, 118, -158, -100, -277, -277, -277, -277, -67, 128,
-	341, -277, -277, -277, -277, -277, -277, -104, -148, 428,
-	-151, 43, -152, 44, 10, -122, 150, 83, -3, -276,
-	81, -57, 341, -255, -237, -190, 88, 89, 83, -277,
-	339, 70, 342, -145, 48, 257, -153, 52, -154, -149,
-	53, 17, -163, -189, -57, -111, 197, -158, -58, 212,
-	432, -260, 59, 340, 343, -146, 50, -144, 49, -144,
-	-152, 17, -155, 45, 46, 88, -277, -277, 83, 175,
-	-257, 59, -147, 51, 73, 101, 88, 17, 17, -267,
-	-268, 73, 214, 341, 73, 101, 88, 88, -268, 73,
-	11, 10, 342, -266, 183, 178, 181, 31, -266, 343,
-	177, 30, 98,
+	-1000, -307, -1, -3, -162, -8, -9, -10, -11, -12,
+	-13, -14, -15, -16, -17, -18, -19, -41, -42, -43,
+	-45, -46, -47, -48, -49, -6, -44, -20, -21, -50,
+	-51, -52, -53, -54, -55, -4, -309, 6, 343, 7,
+	8, -74, 10, 11, 31, -23, -33, 153, -34, -24,
+	154, -35, 156, 155, 191, 157, 184, 71, 226, 227,
+	229, 230, 231, 232, -75, 438, 189, 190, 159, 35,
+	42, -59, 32, 33, 36, 441, 442, 81, 9, 330,
+	186, 185, 26, -57, -60, -56, -58, -308, 482, -82,
+	5, -3, -150, 16, -3, -65, -312, -164, 370, -163,
+	-227, -291, -221, 84, 85, 86, 340, 177, 374, 375,
+	224, 77, 276, 459, 226, 240, 234, 261, 253, 341,
+	376, 178, 212, 456, 251, 254, 308, 461, 377, 192,
+	300, 281, 289, 95, 229, 317, 474, 378, 472, 97,
+	445, 460, 76, 48, 430, 41, 187, 249, 245, 462,
+	213, 379, 351, 206, 105, 102, 481, 243, 47, 28,
+	471, 104, 46, 463, 380, 464, 291, 266, 450, 45,
+	305, 193, 381, 80, 345, 458, 293, 244, 290, 223,
+	470, 159, 382, 434, 302, 451, 383, 267, 271, 384,
+	309, 49, 385, 386, 452, 103, 387, 75, 465, 238,
+	239, 388, 221, 176, 311, 265, 174, 34, 294, 441,
+	342, 225, 55, 200, 312, 43, 269, 42, 446, 389,
+	449, 264, 260, 50, 390, 391, 392, 393, 466, 263,
+	237, 259, 480, 218, 467, 59, 161, 273, 272, 274,
+	207, 307, 256, 394, 395, 396, 181, 78, 397, 246,
+	19, 398, 399, 303, 214, 400, 53, 401, 402, 315,
+	190, 403, 51, 468, 38, 195, 469, 404, 405, 406,
+	407, 408, 301, 409, 439, 292, 268, 270, 202, 288,
+	442, 440, 299, 344, 410, 242, 194, 473, 411, 182,
+	457, 196, 199, 189, 316, 183, 412, 413, 414, 415,
+	416, 417, 418, 230, 432, 433, 475, 40, 419, 420,
+	421, 422, 222, 217, 310, 319, 58, 79, 278, 423,
+	455, 236, 215, 424, 227, 52, 476, 477, 478, 209,
+	479, 284, 106, 219, 220, 44, 257, 201, 425, 426,
+	247, 248, 262, 235, 258, 228, 435, 203, 304, 191,
+	427, 318, 216, 279, 348, 208, 306, 454, 347, 255,
+	252, 210, 428, 438, 165, 204, 205, 429, 436, 295,
+	285, 443, 296, 298, 297, 286, 211, 346, 250, 280,
+	-65, -65, -65, -65, -65, -65, -267, -269, 81, 126,
+	81, -83, -217, 164, 173, 172, 169, -295, 107, 219,
+	321, 162, -39, -38, -37, -36, -40, 30, -30, -31,
+	-287, -29, -26, 158, 155, 199, 102, 103, 191, 192,
+	193, 157, 175, 190, 194, 189, 208, -25, 77, 32,
+	343, 346, -274, 154, 160, 161, 331, 105, 104, 72,
+	156, -271, 276, 459, -40, 461, 95, 97, 460, 41,
+	164, 462, 463, 464, 465, 174, 466, 467, 468, 469,
+	475, 476, 477, 478, 106, 5, 163, -295, -92, 286,
+	77, -294, -291, 163, -217, 164, 165, -295, 163, -113,
+	-227, 163, -198, 281, -218, 282, 283, 294, 295, 302,
+	-210, 303, 301, 202, -306, 309, 163, 304, 153, 144,
+	291, 292, 285, 286, 211, 298, -302, -291, 464, 479,
+	308, 254, 287, 293, 310, 446, 297, 296, -227, 228,
+	-232, 233, -222, -291, -221, 231, -113, -71, 434, 157,
+	-72, 439, 440, 21, -234, -234, -84, 446, 448, -133,
+	-97, -119, 110, -124, 30, 24, -123, -120, -141, -138,
+	-139, 144, 145, 147, 146, 148, 133, 134, 141, 111,
+	149, -128, -126, -127, -129, 88, 87, 96, 89, 90,
+	91, 92, 98, 99, 100, -222, -227, -136, -309, 65,
+	66, 331, 332, 333, 334, 339, 335, 113, 54, 320,
+	329, 328, 327, 324, 325, 322, 323, 337, 338, 168,
+	321, 162, 139, 330, -291, -221, 41, -63, 167, 284,
+	284, -113, -73, 460, 344, 443, 43, 52, 44, -209,
+	289, 290, 44, -182, 45, 53, -5, -4, -309, 6,
+	21, 22, -174, 18, 17, -310, 83, -76, -89, 60,
+	61, -91, 22, 37, 64, 62, -66, 82, -164, -192,
+	81, -88, 135, -97, -227, -88, -207, 167, -207, -207,
+	-197, -237, 228, -201, 310, 309, -223, -199, -222, -220,
+	-198, 307, 158, 349, 109, 23, 25, 112, 144, 17,
+	113, 36, 160, 175, 143, 171, 331, 153, 69, 350,
+	322, 323, 320, 326, 333, 334, 321, 282, 30, 11,
+	352, 26, 185, 22, 37, 137, 155, 116, 353, 117,
+	188, 24, 186, 100, 355, 20, 72, 180, 12, 173,
+	14, 356, 357, 15, 168, 167, 128, 164, 67, 9,
+	149, 27, 125, 63, 358, 29, 359, 360, 361, 362,
+	65, 126, 18, 324, 325, 32, 447, 363, 339, 197,
+	139, 70, 56, 448, 110, 364, 365, 98, 366, 101,
+	73, 453, 107, 16, 68, 39, 367, 198, 368, 170,
+	431, 369, 313, 370, 127, 156, 330, 66, 371, 162,
+	283, 6, 336, 31, 184, 172, 64, 372, 163, 115,
+	337, 338, 166, 99, 5, 169, 33, 10, 71, 74,
+	327, 328, 329, 54, 343, 114, 13, 373, 314, 108,
+	308, 254, -268, 126, -255, -259, -222, 179, -284, 175,
+	-113, -277, -276, -222, -92, 163, -291, 164, 164, 164,
+	-215, 168, 330, -36, -37, -198, 143, 196, 82, 82,
+	-259, -258, -257, -296, 198, 179, -283, -275, 171, 180,
+	-265, 172, 173, -260, 164, 29, -296, -260, 170, 180,
+	198, 198, 106, 198, 106, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 195, -266, 118, -266, 347, 347,
+	-271, -296, -296, -296, 166, 34, 34, -219, -260, 166,
+	23, -266, -266, -198, 143, -266, -266, -266, -266, 206,
+	206, -266, -266, -266, -266, -266, -266, -266, -266, -266,
+	-266, -266, -266, -266, -266, -266, -113, -95, 213, 153,
+	155, 158, 73, 118, -38, 208, -22, -113, 163, -291,
+	-214, 168, -214, -113, 150, -113, -212, 126, 13, -212,
+	-209, 284, 288, -212, -212, -212, -212, 209, 300, -261,
+	164, 34, 176, 284, 209, 300, 209, 210, 209, 210,
+	209, -208, 12, 128, 321, 305, 302, 202, 163, 203,
+	165, 306, -291, 449, 210, 284, 205, 299, -212, -235,
+	-309, -223, -235, -235, 31, 166, -222, -67, -222, 88,
+	-7, -3, -11, -10, -12, 118, -7, -90, 284, -78,
+	144, 464, 450, 451, 452, 449, 301, 457, 455, 453,
+	209, 454, 82, 109, 107, 108, 125, -97, -121, 128,
+	110, 126, 127, 112, 130, 129, 140, 133, 134, 135,
+	136, 137, 138, 139, 131, 132, 143, 118, 119, 120,
+	121, 122, 123, 124, -206, -309, -139, -309, 151, 152,
+	-124, -124, -124, -124, -124, -124, -124, -124, -124, -124,
+	-309, 150, -2, -162, -133, -4, -309, -309, -309, -309,
+	-309, -309, -309, -309, -146, -97, -309, -313, -130, -309,
+	-313, -130, -313, -130, -313, -309, -313, -130, -313, -130,
+	-313, -313, -130, -309, -309, -309, -309, -309, -309, -309,
+	-62, 12, 447, 445, -64, -309, 89, -303, -304, -116,
+	-113, -309, 89, 12, 52, 44, 17, 17, 44, -182,
+	45, 17, 46, 17, -150, -3, -65, -189, 20, 32,
+	-97, -151, -172, -97, -150, 56, -86, -88, -91, 60,
+	61, 94, -163, 23, -191, -222, 12, -225, -224, 23,
+	-222, 88, 150, 12, -114, 27, -113, -99, -100, -101,
+	-102, -116, -140, -309, -165, 358, 12, -106, -107, -113,
+	-115, -227, 82, 228, -201, -237, -203, -202, 311, 313,
+	118, -226, -222, 88, 30, 83, 82, -113, -239, -242,
+	-244, -243, -245, -240, -241, 251, 252, 144, 255, 257,
+	258, 259, 260, 261, 262, 263, 264, 265, 266, 31,
+	187, 247, 248, 249, 250, 267, 268, 269, 270, 271,
+	272, 273, 274, 234, 253, 341, 235, 236, 237, 238,
+	239, 240, 242, 243, 244, 245, 246, -294, -291, 81,
+	83, 82, -246, 81, -95, -215, -282, -279, 74, -291,
+	-291, -291, -291, 110, -266, -266, 195, -29, -26, -287,
+	16, -25, -26, 158, 102, 103, 155, 81, -255, 81,
+	-264, -294, -291, 81, 29, 170, 169, -263, -260, -263,
+	-264, -291, -141, -222, -227, -291, 29, 29, -194, -222,
+	-194, -194, 21, -194, 21, -194, 21, 89, -222, -194,
+	21, -194, 21, -194, 21, -194, 21, -194, 21, 30,
+	75, 76, 30, 78, 79, 80, -141, -141, -255, -198,
+	-113, -291, 89, 89, -266, -266, 89, 88, 88, 88,
+	-266, -266, 89, 88, -291, 88, -297, 181, 223, 225,
+	89, 89, 89, 89, 30, 88, -298, 30, 471, 470,
+	472, 473, 474, 89, 30, 89, 30, 89, -222, 81,
+	-94, 215, 118, 204, 204, 163, 163, 217, -113, 216,
+	218, 220, 41, 82, 166, -214, 73, -108, -113, 24,
+	-291, -228, -227, -220, 88, -97, -262, 12, 128, -208,
+	-208, -212, -113, -262, -208, -212, -113, -212, -212, -212,
+	-212, -208, -212, -227, -227, -113, -113, -113, -113, -113,
+	-113, -113, -235, -235, -235, -213, 126, 73, -233, 231,
+	265, 435, 436, 437, 82, 343, -106, 449, 449, 449,
+	449, 449, 449, -97, -97, -97, -97, -131, 98, 110,
+	99, 100, -124, -132, -136, -139, 93, 128, 126, 127,
+	112, -124, -124, -124, -124, -124, -124, -124, -124, -124,
+	-124, -124, -124, -124, -124, -124, -236, -291, 88, 144,
+	-291, -123, -123, -222, -87, 22, 37, -86, -223, -228,
+	-220, -82, -310, -2, -310, -150, -86, -86, -97, -97,
+	-141, 88, -86, -141, 88, -86, -86, -81, 22, 37,
+	-144, -145, 114, -141, -310, -124, -222, -222, -86, -87,
+	-87, -86, -86, 444, 446, -234, 31, -190, -222, 43,
+	433, 82, -305, 313, 314, 447, -230, 198, -229, 23,
+	-227, 88, -134, -133, -227, 17, 17, 88, 88, 17,
+	46, 17, 88, 17, 88, -174, -310, -175, 27, 10,
+	128, 82, 19, 82, -173, 25, 26, -174, -125, -222,
+	89, 92, -98, 82, 12, -91, -139, 83, 82, -113,
+	-224, 135, -228, -113, -193, 198, -113, 31, 82, -109,
+	-111, -110, -112, 63, 67, 69, 64, 65, 66, 70,
+	-231, 23, -99, -3, -309, -309, -113, -106, -311, 82,
+	12, 74, -311, 82, 150, -201, -203, 82, 312, 314,
+	315, 73, 101, -97, -248, 143, -273, -272, -271, -255,
+	-257, -258, -259, 83, -176, -251, 279, -246, -246, -246,
+	-246, -246, -247, -198, -247, -247, -247, 81, 81, -246,
+	-246, -246, -246, -249, 81, -249, -249, -250, 81, -250,
+	-284, -97, -281, -280, -278, -279, 174, 95, 343, -276,
+	-173, 89, -94, -113, 73, -222, -282, -282, -282, 24,
+	-291, 88, -291, 88, 82, 17, -256, -255, -142, 223,
+	-286, 198, -283, -277, 81, 29, -263, -264, -264, 150,
+	-291, 82, 27, 106, 106, 106, 106, 343, 155, 31,
+	-255, -142, -236, 166, -236, -236, 88, 88, -211, 479,
+	-106, 165, 222, -96, 326, 88, 84, -113, -113, -113,
+	-113, -113, 158, 155, 206, -113, -113, -106, -113, 82,
+	-70, 183, 178, -113, -212, -212, -113, -212, -212, 88,
+	-113, -222, -78, 313, 343, 20, -79, 20, 98, 99,
+	100, -132, -124, -124, -124, -85, 188, 109, -310, -310,
+	-86, -86, -309, 150, -5, -310, -174, -310, -310, 82,
+	74, 23, 12, 12, -310, 12, 12, -310, -310, -86,
+	-147, -145, 116, -97, -310, -310, 82, 82, -310, -310,
+	-310, -310, -310, -234, 444, -196, -200, -141, -310, 82,
+	150, -304, 446, 314, -117, 71, 167, 72, -309, -229,
+	-310, 88, 88, 88, 17, 88, 88, -189, 39, 47,
+	58, -97, -97, -172, -189, -205, 20, 12, 54, 54,
+	-118, 13, -88, -99, -91, -222, 150, -118, -122, 31,
+	54, -3, -309, -309, -196, -100, -101, -101, -100, -101,
+	63, 63, 63, 68, 63, 68, 63, -110, -227, -310,
+	-310, -3, -97, -193, 74, -99, -113, -99, -115, -227,
+	135, -202, -204, 316, 313, 319, -291, 88, 82, -271,
+	-259, 98, 110, 30, 73, 276, 95, 170, 29, 169,
+	-252, 280, -247, -247, -248, -291, 144, -248, -248, -248,
+	-254, 88, -254, 89, 89, 83, -32, -27, -28, 32,
+	77, -278, -266, 88, 38, 83, 165, -113, 73, 73,
+	73, 16, -191, 82, 83, -143, 224, -141, 83, -222,
+	83, -191, -264, -223, -222, -309, 163, 30, 30, -142,
+	-143, -248, -291, 481, 480, 83, -113, -93, 213, 221,
+	81, 85, -293, 74, 204, 276, 204, 207, 166, -70,
+	-32, -113, -208, -208, 32, 313, 458, 456, -85, 109,
+	-124, -124, -152, 367, -310, -310, -87, -223, -150, -189,
+	-238, 144, 251, 187, 249, 245, 265, 256, 278, 247,
+	279, -236, -238, -124, -124, -124, -124, 340, -150, 117,
+	-97, 115, -124, -124, 88, 82, 118, -222, -222, 164,
+	164, 164, -194, 88, 40, 88, 88, 59, -113, -148,
+	14, -97, 135, -174, -195, 73, -196, -135, -137, -136,
+	-309, -190, -310, -194, -118, -104, -103, 73, 74, -105,
+	73, -103, 63, 63, -310, 82, -118, -99, -118, -118,
+	150, 313, 317, 318, -271, 98, -124, 10, 88, 29,
+	29, -248, -248, 83, 82, 83, 82, 83, 82, -216,
+	380, 110, -28, -27, -266, -266, 89, -291, -113, -113,
+	-113, -113, 17, -255, -141, 54, -281, 83, -285, -286,
+	-113, -123, -143, -192, 83, -290, 343, -292, -291, -222,
+	-222, -222, -113, -212, -212, 32, -291, -124, -309, -222,
+	-310, -174, -310, -246, -246, -246, -250, -246, 239, -246,
+	239, -310, -310, 20, 20, 20, 20, -309, -77, 336,
+	-97, 82, 82, -61, 330, 167, -200, -97, 150, -309,
+	-309, -309, -310, 88, -247, -149, 15, 17, 28, -195,
+	82, -310, -310, 54, -310, -150, -97, 81, -97, -170,
+	88, 92, -150, -118, -127, -247, 88, -247, 89, 89,
+	380, 30, 78, 79, 80, 30, 75, 76, -192, -191,
+	200, 182, -310, 82, -253, 343, 346, 23, -289, -288,
+	-223, 81, 74, -153, -154, -291, -189, -247, -291, -124,
+	-124, -124, -124, -124, -174, 88, -124, -124, 27, -222,
+	-191, -310, -191, -191, -230, -247, -178, -183, -209, -160,
+	373, -97, -133, 29, -137, 54, -3, -135, -174, -191,
+	-167, 289, -174, -248, -248, 83, 83, 23, 201, -113,
+	-286, 347, 347, -3, 82, 118, -191, -113, -310, -155,
+	198, -310, -310, -310, -310, -80, 128, 343, -310, -310,
+	-310, 163, -310, -310, -310, -117, -181, 434, -184, 43,
+	-185, 44, -161, -171, -222, 10, -135, 83, -310, -309,
+	-3, -309, 81, -68, 343, -288, -270, -223, 88, 89,
+	83, -150, 17, -310, 341, 70, 344, -113, -178, 48,
+	257, -186, 52, -187, -182, 17, 82, 23, -196, -231,
+	-168, -166, -222, 394, -68, -124, 197, -191, -69, 212,
+	446, -293, -156, -157, 433, 431, -133, 59, 342, 345,
+	-247, -179, 50, -177, 49, -177, -185, 17, -188, 45,
+	46, 88, -171, -309, -227, -310, 82, 20, -239, -170,
+	404, -310, -310, 83, 175, -290, -158, 112, 430, 427,
+	-159, 89, 92, 149, 59, -180, 51, 73, 101, 88,
+	17, 17, -153, -166, 401, 404, 24, -167, -170, -300,
+	-301, 73, 214, -158, 432, 407, 382, 407, 382, -124,
+	343, 73, 101, 88, 88, -310, -170, 404, -167, -301,
+	73, 11, 10, 109, -222, 344, -169, 450, 98, 30,
+	-170, -299, 183, 178, 181, 31, -299, -158, 345, 73,
+	-170, 177, 30, 98, 353, 450, -169, 73, 450,
 }
 
 var yyDef = [...]int{
-	33, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	36, -2, 2, 4, 0, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 811, 0, 549, 549, 549, 549, 549, 549,
-	549, 0, 0, -2, -2, -2, 835, 37, 0, 923,
-	0, 0, -2, 483, 484, 0, 486, -2, 0, 0,
-	495, 1348, 1348, 544, 0, 0, 0, 0, 0, 1346,
-	54, 55, 501, 502, 503, 1, 3, 0, 553, 819,
-	0, 0, -2, 551, 0, 0, 929, 929, 929, 0,
-	85, 86, 0, 0, 0, 835, 0, 0, 0, 0,
-	0, 927, 0, 924, 108, 109, 89, -2, 113, 114,
-	0, 118, 366, 327, 369, 325, 355, -2, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 330, 222, 222, 0, 0, -2, 318, 318, 318,
-	0, 0, 0, 352, 931, 272, 222, 222, 0, 222,
-	222, 222, 222, 0, 0, 222, 222, 222, 222, 222,
-	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
-	0, 107, 848, 0, 0, 117, 38, 34, 35, 36,
-	0, 0, 0, 925, 925, 0, 418, 633, 944, 945,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
-	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
-	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
-	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	31, 32, 33, 34, 35, 899, 0, 592, 0, 592,
+	592, 592, 592, 592, 592, 0, 0, -2, -2, -2,
+	923, 40, 0, 1011, 0, 0, -2, 521, 522, 0,
+	524, -2, 0, 0, 533, 539, 1456, 1456, 587, 0,
+	0, 68, 0, 0, 0, 1024, 0, 1454, 91, 92,
+	543, 544, 545, 62, 63, 54, -2, 1, 3, 0,
+	596, 5, 907, 0, 0, -2, 594, 82, 0, 84,
+	659, 1038, 1039, 37, 38, 39, 1180, 1181, 1182, 1183,
 	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
 	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
 	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
@@ -4787,184 +5161,222 @@ var yyDef = [...]int{
 	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
 	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
 	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1345, 0, 474, 474, 0, 474, 474, 474, 474,
-	0, 0, 0, 430, 0, 0, 0, 0, 471, 0,
-	0, 449, 451, 0, 0, 458, 474, 1349, 1349, 1349,
-	914, 0, 468, 466, 480, 481, 463, 464, 482, 485,
-	0, 490, 493, 940, 941, 0, 508, 0, 1159, 500,
-	513, 514, 0, 545, 546, 39, 684, 643, 0, 649,
-	651, 0, 686, 687, 688, 689, 690, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 716, 717, 718,
-	719, 796, 797, 798, 799, 800, 801, 802, 803, 653,
-	654, 793, 0, 903, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 784, 0, 753, 753, 753, 753, 753,
-	753, 753, 753, 0, 0, 0, 0, 0, 0, 0,
-	-2, -2, 1348, 0, 523, 0, 811, 50, 0, 549,
-	554, 555, 854, 0, 0, 811, 1347, 0, 0, -2,
-	-2, 565, 571, 572, 573, 574, 550, 0, 577, 581,
-	0, 0, 0, 930, 0, 0, 71, 0, 1314, 907,
-	-2, -2, 0, 0, 942, 943, 916, -2, 948, 949,
-	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
-	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
-	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
-	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
-	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
-	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
-	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353,
+	1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363,
+	1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373,
+	1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383,
+	1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393,
+	1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403,
+	1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413,
+	1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423,
+	1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433,
+	1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443,
+	1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453,
+	0, 0, 1017, 1017, 1017, 0, 122, 123, 0, 0,
+	0, 923, 0, 0, 0, 0, 0, 1015, 0, 1012,
+	145, 146, 126, -2, 150, 151, 0, 155, 403, 364,
+	406, 362, 392, -2, 355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 367, 259, 259,
+	0, 0, -2, 355, 355, 355, 0, 0, 0, 389,
+	1019, 309, 259, 259, 0, 259, 259, 259, 259, 0,
+	0, 259, 259, 259, 259, 259, 259, 259, 259, 259,
+	259, 259, 259, 259, 259, 259, 0, 144, 936, 0,
+	0, 154, 41, 0, 0, 0, 1013, 1013, 0, 455,
+	694, 0, 512, 512, 0, 512, 512, 512, 512, 0,
+	0, 0, 467, 0, 0, 0, 0, 509, 0, 0,
+	486, 488, 0, 0, 495, 0, 512, 1457, 1457, 1457,
+	1002, 0, 506, 504, 518, 519, 501, 502, 520, 523,
+	0, 528, 531, 1034, 1035, 0, 550, 0, 1257, 538,
+	0, 540, 541, 542, 556, 557, 0, 588, 589, 42,
+	746, 704, 0, 710, 712, 0, 748, 749, 750, 751,
+	752, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 778, 779, 780, 781, 858, 859, 860, 861, 862,
+	863, 864, 865, 714, 715, 855, 0, 991, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 846, 0, 815,
+	815, 815, 815, 815, 815, 815, 815, 0, 0, 0,
+	0, 0, 0, 0, -2, -2, 47, 71, 0, 0,
+	566, 0, 0, 1025, 1026, 1027, 0, 0, 0, 973,
+	507, 508, 0, 0, 0, 974, 899, 87, 0, 592,
+	597, 598, 942, 0, 0, 899, 1455, 0, 0, -2,
+	-2, 608, 614, 615, 616, 617, 593, 0, 83, 0,
+	0, 0, 620, 624, 0, 0, 0, 1018, 0, 0,
+	108, 0, 1419, 995, -2, -2, 0, 0, 1036, 1037,
+	1004, -2, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
 	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
 	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
 	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
-	1080, 1081, 1082, 1083, -2, 1103, 0, 0, 127, 128,
-	0, 37, 248, 0, 123, 0, 242, 196, 848, 927,
-	937, 0, 0, 0, 0, 0, 91, 115, 116, 222,
-	222, 0, 117, 117, 334, 335, 336, 0, 0, -2,
-	246, 0, 319, 0, 0, 236, 236, 240, 238, 239,
-	0, 0, 0, 0, 0, 0, 346, 0, 347, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 402, 0,
-	223, 0, 364, 365, 273, 0, 0, 0, 0, 344,
-	345, 0, 0, 932, 933, 0, 0, 222, 222, 0,
-	0, 0, 0, 222, 222, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 839, 0, 0, 0, 0, 0, 0, -2, 0,
-	410, 0, 925, 0, 0, 0, 0, 417, 0, 419,
-	420, 0, 0, 421, 0, 471, 471, 469, 470, 423,
-	424, 425, 426, 474, 0, 0, 231, 232, 233, 471,
-	474, 0, 474, 474, 474, 474, 471, 474, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1349, 1349, 1349,
-	477, 455, 456, 459, 460, 1350, 1351, 461, 462, 915,
-	491, 494, 511, 509, 510, 512, 504, 505, 506, 507,
-	0, 524, 525, 530, 0, 0, 0, 0, 536, 537,
-	538, 0, 0, 541, 542, 543, 0, 0, 0, 0,
-	0, 647, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 672, 673, 674, 675, 676, 677, 650, 0,
-	664, 0, 0, 0, 706, 707, 708, 709, 710, 711,
-	712, 713, 714, 0, 562, 0, 0, 0, 811, 0,
-	0, 0, 0, 0, 0, 0, 559, 0, 785, 0,
-	737, 745, 0, 738, 746, 739, 747, 740, 0, 741,
-	748, 742, 749, 743, 744, 750, 0, 0, 0, 562,
-	562, 0, 0, 40, 515, 516, 0, 616, 935, 819,
-	0, 564, 857, 0, 0, 820, 812, 813, 816, 819,
-	0, 586, 575, 566, 569, 570, 552, 0, 578, 582,
-	0, 584, 585, 0, 0, 69, 0, 632, 0, 588,
-	590, 591, 592, 614, 0, 0, 0, 0, 65, 67,
-	633, 0, 1314, 913, 0, 73, 74, 0, 0, 0,
-	210, 918, 919, 920, -2, 229, 0, 135, 203, 147,
-	148, 149, 196, 151, 196, 196, 196, 196, 207, 207,
-	207, 207, 179, 180, 181, 182, 183, 0, 0, 166,
-	196, 196, 196, 196, 186, 187, 188, 189, 190, 191,
-	192, 193, 152, 153, 154, 155, 156, 157, 158, 159,
-	160, 198, 198, 198, 200, 200, 0, 38, 0, 214,
-	0, 816, 0, 839, 0, 0, 938, 0, 937, 937,
-	937, 106, 0, 0, 0, 367, 328, 356, 368, 0,
-	331, 332, -2, 0, 0, 318, 0, 320, 0, 230,
-	0, -2, 0, 0, 0, 236, 240, 237, 240, 228,
-	241, 348, 793, 0, 349, 350, 0, 382, 602, 0,
-	0, 0, 0, 0, 388, 389, 390, 0, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 357, 358,
-	359, 360, 361, 362, 363, 0, 0, 320, 0, 353,
-	0, 274, 275, 0, 0, 278, 279, 280, 281, 0,
-	0, 284, 285, 286, 287, 288, 312, 313, 314, 289,
-	290, 291, 292, 293, 294, 295, 306, 307, 308, 309,
-	310, 311, 296, 297, 298, 299, 300, 303, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 836, 837,
-	838, 0, 0, 0, 0, 0, 261, 63, 926, 416,
-	634, 946, 947, 475, 476, 0, 234, 235, 474, 474,
-	427, 450, 0, 474, 431, 452, 432, 434, 433, 435,
-	474, 438, 472, 473, 439, 440, 441, 442, 443, 444,
-	445, 446, 447, 448, 454, 0, 0, 0, 492, 496,
-	497, 498, 499, 0, 0, 527, 532, 533, 534, 535,
-	547, 540, 685, 644, 645, 646, 648, 665, 0, 667,
-	669, 655, 656, 680, 681, 682, 0, 0, 0, 0,
-	678, 660, 0, 691, 692, 693, 694, 695, 696, 697,
-	698, 699, 700, 701, 702, 705, 768, 769, 770, 0,
-	703, 704, 715, 0, 0, 0, 563, 794, 0, -2,
-	0, 683, 902, 819, 0, 0, 0, 0, 688, 796,
-	0, 688, 796, 0, 0, 0, 560, 561, 791, 788,
-	0, 0, 754, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 518, 519, 521, 0, 636, 0, 617, 0,
-	619, 620, 0, 936, 854, 51, 41, 0, 855, 0,
-	0, 0, 0, 815, 817, 818, 854, 0, 804, 0,
-	0, 641, 0, 0, 567, 47, 583, 579, 0, 641,
-	0, 0, 631, 0, 0, 0, 0, 0, 0, 621,
-	0, 0, 624, 0, 0, 0, 0, 615, 0, 0,
-	0, -2, 0, 0, 0, 61, 62, 0, 0, 0,
-	908, 72, 0, 0, 77, 78, 909, 910, 911, 912,
-	0, 110, -2, 269, 129, 131, 132, 133, 124, 134,
-	205, 204, 150, 207, 207, 173, 174, 210, 0, 210,
-	210, 210, 0, 0, 167, 168, 169, 170, 161, 0,
-	162, 163, 164, 0, 165, 247, 0, 823, 215, 216,
-	218, 222, 0, 0, 243, 244, 0, 0, 100, 0,
-	939, 0, 0, 0, 928, 119, 120, 121, 122, 117,
-	0, 0, 125, 322, 0, 0, 0, 245, 0, 0,
-	224, 240, 225, 226, 0, 351, 0, 0, 384, 385,
-	386, 387, 0, 0, 0, 320, 322, 210, 0, 276,
-	277, 282, 283, 301, 0, 0, 0, 0, 849, 850,
-	0, 853, 92, 374, 376, 375, 379, 0, 0, 0,
-	0, 411, 261, 823, 0, 415, 262, 263, 471, 437,
-	453, 471, 429, 436, 478, 457, 488, 531, 0, 0,
-	0, 539, 0, 666, 668, 670, 657, 678, 661, 0,
-	658, 0, 0, 652, 720, 0, 0, 562, 0, 811,
-	854, 724, 725, 0, 0, 0, 0, 0, 761, 0,
-	0, 762, 0, 811, 0, 789, 0, 0, 736, 755,
-	0, 0, 756, 757, 758, 759, 760, 517, 520, 522,
-	596, 0, 0, 0, 0, 618, 934, 43, 0, 0,
-	0, 821, 822, 814, 42, 0, 921, 922, 805, 806,
-	807, 0, 576, 587, 568, 0, 819, 896, 0, 0,
-	888, 0, 0, 641, 904, 0, 589, 610, 612, 0,
-	607, 622, 623, 625, 0, 627, 0, 629, 630, 593,
-	594, 595, 0, 641, 0, 641, 66, 641, 68, 0,
-	635, 75, 76, 0, 0, 82, 211, 212, 117, 271,
-	130, 136, 0, 0, 0, 140, 0, 0, 143, 145,
-	146, 206, 210, 210, 175, 208, 209, 176, 177, 178,
-	0, 194, 0, 0, 0, 264, 87, 827, 826, 222,
-	222, 217, 0, 220, 0, 197, 0, 102, 0, 0,
-	0, 0, 326, 600, 0, 337, 338, 0, 321, 381,
-	0, 214, 0, 227, 794, 603, 0, 0, 339, 0,
-	322, 342, 343, 354, 304, 305, 302, 598, 840, 841,
-	842, 0, 852, 95, 0, 0, 0, 0, 372, 0,
-	413, 414, 64, 474, 474, 526, 0, 529, 0, 659,
-	0, 679, 662, 721, 722, 0, 795, 819, 45, 0,
-	196, 196, 774, 196, 200, 777, 196, 779, 196, 782,
-	0, 0, 0, 0, 0, 0, 0, 786, 735, 792,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 859,
-	856, 44, 809, 0, 642, 580, 48, 52, 0, 896,
-	887, 898, 900, 0, 0, 0, 892, 0, 811, 0,
-	0, 604, 611, 0, 0, 605, 0, 606, 626, 628,
-	-2, 811, 641, 59, 60, 0, 79, 80, 81, 270,
-	137, 138, 0, 141, 142, 144, 171, 172, 207, 0,
-	207, 0, 201, 0, 253, 265, 0, 824, 825, 0,
-	0, 219, 221, 598, 103, 104, 105, 0, 0, 126,
-	323, 0, 213, 0, 0, 406, 403, 340, 341, 0,
-	0, 851, 373, 0, 93, 94, 0, 0, 378, 412,
-	422, 428, 528, 548, 663, 723, 854, 726, 771, 207,
-	775, 776, 778, 780, 781, 783, 728, 727, 0, 0,
-	0, 0, 0, 819, 0, 790, 0, 0, 0, 0,
-	0, 616, 207, 879, 49, 0, 0, 0, 53, 0,
-	901, 0, 0, 0, 0, 70, 819, 905, 906, 608,
-	0, 613, 819, 58, 139, 210, 195, 210, 0, 0,
-	266, 828, 829, 830, 831, 832, 833, 834, 0, 329,
-	601, 0, 0, 383, 0, 391, 0, 0, 0, 0,
-	96, 97, 0, 0, 0, 46, 772, 773, 0, 0,
-	0, 0, 763, 0, 787, 0, 0, 0, 638, 0,
-	0, 636, 861, 860, 873, 877, 810, 808, 0, 899,
-	0, 891, 894, 890, 893, 56, 0, 57, 184, 185,
-	199, 202, 0, 0, 0, 407, 404, 405, 843, 599,
-	0, 0, 0, 380, 729, 731, 730, 732, 0, 0,
-	0, 734, 751, 752, 637, 639, 640, 597, 879, 0,
-	872, 875, -2, 0, 0, 889, 0, 609, 843, 0,
-	0, 370, 845, 98, 99, 315, 316, 317, 92, 733,
-	0, 0, 0, 866, 864, 864, 877, 0, 881, 0,
-	886, 0, 897, 895, 88, 0, 0, 0, 0, 846,
-	847, 95, 764, 0, 767, 869, 0, 862, 865, 863,
-	874, 0, 880, 0, 0, 878, 408, 409, 249, 0,
-	377, 765, 858, 0, 867, 868, 876, 0, 0, 250,
-	251, 0, 844, 0, 870, 871, 882, 884, 252, 0,
-	0, 0, 0, 254, 256, 257, 0, 0, 255, 766,
-	258, 259, 260,
+	1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089,
+	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109,
+	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119,
+	1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129,
+	1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139,
+	1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149,
+	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
+	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
+	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
+	-2, 1199, 0, 0, 164, 165, 0, 40, 285, 0,
+	160, 0, 279, 233, 936, 1015, 1031, 0, 0, 0,
+	0, 0, 128, 152, 153, 259, 259, 0, 154, 154,
+	371, 372, 373, 0, 0, -2, 283, 0, 356, 0,
+	0, 273, 273, 277, 275, 276, 0, 0, 0, 0,
+	0, 0, 383, 0, 384, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 439, 0, 260, 0, 401, 402,
+	310, 0, 0, 0, 0, 381, 382, 0, 0, 1020,
+	1021, 0, 0, 259, 259, 0, 0, 0, 0, 259,
+	259, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 927, 0, 0,
+	0, 0, 0, 0, -2, 0, 447, 0, 1013, 0,
+	0, 0, 0, 454, 0, 456, 457, 0, 0, 458,
+	0, 509, 509, 460, 461, 462, 463, 512, 0, 0,
+	268, 269, 270, 509, 512, 0, 512, 512, 512, 512,
+	509, 512, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1457, 1457, 1457, 515, 492, 493, 496, 497, 498,
+	1458, 1459, 499, 500, 1003, 529, 532, 553, 551, 552,
+	554, 546, 547, 548, 549, 0, 555, 567, 568, 573,
+	0, 0, 0, 0, 579, 580, 581, 0, 0, 584,
+	585, 586, 0, 0, 0, 0, 0, 708, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 732, 733, 734,
+	735, 736, 737, 738, 711, 0, 725, 0, 0, 0,
+	768, 769, 770, 771, 772, 773, 774, 775, 776, 0,
+	605, 0, 0, 0, 0, 899, 0, 0, 0, 0,
+	0, 0, 0, 602, 0, 847, 0, 799, 807, 0,
+	800, 808, 801, 809, 802, 0, 803, 810, 804, 811,
+	805, 806, 812, 0, 0, 0, 605, 605, 0, 0,
+	0, 1456, 48, 49, 45, 0, 0, 558, 559, 0,
+	677, 1029, 1023, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 907, 0, 607, 945, 0, 0,
+	908, 900, 901, 904, 907, 0, 629, 618, 609, 612,
+	613, 595, 85, 0, 0, 661, 0, 621, 625, 0,
+	627, 628, 0, 0, 106, 0, 693, 0, 631, 633,
+	634, 635, 675, 0, 638, 0, 0, 0, 0, 102,
+	104, 694, 0, 1419, 1001, 0, 110, 111, 0, 0,
+	0, 247, 1006, 1007, 1008, -2, 266, 0, 172, 240,
+	184, 185, 186, 233, 188, 233, 233, 233, 233, 244,
+	244, 244, 244, 216, 217, 218, 219, 220, 0, 0,
+	203, 233, 233, 233, 233, 223, 224, 225, 226, 227,
+	228, 229, 230, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 235, 235, 235, 237, 237, 0, 41, 0,
+	251, 0, 904, 0, 927, 0, 0, 1032, 0, 1031,
+	1031, 1031, 143, 0, 0, 0, 404, 365, 393, 405,
+	0, 368, 369, -2, 0, 0, 355, 0, 357, 0,
+	267, 0, -2, 0, 0, 0, 273, 277, 274, 277,
+	265, 278, 385, 855, 0, 386, 387, 0, 419, 663,
+	0, 0, 0, 0, 0, 425, 426, 427, 0, 429,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 394,
+	395, 396, 397, 398, 399, 400, 0, 0, 357, 0,
+	390, 0, 311, 312, 0, 0, 315, 316, 317, 318,
+	0, 0, 321, 322, 323, 324, 325, 349, 350, 351,
+	326, 327, 328, 329, 330, 331, 332, 343, 344, 345,
+	346, 347, 348, 333, 334, 335, 336, 337, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 924,
+	925, 926, 0, 0, 0, 0, 0, 298, 100, 1014,
+	453, 695, 1040, 1041, 513, 514, 0, 271, 272, 512,
+	512, 464, 487, 0, 512, 468, 489, 469, 471, 470,
+	472, 512, 475, 510, 511, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 491, 0, 0, 0, 530,
+	534, 535, 536, 537, 0, 0, 570, 575, 576, 577,
+	578, 590, 583, 747, 705, 706, 707, 709, 726, 0,
+	728, 730, 716, 717, 741, 742, 743, 0, 0, 0,
+	0, 739, 721, 0, 753, 754, 755, 756, 757, 758,
+	759, 760, 761, 762, 763, 764, 767, 830, 831, 832,
+	0, 765, 766, 777, 0, 0, 0, 606, 856, 0,
+	-2, 0, 744, 0, 990, 907, 0, 0, 0, 0,
+	750, 858, 0, 750, 858, 0, 0, 0, 603, 604,
+	853, 850, 0, 0, 816, 0, 0, 0, 0, 0,
+	0, 0, 0, 1456, 0, 44, 0, 0, 980, 69,
+	70, 0, 561, 562, 564, 0, 697, 0, 678, 0,
+	680, 681, 0, 1030, 1028, 0, 0, 66, 67, 0,
+	0, 0, 59, 0, 61, 942, 88, 73, 0, 943,
+	0, 0, 0, 0, 903, 905, 906, 942, 0, 866,
+	0, 0, 702, 0, 0, 610, 86, 660, 0, 79,
+	626, 622, 0, 702, 0, 0, 692, 0, 0, 0,
+	0, 0, 0, 682, 0, 0, 685, 0, 0, 0,
+	0, 676, 0, 0, 0, 0, -2, 0, 0, 0,
+	98, 99, 0, 0, 0, 996, 109, 0, 0, 114,
+	115, 997, 998, 999, 1000, 0, 147, -2, 306, 166,
+	168, 169, 170, 161, 171, 242, 241, 187, 244, 244,
+	210, 211, 247, 0, 247, 247, 247, 0, 0, 204,
+	205, 206, 207, 198, 0, 199, 200, 201, 0, 202,
+	284, 0, 911, 252, 253, 255, 259, 0, 0, 280,
+	281, 0, 0, 137, 0, 1033, 0, 0, 0, 1016,
+	156, 157, 158, 159, 154, 0, 0, 162, 359, 0,
+	0, 0, 282, 0, 0, 261, 277, 262, 263, 0,
+	388, 0, 0, 421, 422, 423, 424, 0, 0, 0,
+	357, 359, 247, 0, 313, 314, 319, 320, 338, 0,
+	0, 0, 0, 937, 938, 0, 941, 129, 411, 413,
+	412, 416, 0, 0, 0, 0, 448, 298, 911, 0,
+	452, 299, 300, 509, 474, 490, 509, 466, 473, 516,
+	494, 526, 574, 0, 0, 0, 582, 0, 727, 729,
+	731, 718, 739, 722, 0, 719, 0, 0, 713, 878,
+	0, 0, 605, 0, 899, 745, 942, 786, 787, 0,
+	0, 0, 0, 0, 823, 0, 0, 824, 0, 899,
+	0, 851, 0, 0, 798, 817, 0, 0, 818, 819,
+	820, 821, 822, 43, 0, 46, 992, 0, 72, 0,
+	0, 560, 563, 565, 657, 0, 0, 0, 0, 679,
+	1022, 64, 65, 56, 0, 58, 60, 75, 0, 0,
+	0, 909, 910, 902, 74, 0, 1009, 1010, 867, 868,
+	869, 0, 619, 630, 611, 662, 0, 907, 984, 0,
+	0, 976, 0, 0, 702, 632, 671, 673, 0, 668,
+	683, 684, 686, 0, 688, 0, 690, 691, 636, 637,
+	656, 0, 0, 702, 0, 702, 103, 702, 105, 0,
+	696, 112, 113, 0, 0, 119, 248, 249, 154, 308,
+	167, 173, 0, 0, 0, 177, 0, 0, 180, 182,
+	183, 243, 247, 247, 212, 245, 246, 213, 214, 215,
+	0, 231, 0, 0, 0, 301, 124, 915, 914, 259,
+	259, 254, 0, 257, 0, 234, 0, 139, 0, 0,
+	0, 0, 363, 0, 374, 375, 0, 358, 418, 0,
+	251, 0, 264, 856, 664, 0, 0, 376, 0, 359,
+	379, 380, 391, 341, 342, 339, 659, 928, 929, 930,
+	0, 940, 132, 0, 0, 0, 0, 409, 0, 450,
+	451, 101, 512, 512, 569, 0, 572, 0, 720, 0,
+	740, 723, 782, 0, 783, 784, 0, 857, 907, 77,
+	0, 233, 233, 836, 233, 237, 839, 233, 841, 233,
+	844, 0, 0, 0, 0, 0, 0, 0, 848, 797,
+	854, 0, 0, 0, 51, 0, 0, 982, 981, 0,
+	0, 0, 0, 57, 0, 244, 947, 944, 76, 871,
+	0, 703, 623, 80, 89, 0, 984, 975, 986, 988,
+	0, 0, 0, 0, 899, 665, 672, 0, 0, 666,
+	0, 667, 687, 689, -2, 0, 899, 702, 96, 97,
+	0, 116, 117, 118, 307, 174, 175, 0, 178, 179,
+	181, 208, 209, 244, 0, 244, 0, 238, 0, 290,
+	302, 0, 912, 913, 0, 0, 256, 258, 659, 140,
+	141, 142, 0, 163, 360, 0, 250, 0, 0, 443,
+	440, 377, 378, 0, 939, 410, 0, 130, 131, 0,
+	0, 415, 449, 459, 465, 571, 591, 724, 882, 880,
+	785, 942, 788, 833, 244, 837, 838, 840, 842, 843,
+	845, 790, 789, 0, 0, 0, 0, 0, 907, 0,
+	852, 0, 0, 0, 52, 53, 993, 994, 0, 0,
+	0, 0, 677, 244, 967, 873, 0, 0, 0, 90,
+	0, 989, 0, 0, 107, 907, 669, 0, 674, 0,
+	654, 655, 907, 95, 176, 247, 232, 247, 0, 0,
+	303, 916, 917, 918, 919, 920, 921, 922, 0, 366,
+	0, 0, 420, 0, 428, 0, 0, 0, 133, 134,
+	0, 0, 0, 0, 884, 883, 78, 834, 835, 0,
+	0, 0, 0, 825, 0, 849, 0, 0, 0, 983,
+	0, 699, 0, 0, 697, 949, 948, 961, 965, 81,
+	0, 872, 870, 0, 987, 0, 979, 978, 93, 0,
+	0, 0, 94, 221, 222, 236, 239, 0, 0, 0,
+	444, 441, 442, 931, 0, 0, 0, 417, 879, 899,
+	0, 791, 793, 792, 794, 0, 0, 0, 796, 813,
+	814, 0, 698, 700, 701, 658, 967, 0, 960, 963,
+	-2, 0, 874, 875, 0, 0, 977, 670, 675, 0,
+	931, 0, 0, 407, 933, 135, 136, 352, 353, 354,
+	129, 886, 0, 795, 0, 0, 0, 244, 954, 952,
+	952, 965, 0, 969, 0, 0, 0, 0, 985, 0,
+	0, 641, 0, 1317, 125, 0, 0, 0, 0, 934,
+	935, 132, 881, 0, 889, 890, 885, 826, 0, 829,
+	50, 957, 0, 950, 953, 951, 962, 0, 968, 0,
+	0, 966, 876, 882, 639, 640, 0, 0, 0, 0,
+	0, 445, 446, 286, 0, 414, 887, 0, 0, 0,
+	0, 896, 897, 0, 827, 946, 0, 955, 956, 964,
+	0, 0, 0, 642, 643, 0, 0, 649, 0, 287,
+	288, 0, 932, 0, 891, 892, 893, 894, 895, 0,
+	0, 958, 959, 970, 972, 877, 644, 0, 650, 289,
+	0, 0, 0, 0, 898, 0, 0, 651, 652, 0,
+	648, 291, 293, 294, 0, 0, 292, 888, 828, 0,
+	653, 295, 296, 297, 645, 646, 0, 0, 647,
 }
 
 var yyTok1 = [...]int{
@@ -4973,7 +5385,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
 	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 468,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 482,
 	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5051,7 +5463,10 @@ var yyTok3 = [...]int{
 	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
 	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
-	57790, 465, 57791, 466, 57792, 467, 0,
+	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
+	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
+	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
+	57805, 480, 57806, 481, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5393,77 +5808,252 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:409
+//line sql.y:461
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:414
+//line sql.y:466
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:415
+//line sql.y:467
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:419
+//line sql.y:471
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 33:
+	case 5:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:475
+		{
+			yyVAL.statement = SetWith(yyDollar[2].selStmt, yyDollar[1].with)
+		}
+	case 36:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:451
+//line sql.y:509
 		{
 			setParseTree(yylex, nil)
 		}
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:457
+//line sql.y:515
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 35:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:461
+//line sql.y:519
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 36:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:465
+//line sql.y:523
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 37:
+	case 40:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:470
+//line sql.y:528
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 38:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:474
+//line sql.y:532
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 39:
+	case 42:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:480
+//line sql.y:538
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 40:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:486
+	case 43:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:546
 		{
 			yyVAL.statement = &Load{}
 		}
-	case 41:
+	case 44:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:550
+		{
+			yyVAL.statement = &Load{}
+		}
+	case 45:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:554
+		{
+			if yyDollar[2].bytes != nil {
+				lines, ok := parseIgnoreLines(yyDollar[2].bytes)
+				if !ok {
+					yylex.Error("invalid number of lines")
+					return 1
+				}
+				yyDollar[1].loadData.IgnoreLines = lines
+			}
+			yyDollar[1].loadData.Columns = yyDollar[3].columns
+			yyVAL.statement = yyDollar[1].loadData
+		}
+	case 46:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:567
+		{
+			yylex.Error("unsupported: SET clause in LOAD DATA LOCAL INFILE")
+			return 1
+		}
+	case 47:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:573
+		{
+			yyVAL.bytes = nil
+		}
+	case 50:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:581
+		{
+			yyVAL.loadData = &LoadData{
+				FileName:           string(yyDollar[6].bytes),
+				Replace:            yyDollar[7].str == ReplaceStr,
+				Ignore:             yyDollar[7].str == IgnoreStr,
+				Table:              yyDollar[10].tableName,
+				Charset:            yyDollar[11].str,
+				FieldsTerminatedBy: "\t",
+				FieldsEscapedBy:    "\\",
+				LinesTerminatedBy:  "\n",
+			}
+		}
+	case 51:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:595
+		{
+			yyVAL.str = ""
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:599
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:603
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 56:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:614
+		{
+			yyDollar[1].loadData.FieldsTerminatedBy = string(yyDollar[5].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 57:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:619
+		{
+			yyDollar[1].loadData.FieldsOptionallyEnclosed = yyDollar[3].str != ""
+			yyDollar[1].loadData.FieldsEnclosedBy = string(yyDollar[6].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 58:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:625
+		{
+			yyDollar[1].loadData.FieldsEscapedBy = string(yyDollar[5].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 59:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:630
+		{
+			yyDollar[1].loadData.FieldsTerminatedBy = string(yyDollar[4].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 60:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:635
+		{
+			yyDollar[1].loadData.FieldsOptionallyEnclosed = yyDollar[2].str != ""
+			yyDollar[1].loadData.FieldsEnclosedBy = string(yyDollar[5].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 61:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:641
+		{
+			yyDollar[1].loadData.FieldsEscapedBy = string(yyDollar[4].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 64:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:652
+		{
+			yyDollar[1].loadData.LinesStartingBy = string(yyDollar[5].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 65:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:657
+		{
+			yyDollar[1].loadData.LinesTerminatedBy = string(yyDollar[5].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 66:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:662
+		{
+			yyDollar[1].loadData.LinesStartingBy = string(yyDollar[4].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 67:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:667
+		{
+			yyDollar[1].loadData.LinesTerminatedBy = string(yyDollar[4].bytes)
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 68:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:673
+		{
+			yyVAL.bytes = nil
+		}
+	case 69:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:677
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 70:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:681
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 71:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:686
+		{
+			yyVAL.columns = nil
+		}
+	case 72:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:690
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:492
+//line sql.y:696
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5472,27 +6062,27 @@ yydefault:
 			sel.Into = yyDollar[5].selectInto
 			yyVAL.selStmt = sel
 		}
-	case 42:
+	case 74:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:501
+//line sql.y:705
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].lock}
 		}
-	case 43:
+	case 75:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:709
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 44:
+	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:509
+//line sql.y:713
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 45:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:532
+//line sql.y:736
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5500,45 +6090,77 @@ yydefault:
 			sel.Lock = yyDollar[4].lock
 			yyVAL.selStmt = sel
 		}
-	case 46:
+	case 78:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:540
+//line sql.y:744
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 47:
+	case 79:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:546
+//line sql.y:750
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 48:
+	case 80:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:552
+//line sql.y:756
 		{
 			yyVAL.statement = &VStream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 49:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:560
+	case 81:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:764
+		{
+			sel := NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel.Windows = yyDollar[9].windowDefs
+			yyVAL.selStmt = sel
+		}
+	case 82:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:772
 		{
-			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
 		}
-	case 50:
+	case 83:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:776
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 84:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:782
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 85:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:786
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 86:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:792
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:798
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 51:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:802
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 52:
+	case 89:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:577
+//line sql.y:809
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -5550,9 +6172,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 53:
+	case 90:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:589
+//line sql.y:821
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -5562,210 +6184,210 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].insertAction, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 54:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:601
+//line sql.y:833
 		{
 			yyVAL.insertAction = InsertAct
 		}
-	case 55:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:605
+//line sql.y:837
 		{
 			yyVAL.insertAction = ReplaceAct
 		}
-	case 56:
+	case 93:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:611
+//line sql.y:843
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 57:
+	case 94:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:617
+//line sql.y:849
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 58:
+	case 95:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:621
+//line sql.y:853
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
 		}
-	case 59:
+	case 96:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:625
+//line sql.y:857
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 60:
+	case 97:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:629
+//line sql.y:861
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 61:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+//line sql.y:866
 		{
 		}
-	case 62:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:635
+//line sql.y:867
 		{
 		}
-	case 63:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:639
+//line sql.y:871
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
-	case 64:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:643
+//line sql.y:875
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
 		}
-	case 65:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:649
+//line sql.y:881
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 66:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:653
+//line sql.y:885
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 67:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:659
+//line sql.y:891
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 68:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:663
+//line sql.y:895
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 69:
+	case 106:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:668
+//line sql.y:900
 		{
 			yyVAL.partitions = nil
 		}
-	case 70:
+	case 107:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:672
+//line sql.y:904
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 71:
+	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:678
+//line sql.y:910
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 72:
+	case 109:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:684
+//line sql.y:916
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].scope, Characteristics: yyDollar[5].characteristics}
 		}
-	case 73:
+	case 110:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:688
+//line sql.y:920
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics, Scope: ImplicitScope}
 		}
-	case 74:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:694
+//line sql.y:926
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
-	case 75:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:698
+//line sql.y:930
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
-	case 76:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:704
+//line sql.y:936
 		{
 			yyVAL.characteristic = yyDollar[3].isolationLevel
 		}
-	case 77:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:708
+//line sql.y:940
 		{
 			yyVAL.characteristic = ReadWrite
 		}
-	case 78:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:712
+//line sql.y:944
 		{
 			yyVAL.characteristic = ReadOnly
 		}
-	case 79:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:718
+//line sql.y:950
 		{
 			yyVAL.isolationLevel = RepeatableRead
 		}
-	case 80:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:722
+//line sql.y:954
 		{
 			yyVAL.isolationLevel = ReadCommitted
 		}
-	case 81:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:726
+//line sql.y:958
 		{
 			yyVAL.isolationLevel = ReadUncommitted
 		}
-	case 82:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:730
+//line sql.y:962
 		{
 			yyVAL.isolationLevel = Serializable
 		}
-	case 83:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:968
 		{
 			yyVAL.scope = SessionScope
 		}
-	case 84:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:740
+//line sql.y:972
 		{
 			yyVAL.scope = GlobalScope
 		}
-	case 85:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:746
+//line sql.y:978
 		{
 			yyDollar[1].createTable.TableSpec = yyDollar[2].TableSpec
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 86:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:752
+//line sql.y:984
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTable.OptLike = yyDollar[2].optLike
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 87:
+	case 124:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:759
+//line sql.y:991
 		{
 			indexDef := yyDollar[1].alterTable.AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumns
@@ -5774,1695 +6396,1695 @@ yydefault:
 			yyDollar[1].alterTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 88:
+	case 125:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:768
+//line sql.y:1000
 		{
 			yyVAL.statement = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].boolean, Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columns, Select: yyDollar[10].selStmt, CheckOption: yyDollar[11].str}
 		}
-	case 89:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:772
+//line sql.y:1004
 		{
 			yyDollar[1].createDatabase.FullyParsed = true
 			yyDollar[1].createDatabase.CreateOptions = yyDollar[2].collateAndCharsets
 			yyVAL.statement = yyDollar[1].createDatabase
 		}
-	case 90:
+	case 127:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:779
+//line sql.y:1011
 		{
 			yyVAL.boolean = false
 		}
-	case 91:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:1015
 		{
 			yyVAL.boolean = true
 		}
-	case 92:
+	case 129:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:788
+//line sql.y:1020
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 93:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:792
+//line sql.y:1024
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 94:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:1030
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 95:
+	case 132:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:803
+//line sql.y:1035
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 96:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:1040
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 97:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:814
+//line sql.y:1046
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 98:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:819
+//line sql.y:1051
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 99:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:1057
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 100:
+	case 137:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:831
+//line sql.y:1063
 		{
 			yyVAL.createTable = &CreateTable{Table: yyDollar[5].tableName, IfNotExists: yyDollar[4].boolean, Temp: yyDollar[2].boolean}
 			setDDL(yylex, yyVAL.createTable)
 		}
-	case 101:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:838
+//line sql.y:1070
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 102:
+	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:845
+//line sql.y:1077
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[6].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[3].colIdent, Type: string(yyDollar[2].bytes)}, Options: yyDollar[4].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 103:
+	case 140:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:850
+//line sql.y:1082
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Fulltext: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 104:
+	case 141:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:855
+//line sql.y:1087
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Spatial: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 105:
+	case 142:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:860
+//line sql.y:1092
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Unique: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 106:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:867
+//line sql.y:1099
 		{
 			yyVAL.createDatabase = &CreateDatabase{DBName: string(yyDollar[4].colIdent.String()), IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createDatabase)
 		}
-	case 107:
+	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:1106
 		{
 			yyVAL.alterDatabase = &AlterDatabase{}
 			setDDL(yylex, yyVAL.alterDatabase)
 		}
-	case 110:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:885
+//line sql.y:1117
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
-	case 111:
+	case 148:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:891
+//line sql.y:1123
 		{
 			yyVAL.collateAndCharsets = nil
 		}
-	case 112:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:895
+//line sql.y:1127
 		{
 			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
 		}
-	case 113:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:1133
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 114:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:1137
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 115:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:909
+//line sql.y:1141
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 116:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:913
+//line sql.y:1145
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:918
+//line sql.y:1150
 		{
 			yyVAL.boolean = false
 		}
-	case 118:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:922
+//line sql.y:1154
 		{
 			yyVAL.boolean = true
 		}
-	case 119:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:928
+//line sql.y:1160
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 120:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:932
+//line sql.y:1164
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 121:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:938
+//line sql.y:1170
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 122:
+	case 159:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:942
+//line sql.y:1174
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 123:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:949
+//line sql.y:1181
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 124:
+	case 161:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:953
+//line sql.y:1185
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 125:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:1191
 		{
 			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
-	case 126:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:963
+//line sql.y:1195
 		{
 			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
-	case 127:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:969
+//line sql.y:1201
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 128:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:974
+//line sql.y:1206
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
 		}
-	case 129:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:979
+//line sql.y:1211
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 130:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:983
+//line sql.y:1215
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
 		}
-	case 131:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:988
+//line sql.y:1220
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 132:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:992
+//line sql.y:1224
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 133:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:996
+//line sql.y:1228
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 134:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1002
+//line sql.y:1234
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptions
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 135:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1012
+//line sql.y:1244
 		{
 			yyVAL.columnTypeOptions = &ColumnTypeOptions{NotNull: false, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil}
 		}
-	case 136:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1016
+//line sql.y:1248
 		{
 			yyDollar[1].columnTypeOptions.NotNull = false
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 137:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1021
+//line sql.y:1253
 		{
 			yyDollar[1].columnTypeOptions.NotNull = true
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 138:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1026
+//line sql.y:1258
 		{
 			yyDollar[1].columnTypeOptions.Default = yyDollar[3].expr
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 139:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1031
+//line sql.y:1263
 		{
 			yyDollar[1].columnTypeOptions.OnUpdate = yyDollar[4].expr
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 140:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1036
+//line sql.y:1268
 		{
 			yyDollar[1].columnTypeOptions.Autoincrement = true
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 141:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1041
+//line sql.y:1273
 		{
 			yyDollar[1].columnTypeOptions.Comment = NewStrLiteral(yyDollar[3].bytes)
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 142:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1046
+//line sql.y:1278
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyPrimary
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 143:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1051
+//line sql.y:1283
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKey
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 144:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1056
+//line sql.y:1288
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyUniqueKey
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 145:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1061
+//line sql.y:1293
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyUnique
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 146:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1068
+//line sql.y:1300
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolean
 			yyVAL.columnType.Zerofill = yyDollar[3].boolean
 		}
-	case 150:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1079
+//line sql.y:1311
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
-	case 151:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1084
+//line sql.y:1316
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 152:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1090
+//line sql.y:1322
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 153:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1094
+//line sql.y:1326
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 154:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1098
+//line sql.y:1330
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 155:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1102
+//line sql.y:1334
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 156:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1106
+//line sql.y:1338
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 157:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1110
+//line sql.y:1342
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 158:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1114
+//line sql.y:1346
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 159:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1118
+//line sql.y:1350
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 160:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1122
+//line sql.y:1354
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 161:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1128
+//line sql.y:1360
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 162:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1134
+//line sql.y:1366
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 163:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1140
+//line sql.y:1372
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 164:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1146
+//line sql.y:1378
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 165:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1152
+//line sql.y:1384
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 166:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+//line sql.y:1392
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 167:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1164
+//line sql.y:1396
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 168:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1168
+//line sql.y:1400
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 169:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1172
+//line sql.y:1404
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 170:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1176
+//line sql.y:1408
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 171:
+	case 208:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1182
+//line sql.y:1414
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 172:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1186
+//line sql.y:1418
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 173:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1190
+//line sql.y:1422
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 174:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1194
+//line sql.y:1426
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 175:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1198
+//line sql.y:1430
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 176:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1202
+//line sql.y:1434
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 177:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1206
+//line sql.y:1438
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 178:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1210
+//line sql.y:1442
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 179:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1446
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 180:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1450
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 181:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1222
+//line sql.y:1454
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 182:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1226
+//line sql.y:1458
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 183:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1462
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 184:
+	case 221:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1234
+//line sql.y:1466
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 185:
+	case 222:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1239
+//line sql.y:1471
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 186:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1245
+//line sql.y:1477
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 187:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1481
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 188:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1253
+//line sql.y:1485
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 189:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1257
+//line sql.y:1489
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 190:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1261
+//line sql.y:1493
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 191:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1265
+//line sql.y:1497
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 192:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1269
+//line sql.y:1501
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 193:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1273
+//line sql.y:1505
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 194:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1511
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 195:
+	case 232:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1284
+//line sql.y:1516
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 196:
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1289
+//line sql.y:1521
 		{
 			yyVAL.literal = nil
 		}
-	case 197:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1293
+//line sql.y:1525
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
-	case 198:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1298
+//line sql.y:1530
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 199:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1302
+//line sql.y:1534
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 200:
+	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1310
+//line sql.y:1542
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 201:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1314
+//line sql.y:1546
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 			}
 		}
-	case 202:
+	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1320
+//line sql.y:1552
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 203:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1328
+//line sql.y:1560
 		{
 			yyVAL.boolean = false
 		}
-	case 204:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1332
+//line sql.y:1564
 		{
 			yyVAL.boolean = true
 		}
-	case 205:
+	case 242:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1337
+//line sql.y:1569
 		{
 			yyVAL.boolean = false
 		}
-	case 206:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1573
 		{
 			yyVAL.boolean = true
 		}
-	case 207:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1346
+//line sql.y:1578
 		{
 			yyVAL.str = ""
 		}
-	case 208:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1350
+//line sql.y:1582
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 209:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1354
+//line sql.y:1586
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 210:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1359
+//line sql.y:1591
 		{
 			yyVAL.str = ""
 		}
-	case 211:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1363
+//line sql.y:1595
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 212:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1367
+//line sql.y:1599
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 213:
+	case 250:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1374
+//line sql.y:1606
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 214:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1379
+//line sql.y:1611
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 215:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1383
+//line sql.y:1615
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 216:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1389
+//line sql.y:1621
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 217:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1393
+//line sql.y:1625
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 218:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1399
+//line sql.y:1631
 		{
 			yyVAL.indexOption = yyDollar[1].indexOption
 		}
-	case 219:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1403
+//line sql.y:1635
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 220:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1408
+//line sql.y:1640
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
-	case 221:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1412
+//line sql.y:1644
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 222:
+	case 259:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1418
+//line sql.y:1650
 		{
 			yyVAL.str = ""
 		}
-	case 223:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1422
+//line sql.y:1654
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 224:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
+//line sql.y:1660
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 225:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1664
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 226:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1436
+//line sql.y:1668
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
-	case 227:
+	case 264:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1440
+//line sql.y:1672
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
-	case 228:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1444
+//line sql.y:1676
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 229:
+	case 266:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1449
+//line sql.y:1681
 		{
 			yyVAL.str = ""
 		}
-	case 230:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1453
+//line sql.y:1685
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 231:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+//line sql.y:1691
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 232:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1463
+//line sql.y:1695
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 233:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1467
+//line sql.y:1699
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 234:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1706
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 235:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1478
+//line sql.y:1710
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 236:
+	case 273:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1483
+//line sql.y:1715
 		{
 			yyVAL.str = "key"
 		}
-	case 237:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1487
+//line sql.y:1719
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 238:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1725
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 239:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1497
+//line sql.y:1729
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 240:
+	case 277:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1502
+//line sql.y:1734
 		{
 			yyVAL.str = ""
 		}
-	case 241:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1506
+//line sql.y:1738
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 242:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1512
+//line sql.y:1744
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 243:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1516
+//line sql.y:1748
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 244:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1522
+//line sql.y:1754
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
 		}
-	case 245:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1528
+//line sql.y:1760
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 246:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1532
+//line sql.y:1764
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 247:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+//line sql.y:1770
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 248:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1542
+//line sql.y:1774
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 249:
+	case 286:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1548
+//line sql.y:1780
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 250:
+	case 287:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1552
+//line sql.y:1784
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 251:
+	case 288:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1556
+//line sql.y:1788
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 252:
+	case 289:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1560
+//line sql.y:1792
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 253:
+	case 290:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1566
+//line sql.y:1798
 		{
 			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
 		}
-	case 254:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1572
+//line sql.y:1804
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 255:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1578
+//line sql.y:1810
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 256:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1584
+//line sql.y:1816
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 257:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1588
+//line sql.y:1820
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 258:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1824
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 259:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1596
+//line sql.y:1828
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 260:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1600
+//line sql.y:1832
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 261:
+	case 298:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1605
+//line sql.y:1837
 		{
 			yyVAL.str = ""
 		}
-	case 262:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1609
+//line sql.y:1841
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 263:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1613
+//line sql.y:1845
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 264:
+	case 301:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1618
+//line sql.y:1850
 		{
 			yyVAL.boolean = true
 		}
-	case 265:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1622
+//line sql.y:1854
 		{
 			yyVAL.boolean = true
 		}
-	case 266:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1626
+//line sql.y:1858
 		{
 			yyVAL.boolean = false
 		}
-	case 267:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1631
+//line sql.y:1863
 		{
 			yyVAL.tableOptions = nil
 		}
-	case 268:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1635
+//line sql.y:1867
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
-	case 269:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1641
+//line sql.y:1873
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 270:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1645
+//line sql.y:1877
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
 		}
-	case 271:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1649
+//line sql.y:1881
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 272:
+	case 309:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:1887
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 273:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1659
+//line sql.y:1891
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 274:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1665
+//line sql.y:1897
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 275:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1669
+//line sql.y:1901
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1673
+//line sql.y:1905
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
 		}
-	case 277:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1677
+//line sql.y:1909
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
 		}
-	case 278:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1681
+//line sql.y:1913
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 279:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1685
+//line sql.y:1917
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1689
+//line sql.y:1921
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 281:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1693
+//line sql.y:1925
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1697
+//line sql.y:1929
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 283:
+	case 320:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1701
+//line sql.y:1933
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 284:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1705
+//line sql.y:1937
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1709
+//line sql.y:1941
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 286:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1713
+//line sql.y:1945
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 287:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1717
+//line sql.y:1949
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1721
+//line sql.y:1953
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 289:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1725
+//line sql.y:1957
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 290:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1729
+//line sql.y:1961
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 291:
+	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1733
+//line sql.y:1965
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 292:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1737
+//line sql.y:1969
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 293:
+	case 330:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1741
+//line sql.y:1973
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 294:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1745
+//line sql.y:1977
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1749
+//line sql.y:1981
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 296:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1753
+//line sql.y:1985
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 297:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1757
+//line sql.y:1989
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 298:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1761
+//line sql.y:1993
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1765
+//line sql.y:1997
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1769
+//line sql.y:2001
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 301:
+	case 338:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1773
+//line sql.y:2005
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
-	case 302:
+	case 339:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1777
+//line sql.y:2009
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
 		}
-	case 303:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1782
+//line sql.y:2014
 		{
 			yyVAL.str = ""
 		}
-	case 304:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1786
+//line sql.y:2018
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 305:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1790
+//line sql.y:2022
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 315:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:2041
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 316:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:2045
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 317:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
+//line sql.y:2049
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 318:
+	case 355:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1822
+//line sql.y:2054
 		{
 			yyVAL.bytes = []byte("")
 		}
-	case 320:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1828
+//line sql.y:2060
 		{
 			yyVAL.colName = nil
 		}
-	case 321:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1832
+//line sql.y:2064
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 322:
+	case 359:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1837
+//line sql.y:2069
 		{
 			yyVAL.colName = nil
 		}
-	case 323:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:2073
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 324:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1846
+//line sql.y:2078
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 325:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:2082
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 326:
+	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1854
+//line sql.y:2086
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, &OrderByOption{Cols: yyDollar[5].columns})
 		}
-	case 327:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1858
+//line sql.y:2090
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 328:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1862
+//line sql.y:2094
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...)
 		}
-	case 329:
+	case 366:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1866
+//line sql.y:2098
 		{
 			yyVAL.alterOptions = append(append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...), &OrderByOption{Cols: yyDollar[7].columns})
 		}
-	case 330:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1872
+//line sql.y:2104
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 331:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1876
+//line sql.y:2108
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 332:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1880
+//line sql.y:2112
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 333:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1886
+//line sql.y:2118
 		{
 			yyVAL.alterOption = yyDollar[1].tableOptions
 		}
-	case 334:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1890
+//line sql.y:2122
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 335:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1894
+//line sql.y:2126
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 336:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1898
+//line sql.y:2130
 		{
 			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
 		}
-	case 337:
+	case 374:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1902
+//line sql.y:2134
 		{
 			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
 		}
-	case 338:
+	case 375:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1906
+//line sql.y:2138
 		{
 			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 339:
+	case 376:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1910
+//line sql.y:2142
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
 		}
-	case 340:
+	case 377:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1914
+//line sql.y:2146
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
 		}
-	case 341:
+	case 378:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1918
+//line sql.y:2150
 		{
 			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
 		}
-	case 342:
+	case 379:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1922
+//line sql.y:2154
 		{
 			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 343:
+	case 380:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1926
+//line sql.y:2158
 		{
 			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 344:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1930
+//line sql.y:2162
 		{
 			yyVAL.alterOption = &KeyState{Enable: false}
 		}
-	case 345:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1934
+//line sql.y:2166
 		{
 			yyVAL.alterOption = &KeyState{Enable: true}
 		}
-	case 346:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1938
+//line sql.y:2170
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: false}
 		}
-	case 347:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1942
+//line sql.y:2174
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: true}
 		}
-	case 348:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2178
 		{
 			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
 		}
-	case 349:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1950
+//line sql.y:2182
 		{
 			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
 		}
-	case 350:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1954
+//line sql.y:2186
 		{
 			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
 		}
-	case 351:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1958
+//line sql.y:2190
 		{
 			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
 		}
-	case 352:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1962
+//line sql.y:2194
 		{
 			yyVAL.alterOption = &Force{}
 		}
-	case 353:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:2198
 		{
 			yyVAL.alterOption = &RenameTableName{Table: yyDollar[3].tableName}
 		}
-	case 354:
+	case 391:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1970
+//line sql.y:2202
 		{
 			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
 		}
-	case 355:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1976
+//line sql.y:2208
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 356:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1980
+//line sql.y:2212
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 357:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1986
+//line sql.y:2218
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 358:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2222
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 359:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1994
+//line sql.y:2226
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 360:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1998
+//line sql.y:2230
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 361:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2002
+//line sql.y:2234
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 362:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2006
+//line sql.y:2238
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 363:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2010
+//line sql.y:2242
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 364:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2014
+//line sql.y:2246
 		{
 			yyVAL.alterOption = &Validation{With: true}
 		}
-	case 365:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2018
+//line sql.y:2250
 		{
 			yyVAL.alterOption = &Validation{With: false}
 		}
-	case 366:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2024
+//line sql.y:2256
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 367:
+	case 404:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2030
+//line sql.y:2262
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = &PartitionSpec{Action: RemoveAction}
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 368:
+	case 405:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2037
+//line sql.y:2269
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[4].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 369:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2044
+//line sql.y:2276
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[2].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 370:
+	case 407:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2050
+//line sql.y:2282
 		{
 			yyVAL.statement = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columns, Select: yyDollar[9].selStmt, CheckOption: yyDollar[10].str}
 		}
-	case 371:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2286
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.AlterOptions = yyDollar[3].collateAndCharsets
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 372:
+	case 409:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2061
+//line sql.y:2293
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.UpdateDataDirectory = true
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 373:
+	case 410:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2068
+//line sql.y:2300
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -7474,9 +8096,9 @@ yydefault:
 				},
 			}
 		}
-	case 374:
+	case 411:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2080
+//line sql.y:2312
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -7486,21 +8108,21 @@ yydefault:
 				},
 			}
 		}
-	case 375:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2090
+//line sql.y:2322
 		{
 			yyVAL.statement = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 376:
+	case 413:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2094
+//line sql.y:2326
 		{
 			yyVAL.statement = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 377:
+	case 414:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2098
+//line sql.y:2330
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -7513,9 +8135,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 378:
+	case 415:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2111
+//line sql.y:2343
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -7525,15 +8147,15 @@ yydefault:
 				},
 			}
 		}
-	case 379:
+	case 416:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2121
+//line sql.y:2353
 		{
 			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 380:
+	case 417:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2125
+//line sql.y:2357
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -7544,207 +8166,207 @@ yydefault:
 				},
 			}
 		}
-	case 381:
+	case 418:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2138
+//line sql.y:2370
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
 		}
-	case 382:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2142
+//line sql.y:2374
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
 		}
-	case 383:
+	case 420:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2146
+//line sql.y:2378
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
 		}
-	case 384:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2150
+//line sql.y:2382
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
 		}
-	case 385:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2154
+//line sql.y:2386
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
-	case 386:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2158
+//line sql.y:2390
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
 		}
-	case 387:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2162
+//line sql.y:2394
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
-	case 388:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:2398
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
 		}
-	case 389:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2402
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
-	case 390:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2174
+//line sql.y:2406
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 391:
+	case 428:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2178
+//line sql.y:2410
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
 		}
-	case 392:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2182
+//line sql.y:2414
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
 		}
-	case 393:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2186
+//line sql.y:2418
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
-	case 394:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2190
+//line sql.y:2422
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
 		}
-	case 395:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2194
+//line sql.y:2426
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
-	case 396:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2198
+//line sql.y:2430
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
 		}
-	case 397:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2202
+//line sql.y:2434
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
-	case 398:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2206
+//line sql.y:2438
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
 		}
-	case 399:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2210
+//line sql.y:2442
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
-	case 400:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2446
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
 		}
-	case 401:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2218
+//line sql.y:2450
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
-	case 402:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2222
+//line sql.y:2454
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
 		}
-	case 403:
+	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2227
+//line sql.y:2459
 		{
 			yyVAL.boolean = false
 		}
-	case 404:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2231
+//line sql.y:2463
 		{
 			yyVAL.boolean = false
 		}
-	case 405:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2235
+//line sql.y:2467
 		{
 			yyVAL.boolean = true
 		}
-	case 406:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2242
+//line sql.y:2474
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 407:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2246
+//line sql.y:2478
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 408:
+	case 445:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2252
+//line sql.y:2484
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 409:
+	case 446:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2256
+//line sql.y:2488
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 410:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2262
+//line sql.y:2494
 		{
 			yyVAL.statement = &RenameTable{TablePairs: yyDollar[3].renameTablePairs}
 		}
-	case 411:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2268
+//line sql.y:2500
 		{
 			yyVAL.renameTablePairs = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
-	case 412:
+	case 449:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2272
+//line sql.y:2504
 		{
 			yyVAL.renameTablePairs = append(yyDollar[1].renameTablePairs, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 413:
+	case 450:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2278
+//line sql.y:2510
 		{
 			yyVAL.statement = &DropTable{FromTables: yyDollar[5].tableNames, IfExists: yyDollar[4].boolean, Temp: yyDollar[2].boolean}
 		}
-	case 414:
+	case 451:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2282
+//line sql.y:2514
 		{
 			// Change this to an alter statement
 			if yyDollar[3].colIdent.Lowered() == "primary" {
@@ -7753,1280 +8375,1427 @@ yydefault:
 				yyVAL.statement = &AlterTable{Table: yyDollar[5].tableName, AlterOptions: append([]AlterOption{&DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}}, yyDollar[6].alterOptions...)}
 			}
 		}
-	case 415:
+	case 452:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2291
+//line sql.y:2523
 		{
 			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 416:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2295
+//line sql.y:2527
 		{
 			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
 		}
-	case 417:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2301
+//line sql.y:2533
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[3].tableName}
 		}
-	case 418:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2305
+//line sql.y:2537
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[2].tableName}
 		}
-	case 419:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2310
+//line sql.y:2542
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 420:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2316
+//line sql.y:2548
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
 		}
-	case 421:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2320
+//line sql.y:2552
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
 		}
-	case 422:
+	case 459:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2324
+//line sql.y:2556
 		{
 			yyVAL.statement = &Show{&ShowBasic{Full: yyDollar[2].boolean, Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 423:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2328
+//line sql.y:2560
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 424:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2332
+//line sql.y:2564
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 425:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2336
+//line sql.y:2568
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
-	case 426:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2340
+//line sql.y:2572
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
-	case 427:
+	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2344
+//line sql.y:2576
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
 		}
-	case 428:
+	case 465:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2348
+//line sql.y:2580
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 429:
+	case 466:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2352
+//line sql.y:2584
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 430:
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2356
+//line sql.y:2588
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
 		}
-	case 431:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2360
+//line sql.y:2592
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
 		}
-	case 432:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2364
+//line sql.y:2596
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 433:
+	case 470:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2368
+//line sql.y:2600
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 434:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2372
+//line sql.y:2604
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 435:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2376
+//line sql.y:2608
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 436:
+	case 473:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2380
+//line sql.y:2612
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 437:
+	case 474:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2384
+//line sql.y:2616
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].boolean, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 438:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2388
+//line sql.y:2620
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].str, Filter: yyDollar[4].showFilter}}
 		}
-	case 439:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2392
+//line sql.y:2624
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
-	case 440:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2396
+//line sql.y:2628
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
-	case 441:
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2400
+//line sql.y:2632
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
-	case 442:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2404
+//line sql.y:2636
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
-	case 443:
+	case 480:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2408
+//line sql.y:2640
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
-	case 444:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2412
+//line sql.y:2644
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
-	case 445:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2416
+//line sql.y:2648
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
-	case 446:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2420
+//line sql.y:2652
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 447:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2424
+//line sql.y:2656
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 448:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2428
+//line sql.y:2660
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 449:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2432
+//line sql.y:2664
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 450:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2436
+//line sql.y:2668
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 451:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2440
+//line sql.y:2672
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 452:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2444
+//line sql.y:2676
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 453:
+	case 490:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2448
+//line sql.y:2680
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[3].bytes), Scope: ImplicitScope}}
+			showTablesOpt := &ShowTablesOpt{}
+			if yyDollar[2].boolean {
+				showTablesOpt.Full = "full "
+			}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 		}
-	case 454:
+	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2452
+//line sql.y:2688
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 455:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2693
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 456:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2697
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 457:
+	case 494:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2465
+//line sql.y:2701
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 458:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2469
+//line sql.y:2705
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 459:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2474
+//line sql.y:2709
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
+		}
+	case 497:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2714
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 460:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2488
+//line sql.y:2728
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 461:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2492
+//line sql.y:2732
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 462:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2496
+//line sql.y:2736
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 463:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2502
+//line sql.y:2742
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 464:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2506
+//line sql.y:2746
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 465:
+	case 503:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2512
+//line sql.y:2752
 		{
 			yyVAL.str = ""
 		}
-	case 466:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2516
+//line sql.y:2756
 		{
 			yyVAL.str = "extended "
 		}
-	case 467:
+	case 505:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2522
+//line sql.y:2762
 		{
 			yyVAL.boolean = false
 		}
-	case 468:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2526
+//line sql.y:2766
 		{
 			yyVAL.boolean = true
 		}
-	case 469:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2532
+//line sql.y:2772
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 470:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2536
+//line sql.y:2776
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 471:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2542
+//line sql.y:2782
 		{
 			yyVAL.str = ""
 		}
-	case 472:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2546
+//line sql.y:2786
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 473:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2550
+//line sql.y:2790
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 474:
+	case 512:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2556
+//line sql.y:2796
 		{
 			yyVAL.showFilter = nil
 		}
-	case 475:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2560
+//line sql.y:2800
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 476:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2564
+//line sql.y:2804
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 477:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2570
+//line sql.y:2810
 		{
 			yyVAL.showFilter = nil
 		}
-	case 478:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2574
+//line sql.y:2814
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 479:
+	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2580
+//line sql.y:2820
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 480:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2584
+//line sql.y:2824
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 481:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2588
+//line sql.y:2828
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 482:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2834
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 483:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2598
+//line sql.y:2838
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 484:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2604
+//line sql.y:2844
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 485:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2848
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 486:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2614
+//line sql.y:2854
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 487:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2620
+//line sql.y:2860
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 488:
+	case 526:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2624
+//line sql.y:2864
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 489:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2629
+//line sql.y:2869
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2631
+//line sql.y:2871
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2634
+//line sql.y:2874
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 492:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2636
+//line sql.y:2876
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 493:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+//line sql.y:2881
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 494:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2647
+//line sql.y:2887
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 495:
+	case 533:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2652
+//line sql.y:2892
 		{
 			yyVAL.explainType = EmptyType
 		}
-	case 496:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+//line sql.y:2896
 		{
 			yyVAL.explainType = JSONType
 		}
-	case 497:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2660
+//line sql.y:2900
 		{
 			yyVAL.explainType = TreeType
 		}
-	case 498:
+	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2664
+//line sql.y:2904
 		{
 			yyVAL.explainType = VitessType
 		}
-	case 499:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2668
+//line sql.y:2908
 		{
 			yyVAL.explainType = TraditionalType
 		}
-	case 500:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2672
+//line sql.y:2912
 		{
 			yyVAL.explainType = AnalyzeType
 		}
-	case 501:
+	case 539:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2917
+		{
+			yyVAL.vexplainType = PlanVExplainType
+		}
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2921
+		{
+			yyVAL.vexplainType = PlanVExplainType
+		}
+	case 541:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2925
+		{
+			yyVAL.vexplainType = QueriesVExplainType
+		}
+	case 542:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2929
+		{
+			yyVAL.vexplainType = AllVExplainType
+		}
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2678
+//line sql.y:2935
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 502:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2682
+//line sql.y:2939
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 503:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2943
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 504:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2692
+//line sql.y:2949
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 505:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2696
+//line sql.y:2953
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 506:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2700
+//line sql.y:2957
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 507:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2704
+//line sql.y:2961
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 508:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2709
+//line sql.y:2966
 		{
 			yyVAL.str = ""
 		}
-	case 509:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2713
+//line sql.y:2970
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 510:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2717
+//line sql.y:2974
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 511:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2723
+//line sql.y:2980
 		{
 			yyVAL.statement = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
-	case 512:
+	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2727
+//line sql.y:2984
 		{
 			yyVAL.statement = &ExplainStmt{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
-	case 513:
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2988
+		{
+			yyVAL.statement = &VExplainStmt{Type: yyDollar[2].vexplainType, Statement: yyDollar[3].statement}
+		}
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2733
+//line sql.y:2994
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 514:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2737
+//line sql.y:2998
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 515:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2743
+//line sql.y:3004
 		{
 			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
 		}
-	case 516:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2749
+//line sql.y:3010
 		{
 			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
 		}
-	case 517:
+	case 560:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2753
+//line sql.y:3014
 		{
 			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
 		}
-	case 518:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2759
+//line sql.y:3020
 		{
 			yyVAL.tableAndLockType = &TableAndLockType{Table: yyDollar[1].aliasedTableName, Lock: yyDollar[2].lockType}
 		}
-	case 519:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:3026
 		{
 			yyVAL.lockType = Read
 		}
-	case 520:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2769
+//line sql.y:3030
 		{
 			yyVAL.lockType = ReadLocal
 		}
-	case 521:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2773
+//line sql.y:3034
 		{
 			yyVAL.lockType = Write
 		}
-	case 522:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2777
+//line sql.y:3038
 		{
 			yyVAL.lockType = LowPriorityWrite
 		}
-	case 523:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2783
+//line sql.y:3044
 		{
 			yyVAL.statement = &UnlockTables{}
 		}
-	case 524:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2789
+//line sql.y:3050
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, FlushOptions: yyDollar[3].strs}
 		}
-	case 525:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2793
+//line sql.y:3054
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean}
 		}
-	case 526:
+	case 569:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2797
+//line sql.y:3058
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, WithLock: true}
 		}
-	case 527:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2801
+//line sql.y:3062
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames}
 		}
-	case 528:
+	case 571:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2805
+//line sql.y:3066
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, WithLock: true}
 		}
-	case 529:
+	case 572:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2809
+//line sql.y:3070
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, ForExport: true}
 		}
-	case 530:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2815
+//line sql.y:3076
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 531:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2819
+//line sql.y:3080
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 532:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2825
+//line sql.y:3086
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 533:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2829
+//line sql.y:3090
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 534:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2833
+//line sql.y:3094
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 535:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2837
+//line sql.y:3098
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 536:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2841
+//line sql.y:3102
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 537:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2845
+//line sql.y:3106
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 538:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2849
+//line sql.y:3110
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 539:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2853
+//line sql.y:3114
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + yyDollar[3].str
 		}
-	case 540:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2857
+//line sql.y:3118
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 541:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2861
+//line sql.y:3122
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 542:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2865
+//line sql.y:3126
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 543:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2869
+//line sql.y:3130
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 544:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2874
+//line sql.y:3135
 		{
 			yyVAL.boolean = false
 		}
-	case 545:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2878
+//line sql.y:3139
 		{
 			yyVAL.boolean = true
 		}
-	case 546:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2882
+//line sql.y:3143
 		{
 			yyVAL.boolean = true
 		}
-	case 547:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2887
+//line sql.y:3148
 		{
 			yyVAL.str = ""
 		}
-	case 548:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2891
+//line sql.y:3152
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].colIdent.String()
 		}
-	case 549:
+	case 592:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2896
+//line sql.y:3157
 		{
 			setAllowComments(yylex, true)
 		}
-	case 550:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2900
+//line sql.y:3161
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 551:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2906
+//line sql.y:3167
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 552:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2910
+//line sql.y:3171
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 553:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2916
+//line sql.y:3177
 		{
 			yyVAL.boolean = true
 		}
-	case 554:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2920
+//line sql.y:3181
 		{
 			yyVAL.boolean = false
 		}
-	case 555:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2924
+//line sql.y:3185
 		{
 			yyVAL.boolean = true
 		}
-	case 556:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2929
+//line sql.y:3190
 		{
 			yyVAL.str = ""
 		}
-	case 557:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:3194
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 558:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2937
+//line sql.y:3198
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 559:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2942
+//line sql.y:3203
 		{
 			yyVAL.boolean = false
 		}
-	case 560:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2946
+//line sql.y:3207
 		{
 			yyVAL.boolean = true
 		}
-	case 561:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:3211
 		{
 			yyVAL.boolean = true
 		}
-	case 562:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:3216
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 563:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2959
+//line sql.y:3220
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 564:
+	case 607:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2964
+//line sql.y:3225
 		{
 			yyVAL.strs = nil
 		}
-	case 565:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2968
+//line sql.y:3229
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 566:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2972
+//line sql.y:3233
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 567:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2976
+//line sql.y:3237
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 568:
+	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2980
+//line sql.y:3241
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 569:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2986
+//line sql.y:3247
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 570:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2990
+//line sql.y:3251
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 571:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2994
+//line sql.y:3255
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 572:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2998
+//line sql.y:3259
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 573:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3002
+//line sql.y:3263
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 574:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3006
+//line sql.y:3267
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 575:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3012
+//line sql.y:3273
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 576:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3277
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 577:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3022
+//line sql.y:3283
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 578:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3026
+//line sql.y:3287
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 579:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3030
+//line sql.y:3291
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 580:
+	case 623:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3034
+//line sql.y:3295
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 581:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3039
+//line sql.y:3300
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 582:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3043
+//line sql.y:3304
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 583:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3047
+//line sql.y:3308
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 585:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3054
+//line sql.y:3315
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 586:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3059
+//line sql.y:3320
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 587:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3063
+//line sql.y:3324
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 588:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3069
+//line sql.y:3330
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 589:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3073
+//line sql.y:3334
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 592:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3083
+//line sql.y:3344
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 593:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3087
+//line sql.y:3348
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].derivedTable, As: yyDollar[3].tableIdent}
 		}
-	case 594:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3091
+//line sql.y:3352
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 595:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3097
+	case 639:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3359
 		{
-			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
+			yyVAL.tableExpr = &JSONTableExpr{Expr: yyDollar[3].expr, Path: yyDollar[5].expr, Columns: yyDollar[6].jtColumns, Alias: yyDollar[9].tableIdent}
 		}
-	case 596:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3103
+	case 640:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3365
 		{
-			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+			yyVAL.jtColumns = yyDollar[3].jtColumns
 		}
-	case 597:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3107
+	case 641:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3371
 		{
-			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
+			yyVAL.jtColumns = JSONTableColumns{yyDollar[1].jtColumn}
 		}
-	case 598:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3112
+	case 642:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3375
 		{
-			yyVAL.columns = nil
+			yyVAL.jtColumns = append(yyDollar[1].jtColumns, yyDollar[3].jtColumn)
 		}
-	case 599:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3116
+//line sql.y:3381
 		{
-			yyVAL.columns = yyDollar[2].columns
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTableOrdinalityType, Name: yyDollar[1].colIdent}
 		}
-	case 600:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3122
+	case 644:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3385
 		{
-			yyVAL.columns = Columns{yyDollar[1].colIdent}
+			yyDollar[2].columnType.Options = &ColumnTypeOptions{}
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTablePathType, Name: yyDollar[1].colIdent, ColType: yyDollar[2].columnType, Path: yyDollar[4].expr}
 		}
-	case 601:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3126
+	case 645:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3390
 		{
-			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+			yyDollar[2].columnType.Options = &ColumnTypeOptions{}
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTablePathType, Name: yyDollar[1].colIdent, ColType: yyDollar[2].columnType, Path: yyDollar[4].expr, OnEmpty: yyDollar[5].jtOnResponse}
 		}
-	case 602:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3132
+	case 646:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3395
 		{
-			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+			yyDollar[2].columnType.Options = &ColumnTypeOptions{}
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTablePathType, Name: yyDollar[1].colIdent, ColType: yyDollar[2].columnType, Path: yyDollar[4].expr, OnError: yyDollar[5].jtOnResponse}
 		}
-	case 603:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+	case 647:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3400
 		{
-			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+			yyDollar[2].columnType.Options = &ColumnTypeOptions{}
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTablePathType, Name: yyDollar[1].colIdent, ColType: yyDollar[2].columnType, Path: yyDollar[4].expr, OnEmpty: yyDollar[5].jtOnResponse, OnError: yyDollar[8].jtOnResponse}
 		}
-	case 604:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3149
+	case 648:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3405
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyDollar[2].columnType.Options = &ColumnTypeOptions{}
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTableExistsType, Name: yyDollar[1].colIdent, ColType: yyDollar[2].columnType, Path: yyDollar[5].expr}
 		}
-	case 605:
+	case 649:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3410
+		{
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTableNestedType, Path: yyDollar[2].expr, Columns: yyDollar[3].jtColumns}
+		}
+	case 650:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3414
+		{
+			yyVAL.jtColumn = &JSONTableColumn{Type: JSONTableNestedType, Path: yyDollar[3].expr, Columns: yyDollar[4].jtColumns}
+		}
+	case 651:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3420
+		{
+			yyVAL.jtOnResponse = &JSONTableOnResponse{Type: JSONTableErrorType}
+		}
+	case 652:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3424
+		{
+			yyVAL.jtOnResponse = &JSONTableOnResponse{Type: JSONTableNullType}
+		}
+	case 653:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3428
+		{
+			yyVAL.jtOnResponse = &JSONTableOnResponse{Type: JSONTableDefaultType, Expr: yyDollar[2].expr}
+		}
+	case 654:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3434
+		{
+			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
+		}
+	case 655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3438
+		{
+			yyVAL.expr = NewArgument(yyDollar[1].bytes)
+		}
+	case 656:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3444
+		{
+			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
+		}
+	case 657:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3450
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+		}
+	case 658:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3454
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
+		}
+	case 659:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3459
+		{
+			yyVAL.columns = nil
+		}
+	case 660:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3463
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 661:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3469
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 662:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3473
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 663:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3479
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 664:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3483
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 665:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3153
+//line sql.y:3496
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 606:
+	case 666:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3157
+//line sql.y:3500
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 607:
+	case 667:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3504
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3161
+//line sql.y:3508
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 608:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3167
+//line sql.y:3514
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 609:
+	case 670:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3169
+//line sql.y:3516
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 610:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3173
+//line sql.y:3520
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 611:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3175
+//line sql.y:3522
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 612:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3179
+//line sql.y:3526
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 613:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3181
+//line sql.y:3528
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 614:
+	case 675:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3184
+//line sql.y:3531
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 615:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3186
+//line sql.y:3533
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 616:
+	case 677:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3189
+//line sql.y:3536
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 617:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3193
+//line sql.y:3540
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 618:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3197
+//line sql.y:3544
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 620:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3204
+//line sql.y:3551
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 621:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3557
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 622:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3214
+//line sql.y:3561
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 623:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3218
+//line sql.y:3565
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 624:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3224
+//line sql.y:3571
 		{
 			yyVAL.joinType = StraightJoinType
 		}
-	case 625:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3230
+//line sql.y:3577
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 626:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3234
+//line sql.y:3581
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 627:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3238
+//line sql.y:3585
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 628:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3242
+//line sql.y:3589
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 629:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3248
+//line sql.y:3595
 		{
 			yyVAL.joinType = NaturalJoinType
 		}
-	case 630:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3252
+//line sql.y:3599
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -9034,489 +9803,495 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 631:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3262
+//line sql.y:3609
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 632:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3266
+//line sql.y:3613
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 633:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3272
+//line sql.y:3619
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 634:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3276
+//line sql.y:3623
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 635:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3282
+//line sql.y:3629
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 636:
+	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3287
+//line sql.y:3634
 		{
 			yyVAL.indexHints = nil
 		}
-	case 637:
+	case 698:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3291
+//line sql.y:3638
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 638:
+	case 699:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3295
+//line sql.y:3642
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 639:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3299
+//line sql.y:3646
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 640:
+	case 701:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3303
+//line sql.y:3650
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 641:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3308
+//line sql.y:3655
 		{
 			yyVAL.expr = nil
 		}
-	case 642:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3312
+//line sql.y:3659
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 643:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3318
+//line sql.y:3665
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 644:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3322
+//line sql.y:3669
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 645:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3326
+//line sql.y:3673
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 646:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3330
+//line sql.y:3677
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 647:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3334
+//line sql.y:3681
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 648:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3338
+//line sql.y:3685
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
-	case 649:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3342
+//line sql.y:3689
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 650:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3346
+//line sql.y:3693
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 651:
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3352
+//line sql.y:3699
 		{
 			yyVAL.str = ""
 		}
-	case 652:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3356
+//line sql.y:3703
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 653:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3362
+//line sql.y:3709
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 654:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3366
+//line sql.y:3713
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 655:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3372
+//line sql.y:3719
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
-	case 656:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3376
+//line sql.y:3723
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
-	case 657:
+	case 718:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3380
+//line sql.y:3727
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
-	case 658:
+	case 719:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3384
+//line sql.y:3731
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 659:
+	case 720:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3388
+//line sql.y:3735
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 660:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3392
+//line sql.y:3739
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
-	case 661:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3396
+//line sql.y:3743
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
-	case 662:
+	case 723:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3400
+//line sql.y:3747
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 663:
+	case 724:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3404
+//line sql.y:3751
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 664:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3408
+//line sql.y:3755
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 665:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3414
+//line sql.y:3761
 		{
 			yyVAL.isExprOperator = IsNullOp
 		}
-	case 666:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3418
+//line sql.y:3765
 		{
 			yyVAL.isExprOperator = IsNotNullOp
 		}
-	case 667:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3422
+//line sql.y:3769
 		{
 			yyVAL.isExprOperator = IsTrueOp
 		}
-	case 668:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3426
+//line sql.y:3773
 		{
 			yyVAL.isExprOperator = IsNotTrueOp
 		}
-	case 669:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3430
+//line sql.y:3777
 		{
 			yyVAL.isExprOperator = IsFalseOp
 		}
-	case 670:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3434
+//line sql.y:3781
 		{
 			yyVAL.isExprOperator = IsNotFalseOp
 		}
-	case 671:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3440
+//line sql.y:3787
 		{
 			yyVAL.comparisonExprOperator = EqualOp
 		}
-	case 672:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3444
+//line sql.y:3791
 		{
 			yyVAL.comparisonExprOperator = LessThanOp
 		}
-	case 673:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3448
+//line sql.y:3795
 		{
 			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
-	case 674:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3452
+//line sql.y:3799
 		{
 			yyVAL.comparisonExprOperator = LessEqualOp
 		}
-	case 675:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3456
+//line sql.y:3803
 		{
 			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
-	case 676:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3460
+//line sql.y:3807
 		{
 			yyVAL.comparisonExprOperator = NotEqualOp
 		}
-	case 677:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3464
+//line sql.y:3811
 		{
 			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
-	case 678:
+	case 739:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3469
+//line sql.y:3816
 		{
 			yyVAL.expr = nil
 		}
-	case 679:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3473
+//line sql.y:3820
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 680:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3479
+//line sql.y:3826
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 681:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3483
+//line sql.y:3830
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 682:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3487
+//line sql.y:3834
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 683:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3493
+//line sql.y:3840
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 684:
+	case 745:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3844
+		{
+			yyVAL.subquery = &Subquery{SetWith(yyDollar[3].selStmt, yyDollar[2].with)}
+		}
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3499
+//line sql.y:3850
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 685:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3503
+//line sql.y:3854
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 686:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3509
+//line sql.y:3860
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 687:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3513
+//line sql.y:3864
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 688:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3517
+//line sql.y:3868
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 689:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3521
+//line sql.y:3872
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 690:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3525
+//line sql.y:3876
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 691:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3529
+//line sql.y:3880
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
-	case 692:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3533
+//line sql.y:3884
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
-	case 693:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3537
+//line sql.y:3888
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
-	case 694:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3541
+//line sql.y:3892
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
-	case 695:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3545
+//line sql.y:3896
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
-	case 696:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3549
+//line sql.y:3900
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
-	case 697:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3553
+//line sql.y:3904
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
-	case 698:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3557
+//line sql.y:3908
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
-	case 699:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3561
+//line sql.y:3912
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 700:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3565
+//line sql.y:3916
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 701:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3569
+//line sql.y:3920
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
-	case 702:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3573
+//line sql.y:3924
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
-	case 703:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3577
+//line sql.y:3928
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 704:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3581
+//line sql.y:3932
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 705:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3585
+//line sql.y:3936
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 706:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3589
+//line sql.y:3940
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 707:
+	case 769:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3593
+//line sql.y:3944
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 708:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3597
+//line sql.y:3948
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
-	case 709:
+	case 771:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3601
+//line sql.y:3952
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
 		}
-	case 710:
+	case 772:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3605
+//line sql.y:3956
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
 		}
-	case 711:
+	case 773:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3609
+//line sql.y:3960
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -9524,9 +10299,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 712:
+	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3617
+//line sql.y:3968
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -9540,21 +10315,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 713:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3631
+//line sql.y:3982
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 714:
+	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3635
+//line sql.y:3986
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 715:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3639
+//line sql.y:3990
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -9562,503 +10337,503 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 720:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3657
+	case 782:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4008
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 721:
+	case 783:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3661
+//line sql.y:4012
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 722:
+	case 784:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3665
+//line sql.y:4016
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 723:
+	case 785:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3669
+//line sql.y:4020
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 724:
+	case 786:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3679
+//line sql.y:4030
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 725:
+	case 787:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3683
+//line sql.y:4034
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 726:
+	case 788:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3687
+//line sql.y:4038
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 727:
+	case 789:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3691
+//line sql.y:4042
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 728:
+	case 790:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3695
+//line sql.y:4046
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 729:
+	case 791:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3699
+//line sql.y:4050
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 730:
+	case 792:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3703
+//line sql.y:4054
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 731:
+	case 793:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3707
+//line sql.y:4058
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 732:
+	case 794:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3711
+//line sql.y:4062
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 733:
+	case 795:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3715
+//line sql.y:4066
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
 		}
-	case 734:
+	case 796:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3719
+//line sql.y:4070
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 735:
+	case 797:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3723
+//line sql.y:4074
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 736:
+	case 798:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3727
+//line sql.y:4078
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 737:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3737
+//line sql.y:4088
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 738:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3741
+//line sql.y:4092
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 739:
+	case 801:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3745
+//line sql.y:4096
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 740:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3750
+//line sql.y:4101
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 741:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3755
+//line sql.y:4106
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 742:
+	case 804:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3760
+//line sql.y:4111
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 743:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3766
+//line sql.y:4117
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 744:
+	case 806:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3771
+//line sql.y:4122
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 745:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3776
+//line sql.y:4127
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 746:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3780
+//line sql.y:4131
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 747:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3784
+//line sql.y:4135
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 748:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3789
+//line sql.y:4140
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 749:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3794
+//line sql.y:4145
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 750:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3799
+//line sql.y:4150
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 751:
+	case 813:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3803
+//line sql.y:4154
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 752:
+	case 814:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3807
+//line sql.y:4158
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 755:
+	case 817:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3817
+//line sql.y:4168
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 756:
+	case 818:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3827
+//line sql.y:4178
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 757:
+	case 819:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3831
+//line sql.y:4182
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 758:
+	case 820:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3835
+//line sql.y:4186
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 759:
+	case 821:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3839
+//line sql.y:4190
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 760:
+	case 822:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3843
+//line sql.y:4194
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 761:
+	case 823:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3847
+//line sql.y:4198
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 762:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3851
+//line sql.y:4202
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 763:
+	case 825:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3857
+//line sql.y:4208
 		{
 			yyVAL.matchExprOption = NoOption
 		}
-	case 764:
+	case 826:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3861
+//line sql.y:4212
 		{
 			yyVAL.matchExprOption = BooleanModeOpt
 		}
-	case 765:
+	case 827:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3865
+//line sql.y:4216
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
-	case 766:
+	case 828:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3869
+//line sql.y:4220
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
-	case 767:
+	case 829:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3873
+//line sql.y:4224
 		{
 			yyVAL.matchExprOption = QueryExpansionOpt
 		}
-	case 768:
+	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3879
+//line sql.y:4230
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 769:
+	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3883
+//line sql.y:4234
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 770:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3887
+//line sql.y:4238
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 771:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3893
+//line sql.y:4244
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 772:
+	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3897
+//line sql.y:4248
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
-	case 773:
+	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3901
+//line sql.y:4252
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 774:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3905
+//line sql.y:4256
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 775:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3909
+//line sql.y:4260
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 776:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3913
+//line sql.y:4264
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 777:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3919
+//line sql.y:4270
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 778:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3923
+//line sql.y:4274
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 779:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3927
+//line sql.y:4278
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 780:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3931
+//line sql.y:4282
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 781:
+	case 843:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3935
+//line sql.y:4286
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 782:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3939
+//line sql.y:4290
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 783:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3943
+//line sql.y:4294
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 784:
+	case 846:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3948
+//line sql.y:4299
 		{
 			yyVAL.expr = nil
 		}
-	case 785:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3952
+//line sql.y:4303
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 786:
+	case 848:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3957
+//line sql.y:4308
 		{
 			yyVAL.str = string("")
 		}
-	case 787:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3961
+//line sql.y:4312
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 788:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3967
+//line sql.y:4318
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 789:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3971
+//line sql.y:4322
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 790:
+	case 852:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3977
+//line sql.y:4328
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 791:
+	case 853:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3982
+//line sql.y:4333
 		{
 			yyVAL.expr = nil
 		}
-	case 792:
+	case 854:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3986
+//line sql.y:4337
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 793:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3992
+//line sql.y:4343
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 794:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3996
+//line sql.y:4347
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 795:
+	case 857:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4000
+//line sql.y:4351
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 796:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4006
+//line sql.y:4357
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 797:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4010
+//line sql.y:4361
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 798:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4014
+//line sql.y:4365
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 799:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4018
+//line sql.y:4369
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 800:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4022
+//line sql.y:4373
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 801:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4026
+//line sql.y:4377
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 802:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4030
+//line sql.y:4381
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 803:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4034
+//line sql.y:4385
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 804:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4040
+//line sql.y:4391
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -10067,597 +10842,753 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 805:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4049
+//line sql.y:4400
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 806:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4053
+//line sql.y:4404
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 807:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4058
+//line sql.y:4409
 		{
 			yyVAL.exprs = nil
 		}
-	case 808:
+	case 870:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4062
+//line sql.y:4413
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 871:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4418
+		{
+			yyVAL.expr = nil
+		}
+	case 872:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4422
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 873:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4427
+		{
+			yyVAL.windowDefs = nil
+		}
+	case 874:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4431
+		{
+			yyVAL.windowDefs = yyDollar[2].windowDefs
+		}
+	case 875:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4437
+		{
+			yyVAL.windowDefs = WindowDefinitions{yyDollar[1].windowDef}
+		}
+	case 876:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4441
+		{
+			yyVAL.windowDefs = append(yyDollar[1].windowDefs, yyDollar[3].windowDef)
+		}
+	case 877:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4447
+		{
+			yyVAL.windowDef = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpec}
+		}
+	case 878:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4452
+		{
+			yyVAL.overClause = nil
+		}
+	case 879:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4456
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 880:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4460
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 881:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4466
+		{
+			yyVAL.windowSpec = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionClause: yyDollar[2].exprs, OrderClause: yyDollar[3].orderBy, FrameClause: yyDollar[4].frameClause}
+		}
+	case 882:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4471
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 883:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4475
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 884:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4480
+		{
+			yyVAL.exprs = nil
+		}
+	case 885:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4484
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 886:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4489
+		{
+			yyVAL.frameClause = nil
+		}
+	case 887:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4493
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[2].framePoint}
+		}
+	case 888:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4497
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 889:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4503
+		{
+			yyVAL.frameUnit = FrameRowsType
+		}
+	case 890:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4507
+		{
+			yyVAL.frameUnit = FrameRangeType
+		}
+	case 891:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4513
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowType}
+		}
+	case 892:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4517
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingType}
+		}
+	case 893:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4521
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingType}
+		}
+	case 894:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4525
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].expr}
+		}
+	case 895:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4529
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].expr}
+		}
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4536
 		{
-			yyVAL.exprs = yyDollar[3].exprs
+			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 809:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4067
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4540
 		{
-			yyVAL.expr = nil
+			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 810:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4071
+	case 898:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4544
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 811:
+	case 899:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4076
+//line sql.y:4549
 		{
 			yyVAL.orderBy = nil
 		}
-	case 812:
+	case 900:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4080
+//line sql.y:4553
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 813:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4086
+//line sql.y:4559
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 814:
+	case 902:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4090
+//line sql.y:4563
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 815:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4096
+//line sql.y:4569
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 816:
+	case 904:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4101
+//line sql.y:4574
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 817:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4105
+//line sql.y:4578
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 818:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4109
+//line sql.y:4582
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 819:
+	case 907:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4114
+//line sql.y:4587
 		{
 			yyVAL.limit = nil
 		}
-	case 820:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4118
+//line sql.y:4591
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 821:
+	case 909:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4122
+//line sql.y:4595
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 822:
+	case 910:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4126
+//line sql.y:4599
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 823:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4131
+//line sql.y:4604
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 824:
+	case 912:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4135
+//line sql.y:4608
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 825:
+	case 913:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4139
+//line sql.y:4612
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 826:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4143
+//line sql.y:4616
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 827:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4147
+//line sql.y:4620
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 828:
+	case 916:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4154
+//line sql.y:4627
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 829:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4158
+//line sql.y:4631
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 830:
+	case 918:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4162
+//line sql.y:4635
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 831:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4166
+//line sql.y:4639
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 832:
+	case 920:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4172
+//line sql.y:4645
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 833:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4176
+//line sql.y:4649
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 834:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4180
+//line sql.y:4653
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 835:
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4185
+//line sql.y:4658
 		{
 			yyVAL.str = ""
 		}
-	case 836:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4189
+//line sql.y:4662
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 837:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4193
+//line sql.y:4666
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 838:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4197
+//line sql.y:4670
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 839:
+	case 927:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4202
+//line sql.y:4675
 		{
 			yyVAL.str = ""
 		}
-	case 840:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4206
+//line sql.y:4679
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 841:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4212
+//line sql.y:4685
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 842:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4216
+//line sql.y:4689
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 843:
+	case 931:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4221
+//line sql.y:4694
 		{
 			yyVAL.str = ""
 		}
-	case 844:
+	case 932:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4225
+//line sql.y:4698
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 845:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4230
+//line sql.y:4703
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 846:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4234
+//line sql.y:4707
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 847:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4238
+//line sql.y:4711
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 848:
+	case 936:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4243
+//line sql.y:4716
 		{
 			yyVAL.str = ""
 		}
-	case 849:
+	case 937:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4247
+//line sql.y:4720
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 850:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4253
+//line sql.y:4726
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 851:
+	case 939:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4257
+//line sql.y:4730
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 852:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4261
+//line sql.y:4734
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'@" + string(yyDollar[2].bytes)
 		}
-	case 853:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4265
+//line sql.y:4738
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 854:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4270
+//line sql.y:4743
 		{
 			yyVAL.lock = NoLock
 		}
-	case 855:
+	case 943:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4274
+//line sql.y:4747
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 856:
+	case 944:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4278
+//line sql.y:4751
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 857:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4283
+//line sql.y:4756
 		{
 			yyVAL.selectInto = nil
 		}
-	case 858:
+	case 946:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:4287
+//line sql.y:4760
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 859:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4291
+//line sql.y:4764
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 860:
+	case 948:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4295
+//line sql.y:4768
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 861:
+	case 949:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4300
+//line sql.y:4773
 		{
 			yyVAL.str = ""
 		}
-	case 862:
+	case 950:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4304
+//line sql.y:4777
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 863:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4308
+//line sql.y:4781
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 864:
+	case 952:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4313
+//line sql.y:4786
 		{
 			yyVAL.str = ""
 		}
-	case 865:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4317
+//line sql.y:4790
 		{
 			yyVAL.str = " header"
 		}
-	case 866:
+	case 954:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4322
+//line sql.y:4795
 		{
 			yyVAL.str = ""
 		}
-	case 867:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4326
+//line sql.y:4799
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 868:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4330
+//line sql.y:4803
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 869:
+	case 957:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4335
+//line sql.y:4808
 		{
 			yyVAL.str = ""
 		}
-	case 870:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4339
+//line sql.y:4812
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 871:
+	case 959:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4343
+//line sql.y:4816
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 872:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4349
+//line sql.y:4822
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 873:
+	case 961:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4354
+//line sql.y:4827
 		{
 			yyVAL.str = ""
 		}
-	case 874:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4358
+//line sql.y:4831
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 875:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4363
+//line sql.y:4836
 		{
 			yyVAL.str = ""
 		}
-	case 876:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4367
+//line sql.y:4840
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 877:
+	case 965:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4372
+//line sql.y:4845
 		{
 			yyVAL.str = ""
 		}
-	case 878:
+	case 966:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4376
+//line sql.y:4849
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 879:
+	case 967:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4381
+//line sql.y:4854
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 968:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4385
+//line sql.y:4858
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 881:
+	case 969:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4390
+//line sql.y:4863
 		{
 			yyVAL.str = ""
 		}
-	case 882:
+	case 970:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4394
+//line sql.y:4867
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 883:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4399
+//line sql.y:4872
 		{
 			yyVAL.str = ""
 		}
-	case 884:
+	case 972:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4403
+//line sql.y:4876
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 885:
+	case 973:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4408
+//line sql.y:4881
 		{
 			yyVAL.str = ""
 		}
-	case 886:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4412
+//line sql.y:4885
 		{
 			yyVAL.str = " optionally"
 		}
-	case 887:
+	case 975:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4425
+//line sql.y:4898
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 888:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4429
+//line sql.y:4902
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 889:
+	case 977:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4433
+//line sql.y:4906
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 890:
+	case 978:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4437
+//line sql.y:4910
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 891:
+	case 979:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4441
+//line sql.y:4914
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 892:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4447
+//line sql.y:4920
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 893:
+	case 981:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4451
+//line sql.y:4924
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 894:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4455
+//line sql.y:4928
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 895:
+	case 983:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4459
+//line sql.y:4932
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 896:
+	case 984:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4464
+//line sql.y:4937
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 897:
+	case 985:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4468
+//line sql.y:4941
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 898:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4474
+//line sql.y:4947
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 899:
+	case 987:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4478
+//line sql.y:4951
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 900:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4484
+//line sql.y:4957
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 901:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4488
+//line sql.y:4961
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 902:
+	case 990:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4494
+//line sql.y:4967
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 903:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4500
+//line sql.y:4973
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -10665,265 +11596,306 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 904:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4510
+//line sql.y:4983
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 905:
+	case 993:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4514
+//line sql.y:4987
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 906:
+	case 994:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4520
+//line sql.y:4993
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 907:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4526
+//line sql.y:4999
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 908:
+	case 996:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4530
+//line sql.y:5003
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 909:
+	case 997:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4536
+//line sql.y:5009
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 910:
+	case 998:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4540
+//line sql.y:5013
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 911:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4544
+//line sql.y:5017
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
-	case 912:
+	case 1000:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4548
+//line sql.y:5021
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
 		}
-	case 913:
+	case 1001:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4552
+//line sql.y:5025
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 915:
+	case 1003:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4560
+//line sql.y:5033
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 918:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4570
+//line sql.y:5043
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 919:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4574
+//line sql.y:5047
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 920:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4578
+//line sql.y:5051
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 923:
+	case 1011:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4587
+//line sql.y:5060
 		{
 			yyVAL.boolean = false
 		}
-	case 924:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4589
+//line sql.y:5062
 		{
 			yyVAL.boolean = true
 		}
-	case 925:
+	case 1013:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4592
+//line sql.y:5065
 		{
 			yyVAL.boolean = false
 		}
-	case 926:
+	case 1014:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4594
+//line sql.y:5067
 		{
 			yyVAL.boolean = true
 		}
-	case 927:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4597
+//line sql.y:5070
 		{
 			yyVAL.boolean = false
 		}
-	case 928:
+	case 1016:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4599
+//line sql.y:5072
 		{
 			yyVAL.boolean = true
 		}
-	case 929:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4602
+//line sql.y:5075
 		{
 			yyVAL.ignore = false
 		}
-	case 930:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4604
+//line sql.y:5077
 		{
 			yyVAL.ignore = true
 		}
-	case 931:
+	case 1019:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4607
+//line sql.y:5080
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 932:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4609
+//line sql.y:5082
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 933:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4611
+//line sql.y:5084
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 934:
+	case 1022:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4615
+//line sql.y:5088
 		{
 			yyVAL.statement = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprs}
 		}
-	case 935:
+	case 1023:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5094
+		{
+			id, ok := parseConnectionID(yyDollar[3].bytes)
+			if !ok {
+				yylex.Error("invalid connection id")
+				return 1
+			}
+			yyVAL.statement = &Kill{Type: yyDollar[2].killType, ConnectionID: id}
+		}
+	case 1024:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4620
+//line sql.y:5104
+		{
+			yyVAL.killType = ConnectionType
+		}
+	case 1025:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5108
+		{
+			yyVAL.killType = ConnectionType
+		}
+	case 1026:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5112
+		{
+			yyVAL.killType = QueryType
+		}
+	case 1027:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5118
+		{
+			yyVAL.statement = &PurgeResultCache{}
+		}
+	case 1028:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5122
+		{
+			yyVAL.statement = &PurgeResultCache{Keyspace: yyDollar[4].tableIdent}
+		}
+	case 1029:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5127
 		{
 			yyVAL.exprs = nil
 		}
-	case 936:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4624
+//line sql.y:5131
 		{
 			yyVAL.exprs = yyDollar[1].exprs
 		}
-	case 937:
+	case 1031:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4629
+//line sql.y:5136
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 938:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4631
+//line sql.y:5138
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 939:
+	case 1033:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4635
+//line sql.y:5142
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[2].colIdent.String())}
 		}
-	case 940:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4641
+//line sql.y:5148
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 941:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4645
+//line sql.y:5152
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 943:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4652
+//line sql.y:5159
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 944:
+	case 1038:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4658
+//line sql.y:5165
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 945:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4662
+//line sql.y:5169
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 947:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4669
+//line sql.y:5176
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1346:
+	case 1454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5092
+//line sql.y:5613
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1347:
+	case 1455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5101
+//line sql.y:5622
 		{
 			decNesting(yylex)
 		}
-	case 1348:
+	case 1456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5106
+//line sql.y:5627
 		{
 			skipToEnd(yylex)
 		}
-	case 1349:
+	case 1457:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5111
+//line sql.y:5632
 		{
 			skipToEnd(yylex)
 		}
-	case 1350:
+	case 1458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5115
+//line sql.y:5636
 		{
 			skipToEnd(yylex)
 		}
-	case 1351:
+	case 1459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5119
+//line sql.y:5640
 		{
 			skipToEnd(yylex)
 		}
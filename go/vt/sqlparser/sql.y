@@ -86,6 +86,19 @@ func skipToEnd(yylex interface{}) {
   orderBy       OrderBy
   order         *Order
   limit         *Limit
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  frameUnit     FrameUnitType
+  framePoint    *FramePoint
+  windowDef     *WindowDefinition
+  windowDefs    WindowDefinitions
+  with          *With
+  cte           *CommonTableExpr
+  ctes          []*CommonTableExpr
+  jtColumn      *JSONTableColumn
+  jtColumns     JSONTableColumns
+  jtOnResponse  *JSONTableOnResponse
   updateExprs   UpdateExprs
   setExprs      SetExprs
   updateExpr    *UpdateExpr
@@ -131,6 +144,8 @@ func skipToEnd(yylex interface{}) {
   matchExprOption MatchExprOption
   orderDirection  OrderDirection
   explainType 	  ExplainType
+  vexplainType 	  VExplainType
+  killType 	  KillType
   selectInto	  *SelectInto
   createDatabase  *CreateDatabase
   alterDatabase  *AlterDatabase
@@ -147,6 +162,7 @@ func skipToEnd(yylex interface{}) {
   tableOptions     TableOptions
   renameTablePairs []*RenameTablePair
   columnTypeOptions *ColumnTypeOptions
+  loadData         *LoadData
 }
 
 %token LEX_ERROR
@@ -221,7 +237,7 @@ func skipToEnd(yylex interface{}) {
 
 // SHOW tokens
 %token <bytes> COLLATION DATABASES SCHEMAS TABLES VITESS_METADATA VSCHEMA FULL PROCESSLIST COLUMNS FIELDS ENGINES PLUGINS EXTENDED
-%token <bytes> KEYSPACES VITESS_KEYSPACES VITESS_SHARDS VITESS_TABLETS CODE PRIVILEGES FUNCTION OPEN TRIGGERS EVENT USER
+%token <bytes> KEYSPACES VITESS_KEYSPACES VITESS_SHARDS VITESS_TABLETS VITESS_TABLET QUEUES CODE PRIVILEGES FUNCTION OPEN TRIGGERS EVENT USER
 
 // SET tokens
 %token <bytes> NAMES CHARSET GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -248,8 +264,20 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
 %token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
+// Window functions
+%token <bytes> CURRENT RANGE ROW ROWS
+
 // Explain tokens
-%token <bytes> FORMAT TREE VITESS TRADITIONAL
+%token <bytes> FORMAT TREE VITESS TRADITIONAL VEXPLAIN PLAN QUERIES
+
+// Kill tokens
+%token <bytes> KILL
+
+// Result cache tokens
+%token <bytes> PURGE VITESS_RESULT_CACHE
+
+// Load data tokens
+%token <bytes> INFILE CONCURRENT
 
 // Lock type tokens
 %token <bytes> LOCAL LOW_PRIORITY
@@ -279,10 +307,16 @@ func skipToEnd(yylex interface{}) {
 %type <boolean> default_optional
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
-%type <statement> lock_statement unlock_statement call_statement
+%type <statement> lock_statement unlock_statement call_statement kill_statement purge_statement
+%type <loadData> load_data_head load_data_fields load_data_field_list load_data_lines load_data_line_list
+%type <str> load_duplicate_opt
+%type <bytes> load_priority_opt load_ignore_lines_opt
+%type <columns> load_columns_opt
 %type <bytes2> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
+%type <vexplainType> vexplain_type_opt
+%type <killType> kill_type_opt
 %type <insertAction> insert_or_replace
 %type <bytes> explain_synonyms
 %type <str> cache_opt separator_opt flush_option for_channel_opt
@@ -325,6 +359,24 @@ func skipToEnd(yylex interface{}) {
 %type <exprs> group_by_opt
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
+%type <overClause> over_clause_opt
+%type <windowSpec> window_spec
+%type <colIdent> window_name_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameUnit> frame_unit
+%type <framePoint> frame_point
+%type <expr> frame_expression
+%type <windowDefs> window_clause_opt window_definition_list
+%type <with> with_clause
+%type <cte> common_table_expr
+%type <ctes> common_table_expr_list
+%type <tableExpr> json_table_function
+%type <jtColumn> jt_column
+%type <jtColumns> jt_columns_clause jt_column_list
+%type <jtOnResponse> jt_on_response
+%type <expr> text_literal_or_arg
+%type <windowDef> window_definition
 %type <order> order
 %type <orderDirection> asc_desc_opt
 %type <limit> limit_opt
@@ -419,6 +471,10 @@ command:
   {
     $$ = $1
   }
+| with_clause select_statement
+  {
+    $$ = SetWith($2, $1)
+  }
 | stream_statement
 | vstream_statement
 | insert_statement
@@ -447,6 +503,8 @@ command:
 | lock_statement
 | unlock_statement
 | call_statement
+| kill_statement
+| purge_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -481,11 +539,157 @@ do_statement:
     $$ = &OtherAdmin{}
   }
 
+// LOAD DATA LOCAL INFILE is executed by vtgate, so it is parsed.
+// The other LOAD DATA statements are passed through as is.
 load_statement:
-  LOAD DATA skip_to_end
+  LOAD DATA load_priority_opt INFILE skip_to_end
+  {
+    $$ = &Load{}
+  }
+| LOAD DATA FROM skip_to_end
   {
     $$ = &Load{}
   }
+| load_data_lines load_ignore_lines_opt load_columns_opt
+  {
+    if $2 != nil {
+      lines, ok := parseIgnoreLines($2)
+      if !ok {
+        yylex.Error("invalid number of lines")
+        return 1
+      }
+      $1.IgnoreLines = lines
+    }
+    $1.Columns = $3
+    $$ = $1
+  }
+| load_data_lines load_ignore_lines_opt load_columns_opt SET update_list
+  {
+    yylex.Error("unsupported: SET clause in LOAD DATA LOCAL INFILE")
+    return 1
+  }
+
+load_priority_opt:
+  {
+    $$ = nil
+  }
+| LOW_PRIORITY
+| CONCURRENT
+
+load_data_head:
+  LOAD DATA load_priority_opt LOCAL INFILE STRING load_duplicate_opt INTO TABLE table_name charset_opt
+  {
+    $$ = &LoadData{
+      FileName: string($6),
+      Replace: $7 == ReplaceStr,
+      Ignore: $7 == IgnoreStr,
+      Table: $10,
+      Charset: $11,
+      FieldsTerminatedBy: "\t",
+      FieldsEscapedBy: "\\",
+      LinesTerminatedBy: "\n",
+    }
+  }
+
+load_duplicate_opt:
+  {
+    $$ = ""
+  }
+| REPLACE
+  {
+    $$ = ReplaceStr
+  }
+| IGNORE
+  {
+    $$ = IgnoreStr
+  }
+
+// The FIELDS and LINES options can be in any order, as in MySQL.
+load_data_fields:
+  load_data_head
+| load_data_field_list
+
+load_data_field_list:
+  load_data_head columns_or_fields TERMINATED BY STRING
+  {
+    $1.FieldsTerminatedBy = string($5)
+    $$ = $1
+  }
+| load_data_head columns_or_fields optionally_opt ENCLOSED BY STRING
+  {
+    $1.FieldsOptionallyEnclosed = $3 != ""
+    $1.FieldsEnclosedBy = string($6)
+    $$ = $1
+  }
+| load_data_head columns_or_fields ESCAPED BY STRING
+  {
+    $1.FieldsEscapedBy = string($5)
+    $$ = $1
+  }
+| load_data_field_list TERMINATED BY STRING
+  {
+    $1.FieldsTerminatedBy = string($4)
+    $$ = $1
+  }
+| load_data_field_list optionally_opt ENCLOSED BY STRING
+  {
+    $1.FieldsOptionallyEnclosed = $2 != ""
+    $1.FieldsEnclosedBy = string($5)
+    $$ = $1
+  }
+| load_data_field_list ESCAPED BY STRING
+  {
+    $1.FieldsEscapedBy = string($4)
+    $$ = $1
+  }
+
+load_data_lines:
+  load_data_fields
+| load_data_line_list
+
+load_data_line_list:
+  load_data_fields LINES STARTING BY STRING
+  {
+    $1.LinesStartingBy = string($5)
+    $$ = $1
+  }
+| load_data_fields LINES TERMINATED BY STRING
+  {
+    $1.LinesTerminatedBy = string($5)
+    $$ = $1
+  }
+| load_data_line_list STARTING BY STRING
+  {
+    $1.LinesStartingBy = string($4)
+    $$ = $1
+  }
+| load_data_line_list TERMINATED BY STRING
+  {
+    $1.LinesTerminatedBy = string($4)
+    $$ = $1
+  }
+
+load_ignore_lines_opt:
+  {
+    $$ = nil
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = $2
+  }
+| IGNORE INTEGRAL ROWS
+  {
+    $$ = $2
+  }
+
+load_columns_opt:
+  {
+    $$ = nil
+  }
+| openb ins_column_list closeb
+  {
+    $$ = $2
+  }
 
 select_statement:
   base_select order_by_opt limit_opt lock_opt into_option
@@ -556,9 +760,37 @@ vstream_statement:
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
 //  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
+  {
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
+  }
+
+with_clause:
+  WITH common_table_expr_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE common_table_expr_list
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+common_table_expr_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| common_table_expr_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
   }
 
 union_rhs:
@@ -2446,7 +2678,11 @@ show_statement:
   }
 | SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
   {
-      $$ = &Show{&ShowLegacy{Type: string($3), Scope: ImplicitScope}}
+      showTablesOpt := &ShowTablesOpt{}
+      if $2 {
+        showTablesOpt.Full = "full "
+      }
+      $$ = &Show{&ShowLegacy{Type: string($3), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
   }
 | SHOW VITESS_METADATA VARIABLES like_opt
   {
@@ -2469,6 +2705,10 @@ show_statement:
   {
     $$ = &Show{&ShowLegacy{Type: string($2), Scope: ImplicitScope}}
   }
+| SHOW VITESS_TABLET QUEUES
+  {
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), Scope: ImplicitScope}}
+  }
 /* vitess_topo supports SHOW VITESS_SHARDS / SHOW VITESS_TABLETS */
 | SHOW vitess_topo like_or_where_opt
   {
@@ -2673,6 +2913,23 @@ explain_format_opt:
     $$ = AnalyzeType
   }
 
+vexplain_type_opt:
+  {
+    $$ = PlanVExplainType
+  }
+| PLAN
+  {
+    $$ = PlanVExplainType
+  }
+| QUERIES
+  {
+    $$ = QueriesVExplainType
+  }
+| ALL
+  {
+    $$ = AllVExplainType
+  }
+
 explain_synonyms:
   EXPLAIN
   {
@@ -2727,6 +2984,10 @@ explain_statement:
   {
     $$ = &ExplainStmt{Type: $2, Statement: $3}
   }
+| VEXPLAIN vexplain_type_opt explainable_statement
+  {
+    $$ = &VExplainStmt{Type: $2, Statement: $3}
+  }
 
 other_statement:
   REPAIR skip_to_end
@@ -3091,6 +3352,92 @@ table_factor:
   {
     $$ = &ParenTableExpr{Exprs: $2}
   }
+| json_table_function
+
+json_table_function:
+  JSON_TABLE openb expression ',' text_literal_or_arg jt_columns_clause closeb as_opt table_id
+  {
+    $$ = &JSONTableExpr{Expr: $3, Path: $5, Columns: $6, Alias: $9}
+  }
+
+jt_columns_clause:
+  COLUMNS openb jt_column_list closeb
+  {
+    $$ = $3
+  }
+
+jt_column_list:
+  jt_column
+  {
+    $$ = JSONTableColumns{$1}
+  }
+| jt_column_list ',' jt_column
+  {
+    $$ = append($1, $3)
+  }
+
+jt_column:
+  sql_id FOR ORDINALITY
+  {
+    $$ = &JSONTableColumn{Type: JSONTableOrdinalityType, Name: $1}
+  }
+| sql_id column_type PATH text_literal_or_arg
+  {
+    $2.Options = &ColumnTypeOptions{}
+    $$ = &JSONTableColumn{Type: JSONTablePathType, Name: $1, ColType: $2, Path: $4}
+  }
+| sql_id column_type PATH text_literal_or_arg jt_on_response ON EMPTY
+  {
+    $2.Options = &ColumnTypeOptions{}
+    $$ = &JSONTableColumn{Type: JSONTablePathType, Name: $1, ColType: $2, Path: $4, OnEmpty: $5}
+  }
+| sql_id column_type PATH text_literal_or_arg jt_on_response ON ERROR
+  {
+    $2.Options = &ColumnTypeOptions{}
+    $$ = &JSONTableColumn{Type: JSONTablePathType, Name: $1, ColType: $2, Path: $4, OnError: $5}
+  }
+| sql_id column_type PATH text_literal_or_arg jt_on_response ON EMPTY jt_on_response ON ERROR
+  {
+    $2.Options = &ColumnTypeOptions{}
+    $$ = &JSONTableColumn{Type: JSONTablePathType, Name: $1, ColType: $2, Path: $4, OnEmpty: $5, OnError: $8}
+  }
+| sql_id column_type EXISTS PATH text_literal_or_arg
+  {
+    $2.Options = &ColumnTypeOptions{}
+    $$ = &JSONTableColumn{Type: JSONTableExistsType, Name: $1, ColType: $2, Path: $5}
+  }
+| NESTED text_literal_or_arg jt_columns_clause
+  {
+    $$ = &JSONTableColumn{Type: JSONTableNestedType, Path: $2, Columns: $3}
+  }
+| NESTED PATH text_literal_or_arg jt_columns_clause
+  {
+    $$ = &JSONTableColumn{Type: JSONTableNestedType, Path: $3, Columns: $4}
+  }
+
+jt_on_response:
+  ERROR
+  {
+    $$ = &JSONTableOnResponse{Type: JSONTableErrorType}
+  }
+| NULL
+  {
+    $$ = &JSONTableOnResponse{Type: JSONTableNullType}
+  }
+| DEFAULT text_literal_or_arg
+  {
+    $$ = &JSONTableOnResponse{Type: JSONTableDefaultType, Expr: $2}
+  }
+
+text_literal_or_arg:
+  STRING
+  {
+    $$ = NewStrLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1)
+  }
 
 derived_table:
   openb select_statement closeb
@@ -3493,6 +3840,10 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb with_clause simple_select closeb
+  {
+    $$ = &Subquery{SetWith($3, $2)}
+  }
 
 expression_list:
   expression
@@ -3653,9 +4004,9 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -4072,6 +4423,128 @@ having_opt:
     $$ = $2
   }
 
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
+
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+window_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| id_or_var
+  {
+    $$ = $1
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = FrameRowsType
+  }
+| RANGE
+  {
+    $$ = FrameRangeType
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+// MySQL only accepts unsigned integers, parameters and intervals as frame bounds.
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -4616,6 +5089,40 @@ call_statement:
     $$ = &CallProc{Name: $2, Params: $4}
   }
 
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    id, ok := parseConnectionID($3)
+    if !ok {
+      yylex.Error("invalid connection id")
+      return 1
+    }
+    $$ = &Kill{Type: $2, ConnectionID: id}
+  }
+
+kill_type_opt:
+  {
+    $$ = ConnectionType
+  }
+| CONNECTION
+  {
+    $$ = ConnectionType
+  }
+| QUERY
+  {
+    $$ = QueryType
+  }
+
+purge_statement:
+  PURGE VITESS_RESULT_CACHE
+  {
+    $$ = &PurgeResultCache{}
+  }
+| PURGE VITESS_RESULT_CACHE FROM table_id
+  {
+    $$ = &PurgeResultCache{Keyspace: $4}
+  }
+
 expression_list_opt:  
   {
     $$ = nil
@@ -4715,6 +5222,7 @@ reserved_keyword:
 | DIV
 | DROP
 | ELSE
+| EMPTY
 | END
 | ESCAPE
 | EXISTS
@@ -4776,6 +5284,7 @@ reserved_keyword:
 | PARTITION
 | PERCENT_RANK
 | PRIMARY
+| RANGE
 | RANK
 | READ
 | RECURSIVE
@@ -4858,9 +5367,11 @@ non_reserved_keyword:
 | COMPONENT
 | COMPRESSED
 | COMPRESSION
+| CONCURRENT
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -4925,6 +5436,7 @@ non_reserved_keyword:
 | KEY_BLOCK_SIZE
 | KEYS
 | KEYSPACES
+| KILL
 | LANGUAGE
 | LAST
 | LAST_INSERT_ID
@@ -4989,11 +5501,15 @@ non_reserved_keyword:
 | PRIVILEGE_CHECKS_USER
 | PRIVILEGES
 | PROCESS
+| PLAN
 | PLUGINS
 | POINT
 | POLYGON
 | PROCEDURE
 | PROCESSLIST
+| PURGE
+| QUERIES
+| QUEUES
 | QUERY
 | RANDOM
 | REAL
@@ -5015,6 +5531,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
 | S3
 | SECONDARY
@@ -5072,6 +5590,7 @@ non_reserved_keyword:
 | VARCHAR
 | VARIABLES
 | VCPU
+| VEXPLAIN
 | VIEW
 | VINDEX
 | VINDEXES
@@ -5079,7 +5598,9 @@ non_reserved_keyword:
 | VITESS
 | VITESS_KEYSPACES
 | VITESS_METADATA
+| VITESS_RESULT_CACHE
 | VITESS_SHARDS
+| VITESS_TABLET
 | VITESS_TABLETS
 | VSCHEMA
 | WARNINGS
@@ -138,6 +138,7 @@ var keywords = map[string]int{
 	"compact":             COMPACT,
 	"compressed":          COMPRESSED,
 	"compression":         COMPRESSION,
+	"concurrent":          CONCURRENT,
 	"condition":           UNUSED,
 	"connection":          CONNECTION,
 	"constraint":          CONSTRAINT,
@@ -149,6 +150,7 @@ var keywords = map[string]int{
 	"create":              CREATE,
 	"cross":               CROSS,
 	"csv":                 CSV,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -190,6 +192,7 @@ var keywords = map[string]int{
 	"each":                UNUSED,
 	"else":                ELSE,
 	"elseif":              UNUSED,
+	"empty":               EMPTY,
 	"enable":              ENABLE,
 	"enclosed":            ENCLOSED,
 	"encryption":          ENCRYPTION,
@@ -218,6 +221,7 @@ var keywords = map[string]int{
 	"float":               FLOAT_TYPE,
 	"float4":              UNUSED,
 	"float8":              UNUSED,
+	"following":           FOLLOWING,
 	"flush":               FLUSH,
 	"for":                 FOR,
 	"force":               FORCE,
@@ -249,7 +253,7 @@ var keywords = map[string]int{
 	"in":                  IN,
 	"index":               INDEX,
 	"indexes":             INDEXES,
-	"infile":              UNUSED,
+	"infile":              INFILE,
 	"inout":               UNUSED,
 	"inner":               INNER,
 	"inplace":             INPLACE,
@@ -272,11 +276,12 @@ var keywords = map[string]int{
 	"invoker":             INVOKER,
 	"join":                JOIN,
 	"json":                JSON,
+	"json_table":          JSON_TABLE,
 	"key":                 KEY,
 	"keys":                KEYS,
 	"keyspaces":           KEYSPACES,
 	"key_block_size":      KEY_BLOCK_SIZE,
-	"kill":                UNUSED,
+	"kill":                KILL,
 	"last":                LAST,
 	"language":            LANGUAGE,
 	"last_insert_id":      LAST_INSERT_ID,
@@ -325,6 +330,7 @@ var keywords = map[string]int{
 	"name":                NAME,
 	"names":               NAMES,
 	"natural":             NATURAL,
+	"nested":              NESTED,
 	"nchar":               NCHAR,
 	"next":                NEXT,
 	"no":                  NO,
@@ -344,30 +350,39 @@ var keywords = map[string]int{
 	"optionally":          OPTIONALLY,
 	"or":                  OR,
 	"order":               ORDER,
+	"ordinality":          ORDINALITY,
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             OUTFILE,
+	"over":                OVER,
 	"overwrite":           OVERWRITE,
 	"pack_keys":           PACK_KEYS,
 	"parser":              PARSER,
 	"partition":           PARTITION,
 	"partitioning":        PARTITIONING,
 	"password":            PASSWORD,
+	"path":                PATH,
+	"plan":                PLAN,
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
 	"precision":           UNUSED,
+	"preceding":           PRECEDING,
 	"primary":             PRIMARY,
 	"privileges":          PRIVILEGES,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
+	"purge":               PURGE,
+	"queries":             QUERIES,
+	"queues":              QUEUES,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
 	"rebuild":             REBUILD,
+	"recursive":           RECURSIVE,
 	"redundant":           REDUNDANT,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
@@ -387,6 +402,8 @@ var keywords = map[string]int{
 	"revoke":              UNUSED,
 	"right":               RIGHT,
 	"rlike":               REGEXP,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"rollback":            ROLLBACK,
 	"row_format":          ROW_FORMAT,
 	"s3":                  S3,
@@ -463,6 +480,7 @@ var keywords = map[string]int{
 	"union":               UNION,
 	"unique":              UNIQUE,
 	"unlock":              UNLOCK,
+	"unbounded":           UNBOUNDED,
 	"unsigned":            UNSIGNED,
 	"update":              UPDATE,
 	"upgrade":             UPGRADE,
@@ -482,19 +500,23 @@ var keywords = map[string]int{
 	"varcharacter":        UNUSED,
 	"varying":             UNUSED,
 	"virtual":             UNUSED,
+	"vexplain":            VEXPLAIN,
 	"vindex":              VINDEX,
 	"vindexes":            VINDEXES,
 	"view":                VIEW,
 	"vitess":              VITESS,
 	"vitess_keyspaces":    VITESS_KEYSPACES,
 	"vitess_metadata":     VITESS_METADATA,
+	"vitess_result_cache": VITESS_RESULT_CACHE,
 	"vitess_shards":       VITESS_SHARDS,
+	"vitess_tablet":       VITESS_TABLET,
 	"vitess_tablets":      VITESS_TABLETS,
 	"vschema":             VSCHEMA,
 	"warnings":            WARNINGS,
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"without":             WITHOUT,
 	"work":                WORK,
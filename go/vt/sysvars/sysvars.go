@@ -65,6 +65,12 @@ var (
 	ReadAfterWriteTimeOut = SystemVariable{Name: "read_after_write_timeout"}
 	SessionTrackGTIDs     = SystemVariable{Name: "session_track_gtids", IdentifierAsString: true}
 
+	// Consistent snapshot transactions
+	ConsistentSnapshot = SystemVariable{Name: "consistent_snapshot", IsBoolean: true, Default: off}
+
+	// Reads on the delayed replicas
+	ReadAsOf = SystemVariable{Name: "read_as_of", Default: off}
+
 	VitessAware = []SystemVariable{
 		Autocommit,
 		ClientFoundRows,
@@ -82,6 +88,8 @@ var (
 		ReadAfterWriteGTID,
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
+		ConsistentSnapshot,
+		ReadAsOf,
 	}
 
 	IgnoreThese = []SystemVariable{
@@ -154,6 +162,10 @@ var (
 		{Name: "transaction_write_set_extraction"},
 	}
 	UseReservedConn = []SystemVariable{
+		{Name: "character_set_client"},
+		{Name: "character_set_connection"},
+		{Name: "character_set_results"},
+		{Name: "collation_connection"},
 		{Name: "default_week_format"},
 		{Name: "end_markers_in_json", IsBoolean: true},
 		{Name: "eq_range_index_dive_limit"},
@@ -205,13 +217,9 @@ var (
 		// as long as they have the same value as the underlying database
 		{Name: "binlog_format"},
 		{Name: "block_encryption_mode"},
-		{Name: "character_set_client"},
-		{Name: "character_set_connection"},
 		{Name: "character_set_database"},
 		{Name: "character_set_filesystem"},
-		{Name: "character_set_results"},
 		{Name: "character_set_server"},
-		{Name: "collation_connection"},
 		{Name: "collation_database"},
 		{Name: "collation_server"},
 		{Name: "completion_type"},
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableacl
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/ioutil2"
+	"vitess.io/vitess/go/json2"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+// maxConfigVersions is the number of configs kept in the history.
+const maxConfigVersions = 20
+
+// ConfigVersion is a config that was applied by Set.
+// Versions are numbered from 1 in the order they were applied.
+type ConfigVersion struct {
+	Version int                `json:"version"`
+	Time    time.Time          `json:"time"`
+	Config  *tableaclpb.Config `json:"config"`
+}
+
+// recordVersion adds config to the history and returns its version.
+// A config equal to the latest one is not recorded again, so that the
+// periodic reloads of an unchanged config do not fill the history.
+// The caller must hold the lock.
+func (tacl *tableACL) recordVersion(config *tableaclpb.Config) int {
+	if n := len(tacl.history); n > 0 && proto.Equal(tacl.history[n-1].Config, config) {
+		return tacl.version
+	}
+	tacl.version++
+	tacl.history = append(tacl.history, ConfigVersion{
+		Version: tacl.version,
+		Time:    time.Now(),
+		Config:  proto.Clone(config).(*tableaclpb.Config),
+	})
+	if len(tacl.history) > maxConfigVersions {
+		tacl.history = tacl.history[len(tacl.history)-maxConfigVersions:]
+	}
+	return tacl.version
+}
+
+// History returns the most recent configs, oldest first.
+func History() []ConfigVersion {
+	return currentTableACL.History()
+}
+
+// History returns the most recent configs, oldest first.
+func (tacl *tableACL) History() []ConfigVersion {
+	tacl.RLock()
+	defer tacl.RUnlock()
+	history := make([]ConfigVersion, 0, len(tacl.history))
+	for _, cv := range tacl.history {
+		cv.Config = proto.Clone(cv.Config).(*tableaclpb.Config)
+		history = append(history, cv)
+	}
+	return history
+}
+
+// ConfigAt returns the config of a version from the history.
+func ConfigAt(version int) (*tableaclpb.Config, error) {
+	return currentTableACL.ConfigAt(version)
+}
+
+// ConfigAt returns the config of a version from the history.
+func (tacl *tableACL) ConfigAt(version int) (*tableaclpb.Config, error) {
+	tacl.RLock()
+	defer tacl.RUnlock()
+	for _, cv := range tacl.history {
+		if cv.Version == version {
+			return proto.Clone(cv.Config).(*tableaclpb.Config), nil
+		}
+	}
+	return nil, fmt.Errorf("table ACL config version %d is not in the history", version)
+}
+
+// Rollback applies the config of a previous version again.
+// It is recorded as a new version. If the config was loaded from a file,
+// the file is first replaced with the config, so that the next reload
+// of the file does not undo the rollback.
+func Rollback(version int) error {
+	return currentTableACL.Rollback(version)
+}
+
+// Rollback applies the config of a previous version again.
+// It is recorded as a new version. If the config was loaded from a file,
+// the file is first replaced with the config, so that the next reload
+// of the file does not undo the rollback.
+func (tacl *tableACL) Rollback(version int) error {
+	config, err := tacl.ConfigAt(version)
+	if err != nil {
+		return err
+	}
+	tacl.RLock()
+	configFile, isJSON := tacl.configFile, tacl.configJSON
+	tacl.RUnlock()
+	if configFile != "" {
+		if err := writeConfigFile(configFile, config, isJSON); err != nil {
+			return fmt.Errorf("cannot write table ACL config version %d to %s: %v", version, configFile, err)
+		}
+	}
+	return tacl.Set(config)
+}
+
+// writeConfigFile replaces a config file with config, in the same encoding.
+func writeConfigFile(configFile string, config *tableaclpb.Config, isJSON bool) error {
+	var data []byte
+	var err error
+	if isJSON {
+		data, err = json2.MarshalIndentPB(config, "  ")
+	} else {
+		data, err = proto.Marshal(config)
+	}
+	if err != nil {
+		return err
+	}
+	perm := os.FileMode(0644)
+	if fi, err := os.Stat(configFile); err == nil {
+		perm = fi.Mode().Perm()
+	}
+	return ioutil2.WriteFileAtomic(configFile, data, perm)
+}
+
+// DiffConfigs returns the differences between two configs, one line
+// per changed setting or table group. Table groups are matched by name.
+func DiffConfigs(from, to *tableaclpb.Config) []string {
+	var diffs []string
+	toGroups := make(map[string]*tableaclpb.TableGroupSpec, len(to.TableGroups))
+	for _, group := range to.TableGroups {
+		toGroups[group.Name] = group
+	}
+	fromGroups := make(map[string]*tableaclpb.TableGroupSpec, len(from.TableGroups))
+	for _, group := range from.TableGroups {
+		fromGroups[group.Name] = group
+		toGroup, ok := toGroups[group.Name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("- table group %q", group.Name))
+			continue
+		}
+		prefix := fmt.Sprintf("table group %q", group.Name)
+		diffs = appendListDiff(diffs, prefix+" table_names_or_prefixes", group.TableNamesOrPrefixes, toGroup.TableNamesOrPrefixes)
+		diffs = appendListDiff(diffs, prefix+" readers", group.Readers, toGroup.Readers)
+		diffs = appendListDiff(diffs, prefix+" writers", group.Writers, toGroup.Writers)
+		diffs = appendListDiff(diffs, prefix+" admins", group.Admins, toGroup.Admins)
+	}
+	for _, group := range to.TableGroups {
+		if _, ok := fromGroups[group.Name]; !ok {
+			diffs = append(diffs, fmt.Sprintf("+ table group %q", group.Name))
+		}
+	}
+	diffs = appendListDiff(diffs, "admins", from.Admins, to.Admins)
+	if from.DryRun != to.DryRun {
+		diffs = append(diffs, fmt.Sprintf("~ dry_run: %v -> %v", from.DryRun, to.DryRun))
+	}
+	return diffs
+}
+
+func appendListDiff(diffs []string, name string, from, to []string) []string {
+	if len(from) == len(to) {
+		equal := true
+		for i := range from {
+			if from[i] != to[i] {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return diffs
+		}
+	}
+	return append(diffs, fmt.Sprintf("~ %s: %v -> %v", name, from, to))
+}
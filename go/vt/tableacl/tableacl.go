@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/tableacl/acl"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 )
 
@@ -73,11 +74,19 @@ type tableACL struct {
 	// mutex protects entries, config, and callback
 	sync.RWMutex
 	entries aclEntries
+	admins  acl.ACL
 	config  tableaclpb.Config
 	// callback is executed on successful reload.
 	callback func()
 	// ACL Factory override for testing
 	factory acl.Factory
+	// history holds the most recent configs, oldest first.
+	history []ConfigVersion
+	version int
+	// configFile is the file the config was loaded from, if any, and
+	// configJSON tells whether it is json-encoded.
+	configFile string
+	configJSON bool
 }
 
 // currentTableACL stores current effective ACL information.
@@ -96,8 +105,13 @@ var currentTableACL tableACL
 //       "writers": ["client1"],
 //       "admins": ["client1"]
 //     }
-//   ]
+//   ],
+//   "admins": ["dba"],
+//   "dry_run": false
 // }
+//
+// The top-level admins are exempt from all the table ACL checks. In
+// dry run mode, violations are logged but the queries are let through.
 func Init(configFile string, aclCB func()) error {
 	return currentTableACL.init(configFile, aclCB)
 }
@@ -113,14 +127,27 @@ func (tacl *tableACL) init(configFile string, aclCB func()) error {
 		return err
 	}
 	config := &tableaclpb.Config{}
+	isJSON := false
 	if err := proto.Unmarshal(data, config); err != nil {
 		// try to parse tableacl as json file
 		if jsonErr := json2.Unmarshal(data, config); jsonErr != nil {
 			log.Infof("unable to parse tableACL config file as a protobuf or json file.  protobuf err: %v  json err: %v", err, jsonErr)
 			return fmt.Errorf("unable to unmarshal Table ACL data: %s", data)
 		}
+		isJSON = true
+	}
+	if err := tacl.Set(config); err != nil {
+		return err
 	}
-	return tacl.Set(config)
+	tacl.setConfigFile(configFile, isJSON)
+	return nil
+}
+
+func (tacl *tableACL) setConfigFile(configFile string, isJSON bool) {
+	tacl.Lock()
+	defer tacl.Unlock()
+	tacl.configFile = configFile
+	tacl.configJSON = isJSON
 }
 
 func (tacl *tableACL) SetCallback(callback func()) {
@@ -131,7 +158,11 @@ func (tacl *tableACL) SetCallback(callback func()) {
 
 // InitFromProto inits table ACLs from a proto.
 func InitFromProto(config *tableaclpb.Config) error {
-	return currentTableACL.Set(config)
+	if err := currentTableACL.Set(config); err != nil {
+		return err
+	}
+	currentTableACL.setConfigFile("", false)
+	return nil
 }
 
 // load loads configurations from a proto-defined Config
@@ -186,11 +217,20 @@ func (tacl *tableACL) Set(config *tableaclpb.Config) error {
 	if err != nil {
 		return err
 	}
+	var admins acl.ACL = acl.DenyAllACL{}
+	if len(config.Admins) > 0 {
+		if admins, err = factory.New(config.Admins); err != nil {
+			return err
+		}
+	}
 	tacl.Lock()
 	tacl.entries = entries
+	tacl.admins = admins
 	tacl.config = *config
+	version := tacl.recordVersion(config)
 	callback := tacl.callback
 	tacl.Unlock()
+	log.Infof("Table ACL config version %d applied", version)
 	if callback != nil {
 		callback()
 	}
@@ -269,6 +309,30 @@ func (tacl *tableACL) Authorized(table string, role Role) *ACLResult {
 	}
 }
 
+// IsAdmin returns true if the caller has the admin role, which is
+// exempt from all the table ACL checks.
+func IsAdmin(callerID *querypb.VTGateCallerID) bool {
+	return currentTableACL.IsAdmin(callerID)
+}
+
+func (tacl *tableACL) IsAdmin(callerID *querypb.VTGateCallerID) bool {
+	tacl.RLock()
+	defer tacl.RUnlock()
+	return tacl.admins != nil && tacl.admins.IsMember(callerID)
+}
+
+// DryRun returns true if table ACL violations must be logged instead
+// of enforced.
+func DryRun() bool {
+	return currentTableACL.DryRun()
+}
+
+func (tacl *tableACL) DryRun() bool {
+	tacl.RLock()
+	defer tacl.RUnlock()
+	return tacl.config.DryRun
+}
+
 // GetCurrentConfig returns a copy of current tableacl configuration.
 func GetCurrentConfig() *tableaclpb.Config {
 	return currentTableACL.Config()
@@ -21,7 +21,9 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -105,6 +107,210 @@ func TestInitFromProto(t *testing.T) {
 	}
 }
 
+var aclAdminsJSON = `{
+  "table_groups": [
+    {
+      "name": "group01",
+      "table_names_or_prefixes": ["test_table"],
+      "readers": ["vt"]
+    }
+  ],
+  "admins": ["dba"],
+  "dry_run": true
+}`
+
+func TestAdminsAndDryRun(t *testing.T) {
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	if tacl.IsAdmin(&querypb.VTGateCallerID{Username: "dba"}) {
+		t.Fatalf("tableacl has not been initialized, nobody should be an admin")
+	}
+	if tacl.DryRun() {
+		t.Fatalf("tableacl has not been initialized, dry run should be off")
+	}
+
+	f, err := ioutil.TempFile("", "tableacl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.WriteString(f, aclAdminsJSON); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tacl.init(f.Name(), func() {}); err != nil {
+		t.Fatal(err)
+	}
+	if !tacl.IsAdmin(&querypb.VTGateCallerID{Username: "dba"}) {
+		t.Fatalf("user: dba should be an admin")
+	}
+	if tacl.IsAdmin(&querypb.VTGateCallerID{Username: "vt"}) {
+		t.Fatalf("user: vt should not be an admin")
+	}
+	if !tacl.DryRun() {
+		t.Fatalf("dry run should be on")
+	}
+
+	if err := tacl.Set(&tableaclpb.Config{}); err != nil {
+		t.Fatal(err)
+	}
+	if tacl.IsAdmin(&querypb.VTGateCallerID{Username: "dba"}) || tacl.DryRun() {
+		t.Fatalf("a new config without admins nor dry run should reset them")
+	}
+}
+
+func TestHistoryAndRollback(t *testing.T) {
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	callbacks := 0
+	tacl.SetCallback(func() { callbacks++ })
+
+	v1 := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+	}
+	v2 := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u2"},
+		}, {
+			Name:                 "group02",
+			TableNamesOrPrefixes: []string{"other_table"},
+		}},
+		Admins: []string{"dba"},
+		DryRun: true,
+	}
+	if err := tacl.Set(v1); err != nil {
+		t.Fatal(err)
+	}
+	if err := tacl.Set(v2); err != nil {
+		t.Fatal(err)
+	}
+	// Changing the applied config must not change the history.
+	v2.DryRun = false
+
+	history := tacl.History()
+	if len(history) != 2 || history[0].Version != 1 || history[1].Version != 2 {
+		t.Fatalf("got history %v, want versions 1 and 2", history)
+	}
+	if !proto.Equal(history[0].Config, v1) || !history[1].Config.DryRun {
+		t.Fatalf("history does not hold the applied configs: %v", history)
+	}
+
+	want := []string{
+		`~ table group "group01" readers: [u1] -> [u2]`,
+		`+ table group "group02"`,
+		`~ admins: [] -> [dba]`,
+		`~ dry_run: false -> true`,
+	}
+	if got := DiffConfigs(history[0].Config, history[1].Config); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got diff %q, want %q", got, want)
+	}
+	if got := DiffConfigs(v1, v1); got != nil {
+		t.Fatalf("got diff %q for the same config, want none", got)
+	}
+
+	if err := tacl.Rollback(1); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(tacl.Config(), v1) {
+		t.Fatalf("got config %v after rollback, want %v", tacl.Config(), v1)
+	}
+	history = tacl.History()
+	if len(history) != 3 || history[2].Version != 3 {
+		t.Fatalf("a rollback should be recorded as version 3, got %v", history)
+	}
+	if callbacks != 3 {
+		t.Fatalf("got %d callbacks, want 3", callbacks)
+	}
+	if err := tacl.Rollback(10); err == nil || err.Error() != "table ACL config version 10 is not in the history" {
+		t.Fatalf("got error %v for an unknown version", err)
+	}
+
+	// Setting the latest config again is not recorded.
+	if err := tacl.Set(v1); err != nil {
+		t.Fatal(err)
+	}
+	if history = tacl.History(); len(history) != 3 {
+		t.Fatalf("an unchanged config should not be recorded, got %v", history)
+	}
+
+	for i := 0; i < maxConfigVersions; i++ {
+		config := v2
+		if i%2 == 1 {
+			config = v1
+		}
+		if err := tacl.Set(config); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history = tacl.History()
+	if len(history) != maxConfigVersions || history[0].Version != 4 {
+		t.Fatalf("got %d versions starting at %d, want %d starting at 4", len(history), history[0].Version, maxConfigVersions)
+	}
+}
+
+func TestRollbackWritesConfigFile(t *testing.T) {
+	v1 := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+	}
+	v2 := &tableaclpb.Config{Admins: []string{"dba"}}
+
+	for _, isJSON := range []bool{true, false} {
+		tacl := tableACL{factory: &simpleacl.Factory{}}
+		configFile := path.Join(t.TempDir(), "acl")
+		if err := writeConfigFile(configFile, v1, isJSON); err != nil {
+			t.Fatal(err)
+		}
+		if err := tacl.init(configFile, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := writeConfigFile(configFile, v2, isJSON); err != nil {
+			t.Fatal(err)
+		}
+		if err := tacl.init(configFile, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := tacl.Rollback(1); err != nil {
+			t.Fatal(err)
+		}
+		// The next reload of the file keeps the rolled back config.
+		if err := tacl.init(configFile, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(tacl.Config(), v1) {
+			t.Fatalf("got config %v after a reload, want %v", tacl.Config(), v1)
+		}
+		data, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.HasPrefix(string(data), "{"); got != isJSON {
+			t.Fatalf("the config file changed encoding: %q", data)
+		}
+
+		// The rollback is not applied if the file cannot be written.
+		if err := os.RemoveAll(path.Dir(configFile)); err != nil {
+			t.Fatal(err)
+		}
+		if err := tacl.Rollback(2); err == nil {
+			t.Fatalf("a rollback should fail if the config file cannot be written")
+		}
+		if !proto.Equal(tacl.Config(), v1) {
+			t.Fatalf("got config %v after a failed rollback, want %v", tacl.Config(), v1)
+		}
+	}
+}
+
 func TestTableACLValidateConfig(t *testing.T) {
 	tests := []struct {
 		names []string
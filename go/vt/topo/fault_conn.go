@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"flag"
+
+	"vitess.io/vitess/go/vt/faultinjection"
+)
+
+var _ Conn = (*faultConn)(nil)
+
+// faults fails the operations as if they timed out.
+var faults = faultinjection.New("Topo", func(message string) error {
+	return NewError(Timeout, message)
+})
+
+func init() {
+	flag.Var(faults, "topo_fault_injection", "for tests only: JSON list of rules that delay or fail the topo operations by path, like [{\"regexp\": \"^/keyspaces/\", \"percent\": 10, \"delay_ms\": 100, \"fail\": true}]")
+}
+
+// faultConn is a wrapper for a Conn that injects the faults of the
+// -topo_fault_injection rules in its operations.
+type faultConn struct {
+	Conn
+}
+
+func newFaultConn(conn Conn) Conn {
+	return &faultConn{Conn: conn}
+}
+
+// ListDir is part of the Conn interface
+func (fc *faultConn) ListDir(ctx context.Context, dirPath string, full bool) ([]DirEntry, error) {
+	if err := faults.Inject(ctx, dirPath); err != nil {
+		return nil, err
+	}
+	return fc.Conn.ListDir(ctx, dirPath, full)
+}
+
+// Create is part of the Conn interface
+func (fc *faultConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	if err := faults.Inject(ctx, filePath); err != nil {
+		return nil, err
+	}
+	return fc.Conn.Create(ctx, filePath, contents)
+}
+
+// Update is part of the Conn interface
+func (fc *faultConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	if err := faults.Inject(ctx, filePath); err != nil {
+		return nil, err
+	}
+	return fc.Conn.Update(ctx, filePath, contents, version)
+}
+
+// Get is part of the Conn interface
+func (fc *faultConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	if err := faults.Inject(ctx, filePath); err != nil {
+		return nil, nil, err
+	}
+	return fc.Conn.Get(ctx, filePath)
+}
+
+// Delete is part of the Conn interface
+func (fc *faultConn) Delete(ctx context.Context, filePath string, version Version) error {
+	if err := faults.Inject(ctx, filePath); err != nil {
+		return err
+	}
+	return fc.Conn.Delete(ctx, filePath, version)
+}
+
+// Lock is part of the Conn interface
+func (fc *faultConn) Lock(ctx context.Context, dirPath, contents string) (LockDescriptor, error) {
+	if err := faults.Inject(ctx, dirPath); err != nil {
+		return nil, err
+	}
+	return fc.Conn.Lock(ctx, dirPath, contents)
+}
+
+// Watch is part of the Conn interface
+func (fc *faultConn) Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc) {
+	if err := faults.Inject(ctx, filePath); err != nil {
+		return &WatchData{Err: err}, nil, nil
+	}
+	return fc.Conn.Watch(ctx, filePath)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultConn(t *testing.T) {
+	conn := newFaultConn(&fakeConn{})
+	ctx := context.Background()
+
+	require.NoError(t, faults.Set(`[{"regexp": "^/keyspaces/", "percent": 100, "fail": true}]`))
+	defer faults.Set("")
+	_, _, err := conn.Get(ctx, "/keyspaces/ks/Keyspace")
+	assert.True(t, IsErrType(err, Timeout), "%v", err)
+	_, err = conn.ListDir(ctx, "/keyspaces/", true)
+	assert.True(t, IsErrType(err, Timeout), "%v", err)
+	_, err = conn.Lock(ctx, "/keyspaces/ks", "")
+	assert.True(t, IsErrType(err, Timeout), "%v", err)
+	current, changes, cancel := conn.Watch(ctx, "/keyspaces/ks/Keyspace")
+	assert.True(t, IsErrType(current.Err, Timeout), "%v", current.Err)
+	assert.Nil(t, changes)
+	assert.Nil(t, cancel)
+
+	// Other paths are not affected.
+	_, _, err = conn.Get(ctx, "/cells/zone1/CellInfo")
+	assert.NoError(t, err)
+
+	require.NoError(t, faults.Set(""))
+	_, _, err = conn.Get(ctx, "/keyspaces/ks/Keyspace")
+	assert.NoError(t, err)
+}
@@ -198,3 +198,51 @@ func CompareRoutingRules(ctx context.Context, fromTS, toTS *topo.Server) error {
 	}
 	return nil
 }
+
+// CompareServingGraph will compare the SrvKeyspace and SrvVSchema objects
+// of all cells in the destination topo.
+func CompareServingGraph(ctx context.Context, fromTS, toTS *topo.Server) error {
+	cells, err := fromTS.GetCellInfoNames(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetCellInfoNames()")
+	}
+
+	for _, cell := range cells {
+		keyspaces, err := fromTS.GetSrvKeyspaceNames(ctx, cell)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetSrvKeyspaceNames(%v)", cell)
+		}
+		for _, keyspace := range keyspaces {
+			fromSK, err := fromTS.GetSrvKeyspace(ctx, cell, keyspace)
+			if err != nil {
+				if topo.IsErrType(err, topo.NoNode) {
+					continue
+				}
+				return vterrors.Wrapf(err, "GetSrvKeyspace(%v, %v)", cell, keyspace)
+			}
+			toSK, err := toTS.GetSrvKeyspace(ctx, cell, keyspace)
+			if err != nil {
+				return vterrors.Wrapf(err, "GetSrvKeyspace(%v, %v)", cell, keyspace)
+			}
+			if !proto.Equal(fromSK, toSK) {
+				return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "SrvKeyspace %v in cell %v: does not match between from and to topology", keyspace, cell)
+			}
+		}
+
+		fromSV, err := fromTS.GetSrvVSchema(ctx, cell)
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) {
+				continue
+			}
+			return vterrors.Wrapf(err, "GetSrvVSchema(%v)", cell)
+		}
+		toSV, err := toTS.GetSrvVSchema(ctx, cell)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetSrvVSchema(%v)", cell)
+		}
+		if !proto.Equal(fromSV, toSV) {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "SrvVSchema in cell %v: does not match between from and to topology", cell)
+		}
+	}
+	return nil
+}
@@ -89,4 +89,17 @@ func TestBasicCompare(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Compare routing rules failed: %v", err)
 	}
+
+	// check serving graph compare
+	err = CompareServingGraph(ctx, fromTS, toTS)
+	if err == nil {
+		t.Fatalf("Compare serving graph is not failing when topos are not in sync")
+	}
+
+	CopyServingGraph(ctx, fromTS, toTS)
+
+	err = CompareServingGraph(ctx, fromTS, toTS)
+	if err != nil {
+		t.Fatalf("Compare serving graph failed: %v", err)
+	}
 }
@@ -20,6 +20,7 @@ package helpers
 
 import (
 	"context"
+	"fmt"
 
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
@@ -187,3 +188,50 @@ func CopyRoutingRules(ctx context.Context, fromTS, toTS *topo.Server) {
 		log.Errorf("SaveRoutingRules(%v): %v", rr, err)
 	}
 }
+
+// CopyServingGraph will create the cell-local serving graph objects
+// (SrvKeyspace and SrvVSchema records) in the destination topo. It is
+// used when moving a cell's local records to a different topo server.
+// The records are overwritten, so it can be run again after a failure.
+func CopyServingGraph(ctx context.Context, fromTS, toTS *topo.Server) error {
+	cells, err := fromTS.GetCellInfoNames(ctx)
+	if err != nil {
+		return fmt.Errorf("GetCellInfoNames(): %v", err)
+	}
+
+	for _, cell := range cells {
+		keyspaces, err := fromTS.GetSrvKeyspaceNames(ctx, cell)
+		if err != nil {
+			return fmt.Errorf("GetSrvKeyspaceNames(%v): %v", cell, err)
+		}
+
+		for _, keyspace := range keyspaces {
+			srvKeyspace, err := fromTS.GetSrvKeyspace(ctx, cell, keyspace)
+			switch {
+			case err == nil:
+			case topo.IsErrType(err, topo.NoNode):
+				// The keyspace only has ShardReplication
+				// records in this cell.
+				continue
+			default:
+				return fmt.Errorf("GetSrvKeyspace(%v, %v): %v", cell, keyspace, err)
+			}
+			if err := toTS.UpdateSrvKeyspace(ctx, cell, keyspace, srvKeyspace); err != nil {
+				return fmt.Errorf("UpdateSrvKeyspace(%v, %v): %v", cell, keyspace, err)
+			}
+		}
+
+		srvVSchema, err := fromTS.GetSrvVSchema(ctx, cell)
+		switch {
+		case err == nil:
+			if err := toTS.UpdateSrvVSchema(ctx, cell, srvVSchema); err != nil {
+				return fmt.Errorf("UpdateSrvVSchema(%v): %v", cell, err)
+			}
+		case topo.IsErrType(err, topo.NoNode):
+			// Nothing to do.
+		default:
+			return fmt.Errorf("GetSrvVSchema(%v): %v", cell, err)
+		}
+	}
+	return nil
+}
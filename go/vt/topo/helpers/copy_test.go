@@ -19,6 +19,7 @@ package helpers
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
 	"context"
@@ -97,6 +98,24 @@ func createSetup(ctx context.Context, t *testing.T) (*topo.Server, *topo.Server)
 		t.Fatalf("cannot save routing rules: %v", err)
 	}
 
+	srvKeyspace := &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:      topodatapb.TabletType_MASTER,
+			ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+		}},
+	}
+	if err := fromTS.UpdateSrvKeyspace(ctx, "test_cell", "test_keyspace", srvKeyspace); err != nil {
+		t.Fatalf("cannot save SrvKeyspace: %v", err)
+	}
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"test_keyspace": {},
+		},
+	}
+	if err := fromTS.UpdateSrvVSchema(ctx, "test_cell", srvVSchema); err != nil {
+		t.Fatalf("cannot save SrvVSchema: %v", err)
+	}
+
 	return fromTS, toTS
 }
 
@@ -150,4 +169,42 @@ func TestBasic(t *testing.T) {
 		t.Fatalf("unexpected tablets: %v", tablets)
 	}
 	CopyTablets(ctx, fromTS, toTS)
+
+	// check serving graph copy
+	if err := CopyServingGraph(ctx, fromTS, toTS); err != nil {
+		t.Fatalf("CopyServingGraph failed: %v", err)
+	}
+	sk, err := toTS.GetSrvKeyspace(ctx, "test_cell", "test_keyspace")
+	if err != nil {
+		t.Fatalf("toTS.GetSrvKeyspace failed: %v", err)
+	}
+	if len(sk.Partitions) != 1 || sk.Partitions[0].ShardReferences[0].Name != "0" {
+		t.Fatalf("unexpected SrvKeyspace: %v", sk)
+	}
+	sv, err := toTS.GetSrvVSchema(ctx, "test_cell")
+	if err != nil {
+		t.Fatalf("toTS.GetSrvVSchema failed: %v", err)
+	}
+	if _, ok := sv.Keyspaces["test_keyspace"]; !ok {
+		t.Fatalf("unexpected SrvVSchema: %v", sv)
+	}
+
+	// copying the serving graph again leaves the same records
+	if err := CopyServingGraph(ctx, fromTS, toTS); err != nil {
+		t.Fatalf("second CopyServingGraph failed: %v", err)
+	}
+	sk2, err := toTS.GetSrvKeyspace(ctx, "test_cell", "test_keyspace")
+	if err != nil {
+		t.Fatalf("toTS.GetSrvKeyspace failed: %v", err)
+	}
+	if !proto.Equal(sk, sk2) {
+		t.Fatalf("SrvKeyspace changed by the second copy: %v, was %v", sk2, sk)
+	}
+	sv2, err := toTS.GetSrvVSchema(ctx, "test_cell")
+	if err != nil {
+		t.Fatalf("toTS.GetSrvVSchema failed: %v", err)
+	}
+	if !proto.Equal(sv, sv2) {
+		t.Fatalf("SrvVSchema changed by the second copy: %v, was %v", sv2, sv)
+	}
 }
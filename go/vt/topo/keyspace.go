@@ -293,6 +293,9 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 	if err := ts.DeleteVSchema(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
 		return err
 	}
+	if err := ts.SaveTabletConfig(ctx, keyspace, "", nil); err != nil {
+		return err
+	}
 
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
@@ -367,6 +367,9 @@ func (ts *Server) DeleteShard(ctx context.Context, keyspace, shard string) error
 	if err := ts.globalCell.Delete(ctx, shardPath, nil); err != nil {
 		return err
 	}
+	if err := ts.SaveTabletConfig(ctx, keyspace, shard, nil); err != nil {
+		return err
+	}
 	event.Dispatch(&events.ShardChange{
 		KeyspaceName: keyspace,
 		ShardName:    shard,
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// The tablet config is a set of name/value pairs that overrides the
+// runtime settings of the tablets, e.g. their pool sizes. It can be
+// set for a whole keyspace, and for a shard, which takes precedence.
+// It is stored as json in the global topo.
+
+func tabletConfigFilePath(keyspace, shard string) string {
+	if shard == "" {
+		return path.Join(KeyspacesPath, keyspace, TabletConfigFile)
+	}
+	return path.Join(KeyspacesPath, keyspace, ShardsPath, shard, TabletConfigFile)
+}
+
+// GetTabletConfig returns the tablet config of a keyspace, or of a
+// shard if shard is not empty. It returns an empty config if none
+// was saved.
+func (ts *Server) GetTabletConfig(ctx context.Context, keyspace, shard string) (map[string]string, error) {
+	config := make(map[string]string)
+	data, _, err := ts.globalCell.Get(ctx, tabletConfigFilePath(keyspace, shard))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return config, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, vterrors.Wrapf(err, "bad tablet config data: %q", data)
+	}
+	return config, nil
+}
+
+// SaveTabletConfig saves the tablet config of a keyspace, or of a
+// shard if shard is not empty. An empty config is removed.
+func (ts *Server) SaveTabletConfig(ctx context.Context, keyspace, shard string, config map[string]string) error {
+	nodePath := tabletConfigFilePath(keyspace, shard)
+	if len(config) == 0 {
+		if err := ts.globalCell.Delete(ctx, nodePath, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, nodePath, data, nil)
+	return err
+}
+
+// GetEffectiveTabletConfig returns the tablet config that applies to
+// the tablets of a shard: the config of its keyspace, overridden by
+// its own config.
+func (ts *Server) GetEffectiveTabletConfig(ctx context.Context, keyspace, shard string) (map[string]string, error) {
+	config, err := ts.GetTabletConfig(ctx, keyspace, "")
+	if err != nil {
+		return nil, err
+	}
+	shardConfig, err := ts.GetTabletConfig(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range shardConfig {
+		config[name] = value
+	}
+	return config, nil
+}
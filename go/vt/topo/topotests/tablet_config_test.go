@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestTabletConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "-80"))
+
+	config, err := ts.GetEffectiveTabletConfig(ctx, "ks", "-80")
+	require.NoError(t, err)
+	assert.Empty(t, config)
+
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "", map[string]string{"PoolSize": "10", "Consolidator": "disable"}))
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "-80", map[string]string{"PoolSize": "20"}))
+
+	config, err = ts.GetTabletConfig(ctx, "ks", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"PoolSize": "10", "Consolidator": "disable"}, config)
+	config, err = ts.GetEffectiveTabletConfig(ctx, "ks", "-80")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"PoolSize": "20", "Consolidator": "disable"}, config)
+	config, err = ts.GetEffectiveTabletConfig(ctx, "ks", "80-")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"PoolSize": "10", "Consolidator": "disable"}, config)
+
+	// Saving an empty config removes it.
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "", nil))
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "", nil))
+	config, err = ts.GetEffectiveTabletConfig(ctx, "ks", "-80")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"PoolSize": "20"}, config)
+
+	require.NoError(t, ts.DeleteShard(ctx, "ks", "-80"))
+	config, err = ts.GetTabletConfig(ctx, "ks", "-80")
+	require.NoError(t, err)
+	assert.Empty(t, config)
+}
@@ -525,6 +525,12 @@ func (itc *internalTabletConn) VStreamResults(ctx context.Context, target *query
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// ExportTable is part of the QueryService interface.
+func (itc *internalTabletConn) ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	err := itc.tablet.qsc.QueryService().ExportTable(ctx, target, table, lastpk, endpk, send)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 //
 // TabletManagerClient implementation
 //
@@ -557,6 +563,14 @@ func (itmc *internalTabletManagerClient) GetSchema(ctx context.Context, tablet *
 	return t.tm.GetSchema(ctx, tables, excludeTables, includeViews)
 }
 
+func (itmc *internalTabletManagerClient) GetSchemaForPos(ctx context.Context, tablet *topodatapb.Tablet, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetSchemaForPos(ctx, position, tables, excludeTables)
+}
+
 func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
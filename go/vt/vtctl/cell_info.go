@@ -65,6 +65,18 @@ func init() {
 		commandGetCellInfo,
 		"<cell>",
 		"Prints a JSON representation of the CellInfo for a cell."})
+
+	addCommand(cellsGroupName, command{
+		"DrainCell",
+		commandDrainCell,
+		"[-percent <percent>] <cell>",
+		"Drains a cell before a maintenance: the vtgates send the given percentage of the reads on the replicas of the cell to the other cells, if they watch them. The tablets of the cell report the drain in their health. Running it with increasing percentages shifts the traffic gradually."})
+
+	addCommand(cellsGroupName, command{
+		"UndrainCell",
+		commandUndrainCell,
+		"<cell>",
+		"Stops draining a cell: the vtgates send the reads to its replicas again."})
 }
 
 func commandAddCellInfo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -113,6 +125,44 @@ func commandUpdateCellInfo(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	})
 }
 
+func commandDrainCell(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	percent := subFlags.Int("percent", 100, "The percentage of the reads on the replicas of the cell to send to the other cells.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <cell> argument is required for the DrainCell command")
+	}
+	if *percent < 0 || *percent > 100 {
+		return fmt.Errorf("the percent must be between 0 and 100: %d", *percent)
+	}
+	return setCellDrain(ctx, wr, subFlags.Arg(0), int32(*percent))
+}
+
+func commandUndrainCell(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <cell> argument is required for the UndrainCell command")
+	}
+	return setCellDrain(ctx, wr, subFlags.Arg(0), 0)
+}
+
+func setCellDrain(ctx context.Context, wr *wrangler.Wrangler, cell string, percent int32) error {
+	// The CellInfo must exist, UpdateCellInfoFields would create it.
+	if _, err := wr.TopoServer().GetCellInfo(ctx, cell, true /*strongRead*/); err != nil {
+		return err
+	}
+	return wr.TopoServer().UpdateCellInfoFields(ctx, cell, func(ci *topodatapb.CellInfo) error {
+		if ci.DrainPercent == percent {
+			return topo.NewError(topo.NoUpdateNeeded, cell)
+		}
+		ci.DrainPercent = percent
+		return nil
+	})
+}
+
 func commandDeleteCellInfo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	force := subFlags.Bool("force", false, "Proceeds even if the cell's topology server cannot be reached. The assumption is that you turned down the entire cell, and just need to update the global topo data.")
 	if err := subFlags.Parse(args); err != nil {
@@ -115,6 +115,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -302,6 +303,12 @@ var commands = []commandGroup{
 			{"ValidateKeyspace", commandValidateKeyspace,
 				"[-ping-tablets] <keyspace name>",
 				"Validates that all nodes reachable from the specified keyspace are consistent."},
+			{"SetTabletConfig", commandSetTabletConfig,
+				"[-clear] <keyspace|keyspace/shard> [<name>=<value> ...]",
+				"Sets runtime settings of the tablets of a keyspace or shard, e.g. PoolSize=20 or QueryTimeout=10s. The tablets apply them without a restart. A shard setting overrides the keyspace one. An empty value removes a setting, and -clear removes all of them first."},
+			{"GetTabletConfig", commandGetTabletConfig,
+				"[-effective] <keyspace|keyspace/shard>",
+				"Displays the runtime settings saved for the tablets of a keyspace or shard. With -effective, the keyspace settings are merged into the shard ones."},
 			{"Reshard", commandReshard,
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='master,replica,rdonly'  ks.workflow001 '0' '-80,80-'"},
@@ -1873,6 +1880,71 @@ func commandValidateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlag
 	return wr.ValidateKeyspace(ctx, keyspace, *pingTablets)
 }
 
+// parseTabletConfigTarget parses a <keyspace> or <keyspace/shard> argument.
+func parseTabletConfigTarget(arg string) (keyspace, shard string, err error) {
+	if !strings.Contains(arg, "/") {
+		return arg, "", nil
+	}
+	return topoproto.ParseKeyspaceShard(arg)
+}
+
+func commandSetTabletConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	clearConfig := subFlags.Bool("clear", false, "Removes all the existing settings before setting the new ones")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 1 {
+		return fmt.Errorf("the <keyspace|keyspace/shard> argument is required for the SetTabletConfig command")
+	}
+	keyspace, shard, err := parseTabletConfigTarget(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	config := make(map[string]string)
+	if !*clearConfig {
+		if config, err = wr.TopoServer().GetTabletConfig(ctx, keyspace, shard); err != nil {
+			return err
+		}
+	}
+	for _, arg := range subFlags.Args()[1:] {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid setting %q, expected <name>=<value>", arg)
+		}
+		if parts[1] == "" {
+			delete(config, parts[0])
+			continue
+		}
+		config[parts[0]] = parts[1]
+	}
+	return wr.TopoServer().SaveTabletConfig(ctx, keyspace, shard, config)
+}
+
+func commandGetTabletConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	effective := subFlags.Bool("effective", false, "Merges the keyspace settings into the shard ones")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace|keyspace/shard> argument is required for the GetTabletConfig command")
+	}
+	keyspace, shard, err := parseTabletConfigTarget(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	var config map[string]string
+	if *effective && shard != "" {
+		config, err = wr.TopoServer().GetEffectiveTabletConfig(ctx, keyspace, shard)
+	} else {
+		config, err = wr.TopoServer().GetTabletConfig(ctx, keyspace, shard)
+	}
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), config)
+}
+
 func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	for _, arg := range args {
 		if arg == "-v2" {
@@ -3194,6 +3266,7 @@ func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFla
 	routingRules := subFlags.String("rules", "", "Specify rules as a string")
 	routingRulesFile := subFlags.String("rules_file", "", "Specify rules in a file")
 	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered routing rules, simply echo to console.")
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
 
@@ -3227,6 +3300,23 @@ func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFla
 		wr.Logger().Printf("New RoutingRules object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b)
 	}
 
+	// Make sure the rules resolve against the current VSchema before
+	// saving them. Otherwise, vtgate would fail every query that uses
+	// one of the broken rules.
+	srvVSchema, err := wr.TopoServer().BuildSrvVSchema(ctx)
+	if err != nil {
+		return err
+	}
+	srvVSchema.RoutingRules = rr
+	if err := vindexes.ValidateRoutingRules(srvVSchema); err != nil {
+		return err
+	}
+
+	if *dryRun {
+		wr.Logger().Printf("Dry run: Skipping update of RoutingRules\n")
+		return nil
+	}
+
 	if err := wr.TopoServer().SaveRoutingRules(ctx, rr); err != nil {
 		return err
 	}
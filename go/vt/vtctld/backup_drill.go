@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// A backup drill restores the latest backup of a shard on a scratch
+// tablet, and compares the checksums of its tables with the ones of a
+// live replica at the same position, so that the backups that cannot be
+// restored are found before they are needed.
+
+var (
+	backupDrillInterval       = flag.Duration("backup_drill_interval", 0, "interval between the backup drills, which restore the latest backup of the shards of the -backup_drill_scratch_tablets on them and check it against a live replica. 0 disables the drills.")
+	backupDrillScratchTablets = flag.String("backup_drill_scratch_tablets", "", "comma separated list of the aliases of the tablets the backups of their shards are restored on by the backup drills. Their data is replaced at every drill, so they must be SPARE or DRAINED.")
+	backupDrillTimeout        = flag.Duration("backup_drill_timeout", time.Hour, "timeout of a backup drill, including the restore")
+	backupDrillWaitTimeout    = flag.Duration("backup_drill_wait_timeout", 10*time.Minute, "timeout for the scratch tablet to catch up with the replica before the checksums")
+)
+
+var (
+	backupDrills = stats.NewCountersWithMultiLabels(
+		"BackupDrills",
+		"Backup drills by shard and result",
+		[]string{"Keyspace", "Shard", "Result"})
+	backupDrillLastSuccess = stats.NewGaugesWithMultiLabels(
+		"BackupDrillLastSuccessTimestamp",
+		"Unix time of the end of the last successful backup drill of a shard",
+		[]string{"Keyspace", "Shard"})
+)
+
+const (
+	// BackupDrillPassed is the result of a drill that restored the
+	// backup with the same data as the replica.
+	BackupDrillPassed = "passed"
+	// BackupDrillFailed is the result of a drill that could not restore
+	// the backup, or restored different data.
+	BackupDrillFailed = "failed"
+)
+
+// BackupDrill is the record of the last backup drill of a shard, stored
+// in the global topo under BackupDrillPath.
+type BackupDrill struct {
+	Keyspace      string `json:"keyspace"`
+	Shard         string `json:"shard"`
+	ScratchTablet string `json:"scratch_tablet"`
+	Replica       string `json:"replica,omitempty"`
+	// Position is the position the checksums were computed at.
+	Position  string    `json:"position,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+	// MismatchedTables are the tables with different checksums.
+	MismatchedTables []string `json:"mismatched_tables,omitempty"`
+}
+
+// BackupDrillPath returns the path of the record of the last backup
+// drill of a shard in the global topo.
+func BackupDrillPath(keyspace, shard string) string {
+	return path.Join("backup_drills", keyspace, shard)
+}
+
+// ReadBackupDrill reads the record of the last backup drill of a shard.
+func ReadBackupDrill(ctx context.Context, ts *topo.Server, keyspace, shard string) (*BackupDrill, error) {
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := conn.Get(ctx, BackupDrillPath(keyspace, shard))
+	if err != nil {
+		return nil, err
+	}
+	drill := &BackupDrill{}
+	if err := json.Unmarshal(data, drill); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal backup drill of %s/%s: %v", keyspace, shard, err)
+	}
+	return drill, nil
+}
+
+func initBackupDrills(ts *topo.Server) {
+	if *backupDrillInterval == 0 || *backupDrillScratchTablets == "" {
+		return
+	}
+	var aliases []*topodatapb.TabletAlias
+	for _, s := range strings.Split(*backupDrillScratchTablets, ",") {
+		alias, err := topoproto.ParseTabletAlias(strings.TrimSpace(s))
+		if err != nil {
+			log.Exitf("invalid -backup_drill_scratch_tablets: %v", err)
+		}
+		aliases = append(aliases, alias)
+	}
+
+	tmClient := tmclient.NewTabletManagerClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks := timer.NewTimer(*backupDrillInterval)
+	ticks.Start(func() {
+		for _, alias := range aliases {
+			runBackupDrill(ctx, ts, tmClient, alias)
+		}
+	})
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+// runBackupDrill runs a backup drill on a scratch tablet, and records
+// its result in the stats and the global topo.
+func runBackupDrill(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient, scratchAlias *topodatapb.TabletAlias) {
+	ctx, cancel := context.WithTimeout(ctx, *backupDrillTimeout)
+	defer cancel()
+
+	scratch, err := ts.GetTablet(ctx, scratchAlias)
+	if err != nil {
+		log.Errorf("Backup drill: cannot read scratch tablet %v: %v", topoproto.TabletAliasString(scratchAlias), err)
+		return
+	}
+	drill := &BackupDrill{
+		Keyspace:      scratch.Keyspace,
+		Shard:         scratch.Shard,
+		ScratchTablet: topoproto.TabletAliasString(scratchAlias),
+		StartTime:     time.Now(),
+	}
+	log.Infof("Backup drill of %s/%s on %s", drill.Keyspace, drill.Shard, drill.ScratchTablet)
+	err = drillBackup(ctx, ts, tmClient, scratch.Tablet, drill)
+	drill.EndTime = time.Now()
+	switch {
+	case err != nil:
+		drill.Result = BackupDrillFailed
+		drill.Error = err.Error()
+	case len(drill.MismatchedTables) > 0:
+		drill.Result = BackupDrillFailed
+		drill.Error = fmt.Sprintf("the checksums of tables %s differ from the ones of %s", strings.Join(drill.MismatchedTables, ", "), drill.Replica)
+	default:
+		drill.Result = BackupDrillPassed
+		backupDrillLastSuccess.Set([]string{drill.Keyspace, drill.Shard}, drill.EndTime.Unix())
+	}
+	backupDrills.Add([]string{drill.Keyspace, drill.Shard, drill.Result}, 1)
+	if drill.Result == BackupDrillFailed {
+		log.Errorf("Backup drill of %s/%s on %s failed: %s", drill.Keyspace, drill.Shard, drill.ScratchTablet, drill.Error)
+	}
+
+	if err := writeBackupDrill(ctx, ts, drill); err != nil {
+		log.Errorf("Backup drill: cannot record the drill of %s/%s: %v", drill.Keyspace, drill.Shard, err)
+	}
+}
+
+func writeBackupDrill(ctx context.Context, ts *topo.Server, drill *BackupDrill) error {
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(drill, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = conn.Update(ctx, BackupDrillPath(drill.Keyspace, drill.Shard), data, nil)
+	return err
+}
+
+// drillBackup restores the latest backup on the scratch tablet, stops it
+// and a replica of the shard at the same position, and compares the
+// checksums of their tables. The scratch tablet is left stopped at that
+// position, for investigation.
+//
+// The shard is locked during the drill, so that no reparent or other
+// shard operation runs while its replica is drained and stopped. The
+// scratch tablet must be SPARE or DRAINED, so that a serving tablet is
+// never overwritten by a misconfigured drill.
+func drillBackup(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient, scratch *topodatapb.Tablet, drill *BackupDrill) (err error) {
+	ctx, unlock, lockErr := ts.LockShard(ctx, scratch.Keyspace, scratch.Shard, fmt.Sprintf("BackupDrill(%v)", drill.ScratchTablet))
+	if lockErr != nil {
+		return fmt.Errorf("cannot lock the shard: %v", lockErr)
+	}
+	defer unlock(&err)
+
+	// The type is read again under the lock.
+	ti, err := ts.GetTablet(ctx, scratch.Alias)
+	if err != nil {
+		return fmt.Errorf("cannot read the scratch tablet: %v", err)
+	}
+	scratch = ti.Tablet
+	if scratch.Type != topodatapb.TabletType_SPARE && scratch.Type != topodatapb.TabletType_DRAINED {
+		return fmt.Errorf("the scratch tablet %v is %v, it must be SPARE or DRAINED", drill.ScratchTablet, scratch.Type)
+	}
+
+	replica, err := backupDrillReplica(ctx, ts, scratch)
+	if err != nil {
+		return err
+	}
+	drill.Replica = topoproto.TabletAliasString(replica.Alias)
+
+	stream, err := tmClient.RestoreFromBackup(ctx, scratch)
+	if err != nil {
+		return fmt.Errorf("cannot restore the backup: %v", err)
+	}
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("cannot restore the backup: %v", err)
+		}
+	}
+
+	// The scratch tablet replicates from the master after the restore.
+	// It is stopped, then the replica is drained and stopped at or after
+	// its position, then the scratch tablet catches up with the replica.
+	// The replica is restored even if ctx is done.
+	if err := tmClient.ChangeType(ctx, replica, topodatapb.TabletType_DRAINED); err != nil {
+		return fmt.Errorf("cannot drain %v: %v", drill.Replica, err)
+	}
+	defer func() {
+		restoreCtx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+		if err := tmClient.StartReplication(restoreCtx, replica); err != nil {
+			log.Errorf("Backup drill: cannot restart the replication of %v: %v", drill.Replica, err)
+		}
+		if err := tmClient.ChangeType(restoreCtx, replica, replica.Type); err != nil {
+			log.Errorf("Backup drill: cannot change %v back to %v: %v", drill.Replica, replica.Type, err)
+		}
+	}()
+	if err := tmClient.StopReplication(ctx, scratch); err != nil {
+		return fmt.Errorf("cannot stop the replication of the scratch tablet: %v", err)
+	}
+	scratchPos, err := tmClient.MasterPosition(ctx, scratch)
+	if err != nil {
+		return fmt.Errorf("cannot read the position of the scratch tablet: %v", err)
+	}
+	replicaPos, err := tmClient.StopReplicationMinimum(ctx, replica, scratchPos, *backupDrillWaitTimeout)
+	if err != nil {
+		return fmt.Errorf("cannot stop the replication of %v at %v: %v", drill.Replica, scratchPos, err)
+	}
+	if err := tmClient.StartReplicationUntilAfter(ctx, scratch, replicaPos, *backupDrillWaitTimeout); err != nil {
+		return fmt.Errorf("cannot restart the replication of the scratch tablet until %v: %v", replicaPos, err)
+	}
+	if _, err := tmClient.StopReplicationMinimum(ctx, scratch, replicaPos, *backupDrillWaitTimeout); err != nil {
+		return fmt.Errorf("the scratch tablet did not catch up with %v at %v: %v", drill.Replica, replicaPos, err)
+	}
+	drill.Position = replicaPos
+
+	sd, err := tmClient.GetSchema(ctx, replica, nil, nil, false)
+	if err != nil {
+		return fmt.Errorf("cannot read the schema of %v: %v", drill.Replica, err)
+	}
+	for _, td := range sd.TableDefinitions {
+		scratchSum, err := tableChecksum(ctx, tmClient, scratch, td.Name)
+		if err != nil {
+			return err
+		}
+		replicaSum, err := tableChecksum(ctx, tmClient, replica, td.Name)
+		if err != nil {
+			return err
+		}
+		if scratchSum != replicaSum {
+			drill.MismatchedTables = append(drill.MismatchedTables, td.Name)
+		}
+	}
+	return nil
+}
+
+// backupDrillReplica returns the tablet of the shard the scratch tablet
+// is compared with: a rdonly tablet if there is one, else a replica.
+func backupDrillReplica(ctx context.Context, ts *topo.Server, scratch *topodatapb.Tablet) (*topodatapb.Tablet, error) {
+	tablets, err := ts.GetTabletMapForShard(ctx, scratch.Keyspace, scratch.Shard)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*topodatapb.Tablet
+	for _, ti := range tablets {
+		if topoproto.TabletAliasEqual(ti.Alias, scratch.Alias) {
+			continue
+		}
+		if ti.Type == topodatapb.TabletType_RDONLY || ti.Type == topodatapb.TabletType_REPLICA {
+			candidates = append(candidates, ti.Tablet)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no replica in %s/%s to compare the backup with", scratch.Keyspace, scratch.Shard)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Type != candidates[j].Type {
+			return candidates[i].Type == topodatapb.TabletType_RDONLY
+		}
+		return topoproto.TabletAliasString(candidates[i].Alias) < topoproto.TabletAliasString(candidates[j].Alias)
+	})
+	return candidates[0], nil
+}
+
+func tableChecksum(ctx context.Context, tmClient tmclient.TabletManagerClient, tablet *topodatapb.Tablet, table string) (string, error) {
+	query := fmt.Sprintf("checksum table %s.%s", sqlparser.String(sqlparser.NewTableIdent(topoproto.TabletDbName(tablet))), sqlparser.String(sqlparser.NewTableIdent(table)))
+	qrproto, err := tmClient.ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 1, false, false)
+	if err != nil {
+		return "", fmt.Errorf("cannot checksum table %s on %v: %v", table, topoproto.TabletAliasString(tablet.Alias), err)
+	}
+	qr := sqltypes.Proto3ToResult(qrproto)
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return "", fmt.Errorf("unexpected checksum of table %s on %v: %v", table, topoproto.TabletAliasString(tablet.Alias), qr.Rows)
+	}
+	return qr.Rows[0][1].ToString(), nil
+}
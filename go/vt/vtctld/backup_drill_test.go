@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type eofStream struct{}
+
+func (eofStream) Recv() (*logutilpb.Event, error) {
+	return nil, io.EOF
+}
+
+// drillTMClient records the actions of a backup drill, and returns the
+// checksums of the tables by tablet alias.
+type drillTMClient struct {
+	tmclient.TabletManagerClient
+	actions   []string
+	checksums map[string]string
+	// cancel, if set, is called when the schema is read.
+	cancel func()
+}
+
+func (c *drillTMClient) record(action string, tablet *topodatapb.Tablet) {
+	c.actions = append(c.actions, fmt.Sprintf("%s %s", action, topoproto.TabletAliasString(tablet.Alias)))
+}
+
+func (c *drillTMClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+	c.record("RestoreFromBackup", tablet)
+	return eofStream{}, nil
+}
+
+func (c *drillTMClient) StopReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.record("StopReplication", tablet)
+	return nil
+}
+
+func (c *drillTMClient) StartReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	c.record("StartReplication", tablet)
+	return nil
+}
+
+func (c *drillTMClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	c.record("ChangeType "+dbType.String(), tablet)
+	return nil
+}
+
+func (c *drillTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.record("MasterPosition", tablet)
+	return "pos1", nil
+}
+
+func (c *drillTMClient) StopReplicationMinimum(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (string, error) {
+	c.record("StopReplicationMinimum "+stopPos, tablet)
+	return "pos2", nil
+}
+
+func (c *drillTMClient) StartReplicationUntilAfter(ctx context.Context, tablet *topodatapb.Tablet, position string, waitTime time.Duration) error {
+	c.record("StartReplicationUntilAfter "+position, tablet)
+	return nil
+}
+
+func (c *drillTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if err := topo.CheckShardLocked(ctx, tablet.Keyspace, tablet.Shard); err != nil {
+		return nil, err
+	}
+	if c.cancel != nil {
+		c.cancel()
+		return nil, ctx.Err()
+	}
+	return &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1"}, {Name: "t2"}},
+	}, nil
+}
+
+func (c *drillTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	c.actions = append(c.actions, fmt.Sprintf("%s %s", query, alias))
+	qr := sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Checksum", "varchar|int64"), fmt.Sprintf("t|%s", c.checksums[alias]))
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func TestBackupDrill(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	for _, tablet := range []*topodatapb.Tablet{
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}, Type: topodatapb.TabletType_MASTER},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}, Type: topodatapb.TabletType_REPLICA},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 102}, Type: topodatapb.TabletType_RDONLY},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 103}, Type: topodatapb.TabletType_DRAINED},
+	} {
+		tablet.Keyspace = "ks"
+		tablet.Shard = "0"
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+	}
+	scratch := &topodatapb.TabletAlias{Cell: "cell1", Uid: 103}
+
+	tmc := &drillTMClient{checksums: map[string]string{"cell1-0000000102": "1234", "cell1-0000000103": "1234"}}
+	runBackupDrill(ctx, ts, tmc, scratch)
+	assert.Equal(t, []string{
+		"RestoreFromBackup cell1-0000000103",
+		"ChangeType DRAINED cell1-0000000102",
+		"StopReplication cell1-0000000103",
+		"MasterPosition cell1-0000000103",
+		"StopReplicationMinimum pos1 cell1-0000000102",
+		"StartReplicationUntilAfter pos2 cell1-0000000103",
+		"StopReplicationMinimum pos2 cell1-0000000103",
+		"checksum table vt_ks.t1 cell1-0000000103",
+		"checksum table vt_ks.t1 cell1-0000000102",
+		"checksum table vt_ks.t2 cell1-0000000103",
+		"checksum table vt_ks.t2 cell1-0000000102",
+		"StartReplication cell1-0000000102",
+		"ChangeType RDONLY cell1-0000000102",
+	}, tmc.actions)
+	drill, err := ReadBackupDrill(ctx, ts, "ks", "0")
+	require.NoError(t, err)
+	assert.Equal(t, BackupDrillPassed, drill.Result)
+	assert.Equal(t, "cell1-0000000102", drill.Replica)
+	assert.Equal(t, "pos2", drill.Position)
+	assert.Equal(t, "", drill.Error)
+	assert.EqualValues(t, 1, backupDrills.Counts()["ks.0.passed"])
+	assert.Equal(t, drill.EndTime.Unix(), backupDrillLastSuccess.Counts()["ks.0"])
+
+	// The tables differ.
+	tmc = &drillTMClient{checksums: map[string]string{"cell1-0000000102": "1234", "cell1-0000000103": "5678"}}
+	runBackupDrill(ctx, ts, tmc, scratch)
+	drill, err = ReadBackupDrill(ctx, ts, "ks", "0")
+	require.NoError(t, err)
+	assert.Equal(t, BackupDrillFailed, drill.Result)
+	assert.Equal(t, []string{"t1", "t2"}, drill.MismatchedTables)
+	assert.Equal(t, "the checksums of tables t1, t2 differ from the ones of cell1-0000000102", drill.Error)
+	assert.EqualValues(t, 1, backupDrills.Counts()["ks.0.failed"])
+
+	// The replica is restored even if the drill is canceled.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	tmc = &drillTMClient{cancel: cancel}
+	runBackupDrill(cancelCtx, ts, tmc, scratch)
+	assert.Equal(t, []string{
+		"StartReplication cell1-0000000102",
+		"ChangeType RDONLY cell1-0000000102",
+	}, tmc.actions[len(tmc.actions)-2:])
+
+	// A serving tablet is never used as a scratch tablet.
+	tmc = &drillTMClient{}
+	runBackupDrill(ctx, ts, tmc, &topodatapb.TabletAlias{Cell: "cell1", Uid: 101})
+	assert.Empty(t, tmc.actions)
+	drill, err = ReadBackupDrill(ctx, ts, "ks", "0")
+	require.NoError(t, err)
+	assert.Equal(t, BackupDrillFailed, drill.Result)
+	assert.Equal(t, "the scratch tablet cell1-0000000101 is REPLICA, it must be SPARE or DRAINED", drill.Error)
+}
@@ -183,6 +183,8 @@ func InitVtctld(ts *topo.Server) {
 	// Init online DDL schema manager
 	initSchemaManager(ts)
 
+	initBackupDrills(ts)
+
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 }
@@ -270,6 +270,33 @@ func TestCode(t *testing.T) {
 	}
 }
 
+func TestErrState(t *testing.T) {
+	testcases := []struct {
+		in   error
+		want State
+	}{{
+		in:   nil,
+		want: Undefined,
+	}, {
+		in:   errors.New("generic"),
+		want: Undefined,
+	}, {
+		in:   New(vtrpcpb.Code_ABORTED, "generic"),
+		want: Undefined,
+	}, {
+		in:   NewErrorf(vtrpcpb.Code_ABORTED, LockDeadlock, "deadlock"),
+		want: LockDeadlock,
+	}, {
+		in:   Wrapf(NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, SyntaxError, "syntax error"), "parse"),
+		want: SyntaxError,
+	}}
+	for _, tcase := range testcases {
+		if got := ErrState(tcase.in); got != tcase.want {
+			t.Errorf("ErrState(%v): %v, want %v", tcase.in, got, tcase.want)
+		}
+	}
+}
+
 func TestWrapping(t *testing.T) {
 	err1 := Errorf(vtrpcpb.Code_UNAVAILABLE, "foo")
 	err2 := Wrapf(err1, "bar")
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vterrors
+
+import (
+	"fmt"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// State is the MySQL condition of an error created by Vitess. The vtgates
+// send it to the MySQL clients as the matching MySQL error number and
+// SQLSTATE, see mysql.NewSQLErrorFromError, so that their drivers can
+// react to it like they would with MySQL, e.g. by retrying deadlocks.
+type State int
+
+// All the error states, grouped by the error code they usually go with.
+const (
+	Undefined State = iota
+
+	// not found
+	NoSuchThread
+
+	// invalid argument
+	SyntaxError
+	EmptyQuery
+	BadFieldError
+	NoSuchTable
+	UnknownSystemVariable
+	WrongValueForVar
+
+	// aborted
+	LockDeadlock
+
+	// deadline exceeded
+	LockWaitTimeout
+	QueryInterrupted
+
+	// resource exhausted
+	TooManyConnections
+	TooManyUserConnections
+	NetPacketTooLarge
+
+	// failed precondition
+	ReadOnly
+
+	// permission denied
+	AccessDenied
+	KillDenied
+
+	// unimplemented
+	NotSupportedYet
+
+	// NumOfStates is the number of states, it must stay last.
+	NumOfStates
+)
+
+// NewErrorf formats according to a format specifier and returns the
+// string as an error with the code and the state.
+// NewErrorf also records the stack trace at the point it was called.
+func NewErrorf(code vtrpcpb.Code, state State, format string, args ...interface{}) error {
+	return &fundamental{
+		msg:   fmt.Sprintf(format, args...),
+		code:  code,
+		state: state,
+		stack: callers(),
+	}
+}
+
+// ErrState returns the state of err, or of its cause, or Undefined if it
+// has none.
+func ErrState(err error) State {
+	if err == nil {
+		return Undefined
+	}
+	if err, ok := err.(*fundamental); ok {
+		return err.state
+	}
+
+	cause := Cause(err)
+	if cause != err && cause != nil {
+		return ErrState(cause)
+	}
+	return Undefined
+}
@@ -136,8 +136,9 @@ func Errorf(code vtrpcpb.Code, format string, args ...interface{}) error {
 
 // fundamental is an error that has a message and a stack, but no caller.
 type fundamental struct {
-	msg  string
-	code vtrpcpb.Code
+	msg   string
+	code  vtrpcpb.Code
+	state State
 	*stack
 }
 
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 
@@ -429,6 +430,14 @@ func initTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options) error {
 		Fields: mysql.ShowPrimaryFields,
 		Rows:   indexRows,
 	}
+	tableNames := make([]string, 0, len(ddls))
+	for _, ddl := range ddls {
+		tableNames = append(tableNames, ddl.GetTable().Name.String())
+	}
+	sort.Strings(tableNames)
+	schemaQueries[mysql.BaseShowPartitionsForTables(tableNames)] = &sqltypes.Result{
+		Fields: mysql.ShowPartitionsFields,
+	}
 
 	return nil
 }
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var consistentSnapshotTimeout = flag.Duration("consistent_snapshot_timeout", 10*time.Second, "Timeout to start the transactions of a session with @@consistent_snapshot set. The commits on the shards are blocked while the snapshots are started, for at most this long.")
+
+var enableConsistentSnapshot = flag.Bool("enable_consistent_snapshot", false, "Allow the sessions with @@consistent_snapshot set to start their transactions. Starting them takes a global read lock on the master of every target shard, which blocks its writes for up to -consistent_snapshot_timeout. On replica and rdonly targets, the locks are held until the chosen tablets have replicated the positions of the masters.")
+
+// beginSnapshot starts the transaction of a session with
+// @@consistent_snapshot set on all the shards of its target.
+func (e *Executor) beginSnapshot(ctx context.Context, safeSession *SafeSession) error {
+	keyspace, tabletType, dest, err := e.ParseDestinationTarget(safeSession.TargetString)
+	if err != nil {
+		return err
+	}
+	if keyspace == "" {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshot transactions need a keyspace target, use <keyspace>[@<tablet_type>]")
+	}
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+	rss, err := e.resolver.resolver.ResolveDestination(ctx, keyspace, tabletType, dest)
+	if err != nil {
+		return err
+	}
+	return e.txConn.BeginSnapshot(ctx, safeSession, rss, *consistentSnapshotTimeout)
+}
+
+// snapshotLock is a global read lock held on a reserved connection.
+type snapshotLock struct {
+	target     *querypb.Target
+	reservedID int64
+	alias      *topodatapb.TabletAlias
+}
+
+// BeginSnapshot starts consistent snapshot transactions on all the shards
+// of rss, at coordinated positions: it takes a global read lock on the
+// master of every shard first, so that no transaction commits on any of
+// them while the snapshots are started, and releases the locks right
+// after. On the master tablets, the snapshots are started on the locked
+// tablets. On the other tablet types, the GTID position of every master is
+// read under the lock, and the snapshot of each shard is started once the
+// chosen tablet has executed that position. Since the masters are locked,
+// the tablet can't replicate past it. The transactions are read only. If
+// it fails, or does not complete within timeout, the locks are released
+// and the transactions that were started are rolled back.
+func (txc *TxConn) BeginSnapshot(ctx context.Context, session *SafeSession, rss []*srvtopo.ResolvedShard, timeout time.Duration) (err error) {
+	// The cleanup must run even if ctx is done.
+	cleanupCtx, cancelCleanup := context.WithTimeout(callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx)), timeout)
+	defer cancelCleanup()
+	defer func() {
+		if err != nil {
+			_ = txc.Rollback(cleanupCtx, session)
+		}
+	}()
+	if !*enableConsistentSnapshot {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshot transactions lock all the writes of the target shards on their master tablets, start vtgate with -enable_consistent_snapshot to allow them")
+	}
+	for _, rs := range rss {
+		if _, usingLegacyGw := rs.Gateway.(*DiscoveryGateway); usingLegacyGw {
+			return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshot transactions are not supported on old gen gateway")
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	masters := make([]*srvtopo.ResolvedShard, len(rss))
+	for i, rs := range rss {
+		masters[i] = rs
+		if rs.Target.TabletType != topodatapb.TabletType_MASTER {
+			target := proto.Clone(rs.Target).(*querypb.Target)
+			target.TabletType = topodatapb.TabletType_MASTER
+			masters[i] = &srvtopo.ResolvedShard{Target: target, Gateway: rs.Gateway}
+		}
+	}
+	locks := make([]*snapshotLock, len(rss))
+	defer txc.releaseSnapshotLocks(cleanupCtx, masters, locks)
+	err = runShards(masters, func(i int, rs *srvtopo.ResolvedShard) error {
+		_, reservedID, alias, err := rs.Gateway.ReserveExecute(ctx, rs.Target, nil, "flush tables with read lock", nil, 0, nil)
+		if reservedID != 0 {
+			locks[i] = &snapshotLock{target: rs.Target, reservedID: reservedID, alias: alias}
+		}
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot lock %s/%s for a consistent snapshot", rs.Target.Keyspace, rs.Target.Shard)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	options := &querypb.ExecuteOptions{}
+	if session.Options != nil {
+		options = proto.Clone(session.Options).(*querypb.ExecuteOptions)
+	}
+	options.TransactionIsolation = querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	return runShards(rss, func(i int, rs *srvtopo.ResolvedShard) error {
+		// On the master, the snapshot must be started on the locked tablet.
+		alias := locks[i].alias
+		if rs.Target.TabletType != topodatapb.TabletType_MASTER {
+			var err error
+			if alias, err = txc.waitSnapshotPosition(ctx, rs, locks[i]); err != nil {
+				return err
+			}
+		}
+		qs, err := rs.Gateway.QueryServiceByAlias(alias)
+		if err != nil {
+			return err
+		}
+		transactionID, alias, err := qs.Begin(ctx, rs.Target, options)
+		if err != nil {
+			return err
+		}
+		return session.AppendOrUpdate(&vtgatepb.Session_ShardSession{
+			Target:        rs.Target,
+			TransactionId: transactionID,
+			TabletAlias:   alias,
+		}, txc.mode)
+	})
+}
+
+// waitSnapshotPosition reads the GTID position of the master held by lock,
+// and waits for a tablet of rs to execute it. It returns the alias of that
+// tablet.
+func (txc *TxConn) waitSnapshotPosition(ctx context.Context, rs *srvtopo.ResolvedShard, lock *snapshotLock) (*topodatapb.TabletAlias, error) {
+	qs, err := rs.Gateway.QueryServiceByAlias(lock.alias)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := qs.Execute(ctx, lock.target, "select @@global.gtid_executed", nil, 0, lock.reservedID, nil)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read the position of %s/%s for a consistent snapshot", rs.Target.Keyspace, rs.Target.Shard)
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected position of %s/%s: %v", rs.Target.Keyspace, rs.Target.Shard, qr.Rows)
+	}
+	// The wait is bounded by the timeout of the snapshot, in seconds.
+	seconds := int64(1)
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := int64(time.Until(deadline) / time.Second); remaining > seconds {
+			seconds = remaining
+		}
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"gtid":    sqltypes.ValueBindVariable(qr.Rows[0][0]),
+		"timeout": sqltypes.Int64BindVariable(seconds),
+	}
+	qr, reservedID, alias, err := rs.Gateway.ReserveExecute(ctx, rs.Target, nil, "select wait_for_executed_gtid_set(:gtid, :timeout)", bindVars, 0, nil)
+	if reservedID != 0 {
+		defer func() {
+			if qs, err := rs.Gateway.QueryServiceByAlias(alias); err == nil {
+				_ = qs.Release(ctx, rs.Target, 0, reservedID)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot wait for the position of %s/%s for a consistent snapshot", rs.Target.Keyspace, rs.Target.Shard)
+	}
+	// wait_for_executed_gtid_set returns 0 once the position is executed,
+	// and 1 on timeout.
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) == 0 || qr.Rows[0][0].ToString() != "0" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "%s did not reach the position of %s/%s for a consistent snapshot", topoproto.TabletAliasString(alias), rs.Target.Keyspace, rs.Target.Shard)
+	}
+	return alias, nil
+}
+
+// releaseSnapshotLocks releases the global read locks taken by
+// BeginSnapshot, by closing their reserved connections.
+func (txc *TxConn) releaseSnapshotLocks(ctx context.Context, rss []*srvtopo.ResolvedShard, locks []*snapshotLock) {
+	_ = runShards(rss, func(i int, rs *srvtopo.ResolvedShard) error {
+		lock := locks[i]
+		if lock == nil {
+			return nil
+		}
+		qs, err := rs.Gateway.QueryServiceByAlias(lock.alias)
+		if err == nil {
+			err = qs.Release(ctx, lock.target, 0, lock.reservedID)
+		}
+		if err != nil {
+			log.Warningf("Cannot release the consistent snapshot lock on %s: %v", topoproto.TabletAliasString(lock.alias), err)
+		}
+		return nil
+	})
+}
+
+// runShards executes the action for all shards in parallel and returns
+// a consolidated error. Flow is identical to TxConn.runSessions.
+func runShards(rss []*srvtopo.ResolvedShard, action func(int, *srvtopo.ResolvedShard) error) error {
+	if len(rss) == 1 {
+		return action(0, rss[0])
+	}
+	allErrors := new(concurrency.AllErrorRecorder)
+	var wg sync.WaitGroup
+	for i, rs := range rss {
+		wg.Add(1)
+		go func(i int, rs *srvtopo.ResolvedShard) {
+			defer wg.Done()
+			if err := action(i, rs); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(i, rs)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
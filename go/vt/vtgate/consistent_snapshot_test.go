@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestConsistentSnapshot(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestConsistentSnapshot", session, "set consistent_snapshot = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.ConsistentSnapshot)
+	qr, err := executor.Execute(context.Background(), "TestConsistentSnapshot", session, "select @@consistent_snapshot", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[[INT64(1)]]", fmt.Sprintf("%v", qr.Rows))
+
+	// The master tablets are not locked unless it is enabled.
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "begin", nil)
+	require.EqualError(t, err, "consistent snapshot transactions lock all the writes of the target shards on their master tablets, start vtgate with -enable_consistent_snapshot to allow them")
+	assert.False(t, session.InTransaction())
+	assert.Empty(t, sbc1.Queries)
+
+	defer func(saved bool) { *enableConsistentSnapshot = saved }(*enableConsistentSnapshot)
+	*enableConsistentSnapshot = true
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "begin", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.ShardSessions, 8)
+	require.Len(t, sbc1.Queries, 1)
+	assert.Equal(t, "flush tables with read lock", sbc1.Queries[0].Sql)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+
+	// The queries use the snapshot transactions.
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "rollback", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.RollbackCount.Get())
+
+	// If a shard cannot be locked, the other locks are released
+	// and the transaction does not start.
+	sbc2.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "begin", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot lock TestExecutor/40-60 for a consistent snapshot")
+	assert.False(t, session.InTransaction())
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 2, sbc1.ReleaseCount.Get())
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+
+	session.TargetString = ""
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "begin", nil)
+	require.EqualError(t, err, "consistent snapshot transactions need a keyspace target, use <keyspace>[@<tablet_type>]")
+}
+
+func TestConsistentSnapshotOnReplica(t *testing.T) {
+	defer func(saved bool) { *enableConsistentSnapshot = saved }(*enableConsistentSnapshot)
+	*enableConsistentSnapshot = true
+
+	executor, sbc1, _, _ := createExecutorEnv()
+	// Transactions on replicas need the tablet gateway.
+	defer func(saved string) { *GatewayImplementation = saved }(*GatewayImplementation)
+	*GatewayImplementation = tabletGatewayImplementation
+	hc := vtgateHealthCheck.(*discovery.FakeHealthCheck)
+	replicas := make(map[string]*sandboxconn.SandboxConn)
+	for _, shard := range []string{"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"} {
+		replicas[shard] = hc.AddTestTablet("aa", "replica-"+shard, 1, "TestExecutor", shard, topodatapb.TabletType_REPLICA, true, 1, nil)
+		replicas[shard].SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "0")})
+	}
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	sbc1.SetResults([]*sqltypes.Result{
+		{},
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid", "varchar"), gtid),
+	})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@replica", Autocommit: true})
+	_, err := executor.Execute(context.Background(), "TestConsistentSnapshot", session, "set consistent_snapshot = 1", nil)
+	require.NoError(t, err)
+
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "begin", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.ShardSessions, 8)
+	// The position of the master is read under the lock, and the
+	// snapshot starts once the replica has executed it.
+	require.Len(t, sbc1.Queries, 2)
+	assert.Equal(t, "flush tables with read lock", sbc1.Queries[0].Sql)
+	assert.Equal(t, "select @@global.gtid_executed", sbc1.Queries[1].Sql)
+	assert.EqualValues(t, 0, sbc1.BeginCount.Get())
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	replica := replicas["-20"]
+	require.Len(t, replica.Queries, 1)
+	assert.Equal(t, "select wait_for_executed_gtid_set(:gtid, :timeout)", replica.Queries[0].Sql)
+	assert.Equal(t, gtid, string(replica.Queries[0].BindVariables["gtid"].Value))
+	assert.EqualValues(t, 1, replica.ReleaseCount.Get())
+	assert.EqualValues(t, 1, replica.BeginCount.Get())
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "rollback", nil)
+	require.NoError(t, err)
+
+	// The transaction does not start if a replica does not reach the
+	// position of its master in time.
+	replicas["40-60"].SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "1")})
+	_, err = executor.Execute(context.Background(), "TestConsistentSnapshot", session, "begin", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did not reach the position of TestExecutor/40-60 for a consistent snapshot")
+	assert.False(t, session.InTransaction())
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 2, sbc1.ReleaseCount.Get())
+}
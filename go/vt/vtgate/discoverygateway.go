@@ -244,6 +244,10 @@ func (dg *DiscoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 	var err error
 	invalidTablets := make(map[string]bool)
 
+	if _, ok := readAsOfFromContext(ctx); ok && target.TabletType != topodatapb.TabletType_MASTER {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "reads with @@read_as_of are not supported on old gen gateway")
+	}
+
 	if len(discovery.AllowedTabletTypes) > 0 {
 		var match bool
 		for _, allowed := range discovery.AllowedTabletTypes {
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// columnCollations holds the collations of the columns of a result, as
+// reported by the Charset of their field. They are used to compare and
+// hash text values the way MySQL does. The collation of a column is nil
+// if it is unknown, or not supported, in which case text values can only
+// be compared if they are binary.
+type columnCollations []collations.Collation
+
+func newColumnCollations(fields []*querypb.Field) columnCollations {
+	var colls columnCollations
+	for i, field := range fields {
+		coll := collations.LookupByID(collations.ID(field.Charset))
+		if coll == nil {
+			continue
+		}
+		if colls == nil {
+			colls = make(columnCollations, len(fields))
+		}
+		colls[i] = coll
+	}
+	return colls
+}
+
+func (cc columnCollations) get(col int) collations.Collation {
+	if col < len(cc) {
+		return cc[col]
+	}
+	return nil
+}
+
+// compare compares two values of the column col.
+func (cc columnCollations) compare(v1, v2 sqltypes.Value, col int) (int, error) {
+	return evalengine.NullsafeCompareCollation(v1, v2, cc.get(col))
+}
+
+// hashcode returns the hashcode of a value of the column col.
+func (cc columnCollations) hashcode(v sqltypes.Value, col int) (int64, error) {
+	return evalengine.NullsafeHashcodeCollation(v, cc.get(col))
+}
@@ -75,6 +75,11 @@ func (ddl *DDL) GetTableName() string {
 
 // IsOnlineSchemaDDL returns true if the query is an online schema change DDL
 func (ddl *DDL) isOnlineSchemaDDL() bool {
+	if alter, ok := ddl.DDL.(*sqlparser.AlterTable); ok && alter.PartitionSpec != nil {
+		// The partition operations, e.g. TRUNCATE PARTITION, are not
+		// table copies: the online schema change tools can't run them.
+		return false
+	}
 	switch ddl.DDL.GetAction() {
 	case sqlparser.CreateDDLAction, sqlparser.DropDDLAction, sqlparser.AlterDDLAction:
 		return !ddl.OnlineDDL.Strategy.IsDirect()
@@ -17,9 +17,10 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
-	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 // Distinct Primitive is used to uniqueify results
@@ -34,13 +35,17 @@ type row = []sqltypes.Value
 
 type probeTable struct {
 	m map[int64][]row
+	// size is the number of distinct rows held in m.
+	size int
+	// collations are used to compare and hash the text values.
+	collations columnCollations
 }
 
 func (pt *probeTable) exists(inputRow row) (bool, error) {
 	// calculate hashcode from all column values in the input row
 	code := int64(17)
-	for _, value := range inputRow {
-		hashcode, err := evalengine.NullsafeHashcode(value)
+	for i, value := range inputRow {
+		hashcode, err := pt.collations.hashcode(value, i)
 		if err != nil {
 			return false, err
 		}
@@ -51,13 +56,14 @@ func (pt *probeTable) exists(inputRow row) (bool, error) {
 	if !found {
 		// nothing with this hash code found, we can be sure it's a not seen row
 		pt.m[code] = []row{inputRow}
+		pt.size++
 		return false, nil
 	}
 
 	// we found something in the map - still need to check all individual values
 	// so we don't just fall for a hash collision
 	for _, existingRow := range existingRows {
-		exists, err := equal(existingRow, inputRow)
+		exists, err := pt.equal(existingRow, inputRow)
 		if err != nil {
 			return false, err
 		}
@@ -67,13 +73,14 @@ func (pt *probeTable) exists(inputRow row) (bool, error) {
 	}
 
 	pt.m[code] = append(existingRows, inputRow)
+	pt.size++
 
 	return false, nil
 }
 
-func equal(a, b []sqltypes.Value) (bool, error) {
+func (pt *probeTable) equal(a, b []sqltypes.Value) (bool, error) {
 	for i, aVal := range a {
-		cmp, err := evalengine.NullsafeCompare(aVal, b[i])
+		cmp, err := pt.collations.compare(aVal, b[i], i)
 		if err != nil {
 			return false, err
 		}
@@ -84,8 +91,17 @@ func equal(a, b []sqltypes.Value) (bool, error) {
 	return true, nil
 }
 
-func newProbeTable() *probeTable {
-	return &probeTable{m: map[int64][]row{}}
+// checkMemoryRows returns an error if the probe table holds
+// more rows than vtgate is allowed to keep in memory.
+func (pt *probeTable) checkMemoryRows(vcursor VCursor) error {
+	if vcursor.ExceedsMaxMemoryRows(pt.size) {
+		return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	return nil
+}
+
+func newProbeTable(fields []*querypb.Field) *probeTable {
+	return &probeTable{m: map[int64][]row{}, collations: newColumnCollations(fields)}
 }
 
 // Execute implements the Primitive interface
@@ -100,7 +116,7 @@ func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		InsertID: input.InsertID,
 	}
 
-	pt := newProbeTable()
+	pt := newProbeTable(input.Fields)
 
 	for _, row := range input.Rows {
 		exists, err := pt.exists(row)
@@ -111,15 +127,21 @@ func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 			result.Rows = append(result.Rows, row)
 		}
 	}
+	if err := pt.checkMemoryRows(vcursor); err != nil {
+		return nil, err
+	}
 
 	return result, err
 }
 
 // StreamExecute implements the Primitive interface
 func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
-	pt := newProbeTable()
+	pt := newProbeTable(nil)
 
 	err := d.Source.StreamExecute(vcursor, bindVars, wantfields, func(input *sqltypes.Result) error {
+		if len(input.Fields) != 0 {
+			pt.collations = newColumnCollations(input.Fields)
+		}
 		result := &sqltypes.Result{
 			Fields:   input.Fields,
 			InsertID: input.InsertID,
@@ -133,6 +155,9 @@ func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.B
 				result.Rows = append(result.Rows, row)
 			}
 		}
+		if err := pt.checkMemoryRows(vcursor); err != nil {
+			return err
+		}
 		return callback(result)
 	})
 
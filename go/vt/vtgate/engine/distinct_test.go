@@ -60,6 +60,10 @@ func TestDistinct(t *testing.T) {
 		testName:      "varchar columns",
 		inputs:        r("myid", "varchar", "monkey", "horse"),
 		expectedError: "types does not support hashcode yet: VARCHAR",
+	}, {
+		testName:       "varchar columns with a collation",
+		inputs:         withCollation(r("myid|n", "varchar|int64", "monkey|1", "Monkey|1", "horse|1", "HORSE |1", "monkey|2"), 45),
+		expectedResult: r("myid|n", "varchar|int64", "monkey|1", "horse|1", "monkey|2"),
 	}}
 
 	for _, tc := range testCases {
@@ -92,3 +96,66 @@ func TestDistinct(t *testing.T) {
 		})
 	}
 }
+
+func TestDistinctMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	saveIgnore := testIgnoreMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() {
+		testMaxMemoryRows = saveMax
+		testIgnoreMaxMemoryRows = saveIgnore
+	}()
+
+	testCases := []struct {
+		testName            string
+		ignoreMaxMemoryRows bool
+		inputs              *sqltypes.Result
+		err                 string
+	}{{
+		testName: "duplicates under the limit",
+		inputs:   r("myid", "int64", "1", "2", "1", "3", "2", "1"),
+	}, {
+		testName: "distinct rows over the limit",
+		inputs:   r("myid", "int64", "1", "2", "3", "4"),
+		err:      "in-memory row count exceeded allowed limit of 3",
+	}, {
+		testName:            "distinct rows over the limit, ignored",
+		ignoreMaxMemoryRows: true,
+		inputs:              r("myid", "int64", "1", "2", "3", "4"),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName+"-Execute", func(t *testing.T) {
+			testIgnoreMaxMemoryRows = tc.ignoreMaxMemoryRows
+			distinct := &Distinct{Source: &fakePrimitive{results: []*sqltypes.Result{tc.inputs}}}
+
+			_, err := distinct.Execute(&noopVCursor{ctx: context.Background()}, nil, true)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+		t.Run(tc.testName+"-StreamExecute", func(t *testing.T) {
+			testIgnoreMaxMemoryRows = tc.ignoreMaxMemoryRows
+			distinct := &Distinct{Source: &fakePrimitive{results: []*sqltypes.Result{tc.inputs}}}
+
+			_, err := wrapStreamExecute(distinct, &noopVCursor{ctx: context.Background()}, nil, true)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+// withCollation sets the collation of the text columns of qr.
+func withCollation(qr *sqltypes.Result, collation uint32) *sqltypes.Result {
+	for _, field := range qr.Fields {
+		if sqltypes.IsText(field.Type) {
+			field.Charset = collation
+		}
+	}
+	return qr
+}
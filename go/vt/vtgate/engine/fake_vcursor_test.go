@@ -77,6 +77,14 @@ func (t noopVCursor) SetSessionEnableSystemSettings(allow bool) error {
 	panic("implement me")
 }
 
+func (t noopVCursor) SetConsistentSnapshot(bool) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) SetReadAsOf(int64) {
+	panic("implement me")
+}
+
 func (t noopVCursor) GetSessionEnableSystemSettings() bool {
 	panic("implement me")
 }
@@ -76,8 +76,9 @@ func (ms *MemorySort) Execute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		return nil, err
 	}
 	sh := &sortHeap{
-		rows:    result.Rows,
-		orderBy: ms.OrderBy,
+		rows:       result.Rows,
+		orderBy:    ms.OrderBy,
+		collations: newColumnCollations(result.Fields),
 	}
 	sort.Sort(sh)
 	if sh.err != nil {
@@ -109,6 +110,7 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 	}
 	err = ms.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
+			sh.collations = newColumnCollations(qr.Fields)
 			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
 				return err
 			}
@@ -215,10 +217,11 @@ func GenericJoin(input interface{}, f func(interface{}) string) string {
 // sortHeap is sorted based on the orderBy params.
 // Implementation is similar to scatterHeap
 type sortHeap struct {
-	rows    [][]sqltypes.Value
-	orderBy []OrderbyParams
-	reverse bool
-	err     error
+	rows       [][]sqltypes.Value
+	orderBy    []OrderbyParams
+	collations columnCollations
+	reverse    bool
+	err        error
 }
 
 // Len satisfies sort.Interface and heap.Interface.
@@ -232,7 +235,7 @@ func (sh *sortHeap) Less(i, j int) bool {
 		if sh.err != nil {
 			return true
 		}
-		cmp, err := evalengine.NullsafeCompare(sh.rows[i][order.Col], sh.rows[j][order.Col])
+		cmp, err := sh.collations.compare(sh.rows[i][order.Col], sh.rows[j][order.Col], order.Col)
 		if err != nil {
 			sh.err = err
 			return true
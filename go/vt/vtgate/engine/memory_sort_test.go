@@ -18,6 +18,7 @@ package engine
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -429,3 +430,47 @@ func TestMemorySortExecuteNoVarChar(t *testing.T) {
 		t.Errorf("StreamExecute err: %v, want %v", err, want)
 	}
 }
+
+func TestMemorySortCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varchar|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{withCollation(sqltypes.MakeTestResult(
+			fields,
+			"b|2",
+			"Résumé|1",
+			"A|3",
+			"resume|4",
+			"a|5",
+		), 45)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col: 0,
+		}, {
+			Col:  1,
+			Desc: true,
+		}},
+		Input: fp,
+	}
+
+	result, err := ms.Execute(nil, nil, false)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|5",
+		"A|3",
+		"b|2",
+		"resume|4",
+		"Résumé|1",
+	)
+	require.Equal(t, fmt.Sprintf("%v", wantResult.Rows), fmt.Sprintf("%v", result.Rows))
+
+	fp.rewind()
+	result, err = wrapStreamExecute(ms, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%v", wantResult.Rows), fmt.Sprintf("%v", result.Rows))
+}
@@ -20,8 +20,6 @@ import (
 	"container/heap"
 	"io"
 
-	"vitess.io/vitess/go/vt/vtgate/evalengine"
-
 	"context"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -97,8 +95,9 @@ func (ms *MergeSort) StreamExecute(vcursor VCursor, bindVars map[string]*querypb
 	}
 
 	sh := &scatterHeap{
-		rows:    make([]streamRow, 0, len(handles)),
-		orderBy: ms.OrderBy,
+		rows:       make([]streamRow, 0, len(handles)),
+		orderBy:    ms.OrderBy,
+		collations: newColumnCollations(fields),
 	}
 
 	// Prime the heap. One element must be pulled from
@@ -236,9 +235,10 @@ type streamRow struct {
 // yielded an error, err is set. This must be checked
 // after every heap operation.
 type scatterHeap struct {
-	rows    []streamRow
-	orderBy []OrderbyParams
-	err     error
+	rows       []streamRow
+	orderBy    []OrderbyParams
+	collations columnCollations
+	err        error
 }
 
 // Len satisfies sort.Interface and heap.Interface.
@@ -252,7 +252,7 @@ func (sh *scatterHeap) Less(i, j int) bool {
 		if sh.err != nil {
 			return true
 		}
-		cmp, err := evalengine.NullsafeCompare(sh.rows[i].row[order.Col], sh.rows[j].row[order.Col])
+		cmp, err := sh.collations.compare(sh.rows[i].row[order.Col], sh.rows[j].row[order.Col], order.Col)
 		if err != nil {
 			sh.err = err
 			return true
@@ -89,6 +89,47 @@ func TestMergeSortNormal(t *testing.T) {
 	}
 }
 
+// TestMergeSortCollation tests a merge sort on a text
+// column that has a case insensitive collation.
+func TestMergeSortCollation(t *testing.T) {
+	idColFields := withCollation(&sqltypes.Result{Fields: sqltypes.MakeTestFields("id|col", "int32|varchar")}, 45).Fields
+	shardResults := []*shardResult{{
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"1|a",
+			"7|C",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"2|B",
+			"---",
+			"3|d",
+		),
+	}}
+	orderBy := []OrderbyParams{{
+		Col: 1,
+	}}
+
+	var results []*sqltypes.Result
+	err := testMergeSort(shardResults, orderBy, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(idColFields,
+		"1|a",
+		"---",
+		"2|B",
+		"---",
+		"7|C",
+		"---",
+		"3|d",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("MergeSort:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+}
+
 // TestMergeSortDescending tests the normal flow of a merge
 // sort where all shards return descending rows.
 func TestMergeSortDescending(t *testing.T) {
@@ -164,6 +164,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	if err != nil {
 		return nil, err
 	}
+	colls := newColumnCollations(result.Fields)
 	out := &sqltypes.Result{
 		Fields: oa.convertFields(result.Fields),
 		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
@@ -177,13 +178,13 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 			continue
 		}
 
-		equal, err := oa.keysEqual(current, row)
+		equal, err := oa.keysEqual(colls, current, row)
 		if err != nil {
 			return nil, err
 		}
 
 		if equal {
-			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct)
+			current, curDistinct, err = oa.merge(result.Fields, colls, current, row, curDistinct)
 			if err != nil {
 				return nil, err
 			}
@@ -214,6 +215,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	var current []sqltypes.Value
 	var curDistinct sqltypes.Value
 	var fields []*querypb.Field
+	var colls columnCollations
 
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
@@ -221,6 +223,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
+			colls = newColumnCollations(qr.Fields)
 			fields = oa.convertFields(qr.Fields)
 			if err := cb(&sqltypes.Result{Fields: fields}); err != nil {
 				return err
@@ -233,13 +236,13 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 				continue
 			}
 
-			equal, err := oa.keysEqual(current, row)
+			equal, err := oa.keysEqual(colls, current, row)
 			if err != nil {
 				return err
 			}
 
 			if equal {
-				current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
+				current, curDistinct, err = oa.merge(fields, colls, current, row, curDistinct)
 				if err != nil {
 					return err
 				}
@@ -328,9 +331,9 @@ func (oa *OrderedAggregate) NeedsTransaction() bool {
 	return oa.Input.NeedsTransaction()
 }
 
-func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error) {
+func (oa *OrderedAggregate) keysEqual(colls columnCollations, row1, row2 []sqltypes.Value) (bool, error) {
 	for _, key := range oa.Keys {
-		cmp, err := evalengine.NullsafeCompare(row1[key], row2[key])
+		cmp, err := colls.compare(row1[key], row2[key], key)
 		if err != nil {
 			return false, err
 		}
@@ -341,14 +344,14 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, colls columnCollations, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
 	result := sqltypes.CopyRow(row1)
 	for _, aggr := range oa.Aggregates {
 		if aggr.isDistinct() {
 			if row2[aggr.Col].IsNull() {
 				continue
 			}
-			cmp, err := evalengine.NullsafeCompare(curDistinct, row2[aggr.Col])
+			cmp, err := colls.compare(curDistinct, row2[aggr.Col], aggr.Col)
 			if err != nil {
 				return nil, sqltypes.NULL, err
 			}
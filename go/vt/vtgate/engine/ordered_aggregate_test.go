@@ -68,6 +68,43 @@ func TestOrderedAggregateExecute(t *testing.T) {
 	assert.Equal(wantResult, result)
 }
 
+func TestOrderedAggregateExecuteCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varchar|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{withCollation(sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"A|1",
+			"b|2",
+			"c|3",
+			"C |4",
+		), 45)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := withCollation(sqltypes.MakeTestResult(
+		fields,
+		"a|2",
+		"b|2",
+		"c|7",
+	), 45)
+	assert.Equal(t, wantResult, result)
+}
+
 func TestOrderedAggregateExecuteTruncate(t *testing.T) {
 	assert := assert.New(t)
 	fp := &fakePrimitive{
@@ -651,13 +688,13 @@ func TestMerge(t *testing.T) {
 		"1|3|2.8|2|bc",
 	)
 
-	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
+	merged, _, err := oa.merge(fields, nil, r.Rows[0], r.Rows[1], sqltypes.NULL)
 	assert.NoError(err)
 	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
-	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL)
+	merged, _, err = oa.merge(fields, nil, r.Rows[1], r.Rows[0], sqltypes.NULL)
 	assert.NoError(err)
 	assert.Equal(want, merged)
 }
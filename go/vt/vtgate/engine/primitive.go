@@ -142,6 +142,12 @@ type (
 		SetReadAfterWriteTimeout(float64)
 		SetSessionTrackGTIDs(bool)
 
+		// SetConsistentSnapshot makes the next transactions start consistent snapshots on all the target shards
+		SetConsistentSnapshot(bool) error
+
+		// SetReadAsOf makes the reads on rdonly tablets go to the delayed replicas lagging that many seconds
+		SetReadAsOf(int64)
+
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
 	}
@@ -156,6 +162,7 @@ type (
 		Original     string                  // Original is the original query.
 		Instructions Primitive               // Instructions contains the instructions needed to fulfil the query.
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
+		CacheTTL     time.Duration           // How long the result can be cached by vtgate, set by the CACHE_TTL directive
 
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
@@ -251,6 +258,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		QueryType    string
 		Original     string                `json:",omitempty"`
 		Instructions *PrimitiveDescription `json:",omitempty"`
+		CacheTTL     time.Duration         `json:",omitempty"`
 		ExecCount    uint64                `json:",omitempty"`
 		ExecTime     time.Duration         `json:",omitempty"`
 		ShardQueries uint64                `json:",omitempty"`
@@ -261,6 +269,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
 		Instructions: instructions,
+		CacheTTL:     p.CacheTTL,
 		ExecCount:    atomic.LoadUint64(&p.ExecCount),
 		ExecTime:     time.Duration(atomic.LoadUint64(&p.ExecTime)),
 		ShardQueries: atomic.LoadUint64(&p.ShardQueries),
@@ -176,6 +176,10 @@ const (
 	SelectReference
 	// SelectNone is used for queries that always return empty values
 	SelectNone
+	// SelectRange is for routing a range scan using an
+	// order preserving Vindex. Requires: A Vindex, and a
+	// Values list with the lower and upper bounds.
+	SelectRange
 	// NumRouteOpcodes is the number of opcodes
 	NumRouteOpcodes
 )
@@ -191,6 +195,7 @@ var routeName = map[RouteOpcode]string{
 	SelectDBA:         "SelectDBA",
 	SelectReference:   "SelectReference",
 	SelectNone:        "SelectNone",
+	SelectRange:       "SelectRange",
 }
 
 var (
@@ -253,6 +258,8 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -318,6 +325,8 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -555,6 +564,30 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
+func (route *Route) paramsSelectRange(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	bounds, err := route.Values[0].ResolveList(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	vindex, ok := route.Vindex.(vindexes.OrderPreserving)
+	if !ok || len(bounds) != 2 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "paramsSelectRange: invalid range on vindex %s", route.Vindex)
+	}
+	destination, err := vindex.MapRange(vcursor, bounds[0], bounds[1])
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
 func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
@@ -584,6 +617,7 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 		InsertID:     in.InsertID,
 	}
 
+	colls := newColumnCollations(in.Fields)
 	sort.Slice(out.Rows, func(i, j int) bool {
 		// If there are any errors below, the function sets
 		// the external err and returns true. Once err is set,
@@ -595,7 +629,7 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 				return true
 			}
 			var cmp int
-			cmp, err = evalengine.NullsafeCompare(out.Rows[i][order.Col], out.Rows[j][order.Col])
+			cmp, err = colls.compare(out.Rows[i][order.Col], out.Rows[j][order.Col], order.Col)
 			if err != nil {
 				return true
 			}
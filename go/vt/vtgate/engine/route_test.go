@@ -592,6 +592,60 @@ func TestSelectMultiEqual(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewNumeric("", nil)
+	sel := NewRoute(
+		SelectRange,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{
+		Values: []sqltypes.PlanValue{{
+			Value: sqltypes.NewInt64(1),
+		}, {
+			Key: "to",
+		}},
+	}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"to": sqltypes.Int64BindVariable(8),
+	}
+	result, err := sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000800)`,
+		`ExecuteMultiShard ks.-20: dummy_select {to: type:INT64 value:"8" } ks.20-: dummy_select {to: type:INT64 value:"8" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000800)`,
+		`StreamExecuteMulti dummy_select ks.-20: {to: type:INT64 value:"8" } ks.20-: {to: type:INT64 value:"8" } `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+
+	// An empty range doesn't go to any shard.
+	vc.Rewind()
+	bv["to"] = sqltypes.Int64BindVariable(0)
+	_, err = sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationNone()`,
+	})
+}
+
 func TestSelectNext(t *testing.T) {
 	sel := NewRoute(
 		SelectNext,
@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// SemiJoin specifies the parameters for a semi-join primitive.
+// It's used for correlated EXISTS subqueries: the RHS is executed
+// for every row of the LHS, and the LHS row is returned only if
+// the RHS returned rows (or didn't, for NOT EXISTS).
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the SemiJoin. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// results should be used to build the
+	// return result.
+	Cols []int `json:",omitempty"`
+
+	// Vars defines the list of joinVars that need to
+	// be built from the LHS result before invoking
+	// the RHS subqquery.
+	Vars map[string]int `json:",omitempty"`
+}
+
+// Execute performs a non-streaming exec.
+func (sj *SemiJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: projectFields(lresult.Fields, sj.Cols)}
+	for _, lrow := range lresult.Rows {
+		keep, err := sj.keepRow(vcursor, bindVars, lrow)
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			result.Rows = append(result.Rows, projectRow(lrow, sj.Cols))
+		}
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (sj *SemiJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return sj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{Fields: projectFields(lresult.Fields, sj.Cols)}
+		for _, lrow := range lresult.Rows {
+			keep, err := sj.keepRow(vcursor, bindVars, lrow)
+			if err != nil {
+				return err
+			}
+			if keep {
+				result.Rows = append(result.Rows, projectRow(lrow, sj.Cols))
+			}
+		}
+		return callback(result)
+	})
+}
+
+// keepRow executes the RHS with the join vars of lrow, and
+// reports if lrow is part of the result.
+func (sj *SemiJoin) keepRow(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrow []sqltypes.Value) (bool, error) {
+	joinVars := make(map[string]*querypb.BindVariable, len(sj.Vars))
+	for k, col := range sj.Vars {
+		joinVars[k] = sqltypes.ValueBindVariable(lrow[col])
+	}
+	rresult, err := sj.Right.Execute(vcursor, combineVars(bindVars, joinVars), false)
+	if err != nil {
+		return false, err
+	}
+	return (len(rresult.Rows) != 0) == (sj.Opcode == SemiJoinExists), nil
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: projectFields(lresult.Fields, sj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this semi-join
+func (sj *SemiJoin) Inputs() []Primitive {
+	return []Primitive{sj.Left, sj.Right}
+}
+
+func projectFields(fields []*querypb.Field, cols []int) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	projected := make([]*querypb.Field, len(cols))
+	for i, index := range cols {
+		projected[i] = fields[index]
+	}
+	return projected
+}
+
+func projectRow(row []sqltypes.Value, cols []int) []sqltypes.Value {
+	projected := make([]sqltypes.Value, len(cols))
+	for i, index := range cols {
+		projected[i] = row[index]
+	}
+	return projected
+}
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	SemiJoinExists = SemiJoinOpcode(iota)
+	SemiJoinNotExists
+)
+
+func (code SemiJoinOpcode) String() string {
+	if code == SemiJoinExists {
+		return "SemiJoin"
+	}
+	return "AntiJoin"
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return "SemiJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (sj *SemiJoin) NeedsTransaction() bool {
+	return sj.Right.NeedsTransaction() || sj.Left.NeedsTransaction()
+}
+
+func (sj *SemiJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":        sj.GetTableName(),
+		"ProjectedIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(sj.Cols)), ","), "[]"),
+	}
+	return PrimitiveDescription{
+		OperatorType: "SemiJoin",
+		Variant:      sj.Opcode.String(),
+		Other:        other,
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newSemiJoinInputs() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"1",
+		"int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"1",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"1",
+				"1",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestSemiJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinInputs()
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0, 1},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+	}
+	r, err := sj.Execute(noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"a"  false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"b"  false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"c"  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+		"3|c",
+	))
+
+	// Anti join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = SemiJoinNotExists
+	r, err = sj.Execute(noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"2|b",
+	))
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinInputs()
+
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{2},
+		Vars: map[string]int{
+			"bv": 0,
+		},
+	}
+	r, err := wrapStreamExecute(sj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute bv: type:INT64 value:"1"  false`,
+		`Execute bv: type:INT64 value:"2"  false`,
+		`Execute bv: type:INT64 value:"3"  false`,
+	})
+	expectResult(t, "sj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col3",
+			"varchar",
+		),
+		"aa",
+		"cc",
+	))
+}
+
+func TestSemiJoinExecuteError(t *testing.T) {
+	leftPrim, _ := newSemiJoinInputs()
+	rightPrim := &fakePrimitive{
+		sendErr: errors.New("right err"),
+	}
+
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+	}
+	_, err := sj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "right err")
+}
@@ -386,7 +386,7 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		}
 		out, ok := vtgatepb.TransactionMode_value[strings.ToUpper(str)]
 		if !ok {
-			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid transaction_mode: %s", str)
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid transaction_mode: %s", str)
 		}
 		vcursor.Session().SetTransactionMode(vtgatepb.TransactionMode(out))
 	case sysvars.Workload.Name:
@@ -396,7 +396,7 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		}
 		out, ok := querypb.ExecuteOptions_Workload_value[strings.ToUpper(str)]
 		if !ok {
-			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid workload: %s", str)
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid workload: %s", str)
 		}
 		vcursor.Session().SetWorkload(querypb.ExecuteOptions_Workload(out))
 	case sysvars.DDLStrategy.Name:
@@ -405,11 +405,13 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return err
 		}
 		if _, _, err := schema.ParseDDLStrategy(str); err != nil {
-			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid DDL strategy: %s", str)
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid DDL strategy: %s", str)
 		}
 		vcursor.Session().SetDDLStrategy(str)
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableSystemSettings)
+	case sysvars.ConsistentSnapshot.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetConsistentSnapshot)
 	case sysvars.Charset.Name, sysvars.Names.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -422,6 +424,15 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		default:
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for charset/names: %v", str)
 		}
+	case sysvars.ReadAsOf.Name:
+		intValue, err := svss.evalAsInt64(env)
+		if err != nil {
+			return vterrors.Wrapf(err, "failed to evaluate value for %s", sysvars.ReadAsOf.Name)
+		}
+		if intValue < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid read_as_of: %d, it must be a number of seconds", intValue)
+		}
+		vcursor.Session().SetReadAsOf(intValue)
 	case sysvars.ReadAfterWriteGTID.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -445,7 +456,7 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		case "own_gtid":
 			vcursor.Session().SetSessionTrackGTIDs(true)
 		default:
-			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", str)
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "Variable 'session_track_gtids' can't be set to the value of '%s'", str)
 		}
 	default:
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported construct %s", svss.Name)
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*VExplain)(nil)
+
+// VExplain is the primitive for the vexplain statement. It describes how
+// Input would be executed without executing it.
+type VExplain struct {
+	noTxNeeded
+
+	Input Primitive
+	Type  sqlparser.VExplainType
+}
+
+// RouteType implements the Primitive interface
+func (v *VExplain) RouteType() string {
+	return "VExplain"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (v *VExplain) GetKeyspaceName() string {
+	return v.Input.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (v *VExplain) GetTableName() string {
+	return v.Input.GetTableName()
+}
+
+// Execute implements the Primitive interface
+func (v *VExplain) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	switch v.Type {
+	case sqlparser.PlanVExplainType:
+		return v.explainPlan()
+	case sqlparser.QueriesVExplainType, sqlparser.AllVExplainType:
+		return v.explainQueries(vcursor, bindVars)
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected vexplain type: %v", v.Type.ToString())
+}
+
+// StreamExecute implements the Primitive interface
+func (v *VExplain) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := v.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (v *VExplain) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: v.fields()}, nil
+}
+
+// Inputs implements the Primitive interface
+func (v *VExplain) Inputs() []Primitive {
+	return []Primitive{v.Input}
+}
+
+func (v *VExplain) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "VExplain",
+		Variant:      v.Type.ToString(),
+	}
+}
+
+func (v *VExplain) fields() []*querypb.Field {
+	if v.Type == sqlparser.PlanVExplainType {
+		return []*querypb.Field{{Name: "JSON", Type: querypb.Type_VARCHAR}}
+	}
+	fields := []*querypb.Field{
+		{Name: "#", Type: querypb.Type_INT64},
+		{Name: "keyspace", Type: querypb.Type_VARCHAR},
+		{Name: "shard", Type: querypb.Type_VARCHAR},
+		{Name: "query", Type: querypb.Type_VARCHAR},
+	}
+	if v.Type == sqlparser.AllVExplainType {
+		fields = append(fields,
+			&querypb.Field{Name: "tablet_plan", Type: querypb.Type_VARCHAR},
+			&querypb.Field{Name: "tablet_table", Type: querypb.Type_VARCHAR},
+			&querypb.Field{Name: "tablet_query", Type: querypb.Type_VARCHAR},
+		)
+	}
+	return fields
+}
+
+func (v *VExplain) explainPlan() (*sqltypes.Result, error) {
+	buf, err := json.MarshalIndent(PrimitiveToPlanDescription(v.Input), "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{
+		Fields: v.fields(),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar(string(buf))}},
+	}, nil
+}
+
+// explainQueries runs Input against a vcursor that records the queries
+// it would send to the tablets instead of sending them. For vexplain all,
+// every recorded shard query is then sent to its tablet as a vexplain
+// statement, so that the tablet reports its own plan for it.
+func (v *VExplain) explainQueries(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	recorder := &vexplainVCursor{VCursor: vcursor}
+	if _, err := v.Input.Execute(recorder, bindVars, true); err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{Fields: v.fields()}
+	for i, q := range recorder.queries {
+		var ks, shard string
+		if q.rs != nil {
+			ks, shard = q.rs.Target.Keyspace, q.rs.Target.Shard
+		}
+		row := []sqltypes.Value{
+			sqltypes.NewInt64(int64(i)),
+			sqltypes.NewVarChar(ks),
+			sqltypes.NewVarChar(shard),
+			sqltypes.NewVarChar(boundQueryString(q.query)),
+		}
+		if v.Type == sqlparser.AllVExplainType {
+			tabletPlan := []sqltypes.Value{sqltypes.NewVarChar(""), sqltypes.NewVarChar(""), sqltypes.NewVarChar("")}
+			if q.rs != nil {
+				qr, err := vcursor.ExecuteStandalone("vexplain plan "+q.query.Sql, q.query.BindVariables, q.rs)
+				if err != nil {
+					return nil, err
+				}
+				if len(qr.Rows) != 1 || len(qr.Rows[0]) != len(tabletPlan) {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected vexplain result from %s/%s: %v", ks, shard, qr.Rows)
+				}
+				tabletPlan = qr.Rows[0]
+			}
+			row = append(row, tabletPlan...)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// boundQueryString returns the query with its bind variables substituted,
+// or the query as is if that is not possible.
+func boundQueryString(query *querypb.BoundQuery) string {
+	if len(query.BindVariables) == 0 {
+		return query.Sql
+	}
+	stmt, err := sqlparser.Parse(query.Sql)
+	if err != nil {
+		return query.Sql
+	}
+	sql, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(query.BindVariables, nil)
+	if err != nil {
+		return query.Sql
+	}
+	return sql
+}
+
+type vexplainQuery struct {
+	// rs is nil for queries that go through the vtgate executor,
+	// like the ones issued by lookup vindexes.
+	rs    *srvtopo.ResolvedShard
+	query *querypb.BoundQuery
+}
+
+// vexplainVCursor records the queries that would be sent to the tablets.
+// Shard queries are not sent: they are answered with an empty result, or,
+// for sequences, with a single zero value. Lookup vindex reads still go
+// through the executor so that the routing decisions can be made.
+type vexplainVCursor struct {
+	VCursor
+	queries []vexplainQuery
+}
+
+func (vc *vexplainVCursor) record(rs *srvtopo.ResolvedShard, sql string, bindVars map[string]*querypb.BindVariable) {
+	vc.queries = append(vc.queries, vexplainQuery{
+		rs:    rs,
+		query: &querypb.BoundQuery{Sql: sql, BindVariables: bindVars},
+	})
+}
+
+// Execute is part of the VCursor interface.
+func (vc *vexplainVCursor) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
+	vc.record(nil, query, bindVars)
+	switch method {
+	case "VindexLookup", "VindexVerify":
+		return vc.VCursor.Execute(method, query, bindVars, rollbackOnError, co)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// ExecuteMultiShard is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool) (*sqltypes.Result, []error) {
+	for i, rs := range rss {
+		vc.record(rs, queries[i].Sql, queries[i].BindVariables)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// ExecuteStandalone is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecuteStandalone(query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	vc.record(rs, query, bindVars)
+	return &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(0)}}}, nil
+}
+
+// StreamExecuteMulti is part of the VCursor interface.
+func (vc *vexplainVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
+	for i, rs := range rss {
+		vc.record(rs, query, bindVars[i])
+	}
+	return nil
+}
+
+// ExecuteKeyspaceID is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError, autocommit bool) (*sqltypes.Result, error) {
+	rss, _, err := vc.ResolveDestinations(keyspace, nil, []key.Destination{key.DestinationKeyspaceID(ksid)})
+	if err != nil {
+		return nil, err
+	}
+	for _, rs := range rss {
+		vc.record(rs, query, bindVars)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// ExecuteLock is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	vc.record(rs, query.Sql, query.BindVariables)
+	return &sqltypes.Result{}, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newVExplainTestRoute() *Route {
+	vindex, _ := vindexes.NewHash("", nil)
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"select id from t where id = :id",
+		"select id from t where 1 != 1",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "id"}}
+	return sel
+}
+
+func TestVExplainPlan(t *testing.T) {
+	vexplain := &VExplain{
+		Input: newVExplainTestRoute(),
+		Type:  sqlparser.PlanVExplainType,
+	}
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	result, err := vexplain.Execute(vc, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, true)
+	require.NoError(t, err)
+
+	// Nothing is resolved nor executed.
+	vc.ExpectLog(t, nil)
+	require.Len(t, result.Rows, 1)
+	assert.Contains(t, result.Rows[0][0].ToString(), `"Variant": "SelectEqualUnique"`)
+	assert.Contains(t, result.Rows[0][0].ToString(), `"Query": "select id from t where id = :id"`)
+}
+
+func TestVExplainQueries(t *testing.T) {
+	vexplain := &VExplain{
+		Input: newVExplainTestRoute(),
+		Type:  sqlparser.QueriesVExplainType,
+	}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-"},
+	}
+	result, err := vexplain.Execute(vc, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, true)
+	require.NoError(t, err)
+
+	// The shards are resolved, but the query is not sent.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("#|keyspace|shard|query", "int64|varchar|varchar|varchar"),
+		"0|ks|20-|select id from t where id = 1",
+	)
+	expectResult(t, "vexplain queries", result, want)
+}
+
+func TestVExplainAll(t *testing.T) {
+	vexplain := &VExplain{
+		Input: newVExplainTestRoute(),
+		Type:  sqlparser.AllVExplainType,
+	}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("PlanId|TableName|Query", "varchar|varchar|varchar"),
+			"Select|t|select id from t where id = 1 limit 10001",
+		)},
+	}
+	result, err := vexplain.Execute(vc, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, true)
+	require.NoError(t, err)
+
+	// Only the vexplain statement is sent to the tablet.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteStandalone vexplain plan select id from t where id = :id id: type:INT64 value:"1"  ks 20-`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("#|keyspace|shard|query|tablet_plan|tablet_table|tablet_query", "int64|varchar|varchar|varchar|varchar|varchar|varchar"),
+		"0|ks|20-|select id from t where id = 1|Select|t|select id from t where id = 1 limit 10001",
+	)
+	expectResult(t, "vexplain all", result, want)
+}
+
+func TestVExplainRecordsSequences(t *testing.T) {
+	vc := &loggingVCursor{shards: []string{"0"}}
+	recorder := &vexplainVCursor{VCursor: vc}
+	qr, err := recorder.ExecuteStandalone("select next :n values from seq", nil, nil)
+	require.NoError(t, err)
+	// A value is returned so that the insert can be planned further.
+	require.Len(t, qr.Rows, 1)
+	assert.Len(t, recorder.queries, 1)
+	vc.ExpectLog(t, nil)
+}
@@ -19,8 +19,10 @@ package evalengine
 import (
 	"bytes"
 	"fmt"
+	"hash/fnv"
 	"math"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	"strconv"
@@ -204,6 +206,22 @@ func NullsafeCompare(v1, v2 sqltypes.Value) (int, error) {
 	return 0, fmt.Errorf("types are not comparable: %v vs %v", v1.Type(), v2.Type())
 }
 
+// NullsafeCompareCollation is like NullsafeCompare, but compares two
+// non binary text values according to coll instead of failing. If coll
+// is nil, it is the same as NullsafeCompare.
+func NullsafeCompareCollation(v1, v2 sqltypes.Value, coll collations.Collation) (int, error) {
+	if coll == nil || !isCollatable(v1) || !isCollatable(v2) {
+		return NullsafeCompare(v1, v2)
+	}
+	switch cmp := coll.Collate(v1.Raw(), v2.Raw()); {
+	case cmp < 0:
+		return -1, nil
+	case cmp > 0:
+		return 1, nil
+	}
+	return 0, nil
+}
+
 // NullsafeHashcode returns an int64 hashcode that is guaranteed to be the same
 // for two values that are considered equal by `NullsafeCompare`.
 // TODO: should be extended to support all possible types
@@ -223,6 +241,25 @@ func NullsafeHashcode(v sqltypes.Value) (int64, error) {
 	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "types does not support hashcode yet: %v", v.Type())
 }
 
+// NullsafeHashcodeCollation is like NullsafeHashcode, but also supports
+// the non binary text values, which are hashed according to coll. The
+// hashcodes of two values are the same if NullsafeCompareCollation
+// considers them equal.
+func NullsafeHashcodeCollation(v sqltypes.Value, coll collations.Collation) (int64, error) {
+	if coll == nil || !isCollatable(v) {
+		return NullsafeHashcode(v)
+	}
+	h := fnv.New64a()
+	h.Write(coll.WeightString(nil, v.Raw()))
+	return int64(h.Sum64()), nil
+}
+
+// isCollatable returns true if the value is a non NULL text value, which
+// must be compared according to its collation.
+func isCollatable(v sqltypes.Value) bool {
+	return v.IsText()
+}
+
 // isByteComparable returns true if the type is binary or date/time.
 func isByteComparable(v sqltypes.Value) bool {
 	if v.IsBinary() {
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -583,6 +584,63 @@ func TestNullsafeCompare(t *testing.T) {
 	}
 }
 
+func TestNullsafeCompareCollation(t *testing.T) {
+	ci := collations.LookupByName("utf8mb4_general_ci")
+	tcases := []struct {
+		v1, v2 sqltypes.Value
+		coll   collations.Collation
+		out    int
+	}{{
+		v1:   sqltypes.NewVarChar("Résumé"),
+		v2:   sqltypes.NewVarChar("resume "),
+		coll: ci,
+		out:  0,
+	}, {
+		v1:   sqltypes.NewVarChar("b"),
+		v2:   sqltypes.NewVarChar("A"),
+		coll: ci,
+		out:  1,
+	}, {
+		v1:   sqltypes.NULL,
+		v2:   sqltypes.NewVarChar("a"),
+		coll: ci,
+		out:  -1,
+	}, {
+		v1:   sqltypes.NewInt64(10),
+		v2:   sqltypes.NewVarChar("9"),
+		coll: ci,
+		out:  1,
+	}, {
+		v1:   sqltypes.NewVarBinary("a"),
+		v2:   sqltypes.NewVarBinary("A"),
+		coll: ci,
+		out:  1,
+	}}
+	for _, tcase := range tcases {
+		got, err := NullsafeCompareCollation(tcase.v1, tcase.v2, tcase.coll)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "NullsafeCompareCollation(%v, %v)", printValue(tcase.v1), printValue(tcase.v2))
+	}
+
+	_, err := NullsafeCompareCollation(sqltypes.NewVarChar("a"), sqltypes.NewVarChar("b"), nil)
+	assert.EqualError(t, err, "types are not comparable: VARCHAR vs VARCHAR")
+}
+
+func TestNullsafeHashcodeCollation(t *testing.T) {
+	ci := collations.LookupByName("utf8mb4_general_ci")
+	h1, err := NullsafeHashcodeCollation(sqltypes.NewVarChar("Résumé"), ci)
+	require.NoError(t, err)
+	h2, err := NullsafeHashcodeCollation(sqltypes.NewVarChar("RESUME  "), ci)
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+	h3, err := NullsafeHashcodeCollation(sqltypes.NewVarChar("resumes"), ci)
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h3)
+
+	_, err = NullsafeHashcodeCollation(sqltypes.NewVarChar("a"), nil)
+	assert.EqualError(t, err, "types does not support hashcode yet: VARCHAR")
+}
+
 func TestCast(t *testing.T) {
 	tcases := []struct {
 		typ querypb.Type
@@ -102,9 +102,17 @@ type Executor struct {
 	normalize    bool
 	streamSize   int
 	plans        cache.Cache
+	results      *resultCache
+	readMirror   *readMirror
+	mirror       *trafficMirror
 	vschemaStats *VSchemaStats
 
 	vm *VSchemaManager
+
+	// connections are the connections of the clients, for KILL and
+	// SHOW PROCESSLIST. It is nil if vtgate does not serve the MySQL
+	// protocol.
+	connections clientConnections
 }
 
 var executorOnce sync.Once
@@ -122,6 +130,9 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		scatterConn: resolver.scatterConn,
 		txConn:      resolver.scatterConn.txConn,
 		plans:       cache.NewDefaultCacheImpl(cacheCfg),
+		results:     newResultCache(*resultCacheKeyspaceSize),
+		readMirror:  newReadMirror(*mirrorReadsPercent, *mirrorReadsMaxConcurrency, *mirrorReadsTimeout),
+		mirror:      newTrafficMirror(*trafficMirrorRules, *trafficMirrorPercent, *trafficMirrorCompare, *trafficMirrorMaxConcurrency, *trafficMirrorTimeout),
 		normalize:   normalize,
 		streamSize:  streamSize,
 	}
@@ -184,6 +195,7 @@ func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType
 }
 
 func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	ctx = withReadAsOf(ctx, safeSession)
 	stmtType, qr, err := e.newExecute(ctx, safeSession, sql, bindVars, logStats)
 	if err == planbuilder.ErrPlanNotSupported {
 		return e.legacyExecute(ctx, safeSession, sql, bindVars, logStats)
@@ -193,10 +205,8 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 
 func (e *Executor) legacyExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
 	//Start an implicit transaction if necessary.
-	if !safeSession.Autocommit && !safeSession.InTransaction() {
-		if err := e.txConn.Begin(ctx, safeSession); err != nil {
-			return 0, nil, err
-		}
+	if err := e.startTxIfNecessary(ctx, safeSession); err != nil {
+		return 0, nil, err
 	}
 
 	destKeyspace, destTabletType, dest, err := e.ParseDestinationTarget(safeSession.TargetString)
@@ -235,6 +245,12 @@ func (e *Executor) legacyExecute(ctx context.Context, safeSession *SafeSession,
 	case sqlparser.StmtSet:
 		qr, err := e.handleSet(ctx, sql, logStats)
 		return sqlparser.StmtSet, qr, err
+	case sqlparser.StmtKill:
+		qr, err := e.handleKill(ctx, sql, logStats)
+		return sqlparser.StmtKill, qr, err
+	case sqlparser.StmtPurgeResultCache:
+		qr, err := e.handlePurgeResultCache(ctx, sql, logStats)
+		return sqlparser.StmtPurgeResultCache, qr, err
 	case sqlparser.StmtShow:
 		qr, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 		return sqlparser.StmtShow, qr, err
@@ -298,6 +314,10 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 			bindVars[key] = sqltypes.StringBindVariable(session.SessionUUID)
 		case sysvars.SessionEnableSystemSettings.Name:
 			bindVars[key] = sqltypes.BoolBindVariable(session.EnableSystemSettings)
+		case sysvars.ConsistentSnapshot.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.GetConsistentSnapshot())
+		case sysvars.ReadAsOf.Name:
+			bindVars[key] = sqltypes.Int64BindVariable(session.GetReadAsOf())
 		case sysvars.ReadAfterWriteGTID.Name:
 			var v string
 			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
@@ -362,6 +382,9 @@ func (e *Executor) handleBegin(ctx context.Context, safeSession *SafeSession, lo
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	err := e.txConn.Begin(ctx, safeSession)
+	if err == nil && safeSession.GetConsistentSnapshot() {
+		err = e.beginSnapshot(ctx, safeSession)
+	}
 	logStats.ExecuteTime = time.Since(execStart)
 
 	e.updateQueryCounts("Begin", "", "", 0)
@@ -435,6 +458,7 @@ func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession
 // CloseSession releases the current connection, which rollbacks open transactions and closes reserved connections.
 // It is called then the MySQL servers closes the connection to its client.
 func (e *Executor) CloseSession(ctx context.Context, safeSession *SafeSession) error {
+	e.scatterConn.closeSession(safeSession)
 	return e.txConn.ReleaseAll(ctx, safeSession)
 }
 
@@ -835,6 +859,13 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Fields: buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner"),
 			Rows:   rows,
 		}, nil
+	case sqlparser.KeywordString(sqlparser.PROCESSLIST):
+		// The connections of vtgate, unless the query targets a shard:
+		// then it is the processlist of its tablet.
+		if e.connections != nil && dest == nil {
+			full := show.ShowTablesOpt != nil && show.ShowTablesOpt.Full != ""
+			return e.showProcesslist(ctx, full), nil
+		}
 	case sqlparser.KeywordString(sqlparser.WARNINGS):
 		fields := []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar},
@@ -1032,6 +1063,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	if bindVars == nil {
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
+	ctx = withReadAsOf(ctx, safeSession)
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor, _ := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv)
 	vcursor.SetIgnoreMaxMemoryRows(true)
@@ -1048,6 +1080,18 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	case sqlparser.StmtVStream:
 		log.Infof("handleVStream called with target %v", target)
 		return e.handleVStream(ctx, sql, target, callback, vcursor, logStats)
+	case sqlparser.StmtKill:
+		qr, err := e.handleKill(ctx, sql, logStats)
+		if err != nil {
+			return err
+		}
+		return callback(qr)
+	case sqlparser.StmtPurgeResultCache:
+		qr, err := e.handlePurgeResultCache(ctx, sql, logStats)
+		if err != nil {
+			return err
+		}
+		return callback(qr)
 	default:
 		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported statement type for OLAP: %s", stmtType)
 	}
@@ -1531,7 +1575,7 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain, sqlparser.StmtFlush:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain, sqlparser.StmtFlush, sqlparser.StmtKill, sqlparser.StmtPurgeResultCache:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -246,6 +246,15 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set @@enable_system_settings = false",
 		out: &vtgatepb.Session{Autocommit: true, EnableSystemSettings: false},
+	}, {
+		in:  "set @@read_as_of = 600",
+		out: &vtgatepb.Session{Autocommit: true, ReadAsOf: 600},
+	}, {
+		in:  "set @@read_as_of = 0",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set @@read_as_of = -1",
+		err: "invalid read_as_of: -1, it must be a number of seconds",
 	}}
 	for i, tcase := range testcases {
 		t.Run(fmt.Sprintf("%d-%s", i, tcase.in), func(t *testing.T) {
@@ -363,6 +372,73 @@ func TestExecutorSetOp(t *testing.T) {
 	}
 }
 
+func TestExecutorSetNames(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	defer func(saved bool) { *charsetSysVarSetEnabled = saved }(*charsetSysVarSetEnabled)
+
+	charsetResult := func(value ...string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("charset", "varchar"), value...)
+	}
+
+	testcases := []struct {
+		in              string
+		results         []*sqltypes.Result
+		sysVars         map[string]string
+		disallowResConn bool
+		disallowCharset bool
+		wantErr         string
+	}{{
+		in:      "set names utf8",
+		results: []*sqltypes.Result{charsetResult(), charsetResult(), charsetResult()},
+	}, {
+		// Every setting that differs on the tablet is checked, then
+		// applied on the reserved connection.
+		in:      "set names latin1",
+		results: []*sqltypes.Result{charsetResult("latin1"), charsetResult(), charsetResult("latin1"), charsetResult(), charsetResult("latin1"), charsetResult()},
+		sysVars: map[string]string{"character_set_client": "'latin1'", "character_set_connection": "'latin1'", "character_set_results": "'latin1'"},
+	}, {
+		in:      "set character set default",
+		results: []*sqltypes.Result{charsetResult("utf8"), charsetResult(), charsetResult("utf8"), charsetResult()},
+		sysVars: map[string]string{"character_set_client": "'utf8'", "character_set_results": "'utf8'"},
+	}, {
+		in:      "set names abcd",
+		wantErr: "unexpected value for charset/names: abcd",
+	}, {
+		// Without system settings, the character set is only checked
+		// by vtgate.
+		in:              "set names latin1",
+		disallowResConn: true,
+	}, {
+		// Nor without the character set settings.
+		in:              "set names latin1",
+		disallowCharset: true,
+	}, {
+		in:              "set names ascii",
+		disallowCharset: true,
+		wantErr:         "unexpected value for charset/names: ascii",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			*charsetSysVarSetEnabled = !tcase.disallowCharset
+			session := NewAutocommitSession(masterSession)
+			session.TargetString = KsTestUnsharded
+			session.EnableSystemSettings = !tcase.disallowResConn
+			sbclookup.SetResults(tcase.results)
+			sbclookup.Queries = nil
+			_, err := executor.Execute(context.Background(), "TestExecute", session, tcase.in, nil)
+			if tcase.wantErr != "" {
+				require.EqualError(t, err, tcase.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			utils.MustMatch(t, tcase.sysVars, session.SystemVariables, "")
+			if tcase.results == nil {
+				assert.Empty(t, sbclookup.Queries)
+			}
+		})
+	}
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
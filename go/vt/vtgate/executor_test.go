@@ -1178,6 +1178,27 @@ func TestExecutorDDL(t *testing.T) {
 	}
 }
 
+func TestExecutorDDLPartitionWithOnlineStrategy(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	stmts := []string{
+		"alter table user truncate partition p0",
+		"alter table user add partition (partition p3 values less than (3000))",
+		"alter table user drop partition p0",
+	}
+	for _, stmt := range stmts {
+		sbc1.ExecCount.Set(0)
+		sbc2.ExecCount.Set(0)
+		// The partition operations run directly on the shards, in spite of
+		// the online strategy of the session.
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor", DDLStrategy: "gh-ost"})
+		_, err := executor.Execute(ctx, "TestExecute", session, stmt, nil)
+		require.NoError(t, err, stmt)
+		assert.EqualValues(t, 1, sbc1.ExecCount.Get(), stmt)
+		assert.EqualValues(t, 1, sbc2.ExecCount.Get(), stmt)
+	}
+}
+
 func TestExecutorAlterVSchemaKeyspace(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -2009,6 +2030,41 @@ func TestExecutorExplain(t *testing.T) {
 		fmt.Sprintf("%v", result.Rows), fmt.Sprintf("%v", result.Rows))
 }
 
+func TestExecutorVExplain(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	bindVars := map[string]*querypb.BindVariable{}
+
+	result, err := executorExec(executor, "vexplain plan select * from user where id = 1", bindVars)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Contains(t, result.Rows[0][0].ToString(), `"Variant": "SelectEqualUnique"`)
+
+	result, err = executorExec(executor, "vexplain queries select * from user where id = 1", bindVars)
+	require.NoError(t, err)
+	require.Equal(t,
+		`[[INT64(0) VARCHAR("TestExecutor") VARCHAR("-20") VARCHAR("select * from `+"`user`"+` where id = 1")]]`,
+		fmt.Sprintf("%v", result.Rows))
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("PlanId|TableName|Query", "varchar|varchar|varchar"),
+		"Select|user|select * from `user` where id = 1 limit 10001",
+	)})
+	result, err = executorExec(executor, "vexplain all select * from user where id = 1", bindVars)
+	require.NoError(t, err)
+	require.Equal(t,
+		`[[INT64(0) VARCHAR("TestExecutor") VARCHAR("-20") VARCHAR("select * from `+"`user`"+` where id = 1") `+
+			`VARCHAR("Select") VARCHAR("user") VARCHAR("select * from `+"`user`"+` where id = 1 limit 10001")]]`,
+		fmt.Sprintf("%v", result.Rows))
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "vexplain plan select * from `user` where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, wantQueries, sbc1.Queries)
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+}
+
 func TestExecutorOtherAdmin(t *testing.T) {
 	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
 
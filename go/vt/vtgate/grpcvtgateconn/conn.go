@@ -191,7 +191,7 @@ func (conn *vtgateConn) VStream(ctx context.Context, tabletType topodatapb.Table
 		Vgtid:      vgtid,
 		Filter:     filter,
 	}
-	stream, err := conn.c.VStream(ctx, req)
+	stream, err := conn.c.VStream(ctx, req, grpcclient.VStreamCallOptions()...)
 	if err != nil {
 		return nil, vterrors.FromGRPC(err)
 	}
@@ -27,6 +27,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/arrowipc"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/servenv"
@@ -168,13 +169,28 @@ func (vtg *VTGate) StreamExecute(request *vtgatepb.StreamExecuteRequest, stream
 	if session.Options == nil {
 		session.Options = request.Options
 	}
-	vtgErr := vtg.server.StreamExecute(ctx, session, request.Query.Sql, request.Query.BindVariables, func(value *sqltypes.Result) error {
-		// Send is not safe to call concurrently, but vtgate
-		// guarantees that it's not.
+	// Send is not safe to call concurrently, but vtgate
+	// guarantees that it's not.
+	send := func(value *sqltypes.Result) error {
 		return stream.Send(&vtgatepb.StreamExecuteResponse{
 			Result: sqltypes.ResultToProto3(value),
 		})
-	})
+	}
+	var w *arrowipc.Writer
+	if request.ResultFormat == querypb.ResultFormat_ARROW {
+		w = arrowipc.NewWriter()
+		send = func(value *sqltypes.Result) error {
+			data, err := w.Write(value)
+			if err != nil || len(data) == 0 {
+				return err
+			}
+			return stream.Send(&vtgatepb.StreamExecuteResponse{Arrow: data})
+		}
+	}
+	vtgErr := vtg.server.StreamExecute(ctx, session, request.Query.Sql, request.Query.BindVariables, send)
+	if vtgErr == nil && w != nil {
+		vtgErr = stream.Send(&vtgatepb.StreamExecuteResponse{Arrow: w.Close()})
+	}
 	return vterrors.ToGRPC(vtgErr)
 }
 
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// clientConnections are the connections of the clients of the MySQL
+// protocol, by the connection ids vtgate gave them, see
+// plugin_mysql_server.go. The ids are only unique within a vtgate, so
+// KILL and SHOW PROCESSLIST only see the connections of the vtgate that
+// runs them, not the ones of the other vtgates of the cluster.
+type clientConnections interface {
+	// KillQuery cancels the query running on the connection, if any.
+	// The cancellation propagates to the tablets, which kill the query
+	// on their MySQL connections.
+	KillQuery(ctx context.Context, connectionID uint64) error
+
+	// KillConnection cancels the query running on the connection and
+	// closes it, which rolls back its transactions.
+	KillConnection(ctx context.Context, connectionID uint64) error
+
+	// Processlist returns the rows of SHOW PROCESSLIST, see
+	// processlistFields.
+	Processlist(ctx context.Context, full bool) [][]sqltypes.Value
+}
+
+func (e *Executor) handleKill(ctx context.Context, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	kill, ok := stmt.(*sqlparser.Kill)
+	if !ok {
+		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "unexpected statement type")
+	}
+	if e.connections == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_UNIMPLEMENTED, vterrors.NotSupportedYet, "kill is only supported on the connections of the MySQL protocol")
+	}
+
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	switch kill.Type {
+	case sqlparser.QueryType:
+		err = e.connections.KillQuery(ctx, kill.ConnectionID)
+	default:
+		err = e.connections.KillConnection(ctx, kill.ConnectionID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+type fakeClientConnections struct {
+	kills []string
+}
+
+func (f *fakeClientConnections) KillQuery(ctx context.Context, connectionID uint64) error {
+	f.kills = append(f.kills, fmt.Sprintf("query %d", connectionID))
+	return nil
+}
+
+func (f *fakeClientConnections) KillConnection(ctx context.Context, connectionID uint64) error {
+	f.kills = append(f.kills, fmt.Sprintf("connection %d", connectionID))
+	return nil
+}
+
+func (f *fakeClientConnections) Processlist(ctx context.Context, full bool) [][]sqltypes.Value {
+	info := "select 1"
+	if full {
+		info = "select 1 from dual"
+	}
+	return [][]sqltypes.Value{{
+		sqltypes.NewUint64(1),
+		sqltypes.NewVarChar("alice"),
+		sqltypes.NewVarChar("localhost"),
+		sqltypes.NULL,
+		sqltypes.NewVarChar("Query"),
+		sqltypes.NewInt64(0),
+		sqltypes.NewVarChar("executing"),
+		sqltypes.NewVarChar(info),
+		sqltypes.NewVarChar(""),
+	}}
+}
+
+func TestExecutorKill(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	_, err := executor.Execute(ctx, "TestExecutorKill", session, "kill query 3", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_UNIMPLEMENTED, vterrors.Code(err))
+
+	conns := &fakeClientConnections{}
+	executor.connections = conns
+	for _, query := range []string{"kill query 3", "kill connection 4", "kill 5"} {
+		_, err := executor.Execute(ctx, "TestExecutorKill", session, query, nil)
+		require.NoError(t, err, query)
+	}
+	assert.Equal(t, []string{"query 3", "connection 4", "connection 5"}, conns.kills)
+}
+
+func TestVtgateHandlerKill(t *testing.T) {
+	vh := newVtgateHandler(&VTGate{})
+	alice := &mysql.Conn{ConnectionID: 1, User: "alice"}
+	bob := &mysql.Conn{ConnectionID: 2, User: "bob"}
+	vh.connections[alice] = &connectionState{}
+	vh.connections[bob] = &connectionState{}
+
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "", ""), callerid.NewImmediateCallerID("alice"))
+
+	queryCtx, done := vh.startQuery(context.Background(), alice, "select sleep(10)")
+	defer done()
+	require.NoError(t, vh.KillQuery(ctx, 1))
+	assert.Equal(t, context.Canceled, queryCtx.Err())
+
+	// No query is running on the connection of bob.
+	err := vh.KillQuery(ctx, 2)
+	require.EqualError(t, err, "You are not owner of thread 2")
+	assert.Equal(t, mysql.ERKillDenied, mysql.NewSQLErrorFromError(err).(*mysql.SQLError).Number())
+
+	err = vh.KillConnection(ctx, 3)
+	require.EqualError(t, err, "Unknown thread id: 3 on this vtgate")
+	assert.Equal(t, mysql.ERNoSuchThread, mysql.NewSQLErrorFromError(err).(*mysql.SQLError).Number())
+
+	*vschemaacl.AuthorizedDDLUsers = "alice"
+	vschemaacl.Init()
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	require.NoError(t, vh.KillQuery(ctx, 2))
+}
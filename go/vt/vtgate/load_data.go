@@ -0,0 +1,418 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	mysqlAllowLocalInfile     = flag.Bool("mysql_server_allow_local_infile", false, "If set, vtgate executes LOAD DATA LOCAL INFILE statements as batches of inserts, which are routed like any other insert.")
+	mysqlLocalInfileMaxSize   = flag.Int64("mysql_server_local_infile_max_size", 1<<30, "Max size in bytes of a file loaded with LOAD DATA LOCAL INFILE.")
+	mysqlLocalInfileBatchSize = flag.Int("mysql_server_local_infile_batch_size", 500, "Max number of rows inserted by each statement executed for LOAD DATA LOCAL INFILE.")
+
+	loadDataRows  = stats.NewCounter("LoadDataRows", "Rows loaded with LOAD DATA LOCAL INFILE")
+	loadDataBytes = stats.NewCounter("LoadDataBytes", "Bytes loaded with LOAD DATA LOCAL INFILE")
+)
+
+// loadDataProgressInterval is the number of batches between two
+// progress log messages.
+const loadDataProgressInterval = 100
+
+// loadData executes a LOAD DATA LOCAL INFILE statement. The file is
+// streamed from the client and inserted in batches. Unless the session
+// is in a transaction, each batch is committed on its own.
+func (vh *vtgateHandler) loadData(ctx context.Context, c *mysql.Conn, session *vtgatepb.Session, ld *sqlparser.LoadData, callback func(*sqltypes.Result) error) error {
+	file, err := c.RequestLocalInfile(ld.FileName)
+	if err != nil {
+		return err
+	}
+	r := &loadDataLimitReader{r: file, remaining: *mysqlLocalInfileMaxSize}
+	rowsAffected, err := executeLoadData(r, ld, *mysqlLocalInfileBatchSize, func(sql string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		var result *sqltypes.Result
+		var err error
+		session, result, err = vh.vtg.Execute(ctx, session, sql, bindVars)
+		return result, err
+	})
+	// The client must be done sending the file before we reply.
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	loadDataBytes.Add(*mysqlLocalInfileMaxSize - r.remaining)
+	if err != nil {
+		return mysql.NewSQLErrorFromError(err)
+	}
+	fillInTxStatusFlags(c, session)
+	return callback(&sqltypes.Result{RowsAffected: rowsAffected})
+}
+
+// isLoadData returns true if query is a LOAD statement, so that the other
+// queries are not parsed twice.
+func isLoadData(query string) bool {
+	trimmed, _ := sqlparser.SplitMarginComments(query)
+	return len(trimmed) >= 4 && strings.EqualFold(trimmed[:4], "load")
+}
+
+// executeLoadData reads the rows of r as described by ld, and
+// executes them as inserts of at most batchSize rows.
+func executeLoadData(r io.Reader, ld *sqlparser.LoadData, batchSize int, execute func(string, map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (uint64, error) {
+	lr, err := newLoadDataReader(r, ld)
+	if err != nil {
+		return 0, err
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	for i := 0; i < ld.IgnoreLines; i++ {
+		if err := lr.skipLine(); err != nil {
+			if err == io.EOF {
+				return 0, nil
+			}
+			return 0, err
+		}
+	}
+
+	var rowsAffected, rowsRead uint64
+	batches := 0
+	rows := make([][]sqltypes.Value, 0, batchSize)
+	flush := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+		sql, bindVars := loadDataInsert(ld, rows)
+		result, err := execute(sql, bindVars)
+		if err != nil {
+			return vterrors.Wrapf(err, "LOAD DATA LOCAL INFILE failed after %d rows", rowsRead-uint64(len(rows)))
+		}
+		rowsAffected += result.RowsAffected
+		loadDataRows.Add(int64(len(rows)))
+		rows = rows[:0]
+		batches++
+		if batches%loadDataProgressInterval == 0 {
+			log.Infof("LOAD DATA LOCAL INFILE '%s' into %s: %d rows loaded", ld.FileName, sqlparser.String(ld.Table), rowsRead)
+		}
+		return nil
+	}
+	width := len(ld.Columns)
+	for {
+		row, err := lr.readRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return rowsAffected, err
+		}
+		rowsRead++
+		if width == 0 {
+			width = len(row)
+		}
+		if len(row) != width {
+			return rowsAffected, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "LOAD DATA LOCAL INFILE: row %d has %d fields, expected %d", rowsRead, len(row), width)
+		}
+		rows = append(rows, row)
+		if len(rows) >= batchSize {
+			if err := flush(); err != nil {
+				return rowsAffected, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return rowsAffected, err
+	}
+	return rowsAffected, nil
+}
+
+// loadDataInsert builds the insert statement for a batch of rows.
+func loadDataInsert(ld *sqlparser.LoadData, rows [][]sqltypes.Value) (string, map[string]*querypb.BindVariable) {
+	ins := &sqlparser.Insert{
+		Table:   ld.Table,
+		Columns: ld.Columns,
+		// Like MySQL, duplicate keys are ignored unless REPLACE is
+		// specified, because the file can't be rejected halfway.
+		Ignore: sqlparser.Ignore(!ld.Replace),
+	}
+	if ld.Replace {
+		ins.Action = sqlparser.ReplaceAct
+	}
+	bindVars := make(map[string]*querypb.BindVariable)
+	values := make(sqlparser.Values, 0, len(rows))
+	for _, row := range rows {
+		tuple := make(sqlparser.ValTuple, 0, len(row))
+		for _, val := range row {
+			name := fmt.Sprintf("v%d", len(bindVars)+1)
+			bindVars[name] = sqltypes.ValueBindVariable(val)
+			tuple = append(tuple, sqlparser.NewArgument([]byte(":"+name)))
+		}
+		values = append(values, tuple)
+	}
+	ins.Rows = values
+	return sqlparser.String(ins), bindVars
+}
+
+// loadDataLimitReader fails reads once more than remaining bytes
+// have been read.
+type loadDataLimitReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *loadDataLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "LOAD DATA LOCAL INFILE: file exceeds the max size of %d bytes", *mysqlLocalInfileMaxSize)
+	}
+	return n, err
+}
+
+// loadDataReader reads rows in the format described by the FIELDS
+// and LINES options of a LOAD DATA statement.
+type loadDataReader struct {
+	r *bufio.Reader
+
+	fieldsTerminatedBy string
+	linesStartingBy    string
+	linesTerminatedBy  string
+	enclosedBy         byte
+	escapedBy          byte
+}
+
+func newLoadDataReader(r io.Reader, ld *sqlparser.LoadData) (*loadDataReader, error) {
+	if len(ld.FieldsEnclosedBy) > 1 || len(ld.FieldsEscapedBy) > 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "LOAD DATA LOCAL INFILE: field separator argument is not what is expected")
+	}
+	// The values are sent to the tablets as is, in the utf8 character set
+	// of their connections, so the file must already be in that one.
+	switch strings.ToLower(ld.Charset) {
+	case "", "utf8", "utf8mb3", "utf8mb4", "binary":
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: CHARACTER SET %s in LOAD DATA LOCAL INFILE, only utf8, utf8mb4 and binary are supported", ld.Charset)
+	}
+	lr := &loadDataReader{
+		r:                  bufio.NewReader(r),
+		fieldsTerminatedBy: ld.FieldsTerminatedBy,
+		linesStartingBy:    ld.LinesStartingBy,
+		linesTerminatedBy:  ld.LinesTerminatedBy,
+	}
+	if ld.FieldsEnclosedBy != "" {
+		lr.enclosedBy = ld.FieldsEnclosedBy[0]
+	}
+	if ld.FieldsEscapedBy != "" {
+		lr.escapedBy = ld.FieldsEscapedBy[0]
+	}
+	return lr, nil
+}
+
+// consume discards s if it's next in the input, and returns true.
+func (lr *loadDataReader) consume(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	b, err := lr.r.Peek(len(s))
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	if string(b) != s {
+		return false, nil
+	}
+	_, err = lr.r.Discard(len(s))
+	return true, err
+}
+
+// skipLine discards everything up to the next line terminator.
+func (lr *loadDataReader) skipLine() error {
+	if _, err := lr.r.Peek(1); err != nil {
+		return err
+	}
+	for {
+		found, err := lr.consume(lr.linesTerminatedBy)
+		if err != nil || found {
+			return err
+		}
+		if _, err := lr.r.ReadByte(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// skipToLineStart discards everything up to the line prefix. Lines
+// without the prefix are skipped.
+func (lr *loadDataReader) skipToLineStart() error {
+	for {
+		found, err := lr.consume(lr.linesStartingBy)
+		if err != nil || found {
+			return err
+		}
+		if found, err := lr.consume(lr.linesTerminatedBy); err != nil || found {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := lr.r.ReadByte(); err != nil {
+			return err
+		}
+	}
+}
+
+// readRow returns the values of the next line, or io.EOF.
+func (lr *loadDataReader) readRow() ([]sqltypes.Value, error) {
+	if _, err := lr.r.Peek(1); err != nil {
+		return nil, err
+	}
+	if lr.linesStartingBy != "" {
+		if err := lr.skipToLineStart(); err != nil {
+			return nil, err
+		}
+	}
+	var row []sqltypes.Value
+	for {
+		val, lineEnd, err := lr.readField()
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, val)
+		if lineEnd {
+			return row, nil
+		}
+	}
+}
+
+// readField returns the next field, and whether it ended the line.
+func (lr *loadDataReader) readField() (sqltypes.Value, bool, error) {
+	var buf []byte
+	enclosed := false
+	wasEnclosed := false
+	if lr.enclosedBy != 0 {
+		if b, err := lr.r.Peek(1); err == nil && b[0] == lr.enclosedBy {
+			lr.r.Discard(1)
+			enclosed = true
+			wasEnclosed = true
+		}
+	}
+	// isNull is set when the field starts with the escaped N sequence,
+	// and is reset if anything follows it.
+	isNull := false
+	appendByte := func(c byte) {
+		if isNull {
+			isNull = false
+			buf = append(buf, 'N')
+		}
+		buf = append(buf, c)
+	}
+	value := func() sqltypes.Value {
+		switch {
+		case isNull:
+			return sqltypes.NULL
+		case lr.enclosedBy != 0 && !wasEnclosed && string(buf) == "NULL":
+			return sqltypes.NULL
+		}
+		return sqltypes.NewVarChar(string(buf))
+	}
+
+	for {
+		if enclosed {
+			b, err := lr.r.Peek(1)
+			if err == nil && b[0] == lr.enclosedBy {
+				lr.r.Discard(1)
+				// A doubled enclosing character is a literal one.
+				if b, err := lr.r.Peek(1); err == nil && b[0] == lr.enclosedBy {
+					lr.r.Discard(1)
+					appendByte(lr.enclosedBy)
+					continue
+				}
+				enclosed = false
+				continue
+			}
+		} else {
+			found, err := lr.consume(lr.linesTerminatedBy)
+			if err != nil {
+				return sqltypes.Value{}, false, err
+			}
+			if found {
+				return value(), true, nil
+			}
+			found, err = lr.consume(lr.fieldsTerminatedBy)
+			if err != nil {
+				return sqltypes.Value{}, false, err
+			}
+			if found {
+				return value(), false, nil
+			}
+		}
+
+		c, err := lr.r.ReadByte()
+		if err == io.EOF {
+			return value(), true, nil
+		}
+		if err != nil {
+			return sqltypes.Value{}, false, err
+		}
+		if lr.escapedBy == 0 || c != lr.escapedBy {
+			appendByte(c)
+			continue
+		}
+		c, err = lr.r.ReadByte()
+		if err == io.EOF {
+			appendByte(lr.escapedBy)
+			continue
+		}
+		if err != nil {
+			return sqltypes.Value{}, false, err
+		}
+		switch c {
+		case 'N':
+			if len(buf) == 0 && !wasEnclosed && !isNull {
+				isNull = true
+				continue
+			}
+			appendByte('N')
+		case '0':
+			appendByte(0)
+		case 'b':
+			appendByte('\b')
+		case 'n':
+			appendByte('\n')
+		case 'r':
+			appendByte('\r')
+		case 't':
+			appendByte('\t')
+		case 'Z':
+			appendByte(26)
+		default:
+			appendByte(c)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestLoadDataReader(t *testing.T) {
+	testcases := []struct {
+		stmt string
+		file string
+		want [][]sqltypes.Value
+	}{{
+		stmt: "load data local infile 'f' into table t",
+		file: "1\ta\n2\tb\\tc\n3\t\\N\n",
+		want: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("1"), sqltypes.NewVarChar("a")},
+			{sqltypes.NewVarChar("2"), sqltypes.NewVarChar("b\tc")},
+			{sqltypes.NewVarChar("3"), sqltypes.NULL},
+		},
+	}, {
+		stmt: "load data local infile 'f' into table t fields terminated by ',' optionally enclosed by '\"' lines terminated by '\\r\\n'",
+		file: "1,\"a,b\"\r\n2,\"say \"\"hi\"\"\"\r\n3,NULL\r\n4,\"NULL\"",
+		want: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("1"), sqltypes.NewVarChar("a,b")},
+			{sqltypes.NewVarChar("2"), sqltypes.NewVarChar("say \"hi\"")},
+			{sqltypes.NewVarChar("3"), sqltypes.NULL},
+			{sqltypes.NewVarChar("4"), sqltypes.NewVarChar("NULL")},
+		},
+	}, {
+		stmt: "load data local infile 'f' into table t fields terminated by ',' escaped by '' lines starting by 'x:'",
+		file: "skipped\nx:1,a\\b\nfoo x:2,c\n",
+		want: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("1"), sqltypes.NewVarChar("a\\b")},
+			{sqltypes.NewVarChar("2"), sqltypes.NewVarChar("c")},
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.stmt, func(t *testing.T) {
+			lr, err := newLoadDataReader(strings.NewReader(tcase.file), parseLoadData(t, tcase.stmt))
+			require.NoError(t, err)
+			var got [][]sqltypes.Value
+			for {
+				row, err := lr.readRow()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				got = append(got, row)
+			}
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
+
+func TestExecuteLoadData(t *testing.T) {
+	ld := parseLoadData(t, "load data local infile 'f' into table ks.t fields terminated by ',' ignore 1 lines (id, name)")
+
+	var queries []string
+	var bindVars []map[string]*querypb.BindVariable
+	execute := func(sql string, bv map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		queries = append(queries, sql)
+		bindVars = append(bindVars, bv)
+		return &sqltypes.Result{RowsAffected: uint64(len(bv) / 2)}, nil
+	}
+	file := "id,name\n1,a\n2,b\n3,c\n"
+	rowsAffected, err := executeLoadData(strings.NewReader(file), ld, 2, execute)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, rowsAffected)
+	assert.Equal(t, []string{
+		"insert ignore into ks.t(id, `name`) values (:v1, :v2), (:v3, :v4)",
+		"insert ignore into ks.t(id, `name`) values (:v1, :v2)",
+	}, queries)
+	assert.Equal(t, map[string]*querypb.BindVariable{
+		"v1": sqltypes.ValueBindVariable(sqltypes.NewVarChar("3")),
+		"v2": sqltypes.ValueBindVariable(sqltypes.NewVarChar("c")),
+	}, bindVars[1])
+
+	// REPLACE, and a wrong number of fields.
+	ld = parseLoadData(t, "load data local infile 'f' replace into table t fields terminated by ','")
+	queries = nil
+	_, err = executeLoadData(strings.NewReader("1,a\n2\n"), ld, 10, execute)
+	require.EqualError(t, err, "LOAD DATA LOCAL INFILE: row 2 has 1 fields, expected 2")
+	assert.Empty(t, queries)
+	_, err = executeLoadData(strings.NewReader("1,a\n"), ld, 10, execute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"replace into t values (:v1, :v2)"}, queries)
+
+	// Errors report how many rows were loaded.
+	_, err = executeLoadData(strings.NewReader("1,a\n2,b\n3,c\n"), ld, 2, func(sql string, bv map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		if len(bv) == 4 {
+			return &sqltypes.Result{RowsAffected: 2}, nil
+		}
+		return nil, errors.New("duplicate entry")
+	})
+	require.EqualError(t, err, "LOAD DATA LOCAL INFILE failed after 2 rows: duplicate entry")
+}
+
+func TestLoadDataCharset(t *testing.T) {
+	for _, charset := range []string{"utf8", "UTF8MB4", "binary"} {
+		_, err := newLoadDataReader(strings.NewReader(""), parseLoadData(t, "load data local infile 'f' into table t character set "+charset))
+		require.NoError(t, err)
+	}
+	_, err := newLoadDataReader(strings.NewReader(""), parseLoadData(t, "load data local infile 'f' into table t character set latin1"))
+	require.EqualError(t, err, "unsupported: CHARACTER SET latin1 in LOAD DATA LOCAL INFILE, only utf8, utf8mb4 and binary are supported")
+}
+
+func TestLoadDataLimitReader(t *testing.T) {
+	defer func(saved int64) { *mysqlLocalInfileMaxSize = saved }(*mysqlLocalInfileMaxSize)
+	*mysqlLocalInfileMaxSize = 4
+
+	ld := parseLoadData(t, "load data local infile 'f' into table t")
+	r := &loadDataLimitReader{r: strings.NewReader("1\n2\n3\n"), remaining: *mysqlLocalInfileMaxSize}
+	_, err := executeLoadData(r, ld, 10, func(sql string, bv map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		return &sqltypes.Result{}, nil
+	})
+	require.EqualError(t, err, "LOAD DATA LOCAL INFILE: file exceeds the max size of 4 bytes")
+}
+
+func parseLoadData(t *testing.T, sql string) *sqlparser.LoadData {
+	t.Helper()
+	stmt, err := sqlparser.Parse(sql)
+	require.NoError(t, err)
+	ld, ok := stmt.(*sqlparser.LoadData)
+	require.True(t, ok, "%T", stmt)
+	return ld
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestSingletonOnlineDDL(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	ctx := context.Background()
+	ts, err := executor.serv.GetTopoServer()
+	require.NoError(t, err)
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	requests := func() int {
+		entries, err := conn.ListDir(ctx, schema.MigrationRequestsPath(), true)
+		require.NoError(t, err)
+		count := 0
+		for _, entry := range entries {
+			if schema.IsOnlineDDLUUID(entry.Name) {
+				count++
+			}
+		}
+		return count
+	}
+	noMigrations := &sqltypes.Result{}
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+	exec := func(sql string) error {
+		_, err := executor.Execute(ctx, "TestSingletonOnlineDDL", session, sql, nil)
+		return err
+	}
+
+	require.NoError(t, exec("set @@ddl_strategy='gh-ost --singleton'"))
+	sbclookup.SetResults([]*sqltypes.Result{noMigrations})
+	require.NoError(t, exec("alter table t1 engine=innodb"))
+	assert.Equal(t, 1, requests())
+	assert.Equal(t, sqlSelectPendingOnlineDDLs, sbclookup.Queries[len(sbclookup.Queries)-1].Sql)
+
+	// The first request is pending in topo.
+	sbclookup.SetResults([]*sqltypes.Result{noMigrations})
+	err = exec("alter table t1 engine=innodb")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "singleton migration rejected: found pending migration")
+	assert.Equal(t, 1, requests())
+
+	// Migrations of other contexts conflict with --singleton-context,
+	// but not the ones of the session.
+	require.NoError(t, exec("set @@ddl_strategy='gh-ost --singleton-context'"))
+	sbclookup.SetResults([]*sqltypes.Result{noMigrations})
+	require.NoError(t, exec("alter table t1 engine=innodb"))
+	assert.Equal(t, 2, requests())
+
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid|migration_context", "varchar|varchar"),
+		"a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a|vtgate:other",
+	)})
+	err = exec("alter table t1 engine=innodb")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "singleton-context migration rejected: found pending migration a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a in keyspace TestUnsharded, submitted in a different context: vtgate:other")
+	assert.Equal(t, 2, requests())
+
+	// Migrations without the flags are not checked.
+	require.NoError(t, exec("set @@ddl_strategy='gh-ost'"))
+	require.NoError(t, exec("alter table t1 engine=innodb"))
+	assert.Equal(t, 3, requests())
+}
@@ -112,11 +112,29 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		return 0, nil, err
 	}
 
+	if e.readMirror != nil && e.readMirror.shouldMirror(plan, vcursor, safeSession) {
+		e.readMirror.mirror(ctx, e, plan, vcursor, bindVars)
+	}
+
+	if plan.CacheTTL > 0 && e.results != nil && !safeSession.InTransaction() {
+		return e.executeCachedPlan(ctx, plan, vcursor, bindVars, execStart, logStats, safeSession)
+	}
+
 	if plan.Instructions.NeedsTransaction() {
 		return e.insideTransaction(ctx, safeSession, logStats,
 			e.executePlan(ctx, plan, vcursor, bindVars, execStart))
 	}
 
+	if e.mirror != nil {
+		if to := e.mirror.targetKeyspace(plan, vcursor, safeSession); to != "" {
+			stmtType, qr, err := e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+			if err == nil {
+				e.mirror.mirror(ctx, e, to, plan, vcursor, bindVars, qr)
+			}
+			return stmtType, qr, err
+		}
+	}
+
 	return e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
 }
 
@@ -125,6 +143,9 @@ func (e *Executor) startTxIfNecessary(ctx context.Context, safeSession *SafeSess
 		if err := e.txConn.Begin(ctx, safeSession); err != nil {
 			return err
 		}
+		if safeSession.GetConsistentSnapshot() {
+			return e.beginSnapshot(ctx, safeSession)
+		}
 	}
 	return nil
 }
@@ -50,6 +50,7 @@ type ContextVSchema interface {
 	AnyKeyspace() (*vindexes.Keyspace, error)
 	FirstSortedKeyspace() (*vindexes.Keyspace, error)
 	SysVarSetEnabled() bool
+	CharsetSysVarSetEnabled() bool
 	KeyspaceExists(keyspace string) bool
 	AllKeyspace() ([]*vindexes.Keyspace, error)
 	GetSemTable() *semantics.SemTable
@@ -101,11 +102,20 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchem
 	if err != nil {
 		return nil, err
 	}
+	cacheTTL, err := sqlparser.CacheTTLDirective(stmt)
+	if err != nil {
+		return nil, err
+	}
+	if sel, ok := stmt.(*sqlparser.Select); ok && sel.Lock != sqlparser.NoLock {
+		// Locking reads must always reach the database.
+		cacheTTL = 0
+	}
 	plan := &engine.Plan{
 		Type:         sqlparser.ASTToStatementType(stmt),
 		Original:     query,
 		Instructions: instruction,
 		BindVarNeeds: bindVarNeeds,
+		CacheTTL:     cacheTTL,
 	}
 	return plan, nil
 }
@@ -143,7 +153,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 		if err != nil {
 			return nil, err
 		}
-		return buildRoutePlan(stmt, vschema, configuredPlanner(query))
+		return buildRoutePlan(stmt, vschema, withCommonTableExprs(configuredPlanner(query)))
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, vschema, buildInsertPlan)
 	case *sqlparser.Update:
@@ -151,7 +161,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 	case *sqlparser.Delete:
 		return buildRoutePlan(stmt, vschema, buildDeletePlan)
 	case *sqlparser.Union:
-		return buildRoutePlan(stmt, vschema, buildUnionPlan)
+		return buildRoutePlan(stmt, vschema, withCommonTableExprs(buildUnionPlan))
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, vschema)
 	case *sqlparser.AlterVschema:
@@ -166,9 +176,13 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 		return buildSetPlan(stmt, vschema)
 	case *sqlparser.Load:
 		return buildLoadPlan(query, vschema)
+	case *sqlparser.LoadData:
+		// The file is on the client: vtgate can only read it when it
+		// serves the MySQL protocol, see vtgateHandler.ComQuery.
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA LOCAL INFILE, unless vtgate serves the MySQL protocol with mysql_server_allow_local_infile")
 	case sqlparser.DBDDLStatement:
 		return buildRoutePlan(stmt, vschema, buildDBDDLPlan)
-	case *sqlparser.SetTransaction:
+	case *sqlparser.SetTransaction, *sqlparser.Kill, *sqlparser.PurgeResultCache:
 		return nil, ErrPlanNotSupported
 	case *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback, *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
 		// Empty by design. Not executed by a plan
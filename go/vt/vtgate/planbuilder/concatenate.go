@@ -70,8 +70,22 @@ func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNu
 	panic("implement me")
 }
 
+// SupplyWeightString implements the logicalPlan interface.
+// Both sides must return the weight string in the same column, since
+// the rows of the rhs are appended to the ones of the lhs.
 func (c *concatenate) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
-	panic("implement me")
+	lhsCol, err := c.lhs.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	rhsCol, err := c.rhs.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	if lhsCol != rhsCol {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: union with order by on a text column: the weight_string columns of the sources do not match")
+	}
+	return lhsCol, nil
 }
 
 func (c *concatenate) Primitive() engine.Primitive {
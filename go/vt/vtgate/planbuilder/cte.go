@@ -0,0 +1,277 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// withCommonTableExprs wraps a SELECT or UNION planner so that it can plan
+// statements that have WITH clauses.
+// Common table expressions are replaced by derived tables before calling
+// the planner, so that the queries can be merged into a single route, or
+// planned at vtgate like any other derived table.
+// Recursive common table expressions can't be inlined. They are passed
+// through as is, as long as all the tables they use live in the same
+// unsharded keyspace.
+func withCommonTableExprs(f func(sqlparser.Statement, ContextVSchema) (engine.Primitive, error)) func(sqlparser.Statement, ContextVSchema) (engine.Primitive, error) {
+	return func(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
+		hasWith, recursive := analyzeWith(stmt)
+		if !hasWith {
+			return f(stmt, vschema)
+		}
+		if recursive {
+			return buildRecursiveWithPlan(stmt.(sqlparser.SelectStatement), vschema)
+		}
+		if err := inlineCommonTableExprs(stmt); err != nil {
+			return nil, err
+		}
+		return f(stmt, vschema)
+	}
+}
+
+func withClause(node sqlparser.SQLNode) *sqlparser.With {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		return node.With
+	case *sqlparser.Union:
+		return node.With
+	}
+	return nil
+}
+
+// analyzeWith reports if node, or any of its subqueries, has a WITH clause,
+// and if any of them is recursive.
+func analyzeWith(node sqlparser.SQLNode) (hasWith, recursive bool) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if with := withClause(node); with != nil {
+			hasWith = true
+			recursive = recursive || with.Recursive
+		}
+		return true, nil
+	}, node)
+	return hasWith, recursive
+}
+
+// inlineCommonTableExprs replaces all the references to common table
+// expressions in node with derived tables, and removes the WITH clauses.
+func inlineCommonTableExprs(node sqlparser.SQLNode) error {
+	var err error
+	sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		if withClause(cursor.Node()) == nil {
+			return true
+		}
+		err = inlineWith(cursor.Node())
+		return err == nil
+	}, nil)
+	return err
+}
+
+// inlineWith inlines the WITH clause of a SELECT or UNION statement.
+// Each common table expression can be used by the ones that follow it,
+// and by the statement itself.
+func inlineWith(stmt sqlparser.SQLNode) error {
+	with := withClause(stmt)
+	sqlparser.SetWith(stmt.(sqlparser.SelectStatement), nil)
+	for i, cte := range with.CTEs {
+		if len(cte.Columns) != 0 {
+			if err := renameColumns(cte.Subquery.Select, cte.Columns); err != nil {
+				return err
+			}
+		}
+		for _, next := range with.CTEs[i+1:] {
+			if err := replaceCTE(next.Subquery, cte); err != nil {
+				return err
+			}
+		}
+		if err := replaceCTE(stmt, cte); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameColumns sets the aliases of the select expressions of sel to columns.
+// For a UNION, the column names come from its first SELECT.
+func renameColumns(sel sqlparser.SelectStatement, columns sqlparser.Columns) error {
+	switch node := sel.(type) {
+	case *sqlparser.Union:
+		return renameColumns(node.FirstStatement, columns)
+	case *sqlparser.ParenSelect:
+		return renameColumns(node.Select, columns)
+	case *sqlparser.Select:
+		if len(node.SelectExprs) != len(columns) {
+			return errors.New("In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+		}
+		for i, expr := range node.SelectExprs {
+			aliased, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return fmt.Errorf("unsupported: '%s' in common table expression with a column list", sqlparser.String(expr))
+			}
+			aliased.As = columns[i]
+		}
+		return nil
+	}
+	return fmt.Errorf("BUG: unexpected SELECT type: %T", sel)
+}
+
+// replaceCTE replaces the references to cte in node with a copy of its
+// subquery, skipping the subqueries that define their own common table
+// expression with the same name.
+func replaceCTE(node sqlparser.SQLNode, cte *sqlparser.CommonTableExpr) error {
+	var err error
+	sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch n := cursor.Node().(type) {
+		case *sqlparser.Select, *sqlparser.Union:
+			if n != node && definesCTE(withClause(n), cte.Name) {
+				return false
+			}
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := n.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() || tableName.Name != cte.Name {
+				return true
+			}
+			var sel sqlparser.SelectStatement
+			if sel, err = copySelectStatement(cte.Subquery.Select); err != nil {
+				return false
+			}
+			n.Expr = &sqlparser.DerivedTable{Select: sel}
+			if n.As.IsEmpty() {
+				n.As = cte.Name
+			}
+		}
+		return true
+	}, nil)
+	return err
+}
+
+func definesCTE(with *sqlparser.With, name sqlparser.TableIdent) bool {
+	if with == nil {
+		return false
+	}
+	for _, cte := range with.CTEs {
+		if cte.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// copySelectStatement returns a deep copy of sel, so that each reference
+// to a common table expression can be planned independently.
+func copySelectStatement(sel sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+	stmt, err := sqlparser.Parse(sqlparser.String(sel))
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected statement type: %T", stmt)
+	}
+	return cp, nil
+}
+
+// buildRecursiveWithPlan sends a statement with recursive common table
+// expressions as is to the keyspace of its tables, which must be unsharded.
+func buildRecursiveWithPlan(stmt sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	var names []sqlparser.TableIdent
+	var tables []sqlparser.TableName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.CommonTableExpr:
+			names = append(names, node.Name)
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+				tables = append(tables, tableName)
+			}
+		}
+		return true, nil
+	}, stmt)
+
+	var keyspace *vindexes.Keyspace
+	opcode := engine.SelectReference
+	for _, tableName := range tables {
+		if tableName.Qualifier.IsEmpty() && isCTEName(names, tableName.Name) {
+			continue
+		}
+		table, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil {
+			return nil, err
+		}
+		if keyspace != nil && keyspace.Name != table.Keyspace.Name {
+			return nil, errors.New("unsupported: recursive common table expression across keyspaces")
+		}
+		keyspace = table.Keyspace
+		if table.Type == vindexes.TypeReference {
+			continue
+		}
+		if table.Keyspace.Sharded {
+			return nil, errors.New("unsupported: recursive common table expression in sharded keyspace")
+		}
+		opcode = engine.SelectUnsharded
+	}
+	if keyspace == nil {
+		ks, err := vschema.AnyKeyspace()
+		if err != nil {
+			return nil, err
+		}
+		keyspace = ks
+	}
+
+	removeKeyspaceQualifiers(stmt)
+	buf := sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery)
+	buf.Myprintf("%v", stmt)
+	return engine.NewRoute(opcode, keyspace, sqlparser.String(stmt), buf.String()), nil
+}
+
+// removeKeyspaceQualifiers removes the keyspace qualifiers of the tables
+// and columns of stmt: the database of the keyspace on the tablets has
+// a different name.
+func removeKeyspaceQualifiers(stmt sqlparser.SQLNode) {
+	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+				tableName.Qualifier = sqlparser.NewTableIdent("")
+				node.Expr = tableName
+			}
+		case *sqlparser.ColName:
+			node.Qualifier.Qualifier = sqlparser.NewTableIdent("")
+		}
+		return true
+	}, nil)
+}
+
+func isCTEName(names []sqlparser.TableIdent, name sqlparser.TableIdent) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -38,6 +38,8 @@ func buildExplainPlan(stmt sqlparser.Explain, vschema ContextVSchema) (engine.Pr
 			return buildVitessTypePlan(explain, vschema)
 		}
 		return buildOtherReadAndAdmin(sqlparser.String(explain), vschema)
+	case *sqlparser.VExplainStmt:
+		return buildVExplainPlan(explain, vschema)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG unexpected explain type: %T", stmt)
 }
@@ -101,6 +103,14 @@ func buildVitessTypePlan(explain *sqlparser.ExplainStmt, vschema ContextVSchema)
 	return engine.NewRowsPrimitive(rows, fields), nil
 }
 
+func buildVExplainPlan(vexplain *sqlparser.VExplainStmt, vschema ContextVSchema) (engine.Primitive, error) {
+	input, err := createInstructionFor(sqlparser.String(vexplain.Statement), vexplain.Statement, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.VExplain{Input: input, Type: vexplain.Type}, nil
+}
+
 func extractQuery(m map[string]interface{}) string {
 	queryObj, ok := m["Query"]
 	if !ok {
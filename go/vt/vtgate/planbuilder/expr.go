@@ -149,7 +149,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			return nil, nil, nil, &correlatedSubqueryError{subquery: sqi.ast, plan: sqi.plan}
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
@@ -208,6 +208,19 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 	return pullouts, highestOrigin, expr, nil
 }
 
+// correlatedSubqueryError is returned by findOrigin for a correlated
+// subquery that can't be merged with the route it references.
+// It carries the plan of the subquery, so that the caller can
+// still execute it as the RHS of a semi-join.
+type correlatedSubqueryError struct {
+	subquery *sqlparser.Subquery
+	plan     logicalPlan
+}
+
+func (err *correlatedSubqueryError) Error() string {
+	return "unsupported: cross-shard correlated subquery"
+}
+
 func hasSubquery(node sqlparser.SQLNode) bool {
 	has := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
@@ -53,6 +53,15 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 		}
 		return node, nil
 
+	case *semiJoin:
+		// The subquery can't be referenced by the outer query,
+		// so the filter can only go to the left side.
+		filtered, err := planFilter(pb, node.Left, filter, whereType, origin)
+		if err != nil {
+			return nil, err
+		}
+		node.Left = filtered
+		return node, nil
 	case *route:
 		sel := node.Select.(*sqlparser.Select)
 		switch whereType {
@@ -56,8 +56,24 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs, w
 	if err := pb.processTableExpr(tableExprs[0], where); err != nil {
 		return err
 	}
+	// A JSON_TABLE reads its input from the tables that precede it,
+	// so it has to be pushed into the route built so far.
+	rest := tableExprs[1:]
+	for len(rest) > 0 {
+		jsonTable, ok := rest[0].(*sqlparser.JSONTableExpr)
+		if !ok {
+			break
+		}
+		if err := pb.pushJSONTable(jsonTable, nil); err != nil {
+			return err
+		}
+		rest = rest[1:]
+	}
+	if len(rest) == 0 {
+		return nil
+	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
-	if err := rpb.processTableExprs(tableExprs[1:], where); err != nil {
+	if err := rpb.processTableExprs(rest, where); err != nil {
 		return err
 	}
 	return pb.join(rpb, nil, where)
@@ -84,10 +100,94 @@ func (pb *primitiveBuilder) processTableExpr(tableExpr sqlparser.TableExpr, wher
 		return err
 	case *sqlparser.JoinTableExpr:
 		return pb.processJoin(tableExpr, where)
+	case *sqlparser.JSONTableExpr:
+		return pb.processJSONTable(tableExpr)
 	}
 	return fmt.Errorf("BUG: unexpected table expression type: %T", tableExpr)
 }
 
+// processJSONTable produces a route for a JSON_TABLE that doesn't read
+// from any other table. Such a route can be sent to any keyspace.
+func (pb *primitiveBuilder) processJSONTable(jsonTable *sqlparser.JSONTableExpr) error {
+	ks, err := pb.vschema.AnyKeyspace()
+	if err != nil {
+		return err
+	}
+	rb, st := newRoute(&sqlparser.Select{From: sqlparser.TableExprs{jsonTable}})
+	rb.eroute = engine.NewSimpleRoute(engine.SelectReference, ks)
+	pb.plan, pb.st = rb, st
+	return pb.addJSONTable(rb, jsonTable)
+}
+
+// pushJSONTable pushes a JSON_TABLE into the current route. If ajoin
+// is not nil, the JSON_TABLE is the right side of that join.
+func (pb *primitiveBuilder) pushJSONTable(jsonTable *sqlparser.JSONTableExpr, ajoin *sqlparser.JoinTableExpr) error {
+	rb, ok := pb.plan.(*route)
+	if !ok {
+		return errors.New("unsupported: JSON_TABLE on a cross-shard join")
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected AST struct for query: %s", sqlparser.String(rb.Select))
+	}
+	if err := pb.addJSONTable(rb, jsonTable); err != nil {
+		return err
+	}
+	if ajoin == nil {
+		sel.From = append(sel.From, jsonTable)
+		return nil
+	}
+	sel.From = sqlparser.TableExprs{ajoin}
+	pullouts, _, expr, err := pb.findOrigin(ajoin.Condition.On)
+	if err != nil {
+		return err
+	}
+	ajoin.Condition.On = expr
+	pb.addPullouts(pullouts)
+	for _, filter := range splitAndExpression(nil, ajoin.Condition.On) {
+		rb.UpdatePlan(pb, filter)
+	}
+	return nil
+}
+
+// addJSONTable resolves the input expression of the JSON_TABLE, and adds
+// it to the symtab as an authoritative table with the columns it defines.
+func (pb *primitiveBuilder) addJSONTable(rb *route, jsonTable *sqlparser.JSONTableExpr) error {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if _, _, err := pb.st.Find(node); err != nil {
+				return false, err
+			}
+		case *sqlparser.Subquery:
+			return false, errors.New("unsupported: subquery in JSON_TABLE")
+		}
+		return true, nil
+	}, jsonTable.Expr)
+	if err != nil {
+		return err
+	}
+	t := &table{
+		alias:           sqlparser.TableName{Name: jsonTable.Alias},
+		origin:          rb,
+		isAuthoritative: true,
+	}
+	addJSONTableColumns(t, jsonTable.Columns, rb, pb.st)
+	return pb.st.AddTable(t)
+}
+
+// addJSONTableColumns adds the columns of a JSON_TABLE to t. The columns
+// of NESTED PATH clauses belong to the same table.
+func addJSONTableColumns(t *table, columns sqlparser.JSONTableColumns, rb *route, st *symtab) {
+	for _, col := range columns {
+		if col.Type == sqlparser.JSONTableNestedType {
+			addJSONTableColumns(t, col.Columns, rb, st)
+			continue
+		}
+		t.addColumn(col.Name, &column{origin: rb, st: st})
+	}
+}
+
 // processAliasedTable produces a logicalPlan subtree for the given AliasedTableExpr.
 // If the expression is a subquery, then the primitive will create a table
 // for it in the symtab. If the subquery is a route, then we build a route
@@ -279,6 +379,9 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr, where sq
 	if err := pb.processTableExpr(ajoin.LeftExpr, where); err != nil {
 		return err
 	}
+	if jsonTable, ok := ajoin.RightExpr.(*sqlparser.JSONTableExpr); ok {
+		return pb.pushJSONTable(jsonTable, ajoin)
+	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
 	if err := rpb.processTableExpr(ajoin.RightExpr, where); err != nil {
 		return err
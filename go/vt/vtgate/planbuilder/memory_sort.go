@@ -110,6 +110,9 @@ func (ms *memorySort) SetLimit(limit *sqlparser.Limit) error {
 func (ms *memorySort) Wireup(plan logicalPlan, jt *jointab) error {
 	for i, orderby := range ms.eMemorySort.OrderBy {
 		rc := ms.resultColumns[orderby.Col]
+		if rc.column.typ == sqltypes.TypeJSON {
+			return errJSONComparison
+		}
 		if sqltypes.IsText(rc.column.typ) {
 			// If a weight string was previously requested, reuse it.
 			if weightcolNumber, ok := ms.weightStrings[rc]; ok {
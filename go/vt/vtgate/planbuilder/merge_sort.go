@@ -66,6 +66,9 @@ func (ms *mergeSort) Wireup(plan logicalPlan, jt *jointab) error {
 	rb := ms.input.(*route)
 	for i, orderby := range rb.eroute.OrderBy {
 		rc := ms.resultColumns[orderby.Col]
+		if rc.column.typ == sqltypes.TypeJSON {
+			return errJSONComparison
+		}
 		if sqltypes.IsText(rc.column.typ) {
 			// If a weight string was previously requested, reuse it.
 			if colNumber, ok := ms.weightStrings[rc]; ok {
@@ -57,7 +57,7 @@ var _ logicalPlan = (*orderedAggregate)(nil)
 //    }
 type orderedAggregate struct {
 	resultsBuilder
-	extraDistinct *sqlparser.ColName
+	extraDistinct sqlparser.Expr
 	eaggr         *engine.OrderedAggregate
 }
 
@@ -73,6 +73,12 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 		return nil
 	}
 
+	// Window functions are computed by MySQL over the rows it has,
+	// so they can only be pushed down to a single shard.
+	if nodeHasWindowFunctions(sel) {
+		return errors.New("unsupported: window functions in cross-shard query")
+	}
+
 	// Check if we can allow aggregates.
 	hasAggregates := nodeHasAggregates(sel.SelectExprs) || len(sel.GroupBy) > 0
 
@@ -155,6 +161,22 @@ func nodeHasAggregates(node sqlparser.SQLNode) bool {
 	return hasAggregates
 }
 
+func nodeHasWindowFunctions(node sqlparser.SQLNode) bool {
+	hasWindowFunctions := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node.(type) {
+		case *sqlparser.OverClause, *sqlparser.WindowDefinition:
+			hasWindowFunctions = true
+			return false, errors.New("unused error")
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return hasWindowFunctions
+}
+
 // groupbyHasUniqueVindex looks ahead at the group by expression to see if
 // it references a unique vindex.
 //
@@ -257,7 +279,7 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 			return nil, 0, err
 		}
 		pb.plan = newBuilder
-		col, err := BuildColName(oa.input.ResultColumns(), innerCol)
+		col, err := buildColRef(oa.input.ResultColumns(), innerCol)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -333,6 +355,9 @@ func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr
 func (oa *orderedAggregate) Wireup(plan logicalPlan, jt *jointab) error {
 	for i, colNumber := range oa.eaggr.Keys {
 		rc := oa.resultColumns[colNumber]
+		if rc.column.typ == sqltypes.TypeJSON {
+			return errJSONComparison
+		}
 		if sqltypes.IsText(rc.column.typ) {
 			if weightcolNumber, ok := oa.weightStrings[rc]; ok {
 				oa.eaggr.Keys[i] = weightcolNumber
@@ -24,7 +24,7 @@ import (
 
 func planOrdering(pb *primitiveBuilder, input logicalPlan, orderBy sqlparser.OrderBy) (logicalPlan, error) {
 	switch node := input.(type) {
-	case *subquery, *vindexFunc:
+	case *subquery, *vindexFunc, *concatenate:
 		if len(orderBy) == 0 {
 			return node, nil
 		}
@@ -41,6 +41,14 @@ func planOrdering(pb *primitiveBuilder, input logicalPlan, orderBy sqlparser.Ord
 		}
 		node.underlying = plan
 		return node, nil
+	case *semiJoin:
+		// The semi-join returns the rows of its left side in order.
+		plan, err := planOrdering(pb, node.Left, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		node.Left = plan
+		return node, nil
 	case *route:
 		return planRouteOrdering(orderBy, node)
 	case *join:
@@ -124,7 +132,7 @@ func planOAOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, oa *ordered
 			continue
 		}
 		// Build a brand new reference for the key.
-		col, err := BuildColName(oa.input.ResultColumns(), key)
+		col, err := buildColRef(oa.input.ResultColumns(), key)
 		if err != nil {
 			return nil, vterrors.Wrapf(err, "generating order by clause")
 		}
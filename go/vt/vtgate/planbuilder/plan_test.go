@@ -322,6 +322,10 @@ func (vw *vschemaWrapper) SysVarSetEnabled() bool {
 	return vw.sysVarEnabled
 }
 
+func (vw *vschemaWrapper) CharsetSysVarSetEnabled() bool {
+	return vw.sysVarEnabled
+}
+
 func (vw *vschemaWrapper) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
 	var keyspaceName string
 	if vw.keyspace != nil {
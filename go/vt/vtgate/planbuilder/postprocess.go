@@ -124,6 +124,11 @@ func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 		node.Select.SetLimit(&sqlparser.Limit{Rowcount: arg})
 	case *concatenate:
 		return false, node, nil
+	case *distinct, *semiJoin:
+		// The rows removed by these primitives don't count towards
+		// the limit, so the primitives below them must return all
+		// their rows.
+		return false, node, nil
 	}
 	return true, plan, nil
 }
@@ -122,6 +122,15 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 			return nil, nil, 0, err
 		}
 		return node, rc, idx, nil
+	case *semiJoin:
+		newLeft, rc, colNumber, err := planProjection(pb, node.Left, expr, origin)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		node.Left = newLeft
+		node.esemiJoin.Cols = append(node.esemiJoin.Cols, colNumber)
+		node.resultColumns = append(node.resultColumns, rc)
+		return node, rc, len(node.resultColumns) - 1, nil
 	case *pulloutSubquery:
 		projectedInput, rc, idx, err := planProjection(pb, node.underlying, expr, origin)
 		if err != nil {
@@ -190,6 +190,8 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 					node.Left, node.Right = node.Right, node.Left
 				}
 			}
+		case *sqlparser.FuncExpr:
+			rb.removeKeyspaceFromFunc(node)
 		}
 		return true, nil
 	}, rb.Select)
@@ -247,11 +249,25 @@ func (rb *route) prepareTheAST() {
 				name {
 				node.Left, node.Right = node.Right, node.Left
 			}
+		case *sqlparser.FuncExpr:
+			rb.removeKeyspaceFromFunc(node)
 		}
 		return true, nil
 	}, rb.Select)
 }
 
+// removeKeyspaceFromFunc removes the qualifier of a stored function
+// invoked as keyspace.func(), when it names the keyspace of the route:
+// the database of the keyspace on the tablets has a different name.
+func (rb *route) removeKeyspaceFromFunc(node *sqlparser.FuncExpr) {
+	if node.Qualifier.IsEmpty() || rb.eroute.Keyspace == nil {
+		return
+	}
+	if node.Qualifier.String() == rb.eroute.Keyspace.Name {
+		node.Qualifier = sqlparser.NewTableIdent("")
+	}
+}
+
 // procureValues procures and converts the input into
 // the expected types for rb.Values.
 func (rb *route) procureValues(plan logicalPlan, jt *jointab, val sqlparser.Expr) (sqltypes.PlanValue, error) {
@@ -597,9 +613,18 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
+	case engine.SelectRange:
+		switch opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual:
+			rb.updateRoute(opcode, vindex, values)
+		case engine.SelectRange:
+			if vindex.Cost() < rb.eroute.Vindex.Cost() {
+				rb.updateRoute(opcode, vindex, values)
+			}
+		}
 	case engine.SelectScatter:
 		switch opcode {
-		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectNone:
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectRange, engine.SelectNone:
 			rb.updateRoute(opcode, vindex, values)
 		}
 	}
@@ -625,10 +650,31 @@ func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcod
 		}
 	case *sqlparser.IsExpr:
 		return rb.computeISPlan(pb, node)
+	case *sqlparser.RangeCond:
+		if node.Operator == sqlparser.BetweenOp {
+			return rb.computeRangePlan(pb, node)
+		}
 	}
 	return engine.SelectScatter, nil, nil
 }
 
+// computeRangePlan computes the plan for a BETWEEN constraint.
+// Only order preserving vindexes can narrow down the shards
+// for a range.
+func (rb *route) computeRangePlan(pb *primitiveBuilder, rangeCond *sqlparser.RangeCond) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+	vindex = pb.st.Vindex(rangeCond.Left, rb)
+	if _, ok := vindex.(vindexes.OrderPreserving); !ok {
+		return engine.SelectScatter, nil, nil
+	}
+	if sqlparser.IsNull(rangeCond.From) || sqlparser.IsNull(rangeCond.To) {
+		return engine.SelectNone, nil, nil
+	}
+	if !rb.exprIsValue(rangeCond.From) || !rb.exprIsValue(rangeCond.To) {
+		return engine.SelectScatter, nil, nil
+	}
+	return engine.SelectRange, vindex, sqlparser.ValTuple{rangeCond.From, rangeCond.To}
+}
+
 // computeEqualPlan computes the plan for an equality constraint.
 func (rb *route) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 	left := comparison.Left
@@ -44,16 +44,17 @@ For easy reference, opcodes are:
 
 func TestJoinCanMerge(t *testing.T) {
 	testcases := [engine.NumRouteOpcodes][engine.NumRouteOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false},
-		{false, true, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, true, true, false},
-		{true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false},
+		{true, false, false, false, false, false, false, false, true, false, false},
+		{false, true, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, true, true, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -81,16 +82,17 @@ func TestJoinCanMerge(t *testing.T) {
 
 func TestSubqueryCanMerge(t *testing.T) {
 	testcases := [engine.NumRouteOpcodes][engine.NumRouteOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, true, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
-		{false, false, false, false, false, false, false, false, true, false},
+		{true, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, true, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
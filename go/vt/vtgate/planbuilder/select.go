@@ -272,6 +272,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab,
 	if err := pb.pushLimit(sel.Limit); err != nil {
 		return err
 	}
+	if err := pb.st.ResolveSymbols(sel.Windows); err != nil {
+		return err
+	}
 
 	return setMiscFunc(pb.plan, sel)
 }
@@ -286,6 +289,8 @@ func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
 			}
 			query.Comments = sel.Comments
 			query.Lock = sel.Lock
+			// Only single shard routes can get here with a WINDOW clause.
+			query.Windows = sel.Windows
 			if sel.Into != nil {
 				if node.eroute.Opcode != engine.SelectUnsharded {
 					return false, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: this construct is not supported on sharded keyspace")
@@ -428,6 +433,12 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	reorderBySubquery(filters)
 	for _, filter := range filters {
 		pullouts, origin, expr, err := pb.findOrigin(filter)
+		if correlated, ok := err.(*correlatedSubqueryError); ok && whereType == sqlparser.WhereStr {
+			if err := pb.pushSemiJoin(filter, correlated); err != nil {
+				return err
+			}
+			continue
+		}
 		if err != nil {
 			return err
 		}
@@ -450,6 +461,24 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	return nil
 }
 
+// pushSemiJoin plans a correlated EXISTS or NOT EXISTS filter that can't
+// be merged with the outer query as a semi-join, which executes the
+// subquery for every row of the outer query.
+func (pb *primitiveBuilder) pushSemiJoin(filter sqlparser.Expr, correlated *correlatedSubqueryError) error {
+	opcode := engine.SemiJoinExists
+	if not, ok := filter.(*sqlparser.NotExpr); ok {
+		opcode = engine.SemiJoinNotExists
+		filter = not.Expr
+	}
+	exists, ok := filter.(*sqlparser.ExistsExpr)
+	if !ok || exists.Subquery != correlated.subquery {
+		return correlated
+	}
+	pb.plan = newSemiJoin(pb.plan, correlated.plan, opcode)
+	pb.plan.Reorder(0)
+	return nil
+}
+
 // reorderBySubquery reorders the filters by pushing subqueries
 // to the end. This allows the non-subquery filters to be
 // pushed first because they can potentially improve the routing
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*semiJoin)(nil)
+
+// semiJoin is the logicalPlan for engine.SemiJoin.
+// This gets built for a correlated EXISTS or NOT EXISTS
+// subquery in the WHERE clause that can't be merged with
+// the route it references. The subquery is the RHS, and
+// gets executed for every row of the LHS, with the
+// referenced columns supplied as join vars.
+type semiJoin struct {
+	order         int
+	resultColumns []*resultColumn
+	weightStrings map[*resultColumn]int
+
+	// leftOrder stores the order number of the left node.
+	// See join for how it's used.
+	leftOrder int
+
+	// Left is the outer query, and Right is the subquery.
+	Left, Right logicalPlan
+
+	esemiJoin *engine.SemiJoin
+}
+
+// newSemiJoin builds a semiJoin that returns the rows of left
+// for which right returns rows (or doesn't, for SemiJoinNotExists).
+func newSemiJoin(left, right logicalPlan, opcode engine.SemiJoinOpcode) *semiJoin {
+	sj := &semiJoin{
+		weightStrings: make(map[*resultColumn]int),
+		Left:          left,
+		Right:         right,
+		esemiJoin: &engine.SemiJoin{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+	for i, rc := range left.ResultColumns() {
+		sj.resultColumns = append(sj.resultColumns, rc)
+		sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, i)
+	}
+	return sj
+}
+
+// Order implements the logicalPlan interface
+func (sj *semiJoin) Order() int {
+	return sj.order
+}
+
+// Reorder implements the logicalPlan interface
+func (sj *semiJoin) Reorder(order int) {
+	sj.Left.Reorder(order)
+	sj.leftOrder = sj.Left.Order()
+	sj.Right.Reorder(sj.leftOrder)
+	sj.order = sj.Right.Order() + 1
+}
+
+// Primitive implements the logicalPlan interface
+func (sj *semiJoin) Primitive() engine.Primitive {
+	sj.esemiJoin.Left = sj.Left.Primitive()
+	sj.esemiJoin.Right = sj.Right.Primitive()
+	return sj.esemiJoin
+}
+
+// ResultColumns implements the logicalPlan interface
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	return sj.resultColumns
+}
+
+// Wireup implements the logicalPlan interface
+func (sj *semiJoin) Wireup(plan logicalPlan, jt *jointab) error {
+	err := sj.Right.Wireup(plan, jt)
+	if err != nil {
+		return err
+	}
+	return sj.Left.Wireup(plan, jt)
+}
+
+// WireupV4 implements the logicalPlan interface
+func (sj *semiJoin) WireupV4(semTable *semantics.SemTable) error {
+	err := sj.Right.WireupV4(semTable)
+	if err != nil {
+		return err
+	}
+	return sj.Left.WireupV4(semTable)
+}
+
+// SupplyVar implements the logicalPlan interface
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if !sj.isOnLeft(from) {
+		sj.Right.SupplyVar(from, to, col, varname)
+		return
+	}
+	if sj.isOnLeft(to) {
+		sj.Left.SupplyVar(from, to, col, varname)
+		return
+	}
+	if _, ok := sj.esemiJoin.Vars[varname]; ok {
+		// Looks like somebody else already requested this.
+		return
+	}
+	_, sj.esemiJoin.Vars[varname] = sj.Left.SupplyCol(col)
+}
+
+// SupplyCol implements the logicalPlan interface
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range sj.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+
+	rc, sourceCol := sj.Left.SupplyCol(col)
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (sj *semiJoin) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	rc := sj.resultColumns[colNumber]
+	if weightcolNumber, ok := sj.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	sourceCol, err := sj.Left.SupplyWeightString(sj.esemiJoin.Cols[colNumber])
+	if err != nil {
+		return 0, err
+	}
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	sj.weightStrings[rc] = len(sj.resultColumns) - 1
+	return len(sj.resultColumns) - 1, nil
+}
+
+// Rewrite implements the logicalPlan interface
+func (sj *semiJoin) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "semiJoin: wrong number of inputs")
+	}
+	sj.Left = inputs[0]
+	sj.Right = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (sj *semiJoin) ContainsTables() semantics.TableSet {
+	return sj.Left.ContainsTables().Merge(sj.Right.ContainsTables())
+}
+
+// Inputs implements the logicalPlan interface
+func (sj *semiJoin) Inputs() []logicalPlan {
+	return []logicalPlan{sj.Left, sj.Right}
+}
+
+// isOnLeft returns true if the specified route number
+// is on the left side of the semi-join.
+func (sj *semiJoin) isOnLeft(nodeNum int) bool {
+	return nodeNum <= sj.leftOrder
+}
@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"strings"
 
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sysvars"
+
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -77,15 +80,24 @@ func buildSetPlan(stmt *sqlparser.Set, vschema ContextVSchema) (engine.Primitive
 			}
 			setOps = append(setOps, setOp)
 		case sqlparser.SessionScope:
-			planFunc, ok := sysVarPlanningFunc[expr.Name.Lowered()]
-			if !ok {
-				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct in set: %s", sqlparser.String(expr))
+			exprs := []*sqlparser.SetExpr{expr}
+			if vschema.SysVarSetEnabled() && vschema.CharsetSysVarSetEnabled() {
+				var err error
+				if exprs, err = expandCharsetSetting(expr); err != nil {
+					return nil, err
+				}
 			}
-			setOp, err := planFunc(expr, vschema, ec)
-			if err != nil {
-				return nil, err
+			for _, expr := range exprs {
+				planFunc, ok := sysVarPlanningFunc[expr.Name.Lowered()]
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct in set: %s", sqlparser.String(expr))
+				}
+				setOp, err := planFunc(expr, vschema, ec)
+				if err != nil {
+					return nil, err
+				}
+				setOps = append(setOps, setOp)
 			}
-			setOps = append(setOps, setOp)
 		default:
 			return nil, ErrPlanNotSupported
 		}
@@ -102,6 +114,49 @@ func buildSetPlan(stmt *sqlparser.Set, vschema ContextVSchema) (engine.Primitive
 	}, nil
 }
 
+// charsetVariables are the system variables changed by SET NAMES and
+// SET CHARACTER SET.
+var charsetVariables = map[string][]string{
+	sysvars.Names.Name:   {"character_set_client", "character_set_connection", "character_set_results"},
+	sysvars.Charset.Name: {"character_set_client", "character_set_results"},
+}
+
+// expandCharsetSetting rewrites SET NAMES and SET CHARACTER SET into the
+// settings of the character set variables they change, so that they are
+// applied on the tablet connections when they differ there. It is only
+// used when the character set settings are enabled: otherwise SET NAMES
+// and SET CHARACTER SET only check the character set, without a round
+// trip to the tablets. The other settings are returned as is.
+func expandCharsetSetting(expr *sqlparser.SetExpr) ([]*sqlparser.SetExpr, error) {
+	variables, ok := charsetVariables[expr.Name.Lowered()]
+	if !ok {
+		return []*sqlparser.SetExpr{expr}, nil
+	}
+	var charset string
+	switch node := expr.Expr.(type) {
+	case *sqlparser.Default:
+		charset = "utf8"
+	case *sqlparser.Literal:
+		charset = strings.ToLower(string(node.Val))
+	case *sqlparser.ColName:
+		charset = node.Name.Lowered()
+	default:
+		charset = sqlparser.String(node)
+	}
+	if _, ok := mysql.CharacterSetMap[charset]; !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for charset/names: %v", charset)
+	}
+	exprs := make([]*sqlparser.SetExpr, 0, len(variables))
+	for _, variable := range variables {
+		exprs = append(exprs, &sqlparser.SetExpr{
+			Scope: sqlparser.SessionScope,
+			Name:  sqlparser.NewColIdent(variable),
+			Expr:  sqlparser.NewStrLiteral([]byte(charset)),
+		})
+	}
+	return exprs, nil
+}
+
 func buildNotSupported(setting) planFunc {
 	return func(expr *sqlparser.SetExpr, schema ContextVSchema, _ *expressionConverter) (engine.SetOp, error) {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s: system setting is not supported", expr.Name)
@@ -145,9 +200,18 @@ func planSysVarCheckIgnore(expr *sqlparser.SetExpr, schema ContextVSchema, boole
 	}, nil
 }
 
+// charsetSysVars are the character set variables, which are only applied
+// on reserved connections if the character set settings are enabled.
+var charsetSysVars = map[string]bool{
+	"character_set_client":     true,
+	"character_set_connection": true,
+	"character_set_results":    true,
+	"collation_connection":     true,
+}
+
 func buildSetOpReservedConn(s setting) planFunc {
 	return func(expr *sqlparser.SetExpr, vschema ContextVSchema, _ *expressionConverter) (engine.SetOp, error) {
-		if !vschema.SysVarSetEnabled() {
+		if !vschema.SysVarSetEnabled() || (charsetSysVars[expr.Name.Lowered()] && !vschema.CharsetSysVarSetEnabled()) {
 			return planSysVarCheckIgnore(expr, vschema, s.boolean)
 		}
 		ks, err := vschema.AnyKeyspace()
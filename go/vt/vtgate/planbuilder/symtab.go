@@ -22,10 +22,13 @@ import (
 	"strconv"
 	"strings"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var errNoTable = errors.New("no table info")
@@ -216,11 +219,39 @@ func (st *symtab) FindTable(tname sqlparser.TableName) (*table, error) {
 	}
 	t, ok := st.tables[tname]
 	if !ok {
-		return nil, fmt.Errorf("table %v not found", sqlparser.String(tname))
+		var err error
+		t, err = st.findKeyspaceTable(tname)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			return nil, fmt.Errorf("table %v not found", sqlparser.String(tname))
+		}
 	}
 	return t, nil
 }
 
+// findKeyspaceTable finds the table that an unqualified table name
+// refers to when the table was added with a keyspace qualifier,
+// like t for ks.t. MySQL allows these references as long as they
+// are unambiguous. It returns nil if there's no such table.
+func (st *symtab) findKeyspaceTable(tname sqlparser.TableName) (*table, error) {
+	if !tname.Qualifier.IsEmpty() {
+		return nil, nil
+	}
+	var found *table
+	for _, alias := range st.tableNames {
+		if alias.Qualifier.IsEmpty() || alias.Name != tname.Name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("ambiguous table reference: %s", sqlparser.String(tname))
+		}
+		found = st.tables[alias]
+	}
+	return found, nil
+}
+
 // SetResultColumns sets the result columns.
 func (st *symtab) SetResultColumns(rcs []*resultColumn) {
 	for _, rc := range rcs {
@@ -354,7 +385,11 @@ func (st *symtab) searchTables(col *sqlparser.ColName) (*column, error) {
 		var ok bool
 		t, ok = st.tables[col.Qualifier]
 		if !ok {
-			return nil, nil
+			var err error
+			t, err = st.findKeyspaceTable(col.Qualifier)
+			if t == nil || err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -432,6 +467,17 @@ func BuildColName(rcs []*resultColumn, index int) (*sqlparser.ColName, error) {
 	}, nil
 }
 
+// buildColRef builds a reference to the resultColumn specified by the
+// index, for use in the GROUP BY or ORDER BY of the query sent to the
+// route. Complex expressions have no name to reference, so they are
+// referenced by their column number instead.
+func buildColRef(rcs []*resultColumn, index int) (sqlparser.Expr, error) {
+	if rcs[index].alias.IsEmpty() {
+		return sqlparser.NewIntLiteral([]byte(strconv.Itoa(index + 1))), nil
+	}
+	return BuildColName(rcs, index)
+}
+
 // ResolveSymbols resolves all column references against symtab.
 // This makes sure that they all have their Metadata initialized.
 // If a symbol cannot be resolved or if the expression contains
@@ -566,7 +612,28 @@ func newResultColumn(expr *sqlparser.AliasedExpr, origin logicalPlan) *resultCol
 		// Just to be safe, generate an anonymous column for the expression.
 		rc.column = &column{
 			origin: origin,
+			typ:    jsonOperatorType(expr.Expr),
 		}
 	}
 	return rc
 }
+
+// jsonOperatorType returns the type of the result of the JSON -> and ->>
+// operators, or Null for other expressions. The ->> operator returns
+// text, which is compared with its weight_string like the text columns.
+// The -> operator returns JSON, which vtgate cannot compare like MySQL.
+func jsonOperatorType(expr sqlparser.Expr) querypb.Type {
+	if binary, ok := expr.(*sqlparser.BinaryExpr); ok {
+		switch binary.Operator {
+		case sqlparser.JSONExtractOp:
+			return sqltypes.TypeJSON
+		case sqlparser.JSONUnquoteExtractOp:
+			return sqltypes.Text
+		}
+	}
+	return sqltypes.Null
+}
+
+// errJSONComparison is returned when the JSON results of the -> operator
+// would have to be ordered or grouped by vtgate.
+var errJSONComparison = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: ordering or grouping by the JSON result of the -> operator, use ->> instead")
@@ -31,6 +31,12 @@ import (
 
 func buildUnionPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	union := stmt.(*sqlparser.Union)
+	if sel := firstUnionSelect(union); sel != nil && sel.SQLCalcFoundRows {
+		sel.SQLCalcFoundRows = false
+		if union.Limit != nil {
+			return buildSQLCalcFoundRowsUnionPlan(union, vschema)
+		}
+	}
 	// For unions, create a pb with anonymous scope.
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(union)))
 	if err := pb.processUnion(union, nil); err != nil {
@@ -42,6 +48,68 @@ func buildUnionPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Pr
 	return pb.plan.Primitive(), nil
 }
 
+// firstUnionSelect returns the first select of a union, the only one
+// that can have SQL_CALC_FOUND_ROWS.
+func firstUnionSelect(union *sqlparser.Union) *sqlparser.Select {
+	part := union.FirstStatement
+	for {
+		switch node := part.(type) {
+		case *sqlparser.Select:
+			return node
+		case *sqlparser.ParenSelect:
+			part = node.Select
+		case *sqlparser.Union:
+			part = node.FirstStatement
+		default:
+			return nil
+		}
+	}
+}
+
+// buildSQLCalcFoundRowsUnionPlan plans a union with SQL_CALC_FOUND_ROWS
+// and a limit like a select: the union is executed with its limit, and
+// its rows are counted without it by a derived table.
+func buildSQLCalcFoundRowsUnionPlan(union *sqlparser.Union, vschema ContextVSchema) (engine.Primitive, error) {
+	ljt := newJointab(sqlparser.GetBindvars(union))
+	frpb := newPrimitiveBuilder(vschema, ljt)
+	if err := frpb.processUnion(union, nil); err != nil {
+		return nil, err
+	}
+
+	statement, err := sqlparser.Parse(sqlparser.String(union))
+	if err != nil {
+		return nil, err
+	}
+	countUnion := statement.(*sqlparser.Union)
+	countUnion.OrderBy = nil
+	countUnion.Limit = nil
+	sel := &sqlparser.Select{
+		SelectExprs: []sqlparser.SelectExpr{&sqlparser.AliasedExpr{
+			Expr: &sqlparser.FuncExpr{
+				Name:  sqlparser.NewColIdent("count"),
+				Exprs: []sqlparser.SelectExpr{&sqlparser.StarExpr{}},
+			},
+		}},
+		From: []sqlparser.TableExpr{
+			&sqlparser.AliasedTableExpr{
+				Expr: &sqlparser.DerivedTable{Select: countUnion},
+				As:   sqlparser.NewTableIdent("t"),
+			},
+		},
+	}
+	cjt := newJointab(sqlparser.GetBindvars(sel))
+	countpb := newPrimitiveBuilder(vschema, cjt)
+	if err := countpb.processSelect(sel, nil, ""); err != nil {
+		return nil, err
+	}
+
+	plan := &sqlCalcFoundRows{LimitQuery: frpb.plan, CountQuery: countpb.plan, ljt: ljt, cjt: cjt}
+	if err := plan.Wireup(plan, nil); err != nil {
+		return nil, err
+	}
+	return plan.Primitive(), nil
+}
+
 func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab) error {
 	if err := pb.processPart(union.FirstStatement, outer, false); err != nil {
 		return err
@@ -93,7 +161,8 @@ func (pb *primitiveBuilder) processPart(part sqlparser.SelectStatement, outer *s
 		return pb.processUnion(part, outer)
 	case *sqlparser.Select:
 		if part.SQLCalcFoundRows {
-			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "SQL_CALC_FOUND_ROWS not supported with union")
+			// Only the first select of a union can have it.
+			return mysql.NewSQLError(mysql.ERCantUseOptionHere, mysql.SSSyntaxErrorOrAccessViolation, "Incorrect usage/placement of 'SQL_CALC_FOUND_ROWS'")
 		}
 		if !hasParens {
 			err := checkOrderByAndLimit(part)
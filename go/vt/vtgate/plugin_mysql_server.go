@@ -45,6 +45,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
 	"github.com/google/uuid"
 )
@@ -73,6 +74,8 @@ var (
 	mysqlDefaultWorkloadName = flag.String("mysql_default_workload", "OLTP", "Default session workload (OLTP, OLAP, DBA)")
 	mysqlDefaultWorkload     int32
 
+	mysqlNegotiateCharset = flag.Bool("mysql_server_negotiate_charset", false, "If set, the character set requested by a client in the handshake is set on its session, as with SET NAMES, so that it is applied on the tablet connections when they use a different one. It needs enable_system_settings and enable_charset_system_settings.")
+
 	busyConnections int32
 )
 
@@ -82,20 +85,24 @@ type vtgateHandler struct {
 	mu sync.Mutex
 
 	vtg         *VTGate
-	connections map[*mysql.Conn]bool
+	connections map[*mysql.Conn]*connectionState
 }
 
 func newVtgateHandler(vtg *VTGate) *vtgateHandler {
 	return &vtgateHandler{
 		vtg:         vtg,
-		connections: make(map[*mysql.Conn]bool),
+		connections: make(map[*mysql.Conn]*connectionState),
 	}
 }
 
 func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
+	state := &connectionState{
+		host:  c.RemoteAddr().String(),
+		since: time.Now(),
+	}
 	vh.mu.Lock()
 	defer vh.mu.Unlock()
-	vh.connections[c] = true
+	vh.connections[c] = state
 }
 
 func (vh *vtgateHandler) numConnections() int {
@@ -139,6 +146,54 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 	_ = vh.vtg.CloseSession(ctx, session)
 }
 
+// KillQuery is part of the clientConnections interface.
+func (vh *vtgateHandler) KillQuery(ctx context.Context, connectionID uint64) error {
+	vh.mu.Lock()
+	defer vh.mu.Unlock()
+	c, err := vh.killableLocked(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+	if query := vh.connections[c].query; query != nil {
+		query.cancel()
+	}
+	return nil
+}
+
+// KillConnection is part of the clientConnections interface.
+func (vh *vtgateHandler) KillConnection(ctx context.Context, connectionID uint64) error {
+	vh.mu.Lock()
+	c, err := vh.killableLocked(ctx, connectionID)
+	if err != nil {
+		vh.mu.Unlock()
+		return err
+	}
+	if query := vh.connections[c].query; query != nil {
+		query.cancel()
+	}
+	vh.mu.Unlock()
+
+	// The listener calls ConnectionClosed once the connection is closed,
+	// which rolls back its transactions.
+	c.Close()
+	return nil
+}
+
+// killableLocked returns the connection with the id, if the caller can
+// kill it.
+func (vh *vtgateHandler) killableLocked(ctx context.Context, connectionID uint64) (*mysql.Conn, error) {
+	for c := range vh.connections {
+		if uint64(c.ConnectionID) != connectionID {
+			continue
+		}
+		if ownsConnection(ctx, c) {
+			return c, nil
+		}
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.KillDenied, "You are not owner of thread %d", connectionID)
+	}
+	return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchThread, "Unknown thread id: %d on this vtgate", connectionID)
+}
+
 // Regexp to extract parent span id over the sql query
 var r = regexp.MustCompile(`/\*VT_SPAN_CONTEXT=(.*)\*/`)
 
@@ -201,7 +256,10 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
-	session := vh.session(c)
+	ctx, done := vh.startQuery(ctx, c, query)
+	defer done()
+
+	session := vh.startSession(ctx, c)
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -211,6 +269,16 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		}
 	}()
 
+	if *mysqlAllowLocalInfile && isLoadData(query) {
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return mysql.NewSQLErrorFromError(err)
+		}
+		if ld, ok := stmt.(*sqlparser.LoadData); ok {
+			return vh.loadData(ctx, c, session, ld, callback)
+		}
+	}
+
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
 		return mysql.NewSQLErrorFromError(err)
@@ -262,7 +330,7 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[st
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
-	session := vh.session(c)
+	session := vh.startSession(ctx, c)
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -304,7 +372,10 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
-	session := vh.session(c)
+	ctx, done := vh.startQuery(ctx, c, prepare.PrepareStmt)
+	defer done()
+
+	session := vh.startSession(ctx, c)
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -332,6 +403,25 @@ func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 	return uint16(len(vh.session(c).GetWarnings()))
 }
 
+// startSession returns the session of a connection. A new session gets
+// the character set requested by the client in the handshake, if
+// -mysql_server_negotiate_charset is set.
+func (vh *vtgateHandler) startSession(ctx context.Context, c *mysql.Conn) *vtgatepb.Session {
+	if c.ClientData != nil || !*mysqlNegotiateCharset {
+		return vh.session(c)
+	}
+	session := vh.session(c)
+	charset := mysql.CharacterSetName(c.CharacterSet)
+	if charset == "" {
+		log.Warningf("Unknown character set %d requested by %s, using the default one", c.CharacterSet, c)
+		return session
+	}
+	if _, _, err := vh.vtg.Execute(ctx, session, "set names "+charset, nil); err != nil {
+		log.Warningf("Cannot set the character set %s requested by %s: %v", charset, c, err)
+	}
+	return session
+}
+
 func (vh *vtgateHandler) session(c *mysql.Conn) *vtgatepb.Session {
 	session, _ := c.ClientData.(*vtgatepb.Session)
 	if session == nil {
@@ -419,6 +509,7 @@ func initMySQLProtocol() {
 	// Create a Listener.
 	var err error
 	vtgateHandle = newVtgateHandler(rpcVTGate)
+	rpcVTGate.executor.connections = vtgateHandle
 	if *mysqlServerPort >= 0 {
 		mysqlListener, err = mysql.NewListener(*mysqlTCPVersion, net.JoinHostPort(*mysqlServerBindAddress, fmt.Sprintf("%v", *mysqlServerPort)), authServer, vtgateHandle, *mysqlConnReadTimeout, *mysqlConnWriteTimeout, *mysqlProxyProtocol)
 		if err != nil {
@@ -0,0 +1,249 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// processlistInfoLength is the length of the queries in SHOW PROCESSLIST
+// without FULL, as in MySQL.
+const processlistInfoLength = 100
+
+// processlistFields are the fields of SHOW PROCESSLIST: the ones of MySQL,
+// and the queries the shards are running for the connection. The rows are
+// the client connections of this vtgate only. Info is the query of the
+// client, and Shard_queries lists the queries the tablets run for it, e.g.
+// "ks/-80@master zone1-0000000100 1.2s: select ...". The tablet is missing
+// until the gateway picks one.
+var processlistFields = []*querypb.Field{
+	{Name: "Id", Type: sqltypes.Uint64},
+	{Name: "User", Type: sqltypes.VarChar},
+	{Name: "Host", Type: sqltypes.VarChar},
+	{Name: "db", Type: sqltypes.VarChar},
+	{Name: "Command", Type: sqltypes.VarChar},
+	{Name: "Time", Type: sqltypes.Int64},
+	{Name: "State", Type: sqltypes.VarChar},
+	{Name: "Info", Type: sqltypes.VarChar},
+	{Name: "Shard_queries", Type: sqltypes.VarChar},
+}
+
+// connectionState is the activity of a client connection.
+type connectionState struct {
+	host string
+	// db is the target of the session after its last query.
+	db string
+	// since is when the current query started, or when the connection
+	// became idle.
+	since time.Time
+	// query is the query running on the connection, nil if it is idle.
+	query *runningQuery
+}
+
+// runningQuery is a query running on a connection.
+type runningQuery struct {
+	sql string
+	// cancel cancels the query, for KILL.
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	// shards are the queries the shards are running for the query.
+	shards map[*shardQuery]bool
+}
+
+// shardQuery is a query running on a shard.
+type shardQuery struct {
+	target *querypb.Target
+	sql    string
+	since  time.Time
+	// tablet is the tablet running the query, nil until it is known.
+	// It is protected by the mutex of the running query.
+	tablet *topodatapb.TabletAlias
+}
+
+type runningQueryKey struct{}
+
+// startQuery returns the context of a query of c, and the function to
+// call once the query is done.
+func (vh *vtgateHandler) startQuery(ctx context.Context, c *mysql.Conn, sql string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	query := &runningQuery{
+		sql:    sql,
+		cancel: cancel,
+		shards: make(map[*shardQuery]bool),
+	}
+	vh.mu.Lock()
+	state, ok := vh.connections[c]
+	if !ok {
+		state = &connectionState{}
+		vh.connections[c] = state
+	}
+	state.since = time.Now()
+	state.query = query
+	vh.mu.Unlock()
+
+	return context.WithValue(ctx, runningQueryKey{}, query), func() {
+		vh.mu.Lock()
+		state.since = time.Now()
+		state.query = nil
+		if session, ok := c.ClientData.(*vtgatepb.Session); ok {
+			state.db = session.TargetString
+		}
+		vh.mu.Unlock()
+		cancel()
+	}
+}
+
+// startShardQuery records that the query of ctx, if any, runs sql on the
+// shard of target, on tablet if it is not nil. It returns the function to
+// call once it is done.
+func startShardQuery(ctx context.Context, target *querypb.Target, sql string, tablet *topodatapb.TabletAlias) func() {
+	query, ok := ctx.Value(runningQueryKey{}).(*runningQuery)
+	if !ok {
+		return func() {}
+	}
+	sq := &shardQuery{target: target, sql: sql, since: time.Now(), tablet: tablet}
+	query.mu.Lock()
+	query.shards[sq] = true
+	query.mu.Unlock()
+	return func() {
+		query.mu.Lock()
+		delete(query.shards, sq)
+		query.mu.Unlock()
+	}
+}
+
+// setShardQueryTablet records that tablet runs the shard queries of the
+// query of ctx, if any, for target. The gateway calls it once it picked
+// the tablet.
+func setShardQueryTablet(ctx context.Context, target *querypb.Target, tablet *topodatapb.TabletAlias) {
+	query, ok := ctx.Value(runningQueryKey{}).(*runningQuery)
+	if !ok {
+		return
+	}
+	query.mu.Lock()
+	defer query.mu.Unlock()
+	for sq := range query.shards {
+		if sq.target == target {
+			sq.tablet = tablet
+		}
+	}
+}
+
+// describeShards returns the shard queries of q, longest running first.
+// Their SQL is truncated like Info, unless full is set.
+func (q *runningQuery) describeShards(now time.Time, full bool) string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	shards := make([]*shardQuery, 0, len(q.shards))
+	for sq := range q.shards {
+		shards = append(shards, sq)
+	}
+
+	sort.Slice(shards, func(i, j int) bool { return shards[i].since.Before(shards[j].since) })
+	descs := make([]string, 0, len(shards))
+	for _, sq := range shards {
+		desc := topoproto.KeyspaceShardString(sq.target.Keyspace, sq.target.Shard) + "@" + topoproto.TabletTypeLString(sq.target.TabletType)
+		if sq.tablet != nil {
+			desc += " " + topoproto.TabletAliasString(sq.tablet)
+		}
+		sql := sq.sql
+		if !full && len(sql) > processlistInfoLength {
+			sql = sql[:processlistInfoLength]
+		}
+		descs = append(descs, fmt.Sprintf("%s %v: %s", desc, now.Sub(sq.since).Round(time.Millisecond), sql))
+	}
+	return strings.Join(descs, "; ")
+}
+
+// ownsConnection returns true if the caller of ctx can see and kill the
+// connection c: the users can see their own connections, and the users
+// allowed to alter the vschema can see all of them.
+func ownsConnection(ctx context.Context, c *mysql.Conn) bool {
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil && ef.Principal == c.User {
+		return true
+	}
+	return vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx))
+}
+
+// Processlist is part of the clientConnections interface.
+func (vh *vtgateHandler) Processlist(ctx context.Context, full bool) [][]sqltypes.Value {
+	now := time.Now()
+	vh.mu.Lock()
+	defer vh.mu.Unlock()
+
+	conns := make([]*mysql.Conn, 0, len(vh.connections))
+	for c := range vh.connections {
+		if ownsConnection(ctx, c) {
+			conns = append(conns, c)
+		}
+	}
+	sort.Slice(conns, func(i, j int) bool { return conns[i].ConnectionID < conns[j].ConnectionID })
+
+	rows := make([][]sqltypes.Value, 0, len(conns))
+	for _, c := range conns {
+		state := vh.connections[c]
+		command, stateDesc, info, shards := "Sleep", "", sqltypes.NULL, ""
+		if query := state.query; query != nil {
+			command, stateDesc = "Query", "executing"
+			sql := query.sql
+			if !full && len(sql) > processlistInfoLength {
+				sql = sql[:processlistInfoLength]
+			}
+			info = sqltypes.NewVarChar(sql)
+			shards = query.describeShards(now, full)
+		}
+		db := sqltypes.NULL
+		if state.db != "" {
+			db = sqltypes.NewVarChar(state.db)
+		}
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewUint64(uint64(c.ConnectionID)),
+			sqltypes.NewVarChar(c.User),
+			sqltypes.NewVarChar(state.host),
+			db,
+			sqltypes.NewVarChar(command),
+			sqltypes.NewInt64(int64(now.Sub(state.since) / time.Second)),
+			sqltypes.NewVarChar(stateDesc),
+			info,
+			sqltypes.NewVarChar(shards),
+		})
+	}
+	return rows
+}
+
+func (e *Executor) showProcesslist(ctx context.Context, full bool) *sqltypes.Result {
+	return &sqltypes.Result{
+		Fields: processlistFields,
+		Rows:   e.connections.Processlist(ctx, full),
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestVtgateHandlerProcesslist(t *testing.T) {
+	vh := newVtgateHandler(&VTGate{})
+	alice := &mysql.Conn{ConnectionID: 1, User: "alice"}
+	alice.ClientData = &vtgatepb.Session{TargetString: "TestExecutor"}
+	bob := &mysql.Conn{ConnectionID: 2, User: "bob"}
+	vh.connections[alice] = &connectionState{host: "10.0.0.1:1234"}
+	vh.connections[bob] = &connectionState{host: "10.0.0.2:1234"}
+
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "", ""), callerid.NewImmediateCallerID("alice"))
+
+	// The idle connection of alice knows its database after its first query.
+	_, done := vh.startQuery(context.Background(), alice, "use TestExecutor")
+	done()
+	rows := vh.Processlist(ctx, false)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "[UINT64(1) VARCHAR(\"alice\") VARCHAR(\"10.0.0.1:1234\") VARCHAR(\"TestExecutor\") VARCHAR(\"Sleep\") INT64(0) VARCHAR(\"\") NULL VARCHAR(\"\")]", fmt.Sprintf("%v", rows[0]))
+
+	sql := "select /* " + strings.Repeat("x", processlistInfoLength) + " */ 1 from user"
+	queryCtx, done := vh.startQuery(context.Background(), alice, sql)
+	defer done()
+	target := &querypb.Target{Keyspace: "TestExecutor", Shard: "-20", TabletType: topodatapb.TabletType_MASTER}
+	shardSQL := "select 1 from user /* " + strings.Repeat("y", processlistInfoLength) + " */"
+	shardDone := startShardQuery(queryCtx, target, shardSQL, nil)
+	defer shardDone()
+
+	rows = vh.Processlist(ctx, false)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "Query", rows[0][4].ToString())
+	assert.Equal(t, "executing", rows[0][6].ToString())
+	assert.Equal(t, sql[:processlistInfoLength], rows[0][7].ToString())
+	assert.Regexp(t, `^TestExecutor/-20@master [0-9.]+m?s: `, rows[0][8].ToString())
+	assert.True(t, strings.HasSuffix(rows[0][8].ToString(), ": "+shardSQL[:processlistInfoLength]), rows[0][8].ToString())
+
+	// The tablet shows up once the gateway picked it.
+	setShardQueryTablet(queryCtx, target, &topodatapb.TabletAlias{Cell: "zone1", Uid: 100})
+	rows = vh.Processlist(ctx, true)
+	assert.Equal(t, sql, rows[0][7].ToString())
+	assert.Regexp(t, `^TestExecutor/-20@master zone1-0000000100 [0-9.]+m?s: `, rows[0][8].ToString())
+	assert.True(t, strings.HasSuffix(rows[0][8].ToString(), ": "+shardSQL), rows[0][8].ToString())
+
+	// The users allowed to alter the vschema see all the connections.
+	*vschemaacl.AuthorizedDDLUsers = "alice"
+	vschemaacl.Init()
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	rows = vh.Processlist(ctx, false)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "bob", rows[1][1].ToString())
+	assert.Equal(t, "Sleep", rows[1][4].ToString())
+	assert.True(t, rows[1][3].IsNull())
+}
+
+func TestExecutorShowProcesslist(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.connections = &fakeClientConnections{}
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	qr, err := executor.Execute(ctx, "TestExecutorShowProcesslist", session, "show processlist", nil)
+	require.NoError(t, err)
+	assert.Equal(t, processlistFields, qr.Fields)
+	require.Len(t, qr.Rows, 1)
+	assert.Equal(t, "select 1", qr.Rows[0][7].ToString())
+
+	qr, err = executor.Execute(ctx, "TestExecutorShowProcesslist", session, "show full processlist", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "select 1 from dual", qr.Rows[0][7].ToString())
+}
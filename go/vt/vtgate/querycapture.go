@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"math/rand"
+	"os"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/querycapture"
+)
+
+var (
+	queryCaptureFile       = flag.String("query_capture_file", "", "If set, a sample of the executed queries is appended to this file, for replay with vtreplay")
+	queryCaptureSampleRate = flag.Float64("query_capture_sample_rate", 0.01, "Fraction of the queries written to -query_capture_file, between 0 and 1")
+
+	queryCaptureCount = stats.NewCounter("QueryCaptureCount", "Number of queries written to the query capture file")
+)
+
+func initQueryCapture() error {
+	if *queryCaptureFile == "" {
+		return nil
+	}
+	f, err := os.OpenFile(*queryCaptureFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	ch := QueryLogger.Subscribe("QueryCapture")
+	go captureQueries(ch, querycapture.NewWriter(f), *queryCaptureSampleRate)
+	log.Infof("Capturing %v of the queries to %v", *queryCaptureSampleRate, *queryCaptureFile)
+	return nil
+}
+
+// captureQueries writes a sample of the queries received on ch to w,
+// until ch is closed.
+func captureQueries(ch chan interface{}, w *querycapture.Writer, sampleRate float64) {
+	for record := range ch {
+		stats, ok := record.(*LogStats)
+		if !ok || stats.SQL == "" || rand.Float64() >= sampleRate {
+			continue
+		}
+		err := w.Write(&querycapture.Query{
+			Time:          stats.StartTime,
+			Keyspace:      stats.Keyspace,
+			TabletType:    stats.TabletType,
+			SQL:           stats.SQL,
+			BindVariables: stats.BindVariables,
+		})
+		if err != nil {
+			log.Errorf("cannot write to query capture file: %v", err)
+			continue
+		}
+		queryCaptureCount.Add(1)
+	}
+}
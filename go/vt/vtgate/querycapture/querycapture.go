@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package querycapture defines the format of the workload captured by
+// vtgate with -query_capture_file, and replays such a capture against a
+// vtgate for performance regression testing.
+//
+// A capture is a file with one JSON encoded Query per line, in the
+// order the queries completed.
+package querycapture
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Query is a single captured query.
+type Query struct {
+	// Time is when the query started executing.
+	Time       time.Time
+	Keyspace   string `json:",omitempty"`
+	TabletType string `json:",omitempty"`
+	// SQL is the query as planned by vtgate, which is normalized if
+	// vtgate runs with -normalize_queries.
+	SQL           string
+	BindVariables map[string]*querypb.BindVariable `json:",omitempty"`
+}
+
+// Target returns the target string to execute the query against. If
+// keyspace is not empty, it replaces the captured keyspace.
+func (q *Query) Target(keyspace string) string {
+	if keyspace == "" {
+		keyspace = q.Keyspace
+	}
+	if q.TabletType == "" {
+		return keyspace
+	}
+	return keyspace + "@" + q.TabletType
+}
+
+// Writer writes captured queries. It is safe for concurrent use.
+type Writer struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewWriter returns a Writer that writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{enc: json.NewEncoder(w)}
+}
+
+// Write writes a single query.
+func (w *Writer) Write(q *Query) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.enc.Encode(q)
+}
+
+// Reader reads captured queries.
+type Reader struct {
+	dec *json.Decoder
+}
+
+// NewReader returns a Reader that reads from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{dec: json.NewDecoder(bufio.NewReader(r))}
+}
+
+// Next returns the next query, or io.EOF at the end of the capture.
+func (r *Reader) Next() (*Query, error) {
+	q := &Query{}
+	if err := r.dec.Decode(q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// ExecuteFunc executes a replayed query.
+type ExecuteFunc func(ctx context.Context, q *Query) error
+
+// Replayer re-executes a capture.
+type Replayer struct {
+	// Speed scales the pace of the capture: 2 replays it twice as fast
+	// as it was captured, 0.5 half as fast. If Speed is 0, queries are
+	// sent as fast as Concurrency allows.
+	Speed float64
+	// Concurrency is the maximum number of queries in flight.
+	Concurrency int
+	// Execute is called for every query of the capture.
+	Execute ExecuteFunc
+}
+
+// ReplayStats summarizes a replay.
+type ReplayStats struct {
+	Queries int
+	Errors  int
+	// Lag is the longest time a query was sent after its scheduled
+	// time, because Concurrency queries were already in flight.
+	Lag time.Duration
+}
+
+// Replay replays all the queries read from r. Query errors are counted
+// in the returned stats and do not stop the replay. The first read
+// error, if any, is returned.
+func (rp *Replayer) Replay(ctx context.Context, r *Reader) (*ReplayStats, error) {
+	concurrencyLimit := rp.Concurrency
+	if concurrencyLimit <= 0 {
+		concurrencyLimit = 1
+	}
+	sem := make(chan struct{}, concurrencyLimit)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	stats := &ReplayStats{}
+	var replayErr error
+
+	var first time.Time
+	start := time.Now()
+	for {
+		q, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			replayErr = err
+			break
+		}
+		if first.IsZero() {
+			first = q.Time
+		}
+
+		var scheduled time.Time
+		if rp.Speed > 0 {
+			scheduled = start.Add(time.Duration(float64(q.Time.Sub(first)) / rp.Speed))
+			if wait := time.Until(scheduled); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+				}
+			}
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			replayErr = ctx.Err()
+			break
+		}
+
+		var lag time.Duration
+		if !scheduled.IsZero() {
+			lag = time.Since(scheduled)
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := rp.Execute(ctx, q)
+
+			mu.Lock()
+			defer mu.Unlock()
+			stats.Queries++
+			if err != nil {
+				stats.Errors++
+			}
+			if lag > stats.Lag {
+				stats.Lag = lag
+			}
+		}()
+	}
+	wg.Wait()
+	return stats, replayErr
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querycapture
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestWriteRead(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	queries := []*Query{{
+		Time:          now,
+		Keyspace:      "ks",
+		TabletType:    "MASTER",
+		SQL:           "select * from t where id = :vtg1",
+		BindVariables: map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(1)},
+	}, {
+		Time: now.Add(time.Second),
+		SQL:  "select 1 from dual",
+	}}
+
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf)
+	for _, q := range queries {
+		require.NoError(t, w.Write(q))
+	}
+
+	r := NewReader(buf)
+	for _, want := range queries {
+		got, err := r.Next()
+		require.NoError(t, err)
+		assert.True(t, want.Time.Equal(got.Time))
+		assert.Equal(t, want.Keyspace, got.Keyspace)
+		assert.Equal(t, want.TabletType, got.TabletType)
+		assert.Equal(t, want.SQL, got.SQL)
+		require.Len(t, got.BindVariables, len(want.BindVariables))
+		for k, bv := range want.BindVariables {
+			assert.True(t, proto.Equal(bv, got.BindVariables[k]), "bind variable %s: %v", k, got.BindVariables[k])
+		}
+	}
+	_, err := r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestTarget(t *testing.T) {
+	q := &Query{Keyspace: "ks", TabletType: "REPLICA"}
+	assert.Equal(t, "ks@REPLICA", q.Target(""))
+	assert.Equal(t, "test_ks@REPLICA", q.Target("test_ks"))
+
+	q = &Query{Keyspace: "ks"}
+	assert.Equal(t, "ks", q.Target(""))
+	assert.Equal(t, "test_ks", q.Target("test_ks"))
+}
+
+func captureOf(t *testing.T, queries ...*Query) *Reader {
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf)
+	for _, q := range queries {
+		require.NoError(t, w.Write(q))
+	}
+	return NewReader(buf)
+}
+
+func TestReplay(t *testing.T) {
+	now := time.Now()
+	r := captureOf(t,
+		&Query{Time: now, SQL: "select 1"},
+		&Query{Time: now.Add(time.Hour), SQL: "select 2"},
+		&Query{Time: now.Add(2 * time.Hour), SQL: "fail"},
+	)
+
+	var mu sync.Mutex
+	var executed []string
+	rp := &Replayer{
+		Concurrency: 2,
+		Execute: func(ctx context.Context, q *Query) error {
+			mu.Lock()
+			defer mu.Unlock()
+			executed = append(executed, q.SQL)
+			if q.SQL == "fail" {
+				return errors.New("fail")
+			}
+			return nil
+		},
+	}
+	// Speed 0 ignores the hour between the queries.
+	stats, err := rp.Replay(context.Background(), r)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"select 1", "select 2", "fail"}, executed)
+	assert.Equal(t, 3, stats.Queries)
+	assert.Equal(t, 1, stats.Errors)
+}
+
+func TestReplaySpeed(t *testing.T) {
+	now := time.Now()
+	r := captureOf(t,
+		&Query{Time: now, SQL: "select 1"},
+		&Query{Time: now.Add(200 * time.Millisecond), SQL: "select 2"},
+	)
+
+	var mu sync.Mutex
+	var times []time.Time
+	rp := &Replayer{
+		Speed: 2,
+		Execute: func(ctx context.Context, q *Query) error {
+			mu.Lock()
+			defer mu.Unlock()
+			times = append(times, time.Now())
+			return nil
+		},
+	}
+	_, err := rp.Replay(context.Background(), r)
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	// The 200ms gap is replayed in 100ms.
+	gap := times[1].Sub(times[0])
+	assert.GreaterOrEqual(t, int64(gap), int64(90*time.Millisecond))
+	assert.Less(t, int64(gap), int64(200*time.Millisecond))
+}
+
+func TestReplayCanceled(t *testing.T) {
+	now := time.Now()
+	r := captureOf(t,
+		&Query{Time: now, SQL: "select 1"},
+		&Query{Time: now.Add(time.Hour), SQL: "select 2"},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rp := &Replayer{
+		Speed: 1,
+		Execute: func(ctx context.Context, q *Query) error {
+			cancel()
+			return nil
+		},
+	}
+	stats, err := rp.Replay(ctx, r)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, stats.Queries)
+}
+
+func TestReplayReadError(t *testing.T) {
+	rp := &Replayer{
+		Execute: func(ctx context.Context, q *Query) error {
+			return nil
+		},
+	}
+	_, err := rp.Replay(context.Background(), NewReader(bytes.NewBufferString("{\"SQL\": \"select 1\"}\nnot json\n")))
+	require.Error(t, err)
+}
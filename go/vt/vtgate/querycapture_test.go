@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/querycapture"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func captureAll(sampleRate float64, records ...interface{}) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	ch := make(chan interface{}, len(records))
+	for _, record := range records {
+		ch <- record
+	}
+	close(ch)
+	captureQueries(ch, querycapture.NewWriter(buf), sampleRate)
+	return buf
+}
+
+func TestCaptureQueries(t *testing.T) {
+	logStats := NewLogStats(context.Background(), "Execute", "select * from t where id = :vtg1", map[string]*querypb.BindVariable{
+		"vtg1": sqltypes.Int64BindVariable(1),
+	})
+	logStats.Keyspace = "ks"
+	logStats.TabletType = "MASTER"
+
+	buf := captureAll(1, logStats, &LogStats{}, "unexpected")
+
+	r := querycapture.NewReader(buf)
+	q, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "select * from t where id = :vtg1", q.SQL)
+	assert.Equal(t, "ks@MASTER", q.Target(""))
+	assert.Equal(t, `type:INT64 value:"1" `, q.BindVariables["vtg1"].String())
+	// Records without SQL or of an unexpected type are skipped.
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestCaptureQueriesSampleRate(t *testing.T) {
+	var records []interface{}
+	for i := 0; i < 100; i++ {
+		records = append(records, NewLogStats(context.Background(), "Execute", "select 1", nil))
+	}
+	assert.Empty(t, captureAll(0, records...).String())
+}
@@ -60,5 +60,5 @@ func initQueryLogger(vtg *VTGate) error {
 		}
 	}
 
-	return nil
+	return initQueryCapture()
 }
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var readAsOfTolerance = flag.Duration("read_as_of_tolerance", time.Minute, "How far the replication lag of a delayed replica may be from the @@read_as_of of a session for the replica to serve its reads.")
+
+type readAsOfKey struct{}
+
+// withReadAsOf returns a context for the queries of a session
+// with @@read_as_of set.
+func withReadAsOf(ctx context.Context, session *SafeSession) context.Context {
+	seconds := session.GetReadAsOf()
+	if seconds == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, readAsOfKey{}, time.Duration(seconds)*time.Second)
+}
+
+// readAsOfFromContext returns how long ago the data read with
+// ctx should be, if the session has @@read_as_of set.
+func readAsOfFromContext(ctx context.Context) (time.Duration, bool) {
+	asOf, ok := ctx.Value(readAsOfKey{}).(time.Duration)
+	return asOf, ok
+}
+
+// delayedTablets returns the delayed replicas of target whose replication
+// lag is within -read_as_of_tolerance of asOf. Only the rdonly tablets can
+// be delayed replicas.
+func (gw *TabletGateway) delayedTablets(target *querypb.Target, asOf time.Duration) ([]*discovery.TabletHealth, error) {
+	if target.TabletType != topodatapb.TabletType_RDONLY {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "reads with @@read_as_of need an rdonly target, use <keyspace>@rdonly")
+	}
+	var tablets []*discovery.TabletHealth
+	for _, th := range gw.hc.GetDelayedTabletStats(target) {
+		lag := time.Duration(th.Stats.GetSecondsBehindMaster()) * time.Second
+		if lag >= asOf-*readAsOfTolerance && lag <= asOf+*readAsOfTolerance {
+			tablets = append(tablets, th)
+		}
+	}
+	if len(tablets) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no delayed replica with a replication lag within %v of %v", *readAsOfTolerance, asOf)
+	}
+	return tablets, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	mirrorReadsPercent        = flag.Float64("mirror_reads_to_replicas_percent", 0, "Percentage (0-100) of the selects sent to master tablets outside of a transaction that are also sent to replica tablets, to keep their buffer pools warm before switching read traffic to them. The results of the mirrored selects are discarded. 0 disables mirroring.")
+	mirrorReadsMaxConcurrency = flag.Int("mirror_reads_max_concurrency", 100, "Maximum number of mirrored selects running at the same time. Selects over the limit are not mirrored.")
+	mirrorReadsTimeout        = flag.Duration("mirror_reads_timeout", 10*time.Second, "Timeout of a select mirrored to replica tablets.")
+
+	mirroredReads       = stats.NewCountersWithMultiLabels("MirroredReads", "Selects mirrored to replica tablets, by keyspace and result", []string{"Keyspace", "Result"})
+	mirroredReadTimings = stats.NewTimings("MirroredReadTimings", "Latency of the selects mirrored to replica tablets, by keyspace", "Keyspace")
+)
+
+// mirrorWorkers runs mirrored queries in the background, up to a
+// maximum number at the same time.
+type mirrorWorkers struct {
+	timeout time.Duration
+	slots   chan struct{}
+	// wg tracks the mirrored queries in flight, for tests.
+	wg sync.WaitGroup
+}
+
+func newMirrorWorkers(maxConcurrency int, timeout time.Duration) *mirrorWorkers {
+	return &mirrorWorkers{
+		timeout: timeout,
+		slots:   make(chan struct{}, maxConcurrency),
+	}
+}
+
+// run calls f in the background, with a context that has the callers
+// of ctx and the mirror timeout, because the mirrored query can outlive
+// the request. It returns false if too many mirrored queries are running.
+func (mw *mirrorWorkers) run(ctx context.Context, f func(ctx context.Context)) bool {
+	select {
+	case mw.slots <- struct{}{}:
+	default:
+		return false
+	}
+	mirrorCtx, cancel := context.WithTimeout(callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx)), mw.timeout)
+	mw.wg.Add(1)
+	go func() {
+		defer mw.wg.Done()
+		defer func() { <-mw.slots }()
+		defer cancel()
+		defer func() {
+			if x := recover(); x != nil {
+				log.Errorf("Uncaught panic in mirrored query:\n%v\n%s", x, tb.Stack(4))
+			}
+		}()
+		f(mirrorCtx)
+	}()
+	return true
+}
+
+// mirrorSession returns an autocommit session for a mirrored query
+// of safeSession, with the given target.
+func mirrorSession(safeSession *SafeSession, targetString string) *SafeSession {
+	session := NewSafeSession(&vtgatepb.Session{
+		TargetString: targetString,
+		Autocommit:   true,
+	})
+	if safeSession.Options != nil {
+		session.Options = proto.Clone(safeSession.Options).(*querypb.ExecuteOptions)
+	}
+	return session
+}
+
+func copyBindVars(bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	vars := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		vars[k] = v
+	}
+	return vars
+}
+
+// canMirror returns true if the plan is a select that can run again
+// elsewhere: it is autocommitted, and it has no side effects like
+// sequences and locks.
+func canMirror(plan *engine.Plan, vcursor *vcursorImpl, safeSession *SafeSession) bool {
+	if plan.Type != sqlparser.StmtSelect || vcursor.destination != nil {
+		return false
+	}
+	if safeSession.InTransaction() || safeSession.InReservedConn() || plan.Instructions.NeedsTransaction() {
+		return false
+	}
+	switch plan.Instructions.RouteType() {
+	case "SelectNext", "lock":
+		return false
+	}
+	return true
+}
+
+// readMirror sends a sample of the selects that go to master tablets
+// to replica tablets too. They run in the background, and do not count
+// in the query stats of vtgate.
+type readMirror struct {
+	percent  float64
+	workers  *mirrorWorkers
+	random   func() float64
+	errorLog *logutil.ThrottledLogger
+}
+
+// newReadMirror returns a readMirror, or nil if mirroring is disabled.
+func newReadMirror(percent float64, maxConcurrency int, timeout time.Duration) *readMirror {
+	if percent <= 0 || maxConcurrency <= 0 {
+		return nil
+	}
+	return &readMirror{
+		percent:  percent,
+		workers:  newMirrorWorkers(maxConcurrency, timeout),
+		random:   rand.Float64,
+		errorLog: logutil.NewThrottledLogger("MirroredReads", 10*time.Second),
+	}
+}
+
+// shouldMirror returns true if the select of plan should be mirrored.
+// Only reads on master tablets are sampled.
+func (rm *readMirror) shouldMirror(plan *engine.Plan, vcursor *vcursorImpl, safeSession *SafeSession) bool {
+	if vcursor.TabletType() != topodatapb.TabletType_MASTER || !canMirror(plan, vcursor, safeSession) {
+		return false
+	}
+	return rm.random()*100 < rm.percent
+}
+
+// mirror executes the plan on replica tablets in the background.
+func (rm *readMirror) mirror(ctx context.Context, e *Executor, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable) {
+	keyspace := plan.Instructions.GetKeyspaceName()
+	session := mirrorSession(vcursor.safeSession, vcursor.safeSession.TargetString)
+	vars := copyBindVars(bindVars)
+	ok := rm.workers.run(ctx, func(ctx context.Context) {
+		mirrorCursor, err := newVCursorImpl(ctx, session, vcursor.marginComments, e, NewLogStats(ctx, "MirrorRead", plan.Original, nil), e.vm, vcursor.vschema, e.resolver.resolver, e.serv)
+		if err != nil {
+			mirroredReads.Add([]string{keyspace, "Error"}, 1)
+			return
+		}
+		mirrorCursor.tabletType = topodatapb.TabletType_REPLICA
+
+		start := time.Now()
+		_, err = plan.Instructions.Execute(mirrorCursor, vars, true)
+		mirroredReadTimings.Record(keyspace, start)
+		if err != nil {
+			mirroredReads.Add([]string{keyspace, "Error"}, 1)
+			rm.errorLog.Warningf("Mirrored select to replica tablets of keyspace %s failed: %v", keyspace, err)
+			return
+		}
+		mirroredReads.Add([]string{keyspace, "Success"}, 1)
+	})
+	if !ok {
+		mirroredReads.Add([]string{keyspace, "Dropped"}, 1)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestMirrorReads(t *testing.T) {
+	assert.Nil(t, newReadMirror(0, 10, time.Second))
+
+	executor, _, _, sbclookup := createExecutorEnv()
+	replica := vtgateHealthCheck.(*discovery.FakeHealthCheck).AddTestTablet("aa", "1.1.1.1", 1002, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	executor.readMirror = newReadMirror(50, 10, time.Second)
+	random := 0.1
+	executor.readMirror.random = func() float64 { return random }
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	success := mirroredReads.Counts()[KsTestUnsharded+".Success"]
+	_, err := executor.Execute(context.Background(), "TestMirrorReads", session, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	executor.readMirror.workers.wg.Wait()
+	assert.EqualValues(t, 1, sbclookup.ExecCount.Get())
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+	assert.Equal(t, sbclookup.Queries, replica.Queries)
+	assert.Equal(t, success+1, mirroredReads.Counts()[KsTestUnsharded+".Success"])
+
+	// Selects outside of the sample are not mirrored.
+	random = 0.9
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", session, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	executor.readMirror.workers.wg.Wait()
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+
+	// Neither are writes, selects in a transaction or selects on replicas.
+	random = 0.1
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", session, "update music_user_map set id = 2 where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", session, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", session, "rollback", nil)
+	require.NoError(t, err)
+	replicaSession := NewSafeSession(&vtgatepb.Session{TargetString: "@replica", Autocommit: true})
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", replicaSession, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	executor.readMirror.workers.wg.Wait()
+	assert.EqualValues(t, 2, replica.ExecCount.Get())
+
+	// Mirrored selects over the concurrency limit are dropped.
+	executor.readMirror.workers.slots = make(chan struct{}, 1)
+	executor.readMirror.workers.slots <- struct{}{}
+	dropped := mirroredReads.Counts()[KsTestUnsharded+".Dropped"]
+	_, err = executor.Execute(context.Background(), "TestMirrorReads", session, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, dropped+1, mirroredReads.Counts()[KsTestUnsharded+".Dropped"])
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+)
+
+var (
+	resultCacheKeyspaceSize = flag.Int64("result_cache_keyspace_size", 0, "Max memory in bytes used per keyspace to cache the results of selects with the CACHE_TTL directive. 0 disables result caching.")
+
+	resultCacheHits   = stats.NewCountersWithSingleLabel("ResultCacheHits", "Selects answered from the vtgate result cache, by keyspace", "Keyspace")
+	resultCacheMisses = stats.NewCountersWithSingleLabel("ResultCacheMisses", "Selects with the CACHE_TTL directive not found in the vtgate result cache, by keyspace", "Keyspace")
+)
+
+// resultCacheEntryOverhead is a rough estimate of the memory used by a
+// cache entry, in addition to the keys and values it holds.
+const resultCacheEntryOverhead = 256
+
+// resultCache holds the results of the selects that have a CACHE_TTL
+// directive. Each keyspace has its own LRU cache, capped in bytes, so
+// that a keyspace with large results cannot evict the others.
+type resultCache struct {
+	capacity int64
+
+	mu        sync.Mutex
+	keyspaces map[string]*cache.LRUCache
+}
+
+type cachedResult struct {
+	result  *sqltypes.Result
+	expires time.Time
+	size    int64
+}
+
+// newResultCache returns a resultCache that can use up to capacity
+// bytes per keyspace, or nil if capacity is not positive.
+func newResultCache(capacity int64) *resultCache {
+	if capacity <= 0 {
+		return nil
+	}
+	return &resultCache{
+		capacity:  capacity,
+		keyspaces: make(map[string]*cache.LRUCache),
+	}
+}
+
+func (rc *resultCache) keyspace(keyspace string) *cache.LRUCache {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	lru, ok := rc.keyspaces[keyspace]
+	if !ok {
+		lru = cache.NewLRUCache(rc.capacity, func(val interface{}) int64 {
+			return val.(*cachedResult).size
+		})
+		rc.keyspaces[keyspace] = lru
+	}
+	return lru
+}
+
+// get returns a copy of the result cached under key, if it has not expired.
+func (rc *resultCache) get(keyspace, key string) (*sqltypes.Result, bool) {
+	lru := rc.keyspace(keyspace)
+	val, ok := lru.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := val.(*cachedResult)
+	if time.Now().After(entry.expires) {
+		lru.Delete(key)
+		return nil, false
+	}
+	return entry.result.Copy(), true
+}
+
+// set caches a copy of qr under key for ttl. Results that are larger
+// than the capacity of a keyspace are not cached.
+func (rc *resultCache) set(keyspace, key string, qr *sqltypes.Result, ttl time.Duration) {
+	size := int64(len(key)) + resultCacheEntryOverhead
+	for _, field := range qr.Fields {
+		size += int64(len(field.Name) + len(field.Table) + len(field.OrgName) + len(field.OrgTable) + len(field.Database))
+	}
+	for _, row := range qr.Rows {
+		for _, val := range row {
+			size += int64(len(val.Raw())) + 32
+		}
+	}
+	if size > rc.capacity {
+		return
+	}
+	rc.keyspace(keyspace).Set(key, &cachedResult{
+		result:  qr.Copy(),
+		expires: time.Now().Add(ttl),
+		size:    size,
+	})
+}
+
+// purge drops the cached results of keyspace, or of all the keyspaces
+// if keyspace is empty. It returns the number of results dropped.
+func (rc *resultCache) purge(keyspace string) int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	purged := 0
+	for name, lru := range rc.keyspaces {
+		if keyspace != "" && name != keyspace {
+			continue
+		}
+		purged += lru.Len()
+		lru.Clear()
+	}
+	return purged
+}
+
+// resultCacheKey builds the cache key of a query from its callers, the key
+// prefix of its plan, which identifies its target, the system variables of
+// the session, the query and its bind variables. The callers are part of
+// the key because the tablets check their table ACLs: a result cannot be
+// served to another user. The system variables are because the results
+// depend on some of them, e.g. sql_mode, time_zone or the character sets.
+func resultCacheKey(ctx context.Context, planPrefixKey string, sysVars map[string]string, query string, bindVars map[string]*querypb.BindVariable) string {
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sysVarNames := make([]string, 0, len(sysVars))
+	for name := range sysVars {
+		sysVarNames = append(sysVarNames, name)
+	}
+	sort.Strings(sysVarNames)
+
+	var buf strings.Builder
+	im := callerid.ImmediateCallerIDFromContext(ctx)
+	buf.WriteString(strconv.Quote(callerid.GetUsername(im)))
+	for _, group := range im.GetGroups() {
+		buf.WriteByte(',')
+		buf.WriteString(strconv.Quote(group))
+	}
+	ef := callerid.EffectiveCallerIDFromContext(ctx)
+	buf.WriteByte('/')
+	buf.WriteString(strconv.Quote(callerid.GetPrincipal(ef)))
+	buf.WriteByte('/')
+	buf.WriteString(strconv.Quote(callerid.GetComponent(ef)))
+	buf.WriteByte('/')
+	buf.WriteString(strconv.Quote(callerid.GetSubcomponent(ef)))
+	buf.WriteByte('\n')
+	for _, name := range sysVarNames {
+		buf.WriteString(name)
+		buf.WriteByte('=')
+		buf.WriteString(strconv.Quote(sysVars[name]))
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(planPrefixKey)
+	buf.WriteByte(':')
+	buf.WriteString(query)
+	for _, name := range names {
+		buf.WriteByte('\n')
+		buf.WriteString(name)
+		buf.WriteByte('=')
+		writeBindVarKey(&buf, bindVars[name])
+	}
+	return buf.String()
+}
+
+func writeBindVarKey(buf *strings.Builder, bv *querypb.BindVariable) {
+	buf.WriteString(bv.Type.String())
+	buf.WriteByte(':')
+	if bv.Type != querypb.Type_TUPLE {
+		buf.WriteString(strconv.Quote(string(bv.Value)))
+		return
+	}
+	buf.WriteByte('(')
+	for i, val := range bv.Values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(val.Type.String())
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Quote(string(val.Value)))
+	}
+	buf.WriteByte(')')
+}
+
+// executeCachedPlan executes a plan that has a CACHE_TTL directive: the
+// result is served from the result cache if present, and cached otherwise.
+func (e *Executor) executeCachedPlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time, logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+	keyspace := plan.Instructions.GetKeyspaceName()
+	sysVars := make(map[string]string)
+	safeSession.GetSystemVariables(func(k string, v string) {
+		sysVars[k] = v
+	})
+	key := resultCacheKey(ctx, vcursor.planPrefixKey(), sysVars, plan.Original, bindVars)
+	if qr, ok := e.results.get(keyspace, key); ok {
+		resultCacheHits.Add(keyspace, 1)
+		logStats.Keyspace = keyspace
+		logStats.Table = plan.Instructions.GetTableName()
+		logStats.TabletType = vcursor.TabletType().String()
+		logStats.ExecuteTime = time.Since(execStart)
+		logStats.RowsReturned = uint64(len(qr.Rows))
+		return plan.Type, qr, nil
+	}
+	resultCacheMisses.Add(keyspace, 1)
+
+	stmtType, qr, err := e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	// Results with warnings may be partial, e.g. with SCATTER_ERRORS_AS_WARNINGS.
+	if err == nil && len(safeSession.GetWarnings()) == 0 {
+		e.results.set(keyspace, key, qr, plan.CacheTTL)
+	}
+	return stmtType, qr, err
+}
+
+// handlePurgeResultCache executes a PURGE VITESS_RESULT_CACHE statement.
+// Only the users allowed to alter the vschema can purge the cache.
+func (e *Executor) handlePurgeResultCache(ctx context.Context, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	purge, ok := stmt.(*sqlparser.PurgeResultCache)
+	if !ok {
+		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "unexpected statement type")
+	}
+	if !vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx)) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not authorized to purge the result cache")
+	}
+
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	if e.results == nil {
+		return &sqltypes.Result{}, nil
+	}
+	return &sqltypes.Result{RowsAffected: uint64(e.results.purge(purge.Keyspace.String()))}, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	ctx := context.Background()
+	bv1 := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.StringBindVariable("x"),
+	}
+	bv2 := map[string]*querypb.BindVariable{
+		"b": sqltypes.StringBindVariable("x"),
+		"a": sqltypes.Int64BindVariable(1),
+	}
+	assert.Equal(t, resultCacheKey(ctx, "ks@master", nil, "select 1", bv1), resultCacheKey(ctx, "ks@master", nil, "select 1", bv2))
+
+	bv2["a"] = sqltypes.StringBindVariable("1")
+	assert.NotEqual(t, resultCacheKey(ctx, "ks@master", nil, "select 1", bv1), resultCacheKey(ctx, "ks@master", nil, "select 1", bv2))
+	assert.NotEqual(t, resultCacheKey(ctx, "ks@master", nil, "select 1", bv1), resultCacheKey(ctx, "ks@replica", nil, "select 1", bv1))
+
+	tuple1 := map[string]*querypb.BindVariable{"vals": sqltypes.TestBindVariable([]interface{}{1, 2})}
+	tuple2 := map[string]*querypb.BindVariable{"vals": sqltypes.TestBindVariable([]interface{}{12})}
+	assert.NotEqual(t, resultCacheKey(ctx, "ks@master", nil, "select 1", tuple1), resultCacheKey(ctx, "ks@master", nil, "select 1", tuple2))
+
+	// The results of other users are not shared.
+	ctx1 := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("p", "", ""), callerid.NewImmediateCallerID("user1"))
+	ctx2 := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("p", "", ""), callerid.NewImmediateCallerID("user2"))
+	assert.NotEqual(t, resultCacheKey(ctx1, "ks@master", nil, "select 1", bv1), resultCacheKey(ctx2, "ks@master", nil, "select 1", bv1))
+	ctx2 = callerid.NewContext(ctx, callerid.NewEffectiveCallerID("q", "", ""), callerid.NewImmediateCallerID("user1"))
+	assert.NotEqual(t, resultCacheKey(ctx1, "ks@master", nil, "select 1", bv1), resultCacheKey(ctx2, "ks@master", nil, "select 1", bv1))
+	assert.Equal(t, resultCacheKey(ctx1, "ks@master", nil, "select 1", bv1), resultCacheKey(ctx1, "ks@master", nil, "select 1", bv1))
+
+	// Neither are the results of sessions with other system variables.
+	utc := map[string]string{"time_zone": "'+00:00'", "sql_mode": "''"}
+	assert.NotEqual(t, resultCacheKey(ctx, "ks@master", nil, "select now()", nil), resultCacheKey(ctx, "ks@master", utc, "select now()", nil))
+	assert.NotEqual(t, resultCacheKey(ctx, "ks@master", utc, "select now()", nil), resultCacheKey(ctx, "ks@master", map[string]string{"time_zone": "'+01:00'", "sql_mode": "''"}, "select now()", nil))
+	assert.Equal(t, resultCacheKey(ctx, "ks@master", utc, "select now()", nil), resultCacheKey(ctx, "ks@master", map[string]string{"sql_mode": "''", "time_zone": "'+00:00'"}, "select now()", nil))
+}
+
+func TestResultCache(t *testing.T) {
+	assert.Nil(t, newResultCache(0))
+
+	rc := newResultCache(1024)
+	qr := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+	rc.set("ks1", "k1", qr, time.Hour)
+	rc.set("ks1", "k2", qr, -time.Second)
+	rc.set("ks2", "k1", qr, time.Hour)
+
+	got, ok := rc.get("ks1", "k1")
+	require.True(t, ok)
+	assert.Equal(t, qr, got)
+	// Expired entries are dropped.
+	_, ok = rc.get("ks1", "k2")
+	assert.False(t, ok)
+	_, ok = rc.get("ks3", "k1")
+	assert.False(t, ok)
+
+	// Results larger than the capacity of a keyspace are not cached.
+	big := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "varchar"), string(make([]byte, 2048)))
+	rc.set("ks1", "big", big, time.Hour)
+	_, ok = rc.get("ks1", "big")
+	assert.False(t, ok)
+
+	assert.Equal(t, 1, rc.purge("ks1"))
+	_, ok = rc.get("ks1", "k1")
+	assert.False(t, ok)
+	_, ok = rc.get("ks2", "k1")
+	assert.True(t, ok)
+	assert.Equal(t, 1, rc.purge(""))
+	_, ok = rc.get("ks2", "k1")
+	assert.False(t, ok)
+}
+
+func TestExecutorResultCache(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	executor.results = newResultCache(1 << 20)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	exec := func(sql string, bv map[string]*querypb.BindVariable) *sqltypes.Result {
+		t.Helper()
+		qr, err := executor.Execute(context.Background(), "TestExecute", session, sql, bv)
+		require.NoError(t, err)
+		return qr
+	}
+	execCount := func() int64 {
+		return sbc1.ExecCount.Get() + sbc2.ExecCount.Get()
+	}
+
+	sql := "select /*vt+ CACHE_TTL=30s */ id from user where id = :id"
+	want := exec(sql, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)})
+	require.EqualValues(t, 1, execCount())
+	got := exec(sql, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)})
+	assert.Equal(t, want, got)
+	assert.EqualValues(t, 1, execCount())
+
+	// Other bind variables are a different entry.
+	exec(sql, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(3)})
+	assert.EqualValues(t, 2, execCount())
+
+	// Queries without the directive are never cached.
+	exec("select id from user where id = 1", nil)
+	exec("select id from user where id = 1", nil)
+	assert.EqualValues(t, 4, execCount())
+
+	// Neither are locking reads.
+	exec("select /*vt+ CACHE_TTL=30s */ id from user where id = 1 for update", nil)
+	exec("select /*vt+ CACHE_TTL=30s */ id from user where id = 1 for update", nil)
+	assert.EqualValues(t, 6, execCount())
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "purge vitess_result_cache", nil)
+	require.EqualError(t, err, "not authorized to purge the result cache")
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	vschemaacl.Init()
+	qr := exec("purge vitess_result_cache from TestExecutor", nil)
+	assert.EqualValues(t, 2, qr.RowsAffected)
+	exec(sql, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)})
+	assert.EqualValues(t, 7, execCount())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select /*vt+ CACHE_TTL=forever */ id from user", nil)
+	require.EqualError(t, err, "invalid CACHE_TTL value: forever")
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "purge vitess_result_cache TestExecutor", nil)
+	require.EqualError(t, err, "syntax error at position 39 near 'TestExecutor'")
+}
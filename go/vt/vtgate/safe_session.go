@@ -332,6 +332,15 @@ func (session *SafeSession) SetSystemVariable(name string, expr string) {
 	session.SystemVariables[name] = expr
 }
 
+// GetSystemVariables takes a visitor function that will save each system variable of the session
+func (session *SafeSession) GetSystemVariables(f func(k string, v string)) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for k, v := range session.SystemVariables {
+		f(k, v)
+	}
+}
+
 // SetOptions sets the options
 func (session *SafeSession) SetOptions(options *querypb.ExecuteOptions) {
 	session.mu.Lock()
@@ -492,6 +501,34 @@ func (session *SafeSession) GetSessionEnableSystemSettings() bool {
 	return session.EnableSystemSettings
 }
 
+// SetConsistentSnapshot set the ConsistentSnapshot setting.
+func (session *SafeSession) SetConsistentSnapshot(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.ConsistentSnapshot = enable
+}
+
+// GetConsistentSnapshot returns the ConsistentSnapshot value.
+func (session *SafeSession) GetConsistentSnapshot() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ConsistentSnapshot
+}
+
+// SetReadAsOf set the ReadAsOf setting.
+func (session *SafeSession) SetReadAsOf(seconds int64) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.ReadAsOf = seconds
+}
+
+// GetReadAsOf returns the ReadAsOf value.
+func (session *SafeSession) GetReadAsOf() int64 {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAsOf
+}
+
 // SetReadAfterWriteGTID set the ReadAfterWriteGtid setting.
 func (session *SafeSession) SetReadAfterWriteGTID(vtgtid string) {
 	session.mu.Lock()
@@ -37,6 +37,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -49,6 +50,11 @@ import (
 
 var (
 	messageStreamGracePeriod = flag.Duration("message_stream_grace_period", 30*time.Second, "the amount of time to give for a vttablet to resume if it ends a message stream, usually because of a reparent.")
+
+	scatterMaxConcurrencyPerQuery   = flag.Int("scatter_max_concurrency_per_query", 0, "Max number of shard queries that a single scatter query sends concurrently; the other shard queries wait for a slot. Streaming queries are not limited. 0 means no limit.")
+	scatterMaxConcurrencyPerSession = flag.Int("scatter_max_concurrency_per_session", 0, "Max number of shard queries that all the scatter queries of a MySQL protocol session send concurrently; the other shard queries wait for a slot. Streaming queries are not limited. 0 means no limit.")
+
+	scatterQueued = stats.NewCounter("ScatterShardQueriesQueued", "Shard queries that had to wait for a slot because of the scatter concurrency limits")
 )
 
 // ScatterConn is used for executing queries across
@@ -59,6 +65,12 @@ type ScatterConn struct {
 	txConn               *TxConn
 	gateway              Gateway
 	legacyHealthCheck    discovery.LegacyHealthCheck
+
+	// fanOuts holds the semaphores that cap the concurrent shard queries
+	// of each session, by session UUID. The SafeSession is rebuilt for
+	// every call, so the semaphores cannot live there.
+	fanOutMu sync.Mutex
+	fanOuts  map[string]*sync2.Semaphore
 }
 
 // shardActionFunc defines the contract for a shard action
@@ -215,6 +227,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if err != nil {
 				return nil, err
 			}
+			defer startShardQuery(ctx, rs.Target, queries[i].Sql, info.alias)()
 
 			switch info.actionNeeded {
 			case nothing:
@@ -326,6 +339,7 @@ func (stc *ScatterConn) StreamExecute(
 	fieldSent := false
 
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+		defer startShardQuery(ctx, rs.Target, query, nil)()
 		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars, 0, options, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
@@ -351,6 +365,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	fieldSent := false
 
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+		defer startShardQuery(ctx, rs.Target, query, nil)()
 		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
@@ -513,6 +528,87 @@ func (stc *ScatterConn) multiGo(
 	return allErrors
 }
 
+// fanOutLimiter enforces the scatter concurrency limits of a query:
+// a shard query must acquire a slot from the query semaphore, then
+// from the session one.
+type fanOutLimiter struct {
+	query, session *sync2.Semaphore
+}
+
+func (stc *ScatterConn) newFanOutLimiter(numShards int, session *SafeSession) fanOutLimiter {
+	var l fanOutLimiter
+	if *scatterMaxConcurrencyPerQuery > 0 && numShards > *scatterMaxConcurrencyPerQuery {
+		l.query = sync2.NewSemaphore(*scatterMaxConcurrencyPerQuery, 0)
+	}
+	l.session = stc.sessionFanOut(session)
+	return l
+}
+
+// sessionFanOut returns the semaphore that caps the concurrent shard
+// queries of the session, or nil if there is no cap. Sessions without
+// a UUID, like the ones of the gRPC clients, are not capped.
+func (stc *ScatterConn) sessionFanOut(session *SafeSession) *sync2.Semaphore {
+	if *scatterMaxConcurrencyPerSession <= 0 {
+		return nil
+	}
+	uuid := session.GetSessionUUID()
+	if uuid == "" {
+		return nil
+	}
+	stc.fanOutMu.Lock()
+	defer stc.fanOutMu.Unlock()
+	sem, ok := stc.fanOuts[uuid]
+	if !ok {
+		if stc.fanOuts == nil {
+			stc.fanOuts = make(map[string]*sync2.Semaphore)
+		}
+		sem = sync2.NewSemaphore(*scatterMaxConcurrencyPerSession, 0)
+		stc.fanOuts[uuid] = sem
+	}
+	return sem
+}
+
+// closeSession forgets the fan out semaphore of the session.
+func (stc *ScatterConn) closeSession(session *SafeSession) {
+	uuid := session.GetSessionUUID()
+	if uuid == "" {
+		return
+	}
+	stc.fanOutMu.Lock()
+	defer stc.fanOutMu.Unlock()
+	delete(stc.fanOuts, uuid)
+}
+
+func (l fanOutLimiter) acquire(ctx context.Context) error {
+	if l.query != nil && !acquireFanOutSlot(ctx, l.query) {
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "context expired while waiting for a scatter query slot: %v", ctx.Err())
+	}
+	if l.session != nil && !acquireFanOutSlot(ctx, l.session) {
+		if l.query != nil {
+			l.query.Release()
+		}
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "context expired while waiting for a session scatter query slot: %v", ctx.Err())
+	}
+	return nil
+}
+
+func (l fanOutLimiter) release() {
+	if l.session != nil {
+		l.session.Release()
+	}
+	if l.query != nil {
+		l.query.Release()
+	}
+}
+
+func acquireFanOutSlot(ctx context.Context, sem *sync2.Semaphore) bool {
+	if sem.TryAcquire() {
+		return true
+	}
+	scatterQueued.Add(1)
+	return sem.AcquireContext(ctx)
+}
+
 // multiGoTransaction performs the requested 'action' on the specified
 // ResolvedShards in parallel. For each shard, if the requested
 // session is in a transaction, it opens a new transactions on the connection,
@@ -538,11 +634,17 @@ func (stc *ScatterConn) multiGoTransaction(
 	if numShards == 0 {
 		return allErrors
 	}
+	limiter := stc.newFanOutLimiter(numShards, session)
 	oneShard := func(rs *srvtopo.ResolvedShard, i int) {
 		var err error
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
+		if err = limiter.acquire(ctx); err != nil {
+			return
+		}
+		defer limiter.release()
+
 		shardActionInfo := actionInfo(rs.Target, session, autocommit)
 		updated, err := action(rs, i, shardActionInfo)
 		if updated == nil {
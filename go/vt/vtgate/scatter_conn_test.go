@@ -17,7 +17,10 @@ limitations under the License.
 package vtgate
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -35,6 +38,7 @@ import (
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 )
 
 // This file uses the sandbox_test framework.
@@ -304,3 +308,101 @@ func TestReservedConnFail(t *testing.T) {
 	require.Equal(t, 1, len(session.ShardSessions))
 	assert.NotEqual(t, oldRId, session.Session.ShardSessions[0].ReservedId, "should have recreated a reserved connection since the last connection was lost")
 }
+
+func TestScatterConcurrencyLimits(t *testing.T) {
+	defer func(query, session int) {
+		*scatterMaxConcurrencyPerQuery = query
+		*scatterMaxConcurrencyPerSession = session
+	}(*scatterMaxConcurrencyPerQuery, *scatterMaxConcurrencyPerSession)
+	*scatterMaxConcurrencyPerQuery = 2
+	*scatterMaxConcurrencyPerSession = 1
+
+	keyspace := "TestScatterConcurrencyLimits"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+
+	var rss []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	var sbcs []*sandboxconn.SandboxConn
+	for i := 0; i < 4; i++ {
+		shard := fmt.Sprint(i)
+		sbc := hc.AddTestTablet("aa", shard, 1, keyspace, shard, topodatapb.TabletType_MASTER, true, 1, nil)
+		sbcs = append(sbcs, sbc)
+		rss = append(rss, &srvtopo.ResolvedShard{
+			Target:  &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_MASTER},
+			Gateway: sbc,
+		})
+		queries = append(queries, &querypb.BoundQuery{Sql: "query"})
+	}
+
+	qr, errs := sc.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(&vtgatepb.Session{Autocommit: true}), true /*autocommit*/, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Len(t, qr.Rows, 4)
+	for _, sbc := range sbcs {
+		assert.EqualValues(t, 1, sbc.ExecCount.Get())
+	}
+}
+
+func TestFanOutLimiter(t *testing.T) {
+	defer func(query, session int) {
+		*scatterMaxConcurrencyPerQuery = query
+		*scatterMaxConcurrencyPerSession = session
+	}(*scatterMaxConcurrencyPerQuery, *scatterMaxConcurrencyPerSession)
+
+	sc := &ScatterConn{}
+
+	// No limits.
+	*scatterMaxConcurrencyPerQuery = 0
+	*scatterMaxConcurrencyPerSession = 0
+	l := sc.newFanOutLimiter(10, NewSafeSession(&vtgatepb.Session{SessionUUID: "uuid"}))
+	assert.Nil(t, l.query)
+	assert.Nil(t, l.session)
+
+	// The query limit only applies to queries that exceed it.
+	*scatterMaxConcurrencyPerQuery = 2
+	l = sc.newFanOutLimiter(2, NewSafeSession(nil))
+	assert.Nil(t, l.query)
+
+	// Sessions without a UUID are not capped.
+	*scatterMaxConcurrencyPerSession = 3
+	l = sc.newFanOutLimiter(10, NewSafeSession(nil))
+	assert.Nil(t, l.session)
+
+	// Each call wraps the session in a new SafeSession: the limiters
+	// must still share the semaphore of the session.
+	session := &vtgatepb.Session{SessionUUID: "uuid"}
+	l1 := sc.newFanOutLimiter(10, NewSafeSession(session))
+	l2 := sc.newFanOutLimiter(10, NewSafeSession(session))
+	assert.True(t, l1.session == l2.session, "the limiters of a session must share the session semaphore")
+	l3 := sc.newFanOutLimiter(10, NewAutocommitSession(session))
+	assert.True(t, l1.session == l3.session, "the autocommit queries of a session must share the session semaphore")
+	l4 := sc.newFanOutLimiter(10, NewSafeSession(&vtgatepb.Session{SessionUUID: "other"}))
+	assert.True(t, l1.session != l4.session, "sessions must not share their semaphores")
+
+	// Two slots for l1, then one for l2 uses up the session.
+	require.NoError(t, l1.acquire(context.Background()))
+	require.NoError(t, l1.acquire(context.Background()))
+	require.NoError(t, l2.acquire(context.Background()))
+
+	queued := scatterQueued.Get()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := l2.acquire(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "context expired while waiting for a session scatter query slot")
+	assert.Equal(t, queued+1, scatterQueued.Get())
+	// The failed acquisition must not leak the query slot.
+	assert.Equal(t, 1, l2.query.Size())
+
+	done := make(chan error)
+	go func() {
+		done <- l2.acquire(context.Background())
+	}()
+	l1.release()
+	require.NoError(t, <-done)
+
+	sc.closeSession(NewSafeSession(session))
+	assert.NotContains(t, sc.fanOuts, "uuid")
+	assert.Contains(t, sc.fanOuts, "other")
+}
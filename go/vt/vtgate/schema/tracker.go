@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema contains the vtgate schema tracker. It keeps the column
+// lists of the tables of each keyspace, as reported by the master tablets
+// found through the healthcheck, so the planner can use them instead of
+// hand-maintained authoritative columns in the VSchema.
+package schema
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// mysqlColumnsQuery loads the columns of all the tables of the keyspace
+// database, in table definition order.
+const mysqlColumnsQuery = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() order by table_name, ordinal_position"
+
+// Tracker keeps the column lists of the tables of every keyspace it has
+// seen a serving master tablet for.
+type Tracker struct {
+	ch              chan *discovery.TabletHealth
+	refreshInterval time.Duration
+	cancel          context.CancelFunc
+	wg              sync.WaitGroup
+
+	mu     sync.Mutex
+	tables map[string]map[string][]vindexes.Column
+	loaded map[string]time.Time
+	signal func()
+}
+
+// NewTracker creates a tracker reading health updates from ch. The
+// columns of a keyspace are loaded from the first serving master tablet
+// of that keyspace, and reloaded once refreshInterval elapsed.
+func NewTracker(ch chan *discovery.TabletHealth, refreshInterval time.Duration) *Tracker {
+	return &Tracker{
+		ch:              ch,
+		refreshInterval: refreshInterval,
+		tables:          make(map[string]map[string][]vindexes.Column),
+		loaded:          make(map[string]time.Time),
+	}
+}
+
+// RegisterSignalReceiver sets the function called every time the
+// tracked schema of a keyspace changes.
+func (t *Tracker) RegisterSignalReceiver(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.signal = f
+}
+
+// Start starts processing the health updates in the background.
+func (t *Tracker) Start() {
+	log.Info("Starting schema tracking")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		for {
+			select {
+			case th := <-t.ch:
+				t.processHealth(ctx, th)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the tracker.
+func (t *Tracker) Stop() {
+	log.Info("Stopping schema tracking")
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.wg.Wait()
+}
+
+// Tables returns the tracked tables of the keyspace with their columns.
+// The returned map must not be modified.
+func (t *Tracker) Tables(keyspace string) map[string][]vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace]
+}
+
+// GetColumns returns the tracked columns of the table, or nil if the
+// table is not known.
+func (t *Tracker) GetColumns(keyspace, table string) []vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace][table]
+}
+
+func (t *Tracker) processHealth(ctx context.Context, th *discovery.TabletHealth) {
+	if th == nil || !th.Serving || th.Conn == nil || th.Target.TabletType != topodatapb.TabletType_MASTER {
+		return
+	}
+	keyspace := th.Target.Keyspace
+	t.mu.Lock()
+	lastLoad, ok := t.loaded[keyspace]
+	t.mu.Unlock()
+	if ok && time.Since(lastLoad) < t.refreshInterval {
+		return
+	}
+	if err := t.loadKeyspace(ctx, th); err != nil {
+		log.Warningf("Unable to load the schema of keyspace %v from %v: %v", keyspace, th.Tablet.Alias, err)
+	}
+}
+
+func (t *Tracker) loadKeyspace(ctx context.Context, th *discovery.TabletHealth) error {
+	qr, err := th.Conn.Execute(ctx, th.Target, mysqlColumnsQuery, nil, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	tables := make(map[string][]vindexes.Column)
+	for _, row := range qr.Rows {
+		tableName := row[0].ToString()
+		unsigned := strings.Contains(strings.ToLower(row[3].ToString()), "unsigned")
+		tables[tableName] = append(tables[tableName], vindexes.Column{
+			Name: sqlparser.NewColIdent(row[1].ToString()),
+			Type: sqlparser.SQLTypeToQueryType(row[2].ToString(), unsigned),
+		})
+	}
+
+	keyspace := th.Target.Keyspace
+	t.mu.Lock()
+	t.loaded[keyspace] = time.Now()
+	changed := !tablesEqual(t.tables[keyspace], tables)
+	if changed {
+		t.tables[keyspace] = tables
+	}
+	signal := t.signal
+	t.mu.Unlock()
+
+	if changed && signal != nil {
+		signal()
+	}
+	return nil
+}
+
+func tablesEqual(a, b map[string][]vindexes.Column) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, aCols := range a {
+		bCols, ok := b[name]
+		if !ok || len(aCols) != len(bCols) {
+			return false
+		}
+		for i := range aCols {
+			if !aCols[i].Name.Equal(bCols[i].Name) || aCols[i].Type != bCols[i].Type {
+				return false
+			}
+		}
+	}
+	return true
+}
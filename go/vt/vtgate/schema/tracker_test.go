@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestTracking(t *testing.T) {
+	tablet := topo.NewTablet(1, "cell", "host")
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	fields := sqltypes.MakeTestFields("table_name|column_name|data_type|column_type", "varchar|varchar|varchar|varchar")
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(fields,
+			"t1|id|bigint|bigint(20) unsigned",
+			"t1|name|varchar|varchar(255)",
+			"t2|id|int|int(11)",
+		),
+		sqltypes.MakeTestResult(fields,
+			"t1|id|bigint|bigint(20) unsigned",
+		),
+	})
+
+	ch := make(chan *discovery.TabletHealth)
+	tracker := NewTracker(ch, 0)
+	signals := make(chan struct{}, 10)
+	tracker.RegisterSignalReceiver(func() {
+		signals <- struct{}{}
+	})
+	tracker.Start()
+	defer tracker.Stop()
+
+	th := &discovery.TabletHealth{
+		Conn:    sbc,
+		Tablet:  tablet,
+		Target:  &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_MASTER},
+		Serving: true,
+	}
+	ch <- th
+	waitForSignal(t, signals)
+
+	columns := tracker.GetColumns("ks", "t1")
+	require.Len(t, columns, 2)
+	assert.Equal(t, "id", columns[0].Name.String())
+	assert.Equal(t, querypb.Type_UINT64, columns[0].Type)
+	assert.Equal(t, "name", columns[1].Name.String())
+	assert.Equal(t, querypb.Type_VARCHAR, columns[1].Type)
+	require.Len(t, tracker.GetColumns("ks", "t2"), 1)
+	assert.Equal(t, querypb.Type_INT32, tracker.GetColumns("ks", "t2")[0].Type)
+
+	// A replica does not trigger a load.
+	ch <- &discovery.TabletHealth{
+		Conn:    sbc,
+		Tablet:  tablet,
+		Target:  &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_REPLICA},
+		Serving: true,
+	}
+
+	// The next master update reloads the schema, t2 is gone.
+	ch <- th
+	waitForSignal(t, signals)
+	assert.Nil(t, tracker.GetColumns("ks", "t2"))
+	assert.Len(t, tracker.Tables("ks"), 1)
+	assert.EqualValues(t, 2, sbc.ExecCount.Get())
+}
+
+func waitForSignal(t *testing.T, signals chan struct{}) {
+	t.Helper()
+	select {
+	case <-signals:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for schema change signal")
+	}
+}
@@ -112,6 +112,8 @@ func (a *analyzer) analyzeTableExpr(tableExpr sqlparser.TableExpr) error {
 		}
 	case *sqlparser.ParenTableExpr:
 		return a.analyzeTableExprs(table.Exprs)
+	case *sqlparser.JSONTableExpr:
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: JSON_TABLE")
 	}
 	return nil
 }
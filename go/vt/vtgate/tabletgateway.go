@@ -235,7 +235,18 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			}
 		}
 
-		tablets := gw.hc.GetHealthyTabletStats(target)
+		var tablets []*discovery.TabletHealth
+		if asOf, ok := readAsOfFromContext(ctx); ok && target.TabletType != topodatapb.TabletType_MASTER {
+			tablets, err = gw.delayedTablets(target, asOf)
+			if err != nil {
+				break
+			}
+		} else {
+			tablets = gw.hc.GetHealthyTabletStats(target)
+			if target.TabletType != topodatapb.TabletType_MASTER {
+				tablets = gw.drainCells(target, tablets)
+			}
+		}
 		if len(tablets) == 0 {
 			// fail fast if there is no tablet
 			err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no valid tablet")
@@ -267,6 +278,7 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			continue
 		}
 
+		setShardQueryTablet(ctx, target, tabletLastUsed.Alias)
 		startTime := time.Now()
 		var canRetry bool
 		canRetry, err = inner(ctx, target, th.Conn)
@@ -302,6 +314,46 @@ func (gw *TabletGateway) getStatsAggregator(target *querypb.Target) *TabletStatu
 	return aggr
 }
 
+// drainCells moves the reads away from the tablets of the draining cells:
+// a cell draining at N% loses N% of the reads, picked at random. They go
+// to the other healthy tablets, or else to the serving tablets of the
+// other watched cells. If there are none, the draining tablets still
+// serve the reads.
+func (gw *TabletGateway) drainCells(target *querypb.Target, tablets []*discovery.TabletHealth) []*discovery.TabletHealth {
+	drained := make(map[string]bool)
+	isDrained := func(th *discovery.TabletHealth) bool {
+		percent := th.Stats.GetCellDrainPercent()
+		if percent <= 0 {
+			return false
+		}
+		cell := th.Tablet.Alias.Cell
+		if _, ok := drained[cell]; !ok {
+			drained[cell] = rand.Int31n(100) < percent
+		}
+		return drained[cell]
+	}
+	var kept []*discovery.TabletHealth
+	for _, th := range tablets {
+		if !isDrained(th) {
+			kept = append(kept, th)
+		}
+	}
+	if len(kept) == len(tablets) {
+		return tablets
+	}
+	if len(kept) == 0 {
+		for _, th := range gw.hc.GetServingTabletStats(target) {
+			if !isDrained(th) {
+				kept = append(kept, th)
+			}
+		}
+	}
+	if len(kept) == 0 {
+		return tablets
+	}
+	return kept
+}
+
 func (gw *TabletGateway) shuffleTablets(cell string, tablets []*discovery.TabletHealth) {
 	sameCell, diffCell, sameCellMax := 0, 0, -1
 	length := len(tablets)
@@ -33,9 +33,11 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -186,6 +188,97 @@ func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	verifyContainsError(t, err, "query service can only be used for non-transactional queries on replicas", vtrpcpb.Code_INTERNAL)
 }
 
+func TestTabletGatewayReadAsOf(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	host := "1.1.1.1"
+	port := int32(1001)
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_RDONLY,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+
+	sc1 := hc.AddTestTablet("cell", host, port, keyspace, shard, topodatapb.TabletType_RDONLY, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", host, port+1, keyspace, shard, topodatapb.TabletType_RDONLY, true, 10, nil)
+	sc3 := hc.AddTestTablet("cell", host, port+2, keyspace, shard, topodatapb.TabletType_RDONLY, true, 10, nil)
+	sc2.Tablet().Tags = map[string]string{discovery.DelayedReplicaTag: "true"}
+	sc3.Tablet().Tags = map[string]string{discovery.DelayedReplicaTag: "true"}
+	for _, th := range hc.GetDelayedTabletStats(target) {
+		if th.Tablet == sc2.Tablet() {
+			th.Stats.SecondsBehindMaster = 600
+		} else {
+			th.Stats.SecondsBehindMaster = 3600
+		}
+	}
+
+	// The delayed replicas do not serve the current data.
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get())
+
+	readAsOf := func(seconds int64) context.Context {
+		return withReadAsOf(context.Background(), NewSafeSession(&vtgatepb.Session{ReadAsOf: seconds}))
+	}
+	_, err = tg.Execute(readAsOf(630), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sc2.ExecCount.Get())
+	assert.EqualValues(t, 0, sc3.ExecCount.Get())
+
+	_, err = tg.Execute(readAsOf(1800), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no delayed replica with a replication lag within 1m0s of 30m0s", vtrpcpb.Code_UNAVAILABLE)
+
+	target.TabletType = topodatapb.TabletType_REPLICA
+	_, err = tg.Execute(readAsOf(600), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "reads with @@read_as_of need an rdonly target", vtrpcpb.Code_FAILED_PRECONDITION)
+}
+
+func TestTabletGatewayDrainCells(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	host := "1.1.1.1"
+	port := int32(1001)
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell1")
+
+	sc1 := hc.AddTestTablet("cell1", host, port, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell2", host, port+1, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	setDrain := func(sc *sandboxconn.SandboxConn, percent int32) {
+		for _, th := range hc.GetHealthyTabletStats(target) {
+			if th.Tablet == sc.Tablet() {
+				th.Stats.CellDrainPercent = percent
+			}
+		}
+	}
+
+	// The reads prefer the local cell.
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get())
+
+	// A drained cell does not serve the reads.
+	setDrain(sc1, 100)
+	for i := 0; i < 10; i++ {
+		_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 1, sc1.ExecCount.Get())
+	assert.EqualValues(t, 10, sc2.ExecCount.Get())
+
+	// Unless all the cells are drained.
+	setDrain(sc2, 100)
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sc1.ExecCount.Get())
+}
+
 func testTabletGatewayGeneric(t *testing.T, f func(tg *TabletGateway, target *querypb.Target) error) {
 	t.Helper()
 	keyspace := "ks"
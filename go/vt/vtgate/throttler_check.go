@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ThrottlerCheckHandler is the URL path of the keyspace-wide throttler check.
+// It differs from the vttablet "/throttler/check" path so both can be
+// served by the same process in vtcombo.
+const ThrottlerCheckHandler = "/throttler/check-keyspace"
+
+var throttlerCheckThreshold = flag.Duration("throttler_check_threshold", 5*time.Second, "replication lag above which the vtgate throttler check asks batch writers to back off")
+
+// ThrottlerCheckResult is the result of a keyspace-wide throttler check.
+// It is exported as JSON by the /throttler/check-keyspace endpoint, and its
+// StatusCode is also used as the HTTP status of the response.
+type ThrottlerCheckResult struct {
+	StatusCode int
+	Keyspace   string
+	// Value is the highest replication lag, in seconds, reported by
+	// the serving replicas of the keyspace.
+	Value     float64
+	Threshold float64
+	// Tablet is the alias of the tablet with the highest lag, or of the
+	// replica that does not report its lag.
+	Tablet  string
+	Message string
+}
+
+// checkReplicationLag aggregates the replication lag of all REPLICA
+// tablets of the keyspace across all shards. It returns http.StatusOK if
+// writes can proceed, and http.StatusTooManyRequests if any replica lags
+// more than threshold, or does not serve or report its lag: such a replica
+// may be the one that lags most. It returns http.StatusNotFound if no
+// tablet of the keyspace is known, or if a shard has no replica that
+// reports its lag, since the lag of the shard is unknown then.
+func checkReplicationLag(cacheStatus discovery.TabletsCacheStatusList, keyspace string, threshold time.Duration) *ThrottlerCheckResult {
+	result := &ThrottlerCheckResult{
+		Keyspace:  keyspace,
+		Threshold: threshold.Seconds(),
+	}
+	// reporting tells, for every shard of the keyspace, whether one of
+	// its replicas reports its lag.
+	reporting := make(map[string]bool)
+	var shards []string
+	unhealthy := ""
+	for _, tcs := range cacheStatus {
+		if tcs.Target.Keyspace != keyspace {
+			continue
+		}
+		if _, ok := reporting[tcs.Target.Shard]; !ok {
+			reporting[tcs.Target.Shard] = false
+			shards = append(shards, tcs.Target.Shard)
+		}
+		if tcs.Target.TabletType != topodatapb.TabletType_REPLICA {
+			continue
+		}
+		for _, th := range tcs.TabletsStats {
+			alias := topoproto.TabletAliasString(th.Tablet.Alias)
+			if !th.Serving || th.Stats == nil || th.Stats.HealthError != "" || th.LastError != nil {
+				if unhealthy == "" {
+					unhealthy = alias
+				}
+				continue
+			}
+			reporting[tcs.Target.Shard] = true
+			lag := float64(th.Stats.SecondsBehindMaster)
+			if lag > result.Value || result.Tablet == "" {
+				result.Value = lag
+				result.Tablet = alias
+			}
+		}
+	}
+
+	if len(shards) == 0 {
+		result.StatusCode = http.StatusNotFound
+		result.Message = fmt.Sprintf("no tablet found for keyspace %v", keyspace)
+		return result
+	}
+	for _, shard := range shards {
+		if !reporting[shard] {
+			result.StatusCode = http.StatusNotFound
+			result.Message = fmt.Sprintf("no replica reports its replication lag in shard %v/%v", keyspace, shard)
+			return result
+		}
+	}
+	switch {
+	case unhealthy != "":
+		result.StatusCode = http.StatusTooManyRequests
+		result.Tablet = unhealthy
+		result.Message = fmt.Sprintf("replica %v does not serve or report its replication lag", unhealthy)
+	case result.Value > result.Threshold:
+		result.StatusCode = http.StatusTooManyRequests
+		result.Message = fmt.Sprintf("replication lag of %v is %vs, above threshold %vs", result.Tablet, result.Value, result.Threshold)
+	default:
+		result.StatusCode = http.StatusOK
+	}
+	return result
+}
+
+// initThrottlerCheck registers the /throttler/check-keyspace endpoint. It lets
+// batch jobs ask a single vtgate whether a keyspace can take more writes,
+// instead of discovering and polling every tablet themselves.
+//
+// Parameters are "keyspace" (required) and "threshold" (optional, a
+// duration overriding -throttler_check_threshold).
+func initThrottlerCheck(hc discovery.HealthCheck) {
+	http.HandleFunc(ThrottlerCheckHandler, func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.MONITORING); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		keyspace := r.FormValue("keyspace")
+		if keyspace == "" {
+			http.Error(w, "missing keyspace parameter", http.StatusBadRequest)
+			return
+		}
+		threshold := *throttlerCheckThreshold
+		if value := r.FormValue("threshold"); value != "" {
+			var err error
+			if threshold, err = time.ParseDuration(value); err != nil {
+				http.Error(w, fmt.Sprintf("invalid threshold %q: %v", value, err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		result := checkReplicationLag(hc.CacheStatus(), keyspace, threshold)
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			http.Error(w, fmt.Sprintf("cannot marshal data: %v", err), http.StatusInternalServerError)
+			return
+		}
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", jsonContentType)
+		}
+		w.WriteHeader(result.StatusCode)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestCheckReplicationLag(t *testing.T) {
+	replica := func(uid uint32, shard string, lag uint32, serving bool, err error) *discovery.TabletsCacheStatus {
+		return &discovery.TabletsCacheStatus{
+			Cell:   "cell",
+			Target: &querypb.Target{Keyspace: "ks", Shard: shard, TabletType: topodatapb.TabletType_REPLICA},
+			TabletsStats: discovery.TabletStatsList{{
+				Tablet:    topo.NewTablet(uid, "cell", "host"),
+				Serving:   serving,
+				Stats:     &querypb.RealtimeStats{SecondsBehindMaster: lag},
+				LastError: err,
+			}},
+		}
+	}
+	master := &discovery.TabletsCacheStatus{
+		Cell:   "cell",
+		Target: &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_MASTER},
+		TabletsStats: discovery.TabletStatsList{{
+			Tablet:  topo.NewTablet(1, "cell", "host"),
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{SecondsBehindMaster: 100},
+		}},
+	}
+
+	status := discovery.TabletsCacheStatusList{
+		master,
+		replica(2, "-80", 1, true, nil),
+		replica(3, "80-", 3, true, nil),
+	}
+
+	result := checkReplicationLag(status, "ks", 5*time.Second)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, 3.0, result.Value)
+	assert.Equal(t, "cell-0000000003", result.Tablet)
+
+	result = checkReplicationLag(status, "ks", 2*time.Second)
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, 2.0, result.Threshold)
+
+	result = checkReplicationLag(status, "other", 5*time.Second)
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+
+	// A replica that does not serve, or whose lag is unknown, may be
+	// the one that lags most.
+	for _, broken := range []*discovery.TabletsCacheStatus{
+		replica(4, "80-", 0, false, nil),
+		replica(4, "80-", 0, true, errors.New("tablet error")),
+	} {
+		result = checkReplicationLag(append(status, broken), "ks", 5*time.Second)
+		assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+		assert.Equal(t, "cell-0000000004", result.Tablet)
+	}
+	unhealthy := replica(4, "80-", 0, true, nil)
+	unhealthy.TabletsStats[0].Stats.HealthError = "replication not running"
+	result = checkReplicationLag(append(status, unhealthy), "ks", 5*time.Second)
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+
+	// A shard without a replica that reports its lag fails the check.
+	result = checkReplicationLag(discovery.TabletsCacheStatusList{master, replica(3, "80-", 3, true, nil)}, "ks", 5*time.Second)
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+	assert.Contains(t, result.Message, "ks/-80")
+	result = checkReplicationLag(discovery.TabletsCacheStatusList{replica(2, "-80", 1, false, nil), replica(3, "80-", 3, true, nil)}, "ks", 5*time.Second)
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+}
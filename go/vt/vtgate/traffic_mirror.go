@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	trafficMirrorRules          = flag.String("traffic_mirror_rules", "", "Comma separated list of from_keyspace=to_keyspace rules. The selects routed to from_keyspace are also sent in the background to to_keyspace, for example to validate the target keyspace of a MoveTables under production load before switching traffic. The mirrored selects ignore the routing rules, and their results are discarded, or compared with -traffic_mirror_compare_results.")
+	trafficMirrorPercent        = flag.Float64("traffic_mirror_percent", 100, "Percentage (0-100) of the selects matching -traffic_mirror_rules that are mirrored.")
+	trafficMirrorCompare        = flag.Bool("traffic_mirror_compare_results", false, "If true, the rows returned by the mirrored selects are compared with the ones of the original keyspace, regardless of their order, and the differences are logged.")
+	trafficMirrorMaxConcurrency = flag.Int("traffic_mirror_max_concurrency", 100, "Maximum number of selects mirrored to another keyspace running at the same time. Selects over the limit are not mirrored.")
+	trafficMirrorTimeout        = flag.Duration("traffic_mirror_timeout", 10*time.Second, "Timeout of a select mirrored to another keyspace.")
+
+	mirroredQueries      = stats.NewCountersWithMultiLabels("MirroredQueries", "Selects mirrored to another keyspace, by source keyspace, target keyspace and result", []string{"FromKeyspace", "ToKeyspace", "Result"})
+	mirroredQueryTimings = stats.NewMultiTimings("MirroredQueryTimings", "Latency of the selects mirrored to another keyspace, by source and target keyspace", []string{"FromKeyspace", "ToKeyspace"})
+)
+
+// parseTrafficMirrorRules parses the -traffic_mirror_rules flag into
+// a map of target keyspaces by source keyspace.
+func parseTrafficMirrorRules(rules string) (map[string]string, error) {
+	parsed := make(map[string]string)
+	for _, rule := range strings.Split(rules, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		parts := strings.Split(rule, "=")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid traffic mirror rule %q, expected from_keyspace=to_keyspace", rule)
+		}
+		if parts[0] == parts[1] {
+			return nil, fmt.Errorf("invalid traffic mirror rule %q, the keyspaces must be different", rule)
+		}
+		if _, ok := parsed[parts[0]]; ok {
+			return nil, fmt.Errorf("keyspace %s is mirrored more than once", parts[0])
+		}
+		parsed[parts[0]] = parts[1]
+	}
+	return parsed, nil
+}
+
+// trafficMirror sends the selects routed to a keyspace to another
+// keyspace too, in the background.
+type trafficMirror struct {
+	rules    map[string]string
+	percent  float64
+	compare  bool
+	workers  *mirrorWorkers
+	random   func() float64
+	errorLog *logutil.ThrottledLogger
+}
+
+// newTrafficMirror returns a trafficMirror, or nil if there are no rules.
+func newTrafficMirror(rules string, percent float64, compare bool, maxConcurrency int, timeout time.Duration) *trafficMirror {
+	parsed, err := parseTrafficMirrorRules(rules)
+	if err != nil {
+		log.Errorf("Traffic mirroring is disabled: %v", err)
+		return nil
+	}
+	if len(parsed) == 0 || percent <= 0 || maxConcurrency <= 0 {
+		return nil
+	}
+	return &trafficMirror{
+		rules:    parsed,
+		percent:  percent,
+		compare:  compare,
+		workers:  newMirrorWorkers(maxConcurrency, timeout),
+		random:   rand.Float64,
+		errorLog: logutil.NewThrottledLogger("MirroredQueries", 10*time.Second),
+	}
+}
+
+// targetKeyspace returns the keyspace the select of plan should
+// be mirrored to, or "" if it should not be mirrored.
+func (tm *trafficMirror) targetKeyspace(plan *engine.Plan, vcursor *vcursorImpl, safeSession *SafeSession) string {
+	to, ok := tm.rules[plan.Instructions.GetKeyspaceName()]
+	if !ok || !canMirror(plan, vcursor, safeSession) {
+		return ""
+	}
+	if tm.random()*100 >= tm.percent {
+		return ""
+	}
+	return to
+}
+
+// mirror plans the select again for the keyspace to, without the routing
+// rules, and executes it in the background. The table names qualified
+// with the source keyspace are qualified with the target one instead.
+// qr is the result of the original select.
+func (tm *trafficMirror) mirror(ctx context.Context, e *Executor, to string, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, qr *sqltypes.Result) {
+	from := plan.Instructions.GetKeyspaceName()
+	session := mirrorSession(vcursor.safeSession, to+"@"+topoproto.TabletTypeLString(vcursor.tabletType))
+	vars := copyBindVars(bindVars)
+	vschema := *vcursor.vschema
+	vschema.RoutingRules = nil
+
+	ok := tm.workers.run(ctx, func(ctx context.Context) {
+		err := tm.execute(ctx, e, from, to, session, plan, vcursor, &vschema, vars, qr)
+		if err != nil {
+			mirroredQueries.Add([]string{from, to, "Error"}, 1)
+			tm.errorLog.Warningf("Select mirrored from keyspace %s to keyspace %s failed: %v", from, to, err)
+		}
+	})
+	if !ok {
+		mirroredQueries.Add([]string{from, to, "Dropped"}, 1)
+	}
+}
+
+func (tm *trafficMirror) execute(ctx context.Context, e *Executor, from, to string, session *SafeSession, plan *engine.Plan, vcursor *vcursorImpl, vschema *vindexes.VSchema, vars map[string]*querypb.BindVariable, qr *sqltypes.Result) error {
+	mirrorCursor, err := newVCursorImpl(ctx, session, vcursor.marginComments, e, NewLogStats(ctx, "MirrorQuery", plan.Original, nil), e.vm, vschema, e.resolver.resolver, e.serv)
+	if err != nil {
+		return err
+	}
+	mirrorCursor.ignoreMaxMemoryRows = vcursor.ignoreMaxMemoryRows
+
+	stmt, err := sqlparser.Parse(plan.Original)
+	if err != nil {
+		return err
+	}
+	requalifyTables(stmt, from, to)
+	// The plan is not cached: it would be found by the queries
+	// that really target the keyspace, and it ignores the routing rules.
+	mirrorPlan, err := planbuilder.BuildFromStmt(sqlparser.String(stmt), stmt, mirrorCursor, plan.BindVarNeeds)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	mirrorQr, err := mirrorPlan.Instructions.Execute(mirrorCursor, vars, true)
+	mirroredQueryTimings.Record([]string{from, to}, start)
+	if err != nil {
+		return err
+	}
+	if !tm.compare {
+		mirroredQueries.Add([]string{from, to, "Success"}, 1)
+		return nil
+	}
+	if diff := diffRows(qr, mirrorQr); diff != "" {
+		mirroredQueries.Add([]string{from, to, "Mismatch"}, 1)
+		tm.errorLog.Warningf("Select mirrored from keyspace %s to keyspace %s returned different rows: %q: %s", from, to, plan.Original, diff)
+		return nil
+	}
+	mirroredQueries.Add([]string{from, to, "Match"}, 1)
+	return nil
+}
+
+// requalifyTables replaces the qualifier from by to in the table
+// and column names of stmt.
+func requalifyTables(stmt sqlparser.Statement, from, to string) {
+	requalify := func(name sqlparser.TableName) sqlparser.TableName {
+		if name.Qualifier.String() == from {
+			name.Qualifier = sqlparser.NewTableIdent(to)
+		}
+		return name
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if name, ok := node.Expr.(sqlparser.TableName); ok {
+				node.Expr = requalify(name)
+			}
+		case *sqlparser.ColName:
+			node.Qualifier = requalify(node.Qualifier)
+		case *sqlparser.StarExpr:
+			node.TableName = requalify(node.TableName)
+		}
+		return true, nil
+	}, stmt)
+}
+
+// diffRows compares the rows of two results regardless of their order.
+// It returns a description of the first difference, or "" if they match.
+func diffRows(want, got *sqltypes.Result) string {
+	if len(want.Rows) != len(got.Rows) {
+		return fmt.Sprintf("%d rows instead of %d", len(got.Rows), len(want.Rows))
+	}
+	wantRows, gotRows := sortedRows(want), sortedRows(got)
+	for i := range wantRows {
+		if wantRows[i] != gotRows[i] {
+			return fmt.Sprintf("row %s instead of %s", gotRows[i], wantRows[i])
+		}
+	}
+	return ""
+}
+
+func sortedRows(qr *sqltypes.Result) []string {
+	rows := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		rows = append(rows, fmt.Sprintf("%v", row))
+	}
+	sort.Strings(rows)
+	return rows
+}
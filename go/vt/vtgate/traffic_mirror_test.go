@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestParseTrafficMirrorRules(t *testing.T) {
+	rules, err := parseTrafficMirrorRules("")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	rules, err = parseTrafficMirrorRules("commerce=customer, lookup=lookup2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"commerce": "customer", "lookup": "lookup2"}, rules)
+
+	for _, invalid := range []string{"commerce", "commerce=", "commerce=commerce", "a=b,a=c"} {
+		_, err := parseTrafficMirrorRules(invalid)
+		assert.Error(t, err, invalid)
+	}
+	assert.Nil(t, newTrafficMirror("commerce", 100, false, 10, time.Second))
+}
+
+func TestRequalifyTables(t *testing.T) {
+	stmt, err := sqlparser.Parse("select a.*, a.id, commerce.b.id from commerce.a join commerce.b on a.id = b.id join other.c")
+	require.NoError(t, err)
+	requalifyTables(stmt, "commerce", "customer")
+	assert.Equal(t, "select a.*, a.id, customer.b.id from customer.a join customer.b on a.id = b.id join other.c", sqlparser.String(stmt))
+}
+
+func TestDiffRows(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+	qr := sqltypes.MakeTestResult(fields, "1|a", "2|b")
+	assert.Equal(t, "", diffRows(qr, sqltypes.MakeTestResult(fields, "2|b", "1|a")))
+	assert.Equal(t, "1 rows instead of 2", diffRows(qr, sqltypes.MakeTestResult(fields, "1|a")))
+	assert.Equal(t, `row [INT64(2) VARCHAR("c")] instead of [INT64(2) VARCHAR("b")]`, diffRows(qr, sqltypes.MakeTestResult(fields, "1|a", "2|c")))
+}
+
+func TestTrafficMirror(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	executor.mirror = newTrafficMirror("TestExecutor="+KsTestUnsharded, 100, true, 10, time.Second)
+	require.NotNil(t, executor.mirror)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	fields := sqltypes.MakeTestFields("id", "int64")
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1")})
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1")})
+	counts := mirroredQueries.Counts()
+	_, err := executor.Execute(context.Background(), "TestTrafficMirror", session, "select id from TestExecutor.user where id = 1", nil)
+	require.NoError(t, err)
+	executor.mirror.workers.wg.Wait()
+	require.Len(t, sbclookup.Queries, 1)
+	assert.Equal(t, "select id from `user` where id = 1", sbclookup.Queries[0].Sql)
+	assert.Equal(t, counts["TestExecutor.TestUnsharded.Match"]+1, mirroredQueries.Counts()["TestExecutor.TestUnsharded.Match"])
+
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "1")})
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(fields, "2")})
+	_, err = executor.Execute(context.Background(), "TestTrafficMirror", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	executor.mirror.workers.wg.Wait()
+	assert.Equal(t, counts["TestExecutor.TestUnsharded.Mismatch"]+1, mirroredQueries.Counts()["TestExecutor.TestUnsharded.Mismatch"])
+
+	// Writes and selects routed to other keyspaces are not mirrored.
+	sbclookup.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestTrafficMirror", session, "update user set a = 2 where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestTrafficMirror", session, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	executor.mirror.workers.wg.Wait()
+	assert.Len(t, sbclookup.Queries, 1)
+}
@@ -332,6 +332,11 @@ func (vc *vcursorImpl) SysVarSetEnabled() bool {
 	return vc.GetSessionEnableSystemSettings()
 }
 
+// CharsetSysVarSetEnabled implements the ContextVSchema interface
+func (vc *vcursorImpl) CharsetSysVarSetEnabled() bool {
+	return *charsetSysVarSetEnabled
+}
+
 // KeyspaceExists provides whether the keyspace exists or not.
 func (vc *vcursorImpl) KeyspaceExists(ks string) bool {
 	return vc.vschema.Keyspaces[ks] != nil
@@ -564,10 +569,90 @@ func (vc *vcursorImpl) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	if err != nil {
 		return err
 	}
+	if onlineDDl.IsSingleton() || onlineDDl.IsSingletonContext() {
+		// The requests are locked so that two singleton migrations
+		// submitted at the same time cannot both be accepted.
+		_, err := conn.Create(vc.ctx, fmt.Sprintf("%s/sentry", schema.MigrationRequestsPath()), []byte{})
+		if err != nil && !topo.IsErrType(err, topo.NodeExists) {
+			return err
+		}
+		lockDescriptor, err := conn.Lock(vc.ctx, schema.MigrationRequestsPath(), "vtgate.SubmitOnlineDDL")
+		if err != nil {
+			return err
+		}
+		defer lockDescriptor.Unlock(vc.ctx)
+
+		if err := vc.checkSingletonOnlineDDL(conn, onlineDDl); err != nil {
+			return err
+		}
+	}
 	// Submit an online schema change by writing a migration request in topo
 	return onlineDDl.WriteTopo(vc.ctx, conn, schema.MigrationRequestsPath())
 }
 
+// checkSingletonOnlineDDL returns an error if a migration submitted with
+// --singleton or --singleton-context conflicts with the pending migrations
+// of its keyspace: with --singleton there must be none, with
+// --singleton-context they must all have been submitted in its context.
+func (vc *vcursorImpl) checkSingletonOnlineDDL(conn topo.Conn, onlineDDl *schema.OnlineDDL) error {
+	pending, err := vc.pendingOnlineDDLs(conn, onlineDDl.Keyspace)
+	if err != nil {
+		return err
+	}
+	for uuid, requestContext := range pending {
+		if onlineDDl.IsSingleton() {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "singleton migration rejected: found pending migration %s in keyspace %s", uuid, onlineDDl.Keyspace)
+		}
+		if requestContext != onlineDDl.RequestContext {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "singleton-context migration rejected: found pending migration %s in keyspace %s, submitted in a different context: %s", uuid, onlineDDl.Keyspace, requestContext)
+		}
+	}
+	return nil
+}
+
+// pendingOnlineDDLs returns the context of the pending migrations of a
+// keyspace by UUID: the requests not yet reviewed in topo, and the
+// migrations of the shards that are neither complete, failed nor cancelled.
+func (vc *vcursorImpl) pendingOnlineDDLs(conn topo.Conn, keyspace string) (map[string]string, error) {
+	pending := map[string]string{}
+	entries, err := conn.ListDir(vc.ctx, schema.MigrationRequestsPath(), true)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !schema.IsOnlineDDLUUID(entry.Name) {
+			// e.g. the sentry
+			continue
+		}
+		request, err := schema.ReadTopo(vc.ctx, conn, fmt.Sprintf("%s/%s", schema.MigrationRequestsPath(), entry.Name))
+		if err != nil {
+			return nil, err
+		}
+		if request.Keyspace == keyspace {
+			pending[request.UUID] = request.RequestContext
+		}
+	}
+
+	rss, _, err := vc.resolver.ResolveDestinations(vc.ctx, keyspace, topodatapb.TabletType_MASTER, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: sqlSelectPendingOnlineDDLs}
+	}
+	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, queries, NewAutocommitSession(vc.safeSession.Session), false /* autocommit */, vc.ignoreMaxMemoryRows)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		pending[row[0].ToString()] = row[1].ToString()
+	}
+	return pending, nil
+}
+
+const sqlSelectPendingOnlineDDLs = "select migration_uuid, migration_context from _vt.schema_migrations where migration_status in ('requested', 'queued', 'ready', 'running')"
+
 func commentedShardQueries(shardQueries []*querypb.BoundQuery, marginComments sqlparser.MarginComments) []*querypb.BoundQuery {
 	if marginComments.Leading == "" && marginComments.Trailing == "" {
 		return shardQueries
@@ -648,6 +733,11 @@ func (vc *vcursorImpl) SetFoundRows(foundRows uint64) {
 	vc.safeSession.foundRowsHandled = true
 }
 
+// SetReadAsOf implements the SessionActions interface
+func (vc *vcursorImpl) SetReadAsOf(seconds int64) {
+	vc.safeSession.SetReadAsOf(seconds)
+}
+
 // SetReadAfterWriteGTID implements the SessionActions interface
 func (vc *vcursorImpl) SetDDLStrategy(strategy string) {
 	vc.safeSession.SetDDLStrategy(strategy)
@@ -674,6 +764,12 @@ func (vc *vcursorImpl) GetSessionEnableSystemSettings() bool {
 	return vc.safeSession.GetSessionEnableSystemSettings()
 }
 
+// SetConsistentSnapshot implements the SessionActions interface
+func (vc *vcursorImpl) SetConsistentSnapshot(enable bool) error {
+	vc.safeSession.SetConsistentSnapshot(enable)
+	return nil
+}
+
 // SetReadAfterWriteGTID implements the SessionActions interface
 func (vc *vcursorImpl) SetReadAfterWriteGTID(vtgtid string) {
 	vc.safeSession.SetReadAfterWriteGTID(vtgtid)
@@ -25,8 +25,9 @@ import (
 )
 
 var (
-	_ SingleColumn = (*Binary)(nil)
-	_ Reversible   = (*Binary)(nil)
+	_ SingleColumn    = (*Binary)(nil)
+	_ Reversible      = (*Binary)(nil)
+	_ OrderPreserving = (*Binary)(nil)
 )
 
 // Binary is a vindex that converts binary bits to a keyspace id.
@@ -77,6 +78,18 @@ func (vind *Binary) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination
 	return out, nil
 }
 
+// MapRange maps the range of ids to the key range of their keyspace ids.
+func (vind *Binary) MapRange(_ VCursor, from, to sqltypes.Value) (key.Destination, error) {
+	if from.IsNull() || to.IsNull() {
+		return key.DestinationNone{}, nil
+	}
+	start, end := from.ToBytes(), to.ToBytes()
+	if bytes.Compare(start, end) > 0 {
+		return key.DestinationNone{}, nil
+	}
+	return keyRangeDestination(start, end), nil
+}
+
 // ReverseMap returns the associated ids for the ksids.
 func (*Binary) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
 	var reverseIds = make([]sqltypes.Value, len(ksids))
@@ -26,6 +26,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var binOnlyVindex SingleColumn
@@ -65,6 +67,24 @@ func TestBinaryMap(t *testing.T) {
 	}
 }
 
+func TestBinaryMapRange(t *testing.T) {
+	got, err := binOnlyVindex.(OrderPreserving).MapRange(nil, sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("c"))
+	require.NoError(t, err)
+	want := key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+		Start: []byte("a"),
+		End:   []byte("c\x00"),
+	}}
+	assert.Equal(t, want, got)
+
+	got, err = binOnlyVindex.(OrderPreserving).MapRange(nil, sqltypes.NewVarBinary("c"), sqltypes.NewVarBinary("a"))
+	require.NoError(t, err)
+	assert.Equal(t, key.DestinationNone{}, got)
+
+	got, err = binOnlyVindex.(OrderPreserving).MapRange(nil, sqltypes.NewVarBinary("a"), sqltypes.NULL)
+	require.NoError(t, err)
+	assert.Equal(t, key.DestinationNone{}, got)
+}
+
 func TestBinaryVerify(t *testing.T) {
 	ids := []sqltypes.Value{sqltypes.NewVarBinary("1"), sqltypes.NewVarBinary("2")}
 	ksids := [][]byte{[]byte("1"), []byte("1")}
@@ -29,8 +29,9 @@ import (
 )
 
 var (
-	_ SingleColumn = (*Numeric)(nil)
-	_ Reversible   = (*Numeric)(nil)
+	_ SingleColumn    = (*Numeric)(nil)
+	_ Reversible      = (*Numeric)(nil)
+	_ OrderPreserving = (*Numeric)(nil)
 )
 
 // Numeric defines a bit-pattern mapping of a uint64 to the KeyspaceId.
@@ -95,6 +96,30 @@ func (*Numeric) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, er
 	return out, nil
 }
 
+// MapRange maps the range of ids to the key range of their keyspace ids.
+// If a bound can't be converted to a uint64, the range can't be narrowed
+// down and all shards are returned.
+func (*Numeric) MapRange(_ VCursor, from, to sqltypes.Value) (key.Destination, error) {
+	if from.IsNull() || to.IsNull() {
+		return key.DestinationNone{}, nil
+	}
+	start, err := evalengine.ToUint64(from)
+	if err != nil {
+		return key.DestinationAllShards{}, nil
+	}
+	end, err := evalengine.ToUint64(to)
+	if err != nil {
+		return key.DestinationAllShards{}, nil
+	}
+	if start > end {
+		return key.DestinationNone{}, nil
+	}
+	var startbytes, endbytes [8]byte
+	binary.BigEndian.PutUint64(startbytes[:], start)
+	binary.BigEndian.PutUint64(endbytes[:], end)
+	return keyRangeDestination(startbytes[:], endbytes[:]), nil
+}
+
 // ReverseMap returns the associated ids for the ksids.
 func (*Numeric) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
 	var reverseIds = make([]sqltypes.Value, len(ksids))
@@ -25,6 +25,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var numeric SingleColumn
@@ -90,6 +92,41 @@ func TestNumericVerify(t *testing.T) {
 	}
 }
 
+func TestNumericMapRange(t *testing.T) {
+	tcases := []struct {
+		from, to sqltypes.Value
+		out      key.Destination
+	}{{
+		from: sqltypes.NewInt64(1),
+		to:   sqltypes.NewInt64(8),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+			End:   []byte("\x00\x00\x00\x00\x00\x00\x00\x08\x00"),
+		}},
+	}, {
+		from: sqltypes.NewInt64(8),
+		to:   sqltypes.NewInt64(1),
+		out:  key.DestinationNone{},
+	}, {
+		from: sqltypes.NULL,
+		to:   sqltypes.NewInt64(1),
+		out:  key.DestinationNone{},
+	}, {
+		from: sqltypes.NewInt64(-1),
+		to:   sqltypes.NewInt64(1),
+		out:  key.DestinationAllShards{},
+	}, {
+		from: sqltypes.NewInt64(1),
+		to:   sqltypes.NewFloat64(1.1),
+		out:  key.DestinationAllShards{},
+	}}
+	for _, tcase := range tcases {
+		got, err := numeric.(OrderPreserving).MapRange(nil, tcase.from, tcase.to)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "MapRange(%v, %v)", tcase.from, tcase.to)
+	}
+}
+
 func TestNumericReverseMap(t *testing.T) {
 	got, err := numeric.(Reversible).ReverseMap(nil, [][]byte{[]byte("\x00\x00\x00\x00\x00\x00\x00\x01")})
 	require.NoError(t, err)
@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -101,6 +102,18 @@ type Reversible interface {
 	ReverseMap(vcursor VCursor, ks [][]byte) ([]sqltypes.Value, error)
 }
 
+// An OrderPreserving vindex is a unique vindex that maps ids
+// to keyspace ids in the same order as the ids themselves.
+// This allows VTGate to send a range scan on the vindex column
+// only to the shards that cover the range, instead of all of them.
+// OrderPreserving is supported only for SingleColumn vindexes.
+type OrderPreserving interface {
+	SingleColumn
+	// MapRange maps the ids between from and to, both inclusive,
+	// to a key.Destination.
+	MapRange(vcursor VCursor, from, to sqltypes.Value) (key.Destination, error)
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -184,3 +197,13 @@ func firstColsOnly(rowsColValues [][]sqltypes.Value) []sqltypes.Value {
 	}
 	return firstCols
 }
+
+// keyRangeDestination returns the destination covering all the
+// keyspace ids between from and to, both inclusive.
+func keyRangeDestination(from, to []byte) key.Destination {
+	// The end of a key range is exclusive. Appending a zero byte
+	// to the upper bound gives the smallest keyspace id after it.
+	end := make([]byte, len(to)+1)
+	copy(end, to)
+	return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: from, End: end}}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"sort"
+	"strings"
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/sqltypes"
@@ -439,6 +440,27 @@ outer:
 	}
 }
 
+// ValidateRoutingRules returns an error listing the routing rules
+// of source that can't be applied to its keyspaces, like rules that
+// point to tables that don't exist.
+func ValidateRoutingRules(source *vschemapb.SrvVSchema) error {
+	vschema, err := BuildVSchema(source)
+	if err != nil {
+		return err
+	}
+	var invalid []string
+	for from, rr := range vschema.RoutingRules {
+		if rr.Error != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", from, rr.Error))
+		}
+	}
+	if len(invalid) == 0 {
+		return nil
+	}
+	sort.Strings(invalid)
+	return fmt.Errorf("invalid routing rules: %s", strings.Join(invalid, ", "))
+}
+
 // FindTable returns a pointer to the Table. If a keyspace is specified, only tables
 // from that keyspace are searched. If the specified keyspace is unsharded
 // and no tables matched, it's considered valid: FindTable will construct a table
@@ -812,6 +812,43 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestValidateRoutingRules(t *testing.T) {
+	input := &vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable: "rt1",
+				ToTables:  []string{"ks1.t1"},
+			}, {
+				FromTable: "rt1@replica",
+				ToTables:  []string{"ks2.t1"},
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+			"ks2": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+		},
+	}
+	require.NoError(t, ValidateRoutingRules(input))
+
+	input.RoutingRules.Rules = append(input.RoutingRules.Rules, &vschemapb.RoutingRule{
+		FromTable: "unqualified",
+		ToTables:  []string{"t1"},
+	}, &vschemapb.RoutingRule{
+		FromTable: "badkeyspace",
+		ToTables:  []string{"ks3.t1"},
+	})
+	err := ValidateRoutingRules(input)
+	require.EqualError(t, err, "invalid routing rules: badkeyspace: keyspace ks3 not found in vschema, unqualified: table t1 must be qualified")
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
@@ -38,6 +38,12 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+	schema            SchemaInfo
+}
+
+// SchemaInfo is an interface to schema tracker.
+type SchemaInfo interface {
+	Tables(ks string) map[string][]vindexes.Column
 }
 
 //GetCurrentVschema return the denormalized VSchema from SrvVSchema
@@ -125,6 +131,8 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		// existing vschema instead of overwriting it.
 		if v == nil && vm.e.vschema != nil {
 			vschema = vm.e.vschema
+		} else {
+			vm.updateFromSchema(vschema)
 		}
 
 		vm.e.SaveVSchema(vschema, stats)
@@ -162,3 +170,54 @@ func (vm *VSchemaManager) UpdateVSchema(ctx context.Context, ksName string, vsch
 
 	return err
 }
+
+// setSchemaTracker sets the schema tracker whose column lists are merged
+// into the vschema.
+func (vm *VSchemaManager) setSchemaTracker(schema SchemaInfo) {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	vm.schema = schema
+}
+
+// Rebuild will rebuild and publish the vschema from the last SrvVSchema
+// received, merging in the current column lists of the schema tracker.
+// It is called by the schema tracker when the schema of a keyspace changes.
+func (vm *VSchemaManager) Rebuild() {
+	vm.mu.Lock()
+	v := vm.currentSrvVschema
+	vm.mu.Unlock()
+
+	if v == nil {
+		return
+	}
+	vschema, err := vindexes.BuildVSchema(v)
+	if err != nil {
+		log.Warningf("Error creating VSchema for schema tracking update (will try again next update): %v", err)
+		return
+	}
+	vm.updateFromSchema(vschema)
+	vm.e.SaveVSchema(vschema, NewVSchemaStats(vschema, ""))
+}
+
+// updateFromSchema sets the columns of the vschema tables from the schema
+// tracker. Tables with a column list marked as authoritative in the
+// VSchema are left untouched.
+func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
+	vm.mu.Lock()
+	schema := vm.schema
+	vm.mu.Unlock()
+	if schema == nil {
+		return
+	}
+
+	for ksName, ks := range vschema.Keyspaces {
+		for tblName, columns := range schema.Tables(ksName) {
+			vTbl := ks.Tables[tblName]
+			if vTbl == nil || vTbl.ColumnListAuthoritative {
+				continue
+			}
+			vTbl.Columns = columns
+			vTbl.ColumnListAuthoritative = true
+		}
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+type fakeSchema struct {
+	tables map[string]map[string][]vindexes.Column
+}
+
+func (f *fakeSchema) Tables(ks string) map[string][]vindexes.Column {
+	return f.tables[ks]
+}
+
+func TestVSchemaUpdateFromSchema(t *testing.T) {
+	vschema, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {
+						Columns:                 []*vschemapb.Column{{Name: "a", Type: sqltypes.Int64}},
+						ColumnListAuthoritative: true,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	cols := []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+		{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+	}
+	vm := &VSchemaManager{}
+	vm.setSchemaTracker(&fakeSchema{tables: map[string]map[string][]vindexes.Column{
+		"ks": {
+			"t1": cols,
+			"t2": cols,
+			"t3": cols,
+		},
+	}})
+	vm.updateFromSchema(vschema)
+
+	ks := vschema.Keyspaces["ks"]
+	assert.True(t, ks.Tables["t1"].ColumnListAuthoritative)
+	assert.Equal(t, cols, ks.Tables["t1"].Columns)
+	// The column list of the VSchema wins.
+	require.Len(t, ks.Tables["t2"].Columns, 1)
+	assert.Equal(t, "a", ks.Tables["t2"].Columns[0].Name.String())
+	// Tables unknown to the VSchema are not added.
+	assert.Nil(t, ks.Tables["t3"])
+}
@@ -42,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
@@ -78,8 +79,17 @@ var (
 	sysVarSetEnabled = flag.Bool("enable_system_settings", true, "This will enable the system settings to be changed per session at the database connection level")
 	plannerVersion   = flag.String("planner_version", "v3", "Sets the default planner to use when the session has not changed it. Valid values are: V3, Gen4, Gen4Greedy and Gen4Fallback. Gen4Fallback tries the new gen4 planner and falls back to the V3 planner if the gen4 fails. All Gen4 versions should be considered experimental!")
 
+	// The clients often set a character set that differs from the tablets
+	// only by name, like utf8mb4 on utf8 tablets, so applying it would
+	// reserve a connection for most sessions.
+	charsetSysVarSetEnabled = flag.Bool("enable_charset_system_settings", false, "If set with enable_system_settings, the character set variables changed by SET NAMES, SET CHARACTER SET or the handshake are applied on reserved connections when they differ from the tablets. Otherwise they are checked and ignored.")
+
 	// lockHeartbeatTime is used to set the next heartbeat time.
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
+
+	// schema tracking: the column lists of the tables are loaded from the master tablets and used by the planner.
+	enableSchemaTracking          = flag.Bool("enable_schema_tracking", false, "If enabled, vtgate loads the column lists of the tables from the master tablets and uses them as authoritative columns for tables without a column list in the VSchema")
+	schemaTrackingRefreshInterval = flag.Duration("schema_tracking_refresh_interval", 1*time.Minute, "how often the tracked schema of a keyspace is reloaded, when -enable_schema_tracking is set")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -175,6 +185,9 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	if _, _, err := schema.ParseDDLStrategy(*defaultDDLStrategy); err != nil {
 		log.Fatalf("Invalid value for -ddl_strategy: %v", err.Error())
 	}
+	if _, err := parseTrafficMirrorRules(*trafficMirrorRules); err != nil {
+		log.Fatalf("Invalid value for -traffic_mirror_rules: %v", err)
+	}
 	tc := NewTxConn(gw, getTxMode())
 	// ScatterConn depends on TxConn to perform forced rollbacks.
 	sc := NewScatterConn("VttabletCall", tc, gw)
@@ -210,6 +223,14 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		logStreamExecute: logutil.NewThrottledLogger("StreamExecute", 5*time.Second),
 	}
 
+	if *enableSchemaTracking {
+		st := vtschema.NewTracker(gw.hc.Subscribe(), *schemaTrackingRefreshInterval)
+		rpcVTGate.executor.vm.setSchemaTracker(st)
+		st.RegisterSignalReceiver(rpcVTGate.executor.vm.Rebuild)
+		st.Start()
+		servenv.OnTerm(st.Stop)
+	}
+
 	errorCounts = stats.NewCountersWithMultiLabels("VtgateApiErrorCounts", "Vtgate API error counts per error type", []string{"Operation", "Keyspace", "DbType", "Code"})
 
 	_ = stats.NewRates("QPSByOperation", stats.CounterForDimension(rpcVTGate.timings, "Operation"), 15, 1*time.Minute)
@@ -235,6 +256,7 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	}
 
 	initAPI(gw.hc)
+	initThrottlerCheck(gw.hc)
 
 	return rpcVTGate
 }
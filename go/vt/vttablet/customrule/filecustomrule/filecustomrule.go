@@ -18,10 +18,13 @@ limitations under the License.
 package filecustomrule
 
 import (
+	"encoding/json"
 	"flag"
 	"io/ioutil"
+	"os"
 	"time"
 
+	"vitess.io/vitess/go/ioutil2"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
@@ -85,11 +88,32 @@ func (fcr *FileCustomRule) GetRules() (qrs *rules.Rules, version int64, err erro
 	return fcr.currentRuleSet.Copy(), fcr.currentRuleSetTimestamp, nil
 }
 
+// write replaces the rule file with qrs, so that rules rolled back in
+// vttablet are also the ones loaded the next time it starts.
+func (fcr *FileCustomRule) write(qrs *rules.Rules) error {
+	data, err := json.MarshalIndent(qrs, "", "  ")
+	if err != nil {
+		return err
+	}
+	perm := os.FileMode(0644)
+	if fi, err := os.Stat(fcr.path); err == nil {
+		perm = fi.Mode().Perm()
+	}
+	if err := ioutil2.WriteFileAtomic(fcr.path, data, perm); err != nil {
+		return err
+	}
+	fcr.currentRuleSetTimestamp = time.Now().Unix()
+	fcr.currentRuleSet = qrs.Copy()
+	log.Infof("Custom rule written to file: %s", fcr.path)
+	return nil
+}
+
 // ActivateFileCustomRules activates this static file based custom rule mechanism
 func ActivateFileCustomRules(qsc tabletserver.Controller) {
 	if *fileRulePath != "" {
 		qsc.RegisterQueryRuleSource(FileCustomRuleSource)
 		fileCustomRule.Open(qsc, *fileRulePath)
+		qsc.SetQueryRulesWriter(FileCustomRuleSource, fileCustomRule.write)
 	}
 }
 
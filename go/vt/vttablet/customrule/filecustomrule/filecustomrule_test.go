@@ -65,3 +65,40 @@ func TestFileCustomRule(t *testing.T) {
 		t.Fatalf("Expect custom rule r1 to be found, but got nothing, qrs=%v", qrs)
 	}
 }
+
+func TestFileCustomRuleWrite(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+	rulepath := path.Join(t.TempDir(), "customrule.json")
+	if err := ioutil.WriteFile(rulepath, []byte(customRule1), os.FileMode(0600)); err != nil {
+		t.Fatalf("Cannot write r1 to rule file %s, err=%v", rulepath, err)
+	}
+	fcr := NewFileCustomRule()
+	if err := fcr.Open(tqsc, rulepath); err != nil {
+		t.Fatalf("Cannot open file custom rule service, err=%v", err)
+	}
+
+	qrs := rules.New()
+	qrs.Add(rules.NewQueryRule("disallow t1", "r2", rules.QRFail))
+	if err := fcr.write(qrs); err != nil {
+		t.Fatalf("Cannot write the rules, err=%v", err)
+	}
+	if got, _, _ := fcr.GetRules(); !got.Equal(qrs) {
+		t.Fatalf("GetRules returns %v, want %v", got, qrs)
+	}
+	fi, err := os.Stat(rulepath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("The rule file mode changed to %v", fi.Mode())
+	}
+
+	// The written rules are the ones loaded from the file.
+	fcr = NewFileCustomRule()
+	if err := fcr.Open(tqsc, rulepath); err != nil {
+		t.Fatalf("Cannot open file custom rule service, err=%v", err)
+	}
+	if got, _, _ := fcr.GetRules(); !got.Equal(qrs) {
+		t.Fatalf("GetRules returns %v after a reload, want %v", got, qrs)
+	}
+}
@@ -22,6 +22,7 @@ package topocustomrule
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"reflect"
@@ -130,6 +131,19 @@ func (cr *topoCustomRule) apply(wd *topo.WatchData) error {
 	return nil
 }
 
+// write replaces the rules in topo with qrs, so that rules rolled back in
+// vttablet are not overwritten by the watch.
+func (cr *topoCustomRule) write(qrs *rules.Rules) error {
+	data, err := json.MarshalIndent(qrs, "", "  ")
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	_, err = cr.conn.Update(ctx, cr.filePath, data, nil)
+	return err
+}
+
 func (cr *topoCustomRule) oneWatch() error {
 	defer func() {
 		// Whatever happens, cancel() won't be valid after this function exits.
@@ -195,6 +209,7 @@ func activateTopoCustomRules(qsc tabletserver.Controller) {
 			log.Fatalf("cannot start TopoCustomRule: %v", err)
 		}
 		cr.start()
+		qsc.SetQueryRulesWriter(topoCustomRuleSource, cr.write)
 
 		servenv.OnTerm(cr.stop)
 	}
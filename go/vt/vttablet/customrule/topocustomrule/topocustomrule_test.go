@@ -106,4 +106,10 @@ func TestUpdate(t *testing.T) {
 		t.Fatalf("conn.Update failed: %v", err)
 	}
 	waitForValue(t, qsc, custom2)
+
+	// Written rules are applied through the watch.
+	if err := cr.write(custom1); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	waitForValue(t, qsc, custom1)
 }
@@ -38,6 +38,11 @@ var procSQL = []string{
 		select intval from vitess_test;
 		select intval from vitess_test;
 	END;`,
+	`create procedure proc_select_mixed()
+	BEGIN
+		select intval from vitess_test;
+		select id, intval from vitess_test;
+	END;`,
 	`create procedure proc_dml()
 	BEGIN
 	    start transaction;
@@ -71,28 +76,32 @@ var procSQL = []string{
 func TestCallProcedure(t *testing.T) {
 	client := framework.NewClient()
 	type testcases struct {
-		query   string
-		wantErr bool
+		query      string
+		wantFields int
+		wantErr    string
 	}
 	tcases := []testcases{{
-		query:   "call proc_select1()",
-		wantErr: true,
+		query:      "call proc_select1()",
+		wantFields: 1,
 	}, {
-		query:   "call proc_select4()",
-		wantErr: true,
+		query:      "call proc_select4()",
+		wantFields: 1,
+	}, {
+		query:   "call proc_select_mixed()",
+		wantErr: "stored procedure returned result sets with different columns, only result sets with the same columns are supported (CallerID: dev)",
 	}, {
 		query: "call proc_dml()",
 	}}
 
 	for _, tc := range tcases {
 		t.Run(tc.query, func(t *testing.T) {
-			_, err := client.Execute(tc.query, nil)
-			if tc.wantErr {
-				require.EqualError(t, err, "Multi-Resultset not supported in stored procedure (CallerID: dev)")
+			qr, err := client.Execute(tc.query, nil)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
 				return
 			}
 			require.NoError(t, err)
-
+			assert.Len(t, qr.Fields, tc.wantFields)
 		})
 	}
 }
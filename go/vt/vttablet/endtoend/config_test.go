@@ -133,7 +133,7 @@ func TestConsolidatorReplicasOnly(t *testing.T) {
 	afterOne := framework.FetchInt(framework.DebugVars(), totalConsolidationsTag)
 	assert.Equal(t, initial+1, afterOne, "expected one consolidation")
 
-	revert := changeVar(t, "Consolidator", tabletenv.NotOnMaster)
+	revert := changeVar(t, "Consolidator", tabletenv.NotOnPrimary)
 	defer revert()
 
 	// master should not do query consolidation
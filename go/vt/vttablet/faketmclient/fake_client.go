@@ -88,6 +88,11 @@ func (client *FakeTabletManagerClient) GetSchema(ctx context.Context, tablet *to
 	return client.tmc.GetSchema(ctx, tablet, tables, excludeTables, includeViews)
 }
 
+// GetSchemaForPos is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSchemaForPos(ctx context.Context, tablet *topodatapb.Tablet, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return client.tmc.GetSchemaForPos(ctx, tablet, position, tables, excludeTables)
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	return &tabletmanagerdatapb.Permissions{}, nil
@@ -22,6 +22,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/arrowipc"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -79,11 +80,26 @@ func (q *query) StreamExecute(request *querypb.StreamExecuteRequest, stream quer
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	err = q.server.StreamExecute(ctx, request.Target, request.Query.Sql, request.Query.BindVariables, request.TransactionId, request.Options, func(reply *sqltypes.Result) error {
+	send := func(reply *sqltypes.Result) error {
 		return stream.Send(&querypb.StreamExecuteResponse{
 			Result: sqltypes.ResultToProto3(reply),
 		})
-	})
+	}
+	var w *arrowipc.Writer
+	if request.ResultFormat == querypb.ResultFormat_ARROW {
+		w = arrowipc.NewWriter()
+		send = func(reply *sqltypes.Result) error {
+			data, err := w.Write(reply)
+			if err != nil || len(data) == 0 {
+				return err
+			}
+			return stream.Send(&querypb.StreamExecuteResponse{Arrow: data})
+		}
+	}
+	err = q.server.StreamExecute(ctx, request.Target, request.Query.Sql, request.Query.BindVariables, request.TransactionId, request.Options, send)
+	if err == nil && w != nil {
+		err = stream.Send(&querypb.StreamExecuteResponse{Arrow: w.Close()})
+	}
 	return vterrors.ToGRPC(err)
 }
 
@@ -376,6 +392,17 @@ func (q *query) VStreamResults(request *binlogdatapb.VStreamResultsRequest, stre
 	return vterrors.ToGRPC(err)
 }
 
+// ExportTable is part of the queryservice.QueryServer interface
+func (q *query) ExportTable(request *binlogdatapb.ExportTableRequest, stream queryservicepb.Query_ExportTableServer) (err error) {
+	defer q.server.HandlePanic(&err)
+	ctx := callerid.NewContext(callinfo.GRPCCallInfo(stream.Context()),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	err = q.server.ExportTable(ctx, request.Target, request.Table, request.Lastpk, request.Endpk, stream.Send)
+	return vterrors.ToGRPC(err)
+}
+
 //ReserveExecute implements the QueryServer interface
 func (q *query) ReserveExecute(ctx context.Context, request *querypb.ReserveExecuteRequest) (response *querypb.ReserveExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
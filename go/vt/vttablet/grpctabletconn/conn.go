@@ -18,6 +18,7 @@ package grpctabletconn
 
 import (
 	"flag"
+	"fmt"
 	"io"
 	"sync"
 
@@ -58,8 +59,12 @@ type gRPCQueryClient struct {
 
 	// mu protects the next fields
 	mu sync.RWMutex
+	// cc is the connection of the client when it is not pooled.
 	cc *grpc.ClientConn
-	c  queryservicepb.QueryClient
+	// pool is the connection pool of the client when it is pooled.
+	pool    *addrPool
+	poolKey string
+	c       queryservicepb.QueryClient
 }
 
 var _ queryservice.QueryService = (*gRPCQueryClient)(nil)
@@ -77,6 +82,18 @@ func DialTablet(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (querys
 	if err != nil {
 		return nil, err
 	}
+	if *connectionsPerTablet > 0 {
+		poolKey := fmt.Sprintf("%s/%v", addr, failFast)
+		pool := connPool.get(poolKey, addr, func() (*grpc.ClientConn, error) {
+			return grpcclient.Dial(addr, failFast, opt)
+		})
+		return &gRPCQueryClient{
+			tablet:  tablet,
+			pool:    pool,
+			poolKey: poolKey,
+			c:       &pooledQueryClient{pool: pool},
+		}, nil
+	}
 	cc, err := grpcclient.Dial(addr, failFast, opt)
 	if err != nil {
 		return nil, err
@@ -96,7 +113,7 @@ func DialTablet(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (querys
 func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, tabletconn.ConnClosed
 	}
 
@@ -123,7 +140,7 @@ func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target
 func (conn *gRPCQueryClient) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, tabletconn.ConnClosed
 	}
 
@@ -159,7 +176,7 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 	stream, err := func() (queryservicepb.Query_StreamExecuteClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.c == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -205,7 +222,7 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return 0, nil, tabletconn.ConnClosed
 	}
 
@@ -230,7 +247,7 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target,
 func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return 0, tabletconn.ConnClosed
 	}
 
@@ -251,7 +268,7 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 func (conn *gRPCQueryClient) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return 0, tabletconn.ConnClosed
 	}
 
@@ -272,7 +289,7 @@ func (conn *gRPCQueryClient) Rollback(ctx context.Context, target *querypb.Targe
 func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -294,7 +311,7 @@ func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target
 func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -315,7 +332,7 @@ func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb
 func (conn *gRPCQueryClient) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -337,7 +354,7 @@ func (conn *gRPCQueryClient) RollbackPrepared(ctx context.Context, target *query
 func (conn *gRPCQueryClient) CreateTransaction(ctx context.Context, target *querypb.Target, dtid string, participants []*querypb.Target) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -360,7 +377,7 @@ func (conn *gRPCQueryClient) CreateTransaction(ctx context.Context, target *quer
 func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -383,7 +400,7 @@ func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Ta
 func (conn *gRPCQueryClient) SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -406,7 +423,7 @@ func (conn *gRPCQueryClient) SetRollback(ctx context.Context, target *querypb.Ta
 func (conn *gRPCQueryClient) ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -427,7 +444,7 @@ func (conn *gRPCQueryClient) ConcludeTransaction(ctx context.Context, target *qu
 func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (*querypb.TransactionMetadata, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, tabletconn.ConnClosed
 	}
 
@@ -448,7 +465,7 @@ func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *queryp
 func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -478,7 +495,7 @@ func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.T
 func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) (results []sqltypes.Result, transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -510,7 +527,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 	stream, err := func() (queryservicepb.Query_MessageStreamClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.c == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -551,7 +568,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return 0, tabletconn.ConnClosed
 	}
 	req := &querypb.MessageAckRequest{
@@ -577,7 +594,7 @@ func (conn *gRPCQueryClient) StreamHealth(ctx context.Context, callback func(*qu
 	stream, err := func() (queryservicepb.Query_StreamHealthClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.c == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -609,7 +626,7 @@ func (conn *gRPCQueryClient) VStream(ctx context.Context, target *querypb.Target
 	stream, err := func() (queryservicepb.Query_VStreamClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.c == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -621,7 +638,7 @@ func (conn *gRPCQueryClient) VStream(ctx context.Context, target *querypb.Target
 			Filter:            filter,
 			TableLastPKs:      tablePKs,
 		}
-		stream, err := conn.c.VStream(ctx, req)
+		stream, err := conn.c.VStream(ctx, req, grpcclient.VStreamCallOptions()...)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -654,7 +671,7 @@ func (conn *gRPCQueryClient) VStreamRows(ctx context.Context, target *querypb.Ta
 	stream, err := func() (queryservicepb.Query_VStreamRowsClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.c == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -665,7 +682,7 @@ func (conn *gRPCQueryClient) VStreamRows(ctx context.Context, target *querypb.Ta
 			Query:             query,
 			Lastpk:            lastpk,
 		}
-		stream, err := conn.c.VStreamRows(ctx, req)
+		stream, err := conn.c.VStreamRows(ctx, req, grpcclient.VStreamCallOptions()...)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -695,7 +712,7 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 	stream, err := func() (queryservicepb.Query_VStreamResultsClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.c == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -705,7 +722,49 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Query:             query,
 		}
-		stream, err := conn.c.VStreamResults(ctx, req)
+		stream, err := conn.c.VStreamResults(ctx, req, grpcclient.VStreamCallOptions()...)
+		if err != nil {
+			return nil, tabletconn.ErrorFromGRPC(err)
+		}
+		return stream, nil
+	}()
+	if err != nil {
+		return err
+	}
+	for {
+		r, err := stream.Recv()
+		if err != nil {
+			return tabletconn.ErrorFromGRPC(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := send(r); err != nil {
+			return err
+		}
+	}
+}
+
+// ExportTable streams the rows of a table from the specified starting point.
+func (conn *gRPCQueryClient) ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	stream, err := func() (queryservicepb.Query_ExportTableClient, error) {
+		conn.mu.RLock()
+		defer conn.mu.RUnlock()
+		if conn.c == nil {
+			return nil, tabletconn.ConnClosed
+		}
+
+		req := &binlogdatapb.ExportTableRequest{
+			Target:            target,
+			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+			Table:             table,
+			Lastpk:            lastpk,
+			Endpk:             endpk,
+		}
+		stream, err := conn.c.ExportTable(ctx, req, grpcclient.VStreamCallOptions()...)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -738,7 +797,7 @@ func (conn *gRPCQueryClient) HandlePanic(err *error) {
 func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, 0, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -768,7 +827,7 @@ func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *qu
 func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -798,7 +857,7 @@ func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb
 func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target, transactionID, reservedID int64) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -816,14 +875,21 @@ func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target
 	return nil
 }
 
-// Close closes underlying gRPC channel.
+// Close closes underlying gRPC channel. A pooled channel is only closed
+// once no client uses it anymore.
 func (conn *gRPCQueryClient) Close(ctx context.Context) error {
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
-	if conn.cc == nil {
+	if conn.c == nil {
 		return nil
 	}
 
+	conn.c = nil
+	if conn.pool != nil {
+		connPool.put(conn.poolKey, conn.pool)
+		conn.pool = nil
+		return nil
+	}
 	cc := conn.cc
 	conn.cc = nil
 	return cc.Close()
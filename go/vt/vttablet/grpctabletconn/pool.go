@@ -0,0 +1,461 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctabletconn
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	queryservicepb "vitess.io/vitess/go/vt/proto/queryservice"
+)
+
+var (
+	connectionsPerTablet = flag.Int("tablet_grpc_connections_per_tablet", 0, "If set, the connections to a tablet share a pool of at most this many gRPC connections, over which their calls are multiplexed. 0 means that every connection to a tablet uses its own gRPC connection.")
+	connectionMaxAge     = flag.Duration("tablet_grpc_connection_max_age", 0, "If set, the pooled gRPC connections older than this are replaced by new ones, so that the load is rebalanced. A replaced connection is closed once its calls are done. 0 means that connections are never replaced.")
+
+	connPool = newTabletConnPool()
+
+	poolConnections = stats.NewGaugesFuncWithMultiLabels(
+		"TabletGrpcPoolConnections",
+		"Number of pooled gRPC connections per tablet address",
+		[]string{"Address"},
+		func() map[string]int64 { return connPool.counts(func(pc *pooledConn) int64 { return 1 }) })
+	poolInflightCalls = stats.NewGaugesFuncWithMultiLabels(
+		"TabletGrpcPoolInflightCalls",
+		"Number of calls in flight on the pooled gRPC connections per tablet address",
+		[]string{"Address"},
+		func() map[string]int64 {
+			return connPool.counts(func(pc *pooledConn) int64 { return pc.inflight.Get() })
+		})
+	poolRebalances = stats.NewCountersWithSingleLabel(
+		"TabletGrpcPoolRebalances",
+		"Number of pooled gRPC connections replaced because they reached -tablet_grpc_connection_max_age",
+		"Address")
+)
+
+// tabletConnPool holds the gRPC connections shared by all the
+// connections to the same tablets.
+type tabletConnPool struct {
+	mu    sync.Mutex
+	addrs map[string]*addrPool
+}
+
+func newTabletConnPool() *tabletConnPool {
+	return &tabletConnPool{addrs: make(map[string]*addrPool)}
+}
+
+// get returns the pool of addr, creating it if needed. The pool must be
+// released by the caller with put.
+func (tcp *tabletConnPool) get(key, addr string, dial func() (*grpc.ClientConn, error)) *addrPool {
+	tcp.mu.Lock()
+	defer tcp.mu.Unlock()
+	ap, ok := tcp.addrs[key]
+	if !ok {
+		ap = &addrPool{addr: addr, dial: dial}
+		tcp.addrs[key] = ap
+	}
+	ap.refs++
+	return ap
+}
+
+// put releases a pool returned by get. The connections of the pool are
+// closed when it is no longer used.
+func (tcp *tabletConnPool) put(key string, ap *addrPool) {
+	tcp.mu.Lock()
+	ap.refs--
+	if ap.refs > 0 {
+		tcp.mu.Unlock()
+		return
+	}
+	delete(tcp.addrs, key)
+	tcp.mu.Unlock()
+	ap.close()
+}
+
+// counts returns f summed over the connections of each address.
+func (tcp *tabletConnPool) counts(f func(pc *pooledConn) int64) map[string]int64 {
+	tcp.mu.Lock()
+	defer tcp.mu.Unlock()
+	result := make(map[string]int64)
+	for _, ap := range tcp.addrs {
+		ap.mu.Lock()
+		for _, pc := range ap.conns {
+			result[ap.addr] += f(pc)
+		}
+		ap.mu.Unlock()
+	}
+	return result
+}
+
+// addrPool is the set of gRPC connections to a tablet address.
+type addrPool struct {
+	addr string
+	dial func() (*grpc.ClientConn, error)
+	// refs is protected by the mutex of tabletConnPool.
+	refs int
+
+	mu     sync.Mutex
+	conns  []*pooledConn
+	closed bool
+}
+
+// pooledConn is a gRPC connection of an addrPool.
+type pooledConn struct {
+	cc       *grpc.ClientConn
+	client   queryservicepb.QueryClient
+	created  time.Time
+	inflight sync2.AtomicInt64
+	// draining is set when the connection is removed from the pool.
+	// It is then closed when its last call is done. It is protected
+	// by the mutex of the addrPool.
+	draining bool
+}
+
+// acquire returns the least loaded connection of the pool. A new
+// connection is dialed if all the connections are in use and the pool
+// is not full, or to replace a connection that is too old. The returned
+// connection must be released when the call is done.
+func (ap *addrPool) acquire() (*pooledConn, error) {
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+	if ap.closed {
+		return nil, fmt.Errorf("connection pool to %s is closed", ap.addr)
+	}
+
+	if *connectionMaxAge > 0 {
+		for i := 0; i < len(ap.conns); {
+			pc := ap.conns[i]
+			if time.Since(pc.created) < *connectionMaxAge {
+				i++
+				continue
+			}
+			ap.conns = append(ap.conns[:i], ap.conns[i+1:]...)
+			ap.drain(pc)
+			poolRebalances.Add(ap.addr, 1)
+		}
+	}
+
+	var best *pooledConn
+	for _, pc := range ap.conns {
+		if best == nil || pc.inflight.Get() < best.inflight.Get() {
+			best = pc
+		}
+	}
+	if best == nil || (best.inflight.Get() > 0 && len(ap.conns) < *connectionsPerTablet) {
+		cc, err := ap.dial()
+		if err != nil {
+			if best == nil {
+				return nil, err
+			}
+		} else {
+			best = &pooledConn{
+				cc:      cc,
+				client:  queryservicepb.NewQueryClient(cc),
+				created: time.Now(),
+			}
+			ap.conns = append(ap.conns, best)
+		}
+	}
+	best.inflight.Add(1)
+	return best, nil
+}
+
+// release must be called when a call acquired on pc is done.
+func (ap *addrPool) release(pc *pooledConn) {
+	if pc.inflight.Add(-1) > 0 {
+		return
+	}
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+	if pc.draining && pc.inflight.Get() == 0 {
+		pc.cc.Close()
+	}
+}
+
+// drain closes pc once it has no call in flight. The caller must hold
+// the mutex.
+func (ap *addrPool) drain(pc *pooledConn) {
+	pc.draining = true
+	if pc.inflight.Get() == 0 {
+		pc.cc.Close()
+	}
+}
+
+func (ap *addrPool) close() {
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+	ap.closed = true
+	for _, pc := range ap.conns {
+		pc.cc.Close()
+	}
+	ap.conns = nil
+}
+
+// pooledQueryClient is a QueryClient that runs every call on a
+// connection of an addrPool.
+type pooledQueryClient struct {
+	pool *addrPool
+}
+
+var _ queryservicepb.QueryClient = (*pooledQueryClient)(nil)
+
+// unary acquires a connection for the duration of a unary call.
+func (p *pooledQueryClient) unary(call func(c queryservicepb.QueryClient) error) error {
+	pc, err := p.pool.acquire()
+	if err != nil {
+		return err
+	}
+	defer p.pool.release(pc)
+	return call(pc.client)
+}
+
+// stream acquires a connection until the stream started by call ends.
+func (p *pooledQueryClient) stream(call func(c queryservicepb.QueryClient) (grpc.ClientStream, error)) error {
+	pc, err := p.pool.acquire()
+	if err != nil {
+		return err
+	}
+	stream, err := call(pc.client)
+	if err != nil {
+		p.pool.release(pc)
+		return err
+	}
+	// The context of a stream is canceled when it ends.
+	go func() {
+		<-stream.Context().Done()
+		p.pool.release(pc)
+	}()
+	return nil
+}
+
+func (p *pooledQueryClient) Execute(ctx context.Context, in *querypb.ExecuteRequest, opts ...grpc.CallOption) (out *querypb.ExecuteResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.Execute(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) ExecuteBatch(ctx context.Context, in *querypb.ExecuteBatchRequest, opts ...grpc.CallOption) (out *querypb.ExecuteBatchResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.ExecuteBatch(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) StreamExecute(ctx context.Context, in *querypb.StreamExecuteRequest, opts ...grpc.CallOption) (out queryservicepb.Query_StreamExecuteClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.StreamExecute(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) Begin(ctx context.Context, in *querypb.BeginRequest, opts ...grpc.CallOption) (out *querypb.BeginResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.Begin(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) Commit(ctx context.Context, in *querypb.CommitRequest, opts ...grpc.CallOption) (out *querypb.CommitResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.Commit(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) Rollback(ctx context.Context, in *querypb.RollbackRequest, opts ...grpc.CallOption) (out *querypb.RollbackResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.Rollback(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) Prepare(ctx context.Context, in *querypb.PrepareRequest, opts ...grpc.CallOption) (out *querypb.PrepareResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.Prepare(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) CommitPrepared(ctx context.Context, in *querypb.CommitPreparedRequest, opts ...grpc.CallOption) (out *querypb.CommitPreparedResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.CommitPrepared(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) RollbackPrepared(ctx context.Context, in *querypb.RollbackPreparedRequest, opts ...grpc.CallOption) (out *querypb.RollbackPreparedResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.RollbackPrepared(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) CreateTransaction(ctx context.Context, in *querypb.CreateTransactionRequest, opts ...grpc.CallOption) (out *querypb.CreateTransactionResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.CreateTransaction(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) StartCommit(ctx context.Context, in *querypb.StartCommitRequest, opts ...grpc.CallOption) (out *querypb.StartCommitResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.StartCommit(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) SetRollback(ctx context.Context, in *querypb.SetRollbackRequest, opts ...grpc.CallOption) (out *querypb.SetRollbackResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.SetRollback(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) ConcludeTransaction(ctx context.Context, in *querypb.ConcludeTransactionRequest, opts ...grpc.CallOption) (out *querypb.ConcludeTransactionResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.ConcludeTransaction(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) ReadTransaction(ctx context.Context, in *querypb.ReadTransactionRequest, opts ...grpc.CallOption) (out *querypb.ReadTransactionResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.ReadTransaction(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) BeginExecute(ctx context.Context, in *querypb.BeginExecuteRequest, opts ...grpc.CallOption) (out *querypb.BeginExecuteResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.BeginExecute(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) BeginExecuteBatch(ctx context.Context, in *querypb.BeginExecuteBatchRequest, opts ...grpc.CallOption) (out *querypb.BeginExecuteBatchResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.BeginExecuteBatch(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) MessageStream(ctx context.Context, in *querypb.MessageStreamRequest, opts ...grpc.CallOption) (out queryservicepb.Query_MessageStreamClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.MessageStream(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) MessageAck(ctx context.Context, in *querypb.MessageAckRequest, opts ...grpc.CallOption) (out *querypb.MessageAckResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.MessageAck(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) ReserveExecute(ctx context.Context, in *querypb.ReserveExecuteRequest, opts ...grpc.CallOption) (out *querypb.ReserveExecuteResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.ReserveExecute(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) ReserveBeginExecute(ctx context.Context, in *querypb.ReserveBeginExecuteRequest, opts ...grpc.CallOption) (out *querypb.ReserveBeginExecuteResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.ReserveBeginExecute(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) Release(ctx context.Context, in *querypb.ReleaseRequest, opts ...grpc.CallOption) (out *querypb.ReleaseResponse, err error) {
+	err = p.unary(func(c queryservicepb.QueryClient) (err error) {
+		out, err = c.Release(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) StreamHealth(ctx context.Context, in *querypb.StreamHealthRequest, opts ...grpc.CallOption) (out queryservicepb.Query_StreamHealthClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.StreamHealth(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) VStream(ctx context.Context, in *binlogdatapb.VStreamRequest, opts ...grpc.CallOption) (out queryservicepb.Query_VStreamClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.VStream(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) VStreamRows(ctx context.Context, in *binlogdatapb.VStreamRowsRequest, opts ...grpc.CallOption) (out queryservicepb.Query_VStreamRowsClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.VStreamRows(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) VStreamResults(ctx context.Context, in *binlogdatapb.VStreamResultsRequest, opts ...grpc.CallOption) (out queryservicepb.Query_VStreamResultsClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.VStreamResults(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
+
+func (p *pooledQueryClient) ExportTable(ctx context.Context, in *binlogdatapb.ExportTableRequest, opts ...grpc.CallOption) (out queryservicepb.Query_ExportTableClient, err error) {
+	err = p.stream(func(c queryservicepb.QueryClient) (grpc.ClientStream, error) {
+		out, err = c.ExportTable(ctx, in, opts...)
+		return out, err
+	})
+	return out, err
+}
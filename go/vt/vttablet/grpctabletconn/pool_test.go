@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctabletconn
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vttablet/grpcqueryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconntest"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func setPoolFlags(t *testing.T, connections int, maxAge time.Duration) {
+	oldConnections, oldMaxAge := *connectionsPerTablet, *connectionMaxAge
+	*connectionsPerTablet, *connectionMaxAge = connections, maxAge
+	t.Cleanup(func() {
+		*connectionsPerTablet, *connectionMaxAge = oldConnections, oldMaxAge
+	})
+}
+
+// This test makes sure the go rpc service works over pooled connections
+func TestGRPCTabletConnPooled(t *testing.T) {
+	setPoolFlags(t, 2, 0)
+
+	service := tabletconntest.CreateFakeServer(t)
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	host := listener.Addr().(*net.TCPAddr).IP.String()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	server := grpc.NewServer()
+	grpcqueryservice.Register(server, service)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	tabletconntest.TestSuite(t, protocolName, &topodatapb.Tablet{
+		Keyspace: tabletconntest.TestTarget.Keyspace,
+		Shard:    tabletconntest.TestTarget.Shard,
+		Type:     tabletconntest.TestTarget.TabletType,
+		Alias:    tabletconntest.TestAlias,
+		Hostname: host,
+		PortMap: map[string]int32{
+			"grpc": int32(port),
+		},
+	}, service, nil)
+}
+
+func newTestAddrPool() (*addrPool, *int) {
+	dials := 0
+	return &addrPool{
+		addr: "localhost:0",
+		dial: func() (*grpc.ClientConn, error) {
+			dials++
+			return grpcclient.Dial("localhost:0", grpcclient.FailFast(false), grpc.WithInsecure())
+		},
+	}, &dials
+}
+
+func TestAddrPoolBound(t *testing.T) {
+	setPoolFlags(t, 2, 0)
+	ap, dials := newTestAddrPool()
+	defer ap.close()
+
+	// An idle connection is reused.
+	pc1, err := ap.acquire()
+	require.NoError(t, err)
+	ap.release(pc1)
+	pc1, err = ap.acquire()
+	require.NoError(t, err)
+	assert.Equal(t, 1, *dials)
+
+	// A busy connection makes the pool grow, up to its size.
+	pc2, err := ap.acquire()
+	require.NoError(t, err)
+	assert.True(t, pc1 != pc2)
+	pc3, err := ap.acquire()
+	require.NoError(t, err)
+	assert.Equal(t, 2, *dials)
+	assert.True(t, pc3 == pc1 || pc3 == pc2)
+
+	// The least loaded connection is picked.
+	ap.release(pc1)
+	ap.release(pc2)
+	ap.release(pc3)
+	pc4, err := ap.acquire()
+	require.NoError(t, err)
+	pc5, err := ap.acquire()
+	require.NoError(t, err)
+	assert.True(t, pc4 != pc5)
+	ap.release(pc4)
+	ap.release(pc5)
+
+	ap.close()
+	_, err = ap.acquire()
+	assert.EqualError(t, err, "connection pool to localhost:0 is closed")
+	assert.Equal(t, connectivity.Shutdown, pc1.cc.GetState())
+}
+
+func TestAddrPoolRebalance(t *testing.T) {
+	setPoolFlags(t, 2, time.Hour)
+	ap, dials := newTestAddrPool()
+	defer ap.close()
+
+	old, err := ap.acquire()
+	require.NoError(t, err)
+	old.created = time.Now().Add(-2 * time.Hour)
+	before := poolRebalances.Counts()[ap.addr]
+
+	// The old connection is replaced, but stays open for its call.
+	pc, err := ap.acquire()
+	require.NoError(t, err)
+	assert.True(t, old != pc)
+	assert.Equal(t, 2, *dials)
+	assert.Equal(t, before+1, poolRebalances.Counts()[ap.addr])
+	assert.True(t, old.draining)
+	assert.NotEqual(t, connectivity.Shutdown, old.cc.GetState())
+
+	ap.release(old)
+	assert.Equal(t, connectivity.Shutdown, old.cc.GetState())
+	ap.release(pc)
+}
+
+func TestTabletConnPoolShared(t *testing.T) {
+	setPoolFlags(t, 1, 0)
+	tablet := &topodatapb.Tablet{Hostname: "localhost", PortMap: map[string]int32{"grpc": 1}}
+
+	qs1, err := DialTablet(tablet, grpcclient.FailFast(false))
+	require.NoError(t, err)
+	qs2, err := DialTablet(tablet, grpcclient.FailFast(false))
+	require.NoError(t, err)
+	conn1, conn2 := qs1.(*gRPCQueryClient), qs2.(*gRPCQueryClient)
+	assert.Same(t, conn1.pool, conn2.pool)
+	pool := conn1.pool
+
+	pc, err := pool.acquire()
+	require.NoError(t, err)
+	pool.release(pc)
+	assert.EqualValues(t, 1, poolConnections.Counts()["localhost:1"])
+
+	// The pool is closed with its last client.
+	require.NoError(t, qs1.Close(context.Background()))
+	assert.False(t, pool.closed)
+	require.NoError(t, qs2.Close(context.Background()))
+	assert.True(t, pool.closed)
+	assert.Equal(t, connectivity.Shutdown, pc.cc.GetState())
+}
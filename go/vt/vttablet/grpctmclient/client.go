@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -40,6 +41,7 @@ import (
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	tabletmanagerservicepb "vitess.io/vitess/go/vt/proto/tabletmanagerservice"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var (
@@ -203,6 +205,29 @@ func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet,
 	return response.SchemaDefinition, nil
 }
 
+// GetSchemaForPos is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSchemaForPos(ctx context.Context, tablet *topodatapb.Tablet, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetSchema(ctx, &tabletmanagerdatapb.GetSchemaRequest{
+		Tables:        tables,
+		ExcludeTables: excludeTables,
+		Position:      position,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The tablets that do not know the position field of the request
+	// return their current schema, without the position.
+	if response.Position != position {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "tablet %v does not support fetching the schema as of a position", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return response.SchemaDefinition, nil
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	cc, c, err := client.dial(tablet)
@@ -78,7 +78,13 @@ func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.Get
 	defer s.tm.HandleRPCPanic(ctx, "GetSchema", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.GetSchemaResponse{}
-	sd, err := s.tm.GetSchema(ctx, request.Tables, request.ExcludeTables, request.IncludeViews)
+	var sd *tabletmanagerdatapb.SchemaDefinition
+	if request.Position != "" {
+		sd, err = s.tm.GetSchemaForPos(ctx, request.Position, request.Tables, request.ExcludeTables)
+		response.Position = request.Position
+	} else {
+		sd, err = s.tm.GetSchema(ctx, request.Tables, request.ExcludeTables, request.IncludeViews)
+	}
 	if err == nil {
 		response.SchemaDefinition = sd
 	}
@@ -49,14 +49,13 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/ddlhooks"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
-
-	"github.com/google/shlex"
 )
 
 var (
@@ -109,6 +108,12 @@ const (
 	databasePoolSize              = 3
 )
 
+// The phases of the cut-over of a migration reported by the OSC tools.
+const (
+	cutOverPhasePre  = "pre"
+	cutOverPhasePost = "post"
+)
+
 var (
 	migrationLogFileName = "migration.log"
 	onlineDDLUser        = "vt-online-ddl-internal"
@@ -136,6 +141,11 @@ type Executor struct {
 	ticks             *timer.Timer
 	isOpen            bool
 	schemaInitialized bool
+
+	// ddlHookEvents holds the events of the migrations whose pre phase
+	// DDL hooks ran, until their post phase runs.
+	ddlHookMutex  sync.Mutex
+	ddlHookEvents map[string]*ddlhooks.Event
 }
 
 // GhostBinaryFileName returns the full path+name of the gh-ost binary
@@ -168,6 +178,7 @@ func NewExecutor(env tabletenv.Env, tabletAlias topodatapb.TabletAlias, ts *topo
 		tabletTypeFunc: tabletTypeFunc,
 		ts:             ts,
 		ticks:          timer.NewTimer(*migrationCheckInterval),
+		ddlHookEvents:  make(map[string]*ddlhooks.Event),
 	}
 }
 
@@ -473,6 +484,13 @@ curl -s 'http://localhost:%d/schema-migration/report-status?uuid=%s&status=%s&dr
 		log.Errorf("Error creating script: %+v", err)
 		return err
 	}
+	onCutOverHookContent := fmt.Sprintf(`#!/bin/bash
+curl -sf 'http://localhost:%d/schema-migration/cut-over?uuid=%s&phase=%s&dryrun='"$GH_OST_DRY_RUN"
+		`, *servenv.Port, onlineDDL.UUID, cutOverPhasePre)
+	if _, err := createTempScript(tempDir, "gh-ost-on-before-cut-over", onCutOverHookContent); err != nil {
+		log.Errorf("Error creating script: %+v", err)
+		return err
+	}
 	if _, err := createTempScript(tempDir, "gh-ost-on-success", onHookContent(schema.OnlineDDLStatusComplete)); err != nil {
 		log.Errorf("Error creating script: %+v", err)
 		return err
@@ -552,7 +570,7 @@ curl -s 'http://localhost:%d/schema-migration/report-status?uuid=%s&status=%s&dr
 			fmt.Sprintf(`--panic-flag-file=%s`, e.ghostPanicFlagFileName(onlineDDL.UUID)),
 			fmt.Sprintf(`--execute=%t`, execute),
 		}
-		opts, _ := shlex.Split(onlineDDL.Options)
+		opts := onlineDDL.RuntimeOptions()
 		args = append(args, opts...)
 		_, err := execCmd("bash", args, os.Environ(), "/tmp", nil, nil)
 		return err
@@ -565,6 +583,8 @@ curl -s 'http://localhost:%d/schema-migration/report-status?uuid=%s&status=%s&dr
 		defer atomic.StoreInt64(&e.migrationRunning, 0)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
+		// A terminated migration leaves its panic flag file behind.
+		defer e.deleteGhostPanicFlagFile(onlineDDL.UUID)
 
 		log.Infof("Will now dry-run gh-ost on: %s:%d", mysqlHost, mysqlPort)
 		if err := runGhost(false); err != nil {
@@ -666,6 +686,17 @@ export MYSQL_PWD
 	  get("http://localhost:{{VTTABLET_PORT}}/schema-migration/report-status?uuid={{MIGRATION_UUID}}&status={{OnlineDDLStatusRunning}}&dryrun={{DRYRUN}}");
 	}
 
+	sub before_swap_tables {
+	  my($self, % args) = @_;
+	  defined(get("http://localhost:{{VTTABLET_PORT}}/schema-migration/cut-over?uuid={{MIGRATION_UUID}}&phase={{CutOverPhasePre}}&dryrun={{DRYRUN}}"))
+	    or die "pre cut-over DDL hooks failed";
+	}
+
+	sub after_swap_tables {
+	  my($self, % args) = @_;
+	  get("http://localhost:{{VTTABLET_PORT}}/schema-migration/cut-over?uuid={{MIGRATION_UUID}}&phase={{CutOverPhasePost}}&dryrun={{DRYRUN}}");
+	}
+
 	sub before_exit {
 		my($self, % args) = @_;
 		my $exit_status = $args{exit_status};
@@ -697,6 +728,8 @@ export MYSQL_PWD
 	pluginCode = strings.ReplaceAll(pluginCode, "{{OnlineDDLStatusRunning}}", string(schema.OnlineDDLStatusRunning))
 	pluginCode = strings.ReplaceAll(pluginCode, "{{OnlineDDLStatusComplete}}", string(schema.OnlineDDLStatusComplete))
 	pluginCode = strings.ReplaceAll(pluginCode, "{{OnlineDDLStatusFailed}}", string(schema.OnlineDDLStatusFailed))
+	pluginCode = strings.ReplaceAll(pluginCode, "{{CutOverPhasePre}}", cutOverPhasePre)
+	pluginCode = strings.ReplaceAll(pluginCode, "{{CutOverPhasePost}}", cutOverPhasePost)
 
 	// Validate pt-online-schema-change binary:
 	log.Infof("Will now validate pt-online-schema-change binary")
@@ -780,7 +813,7 @@ export MYSQL_PWD
 				`--no-drop-old-table`,
 			)
 		}
-		opts, _ := shlex.Split(onlineDDL.Options)
+		opts := onlineDDL.RuntimeOptions()
 		args = append(args, opts...)
 		_, err = execCmd("bash", args, os.Environ(), "/tmp", nil, nil)
 		return err
@@ -1010,6 +1043,7 @@ func (e *Executor) scheduleNextMigration(ctx context.Context) error {
 func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
 	failMigration := func(err error) error {
 		_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
+		e.runPostDDLHooks(ctx, onlineDDL.UUID, err)
 		e.triggerNextCheckInterval()
 		return err
 	}
@@ -1018,6 +1052,14 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 	if err != nil {
 		return failMigration(err)
 	}
+	if ddlAction != sqlparser.AlterDDLAction {
+		// CREATE and DROP are executed directly, so they are their own
+		// cut-over. The OSC tools run the DDL hooks of ALTER around
+		// their cut-over, see OnSchemaMigrationCutOver.
+		if err := e.runPreDDLHooks(ctx, onlineDDL); err != nil {
+			return failMigration(err)
+		}
+	}
 	switch ddlAction {
 	case sqlparser.DropDDLAction:
 		go func() error {
@@ -1062,6 +1104,11 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			}
 		}()
 	case sqlparser.AlterDDLAction:
+		if e.env.Config().ForeignKeyMode == tabletenv.ForeignKeyBlock {
+			if err := e.checkForeignKeys(ctx, onlineDDL); err != nil {
+				return failMigration(err)
+			}
+		}
 		switch onlineDDL.Strategy {
 		case schema.DDLStrategyGhost:
 			go func() {
@@ -1185,6 +1232,29 @@ func (e *Executor) dropPTOSCMigrationTriggers(ctx context.Context, onlineDDL *sc
 	return err
 }
 
+// checkForeignKeys fails if the table of the migration is a parent or a
+// child table of a foreign key: the table copy of the online DDL tools
+// would leave the foreign keys referencing the original table.
+func (e *Executor) checkForeignKeys(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectForeignKeys, ":mysql_schema", ":mysql_table", ":mysql_table")
+	bindVars := map[string]*querypb.BindVariable{
+		"mysql_schema": sqltypes.StringBindVariable(onlineDDL.Schema),
+		"mysql_table":  sqltypes.StringBindVariable(onlineDDL.Table),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return err
+	}
+	if row := r.Named().Row(); row != nil {
+		return fmt.Errorf("table %s has the foreign key %s of table %s: online DDL is not allowed in the block foreign key mode", onlineDDL.Table, row.AsString("constraint_name", ""), row.AsString("table_name", ""))
+	}
+	return nil
+}
+
 // reviewRunningMigrations iterates migrations in 'running' state (there really should just be one that is
 // actually running).
 func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning int, runningNotByThisProcess []string, err error) {
@@ -1553,7 +1623,11 @@ func (e *Executor) onSchemaMigrationStatus(ctx context.Context, uuid string, sta
 
 	if !dryRun {
 		switch status {
-		case schema.OnlineDDLStatusComplete, schema.OnlineDDLStatusFailed:
+		case schema.OnlineDDLStatusComplete:
+			e.runPostDDLHooks(ctx, uuid, nil)
+			e.triggerNextCheckInterval()
+		case schema.OnlineDDLStatusFailed:
+			e.runPostDDLHooks(ctx, uuid, fmt.Errorf("migration %s failed", uuid))
 			e.triggerNextCheckInterval()
 		}
 	}
@@ -1561,6 +1635,38 @@ func (e *Executor) onSchemaMigrationStatus(ctx context.Context, uuid string, sta
 	return nil
 }
 
+// runPreDDLHooks runs the pre phase DDL hooks of a migration that is
+// about to be executed.
+func (e *Executor) runPreDDLHooks(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	if !ddlhooks.Enabled() {
+		return nil
+	}
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	event := ddlhooks.NewEvent(e.keyspace, e.shard, onlineDDL.UUID, onlineDDL.SQL, ddlStmt)
+	if err := ddlhooks.Run(ctx, event); err != nil {
+		return err
+	}
+	e.ddlHookMutex.Lock()
+	defer e.ddlHookMutex.Unlock()
+	e.ddlHookEvents[onlineDDL.UUID] = event
+	return nil
+}
+
+// runPostDDLHooks runs the post phase DDL hooks of a migration, once it
+// completed or failed. It runs at most once per migration.
+func (e *Executor) runPostDDLHooks(ctx context.Context, uuid string, err error) {
+	e.ddlHookMutex.Lock()
+	event, ok := e.ddlHookEvents[uuid]
+	delete(e.ddlHookEvents, uuid)
+	e.ddlHookMutex.Unlock()
+	if ok {
+		ddlhooks.RunPost(ctx, event, err)
+	}
+}
+
 // OnSchemaMigrationStatus is called by TabletServer's API, which is invoked by a running gh-ost migration's hooks.
 func (e *Executor) OnSchemaMigrationStatus(ctx context.Context, uuidParam, statusParam, dryrunParam, progressParam string) (err error) {
 	status := schema.OnlineDDLStatus(statusParam)
@@ -1573,6 +1679,29 @@ func (e *Executor) OnSchemaMigrationStatus(ctx context.Context, uuidParam, statu
 	return e.onSchemaMigrationStatus(ctx, uuidParam, status, dryRun, progressPct)
 }
 
+// OnSchemaMigrationCutOver is called by TabletServer's API, which is invoked by the hooks of
+// gh-ost and pt-online-schema-change right before and right after they cut over a migration.
+// It runs the pre phase DDL hooks before the cut-over, and a failure aborts the cut-over.
+// It runs the post phase DDL hooks after it. gh-ost has no hook right after its cut-over:
+// its post phase DDL hooks run when it reports the migration as complete.
+func (e *Executor) OnSchemaMigrationCutOver(ctx context.Context, uuidParam, phaseParam, dryrunParam string) error {
+	if dryrunParam == "true" {
+		return nil
+	}
+	switch phaseParam {
+	case cutOverPhasePre:
+		onlineDDL, err := e.readMigration(ctx, uuidParam)
+		if err != nil {
+			return err
+		}
+		return e.runPreDDLHooks(ctx, onlineDDL)
+	case cutOverPhasePost:
+		e.runPostDDLHooks(ctx, uuidParam, nil)
+		return nil
+	}
+	return fmt.Errorf("unknown cut-over phase: %q", phaseParam)
+}
+
 // VExec is called by a VExec invocation
 func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *querypb.QueryResult, err error) {
 	response := func(result *sqltypes.Result, err error) (*querypb.QueryResult, error) {
@@ -216,6 +216,15 @@ const (
 			AND ACTION_TIMING='AFTER'
 			AND LEFT(TRIGGER_NAME, 7)='pt_osc_'
 		`
+	sqlSelectForeignKeys = `SELECT
+			CONSTRAINT_NAME as constraint_name,
+			TABLE_NAME as table_name
+		FROM INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS
+		WHERE
+			CONSTRAINT_SCHEMA=%a
+			AND (TABLE_NAME=%a OR REFERENCED_TABLE_NAME=%a)
+		LIMIT 1
+		`
 	sqlDropTrigger    = "DROP TRIGGER IF EXISTS `%a`.`%a`"
 	sqlShowTablesLike = "SHOW TABLES LIKE '%a'"
 )
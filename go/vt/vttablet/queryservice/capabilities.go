@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queryservice
+
+import (
+	"context"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Version is the version of the query service API of this build.
+// The tablets advertise it with their capabilities in their health
+// stream.
+const Version = 1
+
+// The options of the requests whose values are capabilities.
+const (
+	bindVariableType     = "bind_variable_type"
+	includedFields       = "included_fields"
+	workload             = "workload"
+	transactionIsolation = "transaction_isolation"
+)
+
+// localCapabilities are the capabilities of this build: every value of
+// the options above it understands, as "<option>:<value>".
+var localCapabilities = func() []string {
+	var caps []string
+	add := func(option string, values map[int32]string) {
+		for _, value := range values {
+			caps = append(caps, capability(option, value))
+		}
+	}
+	add(bindVariableType, querypb.Type_name)
+	add(includedFields, querypb.ExecuteOptions_IncludedFields_name)
+	add(workload, querypb.ExecuteOptions_Workload_name)
+	add(transactionIsolation, querypb.ExecuteOptions_TransactionIsolation_name)
+	sort.Strings(caps)
+	return caps
+}()
+
+func capability(option, value string) string {
+	return option + ":" + value
+}
+
+// LocalCapabilities returns the capabilities of this build.
+func LocalCapabilities() []string {
+	return localCapabilities
+}
+
+// Capabilities are the version and the capabilities a tablet advertised
+// in its health stream.
+type Capabilities struct {
+	version   int32
+	supported map[string]bool
+	// complete is true if the tablet has all the local capabilities.
+	complete bool
+}
+
+// NewCapabilities returns the Capabilities of a tablet.
+func NewCapabilities(version int32, capabilities []string) *Capabilities {
+	c := &Capabilities{
+		version:   version,
+		supported: make(map[string]bool, len(capabilities)),
+		complete:  true,
+	}
+	for _, capability := range capabilities {
+		c.supported[capability] = true
+	}
+	for _, capability := range localCapabilities {
+		if !c.supported[capability] {
+			c.complete = false
+			break
+		}
+	}
+	return c
+}
+
+// Version returns the query service version of the tablet.
+func (c *Capabilities) Version() int32 {
+	if c == nil {
+		return 0
+	}
+	return c.version
+}
+
+// Matches returns true if c are the given version and capabilities.
+func (c *Capabilities) Matches(version int32, capabilities []string) bool {
+	if c == nil || c.version != version || len(c.supported) != len(capabilities) {
+		return false
+	}
+	for _, capability := range capabilities {
+		if !c.supported[capability] {
+			return false
+		}
+	}
+	return true
+}
+
+// Supports returns true if the tablet has the capability. The tablets
+// older than the negotiation are assumed to have them all.
+func (c *Capabilities) Supports(capability string) bool {
+	if c == nil || c.version == 0 {
+		return true
+	}
+	return c.supported[capability]
+}
+
+// Negotiate returns a QueryService that fails the requests the tablet
+// of conn does not support with a FAILED_PRECONDITION error, so that they
+// can be retried on another tablet during a rolling upgrade, instead of
+// failing on the tablet with a decoding error. It returns conn itself if
+// the tablet has all the capabilities of this build.
+func Negotiate(conn QueryService, caps *Capabilities) QueryService {
+	if conn == nil || caps == nil || caps.version == 0 || caps.complete {
+		return conn
+	}
+	return &negotiatedService{QueryService: conn, caps: caps}
+}
+
+// negotiatedService checks the requests with options or bind variables
+// before sending them to the tablet.
+type negotiatedService struct {
+	QueryService
+	caps *Capabilities
+}
+
+func (ns *negotiatedService) require(option, value string) error {
+	if ns.caps.Supports(capability(option, value)) {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the tablet does not support %s %s, it runs the version %d of the query service", option, value, ns.caps.version)
+}
+
+func (ns *negotiatedService) check(bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) error {
+	for _, bv := range bindVariables {
+		if err := ns.require(bindVariableType, bv.Type.String()); err != nil {
+			return err
+		}
+		for _, v := range bv.Values {
+			if err := ns.require(bindVariableType, v.Type.String()); err != nil {
+				return err
+			}
+		}
+	}
+	if options == nil {
+		return nil
+	}
+	if err := ns.require(includedFields, options.IncludedFields.String()); err != nil {
+		return err
+	}
+	if err := ns.require(workload, options.Workload.String()); err != nil {
+		return err
+	}
+	return ns.require(transactionIsolation, options.TransactionIsolation.String())
+}
+
+func (ns *negotiatedService) checkQueries(queries []*querypb.BoundQuery, options *querypb.ExecuteOptions) error {
+	for _, query := range queries {
+		if err := ns.check(query.BindVariables, nil); err != nil {
+			return err
+		}
+	}
+	return ns.check(nil, options)
+}
+
+func (ns *negotiatedService) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (int64, *topodatapb.TabletAlias, error) {
+	if err := ns.check(nil, options); err != nil {
+		return 0, nil, err
+	}
+	return ns.QueryService.Begin(ctx, target, options)
+}
+
+func (ns *negotiatedService) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	if err := ns.check(bindVariables, options); err != nil {
+		return nil, err
+	}
+	return ns.QueryService.Execute(ctx, target, sql, bindVariables, transactionID, reservedID, options)
+}
+
+func (ns *negotiatedService) StreamExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) error {
+	if err := ns.check(bindVariables, options); err != nil {
+		return err
+	}
+	return ns.QueryService.StreamExecute(ctx, target, sql, bindVariables, transactionID, options, callback)
+}
+
+func (ns *negotiatedService) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
+	if err := ns.checkQueries(queries, options); err != nil {
+		return nil, err
+	}
+	return ns.QueryService.ExecuteBatch(ctx, target, queries, asTransaction, transactionID, options)
+}
+
+func (ns *negotiatedService) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
+	if err := ns.check(bindVariables, options); err != nil {
+		return nil, 0, nil, err
+	}
+	return ns.QueryService.BeginExecute(ctx, target, preQueries, sql, bindVariables, reservedID, options)
+}
+
+func (ns *negotiatedService) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
+	if err := ns.checkQueries(queries, options); err != nil {
+		return nil, 0, nil, err
+	}
+	return ns.QueryService.BeginExecuteBatch(ctx, target, queries, asTransaction, options)
+}
+
+func (ns *negotiatedService) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
+	if err := ns.check(bindVariables, options); err != nil {
+		return nil, 0, 0, nil, err
+	}
+	return ns.QueryService.ReserveBeginExecute(ctx, target, preQueries, sql, bindVariables, options)
+}
+
+func (ns *negotiatedService) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
+	if err := ns.check(bindVariables, options); err != nil {
+		return nil, 0, nil, err
+	}
+	return ns.QueryService.ReserveExecute(ctx, target, preQueries, sql, bindVariables, transactionID, options)
+}
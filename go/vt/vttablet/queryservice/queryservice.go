@@ -105,6 +105,11 @@ type QueryService interface {
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
 
+	// ExportTable streams the rows of a table from a consistent snapshot,
+	// after lastpk and up to endpk if they are set. Every batch of rows
+	// comes with its last pk, from which the export can be resumed.
+	ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
 	// StreamHealth streams health status.
 	StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error
 
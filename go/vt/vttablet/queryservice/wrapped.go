@@ -271,6 +271,13 @@ func (ws *wrappedService) VStreamResults(ctx context.Context, target *querypb.Ta
 	})
 }
 
+func (ws *wrappedService) ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	return ws.wrapper(ctx, target, ws.impl, "ExportTable", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		innerErr := conn.ExportTable(ctx, target, table, lastpk, endpk, send)
+		return false, innerErr
+	})
+}
+
 func (ws *wrappedService) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	return ws.wrapper(ctx, nil, ws.impl, "StreamHealth", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.StreamHealth(ctx, callback)
@@ -435,6 +435,11 @@ func (sbc *SandboxConn) VStreamResults(ctx context.Context, target *querypb.Targ
 	return fmt.Errorf("not implemented in test")
 }
 
+// ExportTable is part of the QueryService interface.
+func (sbc *SandboxConn) ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	return fmt.Errorf("not implemented in test")
+}
+
 // QueryServiceByAlias is part of the Gateway interface.
 func (sbc *SandboxConn) QueryServiceByAlias(_ *topodatapb.TabletAlias) (queryservice.QueryService, error) {
 	return sbc, nil
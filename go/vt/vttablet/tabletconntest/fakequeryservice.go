@@ -719,6 +719,11 @@ func (f *FakeQueryService) VStreamResults(ctx context.Context, target *querypb.T
 	panic("not implemented")
 }
 
+// ExportTable is part of the QueryService interface.
+func (f *FakeQueryService) ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	panic("not implemented")
+}
+
 // QueryServiceByAlias satisfies the Gateway interface
 func (f *FakeQueryService) QueryServiceByAlias(_ *topodatapb.TabletAlias) (queryservice.QueryService, error) {
 	panic("not implemented")
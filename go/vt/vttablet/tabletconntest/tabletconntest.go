@@ -867,6 +867,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 	// create a connection
 	if clientCreds != nil {
 		flag.Set("grpc_auth_static_client_creds", clientCreds.Name())
+		defer flag.Set("grpc_auth_static_client_creds", "")
 	}
 
 	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
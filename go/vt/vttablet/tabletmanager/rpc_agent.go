@@ -42,6 +42,8 @@ type RPCTM interface {
 
 	GetSchema(ctx context.Context, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	GetSchemaForPos(ctx context.Context, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error)
+
 	GetPermissions(ctx context.Context) (*tabletmanagerdatapb.Permissions, error)
 
 	// Various read-write methods
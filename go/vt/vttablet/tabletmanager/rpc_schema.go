@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // GetSchema returns the schema.
@@ -34,6 +35,45 @@ func (tm *TabletManager) GetSchema(ctx context.Context, tables, excludeTables []
 	return tm.MysqlDaemon.GetSchema(ctx, topoproto.TabletDbName(tm.Tablet()), tables, excludeTables, includeViews)
 }
 
+// GetSchemaForPos returns the schema as of a replication position, from the
+// schema versions tracked by the tablet server. The tracked versions don't
+// keep the CREATE statements: the table definitions only have the columns,
+// their fields and the primary key columns.
+func (tm *TabletManager) GetSchemaForPos(ctx context.Context, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	se := tm.QueryServiceControl.SchemaEngine()
+	if se == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "GetSchemaForPos: no schema engine")
+	}
+	minimalTables, err := se.GetSchemaForPos(position)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetSchemaForPos(%v)", position)
+	}
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	for _, table := range minimalTables {
+		td := &tabletmanagerdatapb.TableDefinition{
+			Name:   table.Name,
+			Type:   tmutils.TableBaseTable,
+			Fields: table.Fields,
+		}
+		for _, field := range table.Fields {
+			td.Columns = append(td.Columns, field.Name)
+		}
+		for _, pk := range table.PKColumns {
+			if pk < 0 || int(pk) >= len(table.Fields) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "GetSchemaForPos: invalid primary key column %d for table %s", pk, table.Name)
+			}
+			td.PrimaryKeyColumns = append(td.PrimaryKeyColumns, table.Fields[pk].Name)
+		}
+		sd.TableDefinitions = append(sd.TableDefinitions, td)
+	}
+	sd, err = tmutils.FilterTables(sd, tables, excludeTables, false)
+	if err != nil {
+		return nil, err
+	}
+	tmutils.GenerateSchemaVersion(sd)
+	return sd, nil
+}
+
 // ReloadSchema will reload the schema
 // This doesn't need the action mutex because periodic schema reloads happen
 // in the background anyway.
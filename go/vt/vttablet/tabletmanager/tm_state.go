@@ -40,6 +40,7 @@ import (
 )
 
 var publishRetryInterval = flag.Duration("publish_retry_interval", 30*time.Second, "how long vttablet waits to retry publishing the tablet record")
+var blacklistRetryInterval = flag.Duration("blacklist_retry_interval", 30*time.Second, "how long vttablet waits to retry enforcing blacklisted tables")
 
 // tmState manages the state of the TabletManager.
 type tmState struct {
@@ -63,6 +64,9 @@ type tmState struct {
 	blacklistedTables map[topodatapb.TabletType][]string
 	tablet            *topodatapb.Tablet
 	isPublishing      bool
+	// isRetryingBlacklist is set while the query service is disabled
+	// because the blacklisted tables could not be enforced.
+	isRetryingBlacklist bool
 
 	// displayState contains the current snapshot of the internal state
 	// and has its own mutex.
@@ -217,9 +221,23 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 
 	terTime := logutil.ProtoToTime(ts.tablet.MasterTermStartTime)
 
+	reason := ts.canServe(ts.tablet.Type)
+	// The blacklisted tables are typically being migrated away from
+	// this shard. If they can't be enforced, the tablet must not serve
+	// until they can, or writes could go to the wrong shard.
+	if err := ts.applyBlacklist(ctx); err != nil {
+		log.Errorf("Cannot update blacklisted tables rule: %v", err)
+		if reason == "" {
+			reason = fmt.Sprintf("cannot enforce blacklisted tables: %v", err)
+		}
+		if !ts.isRetryingBlacklist {
+			ts.isRetryingBlacklist = true
+			go ts.retryBlacklist()
+		}
+	}
+
 	// Disable TabletServer first so the nonserving state gets advertised
 	// before other services are shutdown.
-	reason := ts.canServe(ts.tablet.Type)
 	if reason != "" {
 		log.Infof("Disabling query service: %v", reason)
 		if err := ts.tm.QueryServiceControl.SetServingType(ts.tablet.Type, terTime, false, reason); err != nil {
@@ -227,10 +245,6 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
-	if err := ts.applyBlacklist(ctx); err != nil {
-		log.Errorf("Cannot update blacklisted tables rule: %v", err)
-	}
-
 	ts.tm.replManager.SetTabletType(ts.tablet.Type)
 
 	if ts.tm.UpdateStream != nil {
@@ -270,6 +284,30 @@ func (ts *tmState) canServe(tabletType topodatapb.TabletType) string {
 	return ""
 }
 
+// retryBlacklist keeps trying to enforce the blacklisted tables, and
+// updates the state once it succeeds so the tablet can serve again.
+func (ts *tmState) retryBlacklist() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	for {
+		ts.mu.Unlock()
+		time.Sleep(*blacklistRetryInterval)
+		ts.mu.Lock()
+		if !ts.isOpen {
+			ts.isRetryingBlacklist = false
+			return
+		}
+		if err := ts.applyBlacklist(ts.ctx); err != nil {
+			log.Errorf("Cannot update blacklisted tables rule, will keep retrying: %v", err)
+			continue
+		}
+		ts.isRetryingBlacklist = false
+		ts.updateLocked(ts.ctx)
+		return
+	}
+}
+
 func (ts *tmState) applyBlacklist(ctx context.Context) (err error) {
 	blacklistRules := rules.New()
 	blacklistedTables := ts.blacklistedTables[ts.tablet.Type]
@@ -18,6 +18,7 @@ package tabletmanager
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -123,6 +124,58 @@ func TestStateBlacklist(t *testing.T) {
 	assert.Equal(t, `[{"Description":"enforce blacklisted tables","Name":"blacklisted_table","TableNames":["t1"],"Action":"FAIL_RETRY"}]`, string(b))
 }
 
+func TestStateBlacklistError(t *testing.T) {
+	defer func(saved time.Duration) { *blacklistRetryInterval = saved }(*blacklistRetryInterval)
+	*blacklistRetryInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+
+	fmd := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	tm.tmState.mu.Lock()
+	fmd.SchemaFunc = func() (*tabletmanagerdatapb.SchemaDefinition, error) {
+		return nil, errors.New("schema unavailable")
+	}
+	tm.tmState.mu.Unlock()
+	si := &topo.ShardInfo{
+		Shard: &topodatapb.Shard{
+			TabletControls: []*topodatapb.Shard_TabletControl{{
+				TabletType:        topodatapb.TabletType_REPLICA,
+				Cells:             []string{"cell1"},
+				BlacklistedTables: []string{"t1"},
+			}},
+		},
+	}
+	tm.tmState.RefreshFromTopoInfo(ctx, si, nil)
+
+	// The blacklist can't be enforced: the tablet must not serve.
+	qsc := tm.QueryServiceControl.(*tabletservermock.Controller)
+	assert.False(t, qsc.IsServing())
+
+	// Once the schema can be read, the retry restores serving.
+	tm.tmState.mu.Lock()
+	fmd.SchemaFunc = nil
+	fmd.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name: "t1",
+		}},
+	}
+	tm.tmState.mu.Unlock()
+	for i := 0; ; i++ {
+		if qsc.IsServing() {
+			break
+		}
+		if i > 500 {
+			t.Fatal("tablet did not start serving after blacklist was enforced")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	b, _ := json.Marshal(qsc.GetQueryRules(blacklistQueryRules))
+	assert.Equal(t, `[{"Description":"enforce blacklisted tables","Name":"blacklisted_table","TableNames":["t1"],"Action":"FAIL_RETRY"}]`, string(b))
+}
+
 func TestStateTabletControls(t *testing.T) {
 	ctx := context.Background()
 	ts := memorytopo.NewServer("cell1")
@@ -0,0 +1,235 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"flag"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	copyPhaseWorkers           = flag.Int("vreplication_copy_phase_workers", 1, "Number of connections used by each VReplication stream to insert the rows of the table being copied. Batches are inserted concurrently, but committed in order, so that the copy can resume from the last committed primary key.")
+	copyPhaseTableWorkers      = flag.Int("vreplication_copy_phase_table_workers", 1, "Number of tables copied concurrently by each VReplication stream during its copy phase. Each table is copied with its own connection. Only the streams that ignore DDLs copy tables concurrently.")
+	copyPhaseMaxBytesPerSecond = flag.Int64("vreplication_copy_phase_max_bytes_per_sec", 0, "Maximum number of bytes per second copied by all the VReplication streams of the tablet during their copy phase. 0 means unlimited.")
+)
+
+// copySessionQueries are executed on the connections of the copy
+// workers, to match the session of the vreplicator connection.
+var copySessionQueries = []string{
+	"set @@session.time_zone = '+00:00'",
+	"set names binary",
+	"set foreign_key_checks=0",
+}
+
+// copyTask is a batch of rows copied by a copy worker. insert is run
+// in a transaction as soon as a worker is available. commit is run in
+// the same transaction once all the previous tasks are committed.
+type copyTask struct {
+	insert func(dbClient *vdbClient) error
+	commit func(dbClient *vdbClient) error
+	prev   <-chan struct{}
+	done   chan struct{}
+}
+
+// copyWorkers copies the batches of rows of a table concurrently, each
+// worker using its own connection. Since the batches are committed in
+// the order they were submitted, the lastpk saved in copy_state always
+// covers a contiguous range of rows.
+type copyWorkers struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	tasks  chan *copyTask
+	wg     sync.WaitGroup
+	// last is closed when the last submitted task is committed.
+	last <-chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func newCopyWorkers(ctx context.Context, vr *vreplicator, count int) (*copyWorkers, error) {
+	clients := make([]*vdbClient, 0, count)
+	for i := 0; i < count; i++ {
+		dbClient, err := newCopyWorkerClient(vr)
+		if err != nil {
+			for _, c := range clients {
+				c.Close()
+			}
+			return nil, err
+		}
+		clients = append(clients, dbClient)
+	}
+
+	done := make(chan struct{})
+	close(done)
+	cw := &copyWorkers{
+		tasks: make(chan *copyTask, count),
+		last:  done,
+	}
+	cw.ctx, cw.cancel = context.WithCancel(ctx)
+	for _, dbClient := range clients {
+		cw.wg.Add(1)
+		go cw.run(dbClient)
+	}
+	return cw, nil
+}
+
+func newCopyWorkerClient(vr *vreplicator) (*vdbClient, error) {
+	dbClient := vr.vre.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, vterrors.Wrap(err, "can't connect to database")
+	}
+	for _, query := range copySessionQueries {
+		if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+			dbClient.Close()
+			return nil, err
+		}
+	}
+	return newVDBClient(dbClient, vr.stats), nil
+}
+
+func (cw *copyWorkers) run(dbClient *vdbClient) {
+	defer cw.wg.Done()
+	defer dbClient.Close()
+	for task := range cw.tasks {
+		if err := cw.execute(dbClient, task); err != nil {
+			cw.setError(err)
+		}
+	}
+}
+
+func (cw *copyWorkers) execute(dbClient *vdbClient, task *copyTask) error {
+	defer dbClient.Rollback()
+	if err := cw.ctx.Err(); err != nil {
+		return err
+	}
+	if err := dbClient.Begin(); err != nil {
+		return err
+	}
+	if err := task.insert(dbClient); err != nil {
+		return err
+	}
+	// If a previous task failed, prev is never closed, but the
+	// context is canceled.
+	select {
+	case <-task.prev:
+	case <-cw.ctx.Done():
+		return cw.ctx.Err()
+	}
+	if err := task.commit(dbClient); err != nil {
+		return err
+	}
+	if err := dbClient.Commit(); err != nil {
+		return err
+	}
+	close(task.done)
+	return nil
+}
+
+func (cw *copyWorkers) setError(err error) {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	if cw.err == nil {
+		cw.err = err
+	}
+	cw.cancel()
+}
+
+func (cw *copyWorkers) error() error {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	return cw.err
+}
+
+// submit queues a batch of rows. It blocks while all the workers are
+// busy, and returns the error of a previous batch, if any.
+func (cw *copyWorkers) submit(insert, commit func(dbClient *vdbClient) error) error {
+	if err := cw.error(); err != nil {
+		return err
+	}
+	task := &copyTask{
+		insert: insert,
+		commit: commit,
+		prev:   cw.last,
+		done:   make(chan struct{}),
+	}
+	cw.last = task.done
+	select {
+	case cw.tasks <- task:
+		return nil
+	case <-cw.ctx.Done():
+		if err := cw.error(); err != nil {
+			return err
+		}
+		return cw.ctx.Err()
+	}
+}
+
+// wait waits for the submitted batches to be copied, closes the
+// connections of the workers, and returns the first error.
+func (cw *copyWorkers) wait() error {
+	close(cw.tasks)
+	cw.wg.Wait()
+	cw.cancel()
+	return cw.error()
+}
+
+// newCopyLimiter returns the limiter shared by the copy phase of all the
+// streams, or nil if the bandwidth is not limited.
+func newCopyLimiter(bytesPerSecond int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond))
+}
+
+// waitCopyBandwidth blocks until size bytes can be copied without
+// exceeding -vreplication_copy_phase_max_bytes_per_sec.
+func (vre *Engine) waitCopyBandwidth(ctx context.Context, size int) error {
+	if vre.copyLimiter == nil {
+		return nil
+	}
+	// WaitN fails for more than burst bytes.
+	burst := vre.copyLimiter.Burst()
+	for size > 0 {
+		n := size
+		if n > burst {
+			n = burst
+		}
+		if err := vre.copyLimiter.WaitN(ctx, n); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
+
+// rowsSize returns the number of bytes of rows.
+func rowsSize(rows []*querypb.Row) int {
+	size := 0
+	for _, row := range rows {
+		size += len(row.Values)
+	}
+	return size
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// copyTestDBClient records the queries of the committed transactions.
+type copyTestDBClient struct {
+	mu            *sync.Mutex
+	committed     *[]string
+	pending       []string
+	inTransaction bool
+}
+
+func (dc *copyTestDBClient) DBName() string { return "db" }
+func (dc *copyTestDBClient) Connect() error { return nil }
+func (dc *copyTestDBClient) Close()         {}
+
+func (dc *copyTestDBClient) Begin() error {
+	dc.inTransaction = true
+	return nil
+}
+
+func (dc *copyTestDBClient) Commit() error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	*dc.committed = append(*dc.committed, dc.pending...)
+	dc.pending = nil
+	dc.inTransaction = false
+	return nil
+}
+
+func (dc *copyTestDBClient) Rollback() error {
+	dc.pending = nil
+	dc.inTransaction = false
+	return nil
+}
+
+func (dc *copyTestDBClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	if strings.HasPrefix(query, "set") {
+		return &sqltypes.Result{}, nil
+	}
+	if !dc.inTransaction {
+		dc.mu.Lock()
+		defer dc.mu.Unlock()
+		*dc.committed = append(*dc.committed, query)
+		return &sqltypes.Result{}, nil
+	}
+	dc.pending = append(dc.pending, query)
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
+
+func newCopyWorkersTestReplicator() (*vreplicator, func() []string) {
+	var mu sync.Mutex
+	var committed []string
+	vr := &vreplicator{
+		vre: &Engine{
+			dbClientFactory: func() binlogplayer.DBClient {
+				return &copyTestDBClient{mu: &mu, committed: &committed}
+			},
+		},
+		stats: binlogplayer.NewStats(),
+	}
+	return vr, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), committed...)
+	}
+}
+
+func TestCopyWorkersCommitInOrder(t *testing.T) {
+	vr, committed := newCopyWorkersTestReplicator()
+	cw, err := newCopyWorkers(context.Background(), vr, 4)
+	require.NoError(t, err)
+
+	var want []string
+	for i := 0; i < 10; i++ {
+		i := i
+		// Earlier batches take longer to insert than later ones.
+		err := cw.submit(func(dbClient *vdbClient) error {
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			_, err := dbClient.Execute(fmt.Sprintf("insert %d", i))
+			return err
+		}, func(dbClient *vdbClient) error {
+			_, err := dbClient.Execute(fmt.Sprintf("lastpk %d", i))
+			return err
+		})
+		require.NoError(t, err)
+		want = append(want, fmt.Sprintf("insert %d", i), fmt.Sprintf("lastpk %d", i))
+	}
+	require.NoError(t, cw.wait())
+	assert.Equal(t, want, committed())
+}
+
+func TestCopyWorkersError(t *testing.T) {
+	vr, committed := newCopyWorkersTestReplicator()
+	cw, err := newCopyWorkers(context.Background(), vr, 2)
+	require.NoError(t, err)
+
+	noop := func(dbClient *vdbClient) error { return nil }
+	lastpk := func(i int) func(dbClient *vdbClient) error {
+		return func(dbClient *vdbClient) error {
+			_, err := dbClient.Execute(fmt.Sprintf("lastpk %d", i))
+			return err
+		}
+	}
+	require.NoError(t, cw.submit(noop, lastpk(0)))
+	require.NoError(t, cw.submit(func(dbClient *vdbClient) error {
+		return errors.New("insert failed")
+	}, lastpk(1)))
+
+	// Once the error is known, no more batches are accepted.
+	for i := 2; ; i++ {
+		if err := cw.submit(noop, lastpk(i)); err != nil {
+			assert.EqualError(t, err, "insert failed")
+			break
+		}
+	}
+	assert.EqualError(t, cw.wait(), "insert failed")
+	assert.Equal(t, []string{"lastpk 0"}, committed())
+}
+
+func TestWaitCopyBandwidth(t *testing.T) {
+	assert.Nil(t, newCopyLimiter(0))
+
+	vre := &Engine{}
+	require.NoError(t, vre.waitCopyBandwidth(context.Background(), 1<<30))
+
+	vre.copyLimiter = newCopyLimiter(10000)
+	start := time.Now()
+	// The first 10000 bytes are the burst, the next 5000 take 500ms.
+	require.NoError(t, vre.waitCopyBandwidth(context.Background(), 15000))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(400*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, vre.waitCopyBandwidth(ctx, 15000))
+}
+
+// copyTestVStreamer streams one packet of rows for each table. The first
+// streams of rows wait for each other, so that they run concurrently.
+type copyTestVStreamer struct {
+	VStreamerClient
+	// gtids are the positions of the snapshots of the tables.
+	gtids map[string]string
+	// events are sent by VStream.
+	events []*binlogdatapb.VEvent
+
+	mu        sync.Mutex
+	streaming int
+	// maxStreaming is the largest number of concurrent streams of rows.
+	maxStreaming int
+	// wait is the number of streams of rows that wait for each other.
+	wait    int
+	started chan struct{}
+	filter  *binlogdatapb.Filter
+	pos     string
+}
+
+func (vs *copyTestVStreamer) VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	tableName := strings.TrimPrefix(query, "select * from ")
+	vs.mu.Lock()
+	vs.streaming++
+	if vs.streaming > vs.maxStreaming {
+		vs.maxStreaming = vs.streaming
+	}
+	wait := vs.wait > 0
+	if wait {
+		vs.wait--
+		if vs.wait == 0 {
+			close(vs.started)
+		}
+	}
+	vs.mu.Unlock()
+	defer func() {
+		vs.mu.Lock()
+		defer vs.mu.Unlock()
+		vs.streaming--
+	}()
+	if wait {
+		select {
+		case <-vs.started:
+		case <-time.After(5 * time.Second):
+			return fmt.Errorf("%s was not copied concurrently", tableName)
+		}
+	}
+	fields := sqltypes.MakeTestFields("id|val", "int64|varbinary")
+	if err := send(&binlogdatapb.VStreamRowsResponse{
+		Fields:   fields,
+		Pkfields: fields[:1],
+		Gtid:     vs.gtids[tableName],
+	}); err != nil {
+		return err
+	}
+	return send(&binlogdatapb.VStreamRowsResponse{
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "2|b").Rows[0])},
+		Lastpk: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields[:1], "2").Rows[0]),
+	})
+}
+
+func (vs *copyTestVStreamer) VStream(ctx context.Context, startPos string, tablePKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	vs.mu.Lock()
+	vs.pos = startPos
+	vs.filter = filter
+	vs.mu.Unlock()
+	if err := send(vs.events); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func newCopyTablesTestReplicator(tables ...string) (*vreplicator, func() []string) {
+	vr, committed := newCopyWorkersTestReplicator()
+	vr.id = 1
+	vr.source = &binlogdatapb.BinlogSource{Filter: &binlogdatapb.Filter{}}
+	vr.pkInfoMap = make(map[string][]*PrimaryKeyInfo)
+	for _, table := range tables {
+		vr.source.Filter.Rules = append(vr.source.Filter.Rules, &binlogdatapb.Rule{Match: table})
+		vr.pkInfoMap[table] = []*PrimaryKeyInfo{{Name: "id"}}
+	}
+	return vr, committed
+}
+
+func TestCopyTablesConcurrently(t *testing.T) {
+	defer func(workers int) { *copyPhaseTableWorkers = workers }(*copyPhaseTableWorkers)
+	*copyPhaseTableWorkers = 2
+
+	vr, committed := newCopyTablesTestReplicator("t1", "t2", "t3")
+	vs := &copyTestVStreamer{
+		gtids: map[string]string{
+			"t1": "MariaDB/0-1-12",
+			"t2": "MariaDB/0-1-13",
+			"t3": "MariaDB/0-1-10",
+		},
+		wait:    2,
+		started: make(chan struct{}),
+	}
+	vr.sourceVStreamer = vs
+	copyState := map[string]*sqltypes.Result{"t1": nil, "t2": nil, "t3": nil}
+	pos, err := mysql.DecodePosition("MariaDB/0-1-10")
+	require.NoError(t, err)
+
+	err = newVCopier(vr).copyTables(context.Background(), []string{"t1", "t2", "t3"}, copyState, nil, pos)
+	require.NoError(t, err)
+	// Two tables were copied at a time.
+	assert.Equal(t, 2, vs.maxStreaming)
+
+	queries := committed()
+	for _, want := range []string{
+		"insert into t1(id,val) values (2,'b')",
+		`update _vt.copy_state set lastpk='fields:<name:\"id\" type:INT64 > rows:<lengths:1 values:\"2\" > ', pos='MariaDB/0-1-12' where vrepl_id=1 and table_name='t1'`,
+		"update _vt.copy_state set lastpk=null, pos='MariaDB/0-1-12' where vrepl_id=1 and table_name='t1'",
+		"insert into t2(id,val) values (2,'b')",
+		"update _vt.copy_state set lastpk=null, pos='MariaDB/0-1-13' where vrepl_id=1 and table_name='t2'",
+		"insert into t3(id,val) values (2,'b')",
+		// The snapshot of t3 is at the position of the stream.
+		"delete from _vt.copy_state where vrepl_id=1 and table_name='t3'",
+	} {
+		assert.Contains(t, queries, want)
+	}
+}
+
+func TestCopyTableConcurrentlyFastForward(t *testing.T) {
+	vr, committed := newCopyTablesTestReplicator("t1", "t2")
+	fields := sqltypes.MakeTestFields("id|val", "int64|varbinary")
+	vs := &copyTestVStreamer{
+		gtids: map[string]string{"t1": "MariaDB/0-1-12"},
+		events: []*binlogdatapb.VEvent{{
+			Type:       binlogdatapb.VEventType_FIELD,
+			FieldEvent: &binlogdatapb.FieldEvent{TableName: "t1", Fields: fields},
+		}, {
+			Type: binlogdatapb.VEventType_ROW,
+			RowEvent: &binlogdatapb.RowEvent{
+				TableName: "t1",
+				RowChanges: []*binlogdatapb.RowChange{{
+					After: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|a").Rows[0]),
+				}},
+			},
+		}, {
+			Type: binlogdatapb.VEventType_GTID,
+			Gtid: "MariaDB/0-1-12",
+		}, {
+			Type: binlogdatapb.VEventType_COMMIT,
+		}},
+	}
+	vr.sourceVStreamer = vs
+	plan, err := buildReplicatorPlan(vr.source.Filter, vr.pkInfoMap, nil, nil)
+	require.NoError(t, err)
+	lastpk := sqltypes.MakeTestResult(fields[:1], "1")
+	startPos, err := mysql.DecodePosition("MariaDB/0-1-11")
+	require.NoError(t, err)
+	pos, err := mysql.DecodePosition("MariaDB/0-1-10")
+	require.NoError(t, err)
+
+	err = newVCopier(vr).copyTableConcurrently(context.Background(), plan, "t1", lastpk, startPos, pos)
+	require.NoError(t, err)
+
+	// Only t1 was fast-forwarded, from the position of its previous snapshot.
+	assert.Equal(t, "MariaDB/0-1-11", vs.pos)
+	require.Len(t, vs.filter.Rules, 1)
+	assert.Equal(t, "t1", vs.filter.Rules[0].Match)
+	assert.Equal(t, []string{
+		// The row event is only applied to the rows already copied.
+		"insert into t1(id,val) select 1, 'a' from dual where (1) <= (1)",
+		"update _vt.copy_state set pos='MariaDB/0-1-12' where vrepl_id=1 and table_name='t1'",
+		"insert into t1(id,val) values (2,'b')",
+		`update _vt.copy_state set lastpk='fields:<name:\"id\" type:INT64 > rows:<lengths:1 values:\"2\" > ', pos='MariaDB/0-1-12' where vrepl_id=1 and table_name='t1'`,
+		"update _vt.copy_state set lastpk=null, pos='MariaDB/0-1-12' where vrepl_id=1 and table_name='t1'",
+	}, committed())
+}
+
+func TestVPlayerSkipsTablePositions(t *testing.T) {
+	vr, committed := newCopyTablesTestReplicator("t1")
+	plan, err := buildReplicatorPlan(vr.source.Filter, vr.pkInfoMap, nil, nil)
+	require.NoError(t, err)
+	fields := sqltypes.MakeTestFields("id|val", "int64|varbinary")
+	tplan, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+	tablePos, err := mysql.DecodePosition("MariaDB/0-1-12")
+	require.NoError(t, err)
+	vp := &vplayer{
+		vr:             vr,
+		dbClient:       newVDBClient(vr.vre.dbClientFactory(), vr.stats),
+		tablePositions: map[string]mysql.Position{"t1": tablePos},
+		tablePlans:     map[string]*TablePlan{"t1": tplan},
+	}
+	rowEvent := &binlogdatapb.RowEvent{
+		TableName: "t1",
+		RowChanges: []*binlogdatapb.RowChange{{
+			After: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|a").Rows[0]),
+		}},
+	}
+
+	// The transaction that follows 0-1-11 is in the snapshot of t1.
+	vp.pos, err = mysql.DecodePosition("MariaDB/0-1-11")
+	require.NoError(t, err)
+	require.NoError(t, vp.applyRowEvent(context.Background(), rowEvent))
+	assert.Empty(t, committed())
+
+	vp.pos = tablePos
+	require.NoError(t, vp.applyRowEvent(context.Background(), rowEvent))
+	assert.Equal(t, []string{"insert into t1(id,val) values (1,'a')"}, committed())
+}
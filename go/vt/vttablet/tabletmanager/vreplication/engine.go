@@ -25,6 +25,8 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
@@ -60,6 +62,8 @@ const (
   table_name varbinary(128),
   lastpk varbinary(2000),
   primary key (vrepl_id, table_name))`
+
+	alterCopyStateAddPos = "alter table _vt.copy_state add column pos varbinary(10000)"
 )
 
 var withDDL *withddl.WithDDL
@@ -71,7 +75,7 @@ const (
 func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
-	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, createReshardingJournalTable, createCopyState, alterCopyStateAddPos)
 	withDDL = withddl.New(allddls)
 }
 
@@ -116,6 +120,10 @@ type Engine struct {
 	ec        *externalConnector
 
 	throttlerClient *throttle.Client
+
+	// copyLimiter caps the bandwidth used by the copy phase of all
+	// the streams. It is nil if the bandwidth is not limited.
+	copyLimiter *rate.Limiter
 }
 
 type journalEvent struct {
@@ -135,6 +143,7 @@ func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mys
 		journaler:       make(map[string]*journalEvent),
 		ec:              newExternalConnector(config.ExternalConnections),
 		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+		copyLimiter:     newCopyLimiter(*copyPhaseMaxBytesPerSecond),
 	}
 	return vre
 }
@@ -162,6 +171,7 @@ func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, db
 		dbName:          dbname,
 		journaler:       make(map[string]*journalEvent),
 		ec:              newExternalConnector(externalConfig),
+		copyLimiter:     newCopyLimiter(*copyPhaseMaxBytesPerSecond),
 	}
 	return vre
 }
@@ -47,6 +47,7 @@ type ReplicatorPlan struct {
 	TargetTables  map[string]*TablePlan
 	TablePlans    map[string]*TablePlan
 	PKInfoMap     map[string][]*PrimaryKeyInfo
+	GeneratedCols map[string]map[string]bool
 }
 
 // buildExecution plan uses the field info as input and the partially built
@@ -81,14 +82,33 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 	return tplan, nil
 }
 
+// forTable returns a copy of the plan that only replicates the target
+// table tableName.
+func (rp *ReplicatorPlan) forTable(tableName string) *ReplicatorPlan {
+	plan := &ReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: rp.VStreamFilter.FieldEventMode},
+		TargetTables:  make(map[string]*TablePlan),
+		TablePlans:    make(map[string]*TablePlan),
+		PKInfoMap:     rp.PKInfoMap,
+		GeneratedCols: rp.GeneratedCols,
+	}
+	if tablePlan, ok := rp.TargetTables[tableName]; ok {
+		plan.VStreamFilter.Rules = append(plan.VStreamFilter.Rules, tablePlan.SendRule)
+		plan.TargetTables[tableName] = tablePlan
+		plan.TablePlans[tablePlan.SendRule.Match] = tablePlan
+	}
+	return plan
+}
+
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
 func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
 	tpb := &tablePlanBuilder{
-		name:    sqlparser.NewTableIdent(tableName),
-		lastpk:  lastpk,
-		pkInfos: rp.PKInfoMap[tableName],
+		name:          sqlparser.NewTableIdent(tableName),
+		lastpk:        lastpk,
+		pkInfos:       rp.PKInfoMap[tableName],
+		generatedCols: rp.GeneratedCols[tableName],
 	}
 	for _, field := range fields {
 		colName := sqlparser.NewColIdent(field.Name)
@@ -101,6 +121,7 @@ func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Res
 			references: map[string]bool{
 				field.Name: true,
 			},
+			isGenerated: tpb.generatedCols[colName.Lowered()],
 		}
 		tpb.colExprs = append(tpb.colExprs, cexpr)
 	}
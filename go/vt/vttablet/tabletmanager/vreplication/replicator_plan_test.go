@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -682,7 +683,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 	}
 
 	for _, tcase := range testcases {
-		plan, err := buildReplicatorPlan(tcase.input, PrimaryKeyInfos, nil)
+		plan, err := buildReplicatorPlan(tcase.input, PrimaryKeyInfos, nil, nil)
 		gotPlan, _ := json.Marshal(plan)
 		wantPlan, _ := json.Marshal(tcase.plan)
 		if string(gotPlan) != string(wantPlan) {
@@ -696,7 +697,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 			t.Errorf("Filter err(%v): %s, want %v", tcase.input, gotErr, tcase.err)
 		}
 
-		plan, err = buildReplicatorPlan(tcase.input, PrimaryKeyInfos, copyState)
+		plan, err = buildReplicatorPlan(tcase.input, PrimaryKeyInfos, nil, copyState)
 		if err != nil {
 			continue
 		}
@@ -722,13 +723,53 @@ func TestBuildPlayerPlanNoDup(t *testing.T) {
 			Filter: "select * from t",
 		}},
 	}
-	_, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+	_, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, nil)
 	want := "more than one target for source table t"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("buildReplicatorPlan err: %v, must contain: %v", err, want)
 	}
 }
 
+func TestBuildPlayerPlanGeneratedColumns(t *testing.T) {
+	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+		"t2": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	generatedCols := map[string]map[string]bool{
+		"t1": {"c3": true},
+		"t2": {"c3": true},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, c2, c3 from t1",
+		}, {
+			Match:  "t2",
+			Filter: "",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, generatedCols, nil)
+	require.NoError(t, err)
+
+	// The generated columns are neither inserted nor updated.
+	t1 := plan.TargetTables["t1"]
+	assert.Equal(t, "insert into t1(c1,c2) values (:a_c1,:a_c2)", t1.Insert.Query)
+	assert.Equal(t, "update t1 set c2=:a_c2 where c1=:b_c1", t1.Update.Query)
+	assert.Equal(t, "delete from t1 where c1=:b_c1", t1.Delete.Query)
+
+	// The plans of the 'select *' are built from the fields.
+	t2, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t2",
+		Fields: sqltypes.MakeTestFields(
+			"c1|c2|c3",
+			"int64|varchar|int64",
+		),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "insert into t2(c1,c2) values (:a_c1,:a_c2)", t2.Insert.Query)
+	assert.Equal(t, "update t2 set c2=:a_c2 where c1=:b_c1", t2.Update.Query)
+}
+
 func TestBuildPlayerPlanExclude(t *testing.T) {
 	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
 		"t1": {&PrimaryKeyInfo{Name: "c1"}},
@@ -743,7 +784,7 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 			Filter: "",
 		}},
 	}
-	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, nil)
 	assert.NoError(t, err)
 
 	want := &TestReplicatorPlan{
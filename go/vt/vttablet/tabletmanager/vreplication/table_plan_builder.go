@@ -51,6 +51,9 @@ type tablePlanBuilder struct {
 	pkCols     []*colExpr
 	lastpk     *sqltypes.Result
 	pkInfos    []*PrimaryKeyInfo
+	// generatedCols are the generated columns of the table. MySQL
+	// computes their values: they are not inserted or updated.
+	generatedCols map[string]bool
 }
 
 // colExpr describes the processing to be performed to
@@ -68,10 +71,11 @@ type colExpr struct {
 	// references contains all the column names referenced in the expression.
 	references map[string]bool
 
-	isGrouped  bool
-	isPK       bool
-	dataType   string
-	columnType string
+	isGrouped   bool
+	isPK        bool
+	isGenerated bool
+	dataType    string
+	columnType  string
 }
 
 // operation is the opcode for the colExpr.
@@ -110,6 +114,7 @@ const (
 // original rule to the source because it may not match the same tables as the
 // target.
 // pkInfoMap specifies the list of primary key columns for each table.
+// generatedCols specifies the generated columns of each table.
 // copyState is a map of tables that have not been fully copied yet.
 // If a table is not present in copyState, then it has been fully copied. If so,
 // all replication events are applied. The table still has to match a Filter.Rule.
@@ -120,12 +125,13 @@ const (
 // The TablePlan built is a partial plan. The full plan for a table is built
 // when we receive field information from events or rows sent by the source.
 // buildExecutionPlan is the function that builds the full plan.
-func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*PrimaryKeyInfo, copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
+func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*PrimaryKeyInfo, generatedCols map[string]map[string]bool, copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
 	plan := &ReplicatorPlan{
 		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
 		TargetTables:  make(map[string]*TablePlan),
 		TablePlans:    make(map[string]*TablePlan),
 		PKInfoMap:     pkInfoMap,
+		GeneratedCols: generatedCols,
 	}
 	for tableName := range pkInfoMap {
 		lastpk, ok := copyState[tableName]
@@ -140,7 +146,7 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*Pr
 		if rule == nil {
 			continue
 		}
-		tablePlan, err := buildTablePlan(tableName, rule.Filter, pkInfoMap, lastpk)
+		tablePlan, err := buildTablePlan(tableName, rule.Filter, pkInfoMap, generatedCols[tableName], lastpk)
 		if err != nil {
 			return nil, err
 		}
@@ -179,7 +185,7 @@ func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Ru
 	return nil, nil
 }
 
-func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKeyInfo, lastpk *sqltypes.Result) (*TablePlan, error) {
+func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKeyInfo, generatedCols map[string]bool, lastpk *sqltypes.Result) (*TablePlan, error) {
 	query := filter
 	// generate equivalent select statement if filter is empty or a keyrange.
 	switch {
@@ -226,9 +232,10 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 			From:  sel.From,
 			Where: sel.Where,
 		},
-		selColumns: make(map[string]bool),
-		lastpk:     lastpk,
-		pkInfos:    pkInfoMap[tableName],
+		selColumns:    make(map[string]bool),
+		lastpk:        lastpk,
+		pkInfos:       pkInfoMap[tableName],
+		generatedCols: generatedCols,
 	}
 
 	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
@@ -354,8 +361,9 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 		}
 	}
 	cexpr := &colExpr{
-		colName:    as,
-		references: make(map[string]bool),
+		colName:     as,
+		references:  make(map[string]bool),
+		isGenerated: tpb.generatedCols[as.Lowered()],
 	}
 	if expr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok {
 		if expr.Distinct {
@@ -525,6 +533,9 @@ func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *s
 	}
 	separator := ""
 	for _, cexpr := range tpb.colExprs {
+		if cexpr.isGenerated {
+			continue
+		}
 		buf.Myprintf("%s%v", separator, cexpr.colName)
 		separator = ","
 	}
@@ -536,6 +547,9 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 	bvf.mode = bvAfter
 	separator := "("
 	for _, cexpr := range tpb.colExprs {
+		if cexpr.isGenerated {
+			continue
+		}
 		buf.Myprintf("%s", separator)
 		separator = ","
 		switch cexpr.operation {
@@ -561,6 +575,9 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 	buf.WriteString(" select ")
 	separator := ""
 	for _, cexpr := range tpb.colExprs {
+		if cexpr.isGenerated {
+			continue
+		}
 		buf.Myprintf("%s", separator)
 		separator = ", "
 		switch cexpr.operation {
@@ -591,7 +608,8 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		// a legitimate use case in the future that demands
 		// a different behavior. This rule is applied uniformly
 		// for updates and deletes also.
-		if cexpr.isGrouped || cexpr.isPK {
+		// The generated columns are computed by MySQL.
+		if cexpr.isGrouped || cexpr.isPK || cexpr.isGenerated {
 			continue
 		}
 		buf.Myprintf("%s%v=", separator, cexpr.colName)
@@ -618,7 +636,7 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 	buf.Myprintf("update %v set ", tpb.name)
 	separator := ""
 	for _, cexpr := range tpb.colExprs {
-		if cexpr.isGrouped || cexpr.isPK {
+		if cexpr.isGrouped || cexpr.isPK || cexpr.isGenerated {
 			continue
 		}
 		buf.Myprintf("%s%v=", separator, cexpr.colName)
@@ -653,7 +671,7 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 		buf.Myprintf("update %v set ", tpb.name)
 		separator := ""
 		for _, cexpr := range tpb.colExprs {
-			if cexpr.isGrouped || cexpr.isPK {
+			if cexpr.isGrouped || cexpr.isPK || cexpr.isGenerated {
 				continue
 			}
 			buf.Myprintf("%s%v=", separator, cexpr.colName)
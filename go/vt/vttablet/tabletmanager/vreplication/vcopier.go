@@ -22,6 +22,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"context"
@@ -31,6 +32,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 
@@ -39,8 +41,7 @@ import (
 )
 
 type vcopier struct {
-	vr        *vreplicator
-	tablePlan *TablePlan
+	vr *vreplicator
 }
 
 func newVCopier(vr *vreplicator) *vcopier {
@@ -56,7 +57,7 @@ func newVCopier(vr *vreplicator) *vcopier {
 func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	defer vc.vr.dbClient.Rollback()
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, nil)
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, vc.vr.generatedCols, nil)
 	if err != nil {
 		return err
 	}
@@ -99,25 +100,37 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // This goes on until all rows are copied, or a timeout. In both cases, copyNext
 // returns, and the replicator decides whether to invoke copyNext again, or to
 // go to the next phase if all the copying is done.
-// Steps 2, 3 and 4 are performed by copyTable.
+// Steps 2, 3 and 4 are performed by copyTable, or by copyTables if the tables
+// are copied concurrently.
 // copyNext also builds the copyState metadata that contains the tables and their last
 // primary key that was copied. A nil Result means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	query := fmt.Sprintf("select table_name, lastpk, pos from _vt.copy_state where vrepl_id=%d", vc.vr.id)
+	qr, err := withDDL.Exec(ctx, query, vc.vr.dbClient.ExecuteFetch)
 	if err != nil {
 		return err
 	}
-	var tableToCopy string
+	var tablesToCopy []string
 	copyState := make(map[string]*sqltypes.Result)
+	tablePositions := make(map[string]mysql.Position)
 	for _, row := range qr.Rows {
 		tableName := row[0].ToString()
-		lastpk := row[1].ToString()
-		if tableToCopy == "" {
-			tableToCopy = tableName
+		if !row[2].IsNull() {
+			pos, err := mysql.DecodePosition(row[2].ToString())
+			if err != nil {
+				return err
+			}
+			tablePositions[tableName] = pos
+			if row[1].IsNull() {
+				// The table is fully copied, but the stream
+				// has not reached the position of its snapshot yet.
+				continue
+			}
 		}
+		tablesToCopy = append(tablesToCopy, tableName)
 		copyState[tableName] = nil
-		if lastpk != "" {
+		if lastpk := row[1].ToString(); lastpk != "" {
 			var r querypb.QueryResult
 			if err := proto.UnmarshalText(lastpk, &r); err != nil {
 				return err
@@ -125,19 +138,41 @@ func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSetting
 			copyState[tableName] = sqltypes.Proto3ToResult(&r)
 		}
 	}
-	if len(copyState) == 0 {
+	if len(qr.Rows) == 0 {
 		return fmt.Errorf("unexpected: there are no tables to copy")
 	}
-	if err := vc.catchup(ctx, copyState); err != nil {
+	if err := vc.catchup(ctx, copyState, tablePositions); err != nil {
 		return err
 	}
-	return vc.copyTable(ctx, tableToCopy, copyState)
+	settings, err = binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	for tableName, pos := range tablePositions {
+		if _, ok := copyState[tableName]; ok || !settings.StartPos.AtLeast(pos) {
+			continue
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+		if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+			return err
+		}
+	}
+	switch {
+	case len(tablesToCopy) == 0:
+		return nil
+	case len(tablesToCopy) > 1 && *copyPhaseTableWorkers > 1 && !settings.StartPos.IsZero() && vc.vr.source.OnDdl == binlogdatapb.OnDDLAction_IGNORE:
+		// The first table is copied alone, to initialize the position
+		// of the stream. DDLs must not be applied by each table.
+		return vc.copyTables(ctx, tablesToCopy, copyState, tablePositions, settings.StartPos)
+	}
+	return vc.copyTable(ctx, tablesToCopy[0], copyState, tablePositions)
 }
 
 // catchup replays events to the subset of the tables that have been copied
 // until replication is caught up. In order to stop, the seconds behind master has
 // to fall below replicationLagTolerance.
-func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result) error {
+func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result, tablePositions map[string]mysql.Position) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	defer func() {
@@ -157,7 +192,9 @@ func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.R
 	// Start vreplication.
 	errch := make(chan error, 1)
 	go func() {
-		errch <- newVPlayer(vc.vr, settings, copyState, mysql.Position{}, "catchup").play(ctx)
+		vp := newVPlayer(vc.vr, settings, copyState, mysql.Position{}, "catchup")
+		vp.tablePositions = tablePositions
+		errch <- vp.play(ctx)
 	}()
 
 	// Wait for catchup.
@@ -190,7 +227,7 @@ func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.R
 // copyTable performs the synchronized copy of the next set of rows from
 // the current table being copied. Each packet received is transactionally
 // committed with the lastpk. This allows for consistent resumability.
-func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState map[string]*sqltypes.Result) error {
+func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState map[string]*sqltypes.Result, tablePositions map[string]mysql.Position) error {
 	defer vc.vr.dbClient.Rollback()
 	defer func() {
 		vc.vr.stats.PhaseTimings.Record("copy", time.Now())
@@ -199,28 +236,205 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 
 	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, nil)
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, vc.vr.generatedCols, nil)
 	if err != nil {
 		return err
 	}
 
-	initialPlan, ok := plan.TargetTables[tableName]
-	if !ok {
-		return fmt.Errorf("plan not found for table: %s, current plans are: %#v", tableName, plan.TargetTables)
+	ctx, cancel := context.WithTimeout(ctx, copyTimeout)
+	defer cancel()
+
+	tc := &tableCopier{
+		vc:        vc,
+		tableName: tableName,
+		dbClient:  vc.vr.dbClient,
+		fastForward: func(ctx context.Context, pos mysql.Position) error {
+			return vc.fastForward(ctx, copyState, tablePositions, pos)
+		},
+	}
+	err = tc.copy(ctx, plan, copyState[tableName])
+	// If there was a timeout, return without an error.
+	select {
+	case <-ctx.Done():
+		log.Infof("Copy of %v stopped at lastpk: %v", tableName, tc.bv)
+		return nil
+	default:
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("Copy of %v finished at lastpk: %v", tableName, tc.bv)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// copyTables copies tables concurrently, at most
+// -vreplication_copy_phase_table_workers at a time, until they are all
+// copied or copyTimeout expires. pos is the position of the stream, which
+// doesn't change until the next catchup.
+// The rows of each table are streamed from their own snapshot, so the
+// target can't be fast-forwarded to a single position like copyTable does.
+// Instead, the rows already copied of each table are fast-forwarded on
+// their own, and the position of the snapshot is saved in copy_state along
+// with lastpk. The next catchup skips the events of the table that are in
+// its snapshot.
+func (vc *vcopier) copyTables(ctx context.Context, tables []string, copyState map[string]*sqltypes.Result, tablePositions map[string]mysql.Position, pos mysql.Position) error {
+	defer func() {
+		vc.vr.stats.PhaseTimings.Record("copy", time.Now())
+		vc.vr.stats.CopyLoopCount.Add(1)
+	}()
+
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, vc.vr.generatedCols, nil)
+	if err != nil {
+		return err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, copyTimeout)
 	defer cancel()
 
+	next := make(chan string, len(tables))
+	for _, tableName := range tables {
+		next <- tableName
+	}
+	close(next)
+	workers := *copyPhaseTableWorkers
+	if workers > len(tables) {
+		workers = len(tables)
+	}
+	var wg sync.WaitGroup
+	var rec concurrency.FirstErrorRecorder
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tableName := range next {
+				if ctx.Err() != nil {
+					return
+				}
+				// The rows already copied are at the position of
+				// the snapshot they were copied from, if the stream
+				// has not reached it yet.
+				startPos := pos
+				if tablePos, ok := tablePositions[tableName]; ok && !pos.AtLeast(tablePos) {
+					startPos = tablePos
+				}
+				if err := vc.copyTableConcurrently(ctx, plan, tableName, copyState[tableName], startPos, pos); err != nil {
+					rec.RecordError(err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// copyTableConcurrently copies the rows of a table with its own connection,
+// while other tables are being copied. The rows already copied are at
+// startPos, and pos is the position of the stream.
+func (vc *vcopier) copyTableConcurrently(ctx context.Context, plan *ReplicatorPlan, tableName string, lastpk *sqltypes.Result, startPos, pos mysql.Position) error {
+	log.Infof("Copying table %s, lastpk: %v", tableName, lastpk)
+
+	dbClient, err := newCopyWorkerClient(vc.vr)
+	if err != nil {
+		return err
+	}
+	defer dbClient.Close()
+	defer dbClient.Rollback()
+
+	tc := &tableCopier{
+		vc:        vc,
+		tableName: tableName,
+		dbClient:  dbClient,
+		savePos:   true,
+		fastForward: func(ctx context.Context, snapshotPos mysql.Position) error {
+			if lastpk == nil {
+				// No rows were copied yet.
+				return nil
+			}
+			vp := newVPlayer(vc.vr, binlogplayer.VRSettings{StartPos: startPos}, map[string]*sqltypes.Result{tableName: lastpk}, snapshotPos, "fastforward")
+			vp.copyTable = tableName
+			vp.dbClient = dbClient
+			return vp.play(ctx)
+		},
+	}
+	err = tc.copy(ctx, plan, lastpk)
+	select {
+	case <-ctx.Done():
+		log.Infof("Copy of %v stopped at lastpk: %v", tableName, tc.bv)
+		return nil
+	default:
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("Copy of %v finished at lastpk: %v", tableName, tc.bv)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	if pos.AtLeast(tc.pos) {
+		buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+	} else {
+		// The row is kept until the stream reaches the position of the
+		// snapshot, so that the catchup skips the events it contains.
+		buf.Myprintf("update _vt.copy_state set lastpk=null, pos=%s where vrepl_id=%s and table_name=%s", encodeString(mysql.EncodePosition(tc.pos)), strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+	}
+	if _, err := dbClient.Execute(buf.String()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// tableCopier copies the rows of a table from a snapshot of the source.
+type tableCopier struct {
+	vc        *vcopier
+	tableName string
+	dbClient  *vdbClient
+	// fastForward brings the rows of the table that are already copied
+	// to the position of the snapshot.
+	fastForward func(ctx context.Context, pos mysql.Position) error
+	// savePos is set if the position of the snapshot is saved in
+	// copy_state along with lastpk.
+	savePos bool
+
+	tablePlan       *TablePlan
+	pkfields        []*querypb.Field
+	updateCopyState *sqlparser.ParsedQuery
+	// pos is the position of the snapshot.
+	pos mysql.Position
+	// bv contains the last lastpk saved in copy_state.
+	bv map[string]*querypb.BindVariable
+}
+
+// copy copies the rows that follow lastpk, until all of them are copied
+// or ctx is done. Each packet received is transactionally committed with
+// the lastpk.
+func (tc *tableCopier) copy(ctx context.Context, plan *ReplicatorPlan, lastpk *sqltypes.Result) error {
+	vr := tc.vc.vr
+	initialPlan, ok := plan.TargetTables[tc.tableName]
+	if !ok {
+		return fmt.Errorf("plan not found for table: %s, current plans are: %#v", tc.tableName, plan.TargetTables)
+	}
+
 	var lastpkpb *querypb.QueryResult
-	if lastpkqr := copyState[tableName]; lastpkqr != nil {
-		lastpkpb = sqltypes.ResultToProto3(lastpkqr)
+	if lastpk != nil {
+		lastpkpb = sqltypes.ResultToProto3(lastpk)
+	}
+
+	// With more than one copy worker, the rows of each packet are
+	// inserted concurrently on separate connections.
+	var workers *copyWorkers
+	if *copyPhaseWorkers > 1 {
+		var err error
+		if workers, err = newCopyWorkers(ctx, vr, *copyPhaseWorkers); err != nil {
+			return err
+		}
 	}
 
-	var pkfields []*querypb.Field
-	var updateCopyState *sqlparser.ParsedQuery
-	var bv map[string]*querypb.BindVariable
-	err = vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
+	err := vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
 		for {
 			select {
 			case <-ctx.Done():
@@ -228,114 +442,145 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			default:
 			}
 			// verify throttler is happy, otherwise keep looping
-			if vc.vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+			if vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
 				break
 			}
 		}
 
-		if vc.tablePlan == nil {
+		if tc.tablePlan == nil {
 			if len(rows.Fields) == 0 {
 				return fmt.Errorf("expecting field event first, got: %v", rows)
 			}
-			if err := vc.fastForward(ctx, copyState, rows.Gtid); err != nil {
+			pos, err := mysql.DecodePosition(rows.Gtid)
+			if err != nil {
+				return err
+			}
+			if err := tc.fastForward(ctx, pos); err != nil {
 				return err
 			}
+			// The rows can't be copied if the fast-forward was interrupted.
+			if ctx.Err() != nil {
+				return io.EOF
+			}
+			tc.pos = pos
 			fieldEvent := &binlogdatapb.FieldEvent{
 				TableName: initialPlan.SendRule.Match,
 				Fields:    rows.Fields,
 			}
-			vc.tablePlan, err = plan.buildExecutionPlan(fieldEvent)
+			tc.tablePlan, err = plan.buildExecutionPlan(fieldEvent)
 			if err != nil {
 				return err
 			}
-			pkfields = rows.Pkfields
+			tc.pkfields = rows.Pkfields
 			buf := sqlparser.NewTrackedBuffer(nil)
-			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
-			updateCopyState = buf.ParsedQuery()
+			if tc.savePos {
+				buf.Myprintf("update _vt.copy_state set lastpk=%a, pos=%a where vrepl_id=%s and table_name=%s", ":lastpk", ":pos", strconv.Itoa(int(vr.id)), encodeString(tc.tableName))
+			} else {
+				buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vr.id)), encodeString(tc.tableName))
+			}
+			tc.updateCopyState = buf.ParsedQuery()
 		}
 		if len(rows.Rows) == 0 {
 			return nil
 		}
 
+		if err := vr.vre.waitCopyBandwidth(ctx, rowsSize(rows.Rows)); err != nil {
+			return io.EOF
+		}
+		if workers != nil {
+			// The streamer may reuse rows once we return.
+			packet := &binlogdatapb.VStreamRowsResponse{
+				Rows:   rows.Rows,
+				Lastpk: rows.Lastpk,
+			}
+			return workers.submit(func(dbClient *vdbClient) error {
+				return tc.insertRows(ctx, dbClient, packet)
+			}, func(dbClient *vdbClient) error {
+				return tc.updateLastPK(dbClient, packet.Lastpk)
+			})
+		}
+
 		// The number of rows we receive depends on the packet size set
 		// for the row streamer. Since the packet size is roughly equivalent
 		// to data size, this should map to a uniform amount of pages affected
 		// per statement. A packet size of 30K will roughly translate to 8
 		// mysql pages of 4K each.
-		if err := vc.vr.dbClient.Begin(); err != nil {
+		if err := tc.dbClient.Begin(); err != nil {
 			return err
 		}
-		_, err = vc.tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
-			start := time.Now()
-			qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
-			if err != nil {
-				return nil, err
-			}
-			vc.vr.stats.QueryTimings.Record("copy", start)
-
-			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
-			vc.vr.stats.QueryCount.Add("copy", 1)
-
-			return qr, err
-		})
-		if err != nil {
+		if err := tc.insertRows(ctx, tc.dbClient, rows); err != nil {
 			return err
 		}
-
-		var buf bytes.Buffer
-		err = proto.CompactText(&buf, &querypb.QueryResult{
-			Fields: pkfields,
-			Rows:   []*querypb.Row{rows.Lastpk},
-		})
-		if err != nil {
+		if err := tc.updateLastPK(tc.dbClient, rows.Lastpk); err != nil {
 			return err
 		}
-		bv = map[string]*querypb.BindVariable{
-			"lastpk": {
-				Type:  sqltypes.VarBinary,
-				Value: buf.Bytes(),
-			},
+		return tc.dbClient.Commit()
+	})
+	if workers != nil {
+		// Wait for the rows already received to be copied, so
+		// that copy_state is final before it is inspected.
+		if werr := workers.wait(); err == nil {
+			err = werr
 		}
-		updateState, err := updateCopyState.GenerateQuery(bv, nil)
+	}
+	return err
+}
+
+// insertRows inserts the rows of a packet using dbClient, which must be
+// in a transaction.
+func (tc *tableCopier) insertRows(ctx context.Context, dbClient *vdbClient, rows *binlogdatapb.VStreamRowsResponse) error {
+	stats := tc.vc.vr.stats
+	_, err := tc.tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
+		start := time.Now()
+		qr, err := dbClient.ExecuteWithRetry(ctx, sql)
 		if err != nil {
-			return err
-		}
-		if _, err := vc.vr.dbClient.Execute(updateState); err != nil {
-			return err
+			return nil, err
 		}
+		stats.QueryTimings.Record("copy", start)
 
-		if err := vc.vr.dbClient.Commit(); err != nil {
-			return err
-		}
-		return nil
+		stats.CopyRowCount.Add(int64(qr.RowsAffected))
+		stats.QueryCount.Add("copy", 1)
+
+		return qr, err
 	})
-	// If there was a timeout, return without an error.
-	select {
-	case <-ctx.Done():
-		log.Infof("Copy of %v stopped at lastpk: %v", tableName, bv)
-		return nil
-	default:
+	return err
+}
+
+// updateLastPK saves lastpk as the copy checkpoint of the table using
+// dbClient.
+func (tc *tableCopier) updateLastPK(dbClient *vdbClient, lastpk *querypb.Row) error {
+	var buf bytes.Buffer
+	err := proto.CompactText(&buf, &querypb.QueryResult{
+		Fields: tc.pkfields,
+		Rows:   []*querypb.Row{lastpk},
+	})
+	if err != nil {
+		return err
+	}
+	bv := map[string]*querypb.BindVariable{
+		"lastpk": {
+			Type:  sqltypes.VarBinary,
+			Value: buf.Bytes(),
+		},
 	}
+	if tc.savePos {
+		bv["pos"] = sqltypes.StringBindVariable(mysql.EncodePosition(tc.pos))
+	}
+	updateState, err := tc.updateCopyState.GenerateQuery(bv, nil)
 	if err != nil {
 		return err
 	}
-	log.Infof("Copy of %v finished at lastpk: %v", tableName, bv)
-	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
-	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+	if _, err := dbClient.Execute(updateState); err != nil {
 		return err
 	}
+	tc.bv = bv
 	return nil
 }
 
-func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
+func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, tablePositions map[string]mysql.Position, pos mysql.Position) error {
 	defer func() {
 		vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
 	}()
-	pos, err := mysql.DecodePosition(gtid)
-	if err != nil {
-		return err
-	}
 	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
 	if err != nil {
 		return err
@@ -345,5 +590,7 @@ func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltyp
 		_, err := vc.vr.dbClient.Execute(update)
 		return err
 	}
-	return newVPlayer(vc.vr, settings, copyState, pos, "fastforward").play(ctx)
+	vp := newVPlayer(vc.vr, settings, copyState, pos, "fastforward")
+	vp.tablePositions = tablePositions
+	return vp.play(ctx)
 }
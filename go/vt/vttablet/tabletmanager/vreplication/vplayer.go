@@ -42,6 +42,15 @@ type vplayer struct {
 	stopPos   mysql.Position
 	saveStop  bool
 	copyState map[string]*sqltypes.Result
+	// tablePositions contains the tables that were copied from a snapshot
+	// taken after the current position. The events of a table are skipped
+	// until the position of its snapshot is reached.
+	tablePositions map[string]mysql.Position
+	// copyTable, if set, is the only table replicated by the player, and
+	// its position is saved in copy_state instead of _vt.vreplication.
+	// It's used to fast-forward the tables copied concurrently.
+	copyTable string
+	dbClient  *vdbClient
 
 	replicatorPlan *ReplicatorPlan
 	tablePlans     map[string]*TablePlan
@@ -86,6 +95,7 @@ func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map
 		stopPos:       settings.StopPos,
 		saveStop:      saveStop,
 		copyState:     copyState,
+		dbClient:      vr.dbClient,
 		timeLastSaved: time.Now(),
 		tablePlans:    make(map[string]*TablePlan),
 		phase:         phase,
@@ -102,15 +112,18 @@ func (vp *vplayer) play(ctx context.Context) error {
 		return nil
 	}
 
-	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.pkInfoMap, vp.copyState)
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.pkInfoMap, vp.vr.generatedCols, vp.copyState)
 	if err != nil {
 		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
 		return err
 	}
+	if vp.copyTable != "" {
+		plan = plan.forTable(vp.copyTable)
+	}
 	vp.replicatorPlan = plan
 
 	// We can't run in statement mode if there are filters defined.
-	vp.canAcceptStmtEvents = true
+	vp.canAcceptStmtEvents = vp.copyTable == ""
 	for _, rule := range vp.vr.source.Filter.Rules {
 		if rule.Filter != "" || rule.Match != "/.*" {
 			vp.canAcceptStmtEvents = false
@@ -196,7 +209,7 @@ func (vp *vplayer) applyStmtEvent(ctx context.Context, event *binlogdatapb.VEven
 	}
 	if event.Type == binlogdatapb.VEventType_SAVEPOINT || vp.canAcceptStmtEvents {
 		start := time.Now()
-		_, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+		_, err := vp.dbClient.ExecuteWithRetry(ctx, sql)
 		vp.vr.stats.QueryTimings.Record(vp.phase, start)
 		vp.vr.stats.QueryCount.Add(vp.phase, 1)
 		return err
@@ -209,11 +222,17 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
+	// The current position is the one before this transaction. If the
+	// snapshot of the table is past it, the snapshot already contains
+	// this transaction.
+	if pos, ok := vp.tablePositions[tplan.TargetName]; ok && !vp.pos.AtLeast(pos) {
+		return nil
+	}
 	for _, change := range rowEvent.RowChanges {
 		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
 			stats := NewVrLogStats("ROWCHANGE")
 			start := time.Now()
-			qr, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+			qr, err := vp.dbClient.ExecuteWithRetry(ctx, sql)
 			vp.vr.stats.QueryCount.Add(vp.phase, 1)
 			vp.vr.stats.QueryTimings.Record(vp.phase, start)
 			stats.Send(sql)
@@ -228,12 +247,17 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 
 func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 	update := binlogplayer.GenerateUpdatePos(vp.vr.id, vp.pos, time.Now().Unix(), ts)
-	if _, err := vp.vr.dbClient.Execute(update); err != nil {
+	if vp.copyTable != "" {
+		update = fmt.Sprintf("update _vt.copy_state set pos=%s where vrepl_id=%d and table_name=%s", encodeString(mysql.EncodePosition(vp.pos)), vp.vr.id, encodeString(vp.copyTable))
+	}
+	if _, err := vp.dbClient.Execute(update); err != nil {
 		return false, fmt.Errorf("error %v updating position", err)
 	}
 	vp.unsavedEvent = nil
 	vp.timeLastSaved = time.Now()
-	vp.vr.stats.SetLastPosition(vp.pos)
+	if vp.copyTable == "" {
+		vp.vr.stats.SetLastPosition(vp.pos)
+	}
 	posReached = !vp.stopPos.IsZero() && vp.pos.AtLeast(vp.stopPos)
 	if posReached {
 		log.Infof("Stopped at position: %v", vp.stopPos)
@@ -247,13 +271,17 @@ func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 }
 
 func (vp *vplayer) recordHeartbeat() (err error) {
+	if vp.copyTable != "" {
+		// The stream itself is not replicating.
+		return nil
+	}
 	tm := time.Now().Unix()
 	vp.vr.stats.RecordHeartbeat(tm)
 	update, err := binlogplayer.GenerateUpdateTime(vp.vr.id, tm)
 	if err != nil {
 		return err
 	}
-	if _, err := vp.vr.dbClient.Execute(update); err != nil {
+	if _, err := vp.dbClient.Execute(update); err != nil {
 		return fmt.Errorf("error %v updating time", err)
 	}
 	return nil
@@ -310,7 +338,7 @@ func (vp *vplayer) recordHeartbeat() (err error) {
 // TODO(sougou): we can look at recognizing self-generated events and find a better
 // way to handle them.
 func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
-	defer vp.vr.dbClient.Rollback()
+	defer vp.dbClient.Rollback()
 
 	// If we're not running, set SecondsBehindMaster to be very high.
 	// TODO(sougou): if we also stored the time of the last event, we
@@ -427,12 +455,12 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		// No-op: begin is called as needed.
 	case binlogdatapb.VEventType_COMMIT:
 		if mustSave {
-			if err := vp.vr.dbClient.Begin(); err != nil {
+			if err := vp.dbClient.Begin(); err != nil {
 				return err
 			}
 		}
 
-		if !vp.vr.dbClient.InTransaction {
+		if !vp.dbClient.InTransaction {
 			// We're skipping an empty transaction. We may have to save the position on inactivity.
 			vp.unsavedEvent = event
 			return nil
@@ -441,14 +469,14 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err != nil {
 			return err
 		}
-		if err := vp.vr.dbClient.Commit(); err != nil {
+		if err := vp.dbClient.Commit(); err != nil {
 			return err
 		}
 		if posReached {
 			return io.EOF
 		}
 	case binlogdatapb.VEventType_FIELD:
-		if err := vp.vr.dbClient.Begin(); err != nil {
+		if err := vp.dbClient.Begin(); err != nil {
 			return err
 		}
 		tplan, err := vp.replicatorPlan.buildExecutionPlan(event.FieldEvent)
@@ -468,7 +496,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		// If the event is for one of the AWS RDS "special" tables, we skip
 		if !strings.Contains(sql, " mysql.rds_") {
 			// This is a player using statement based replication
-			if err := vp.vr.dbClient.Begin(); err != nil {
+			if err := vp.dbClient.Begin(); err != nil {
 				return err
 			}
 			if err := vp.applyStmtEvent(ctx, event); err != nil {
@@ -478,7 +506,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		}
 	case binlogdatapb.VEventType_ROW:
 		// This player is configured for row based replication
-		if err := vp.vr.dbClient.Begin(); err != nil {
+		if err := vp.dbClient.Begin(); err != nil {
 			return err
 		}
 		if err := vp.applyRowEvent(ctx, event.RowEvent); err != nil {
@@ -487,7 +515,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		//Row event is logged AFTER RowChanges are applied so as to calculate the total elapsed time for the Row event
 		stats.Send(fmt.Sprintf("%v", event.RowEvent))
 	case binlogdatapb.VEventType_OTHER:
-		if vp.vr.dbClient.InTransaction {
+		if vp.dbClient.InTransaction {
 			// Unreachable
 			log.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 			return fmt.Errorf("internal error: vplayer is in a transaction on event: %v", event)
@@ -501,7 +529,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			return io.EOF
 		}
 	case binlogdatapb.VEventType_DDL:
-		if vp.vr.dbClient.InTransaction {
+		if vp.dbClient.InTransaction {
 			// Unreachable
 			log.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 			return fmt.Errorf("internal error: vplayer is in a transaction on event: %v", event)
@@ -517,7 +545,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_STOP:
-			if err := vp.vr.dbClient.Begin(); err != nil {
+			if err := vp.dbClient.Begin(); err != nil {
 				return err
 			}
 			if _, err := vp.updatePos(event.Timestamp); err != nil {
@@ -526,7 +554,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			if err := vp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at DDL %s", event.Statement)); err != nil {
 				return err
 			}
-			if err := vp.vr.dbClient.Commit(); err != nil {
+			if err := vp.dbClient.Commit(); err != nil {
 				return err
 			}
 			return io.EOF
@@ -535,7 +563,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			// So, we apply the DDL first, and then save the position.
 			// Manual intervention may be needed if there is a partial
 			// failure here.
-			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
+			if _, err := vp.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
 				return err
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
@@ -547,7 +575,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_EXEC_IGNORE:
-			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
+			if _, err := vp.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
 				log.Infof("Ignoring error: %v for DDL: %s", err, event.Statement)
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
@@ -560,11 +588,15 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			}
 		}
 	case binlogdatapb.VEventType_JOURNAL:
-		if vp.vr.dbClient.InTransaction {
+		if vp.dbClient.InTransaction {
 			// Unreachable
 			log.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 			return fmt.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 		}
+		if vp.copyTable != "" {
+			// The journal is handled by the catchup of the next copy cycle.
+			return fmt.Errorf("journal event while fast-forwarding table %s", vp.copyTable)
+		}
 		// Ensure that we don't have a partial set of table matches in the journal.
 		switch event.Journal.MigrationType {
 		case binlogdatapb.MigrationType_SHARDS:
@@ -607,7 +639,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		stats.Send(fmt.Sprintf("%v", event.Journal))
 		return io.EOF
 	case binlogdatapb.VEventType_HEARTBEAT:
-		if !vp.vr.dbClient.InTransaction {
+		if !vp.dbClient.InTransaction {
 			err := vp.recordHeartbeat()
 			if err != nil {
 				return err
@@ -62,6 +62,9 @@ type vreplicator struct {
 	// mysqld is used to fetch the local schema.
 	mysqld    mysqlctl.MysqlDaemon
 	pkInfoMap map[string][]*PrimaryKeyInfo
+	// generatedCols are the generated columns of each table,
+	// which MySQL computes and which can't be written to.
+	generatedCols map[string]map[string]bool
 
 	originalFKCheckSetting int64
 }
@@ -105,7 +108,8 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 // gets us out of this phase.
 // 2. Copy: If the copy_state table has rows, then we are in this phase. During this
 // phase, we repeatedly invoke copyNext until all the tables are copied. After each
-// table is successfully copied, it's removed from the copy_state table. We exit this
+// table is successfully copied, it's removed from the copy_state table, once the
+// stream has reached the position of the snapshot it was copied from. We exit this
 // phase when there are no rows left in copy_state.
 // 3. Replicate: In this phase, we replicate binlog events indefinitely, unless
 // a stop position was requested. This phase differs from the Init phase because
@@ -131,11 +135,12 @@ func (vr *vreplicator) Replicate(ctx context.Context) error {
 }
 
 func (vr *vreplicator) replicate(ctx context.Context) error {
-	pkInfo, err := vr.buildPkInfoMap(ctx)
+	pkInfo, generatedCols, err := vr.buildPkInfoMap(ctx)
 	if err != nil {
 		return err
 	}
 	vr.pkInfoMap = pkInfo
+	vr.generatedCols = generatedCols
 	if err := vr.getSettingFKCheck(); err != nil {
 		return err
 	}
@@ -201,22 +206,35 @@ type PrimaryKeyInfo struct {
 	ColumnType string
 }
 
-func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*PrimaryKeyInfo, error) {
+// buildPkInfoMap returns the primary key info of the tables, and their
+// generated columns.
+func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*PrimaryKeyInfo, map[string]map[string]bool, error) {
 	schema, err := vr.mysqld.GetSchema(ctx, vr.dbClient.DBName(), []string{"/.*/"}, nil, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	queryTemplate := "select character_set_name, collation_name, column_name, data_type, column_type from information_schema.columns where table_schema=%s and table_name=%s;"
+	queryTemplate := "select character_set_name, collation_name, column_name, data_type, column_type, extra from information_schema.columns where table_schema=%s and table_name=%s;"
 	pkInfoMap := make(map[string][]*PrimaryKeyInfo)
+	generatedCols := make(map[string]map[string]bool)
 	for _, td := range schema.TableDefinitions {
 
 		query := fmt.Sprintf(queryTemplate, encodeString(vr.dbClient.DBName()), encodeString(td.Name))
 		qr, err := vr.mysqld.FetchSuperQuery(ctx, query)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if len(qr.Rows) == 0 {
-			return nil, fmt.Errorf("no data returned from information_schema.columns")
+			return nil, nil, fmt.Errorf("no data returned from information_schema.columns")
+		}
+		for _, row := range qr.Rows {
+			// The extra of MySQL 8.0 default expressions, DEFAULT_GENERATED,
+			// doesn't match.
+			if extra := strings.ToUpper(row[5].ToString()); strings.Contains(extra, "VIRTUAL GENERATED") || strings.Contains(extra, "STORED GENERATED") {
+				if generatedCols[td.Name] == nil {
+					generatedCols[td.Name] = make(map[string]bool)
+				}
+				generatedCols[td.Name][strings.ToLower(row[2].ToString())] = true
+			}
 		}
 
 		var pks []string
@@ -253,7 +271,7 @@ func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*Primar
 				}
 			}
 			if dataType == "" || columnType == "" {
-				return nil, fmt.Errorf("no dataType/columnType found in information_schema.columns for table %s, column %s", td.Name, pk)
+				return nil, nil, fmt.Errorf("no dataType/columnType found in information_schema.columns for table %s, column %s", td.Name, pk)
 			}
 			pkInfos = append(pkInfos, &PrimaryKeyInfo{
 				Name:       pk,
@@ -265,7 +283,7 @@ func (vr *vreplicator) buildPkInfoMap(ctx context.Context) (map[string][]*Primar
 		}
 		pkInfoMap[td.Name] = pkInfos
 	}
-	return pkInfoMap, nil
+	return pkInfoMap, generatedCols, nil
 }
 
 func (vr *vreplicator) readSettings(ctx context.Context) (settings binlogplayer.VRSettings, numTablesToCopy int64, err error) {
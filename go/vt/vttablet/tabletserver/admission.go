@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// cpuSampleInterval is how often the CPU usage is measured.
+const cpuSampleInterval = time.Second
+
+// procStatFile is where the CPU times of the host are read. Tests
+// override it.
+var procStatFile = "/proc/stat"
+
+// admissionController admits the requests that start using a connection
+// by the priority class of their effective caller. Under pressure, i.e.
+// when the connection pool of a request or the CPUs are mostly in use, it
+// rejects the batch requests first, then the normal ones, so that the
+// capacity left stays for the critical requests, which are always
+// admitted. It also times the requests of every class.
+type admissionController struct {
+	enabled    bool
+	thresholds map[vtrpcpb.Priority]float64
+	// cpuTimes returns the CPU time used so far and the one available
+	// in the meantime, in the same unit. It is nil if the CPUs are not
+	// measured.
+	cpuTimes func() (used, total float64, err error)
+
+	// mu protects the following members
+	mu         sync.Mutex
+	cpuSampled time.Time
+	cpuUsed    float64
+	cpuTotal   float64
+	cpuUsage   float64
+
+	timings    *servenv.TimingsWrapper
+	rejections *stats.CountersWithSingleLabel
+}
+
+func newAdmissionController(tsv *TabletServer) *admissionController {
+	config := tsv.config.Admission
+	return &admissionController{
+		enabled: config.Enable,
+		thresholds: map[vtrpcpb.Priority]float64{
+			vtrpcpb.Priority_BATCH:  config.BatchThreshold,
+			vtrpcpb.Priority_NORMAL: config.NormalThreshold,
+		},
+		cpuTimes:   cpuTimesFunc(config.CPUSource),
+		timings:    tsv.exporter.NewTimings("PriorityTimings", "Requests timings by priority class", "Priority"),
+		rejections: tsv.exporter.NewCountersWithSingleLabel("PriorityRejections", "Requests rejected by the admission control, by priority class", "Priority"),
+	}
+}
+
+// admit admits or rejects the request of ctx, given the utilization of
+// the connection pool it needs. If it is admitted, done must be called
+// when it completes.
+func (ac *admissionController) admit(ctx context.Context, utilization func() float64) (done func(), err error) {
+	priority := callerid.GetPriority(callerid.EffectiveCallerIDFromContext(ctx))
+	class := strings.ToLower(priority.String())
+	if threshold, ok := ac.thresholds[priority]; ac.enabled && ok {
+		if pressure := math.Max(utilization(), ac.cpuPressure()); pressure >= threshold {
+			ac.rejections.Add(class, 1)
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.TooManyConnections, "%s request rejected: the tablet is at %.2f of its capacity, over the %.2f threshold", class, pressure, threshold)
+		}
+	}
+	start := time.Now()
+	return func() { ac.timings.Record(class, start) }, nil
+}
+
+// cpuPressure returns the fraction of the CPU time used between the
+// previous sample and the latest one. It is measured at most every
+// cpuSampleInterval.
+func (ac *admissionController) cpuPressure() float64 {
+	if ac.cpuTimes == nil {
+		return 0
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	now := time.Now()
+	if now.Sub(ac.cpuSampled) < cpuSampleInterval {
+		return ac.cpuUsage
+	}
+	ac.cpuSampled = now
+	used, total, err := ac.cpuTimes()
+	if err != nil {
+		log.Warningf("Failed to measure the CPU usage for the admission control: %v", err)
+		return ac.cpuUsage
+	}
+	if ac.cpuTotal != 0 && total > ac.cpuTotal {
+		ac.cpuUsage = (used - ac.cpuUsed) / (total - ac.cpuTotal)
+	}
+	ac.cpuUsed, ac.cpuTotal = used, total
+	return ac.cpuUsage
+}
+
+// cpuTimesFunc returns the cpuTimes of an admissionController that
+// measures the CPUs with source. vttablet can't read the CPU usage of
+// mysqld, which may run on another host, so the sources are proxies for
+// it.
+func cpuTimesFunc(source string) func() (used, total float64, err error) {
+	switch source {
+	case tabletenv.AdmissionCPUHost:
+		return hostCPUTimes
+	case tabletenv.AdmissionCPUVttablet:
+		return processCPUTimes
+	}
+	return nil
+}
+
+// hostCPUTimes returns the busy and total times of the CPUs of the host,
+// in clock ticks, from the cpu line of procStatFile. The idle and iowait
+// times count as not busy.
+func hostCPUTimes() (used, total float64, err error) {
+	file, err := os.Open(procStatFile)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "cpu" {
+			continue
+		}
+		// user nice system idle iowait irq softirq steal: the guest times
+		// that follow are already counted in user and nice.
+		if len(fields) < 9 {
+			return 0, 0, fmt.Errorf("%s: unexpected cpu line: %s", procStatFile, scanner.Text())
+		}
+		var idle float64
+		for i, field := range fields[1:9] {
+			ticks, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("%s: %v", procStatFile, err)
+			}
+			total += float64(ticks)
+			if i == 3 || i == 4 {
+				idle += float64(ticks)
+			}
+		}
+		return total - idle, total, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+	return 0, 0, fmt.Errorf("%s: no cpu line", procStatFile)
+}
+
+// processCPUTimes returns the CPU time used by vttablet, and the time the
+// CPUs the Go runtime may use, as set by GOMAXPROCS, were available, in
+// seconds since the epoch.
+func processCPUTimes() (used, total float64, err error) {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0, 0, err
+	}
+	used = float64(usage.Utime.Nano()+usage.Stime.Nano()) / float64(time.Second)
+	total = float64(time.Now().UnixNano()) / float64(time.Second) * float64(runtime.GOMAXPROCS(0))
+	return used, total, nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"io/ioutil"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func priorityContext(priority vtrpcpb.Priority) context.Context {
+	return callerid.NewContext(context.Background(), &vtrpcpb.CallerID{Principal: "user", Priority: priority}, nil)
+}
+
+func TestAdmissionController(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.Admission.Enable = true
+	tsv := NewTabletServer("AdmissionControllerTest", config, nil, topodatapb.TabletAlias{})
+	ac := tsv.admission
+	cpuUsed, cpuTotal := 0.0, 1.0
+	ac.cpuTimes = func() (float64, float64, error) { return cpuUsed, cpuTotal, nil }
+
+	admit := func(priority vtrpcpb.Priority, utilization float64) error {
+		done, err := ac.admit(priorityContext(priority), func() float64 { return utilization })
+		if err == nil {
+			done()
+		}
+		return err
+	}
+
+	// Under the thresholds, everything is admitted.
+	for _, priority := range []vtrpcpb.Priority{vtrpcpb.Priority_CRITICAL, vtrpcpb.Priority_NORMAL, vtrpcpb.Priority_BATCH} {
+		assert.NoError(t, admit(priority, 0.5))
+	}
+	// The requests without priority are normal.
+	_, err := ac.admit(context.Background(), func() float64 { return 0.8 })
+	require.NoError(t, err)
+	_, err = ac.admit(context.Background(), func() float64 { return 1 })
+	require.Error(t, err)
+
+	// Over the batch threshold, the batch requests are rejected.
+	err = admit(vtrpcpb.Priority_BATCH, 0.8)
+	assert.EqualError(t, err, "batch request rejected: the tablet is at 0.80 of its capacity, over the 0.70 threshold")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Equal(t, vterrors.TooManyConnections, vterrors.ErrState(err))
+	assert.NoError(t, admit(vtrpcpb.Priority_NORMAL, 0.8))
+
+	// Over the normal threshold, only the critical requests are admitted.
+	assert.Error(t, admit(vtrpcpb.Priority_BATCH, 1))
+	assert.Error(t, admit(vtrpcpb.Priority_NORMAL, 1))
+	assert.NoError(t, admit(vtrpcpb.Priority_CRITICAL, 1))
+
+	assert.Equal(t, map[string]int64{"batch": 2, "normal": 2}, ac.rejections.Counts())
+	assert.Equal(t, map[string]int64{
+		"All":                              5,
+		"AdmissionControllerTest.critical": 2,
+		"AdmissionControllerTest.normal":   2,
+		"AdmissionControllerTest.batch":    1,
+	}, ac.timings.Counts())
+
+	// The CPU usage counts as pressure too.
+	ac.cpuSampled = time.Now().Add(-cpuSampleInterval)
+	cpuUsed, cpuTotal = 1, 2
+	assert.Error(t, admit(vtrpcpb.Priority_NORMAL, 0))
+	assert.NoError(t, admit(vtrpcpb.Priority_CRITICAL, 0))
+
+	// Disabled, nothing is rejected.
+	ac.enabled = false
+	assert.NoError(t, admit(vtrpcpb.Priority_BATCH, 1))
+}
+
+func TestHostCPUTimes(t *testing.T) {
+	defer func(file string) { procStatFile = file }(procStatFile)
+	procStatFile = path.Join(t.TempDir(), "stat")
+	stat := `cpu  100 10 50 800 20 5 5 10 30 0
+cpu0 50 5 25 400 10 3 2 5 15 0
+intr 12345
+`
+	require.NoError(t, ioutil.WriteFile(procStatFile, []byte(stat), 0644))
+	used, total, err := hostCPUTimes()
+	require.NoError(t, err)
+	assert.Equal(t, 180.0, used)
+	assert.Equal(t, 1000.0, total)
+
+	require.NoError(t, ioutil.WriteFile(procStatFile, []byte("intr 12345\n"), 0644))
+	_, _, err = hostCPUTimes()
+	assert.EqualError(t, err, procStatFile+": no cpu line")
+
+	assert.Nil(t, cpuTimesFunc(tabletenv.AdmissionCPUNone))
+}
+
+func TestAdmissionExecute(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.Admission.Enable = true
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+	// Every batch request is over the threshold.
+	tsv.admission.thresholds[vtrpcpb.Priority_BATCH] = 0
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.Execute(priorityContext(vtrpcpb.Priority_NORMAL), &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+
+	_, err = tsv.Execute(priorityContext(vtrpcpb.Priority_BATCH), &target, executeSQL, nil, 0, 0, nil)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// The requests of the open transactions are not rejected.
+	ctx := priorityContext(vtrpcpb.Priority_BATCH)
+	transactionID, _, err := tsv.Begin(priorityContext(vtrpcpb.Priority_CRITICAL), &target, nil)
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, transactionID, 0, nil)
+	require.NoError(t, err)
+	_, err = tsv.Rollback(ctx, &target, transactionID)
+	require.NoError(t, err)
+}
+
+func TestAdmissionPoolByPlan(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.Admission.Enable = true
+	config.TxPool.Size = 1
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+	tsv.admission.thresholds[vtrpcpb.Priority_BATCH] = 0.5
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	// The open transaction uses the whole transaction pool.
+	transactionID, _, err := tsv.Begin(priorityContext(vtrpcpb.Priority_CRITICAL), &target, nil)
+	require.NoError(t, err)
+	defer tsv.Rollback(ctx, &target, transactionID)
+
+	// The selects use the query pool, which is free.
+	batchCtx := priorityContext(vtrpcpb.Priority_BATCH)
+	_, err = tsv.Execute(batchCtx, &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+
+	// The autocommit DMLs use the transaction pool.
+	_, err = tsv.Execute(batchCtx, &target, "delete from test_table where pk = 1", nil, 0, 0, nil)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "batch request rejected")
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package connpool
 
 import (
+	"flag"
 	"fmt"
 	"strings"
 	"sync"
@@ -33,6 +34,7 @@ import (
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/faultinjection"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -41,6 +43,16 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
+// faults fails queries as if the connection was lost, to test the
+// retries and the reconnections.
+var faults = faultinjection.New("DBConn", func(message string) error {
+	return mysql.NewSQLError(mysql.CRServerLost, mysql.SSUnknownSQLState, "%s", message)
+})
+
+func init() {
+	flag.Var(faults, "dbconn_fault_injection", "for tests only: JSON list of rules that delay or fail the queries of the tablet server to MySQL, like [{\"regexp\": \"^select\", \"percent\": 10, \"delay_ms\": 100, \"fail\": true}]")
+}
+
 // DBConn is a db connection for tabletserver.
 // It performs automatic reconnects as needed.
 // Its Execute function has a timeout that can kill
@@ -53,6 +65,9 @@ type DBConn struct {
 	dbaPool *dbconnpool.ConnectionPool
 	stats   *tabletenv.Stats
 	current sync2.AtomicString
+	// last is the last query executed since the connection was taken
+	// from the pool. It shows what a connection held idle was used for.
+	last sync2.AtomicString
 
 	// err will be set if a query is killed through a Kill.
 	errmu sync.Mutex
@@ -142,6 +157,7 @@ func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfiel
 
 func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
 	dbc.current.Set(query)
+	dbc.last.Set(query)
 	defer dbc.current.Set("")
 
 	// Check if the context is already past its deadline before
@@ -152,10 +168,17 @@ func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, want
 	default:
 	}
 
+	if err := faults.Inject(ctx, query); err != nil {
+		return nil, err
+	}
+
 	defer dbc.stats.MySQLTimings.Record("Exec", time.Now())
 
 	done, wg := dbc.setDeadline(ctx)
 	qr, err := dbc.conn.ExecuteFetch(query, maxrows, wantfields)
+	if err != nil {
+		dbc.stats.MySQLErrors.Add(ClassifyError(err).String(), 1)
+	}
 
 	if done != nil {
 		close(done)
@@ -243,8 +266,13 @@ func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*
 	defer dbc.stats.MySQLTimings.Record("ExecStream", time.Now())
 
 	dbc.current.Set(query)
+	dbc.last.Set(query)
 	defer dbc.current.Set("")
 
+	if err := faults.Inject(ctx, query); err != nil {
+		return err
+	}
+
 	done, wg := dbc.setDeadline(ctx)
 	err := dbc.conn.ExecuteStreamFetch(query, callback, streamBufferSize)
 
@@ -319,8 +347,10 @@ func (dbc *DBConn) Recycle() {
 	case dbc.pool == nil:
 		dbc.Close()
 	case dbc.conn.IsClosed():
+		dbc.pool.release(dbc)
 		dbc.pool.Put(nil)
 	default:
+		dbc.pool.release(dbc)
 		dbc.pool.Put(dbc)
 	}
 }
@@ -330,6 +360,7 @@ func (dbc *DBConn) Taint() {
 	if dbc.pool == nil {
 		return
 	}
+	dbc.pool.release(dbc)
 	dbc.pool.Put(nil)
 	dbc.pool = nil
 }
@@ -364,11 +395,40 @@ func (dbc *DBConn) Kill(reason string, elapsed time.Duration) error {
 	return nil
 }
 
+// KillQuery kills the currently executing query on MySQL side only,
+// with KILL QUERY: unlike Kill, the connection stays open, and so does
+// its transaction if it has one. The killed query returns an error.
+func (dbc *DBConn) KillQuery(reason string, elapsed time.Duration) error {
+	dbc.stats.KillCounters.Add("Queries", 1)
+	log.Infof("Due to %s, elapsed time: %v, killing query ID %v %s", reason, elapsed, dbc.conn.ID(), dbc.Current())
+
+	killConn, err := dbc.dbaPool.Get(context.TODO())
+	if err != nil {
+		log.Warningf("Failed to get conn from dba pool: %v", err)
+		return err
+	}
+	defer killConn.Recycle()
+	sql := fmt.Sprintf("kill query %d", dbc.conn.ID())
+	_, err = killConn.ExecuteFetch(sql, 10000, false)
+	if err != nil {
+		log.Errorf("Could not kill query ID %v %s: %v", dbc.conn.ID(),
+			sqlparser.TruncateForLog(dbc.Current()), err)
+		return err
+	}
+	return nil
+}
+
 // Current returns the currently executing query.
 func (dbc *DBConn) Current() string {
 	return dbc.current.Get()
 }
 
+// Last returns the last query executed since the connection
+// was taken from the pool.
+func (dbc *DBConn) Last() string {
+	return dbc.last.Get()
+}
+
 // ID returns the connection id.
 func (dbc *DBConn) ID() int64 {
 	return dbc.conn.ID()
@@ -234,6 +234,31 @@ func TestDBConnKill(t *testing.T) {
 	}
 }
 
+func TestDBConnKillQuery(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := NewDBConn(context.Background(), connPool, db.ConnParams())
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	query := fmt.Sprintf("kill query %d", dbConn.ID())
+	db.AddQuery(query, &sqltypes.Result{})
+	require.NoError(t, dbConn.KillQuery("test kill", 0))
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+
+	// The connection is still usable.
+	db.AddQuery("select 1", &sqltypes.Result{})
+	_, err = dbConn.Exec(context.Background(), "select 1", 1, false)
+	require.NoError(t, err)
+
+	db.AddRejectedQuery(query, errors.New("rejected"))
+	err = dbConn.KillQuery("test kill", 0)
+	assert.Contains(t, err.Error(), "rejected")
+}
+
 // TestDBConnClose tests that an Exec returns immediately if a connection
 // is asynchronously killed (and closed) in the middle of an execution.
 func TestDBConnClose(t *testing.T) {
@@ -391,3 +416,33 @@ func TestDBConnStreamKill(t *testing.T) {
 
 	assert.Contains(t, err.Error(), "(errno 2013) due to")
 }
+
+func TestDBConnFaultInjection(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := NewDBConn(context.Background(), connPool, db.ConnParams())
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	require.NoError(t, faults.Set(`[{"regexp": "test_table", "percent": 100, "fail": true}]`))
+	defer faults.Set("")
+	// The connection is reconnected and the query retried,
+	// but it fails again.
+	_, err = dbConn.Exec(context.Background(), sql, 1, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "fault injected by the rule of regexp test_table")
+	assert.True(t, mysql.IsConnErr(err))
+	err = dbConn.Stream(context.Background(), sql, func(*sqltypes.Result) error { return nil }, 10, querypb.ExecuteOptions_ALL)
+	assert.True(t, mysql.IsConnErr(err))
+	assert.Zero(t, db.GetQueryCalledNum(sql))
+
+	require.NoError(t, faults.Set(""))
+	_, err = dbConn.Exec(context.Background(), sql, 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum(sql))
+}
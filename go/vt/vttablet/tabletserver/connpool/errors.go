@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+// ErrorClass is the category of a MySQL error. It tells the caller how
+// to react to the error.
+type ErrorClass int
+
+// The following are ErrorClass values.
+const (
+	// ErrorClassOther is for errors that are returned to the client as is,
+	// like syntax errors or duplicate keys.
+	ErrorClassOther = ErrorClass(iota)
+	// ErrorClassRetryable is for transient errors that only fail the
+	// statement, like lock wait timeouts.
+	ErrorClassRetryable
+	// ErrorClassTxRollback is for transient errors after which MySQL
+	// rolls back the whole transaction, like deadlocks. Only statements
+	// executed in autocommit mode can be retried.
+	ErrorClassTxRollback
+	// ErrorClassFatal is for errors that leave the connection or the
+	// server unusable.
+	ErrorClassFatal
+	// ErrorClassSchemaChanged is for errors caused by a table or a
+	// column that does not exist, usually because the schema changed.
+	ErrorClassSchemaChanged
+)
+
+var errorClassNames = []string{
+	"Other",
+	"Retryable",
+	"TxRollback",
+	"Fatal",
+	"SchemaChanged",
+}
+
+func (c ErrorClass) String() string {
+	if c < 0 || int(c) >= len(errorClassNames) {
+		return ""
+	}
+	return errorClassNames[c]
+}
+
+// IsTransient returns true if a statement executed in autocommit mode
+// can be retried after an error of this class.
+func (c ErrorClass) IsTransient() bool {
+	return c == ErrorClassRetryable || c == ErrorClassTxRollback
+}
+
+// ClassifyError returns the class of a MySQL error. The error number is
+// used if it is known, and the SQLSTATE otherwise.
+func ClassifyError(err error) ErrorClass {
+	sqlErr, ok := err.(*mysql.SQLError)
+	if !ok {
+		return ErrorClassOther
+	}
+	if mysql.IsConnErr(err) {
+		return ErrorClassFatal
+	}
+	switch sqlErr.Number() {
+	case mysql.ERLockWaitTimeout:
+		return ErrorClassRetryable
+	case mysql.ERLockDeadlock:
+		return ErrorClassTxRollback
+	case mysql.ERServerShutdown:
+		return ErrorClassFatal
+	case mysql.ERBadFieldError, mysql.ERNoSuchTable:
+		return ErrorClassSchemaChanged
+	}
+	state := sqlErr.SQLState()
+	switch {
+	case state == mysql.SSLockDeadlock:
+		return ErrorClassTxRollback
+	case state == "42S02", state == mysql.SSBadFieldError:
+		// ER_NO_SUCH_TABLE and ER_BAD_FIELD_ERROR.
+		return ErrorClassSchemaChanged
+	case strings.HasPrefix(state, "08"):
+		// Connection exceptions.
+		return ErrorClassFatal
+	}
+	return ErrorClassOther
+}
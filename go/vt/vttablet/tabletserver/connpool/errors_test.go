@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+func TestClassifyError(t *testing.T) {
+	testcases := []struct {
+		err       error
+		class     ErrorClass
+		transient bool
+	}{{
+		err:   errors.New("not a mysql error"),
+		class: ErrorClassOther,
+	}, {
+		err:   mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "duplicate entry"),
+		class: ErrorClassOther,
+	}, {
+		err:       mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "lock wait timeout"),
+		class:     ErrorClassRetryable,
+		transient: true,
+	}, {
+		err:       mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "deadlock"),
+		class:     ErrorClassTxRollback,
+		transient: true,
+	}, {
+		// Unknown error number, known SQLSTATE.
+		err:       mysql.NewSQLError(1, mysql.SSLockDeadlock, "serialization failure"),
+		class:     ErrorClassTxRollback,
+		transient: true,
+	}, {
+		err:   mysql.NewSQLError(mysql.CRServerLost, mysql.SSUnknownSQLState, "lost connection"),
+		class: ErrorClassFatal,
+	}, {
+		err:   mysql.NewSQLError(mysql.ERServerShutdown, mysql.SSUnknownSQLState, "shutdown"),
+		class: ErrorClassFatal,
+	}, {
+		err:   mysql.NewSQLError(1, "08S01", "communication link failure"),
+		class: ErrorClassFatal,
+	}, {
+		err:   mysql.NewSQLError(mysql.ERNoSuchTable, "42S02", "table doesn't exist"),
+		class: ErrorClassSchemaChanged,
+	}, {
+		err:   mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "unknown column"),
+		class: ErrorClassSchemaChanged,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.err.Error(), func(t *testing.T) {
+			class := ClassifyError(tcase.err)
+			assert.Equal(t, tcase.class.String(), class.String())
+			assert.Equal(t, tcase.transient, class.IsTransient())
+		})
+	}
+}
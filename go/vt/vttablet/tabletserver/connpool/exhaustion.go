@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+const (
+	// exhaustionInterval is the minimum interval between two diagnostics
+	// of an exhausted pool, so that a burst of timeouts produces one.
+	exhaustionInterval = time.Second
+	// maxHeldConns is the number of connections listed in a diagnostics.
+	maxHeldConns = 10
+)
+
+// HeldConn is a connection of the pool in use.
+type HeldConn struct {
+	ID    int64     `json:"id"`
+	Since time.Time `json:"since"`
+	// Query is the query running on the connection, or the last
+	// query it ran if Running is false.
+	Query   string `json:"query,omitempty"`
+	Running bool   `json:"running"`
+	Killed  bool   `json:"killed,omitempty"`
+}
+
+// Diagnostics is the state of a pool, with its longest held connections.
+type Diagnostics struct {
+	Pool     string      `json:"pool"`
+	Time     time.Time   `json:"time"`
+	Capacity int64       `json:"capacity"`
+	InUse    int64       `json:"in_use"`
+	Waiters  int64       `json:"waiters"`
+	Held     []*HeldConn `json:"held"`
+}
+
+type heldConn struct {
+	conn  *DBConn
+	since time.Time
+}
+
+func (cp *Pool) hold(conn *DBConn) {
+	if !cp.trackHeld {
+		return
+	}
+	conn.last.Set("")
+	cp.heldMu.Lock()
+	cp.held[conn] = time.Now()
+	cp.heldMu.Unlock()
+}
+
+func (cp *Pool) release(conn *DBConn) {
+	if !cp.trackHeld {
+		return
+	}
+	cp.heldMu.Lock()
+	delete(cp.held, conn)
+	cp.heldMu.Unlock()
+}
+
+// heldConns returns the connections in use, longest held first.
+func (cp *Pool) heldConns() []heldConn {
+	cp.heldMu.Lock()
+	conns := make([]heldConn, 0, len(cp.held))
+	for conn, since := range cp.held {
+		conns = append(conns, heldConn{conn: conn, since: since})
+	}
+	cp.heldMu.Unlock()
+	sort.Slice(conns, func(i, j int) bool { return conns[i].since.Before(conns[j].since) })
+	return conns
+}
+
+// Waiters returns the number of callers getting a connection.
+func (cp *Pool) Waiters() int64 {
+	return cp.waiterCount.Get()
+}
+
+// Diagnose returns the current state of the pool. Held is empty if
+// the pool does not track the connections in use.
+func (cp *Pool) Diagnose() *Diagnostics {
+	return cp.diagnose(cp.heldConns(), nil)
+}
+
+// LastExhaustion returns the state of the pool the last time a caller
+// timed out getting a connection, or nil if none did.
+func (cp *Pool) LastExhaustion() *Diagnostics {
+	cp.heldMu.Lock()
+	defer cp.heldMu.Unlock()
+	return cp.lastExhaustion
+}
+
+func (cp *Pool) diagnose(conns []heldConn, killed map[*DBConn]bool) *Diagnostics {
+	d := &Diagnostics{
+		Pool:     cp.name,
+		Time:     time.Now(),
+		Capacity: cp.Capacity(),
+		InUse:    cp.InUse(),
+		Waiters:  cp.Waiters(),
+		Held:     make([]*HeldConn, 0, maxHeldConns),
+	}
+	for _, hc := range conns {
+		if len(d.Held) == maxHeldConns {
+			break
+		}
+		query, running := hc.conn.Current(), true
+		if query == "" {
+			query, running = hc.conn.Last(), false
+		}
+		if *streamlog.RedactDebugUIQueries {
+			query, _ = sqlparser.RedactSQLQuery(query)
+		}
+		d.Held = append(d.Held, &HeldConn{
+			ID:      hc.conn.ID(),
+			Since:   hc.since,
+			Query:   query,
+			Running: running,
+			Killed:  killed[hc.conn],
+		})
+	}
+	return d
+}
+
+// exhausted is called when a caller timed out getting a connection.
+// It logs the state of the pool and kills the connections held for
+// longer than the kill threshold of the pool, if it has one. The held
+// connections are only listed if the pool tracks them.
+func (cp *Pool) exhausted() {
+	now := time.Now()
+	cp.heldMu.Lock()
+	if now.Sub(cp.exhaustedAt) < exhaustionInterval {
+		cp.heldMu.Unlock()
+		return
+	}
+	cp.exhaustedAt = now
+	cp.heldMu.Unlock()
+
+	conns := cp.heldConns()
+	var killed map[*DBConn]bool
+	if cp.killHeld > 0 {
+		killed = make(map[*DBConn]bool)
+		for _, hc := range conns {
+			held := now.Sub(hc.since)
+			if held < cp.killHeld {
+				break
+			}
+			if err := hc.conn.Kill(fmt.Sprintf("%s exhaustion", cp.name), held); err == nil {
+				killed[hc.conn] = true
+			}
+		}
+	}
+	d := cp.diagnose(conns, killed)
+
+	cp.heldMu.Lock()
+	cp.lastExhaustion = d
+	cp.heldMu.Unlock()
+	log.Warningf("Pool %s exhausted: %d/%d connections in use, %d waiters, longest held: %s", d.Pool, d.InUse, d.Capacity, d.Waiters, d.describe())
+}
+
+// describe summarizes the held connections for the log.
+func (d *Diagnostics) describe() string {
+	parts := make([]string, 0, len(d.Held))
+	for _, hc := range d.Held {
+		desc := fmt.Sprintf("%d for %v", hc.ID, d.Time.Sub(hc.Since).Round(time.Millisecond))
+		if hc.Killed {
+			desc += " (killed)"
+		}
+		parts = append(parts, desc+": "+sqlparser.TruncateForLog(hc.Query))
+	}
+	return strings.Join(parts, "; ")
+}
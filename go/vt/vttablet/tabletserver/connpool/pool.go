@@ -55,8 +55,20 @@ type Pool struct {
 	idleTimeout        time.Duration
 	waiterCap          int64
 	waiterCount        sync2.AtomicInt64
+	killHeld           time.Duration
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+
+	// trackHeld enables the tracking of the connections in use, which
+	// takes heldMu on every Get and Recycle. It is opt-in, see
+	// queryserver-config-pool-diagnostics, or on with a kill threshold.
+	trackHeld bool
+	// held tracks the connections in use since they were taken,
+	// for the exhaustion diagnostics.
+	heldMu         sync.Mutex
+	held           map[*DBConn]time.Time
+	exhaustedAt    time.Time
+	lastExhaustion *Diagnostics
 }
 
 // NewPool creates a new Pool. The name is used
@@ -71,6 +83,9 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 		timeout:            cfg.TimeoutSeconds.Get(),
 		idleTimeout:        idleTimeout,
 		waiterCap:          int64(cfg.MaxWaiters),
+		killHeld:           cfg.KillHeldSeconds.Get(),
+		trackHeld:          cfg.KillHeldSeconds.Get() > 0 || (env.Config() != nil && env.Config().PoolDiagnostics),
+		held:               make(map[*DBConn]time.Time),
 		dbaPool:            dbconnpool.NewConnectionPool("", 1, idleTimeout, 0),
 	}
 	if name == "" {
@@ -82,6 +97,7 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	env.Exporter().NewGaugeFunc(name+"InUse", "Tablet server conn pool in use", cp.InUse)
 	env.Exporter().NewGaugeFunc(name+"MaxCap", "Tablet server conn pool max cap", cp.MaxCap)
 	env.Exporter().NewCounterFunc(name+"WaitCount", "Tablet server conn pool wait count", cp.WaitCount)
+	env.Exporter().NewGaugeFunc(name+"Waiters", "Tablet server conn pool waiters", cp.Waiters)
 	env.Exporter().NewCounterDurationFunc(name+"WaitTime", "Tablet server wait time", cp.WaitTime)
 	env.Exporter().NewGaugeDurationFunc(name+"IdleTimeout", "Tablet server idle timeout", cp.IdleTimeout)
 	env.Exporter().NewCounterFunc(name+"IdleClosed", "Tablet server conn pool idle closed", cp.IdleClosed)
@@ -146,12 +162,10 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	span, ctx := trace.NewSpan(ctx, "Pool.Get")
 	defer span.Finish()
 
-	if cp.waiterCap > 0 {
-		waiterCount := cp.waiterCount.Add(1)
-		defer cp.waiterCount.Add(-1)
-		if waiterCount > cp.waiterCap {
-			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "pool %s waiter count exceeded", cp.name)
-		}
+	waiterCount := cp.waiterCount.Add(1)
+	defer cp.waiterCount.Add(-1)
+	if cp.waiterCap > 0 && waiterCount > cp.waiterCap {
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "pool %s waiter count exceeded", cp.name)
 	}
 
 	if cp.isCallerIDAppDebug(ctx) {
@@ -173,9 +187,14 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		if err == pools.ErrTimeout {
+			cp.exhausted()
+		}
 		return nil, err
 	}
-	return r.(*DBConn), nil
+	conn := r.(*DBConn)
+	cp.hold(conn)
+	return conn, nil
 }
 
 // Put puts a connection into the pool.
@@ -261,6 +280,15 @@ func (cp *Pool) InUse() int64 {
 	return p.InUse()
 }
 
+// Utilization returns the fraction of the pool connections currently in use.
+func (cp *Pool) Utilization() float64 {
+	p := cp.pool()
+	if p == nil || p.Capacity() == 0 {
+		return 0
+	}
+	return float64(p.InUse()) / float64(p.Capacity())
+}
+
 // MaxCap returns the maximum size of the pool
 func (cp *Pool) MaxCap() int64 {
 	p := cp.pool()
@@ -17,6 +17,7 @@ limitations under the License.
 package connpool
 
 import (
+	"fmt"
 	"runtime"
 	"sync"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -110,6 +112,78 @@ func TestConnPoolMaxWaiters(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConnPoolExhaustion(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("select 1", &sqltypes.Result{})
+	connPool := NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:            1,
+		TimeoutSeconds:  0.1,
+		KillHeldSeconds: 0.05,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	assert.Nil(t, connPool.LastExhaustion())
+
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	_, err = dbConn.Exec(context.Background(), "select 1", 1, false)
+	require.NoError(t, err)
+	d := connPool.Diagnose()
+	assert.EqualValues(t, 1, d.InUse)
+	require.Len(t, d.Held, 1)
+	assert.Equal(t, &HeldConn{ID: dbConn.ID(), Since: d.Held[0].Since, Query: "select 1"}, d.Held[0])
+
+	// The connection is held longer than the kill threshold
+	// when the next caller times out.
+	db.AddQuery(fmt.Sprintf("kill %d", dbConn.ID()), &sqltypes.Result{})
+	_, err = connPool.Get(context.Background())
+	assert.EqualError(t, err, "resource pool timed out")
+	d = connPool.LastExhaustion()
+	require.NotNil(t, d)
+	assert.Equal(t, "TestPool", d.Pool)
+	assert.EqualValues(t, 1, d.Capacity)
+	assert.EqualValues(t, 1, d.Waiters)
+	require.Len(t, d.Held, 1)
+	assert.Equal(t, dbConn.ID(), d.Held[0].ID)
+	assert.True(t, d.Held[0].Killed)
+	assert.True(t, dbConn.IsClosed())
+
+	// The killed connection is replaced.
+	dbConn.Recycle()
+	assert.Empty(t, connPool.Diagnose().Held)
+	dbConn, err = connPool.Get(context.Background())
+	require.NoError(t, err)
+	dbConn.Recycle()
+}
+
+func TestConnPoolHeldTracking(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := tabletenv.NewDefaultConfig()
+	connPool := NewPool(tabletenv.NewEnv(config, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{Size: 1})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	// The connections in use are not tracked by default.
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	d := connPool.Diagnose()
+	assert.EqualValues(t, 1, d.InUse)
+	assert.Empty(t, d.Held)
+	dbConn.Recycle()
+
+	config.PoolDiagnostics = true
+	connPool = NewPool(tabletenv.NewEnv(config, "PoolTest"), "", tabletenv.ConnPoolConfig{Size: 1})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err = connPool.Get(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, connPool.Diagnose().Held, 1)
+	dbConn.Recycle()
+	assert.Empty(t, connPool.Diagnose().Held)
+}
+
 func TestConnPoolGetEmptyDebugConfig(t *testing.T) {
 	db := fakesqldb.New(t)
 	debugConn := db.ConnParamsWithUname("")
@@ -269,6 +343,9 @@ func TestConnPoolStateWhilePoolIsOpen(t *testing.T) {
 	if connPool.InUse() != 1 {
 		t.Fatalf("pool inUse connections should be 1")
 	}
+	if connPool.Utilization() != 0.01 {
+		t.Fatalf("pool utilization should be 0.01")
+	}
 
 	dbConn.Recycle()
 	if connPool.Available() != 100 {
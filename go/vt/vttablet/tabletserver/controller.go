@@ -79,6 +79,11 @@ type Controller interface {
 	// SetQueryRules sets the query rules for this QueryService
 	SetQueryRules(ruleSource string, qrs *rules.Rules) error
 
+	// SetQueryRulesWriter sets the function that writes the query rules
+	// of a source back to where it loads them from, so that they can be
+	// rolled back
+	SetQueryRulesWriter(ruleSource string, write func(*rules.Rules) error) error
+
 	// QueryService returns the QueryService object used by this Controller
 	QueryService() queryservice.QueryService
 
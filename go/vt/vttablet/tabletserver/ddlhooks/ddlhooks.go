@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ddlhooks notifies external systems, like caches or schema
+// registries, of the DDLs executed by vttablet. Before and after a DDL,
+// the pre_ddl and post_ddl hooks of $VTROOT/vthook are executed, and a
+// JSON description of the DDL is posted to a webhook.
+package ddlhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	enableHooks = flag.Bool("enable_ddl_hooks", false, "If true, vttablet runs the pre_ddl and post_ddl hooks of $VTROOT/vthook before and after the DDLs it executes, including online DDL migrations. A failing pre_ddl hook aborts the DDL.")
+	webhookURL  = flag.String("ddl_hooks_webhook_url", "", "If set, vttablet POSTs a JSON description of the DDLs it executes to this URL, before and after executing them. A failing pre phase call aborts the DDL.")
+	hookTimeout = flag.Duration("ddl_hooks_timeout", 10*time.Second, "Timeout of the DDL hooks and webhook calls.")
+
+	hookErrors = stats.NewCountersWithSingleLabel("DDLHookErrors", "Number of failed DDL hooks and webhook calls", "phase")
+)
+
+// The phases of a DDL.
+const (
+	PhasePre  = "pre"
+	PhasePost = "post"
+)
+
+// Event describes a DDL. It is the body of the webhook calls.
+type Event struct {
+	Phase    string   `json:"phase"`
+	Keyspace string   `json:"keyspace,omitempty"`
+	Shard    string   `json:"shard,omitempty"`
+	Tables   []string `json:"tables"`
+	Action   string   `json:"action"`
+	// UUID is the UUID of the online DDL migration, if any.
+	UUID string `json:"uuid,omitempty"`
+	SQL  string `json:"sql"`
+	// Error is the error of the DDL. It is only set in the post phase.
+	Error string `json:"error,omitempty"`
+}
+
+// Enabled returns true if DDLs must be reported to hooks.
+func Enabled() bool {
+	return *enableHooks || *webhookURL != ""
+}
+
+// NewEvent returns the pre phase event of ddl.
+func NewEvent(keyspace, shard, uuid, sql string, ddl sqlparser.DDLStatement) *Event {
+	var tables []string
+	for _, table := range ddl.AffectedTables() {
+		tables = append(tables, table.Name.String())
+	}
+	return &Event{
+		Phase:    PhasePre,
+		Keyspace: keyspace,
+		Shard:    shard,
+		Tables:   tables,
+		Action:   ddl.GetAction().ToString(),
+		UUID:     uuid,
+		SQL:      sql,
+	}
+}
+
+// Run reports event to the hook and to the webhook. An error in the
+// pre phase means that the DDL must not be executed.
+func Run(ctx context.Context, event *Event) error {
+	if !Enabled() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, *hookTimeout)
+	defer cancel()
+
+	err := runHook(ctx, event)
+	if err == nil {
+		err = callWebhook(ctx, event)
+	}
+	if err != nil {
+		hookErrors.Add(event.Phase, 1)
+		return fmt.Errorf("%s DDL hook failed for %s on %s: %v", event.Phase, event.Action, strings.Join(event.Tables, ","), err)
+	}
+	return nil
+}
+
+// RunPost reports the post phase of event, once the DDL has been
+// executed with the result err. Failures are only logged, since the
+// DDL can't be undone.
+func RunPost(ctx context.Context, event *Event, err error) {
+	post := *event
+	post.Phase = PhasePost
+	if err != nil {
+		post.Error = err.Error()
+	}
+	if err := Run(ctx, &post); err != nil {
+		log.Warning(err)
+	}
+}
+
+func runHook(ctx context.Context, event *Event) error {
+	if !*enableHooks {
+		return nil
+	}
+	h := hook.NewHook(event.Phase+"_ddl", []string{
+		"--keyspace=" + event.Keyspace,
+		"--shard=" + event.Shard,
+		"--tables=" + strings.Join(event.Tables, ","),
+		"--action=" + event.Action,
+		"--uuid=" + event.UUID,
+		"--sql=" + event.SQL,
+	})
+	if event.Error != "" {
+		h.Parameters = append(h.Parameters, "--error="+event.Error)
+	}
+	hr := h.ExecuteContext(ctx)
+	switch hr.ExitStatus {
+	case hook.HOOK_SUCCESS, hook.HOOK_DOES_NOT_EXIST, hook.HOOK_VTROOT_ERROR:
+		return nil
+	default:
+		return fmt.Errorf("%v hook failed(%v): %v", h.Name, hr.ExitStatus, hr.Stderr)
+	}
+}
+
+func callWebhook(ctx context.Context, event *Event) error {
+	if *webhookURL == "" {
+		return nil
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", *webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
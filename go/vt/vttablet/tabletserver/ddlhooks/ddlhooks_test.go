@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ddlhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func newTestEvent(t *testing.T, sql string) *Event {
+	stmt, err := sqlparser.Parse(sql)
+	require.NoError(t, err)
+	return NewEvent("ks", "-80", "uuid", sql, stmt.(sqlparser.DDLStatement))
+}
+
+func TestNewEvent(t *testing.T) {
+	event := newTestEvent(t, "drop table t1, t2")
+	assert.Equal(t, &Event{
+		Phase:    PhasePre,
+		Keyspace: "ks",
+		Shard:    "-80",
+		Tables:   []string{"t1", "t2"},
+		Action:   "drop",
+		UUID:     "uuid",
+		SQL:      "drop table t1, t2",
+	}, event)
+}
+
+func TestRunDisabled(t *testing.T) {
+	assert.False(t, Enabled())
+	assert.NoError(t, Run(context.Background(), newTestEvent(t, "alter table t1 add column c int")))
+}
+
+func TestWebhook(t *testing.T) {
+	var events []*Event
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := &Event{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(event))
+		events = append(events, event)
+		if fail {
+			http.Error(w, "schema registry unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	*webhookURL = server.URL
+	defer func() { *webhookURL = "" }()
+
+	event := newTestEvent(t, "alter table t1 add column c int")
+	require.NoError(t, Run(context.Background(), event))
+	RunPost(context.Background(), event, nil)
+	require.Len(t, events, 2)
+	assert.Equal(t, PhasePre, events[0].Phase)
+	assert.Equal(t, PhasePost, events[1].Phase)
+	assert.Equal(t, []string{"t1"}, events[1].Tables)
+	assert.Equal(t, "alter", events[1].Action)
+
+	fail = true
+	before := hookErrors.Counts()[PhasePre]
+	err := Run(context.Background(), event)
+	assert.EqualError(t, err, "pre DDL hook failed for alter on t1: webhook returned 503 Service Unavailable: schema registry unavailable")
+	assert.Equal(t, before+1, hookErrors.Counts()[PhasePre])
+}
+
+func TestHook(t *testing.T) {
+	vtroot, err := ioutil.TempDir("", "ddlhooks")
+	require.NoError(t, err)
+	defer os.RemoveAll(vtroot)
+	require.NoError(t, os.Mkdir(path.Join(vtroot, "vthook"), 0755))
+	oldVTRoot := os.Getenv("VTROOT")
+	os.Setenv("VTROOT", vtroot)
+	defer os.Setenv("VTROOT", oldVTRoot)
+
+	*enableHooks = true
+	defer func() { *enableHooks = false }()
+
+	// A missing hook is not an error.
+	event := newTestEvent(t, "alter table t1 add column c int")
+	require.NoError(t, Run(context.Background(), event))
+
+	out := path.Join(vtroot, "out")
+	script := "#!/bin/sh\necho \"$@\" > " + out + "\n"
+	require.NoError(t, ioutil.WriteFile(path.Join(vtroot, "vthook", "post_ddl"), []byte(script), 0755))
+	RunPost(context.Background(), event, nil)
+	args, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "--keyspace=ks --shard=-80 --tables=t1 --action=alter --uuid=uuid --sql=alter table t1 add column c int", strings.TrimSpace(string(args)))
+
+	script = "#!/bin/sh\necho table is locked >&2\nexit 1\n"
+	require.NoError(t, ioutil.WriteFile(path.Join(vtroot, "vthook", "pre_ddl"), []byte(script), 0755))
+	err = Run(context.Background(), event)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pre_ddl hook failed(1): table is locked")
+}
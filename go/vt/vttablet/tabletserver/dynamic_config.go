@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// dynamicSetting is a runtime setting of the tablet server that
+// can be overridden by the tablet config saved in the topo.
+type dynamicSetting struct {
+	get func() string
+	set func(string) error
+}
+
+func intSetting(get func() int, set func(int)) dynamicSetting {
+	return dynamicSetting{
+		get: func() string { return strconv.Itoa(get()) },
+		set: func(value string) error {
+			val, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			if val < 0 {
+				return fmt.Errorf("negative value: %d", val)
+			}
+			set(val)
+			return nil
+		},
+	}
+}
+
+func durationSetting(get func() time.Duration, set func(time.Duration)) dynamicSetting {
+	return dynamicSetting{
+		get: func() string { return get().String() },
+		set: func(value string) error {
+			val, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			if val < 0 {
+				return fmt.Errorf("negative value: %v", val)
+			}
+			set(val)
+			return nil
+		},
+	}
+}
+
+func boolSetting(get func() bool, set func(bool)) dynamicSetting {
+	return dynamicSetting{
+		get: func() string { return strconv.FormatBool(get()) },
+		set: func(value string) error {
+			val, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			set(val)
+			return nil
+		},
+	}
+}
+
+// dynamicSettings returns the settings that can be set in the tablet
+// config, by name. The names match the ones of the /debug/env page.
+func (tsv *TabletServer) dynamicSettings() map[string]dynamicSetting {
+	return map[string]dynamicSetting{
+		"PoolSize":           intSetting(tsv.PoolSize, tsv.SetPoolSize),
+		"StreamPoolSize":     intSetting(tsv.StreamPoolSize, tsv.SetStreamPoolSize),
+		"TxPoolSize":         intSetting(tsv.TxPoolSize, tsv.SetTxPoolSize),
+		"QueryCacheCapacity": intSetting(tsv.QueryPlanCacheCap, tsv.SetQueryPlanCacheCap),
+		"MaxResultSize":      intSetting(tsv.MaxResultSize, tsv.SetMaxResultSize),
+		"WarnResultSize":     intSetting(tsv.WarnResultSize, tsv.SetWarnResultSize),
+		"QueryTimeout":       durationSetting(tsv.QueryTimeout.Get, tsv.SetQueryTimeout),
+		"TxTimeout":          durationSetting(tsv.TxTimeout, tsv.SetTxTimeout),
+		"StrictTableACL":     boolSetting(tsv.StrictTableACL, tsv.SetStrictTableACL),
+		"Consolidator": {
+			get: tsv.ConsolidatorMode,
+			set: func(value string) error {
+				mode, err := tabletenv.ParseConsolidatorMode(value)
+				if err != nil {
+					return err
+				}
+				tsv.SetConsolidatorMode(mode)
+				return nil
+			},
+		},
+	}
+}
+
+// dynamicConfig periodically applies to the tablet server the tablet
+// config saved in the topo for its keyspace and shard. When a setting
+// is removed from the config, it goes back to the value it had before
+// being overridden. It also reports the drain percentage of the cell
+// of the tablet in its health.
+type dynamicConfig struct {
+	ts       *topo.Server
+	interval time.Duration
+	settings map[string]dynamicSetting
+	errors   *stats.Counter
+
+	cell         string
+	setCellDrain func(percent int32)
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	// applied is the value of the overridden settings,
+	// and defaults is their value before the override.
+	applied  map[string]string
+	defaults map[string]string
+}
+
+func newDynamicConfig(tsv *TabletServer, ts *topo.Server) *dynamicConfig {
+	dc := &dynamicConfig{
+		ts:       ts,
+		interval: tsv.config.TabletConfigRefreshIntervalSeconds.Get(),
+		settings: tsv.dynamicSettings(),
+		errors:   tsv.exporter.NewCounter("TabletConfigErrors", "Errors while loading or applying the tablet config saved in the topo"),
+		applied:  make(map[string]string),
+		defaults: make(map[string]string),
+
+		cell:         tsv.alias.Cell,
+		setCellDrain: tsv.hs.SetCellDrainPercent,
+	}
+	tsv.exporter.Publish("TabletConfig", stats.StringMapFunc(dc.values))
+	return dc
+}
+
+// Open starts refreshing the config of keyspace and shard.
+func (dc *dynamicConfig) Open(keyspace, shard string) {
+	if dc.ts == nil || dc.interval == 0 || keyspace == "" {
+		return
+	}
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if dc.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	dc.cancel = cancel
+	go dc.run(ctx, keyspace, shard)
+}
+
+// Close stops refreshing the config. The settings keep their value.
+func (dc *dynamicConfig) Close() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if dc.cancel == nil {
+		return
+	}
+	dc.cancel()
+	dc.cancel = nil
+}
+
+func (dc *dynamicConfig) run(ctx context.Context, keyspace, shard string) {
+	ticker := time.NewTicker(dc.interval)
+	defer ticker.Stop()
+	for {
+		if err := dc.refresh(ctx, keyspace, shard); err != nil && ctx.Err() == nil {
+			dc.errors.Add(1)
+			log.Warningf("Cannot load the tablet config of %s/%s: %v", keyspace, shard, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (dc *dynamicConfig) refresh(ctx context.Context, keyspace, shard string) error {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+	config, err := dc.ts.GetEffectiveTabletConfig(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	dc.apply(config)
+	if dc.cell == "" {
+		return nil
+	}
+	ci, err := dc.ts.GetCellInfo(ctx, dc.cell, false /*strongRead*/)
+	if err != nil {
+		return err
+	}
+	dc.setCellDrain(ci.DrainPercent)
+	return nil
+}
+
+// apply overrides the settings that are in config, and restores the
+// ones that were overridden but are no longer in config.
+func (dc *dynamicConfig) apply(config map[string]string) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	for name, value := range config {
+		setting, ok := dc.settings[name]
+		if !ok {
+			dc.errors.Add(1)
+			log.Warningf("Ignoring unknown setting in the tablet config: %s", name)
+			continue
+		}
+		if applied, ok := dc.applied[name]; ok && applied == value {
+			continue
+		}
+		if _, ok := dc.defaults[name]; !ok {
+			dc.defaults[name] = setting.get()
+		}
+		if err := setting.set(value); err != nil {
+			dc.errors.Add(1)
+			log.Warningf("Ignoring invalid value in the tablet config: %s=%s: %v", name, value, err)
+			if _, ok := dc.applied[name]; !ok {
+				delete(dc.defaults, name)
+			}
+			continue
+		}
+		dc.applied[name] = value
+		log.Infof("Tablet config: setting %s to %s", name, value)
+	}
+
+	for name := range dc.applied {
+		if _, ok := config[name]; ok {
+			continue
+		}
+		if err := dc.settings[name].set(dc.defaults[name]); err != nil {
+			dc.errors.Add(1)
+			log.Warningf("Cannot restore the default of %s: %v", name, err)
+		}
+		log.Infof("Tablet config: restoring %s to %s", name, dc.defaults[name])
+		delete(dc.applied, name)
+		delete(dc.defaults, name)
+	}
+}
+
+// values returns the effective value of all the settings.
+func (dc *dynamicConfig) values() map[string]string {
+	values := make(map[string]string, len(dc.settings))
+	for name, setting := range dc.settings {
+		values[name] = setting.get()
+	}
+	return values
+}
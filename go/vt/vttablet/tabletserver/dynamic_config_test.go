@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDynamicConfig(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "ks")
+	defer tsv.StopService()
+	defer db.Close()
+	ctx := context.Background()
+	ts := tsv.topoServer
+
+	poolSize := tsv.PoolSize()
+	queryTimeout := tsv.QueryTimeout.Get()
+	require.NotEqual(t, 7, poolSize)
+
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "", map[string]string{
+		"PoolSize":       "7",
+		"QueryTimeout":   "5s",
+		"Consolidator":   tabletenv.Disable,
+		"StrictTableACL": "true",
+	}))
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "0", map[string]string{
+		"PoolSize": "8",
+	}))
+	errors := tsv.dc.errors.Get()
+	require.NoError(t, tsv.dc.refresh(ctx, "ks", "0"))
+	assert.Equal(t, 8, tsv.PoolSize())
+	assert.Equal(t, 5*time.Second, tsv.QueryTimeout.Get())
+	assert.Equal(t, tabletenv.Disable, tsv.ConsolidatorMode())
+	assert.True(t, tsv.StrictTableACL())
+	assert.Equal(t, errors, tsv.dc.errors.Get())
+
+	values := tsv.dc.values()
+	assert.Equal(t, "8", values["PoolSize"])
+	assert.Equal(t, "5s", values["QueryTimeout"])
+
+	// Unknown settings and invalid values are ignored.
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "0", map[string]string{
+		"PoolSize":   "8",
+		"TxPoolSize": "many",
+		"Unknown":    "1",
+	}))
+	txPoolSize := tsv.TxPoolSize()
+	require.NoError(t, tsv.dc.refresh(ctx, "ks", "0"))
+	assert.Equal(t, txPoolSize, tsv.TxPoolSize())
+	assert.Equal(t, errors+2, tsv.dc.errors.Get())
+
+	// Removed settings go back to their previous value.
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "", nil))
+	require.NoError(t, ts.SaveTabletConfig(ctx, "ks", "0", nil))
+	require.NoError(t, tsv.dc.refresh(ctx, "ks", "0"))
+	assert.Equal(t, poolSize, tsv.PoolSize())
+	assert.Equal(t, queryTimeout, tsv.QueryTimeout.Get())
+	assert.Equal(t, tabletenv.Enable, tsv.ConsolidatorMode())
+	assert.False(t, tsv.StrictTableACL())
+}
+
+func TestDynamicConfigRefresh(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.TabletConfigRefreshIntervalSeconds.Set(10 * time.Millisecond)
+	db, tsv := setupTabletServerTestCustom(t, config, "ks")
+	defer tsv.StopService()
+	defer db.Close()
+
+	require.NoError(t, tsv.topoServer.SaveTabletConfig(context.Background(), "ks", "", map[string]string{"MaxResultSize": "123"}))
+	assert.Eventually(t, func() bool {
+		return tsv.MaxResultSize() == 123
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDynamicConfigCellDrain(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "ks")
+	defer tsv.StopService()
+	defer db.Close()
+	ctx := context.Background()
+	ts := tsv.topoServer
+
+	require.NoError(t, ts.CreateCellInfo(ctx, "cell1", &topodatapb.CellInfo{Root: "/cell1"}))
+	tsv.dc.cell = "cell1"
+	require.NoError(t, tsv.dc.refresh(ctx, "ks", "0"))
+	assert.EqualValues(t, 0, tsv.hs.state.RealtimeStats.CellDrainPercent)
+
+	require.NoError(t, ts.UpdateCellInfoFields(ctx, "cell1", func(ci *topodatapb.CellInfo) error {
+		ci.DrainPercent = 50
+		return nil
+	}))
+	require.NoError(t, tsv.dc.refresh(ctx, "ks", "0"))
+	assert.EqualValues(t, 50, tsv.hs.state.RealtimeStats.CellDrainPercent)
+}
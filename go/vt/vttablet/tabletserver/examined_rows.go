@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// execSelectWithExaminedRowsLimit executes a select after checking the
+// rows it is estimated to examine by its EXPLAIN against the
+// -queryserver-config-max-examined-rows limits. Over the limit, the
+// select fails or is executed with the connections of the streaming
+// queries, depending on the action of the limits. Either way, its result
+// is returned at once: only StreamExecute streams results.
+func (qre *QueryExecutor) execSelectWithExaminedRowsLimit() (*sqltypes.Result, error) {
+	config := &qre.tsv.config.ExaminedRowsLimits
+	tableName := qre.plan.TableName().String()
+	limit := config.Get(tableName)
+	if limit <= 0 {
+		return qre.execSelect()
+	}
+
+	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := qre.getConn()
+	if err != nil {
+		return nil, err
+	}
+	explain, err := qre.execDBConn(conn, "explain "+sql, true)
+	conn.Recycle()
+	if err != nil {
+		return nil, err
+	}
+	examined := examinedRows(explain)
+	if examined <= int64(limit) {
+		return qre.execSelect()
+	}
+
+	qre.tsv.Stats().ExaminedRowsLimitHits.Add([]string{tableName, config.Action}, 1)
+	if config.Action != tabletenv.ExaminedRowsStreamPool {
+		username := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qre.ctx))
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "caller id: %s: select is estimated to examine %d rows, more than %d: use a streaming query", username, examined, limit)
+	}
+	conn, err = qre.getStreamConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return qre.execDBConn(conn, sql, true)
+}
+
+// examinedRows estimates the rows examined by a select from its EXPLAIN.
+// The tables of a select are joined with nested loops, in the order of
+// the EXPLAIN: every row of the tables before a table is looked up in
+// it, so that it examines the product of their rows. The examined rows
+// of the selects of a statement add up. The estimate saturates at
+// math.MaxInt64.
+func examinedRows(explain *sqltypes.Result) int64 {
+	idColumn, rowsColumn := -1, -1
+	for i, field := range explain.Fields {
+		switch strings.ToLower(field.Name) {
+		case "id":
+			idColumn = i
+		case "rows":
+			rowsColumn = i
+		}
+	}
+	if rowsColumn == -1 {
+		return 0
+	}
+
+	var total, product int64
+	var lastID string
+	for _, row := range explain.Rows {
+		// The tables without an estimate, like the derived ones,
+		// are accounted for by the selects that produce them.
+		rows, err := strconv.ParseInt(row[rowsColumn].ToString(), 10, 64)
+		if err != nil || rows <= 0 {
+			continue
+		}
+		var id string
+		if idColumn != -1 {
+			id = row[idColumn].ToString()
+		}
+		if product == 0 || id != lastID {
+			product = 1
+			lastID = id
+		}
+		product = saturatingMultiply(product, rows)
+		total = saturatingAdd(total, product)
+	}
+	return total
+}
+
+func saturatingMultiply(a, b int64) int64 {
+	if a > math.MaxInt64/b {
+		return math.MaxInt64
+	}
+	return a * b
+}
+
+func saturatingAdd(a, b int64) int64 {
+	if a > math.MaxInt64-b {
+		return math.MaxInt64
+	}
+	return a + b
+}
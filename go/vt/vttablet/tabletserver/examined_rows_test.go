@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestExaminedRows(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|select_type|table|rows", "int64|varchar|varchar|int64")
+	testcases := []struct {
+		rows []string
+		want int64
+	}{{
+		rows: nil,
+		want: 0,
+	}, {
+		rows: []string{"1|SIMPLE|a|100"},
+		want: 100,
+	}, {
+		// a join b: 100 rows of a, and 10 rows of b for each of them.
+		rows: []string{"1|SIMPLE|a|100", "1|SIMPLE|b|10"},
+		want: 1100,
+	}, {
+		// The selects of a union add up, and the derived
+		// tables have no estimate.
+		rows: []string{"1|PRIMARY|a|100", "2|UNION|b|10", "null|UNION RESULT|<union1,2>|null"},
+		want: 110,
+	}, {
+		rows: []string{"1|SIMPLE|a|9223372036854775807", "1|SIMPLE|b|2"},
+		want: math.MaxInt64,
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, examinedRows(sqltypes.MakeTestResult(fields, tcase.rows...)), "%v", tcase.rows)
+	}
+	assert.Zero(t, examinedRows(sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")))
+}
+
+func TestExaminedRowsLimit(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, sqltypes.MakeTestResult(sqltypes.MakeTestFields("pk", "int64"), "1"))
+	db.AddQuery("explain "+query, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|table|rows", "int64|varchar|int64"), "1|test_table|200"))
+
+	tsv.config.ExaminedRowsLimits = tabletenv.ExaminedRowsLimitsConfig{
+		MaxRows: 100,
+		Action:  tabletenv.ExaminedRowsReject,
+		Tables: map[string]int{
+			"other_table": 1000,
+		},
+	}
+	_, err := tsv.Execute(ctx, &target, query, nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "select is estimated to examine 200 rows, more than 100")
+	assert.EqualValues(t, 1, tsv.stats.ExaminedRowsLimitHits.Counts()["test_table.reject"])
+
+	tsv.config.ExaminedRowsLimits.Action = tabletenv.ExaminedRowsStreamPool
+	qr, err := tsv.Execute(ctx, &target, query, nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Len(t, qr.Rows, 1)
+	assert.EqualValues(t, 1, tsv.stats.ExaminedRowsLimitHits.Counts()["test_table.stream_pool"])
+
+	// The limit of the table overrides the default one.
+	tsv.config.ExaminedRowsLimits.Tables["test_table"] = 1000
+	_, err = tsv.Execute(ctx, &target, query, nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, tsv.stats.ExaminedRowsLimitHits.Counts()["test_table.stream_pool"])
+
+	// The selects in a transaction are not checked.
+	tsv.config.ExaminedRowsLimits.Tables["test_table"] = 1
+	tsv.config.ExaminedRowsLimits.Action = tabletenv.ExaminedRowsReject
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txid, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, &target, query, nil, txid, 0, nil)
+	require.NoError(t, err)
+	_, err = tsv.Rollback(ctx, &target, txid)
+	require.NoError(t, err)
+}
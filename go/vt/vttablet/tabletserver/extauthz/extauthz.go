@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package extauthz lets an external policy engine decide which queries
+// a user may run. It supports a plain HTTP webhook and the data API of
+// an Open Policy Agent (OPA).
+package extauthz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Request is what the authorization service is asked about.
+type Request struct {
+	User     string   `json:"user"`
+	Groups   []string `json:"groups,omitempty"`
+	Table    string   `json:"table"`
+	PlanType string   `json:"plan_type"`
+	// SQL is the normalized query, with bind variables
+	// instead of literals.
+	SQL string `json:"sql"`
+}
+
+// Authorizer decides whether a query is allowed.
+type Authorizer interface {
+	// Authorize returns a PERMISSION_DENIED error if the query
+	// is not allowed.
+	Authorize(ctx context.Context, req *Request) error
+}
+
+// New creates an Authorizer for the external authorization config.
+// If it is disabled, the Authorizer allows everything.
+func New(env tabletenv.Env) Authorizer {
+	config := env.Config().ExternalAuthz
+	if config.Mode != tabletenv.Webhook && config.Mode != tabletenv.OPA {
+		return AllowAll{}
+	}
+	impl := &Impl{
+		opa:      config.Mode == tabletenv.OPA,
+		url:      config.URL,
+		client:   &http.Client{Timeout: config.TimeoutSeconds.Get()},
+		ttl:      config.CacheTTLSeconds.Get(),
+		failOpen: config.FailOpen,
+		logger:   logutil.NewThrottledLogger("ExternalAuthz", 5*time.Second),
+		results:  env.Exporter().NewCountersWithSingleLabel("ExternalAuthzResults", "external authorization results", "Result"),
+		latency:  env.Exporter().NewTimings("ExternalAuthzRequests", "external authorization requests", "Result"),
+	}
+	if impl.ttl > 0 && config.CacheSize > 0 {
+		impl.cache = cache.NewLRUCache(int64(config.CacheSize), func(interface{}) int64 { return 1 })
+	}
+	return impl
+}
+
+// AllowAll is an Authorizer that allows everything.
+type AllowAll struct{}
+
+// Authorize is part of the Authorizer interface.
+func (AllowAll) Authorize(ctx context.Context, req *Request) error {
+	return nil
+}
+
+// Impl calls the authorization service and caches its decisions.
+type Impl struct {
+	opa      bool
+	url      string
+	client   *http.Client
+	ttl      time.Duration
+	failOpen bool
+	// cache is nil if decisions are not cached.
+	cache *cache.LRUCache
+
+	logger  *logutil.ThrottledLogger
+	results *stats.CountersWithSingleLabel
+	latency *servenv.TimingsWrapper
+}
+
+// decision is a cached answer of the authorization service.
+type decision struct {
+	allowed bool
+	reason  string
+	expires time.Time
+}
+
+// Authorize is part of the Authorizer interface.
+func (a *Impl) Authorize(ctx context.Context, req *Request) error {
+	key := cacheKey(req)
+	if a.cache != nil {
+		if v, ok := a.cache.Get(key); ok {
+			if d := v.(*decision); time.Now().Before(d.expires) {
+				a.results.Add("CacheHit", 1)
+				return d.err(req)
+			}
+		}
+	}
+
+	d, err := a.call(ctx, req)
+	if err != nil {
+		a.results.Add("Error", 1)
+		if a.failOpen {
+			a.logger.Warningf("external authorization failed, allowing %q to run %s on table %q: %v", req.User, req.PlanType, req.Table, err)
+			return nil
+		}
+		return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "external authorization failed: %v", err)
+	}
+	if d.allowed {
+		a.results.Add("Allowed", 1)
+	} else {
+		a.results.Add("Denied", 1)
+	}
+	if a.cache != nil {
+		d.expires = time.Now().Add(a.ttl)
+		a.cache.Set(key, d)
+	}
+	return d.err(req)
+}
+
+func (d *decision) err(req *Request) error {
+	if d.allowed {
+		return nil
+	}
+	errStr := fmt.Sprintf("external authorization denied: %q %v cannot run %v on table %q", req.User, req.Groups, req.PlanType, req.Table)
+	if d.reason != "" {
+		errStr += ": " + d.reason
+	}
+	return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
+}
+
+// call asks the authorization service about req.
+func (a *Impl) call(ctx context.Context, req *Request) (*decision, error) {
+	var input interface{} = req
+	if a.opa {
+		input = map[string]interface{}{"input": req}
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequest("POST", a.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := a.client.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		a.latency.Record("Error", start)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	a.latency.Record("OK", start)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s: %s", a.url, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if a.opa {
+		return parseOPAResponse(data)
+	}
+	return parseWebhookResponse(data)
+}
+
+// parseWebhookResponse parses {"allowed": true|false, "reason": "..."}.
+func parseWebhookResponse(data []byte) (*decision, error) {
+	var resp struct {
+		Allowed *bool  `json:"allowed"`
+		Reason  string `json:"reason"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("invalid webhook response: %v", err)
+	}
+	if resp.Allowed == nil {
+		return nil, fmt.Errorf("invalid webhook response, allowed is missing: %s", data)
+	}
+	return &decision{allowed: *resp.Allowed, reason: resp.Reason}, nil
+}
+
+// parseOPAResponse parses the response of the OPA data API. The policy
+// result can be a boolean or an object like {"allow": true, "reason": "..."}.
+// An undefined result denies the query.
+func parseOPAResponse(data []byte) (*decision, error) {
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("invalid OPA response: %v", err)
+	}
+	if len(resp.Result) == 0 {
+		return &decision{reason: "policy result is undefined"}, nil
+	}
+	var allowed bool
+	if err := json.Unmarshal(resp.Result, &allowed); err == nil {
+		return &decision{allowed: allowed}, nil
+	}
+	var result struct {
+		Allow  bool   `json:"allow"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(resp.Result, &result); err != nil {
+		return nil, fmt.Errorf("invalid OPA policy result: %s", resp.Result)
+	}
+	return &decision{allowed: result.Allow, reason: result.Reason}, nil
+}
+
+func cacheKey(req *Request) string {
+	return strings.Join([]string{req.User, strings.Join(req.Groups, ","), req.Table, req.PlanType, req.SQL}, "\x00")
+}
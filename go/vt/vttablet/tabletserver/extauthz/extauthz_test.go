@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extauthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// fakeService answers with respond and counts the requests it gets.
+type fakeService struct {
+	*httptest.Server
+	calls   int64
+	lastReq map[string]interface{}
+}
+
+func newFakeService(t *testing.T, respond func(input map[string]interface{}) string) *fakeService {
+	fs := &fakeService{}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&fs.calls, 1)
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		fs.lastReq = body
+		w.Write([]byte(respond(body)))
+	}))
+	return fs
+}
+
+func newAuthorizer(mode, url string, cacheTTL tabletenv.Seconds, failOpen bool) Authorizer {
+	config := tabletenv.NewDefaultConfig()
+	config.ExternalAuthz.Mode = mode
+	config.ExternalAuthz.URL = url
+	config.ExternalAuthz.CacheTTLSeconds = cacheTTL
+	config.ExternalAuthz.FailOpen = failOpen
+	return New(tabletenv.NewEnv(config, "ExternalAuthzTest"))
+}
+
+var testRequest = &Request{
+	User:     "alice",
+	Groups:   []string{"eng"},
+	Table:    "orders",
+	PlanType: "Select",
+	SQL:      "select * from orders where id = :id",
+}
+
+func TestDisabled(t *testing.T) {
+	a := newAuthorizer(tabletenv.Disable, "", 60, false)
+	assert.Equal(t, AllowAll{}, a)
+	assert.NoError(t, a.Authorize(context.Background(), testRequest))
+}
+
+func TestWebhook(t *testing.T) {
+	fs := newFakeService(t, func(input map[string]interface{}) string {
+		if input["plan_type"] == "Select" {
+			return `{"allowed": true}`
+		}
+		return `{"allowed": false, "reason": "read only"}`
+	})
+	defer fs.Close()
+	a := newAuthorizer(tabletenv.Webhook, fs.URL, 60, false)
+
+	require.NoError(t, a.Authorize(context.Background(), testRequest))
+	assert.Equal(t, map[string]interface{}{
+		"user":      "alice",
+		"groups":    []interface{}{"eng"},
+		"table":     "orders",
+		"plan_type": "Select",
+		"sql":       "select * from orders where id = :id",
+	}, fs.lastReq)
+
+	// The decision is cached.
+	require.NoError(t, a.Authorize(context.Background(), testRequest))
+	assert.EqualValues(t, 1, atomic.LoadInt64(&fs.calls))
+
+	update := *testRequest
+	update.PlanType = "Update"
+	update.SQL = "update orders set status = :status"
+	err := a.Authorize(context.Background(), &update)
+	assert.EqualError(t, err, `external authorization denied: "alice" [eng] cannot run Update on table "orders": read only`)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.EqualValues(t, 2, atomic.LoadInt64(&fs.calls))
+}
+
+func TestWebhookWithoutCache(t *testing.T) {
+	fs := newFakeService(t, func(map[string]interface{}) string { return `{"allowed": true}` })
+	defer fs.Close()
+	a := newAuthorizer(tabletenv.Webhook, fs.URL, 0, false)
+
+	require.NoError(t, a.Authorize(context.Background(), testRequest))
+	require.NoError(t, a.Authorize(context.Background(), testRequest))
+	assert.EqualValues(t, 2, atomic.LoadInt64(&fs.calls))
+}
+
+func TestOPA(t *testing.T) {
+	responses := map[string]string{
+		"bool":      `{"result": true}`,
+		"object":    `{"result": {"allow": false, "reason": "not on call"}}`,
+		"undefined": `{}`,
+	}
+	fs := newFakeService(t, func(input map[string]interface{}) string {
+		return responses[input["input"].(map[string]interface{})["table"].(string)]
+	})
+	defer fs.Close()
+	a := newAuthorizer(tabletenv.OPA, fs.URL, 60, false)
+
+	req := *testRequest
+	req.Table = "bool"
+	assert.NoError(t, a.Authorize(context.Background(), &req))
+	req.Table = "object"
+	assert.EqualError(t, a.Authorize(context.Background(), &req), `external authorization denied: "alice" [eng] cannot run Select on table "object": not on call`)
+	req.Table = "undefined"
+	assert.EqualError(t, a.Authorize(context.Background(), &req), `external authorization denied: "alice" [eng] cannot run Select on table "undefined": policy result is undefined`)
+}
+
+func TestServiceErrors(t *testing.T) {
+	fs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "policy not loaded", http.StatusInternalServerError)
+	}))
+	defer fs.Close()
+
+	a := newAuthorizer(tabletenv.Webhook, fs.URL, 60, false)
+	err := a.Authorize(context.Background(), testRequest)
+	assert.Contains(t, err.Error(), "external authorization failed: "+fs.URL+" returned 500 Internal Server Error: policy not loaded")
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+
+	a = newAuthorizer(tabletenv.Webhook, fs.URL, 60, true)
+	assert.NoError(t, a.Authorize(context.Background(), testRequest))
+
+	invalid := newFakeService(t, func(map[string]interface{}) string { return `{"ok": true}` })
+	defer invalid.Close()
+	a = newAuthorizer(tabletenv.Webhook, invalid.URL, 60, false)
+	assert.EqualError(t, a.Authorize(context.Background(), testRequest), `external authorization failed: invalid webhook response, allowed is missing: {"ok": true}`)
+}
@@ -33,6 +33,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
@@ -53,6 +54,9 @@ type healthStreamer struct {
 	degradedThreshold  time.Duration
 	unhealthyThreshold time.Duration
 
+	// txPoolUtilization reports the transaction pool usage, if set.
+	txPoolUtilization func() float64
+
 	mu      sync.Mutex
 	ctx     context.Context
 	cancel  context.CancelFunc
@@ -75,6 +79,8 @@ func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthS
 			RealtimeStats: &querypb.RealtimeStats{
 				HealthError: errUnintialized,
 			},
+			QueryServiceVersion: queryservice.Version,
+			Capabilities:        queryservice.LocalCapabilities(),
 		},
 
 		history: history.New(5),
@@ -178,6 +184,9 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 
 	hs.state.RealtimeStats.SecondsBehindMasterFilteredReplication, hs.state.RealtimeStats.BinlogPlayersCount = blpFunc()
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
+	if hs.txPoolUtilization != nil {
+		hs.state.RealtimeStats.TransactionPoolUtilization = hs.txPoolUtilization()
+	}
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 
@@ -211,6 +220,14 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 	})
 }
 
+// SetCellDrainPercent sets the drain percentage of the cell of the
+// tablet, reported from the next health update.
+func (hs *healthStreamer) SetCellDrainPercent(percent int32) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	hs.state.RealtimeStats.CellDrainPercent = percent
+}
+
 func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
 	hs.mu.Lock()
 	defer hs.mu.Unlock()
@@ -237,6 +254,13 @@ func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
 			Value: hs.state.RealtimeStats.HealthError,
 		})
 	}
+	if hs.state.RealtimeStats.CellDrainPercent > 0 {
+		details = append(details, &kv{
+			Key:   "Cell Drain",
+			Class: unhappyClass,
+			Value: fmt.Sprintf("%d%%", hs.state.RealtimeStats.CellDrainPercent),
+		})
+	}
 
 	return details
 }
@@ -26,6 +26,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -63,8 +64,10 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 
 	shr := <-ch
 	want := &querypb.StreamHealthResponse{
-		Target:      &querypb.Target{},
-		TabletAlias: &alias,
+		Target:              &querypb.Target{},
+		TabletAlias:         &alias,
+		QueryServiceVersion: queryservice.Version,
+		Capabilities:        queryservice.LocalCapabilities(),
 		RealtimeStats: &querypb.RealtimeStats{
 			HealthError: "tabletserver uninitialized",
 		},
@@ -77,7 +80,9 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Target: &querypb.Target{
 			TabletType: topodatapb.TabletType_REPLICA,
 		},
-		TabletAlias: &alias,
+		TabletAlias:         &alias,
+		QueryServiceVersion: queryservice.Version,
+		Capabilities:        queryservice.LocalCapabilities(),
 		RealtimeStats: &querypb.RealtimeStats{
 			SecondsBehindMasterFilteredReplication: 1,
 			BinlogPlayersCount:                     2,
@@ -94,6 +99,8 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 			TabletType: topodatapb.TabletType_MASTER,
 		},
 		TabletAlias:                         &alias,
+		QueryServiceVersion:                 queryservice.Version,
+		Capabilities:                        queryservice.LocalCapabilities(),
 		Serving:                             true,
 		TabletExternallyReparentedTimestamp: now.Unix(),
 		RealtimeStats: &querypb.RealtimeStats{
@@ -110,7 +117,9 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Target: &querypb.Target{
 			TabletType: topodatapb.TabletType_REPLICA,
 		},
-		TabletAlias: &alias,
+		TabletAlias:         &alias,
+		QueryServiceVersion: queryservice.Version,
+		Capabilities:        queryservice.LocalCapabilities(),
 		RealtimeStats: &querypb.RealtimeStats{
 			SecondsBehindMaster:                    1,
 			SecondsBehindMasterFilteredReplication: 1,
@@ -126,7 +135,9 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Target: &querypb.Target{
 			TabletType: topodatapb.TabletType_REPLICA,
 		},
-		TabletAlias: &alias,
+		TabletAlias:         &alias,
+		QueryServiceVersion: queryservice.Version,
+		Capabilities:        queryservice.LocalCapabilities(),
 		RealtimeStats: &querypb.RealtimeStats{
 			HealthError:                            "repl err",
 			SecondsBehindMasterFilteredReplication: 1,
@@ -136,6 +147,29 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 	assert.Equal(t, want, shr)
 }
 
+func TestHealthStreamerTxPoolUtilization(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias)
+	hs.txPoolUtilization = func() float64 { return 0.25 }
+	hs.Open()
+	defer hs.Close()
+	hs.InitDBConfig(querypb.Target{})
+
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	hs.ChangeState(topodatapb.TabletType_REPLICA, time.Time{}, 0, nil, true)
+	shr := <-ch
+	assert.Equal(t, 0.25, shr.RealtimeStats.TransactionPoolUtilization)
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
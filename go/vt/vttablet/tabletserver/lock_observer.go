@@ -0,0 +1,445 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
+)
+
+const (
+	// sqlLockWaits reads the InnoDB lock waits. The sys view joins the
+	// lock tables of information_schema (MySQL 5.7) or
+	// performance_schema (MySQL 8.0) with innodb_trx.
+	sqlLockWaits = "select locked_table, locked_index, locked_type, wait_age_secs, waiting_trx_id, waiting_pid, waiting_query, blocking_trx_id, blocking_pid, blocking_query from sys.innodb_lock_waits"
+	// sqlInnodbStatus reports the latest deadlock detected by InnoDB.
+	sqlInnodbStatus = "show engine innodb status"
+)
+
+// LockConn is a MySQL connection involved in a lock wait or a deadlock.
+// If the connection belongs to the tablet, it also describes what the
+// tablet is running on it.
+type LockConn struct {
+	ThreadID int64  `json:"thread_id"`
+	TrxID    string `json:"trx_id,omitempty"`
+	Query    string `json:"query,omitempty"`
+
+	// Source is the query list of the connection, or "transaction"
+	// for a connection of the transaction pool. It is empty if the
+	// connection does not belong to the tablet.
+	Source          string    `json:"source,omitempty"`
+	TransactionID   int64     `json:"transaction_id,omitempty"`
+	EffectiveCaller string    `json:"effective_caller,omitempty"`
+	ImmediateCaller string    `json:"immediate_caller,omitempty"`
+	Start           time.Time `json:"start"`
+}
+
+// LockWait is a transaction waiting for a lock held by another one.
+type LockWait struct {
+	Table       string    `json:"table"`
+	Index       string    `json:"index,omitempty"`
+	LockType    string    `json:"lock_type"`
+	WaitSeconds int64     `json:"wait_seconds"`
+	Waiting     *LockConn `json:"waiting"`
+	Blocking    *LockConn `json:"blocking"`
+}
+
+// Deadlock is the latest deadlock reported by InnoDB.
+type Deadlock struct {
+	// Time is the time of the deadlock, as reported by InnoDB.
+	Time         string      `json:"time"`
+	Transactions []*LockConn `json:"transactions"`
+	// RolledBack is the number of the transaction InnoDB rolled back,
+	// starting at 1.
+	RolledBack int    `json:"rolled_back,omitempty"`
+	Status     string `json:"status"`
+}
+
+// lockObserver periodically samples the InnoDB lock waits and the latest
+// deadlock, like pt-deadlock-logger does, and matches the MySQL connections
+// involved with the queries and transactions the tablet is running.
+type lockObserver struct {
+	env        tabletenv.Env
+	interval   time.Duration
+	queryLists []*QueryList
+	txConns    func(f func(txID tx.ConnID, mysqlConnID int64, props *tx.Properties))
+	connected  func() bool
+	errorLog   *logutil.ThrottledLogger
+
+	runMu  sync.Mutex
+	isOpen bool
+	pool   *connpool.Pool
+	ticks  *timer.Timer
+
+	mu           sync.Mutex
+	sampled      time.Time
+	waits        []*LockWait
+	waitingTrxs  map[string]bool
+	deadlock     *Deadlock
+	deadlockTime string
+
+	lockWaits        *stats.Gauge
+	lockWaitSeconds  *stats.Gauge
+	lockWaitsByTable *stats.CountersWithSingleLabel
+	deadlocks        *stats.CountersWithSingleLabel
+	errors           *stats.Counter
+}
+
+func newLockObserver(tsv *TabletServer) *lockObserver {
+	interval := tsv.config.LockObserverIntervalSeconds.Get()
+	lo := &lockObserver{
+		env:        tsv,
+		interval:   interval,
+		queryLists: []*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql},
+		txConns:    tsv.te.txPool.scp.ForAllTxConns,
+		connected:  func() bool { return tsv.sm.State() != StateNotConnected },
+		errorLog:   logutil.NewThrottledLogger("LockObserver", 60*time.Second),
+
+		waitingTrxs: make(map[string]bool),
+
+		lockWaits:        tsv.exporter.NewGauge("LockWaits", "Number of transactions waiting for an InnoDB lock"),
+		lockWaitSeconds:  tsv.exporter.NewGauge("LockWaitSeconds", "Longest current InnoDB lock wait in seconds"),
+		lockWaitsByTable: tsv.exporter.NewCountersWithSingleLabel("LockWaitsByTable", "InnoDB lock waits seen, by locked table", "Table"),
+		deadlocks:        tsv.exporter.NewCountersWithSingleLabel("Deadlocks", "InnoDB deadlocks seen, by whether a tablet transaction was rolled back", "Victim"),
+		errors:           tsv.exporter.NewCounter("LockObserverErrors", "Errors while reading the InnoDB lock waits and deadlocks"),
+	}
+	if interval > 0 {
+		lo.ticks = timer.NewTimer(interval)
+		lo.pool = connpool.NewPool(tsv, "LockObserverPool", tabletenv.ConnPoolConfig{
+			Size:               1,
+			IdleTimeoutSeconds: tsv.config.OltpReadPool.IdleTimeoutSeconds,
+		})
+	}
+	return lo
+}
+
+// Open starts sampling the lock waits. It needs the PROCESS
+// privilege, so the dba user is used.
+func (lo *lockObserver) Open() {
+	if lo.interval == 0 {
+		return
+	}
+	lo.runMu.Lock()
+	defer lo.runMu.Unlock()
+	if lo.isOpen {
+		return
+	}
+	dba := lo.env.Config().DB.DbaWithDB()
+	lo.pool.Open(dba, dba, lo.env.Config().DB.AppDebugWithDB())
+	lo.ticks.Start(lo.sample)
+	lo.isOpen = true
+}
+
+// Close stops sampling. The last sample stays available.
+func (lo *lockObserver) Close() {
+	lo.runMu.Lock()
+	defer lo.runMu.Unlock()
+	if !lo.isOpen {
+		return
+	}
+	lo.ticks.Stop()
+	lo.pool.Close()
+	lo.isOpen = false
+}
+
+// sample reads the lock waits and the latest deadlock once.
+func (lo *lockObserver) sample() {
+	defer lo.env.LogError()
+	if !lo.connected() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lo.interval)
+	defer cancel()
+	conn, err := lo.pool.Get(ctx)
+	if err != nil {
+		lo.recordError(err)
+		return
+	}
+	defer conn.Recycle()
+
+	waits, err := conn.Exec(ctx, sqlLockWaits, 10000, false)
+	if err != nil {
+		lo.recordError(err)
+		return
+	}
+	status, err := conn.Exec(ctx, sqlInnodbStatus, 1, false)
+	if err != nil {
+		lo.recordError(err)
+		return
+	}
+	var deadlock *Deadlock
+	if len(status.Rows) == 1 && len(status.Rows[0]) == 3 {
+		deadlock = parseLatestDeadlock(status.Rows[0][2].ToString())
+	}
+	lo.record(parseLockWaits(waits), deadlock)
+}
+
+func (lo *lockObserver) recordError(err error) {
+	lo.errors.Add(1)
+	lo.errorLog.Errorf("Cannot read the InnoDB lock waits: %v", err)
+}
+
+// record matches the connections of a sample with the tablet
+// queries and transactions, and updates the stats.
+func (lo *lockObserver) record(waits []*LockWait, deadlock *Deadlock) {
+	conns := lo.tabletConns()
+
+	lo.mu.Lock()
+	defer lo.mu.Unlock()
+	// The deadlock of the first sample may have happened
+	// before the tablet started, so it is not counted.
+	first := lo.sampled.IsZero()
+	lo.sampled = time.Now()
+
+	waitingTrxs := make(map[string]bool, len(waits))
+	var longest int64
+	for _, wait := range waits {
+		conns.annotate(wait.Waiting)
+		conns.annotate(wait.Blocking)
+		if wait.WaitSeconds > longest {
+			longest = wait.WaitSeconds
+		}
+		waitingTrxs[wait.Waiting.TrxID] = true
+		if !lo.waitingTrxs[wait.Waiting.TrxID] {
+			lo.lockWaitsByTable.Add(wait.Table, 1)
+		}
+	}
+	lo.waits = waits
+	lo.waitingTrxs = waitingTrxs
+	lo.lockWaits.Set(int64(len(waits)))
+	lo.lockWaitSeconds.Set(longest)
+
+	if deadlock == nil || deadlock.Time == lo.deadlockTime {
+		return
+	}
+	lo.deadlockTime = deadlock.Time
+	for _, conn := range deadlock.Transactions {
+		conns.annotate(conn)
+	}
+	lo.deadlock = deadlock
+	if first {
+		return
+	}
+	victim := "None"
+	if deadlock.RolledBack > 0 && deadlock.RolledBack <= len(deadlock.Transactions) {
+		if deadlock.Transactions[deadlock.RolledBack-1].Source != "" {
+			victim = "Tablet"
+		} else {
+			victim = "Other"
+		}
+	}
+	lo.deadlocks.Add(victim, 1)
+	log.Warningf("InnoDB deadlock at %s, rolled back transaction %d: %s", deadlock.Time, deadlock.RolledBack, deadlock.describe())
+}
+
+// tabletConns is what the tablet runs on its MySQL connections,
+// by MySQL connection id.
+type tabletConns map[int64]*LockConn
+
+func (lo *lockObserver) tabletConns() tabletConns {
+	conns := make(tabletConns)
+	for _, ql := range lo.queryLists {
+		ql.forEach(func(qd *QueryDetail) {
+			conns[qd.connID] = &LockConn{
+				Source:          ql.name,
+				EffectiveCaller: callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qd.ctx)),
+				ImmediateCaller: callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qd.ctx)),
+				Start:           qd.start,
+			}
+		})
+	}
+	// A connection in a transaction is also in the oltp-stateful
+	// list while it runs a query. The transaction wins because it
+	// holds the locks.
+	lo.txConns(func(txID tx.ConnID, mysqlConnID int64, props *tx.Properties) {
+		conns[mysqlConnID] = &LockConn{
+			Source:          "transaction",
+			TransactionID:   txID,
+			EffectiveCaller: callerid.GetPrincipal(props.EffectiveCaller),
+			ImmediateCaller: callerid.GetUsername(props.ImmediateCaller),
+			Start:           props.StartTime,
+		}
+	})
+	return conns
+}
+
+func (conns tabletConns) annotate(conn *LockConn) {
+	if *streamlog.RedactDebugUIQueries && conn.Query != "" {
+		conn.Query, _ = sqlparser.RedactSQLQuery(conn.Query)
+	}
+	tc, ok := conns[conn.ThreadID]
+	if !ok {
+		return
+	}
+	conn.Source = tc.Source
+	conn.TransactionID = tc.TransactionID
+	conn.EffectiveCaller = tc.EffectiveCaller
+	conn.ImmediateCaller = tc.ImmediateCaller
+	conn.Start = tc.Start
+}
+
+// parseLockWaits parses the result of sqlLockWaits.
+func parseLockWaits(qr *sqltypes.Result) []*LockWait {
+	waits := make([]*LockWait, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		if len(row) != 10 {
+			continue
+		}
+		waitSeconds, _ := evalInt64(row[3])
+		waitingPid, _ := evalInt64(row[5])
+		blockingPid, _ := evalInt64(row[8])
+		waits = append(waits, &LockWait{
+			Table:       row[0].ToString(),
+			Index:       row[1].ToString(),
+			LockType:    row[2].ToString(),
+			WaitSeconds: waitSeconds,
+			Waiting: &LockConn{
+				ThreadID: waitingPid,
+				TrxID:    row[4].ToString(),
+				Query:    row[6].ToString(),
+			},
+			Blocking: &LockConn{
+				ThreadID: blockingPid,
+				TrxID:    row[7].ToString(),
+				Query:    row[9].ToString(),
+			},
+		})
+	}
+	return waits
+}
+
+func evalInt64(v sqltypes.Value) (int64, error) {
+	if v.IsNull() {
+		return 0, nil
+	}
+	return v.ToInt64()
+}
+
+// parseLatestDeadlock extracts the LATEST DETECTED DEADLOCK section of
+// the output of SHOW ENGINE INNODB STATUS. It returns nil if InnoDB did
+// not detect any deadlock since MySQL started.
+func parseLatestDeadlock(status string) *Deadlock {
+	const header = "LATEST DETECTED DEADLOCK"
+	start := strings.Index(status, header)
+	if start == -1 {
+		return nil
+	}
+	lines := strings.Split(status[start+len(header):], "\n")
+	deadlock := &Deadlock{}
+	var section []string
+	var current *LockConn
+	wantQuery := false
+	for i, line := range lines {
+		isSeparator := line != "" && strings.Trim(line, "-") == ""
+		if isSeparator {
+			if i <= 1 {
+				continue
+			}
+			break
+		}
+		section = append(section, line)
+		switch {
+		case deadlock.Time == "" && line != "":
+			fields := strings.Fields(line)
+			if len(fields) >= 2 {
+				deadlock.Time = fields[0] + " " + fields[1]
+			}
+		case strings.HasPrefix(line, "*** (") && strings.HasSuffix(line, ") TRANSACTION:"):
+			current = &LockConn{}
+			deadlock.Transactions = append(deadlock.Transactions, current)
+		case strings.HasPrefix(line, "TRANSACTION ") && current != nil && current.TrxID == "":
+			current.TrxID = strings.TrimSuffix(strings.Fields(line)[1], ",")
+		case strings.HasPrefix(line, "MySQL thread id ") && current != nil:
+			fmt.Sscanf(line, "MySQL thread id %d,", &current.ThreadID)
+			wantQuery = true
+			continue
+		case wantQuery && current != nil && !strings.HasPrefix(line, "***"):
+			current.Query = line
+		case strings.HasPrefix(line, "*** WE ROLL BACK TRANSACTION ("):
+			fmt.Sscanf(line, "*** WE ROLL BACK TRANSACTION (%d)", &deadlock.RolledBack)
+		}
+		wantQuery = false
+	}
+	if deadlock.Time == "" {
+		return nil
+	}
+	deadlock.Status = strings.TrimSpace(strings.Join(section, "\n"))
+	return deadlock
+}
+
+// describe summarizes the transactions of the deadlock for the log.
+func (d *Deadlock) describe() string {
+	parts := make([]string, 0, len(d.Transactions))
+	for i, conn := range d.Transactions {
+		desc := fmt.Sprintf("(%d) thread %d", i+1, conn.ThreadID)
+		if conn.Source != "" {
+			desc += fmt.Sprintf(" [%s %d, %s]", conn.Source, conn.TransactionID, conn.EffectiveCaller)
+		}
+		parts = append(parts, desc+": "+conn.Query)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// lockWaitsStatus is the response of /debug/lock_waits.
+type lockWaitsStatus struct {
+	Enabled  bool        `json:"enabled"`
+	Sampled  time.Time   `json:"sampled"`
+	Waits    []*LockWait `json:"lock_waits"`
+	Deadlock *Deadlock   `json:"latest_deadlock"`
+}
+
+// ServeHTTP shows the lock waits and the latest deadlock as JSON.
+func (lo *lockObserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	lo.mu.Lock()
+	status := lockWaitsStatus{
+		Enabled:  lo.interval > 0,
+		Sampled:  lo.sampled,
+		Waits:    lo.waits,
+		Deadlock: lo.deadlock,
+	}
+	b, err := json.MarshalIndent(status, "", "  ")
+	lo.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(b)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const testInnodbStatus = `
+=====================================
+2021-06-01 10:00:05 0x7f7d1c2b9700 INNODB MONITOR OUTPUT
+=====================================
+------------------------
+LATEST DETECTED DEADLOCK
+------------------------
+2021-06-01 10:00:00 0x7f7d1c2b9700
+*** (1) TRANSACTION:
+TRANSACTION 1301, ACTIVE 5 sec starting index read
+mysql tables in use 1, locked 1
+LOCK WAIT 2 lock struct(s), heap size 1136, 1 row lock(s)
+MySQL thread id %d, OS thread handle 140176, query id 120 localhost vt_app updating
+update test_table set name = 'a' where pk = 2
+*** (1) WAITING FOR THIS LOCK TO BE GRANTED:
+RECORD LOCKS space id 30 page no 3 n bits 72 index PRIMARY of table ` + "`vt_test`.`test_table`" + ` trx id 1301 lock_mode X locks rec but not gap waiting
+*** (2) TRANSACTION:
+TRANSACTION 1302, ACTIVE 3 sec starting index read
+mysql tables in use 1, locked 1
+MySQL thread id 9999, OS thread handle 140177, query id 121 localhost batch updating
+update test_table set name = 'b' where pk = 1
+*** (2) HOLDS THE LOCK(S):
+RECORD LOCKS space id 30 page no 3 n bits 72 index PRIMARY of table ` + "`vt_test`.`test_table`" + ` trx id 1302 lock_mode X locks rec but not gap
+*** WE ROLL BACK TRANSACTION (%d)
+------------
+TRANSACTIONS
+------------
+Trx id counter 1310
+`
+
+func TestParseLatestDeadlock(t *testing.T) {
+	assert.Nil(t, parseLatestDeadlock("------------\nTRANSACTIONS\n------------\n"))
+
+	deadlock := parseLatestDeadlock(fmt.Sprintf(testInnodbStatus, 12, 2))
+	require.NotNil(t, deadlock)
+	assert.Equal(t, "2021-06-01 10:00:00", deadlock.Time)
+	assert.Equal(t, 2, deadlock.RolledBack)
+	assert.Equal(t, []*LockConn{{
+		ThreadID: 12,
+		TrxID:    "1301",
+		Query:    "update test_table set name = 'a' where pk = 2",
+	}, {
+		ThreadID: 9999,
+		TrxID:    "1302",
+		Query:    "update test_table set name = 'b' where pk = 1",
+	}}, deadlock.Transactions)
+	assert.Contains(t, deadlock.Status, "*** (2) HOLDS THE LOCK(S):")
+	assert.NotContains(t, deadlock.Status, "Trx id counter")
+}
+
+func TestLockObserver(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.LockObserverIntervalSeconds.Set(time.Hour)
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "", ""), callerid.NewImmediateCallerID("vtgate"))
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	txID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	defer tsv.Rollback(ctx, &target, txID)
+	conn, err := tsv.te.txPool.GetAndLock(txID, "for test")
+	require.NoError(t, err)
+	mysqlConnID := conn.ID()
+	conn.Unlock()
+
+	db.AddQuery(sqlLockWaits, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"locked_table|locked_index|locked_type|wait_age_secs|waiting_trx_id|waiting_pid|waiting_query|blocking_trx_id|blocking_pid|blocking_query",
+			"varchar|varchar|varchar|int64|varchar|int64|varchar|varchar|int64|varchar"),
+		fmt.Sprintf("`vt_test`.`test_table`|PRIMARY|RECORD|7|1302|9999|update test_table set name = 'b' where pk = 1|1301|%d|null", mysqlConnID),
+	))
+	statusFields := sqltypes.MakeTestFields("Type|Name|Status", "varchar|varchar|varchar")
+	db.AddQuery(sqlInnodbStatus, &sqltypes.Result{
+		Fields: statusFields,
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar("InnoDB"), sqltypes.NewVarChar(""), sqltypes.NewVarChar(fmt.Sprintf(testInnodbStatus, mysqlConnID, 2))}},
+	})
+
+	waitsByTable := tsv.lo.lockWaitsByTable.Counts()["`vt_test`.`test_table`"]
+	deadlocks := tsv.lo.deadlocks.Counts()
+	tsv.lo.sample()
+
+	// The wait is counted once, however many samples see it.
+	tsv.lo.sample()
+	assert.EqualValues(t, 1, tsv.lo.lockWaits.Get())
+	assert.EqualValues(t, 7, tsv.lo.lockWaitSeconds.Get())
+	assert.Equal(t, waitsByTable+1, tsv.lo.lockWaitsByTable.Counts()["`vt_test`.`test_table`"])
+	// The deadlock of the first sample is not counted.
+	assert.Equal(t, deadlocks, tsv.lo.deadlocks.Counts())
+
+	rr := httptest.NewRecorder()
+	tsv.lo.ServeHTTP(rr, httptest.NewRequest("GET", "/debug/lock_waits", nil))
+	var status lockWaitsStatus
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &status))
+	require.Len(t, status.Waits, 1)
+	wait := status.Waits[0]
+	assert.Equal(t, "`vt_test`.`test_table`", wait.Table)
+	assert.Equal(t, int64(9999), wait.Waiting.ThreadID)
+	assert.Equal(t, "", wait.Waiting.Source)
+	assert.Equal(t, mysqlConnID, wait.Blocking.ThreadID)
+	assert.Equal(t, "transaction", wait.Blocking.Source)
+	assert.Equal(t, txID, wait.Blocking.TransactionID)
+	assert.Equal(t, "alice", wait.Blocking.EffectiveCaller)
+	assert.Equal(t, "vtgate", wait.Blocking.ImmediateCaller)
+	require.NotNil(t, status.Deadlock)
+	assert.Equal(t, "transaction", status.Deadlock.Transactions[0].Source)
+
+	// A new deadlock that rolled back the tablet transaction.
+	db.AddQuery(sqlInnodbStatus, &sqltypes.Result{
+		Fields: statusFields,
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar("InnoDB"), sqltypes.NewVarChar(""), sqltypes.NewVarChar(strings.Replace(fmt.Sprintf(testInnodbStatus, mysqlConnID, 1), "10:00:00", "10:01:00", 1))}},
+	})
+	tsv.lo.sample()
+	assert.Equal(t, deadlocks["Tablet"]+1, tsv.lo.deadlocks.Counts()["Tablet"])
+	assert.Equal(t, "2021-06-01 10:01:00", tsv.lo.deadlock.Time)
+}
@@ -36,6 +36,7 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 	if sel.Lock != sqlparser.NoLock {
 		plan.PlanID = PlanSelectLock
 	}
+	plan.PKBindVars = analyzePKBindVars(sel.Where, plan.Table)
 
 	if sel.Where != nil {
 		comp, ok := sel.Where.Expr.(*sqlparser.ComparisonExpr)
@@ -68,6 +69,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		PlanID: PlanUpdate,
 		Table:  lookupTable(upd.TableExprs, tables),
 	}
+	plan.PKBindVars = analyzePKBindVars(upd.Where, plan.Table)
 
 	// Store the WHERE clause as string for the hot row protection (txserializer).
 	if upd.Where != nil {
@@ -98,6 +100,7 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 		PlanID: PlanDelete,
 		Table:  lookupTable(del.TableExprs, tables),
 	}
+	plan.PKBindVars = analyzePKBindVars(del.Where, plan.Table)
 
 	if del.Where != nil {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -116,10 +119,63 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// analyzePKBindVars returns the bind variables compared with a primary
+// key column of table in the top level conditions of where.
+func analyzePKBindVars(where *sqlparser.Where, table *schema.Table) []PKBindVar {
+	if where == nil || table == nil {
+		return nil
+	}
+	var bindVars []PKBindVar
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		comp, ok := expr.(*sqlparser.ComparisonExpr)
+		if !ok {
+			continue
+		}
+		left, right := comp.Left, comp.Right
+		var name string
+		switch comp.Operator {
+		case sqlparser.EqualOp:
+			if _, ok := left.(sqlparser.Argument); ok {
+				left, right = right, left
+			}
+			arg, ok := right.(sqlparser.Argument)
+			if !ok {
+				continue
+			}
+			name = string(arg[1:])
+		case sqlparser.InOp:
+			arg, ok := right.(sqlparser.ListArg)
+			if !ok {
+				continue
+			}
+			name = string(arg[2:])
+		default:
+			continue
+		}
+		col, ok := left.(*sqlparser.ColName)
+		if !ok || (!col.Qualifier.IsEmpty() && col.Qualifier.Name != table.Name) {
+			continue
+		}
+		for _, pk := range table.PKColumns {
+			if pk >= len(table.Fields) {
+				break
+			}
+			field := table.Fields[pk]
+			if col.Name.EqualString(field.Name) {
+				bindVars = append(bindVars, PKBindVar{Name: name, Column: field.Name, Type: field.Type})
+				break
+			}
+		}
+	}
+	return bindVars
+}
+
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
 		PlanID:    PlanInsert,
 		FullQuery: GenerateFullQuery(ins),
+		IsReplace: ins.Action == sqlparser.ReplaceAct,
+		HasOnDup:  len(ins.OnDup) > 0,
 	}
 
 	tableName := sqlparser.GetTableName(ins.Table)
@@ -149,6 +205,13 @@ func analyzeShow(show *sqlparser.Show, dbName string) (plan *Plan, err error) {
 			PlanID:    PlanShow,
 			FullQuery: GenerateFullQuery(show),
 		}, nil
+	case *sqlparser.ShowLegacy:
+		switch strings.ToLower(showInternal.Type) {
+		case "vitess_usage":
+			return &Plan{PlanID: PlanShowUsage}, nil
+		case "vitess_tablet queues":
+			return &Plan{PlanID: PlanShowQueues}, nil
+		}
 	}
 	return &Plan{PlanID: PlanOtherRead}, nil
 }
@@ -175,6 +238,18 @@ func analyzeSet(set *sqlparser.Set) (plan *Plan) {
 	}
 }
 
+func analyzeVExplain(vexplain *sqlparser.VExplainStmt, tables map[string]*schema.Table, isReservedConn bool, dbName string) (plan *Plan, err error) {
+	explained, err := Build(vexplain.Statement, tables, isReservedConn, dbName)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{
+		PlanID:    PlanVExplain,
+		Table:     explained.Table,
+		Explained: explained,
+	}, nil
+}
+
 func lookupTable(tableExprs sqlparser.TableExprs, tables map[string]*schema.Table) *schema.Table {
 	if len(tableExprs) > 1 {
 		return nil
@@ -17,6 +17,20 @@ limitations under the License.
 
 package planbuilder
 
+func (cached *PKBindVar) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Column string
+	size += int64(len(cached.Column))
+	return size
+}
 func (cached *Permission) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -35,7 +49,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(152)
+		size += int64(184)
 	}
 	// field Table *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Table
 	size += cached.Table.CachedSize(true)
@@ -54,5 +68,14 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	size += cached.NextCount.CachedSize(false)
 	// field WhereClause *vitess.io/vitess/go/vt/sqlparser.ParsedQuery
 	size += cached.WhereClause.CachedSize(true)
+	// field Explained *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.Plan
+	size += cached.Explained.CachedSize(true)
+	// field PKBindVars []vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.PKBindVar
+	{
+		size += int64(cap(cached.PKBindVars)) * int64(40)
+		for _, elem := range cached.PKBindVars {
+			size += elem.CachedSize(false)
+		}
+	}
 	return size
 }
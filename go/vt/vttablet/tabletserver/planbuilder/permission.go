@@ -56,7 +56,7 @@ func BuildPermissions(stmt sqlparser.Statement) []Permission {
 			permissions = buildTableNamePermissions(t, tableacl.ADMIN, permissions)
 		}
 	case *sqlparser.OtherAdmin, *sqlparser.CallProc, *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback,
-		*sqlparser.Load, *sqlparser.Savepoint, *sqlparser.Release, *sqlparser.SRollback, *sqlparser.Set, *sqlparser.Show,
+		*sqlparser.Load, *sqlparser.LoadData, *sqlparser.Savepoint, *sqlparser.Release, *sqlparser.SRollback, *sqlparser.Set, *sqlparser.Show,
 		*sqlparser.OtherRead, sqlparser.Explain:
 		// no op
 	default:
@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -72,6 +73,15 @@ const (
 	PlanLockTables
 	PlanUnlockTables
 	PlanCallProc
+	// PlanVExplain is for "vexplain" statements. The explained
+	// statement is planned but not executed.
+	PlanVExplain
+	// PlanShowUsage is for "show vitess_usage" statements, which
+	// return the resource usage per table and caller.
+	PlanShowUsage
+	// PlanShowQueues is for "show vitess_tablet queues" statements, which
+	// return the state of the queues where requests can wait in the tablet.
+	PlanShowQueues
 	NumPlans
 )
 
@@ -102,6 +112,9 @@ var planName = []string{
 	"LockTables",
 	"UnlockTables",
 	"CallProcedure",
+	"VExplain",
+	"ShowUsage",
+	"ShowQueues",
 }
 
 func (pt PlanType) String() string {
@@ -153,6 +166,28 @@ type Plan struct {
 	// WhereClause is set for DMLs. It is used by the hot row protection
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
+
+	// Explained is the plan of the statement inside a vexplain.
+	// It is only set for PlanVExplain.
+	Explained *Plan
+
+	// PKBindVars are the bind variables compared with a primary key
+	// column in the WHERE clause. Their types are validated against
+	// the type of the column before executing the query.
+	PKBindVars []PKBindVar
+
+	// IsReplace and HasOnDup are set for the inserts that can delete or
+	// update the existing rows: REPLACE and INSERT ... ON DUPLICATE KEY
+	// UPDATE.
+	IsReplace bool
+	HasOnDup  bool
+}
+
+// PKBindVar is a bind variable compared with a primary key column.
+type PKBindVar struct {
+	Name   string
+	Column string
+	Type   querypb.Type
 }
 
 // TableName returns the table name for the plan.
@@ -202,6 +237,8 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		plan = &Plan{PlanID: PlanDDL, FullQuery: fullQuery}
 	case *sqlparser.Show:
 		plan, err = analyzeShow(stmt, dbName)
+	case *sqlparser.VExplainStmt:
+		plan, err = analyzeVExplain(stmt, tables, isReservedConn, dbName)
 	case *sqlparser.OtherRead, sqlparser.Explain:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
@@ -212,7 +249,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		plan, err = &Plan{PlanID: PlanRelease}, nil
 	case *sqlparser.SRollback:
 		plan, err = &Plan{PlanID: PlanSRollback}, nil
-	case *sqlparser.Load:
+	case *sqlparser.Load, *sqlparser.LoadData:
 		plan, err = &Plan{PlanID: PlanLoad}, nil
 	case *sqlparser.Flush:
 		plan, err = &Plan{PlanID: PlanFlush, FullQuery: GenerateFullQuery(stmt)}, nil
@@ -254,6 +291,7 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 			return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "select with lock not allowed for streaming")
 		}
 		plan.Table = lookupTable(stmt.From, tables)
+		plan.PKBindVars = analyzePKBindVars(stmt.Where, plan.Table)
 	case *sqlparser.OtherRead, *sqlparser.Show, *sqlparser.Union, *sqlparser.CallProc, sqlparser.Explain:
 		// pass
 	default:
@@ -31,9 +31,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // MarshalJSON returns a JSON of the given Plan.
@@ -47,6 +50,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
 		NextCount   string                 `json:",omitempty"`
 		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		Explained   *Plan                  `json:",omitempty"`
 	}{
 		PlanID:      p.PlanID,
 		TableName:   p.TableName(),
@@ -54,6 +58,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		FieldQuery:  p.FieldQuery,
 		FullQuery:   p.FullQuery,
 		WhereClause: p.WhereClause,
+		Explained:   p.Explained,
 	}
 	if !p.NextCount.IsNull() {
 		b, _ := p.NextCount.MarshalJSON()
@@ -268,6 +273,54 @@ func TestMessageStreamingPlan(t *testing.T) {
 	}
 }
 
+func TestPKBindVars(t *testing.T) {
+	tables := map[string]*schema.Table{
+		"t": {
+			Name: sqlparser.NewTableIdent("t"),
+			Fields: []*querypb.Field{
+				{Name: "id", Type: sqltypes.Int64},
+				{Name: "name", Type: sqltypes.VarChar},
+				{Name: "val", Type: sqltypes.Int64},
+			},
+			PKColumns: []int{0, 1},
+		},
+	}
+	testcases := []struct {
+		query string
+		want  []PKBindVar
+	}{{
+		query: "select * from t where id = :id and name = :name and val = :val",
+		want: []PKBindVar{
+			{Name: "id", Column: "id", Type: sqltypes.Int64},
+			{Name: "name", Column: "name", Type: sqltypes.VarChar},
+		},
+	}, {
+		query: "select * from t where :id = t.id and name in ::names",
+		want: []PKBindVar{
+			{Name: "id", Column: "id", Type: sqltypes.Int64},
+			{Name: "names", Column: "name", Type: sqltypes.VarChar},
+		},
+	}, {
+		query: "update t set val = :val where id = :id or name = :name",
+	}, {
+		query: "delete from t where id > :id and id = 1",
+	}, {
+		query: "delete from t where id = :id",
+		want:  []PKBindVar{{Name: "id", Column: "id", Type: sqltypes.Int64}},
+	}, {
+		query: "select * from t join u on t.id = u.id where t.id = :id",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			plan, err := Build(stmt, tables, false, "dbName")
+			require.NoError(t, err)
+			require.Equal(t, tc.want, plan.PKBindVars)
+		})
+	}
+}
+
 func loadSchema(name string) map[string]*schema.Table {
 	b, err := ioutil.ReadFile(locateFile(name))
 	if err != nil {
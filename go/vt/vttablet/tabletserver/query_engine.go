@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -41,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/tableacl"
 	tacl "vitess.io/vitess/go/vt/tableacl/acl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/extauthz"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -138,11 +140,15 @@ type QueryEngine struct {
 	// tableaclExemptCount count the number of accesses allowed
 	// based on membership in the superuser ACL
 	tableaclExemptCount  sync2.AtomicInt64
-	strictTableACL       bool
+	strictTableACL       sync2.AtomicBool
 	enableTableACLDryRun bool
 	// TODO(sougou) There are two acl packages. Need to rename.
 	exemptACL tacl.ACL
 
+	// externalAuthz asks an external policy engine, if one is
+	// configured, whether queries are allowed.
+	externalAuthz extauthz.Authorizer
+
 	strictTransTables bool
 
 	consolidatorMode            sync2.AtomicString
@@ -152,7 +158,7 @@ type QueryEngine struct {
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
 
 	// Loggers
-	accessCheckerLogger *logutil.ThrottledLogger
+	accessCheckerLogger *logutil.AggregatedLogger
 }
 
 // NewQueryEngine creates a new QueryEngine.
@@ -176,12 +182,15 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
-	qe.consolidatorMode.Set(config.Consolidator)
+	// An invalid mode is rejected by config.Verify, and disables the consolidator otherwise.
+	consolidatorMode, _ := tabletenv.ParseConsolidatorMode(config.Consolidator)
+	qe.consolidatorMode.Set(consolidatorMode)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
 	qe.txSerializer = txserializer.New(env)
+	qe.externalAuthz = extauthz.New(env)
 
-	qe.strictTableACL = config.StrictTableACL
+	qe.strictTableACL.Set(config.StrictTableACL)
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
 
 	qe.strictTransTables = config.EnforceStrictTransTables
@@ -205,7 +214,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 
 	planbuilder.PassthroughDMLs = config.PassthroughDML
 
-	qe.accessCheckerLogger = logutil.NewThrottledLogger("accessChecker", 1*time.Second)
+	qe.accessCheckerLogger = logutil.NewAggregatedLogger("accessChecker", 1*time.Minute)
 
 	env.Exporter().NewGaugeFunc("MaxResultSize", "Query engine max result size", qe.maxResultSize.Get)
 	env.Exporter().NewGaugeFunc("WarnResultSize", "Query engine warn result size", qe.warnResultSize.Get)
@@ -218,6 +227,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	env.Exporter().NewGaugeFunc("QueryCacheSize", "Query engine query cache size", qe.plans.UsedCapacity)
 	env.Exporter().NewGaugeFunc("QueryCacheCapacity", "Query engine query cache capacity", qe.plans.MaxCapacity)
 	env.Exporter().NewCounterFunc("QueryCacheEvictions", "Query engine query cache evictions", qe.plans.Evictions)
+	env.Exporter().NewGaugeFunc("ConsolidatorWaiting", "Number of queries waiting for the result of an identical query", qe.consolidator.Waiting)
 	qe.queryCounts = env.Exporter().NewCountersWithMultiLabels("QueryCounts", "query counts", []string{"Table", "Plan"})
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
@@ -229,6 +239,8 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
 	env.Exporter().HandleFunc("/debug/acl", qe.handleHTTPAclJSON)
+	env.Exporter().HandleFunc("/debug/acl/history", qe.handleHTTPAclHistory)
+	env.Exporter().HandleFunc("/debug/query_rules/history", qe.handleHTTPQueryRulesHistory)
 
 	return qe
 }
@@ -369,6 +381,21 @@ func (qe *QueryEngine) ClearQueryPlanCache() {
 	qe.plans.Clear()
 }
 
+// ClearTablePlans removes the cached plans of the queries on tableName.
+func (qe *QueryEngine) ClearTablePlans(tableName string) {
+	var stale []string
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		if plan.TableName().String() == tableName {
+			stale = append(stale, plan.Original)
+		}
+		return true
+	})
+	for _, sql := range stale {
+		qe.plans.Delete(sql)
+	}
+}
+
 // IsMySQLReachable returns an error if it cannot connect to MySQL.
 // This can be called before opening the QueryEngine.
 func (qe *QueryEngine) IsMySQLReachable() error {
@@ -388,7 +415,32 @@ func (qe *QueryEngine) schemaChanged(tables map[string]*schema.Table, created, a
 	defer qe.mu.Unlock()
 	qe.tables = tables
 	if len(altered) != 0 || len(dropped) != 0 {
-		qe.plans.Clear()
+		qe.clearStalePlans(altered, dropped)
+	}
+}
+
+// clearStalePlans removes the cached plans that may depend on the
+// altered or dropped tables: the plans of the queries on these tables,
+// and the plans without a single table, like the ones of joins. The
+// other plans stay cached.
+func (qe *QueryEngine) clearStalePlans(altered, dropped []string) {
+	changed := make(map[string]bool, len(altered)+len(dropped))
+	for _, tableName := range altered {
+		changed[tableName] = true
+	}
+	for _, tableName := range dropped {
+		changed[tableName] = true
+	}
+	var stale []string
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		if plan.Table == nil || changed[plan.TableName().String()] {
+			stale = append(stale, plan.Original)
+		}
+		return true
+	})
+	for _, sql := range stale {
+		qe.plans.Delete(sql)
 	}
 }
 
@@ -524,6 +576,131 @@ func (qe *QueryEngine) handleHTTPAclJSON(response http.ResponseWriter, request *
 	response.Write(buf.Bytes())
 }
 
+// handleHTTPAclHistory lists the recent table ACL configs. With the from
+// and to parameters, it lists the differences between two versions instead.
+// A POST with a rollback parameter applies a previous version again.
+func (qe *QueryEngine) handleHTTPAclHistory(response http.ResponseWriter, request *http.Request) {
+	if request.Method == "POST" {
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		version, err := strconv.Atoi(request.FormValue("rollback"))
+		if err != nil {
+			http.Error(response, fmt.Sprintf("invalid rollback version: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := tableacl.Rollback(version); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.Infof("Table ACL config rolled back to version %d by %s", version, request.RemoteAddr)
+		response.Write([]byte(fmt.Sprintf("Rolled back to version %d\n", version)))
+		return
+	}
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if request.FormValue("from") == "" {
+		writeHTTPJSON(response, tableacl.History())
+		return
+	}
+	from, to, err := parseHTTPVersions(request)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fromConfig, err := tableacl.ConfigAt(from)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusNotFound)
+		return
+	}
+	toConfig, err := tableacl.ConfigAt(to)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeHTTPJSON(response, tableacl.DiffConfigs(fromConfig, toConfig))
+}
+
+// handleHTTPQueryRulesHistory is the equivalent of handleHTTPAclHistory
+// for the query rules of the source parameter.
+func (qe *QueryEngine) handleHTTPQueryRulesHistory(response http.ResponseWriter, request *http.Request) {
+	source := request.FormValue("source")
+	if request.Method == "POST" {
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		version, err := strconv.Atoi(request.FormValue("rollback"))
+		if err != nil {
+			http.Error(response, fmt.Sprintf("invalid rollback version: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := qe.queryRuleSources.Rollback(source, version); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		qe.ClearQueryPlanCache()
+		log.Infof("Query rules of source %s rolled back to version %d by %s", source, version, request.RemoteAddr)
+		response.Write([]byte(fmt.Sprintf("Rolled back to version %d\n", version)))
+		return
+	}
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if request.FormValue("from") == "" {
+		history, err := qe.queryRuleSources.History(source)
+		if err != nil {
+			http.Error(response, err.Error(), http.StatusNotFound)
+			return
+		}
+		writeHTTPJSON(response, history)
+		return
+	}
+	from, to, err := parseHTTPVersions(request)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fromRules, err := qe.queryRuleSources.RulesAt(source, from)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusNotFound)
+		return
+	}
+	toRules, err := qe.queryRuleSources.RulesAt(source, to)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeHTTPJSON(response, fromRules.Diff(toRules))
+}
+
+// parseHTTPVersions returns the from and to versions of a history request.
+func parseHTTPVersions(request *http.Request) (from, to int, err error) {
+	if from, err = strconv.Atoi(request.FormValue("from")); err != nil {
+		return 0, 0, fmt.Errorf("invalid from version: %v", err)
+	}
+	if to, err = strconv.Atoi(request.FormValue("to")); err != nil {
+		return 0, 0, fmt.Errorf("invalid to version: %v", err)
+	}
+	return from, to, nil
+}
+
+func writeHTTPJSON(response http.ResponseWriter, val interface{}) {
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(val, "", " ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	json.HTMLEscape(buf, b)
+	response.Write(buf.Bytes())
+}
+
 // ServeHTTP lists the most recent, cached queries and their count.
 func (qe *QueryEngine) handleHTTPConsolidations(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
@@ -18,6 +18,7 @@ package tabletserver
 
 import (
 	"context"
+	"encoding/json"
 	"expvar"
 	"fmt"
 	"math/rand"
@@ -34,6 +35,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/cache"
@@ -42,12 +44,15 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/tableacl"
+	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema/schematest"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 )
 
 func TestStrictMode(t *testing.T) {
@@ -116,6 +121,7 @@ func TestGetPlanPanicDuetoEmptyQuery(t *testing.T) {
 }
 
 func addSchemaEngineQueries(db *fakesqldb.DB) {
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
 		Fields: mysql.BaseShowTablesFields,
 		Rows: [][]sqltypes.Value{
@@ -222,6 +228,71 @@ func TestQueryPlanCache(t *testing.T) {
 	qe.ClearQueryPlanCache()
 }
 
+func TestClearTablePlans(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	addSchemaEngineQueries(db)
+
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	require.NoError(t, qe.se.Open())
+	require.NoError(t, qe.Open())
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	for _, query := range []string{"select * from test_table_01", "select * from test_table_02"} {
+		_, err := qe.GetPlan(ctx, logStats, query, false, false /* inReservedConn */)
+		require.NoError(t, err)
+	}
+	qe.plans.Wait()
+	assertPlanCacheSize(t, qe, 2)
+
+	qe.ClearTablePlans("test_table_01")
+	qe.plans.Wait()
+	assertPlanCacheSize(t, qe, 1)
+	require.NotNil(t, qe.getQuery("select * from test_table_02"))
+}
+
+func TestSchemaChangedClearsStalePlans(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	addSchemaEngineQueries(db)
+	db.AddQuery("select * from test_table_01 join test_table_02 where 1 != 1", &sqltypes.Result{})
+
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	require.NoError(t, qe.se.Open())
+	require.NoError(t, qe.Open())
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	queries := []string{
+		"select * from test_table_01",
+		"select * from test_table_02",
+		"select * from test_table_03",
+		"select * from test_table_01 join test_table_02",
+	}
+	for _, query := range queries {
+		_, err := qe.GetPlan(ctx, logStats, query, false, false /* inReservedConn */)
+		require.NoError(t, err)
+	}
+	qe.plans.Wait()
+	assertPlanCacheSize(t, qe, 4)
+
+	// The plans of the altered and dropped tables, and the ones of
+	// the joins are removed.
+	qe.schemaChanged(qe.se.GetSchema(), nil, []string{"test_table_01"}, []string{"test_table_03"})
+	qe.plans.Wait()
+	assertPlanCacheSize(t, qe, 1)
+	require.NotNil(t, qe.getQuery("select * from test_table_02"))
+}
+
 func TestNoQueryPlanCache(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -384,6 +455,87 @@ func TestConsolidationsUIRedaction(t *testing.T) {
 	}
 }
 
+func TestQueryRulesHistoryHTTP(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	qe := newTestQueryEngine(1*time.Second, true, newDBConfigs(db))
+
+	qe.queryRuleSources.RegisterSource("test")
+	qrs := rules.New()
+	qrs.Add(rules.NewQueryRule("first", "r1", rules.QRFail))
+	require.NoError(t, qe.queryRuleSources.SetRules("test", qrs))
+	qrs.Add(rules.NewQueryRule("second", "r2", rules.QRFail))
+	require.NoError(t, qe.queryRuleSources.SetRules("test", qrs))
+
+	response := httptest.NewRecorder()
+	qe.handleHTTPQueryRulesHistory(response, httptest.NewRequest("GET", "/debug/query_rules/history?source=test", nil))
+	var history []struct{ Version int }
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &history))
+	assert.Equal(t, []struct{ Version int }{{1}, {2}}, history)
+
+	response = httptest.NewRecorder()
+	qe.handleHTTPQueryRulesHistory(response, httptest.NewRequest("GET", "/debug/query_rules/history?source=test&from=1&to=2", nil))
+	var diff []string
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &diff))
+	assert.Equal(t, []string{`+ rule "r2"`}, diff)
+
+	response = httptest.NewRecorder()
+	qe.handleHTTPQueryRulesHistory(response, httptest.NewRequest("GET", "/debug/query_rules/history?source=test&from=1&to=5", nil))
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	// The source must write the rules back, else it would undo the rollback.
+	response = httptest.NewRecorder()
+	qe.handleHTTPQueryRulesHistory(response, httptest.NewRequest("POST", "/debug/query_rules/history?source=test&rollback=1", nil))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	var written *rules.Rules
+	require.NoError(t, qe.queryRuleSources.SetRulesWriter("test", func(qrs *rules.Rules) error {
+		written = qrs
+		return nil
+	}))
+	response = httptest.NewRecorder()
+	qe.handleHTTPQueryRulesHistory(response, httptest.NewRequest("POST", "/debug/query_rules/history?source=test&rollback=1", nil))
+	assert.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	current, err := qe.queryRuleSources.Get("test")
+	require.NoError(t, err)
+	assert.Nil(t, current.Find("r2"))
+	assert.True(t, written.Equal(current))
+
+	response = httptest.NewRecorder()
+	qe.handleHTTPQueryRulesHistory(response, httptest.NewRequest("POST", "/debug/query_rules/history?source=unknown&rollback=1", nil))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestAclHistoryHTTP(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	defer tableacl.InitFromProto(tableacl.GetCurrentConfig())
+	db := fakesqldb.New(t)
+	defer db.Close()
+	qe := newTestQueryEngine(1*time.Second, true, newDBConfigs(db))
+
+	require.NoError(t, tableacl.InitFromProto(&tableaclpb.Config{}))
+	require.NoError(t, tableacl.InitFromProto(&tableaclpb.Config{DryRun: true}))
+	history := tableacl.History()
+	from, to := history[len(history)-2].Version, history[len(history)-1].Version
+
+	response := httptest.NewRecorder()
+	qe.handleHTTPAclHistory(response, httptest.NewRequest("GET", fmt.Sprintf("/debug/acl/history?from=%d&to=%d", from, to), nil))
+	var diff []string
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &diff))
+	assert.Equal(t, []string{"~ dry_run: false -> true"}, diff)
+
+	response = httptest.NewRecorder()
+	qe.handleHTTPAclHistory(response, httptest.NewRequest("POST", fmt.Sprintf("/debug/acl/history?rollback=%d", from), nil))
+	assert.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.False(t, tableacl.DryRun())
+
+	response = httptest.NewRecorder()
+	qe.handleHTTPAclHistory(response, httptest.NewRequest("POST", "/debug/acl/history?rollback=x", nil))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
 func BenchmarkPlanCacheThroughput(b *testing.B) {
 	db := fakesqldb.New(b)
 	defer db.Close()
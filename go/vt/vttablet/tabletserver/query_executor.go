@@ -19,6 +19,10 @@ package tabletserver
 import (
 	"fmt"
 	"io"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +32,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
@@ -36,8 +41,11 @@ import (
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/ddlhooks"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/extauthz"
 	p "vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -88,6 +96,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		}
 		qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, int64(reply.RowsAffected), 0)
 		qre.plan.AddStats(1, duration, mysqlTime, reply.RowsAffected, uint64(len(reply.Rows)), 0)
+		qre.recordUserResult("Execute", reply)
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
@@ -96,10 +105,22 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if err := qre.checkBindVarTypes(); err != nil {
+		return nil, err
+	}
+	if err := qre.checkForeignKeys(); err != nil {
+		return nil, err
+	}
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
 		return qre.execNextval()
+	case p.PlanVExplain:
+		return qre.execVExplain()
+	case p.PlanShowUsage:
+		return qre.execShowUsage(), nil
+	case p.PlanShowQueues:
+		return qre.execShowQueues(), nil
 	case p.PlanSelectImpossible:
 		// If the fields did not get cached, we have send the query
 		// to mysql, which you can see below.
@@ -127,7 +148,13 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
 			qre.bindVars[sqltypes.BvSchemaName] = sqltypes.StringBindVariable(qre.tsv.config.DB.DBName)
 		}
-		qr, err := qre.execSelect()
+		var qr *sqltypes.Result
+		var err error
+		if qre.plan.PlanID == p.PlanSelect && qre.tsv.config.ExaminedRowsLimits.Enabled() {
+			qr, err = qre.execSelectWithExaminedRowsLimit()
+		} else {
+			qr, err = qre.execSelect()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -164,7 +191,35 @@ func (qre *QueryExecutor) execAutocommit(f func(conn *StatefulConnection) (*sqlt
 	}
 	defer qre.tsv.te.txPool.RollbackAndRelease(qre.ctx, conn)
 
-	return f(conn)
+	return qre.retryTransient(func() (*sqltypes.Result, error) {
+		return f(conn)
+	})
+}
+
+// retryTransient executes f, and executes it again if it fails with a
+// transient MySQL error, up to -queryserver-config-transient-error-retries
+// times. It must only be used for statements executed in autocommit mode:
+// a deadlock rolls back the whole transaction, so retrying a statement
+// that is part of a transaction would lose its previous statements.
+func (qre *QueryExecutor) retryTransient(f func() (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	retries := qre.tsv.config.Oltp.TransientErrorRetries
+	backoff := qre.tsv.config.Oltp.TransientErrorBackoffSeconds.Get()
+	for attempt := 0; ; attempt++ {
+		qr, err := f()
+		if err == nil || attempt >= retries {
+			return qr, err
+		}
+		class := connpool.ClassifyError(err)
+		if !class.IsTransient() {
+			return nil, err
+		}
+		qre.tsv.Stats().TransientErrorRetries.Add(class.String(), 1)
+		select {
+		case <-qre.ctx.Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+	}
 }
 
 func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
@@ -244,6 +299,16 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	if err := qre.checkBindVarTypes(); err != nil {
+		return err
+	}
+
+	switch qre.plan.PlanID {
+	case p.PlanShowUsage:
+		return callback(qre.execShowUsage())
+	case p.PlanShowQueues:
+		return callback(qre.execShowQueues())
+	}
 
 	// if we have a transaction id, let's use the txPool for this query
 	var conn *connpool.DBConn
@@ -267,7 +332,46 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	if err != nil {
 		return err
 	}
-	return qre.execStreamSQL(conn, sql, callback)
+	var rows, size int64
+	var limitErr error
+	limit := qre.streamLimit()
+	err = qre.execStreamSQL(conn, sql, func(qr *sqltypes.Result) error {
+		qre.recordUserResult("Stream", qr)
+		rows += int64(len(qr.Rows))
+		size += resultBytes(qr)
+		if limitErr = qre.verifyStreamLimit(limit, rows, size); limitErr != nil {
+			// Kill the query rather than letting MySQL produce rows
+			// that would be discarded. Only the query is killed, so
+			// that the connection, and its transaction if any, stay
+			// usable.
+			qre.tsv.Stats().StreamLimitKills.Add(qre.userTableLabels("Stream")[:2], 1)
+			conn.KillQuery(limitErr.Error(), time.Since(qre.logStats.StartTime))
+			return limitErr
+		}
+		return callback(qr)
+	})
+	if limitErr != nil {
+		return limitErr
+	}
+	return err
+}
+
+// streamLimit returns the limit of the rows and bytes streamed by the
+// query, which depends on its table and caller.
+func (qre *QueryExecutor) streamLimit() tabletenv.StreamLimit {
+	labels := qre.userTableLabels("Stream")
+	return qre.tsv.config.StreamLimits.Get(labels[0], labels[1])
+}
+
+func (qre *QueryExecutor) verifyStreamLimit(limit tabletenv.StreamLimit, rows, size int64) error {
+	username := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qre.ctx))
+	if limit.MaxRows > 0 && rows > int64(limit.MaxRows) {
+		return mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "caller id: %s: streamed row count exceeded %d", username, limit.MaxRows)
+	}
+	if limit.MaxBytes > 0 && size > int64(limit.MaxBytes) {
+		return mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "caller id: %s: streamed bytes exceeded %d", username, limit.MaxBytes)
+	}
+	return nil
 }
 
 // MessageStream streams messages from a message table.
@@ -299,6 +403,103 @@ func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) e
 	return nil
 }
 
+// checkBindVarTypes counts the bind variables compared with a primary key
+// column that do not match the type of the column: MySQL would not use the
+// primary key for them, and scan the table instead. In strict mode, they
+// fail the query.
+func (qre *QueryExecutor) checkBindVarTypes() error {
+	for _, pkbv := range qre.plan.PKBindVars {
+		bv := qre.bindVars[pkbv.Name]
+		if bv == nil {
+			continue
+		}
+		values := bv.Values
+		if bv.Type != querypb.Type_TUPLE {
+			values = []*querypb.Value{{Type: bv.Type, Value: bv.Value}}
+		}
+		for _, v := range values {
+			if bindVarMatchesType(v, pkbv.Type) {
+				continue
+			}
+			qre.tsv.stats.BindVarTypeMismatches.Add([]string{qre.plan.TableName().String(), pkbv.Column}, 1)
+			if qre.tsv.config.StrictBindVarTypes {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "bind variable %s of type %v does not match the type %v of the primary key column %s", pkbv.Name, v.Type, pkbv.Type, pkbv.Column)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// checkForeignKeys rejects the updates and the deletes of the parent
+// tables whose foreign keys change the child rows in the block foreign
+// key mode: MySQL doesn't write the changes of the child rows to the
+// binlogs, so that vreplication and the vstreams would miss them. A
+// REPLACE deletes the rows it replaces, and an INSERT ... ON DUPLICATE
+// KEY UPDATE updates the rows it conflicts with.
+func (qre *QueryExecutor) checkForeignKeys() error {
+	if qre.tsv.config.ForeignKeyMode != tabletenv.ForeignKeyBlock {
+		return nil
+	}
+	var cascades func(*schema.ForeignKey) bool
+	var action string
+	switch qre.plan.PlanID {
+	case p.PlanUpdate, p.PlanUpdateLimit:
+		cascades, action = (*schema.ForeignKey).CascadesUpdate, "updating"
+	case p.PlanDelete, p.PlanDeleteLimit:
+		cascades, action = (*schema.ForeignKey).CascadesDelete, "deleting"
+	case p.PlanInsert:
+		switch {
+		case qre.plan.IsReplace:
+			cascades, action = (*schema.ForeignKey).CascadesDelete, "replacing"
+		case qre.plan.HasOnDup:
+			cascades, action = (*schema.ForeignKey).CascadesUpdate, "updating"
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+	for _, perm := range qre.plan.Permissions {
+		if perm.Role != tableacl.WRITER {
+			continue
+		}
+		for _, fk := range qre.tsv.se.ChildForeignKeys(perm.TableName) {
+			if cascades(fk) {
+				return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s the rows of table %s is not allowed: foreign key %s of table %s changes the child rows", action, perm.TableName, fk.Name, fk.ChildTable)
+			}
+		}
+	}
+	return nil
+}
+
+// bindVarMatchesType returns true if MySQL can compare v with a column of
+// type typ without converting the column. Strings and decimals match an
+// integer column if they are integers.
+func bindVarMatchesType(v *querypb.Value, typ querypb.Type) bool {
+	switch {
+	case v.Type == querypb.Type_NULL_TYPE:
+		return true
+	case sqltypes.IsIntegral(typ):
+		if sqltypes.IsIntegral(v.Type) {
+			return true
+		}
+		if !sqltypes.IsQuoted(v.Type) && v.Type != querypb.Type_DECIMAL {
+			return false
+		}
+		var err error
+		if sqltypes.IsUnsigned(typ) {
+			_, err = strconv.ParseUint(string(v.Value), 10, 64)
+		} else {
+			_, err = strconv.ParseInt(string(v.Value), 10, 64)
+		}
+		return err == nil
+	case sqltypes.IsQuoted(typ):
+		return sqltypes.IsQuoted(v.Type)
+	}
+	return true
+}
+
 // checkPermissions returns an error if the query does not pass all checks
 // (query blacklisting, table ACL).
 func (qre *QueryExecutor) checkPermissions() error {
@@ -323,6 +524,17 @@ func (qre *QueryExecutor) checkPermissions() error {
 		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
 	}
 
+	if err := qre.checkTableACL(username); err != nil {
+		return err
+	}
+	// The external authorization service is asked regardless of the
+	// exemptions of the table ACL.
+	return qre.checkExternalAuthz(callerid.ImmediateCallerIDFromContext(qre.ctx))
+}
+
+// checkTableACL checks the table ACL of the tables of the query for the
+// immediate caller.
+func (qre *QueryExecutor) checkTableACL(username string) error {
 	// Skip ACL check for queries against the dummy dual table
 	if qre.plan.TableName().String() == "dual" {
 		return nil
@@ -337,7 +549,7 @@ func (qre *QueryExecutor) checkPermissions() error {
 
 	callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
 	if callerID == nil {
-		if qre.tsv.qe.strictTableACL {
+		if qre.tsv.qe.strictTableACL.Get() {
 			return vterrors.Errorf(vtrpcpb.Code_UNAUTHENTICATED, "missing caller id")
 		}
 		return nil
@@ -349,20 +561,55 @@ func (qre *QueryExecutor) checkPermissions() error {
 		return nil
 	}
 
+	// Skip the ACL check if the caller id has the admin role of the table ACL config.
+	if tableacl.IsAdmin(callerID) {
+		qre.tsv.qe.tableaclExemptCount.Add(1)
+		return nil
+	}
+
 	for i, auth := range qre.plan.Authorized {
 		if err := qre.checkAccess(auth, qre.plan.Permissions[i].TableName, callerID); err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
+// checkExternalAuthz asks the external authorization service, if there
+// is one, whether the caller may run the query on each of its tables.
+// callerID may be nil.
+func (qre *QueryExecutor) checkExternalAuthz(callerID *querypb.VTGateCallerID) error {
+	if _, ok := qre.tsv.qe.externalAuthz.(extauthz.AllowAll); ok {
+		return nil
+	}
+	sql, _ := sqlparser.SplitMarginComments(qre.query)
+	for _, perm := range qre.plan.Permissions {
+		if perm.TableName == "dual" {
+			continue
+		}
+		req := &extauthz.Request{
+			User:     callerID.GetUsername(),
+			Groups:   callerID.GetGroups(),
+			Table:    perm.TableName,
+			PlanType: qre.plan.PlanID.String(),
+			SQL:      sql,
+		}
+		if err := qre.tsv.qe.externalAuthz.Authorize(qre.ctx, req); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
-		if qre.tsv.qe.enableTableACLDryRun {
+		if qre.tsv.qe.enableTableACLDryRun || tableacl.DryRun() {
 			qre.tsv.Stats().TableaclPseudoDenied.Add(statsKey, 1)
+			if tableName != "dual" {
+				qre.tsv.qe.accessCheckerLogger.Infof("table acl dry run: %q %v (effective caller id: %v) cannot run %v on table %q",
+					callerID.Username, callerID.Groups, callerid.EffectiveCallerIDFromContext(qre.ctx), qre.plan.PlanID, tableName)
+			}
 			return nil
 		}
 
@@ -371,7 +618,7 @@ func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName
 			return nil
 		}
 
-		if qre.tsv.qe.strictTableACL {
+		if qre.tsv.qe.strictTableACL.Get() {
 			errStr := fmt.Sprintf("table acl error: %q %v cannot run %v on table %q", callerID.Username, callerID.Groups, qre.plan.PlanID, tableName)
 			qre.tsv.Stats().TableaclDenied.Add(statsKey, 1)
 			qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
@@ -385,7 +632,7 @@ func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName
 
 func (qre *QueryExecutor) execDDL(conn *StatefulConnection) (*sqltypes.Result, error) {
 	defer func() {
-		if err := qre.tsv.se.Reload(qre.ctx); err != nil {
+		if err := qre.reloadDDLTables(); err != nil {
 			log.Errorf("failed to reload schema %v", err)
 		}
 	}()
@@ -400,7 +647,14 @@ func (qre *QueryExecutor) execDDL(conn *StatefulConnection) (*sqltypes.Result, e
 			return nil, err
 		}
 	}
+	event, err := qre.ddlHookEvent(sql)
+	if err != nil {
+		return nil, err
+	}
 	result, err := qre.execStatefulConn(conn, sql, true)
+	if event != nil {
+		ddlhooks.RunPost(qre.ctx, event, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -415,6 +669,48 @@ func (qre *QueryExecutor) execDDL(conn *StatefulConnection) (*sqltypes.Result, e
 	return result, nil
 }
 
+// reloadDDLTables reloads the schema of the tables affected by the DDL,
+// or the whole schema if they are not known.
+func (qre *QueryExecutor) reloadDDLTables() error {
+	stmt, err := sqlparser.Parse(qre.query)
+	if ddl, ok := stmt.(sqlparser.DDLStatement); err == nil && ok {
+		var tableNames []string
+		for _, table := range ddl.AffectedTables() {
+			if !table.Qualifier.IsEmpty() && table.Qualifier.String() != qre.tsv.config.DB.DBName {
+				continue
+			}
+			tableNames = append(tableNames, table.Name.String())
+		}
+		if len(tableNames) > 0 {
+			return qre.tsv.se.ReloadTables(qre.ctx, tableNames)
+		}
+	}
+	return qre.tsv.se.Reload(qre.ctx)
+}
+
+// ddlHookEvent runs the pre phase of the DDL hooks, and returns the
+// event to use for the post phase. It returns nil if the hooks are not
+// enabled.
+func (qre *QueryExecutor) ddlHookEvent(sql string) (*ddlhooks.Event, error) {
+	if !ddlhooks.Enabled() {
+		return nil, nil
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(sqlparser.DDLStatement)
+	if !ok {
+		return nil, nil
+	}
+	target := qre.tsv.sm.Target()
+	event := ddlhooks.NewEvent(target.Keyspace, target.Shard, "", sql, ddl)
+	if err := ddlhooks.Run(qre.ctx, event); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "DDL aborted: %v", err)
+	}
+	return event, nil
+}
+
 func (qre *QueryExecutor) execLoad(conn *StatefulConnection) (*sqltypes.Result, error) {
 	result, err := qre.execStatefulConn(conn, qre.query, true)
 	if err != nil {
@@ -532,7 +828,9 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	if err != nil {
 		return nil, err
 	}
-	return qre.execDBConn(conn, sql, true)
+	return qre.retryTransient(func() (*sqltypes.Result, error) {
+		return qre.execDBConn(conn, sql, true)
+	})
 }
 
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
@@ -564,13 +862,46 @@ func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	return nil
 }
 
+// execVExplain returns the plan of the explained statement, along with
+// the query that would be sent to MySQL, without executing it.
+func (qre *QueryExecutor) execVExplain() (*sqltypes.Result, error) {
+	explained := qre.plan.Explained
+	var query string
+	if explained.FullQuery != nil {
+		if explained.PlanID.IsSelect() {
+			qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(qre.getSelectLimit() + 1)
+		} else if explained.PlanID == p.PlanUpdateLimit || explained.PlanID == p.PlanDeleteLimit {
+			qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(qre.tsv.qe.maxResultSize.Get() + 1)
+		}
+		var err error
+		query, err = explained.FullQuery.GenerateQuery(qre.bindVars, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "PlanId", Type: sqltypes.VarChar},
+			{Name: "TableName", Type: sqltypes.VarChar},
+			{Name: "Query", Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarChar(explained.PlanID.String()),
+			sqltypes.NewVarChar(explained.TableName().String()),
+			sqltypes.NewVarChar(query),
+		}},
+	}, nil
+}
+
 func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 	conn, err := qre.getConn()
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Recycle()
-	return qre.execDBConn(conn, qre.query, true)
+	return qre.retryTransient(func() (*sqltypes.Result, error) {
+		return qre.execDBConn(conn, qre.query, true)
+	})
 }
 
 func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
@@ -605,13 +936,26 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	return nil, err
 }
 
+// shouldConsolidate returns true if identical concurrent reads
+// should share a single execution. In notOnPrimary mode, primaries
+// skip consolidation because a consolidated read may return rows
+// older than a write committed while it was waiting.
+func (qre *QueryExecutor) shouldConsolidate() bool {
+	switch qre.tsv.qe.consolidatorMode.Get() {
+	case tabletenv.Enable:
+		return true
+	case tabletenv.NotOnPrimary:
+		return qre.tabletType != topodatapb.TabletType_MASTER
+	}
+	return false
+}
+
 func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(parsedQuery, bindVars)
 	if err != nil {
 		return nil, err
 	}
-	// Check tablet type.
-	if cm := qre.tsv.qe.consolidatorMode.Get(); cm == tabletenv.Enable || (cm == tabletenv.NotOnMaster && qre.tabletType != topodatapb.TabletType_MASTER) {
+	if qre.shouldConsolidate() {
 		q, original := qre.tsv.qe.consolidator.Create(sqlWithoutComments)
 		if original {
 			defer q.Broadcast()
@@ -621,7 +965,9 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 				q.Err = err
 			} else {
 				defer conn.Recycle()
-				q.Result, q.Err = qre.execDBConn(conn, sql, false)
+				q.Result, q.Err = qre.retryTransient(func() (*sqltypes.Result, error) {
+					return qre.execDBConn(conn, sql, false)
+				})
 			}
 		} else {
 			logStats.QuerySources |= tabletenv.QuerySourceConsolidator
@@ -639,7 +985,9 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 		return nil, err
 	}
 	defer conn.Recycle()
-	res, err := qre.execDBConn(conn, sql, false)
+	res, err := qre.retryTransient(func() (*sqltypes.Result, error) {
+		return qre.execDBConn(conn, sql, false)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -674,10 +1022,44 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	withoutComments := query
 	buf.WriteString(query)
 	buf.WriteString(qre.marginComments.Trailing)
+	if qre.tsv.config.AttributionComments {
+		qre.writeAttributionComment(&buf)
+	}
 	fullSQL := buf.String()
 	return fullSQL, withoutComments, nil
 }
 
+// writeAttributionComment writes a comment that attributes the query to
+// its caller, workload and transaction, so that they show in the MySQL
+// slow log and performance_schema. The comment is in the sqlcommenter
+// format: the keys are sorted and the values are URL encoded.
+func (qre *QueryExecutor) writeAttributionComment(buf *strings.Builder) {
+	ef := callerid.EffectiveCallerIDFromContext(qre.ctx)
+	var transactionID, workload string
+	if qre.connID != 0 {
+		transactionID = strconv.FormatInt(qre.connID, 10)
+	}
+	if w := qre.options.GetWorkload(); w != querypb.ExecuteOptions_UNSPECIFIED {
+		workload = strings.ToLower(w.String())
+	}
+	attributes := []struct{ key, value string }{
+		{"caller", callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qre.ctx))},
+		{"component", callerid.GetComponent(ef)},
+		{"principal", callerid.GetPrincipal(ef)},
+		{"transaction_id", transactionID},
+		{"workload", workload},
+	}
+	var parts []string
+	for _, attribute := range attributes {
+		if attribute.value != "" {
+			parts = append(parts, fmt.Sprintf("%s='%s'", attribute.key, url.QueryEscape(attribute.value)))
+		}
+	}
+	if len(parts) > 0 {
+		fmt.Fprintf(buf, " /*%s*/", strings.Join(parts, ","))
+	}
+}
+
 func rewriteOUTParamError(err error) error {
 	sqlErr, ok := err.(*mysql.SQLError)
 	if !ok {
@@ -704,18 +1086,15 @@ func (qre *QueryExecutor) execCallProc() (*sqltypes.Result, error) {
 	if err != nil {
 		return nil, rewriteOUTParamError(err)
 	}
-	if !qr.IsMoreResultsExists() {
-		if qr.IsInTransaction() {
-			conn.Close()
-			return nil, vterrors.New(vtrpcpb.Code_CANCELED, "Transaction not concluded inside the stored procedure, leaking transaction from stored procedure is not allowed")
-		}
-		return qr, nil
-	}
-	err = qre.drainResultSetOnConn(conn)
+	qr, err = qre.fetchProcResult(conn, qr)
 	if err != nil {
 		return nil, err
 	}
-	return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "Multi-Resultset not supported in stored procedure")
+	if qr.IsInTransaction() {
+		conn.Close()
+		return nil, vterrors.New(vtrpcpb.Code_CANCELED, "Transaction not concluded inside the stored procedure, leaking transaction from stored procedure is not allowed")
+	}
+	return qr, nil
 }
 
 func (qre *QueryExecutor) execProc(conn *StatefulConnection) (*sqltypes.Result, error) {
@@ -728,31 +1107,76 @@ func (qre *QueryExecutor) execProc(conn *StatefulConnection) (*sqltypes.Result,
 	if err != nil {
 		return nil, rewriteOUTParamError(err)
 	}
-	if !qr.IsMoreResultsExists() {
-		afterInTx := qr.IsInTransaction()
-		if beforeInTx != afterInTx {
-			conn.Close()
-			return nil, vterrors.New(vtrpcpb.Code_CANCELED, "Transaction state change inside the stored procedure is not allowed")
-		}
-		return qr, nil
-	}
-	err = qre.drainResultSetOnConn(conn.UnderlyingDBConn())
+	qr, err = qre.fetchProcResult(conn.UnderlyingDBConn(), qr)
 	if err != nil {
 		return nil, err
 	}
-	return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "Multi-Resultset not supported in stored procedure")
+	afterInTx := qr.IsInTransaction()
+	if beforeInTx != afterInTx {
+		conn.Close()
+		return nil, vterrors.New(vtrpcpb.Code_CANCELED, "Transaction state change inside the stored procedure is not allowed")
+	}
+	return qr, nil
 }
 
-func (qre *QueryExecutor) drainResultSetOnConn(conn *connpool.DBConn) error {
-	more := true
-	for more {
-		qr, err := conn.FetchNext(qre.ctx, int(qre.getSelectLimit()), true)
+// fetchProcResult reads all the result sets returned by a stored
+// procedure, the first of which is qr, and returns them as one result:
+// MySQL returns a result set for every select of the procedure, and
+// then the status of the call. The rows of the result sets that have
+// the same columns are appended to the first one. A procedure whose
+// result sets have different columns is rejected, since the result of a
+// query holds only one set of fields. The result is returned with the
+// status flags of the call, which report the transaction state after it.
+func (qre *QueryExecutor) fetchProcResult(conn *connpool.DBConn, qr *sqltypes.Result) (*sqltypes.Result, error) {
+	maxRows := int(qre.getSelectLimit())
+	var result *sqltypes.Result
+	mismatched := false
+	for {
+		switch {
+		case len(qr.Fields) == 0:
+		case result == nil:
+			result = qr
+		case sameColumns(result.Fields, qr.Fields):
+			result.Rows = append(result.Rows, qr.Rows...)
+			result.RowsAffected += qr.RowsAffected
+			if len(result.Rows) > maxRows {
+				return nil, mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "Row count exceeded %d", maxRows)
+			}
+		default:
+			// The remaining result sets are still read, so that the
+			// connection can be reused.
+			mismatched = true
+		}
+		if !qr.IsMoreResultsExists() {
+			break
+		}
+		var err error
+		qr, err = conn.FetchNext(qre.ctx, maxRows, true)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		more = qr.IsMoreResultsExists()
 	}
-	return nil
+	if mismatched {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "stored procedure returned result sets with different columns, only result sets with the same columns are supported")
+	}
+	if result == nil {
+		return qr, nil
+	}
+	result.StatusFlags = qr.StatusFlags
+	return result, nil
+}
+
+// sameColumns returns true if the fields have the same names and types.
+func sameColumns(f1, f2 []*querypb.Field) bool {
+	if len(f1) != len(f2) {
+		return false
+	}
+	for i, f := range f1 {
+		if f.Name != f2[i].Name || f.Type != f2[i].Type {
+			return false
+		}
+	}
+	return true
 }
 
 func (qre *QueryExecutor) getSelectLimit() int64 {
@@ -812,14 +1236,210 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 	return nil
 }
 
-func (qre *QueryExecutor) recordUserQuery(queryType string, duration int64) {
+// userTableLabels returns the labels of the per table and caller stats.
+func (qre *QueryExecutor) userTableLabels(queryType string) []string {
 	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qre.ctx))
 	if username == "" {
 		username = callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qre.ctx))
 	}
 	tableName := qre.plan.TableName().String()
-	qre.tsv.Stats().UserTableQueryCount.Add([]string{tableName, username, queryType}, 1)
-	qre.tsv.Stats().UserTableQueryTimesNs.Add([]string{tableName, username, queryType}, duration)
+	return []string{tableName, username, queryType}
+}
+
+func (qre *QueryExecutor) recordUserQuery(queryType string, duration int64) {
+	labels := qre.userTableLabels(queryType)
+	qre.tsv.Stats().UserTableQueryCount.Add(labels, 1)
+	qre.tsv.Stats().UserTableQueryTimesNs.Add(labels, duration)
+	qre.tsv.Stats().UserTableMysqlTimesNs.Add(labels, int64(qre.logStats.MysqlResponseTime))
+}
+
+// recordUserResult attributes the rows and bytes of a result to the
+// table and caller of the query. For streaming queries, it is called
+// for every chunk of the result.
+func (qre *QueryExecutor) recordUserResult(queryType string, qr *sqltypes.Result) {
+	labels := qre.userTableLabels(queryType)
+	qre.tsv.Stats().UserTableRowsReturned.Add(labels, int64(len(qr.Rows)))
+	qre.tsv.Stats().UserTableRowsAffected.Add(labels, int64(qr.RowsAffected))
+	qre.tsv.Stats().UserTableBytesReturned.Add(labels, resultBytes(qr))
+}
+
+// resultBytes returns the size of the values of a result.
+func resultBytes(qr *sqltypes.Result) int64 {
+	var size int64
+	for _, row := range qr.Rows {
+		for _, v := range row {
+			size += int64(v.Len())
+		}
+	}
+	return size
+}
+
+// execShowUsage returns the resource usage recorded per table and caller
+// since the tablet started, summed over all the query types.
+func (qre *QueryExecutor) execShowUsage() *sqltypes.Result {
+	type key struct{ table, caller string }
+	usage := make(map[key][]int64)
+	counters := []*stats.CountersWithMultiLabels{
+		qre.tsv.Stats().UserTableQueryCount,
+		qre.tsv.Stats().UserTableQueryTimesNs,
+		qre.tsv.Stats().UserTableMysqlTimesNs,
+		qre.tsv.Stats().UserTableRowsReturned,
+		qre.tsv.Stats().UserTableRowsAffected,
+		qre.tsv.Stats().UserTableBytesReturned,
+	}
+	for i, counter := range counters {
+		for labels, count := range counter.Counts() {
+			// The labels are TableName.CallerID.Type, dots in the
+			// values are replaced by the stats package.
+			parts := strings.Split(labels, ".")
+			if len(parts) != 3 {
+				continue
+			}
+			k := key{table: parts[0], caller: parts[1]}
+			if usage[k] == nil {
+				usage[k] = make([]int64, len(counters))
+			}
+			usage[k][i] += count
+		}
+	}
+
+	keys := make([]key, 0, len(usage))
+	for k := range usage {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].table != keys[j].table {
+			return keys[i].table < keys[j].table
+		}
+		return keys[i].caller < keys[j].caller
+	})
+
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "TableName", Type: sqltypes.VarChar},
+			{Name: "CallerID", Type: sqltypes.VarChar},
+			{Name: "Queries", Type: sqltypes.Int64},
+			{Name: "QueryTimeNs", Type: sqltypes.Int64},
+			{Name: "MysqlTimeNs", Type: sqltypes.Int64},
+			{Name: "RowsReturned", Type: sqltypes.Int64},
+			{Name: "RowsAffected", Type: sqltypes.Int64},
+			{Name: "BytesReturned", Type: sqltypes.Int64},
+		},
+	}
+	for _, k := range keys {
+		row := []sqltypes.Value{sqltypes.NewVarChar(k.table), sqltypes.NewVarChar(k.caller)}
+		for _, count := range usage[k] {
+			row = append(row, sqltypes.NewInt64(count))
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result
+}
+
+// execShowQueues returns, for each subsystem where requests can wait in the
+// tablet, how many requests are waiting now, how many waited since the tablet
+// started and for how long, and how many were rejected because of the queue.
+func (qre *QueryExecutor) execShowQueues() *sqltypes.Result {
+	waits := qre.tsv.stats.WaitTimings.Histograms()
+	var throttlerDelays []*stats.Histogram
+	for _, h := range qre.tsv.txThrottler.Delays() {
+		throttlerDelays = append(throttlerDelays, h)
+	}
+	queues := []struct {
+		name       string
+		length     int64
+		waits      []*stats.Histogram
+		rejections int64
+	}{{
+		name:       "HotRowProtection",
+		length:     int64(qre.tsv.qe.txSerializer.GlobalQueueSize()),
+		waits:      []*stats.Histogram{waits["TxSerializer"]},
+		rejections: qre.tsv.qe.txSerializer.Rejections(),
+	}, {
+		name:       "TxPool",
+		length:     qre.tsv.te.txPool.scp.Waiters(),
+		waits:      []*stats.Histogram{waits["TransactionPoolResourceWaitTime"], waits["FoundRowsPoolResourceWaitTime"]},
+		rejections: qre.tsv.te.txPool.Rejections(),
+	}, {
+		name:   "Consolidator",
+		length: qre.tsv.qe.consolidator.Waiting(),
+		waits:  []*stats.Histogram{waits["Consolidations"]},
+	}, {
+		name:       "TxThrottler",
+		length:     qre.tsv.txThrottler.Waiting(),
+		waits:      throttlerDelays,
+		rejections: qre.tsv.txThrottler.Rejections(),
+	}}
+
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Queue", Type: sqltypes.VarChar},
+			{Name: "Length", Type: sqltypes.Int64},
+			{Name: "Waits", Type: sqltypes.Int64},
+			{Name: "WaitP50Ns", Type: sqltypes.Int64},
+			{Name: "WaitP90Ns", Type: sqltypes.Int64},
+			{Name: "WaitP99Ns", Type: sqltypes.Int64},
+			{Name: "Rejections", Type: sqltypes.Int64},
+		},
+	}
+	for _, q := range queues {
+		buckets, cutoffs := mergeHistograms(q.waits)
+		var count int64
+		for _, b := range buckets {
+			count += b
+		}
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(q.name),
+			sqltypes.NewInt64(q.length),
+			sqltypes.NewInt64(count),
+			sqltypes.NewInt64(bucketPercentile(buckets, cutoffs, 50)),
+			sqltypes.NewInt64(bucketPercentile(buckets, cutoffs, 90)),
+			sqltypes.NewInt64(bucketPercentile(buckets, cutoffs, 99)),
+			sqltypes.NewInt64(q.rejections),
+		})
+	}
+	return result
+}
+
+// mergeHistograms adds up the buckets of histograms that share the
+// same cutoffs. Nil histograms are skipped.
+func mergeHistograms(histograms []*stats.Histogram) (buckets, cutoffs []int64) {
+	for _, h := range histograms {
+		if h == nil {
+			continue
+		}
+		if buckets == nil {
+			buckets = make([]int64, len(h.Cutoffs())+1)
+			cutoffs = h.Cutoffs()
+		}
+		for i, b := range h.Buckets() {
+			buckets[i] += b
+		}
+	}
+	return buckets, cutoffs
+}
+
+// bucketPercentile estimates the percentile p of the values counted in
+// buckets: it returns the cutoff of the bucket that contains it, which is
+// an upper bound, or the highest cutoff if it's above all of them.
+// It returns 0 if the buckets are empty.
+func bucketPercentile(buckets, cutoffs []int64, p float64) int64 {
+	var count int64
+	for _, b := range buckets {
+		count += b
+	}
+	if count == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(float64(count) * p / 100))
+	var seen int64
+	for i, b := range buckets {
+		seen += b
+		if seen >= rank && i < len(cutoffs) {
+			return cutoffs[i]
+		}
+	}
+	return cutoffs[len(cutoffs)-1]
 }
 
 // resolveNumber extracts a number from a bind variable or sql value.
@@ -17,12 +17,17 @@ limitations under the License.
 package tabletserver
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -37,10 +42,13 @@ import (
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/ddlhooks"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/extauthz"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -278,6 +286,22 @@ func TestQueryExecutorPlans(t *testing.T) {
 		resultWant: emptyResult,
 		planWant:   "Show",
 		logWant:    "show create table mysql.`user`",
+	}, {
+		input: "vexplain plan select * from test_table where pk = 1",
+		resultWant: sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("PlanId|TableName|Query", "varchar|varchar|varchar"),
+			"Select|test_table|select * from test_table where pk = 1 limit 10001",
+		),
+		planWant: "VExplain",
+		logWant:  "",
+	}, {
+		input: "vexplain all insert into test_table(pk) values (1)",
+		resultWant: sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("PlanId|TableName|Query", "varchar|varchar|varchar"),
+			"Insert|test_table|insert into test_table(pk) values (1)",
+		),
+		planWant: "VExplain",
+		logWant:  "",
 	}}
 	for _, tcase := range testcases {
 		t.Run(tcase.input, func(t *testing.T) {
@@ -323,6 +347,110 @@ func TestQueryExecutorPlans(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorShowUsage(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select * from test_table where pk = 1 limit 10001", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pk|name", "int64|varchar"),
+		"1|abc",
+		"2|de",
+	))
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("a", "b", "c"), callerid.NewImmediateCallerID("d"))
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table where pk = 1", 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+
+	qre = newTestQueryExecutor(ctx, tsv, "show vitess_usage", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "ShowUsage", qre.logStats.PlanType)
+	assert.Equal(t, "", qre.logStats.RewrittenSQL())
+
+	var row []sqltypes.Value
+	for _, r := range got.Rows {
+		if r[0].ToString() == "test_table" && r[1].ToString() == "a" {
+			row = r
+		}
+	}
+	require.NotNil(t, row, "no usage for test_table in %v", got.Rows)
+	assert.Equal(t, "1", row[2].ToString(), "Queries")
+	assert.Equal(t, "2", row[5].ToString(), "RowsReturned")
+	assert.Equal(t, "0", row[6].ToString(), "RowsAffected")
+	assert.Equal(t, "7", row[7].ToString(), "BytesReturned")
+}
+
+func TestQueryExecutorShowQueues(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	tsv.stats.WaitTimings.Add("Consolidations", time.Millisecond)
+	tsv.stats.WaitTimings.Add("Consolidations", 5*time.Millisecond)
+	tsv.stats.WaitTimings.Add("TransactionPoolResourceWaitTime", 5*time.Millisecond)
+	tsv.stats.WaitTimings.Add("FoundRowsPoolResourceWaitTime", 50*time.Millisecond)
+	qre := newTestQueryExecutor(ctx, tsv, "show vitess_tablet queues", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "ShowQueues", qre.logStats.PlanType)
+	require.Len(t, got.Rows, 4)
+	assert.Equal(t, `[VARCHAR("HotRowProtection") INT64(0) INT64(0) INT64(0) INT64(0) INT64(0) INT64(0)]`, fmt.Sprintf("%v", got.Rows[0]))
+	assert.Equal(t, `[VARCHAR("TxPool") INT64(0) INT64(2) INT64(5000000) INT64(50000000) INT64(50000000) INT64(0)]`, fmt.Sprintf("%v", got.Rows[1]))
+	assert.Equal(t, `[VARCHAR("Consolidator") INT64(0) INT64(2) INT64(1000000) INT64(5000000) INT64(5000000) INT64(0)]`, fmt.Sprintf("%v", got.Rows[2]))
+	assert.Equal(t, "TxThrottler", got.Rows[3][0].ToString())
+}
+
+func TestBucketPercentile(t *testing.T) {
+	cutoffs := []int64{10, 100, 1000}
+	assert.EqualValues(t, 0, bucketPercentile([]int64{0, 0, 0, 0}, cutoffs, 50))
+	assert.EqualValues(t, 10, bucketPercentile([]int64{5, 4, 1, 0}, cutoffs, 50))
+	assert.EqualValues(t, 100, bucketPercentile([]int64{5, 4, 1, 0}, cutoffs, 90))
+	assert.EqualValues(t, 1000, bucketPercentile([]int64{5, 4, 1, 0}, cutoffs, 99))
+	assert.EqualValues(t, 1000, bucketPercentile([]int64{0, 0, 0, 3}, cutoffs, 50))
+}
+
+func TestQueryExecutorTransientErrorRetries(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	selectQuery := "select * from test_table limit 10001"
+	db.AddRejectedQuery(selectQuery, mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "deadlock"))
+	insertQuery := "insert into test_table(pk) values (1)"
+	db.AddRejectedQuery(insertQuery, mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "duplicate entry"))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.config.Oltp.TransientErrorRetries = 2
+	tsv.config.Oltp.TransientErrorBackoffSeconds = 0
+
+	retries := tsv.Stats().TransientErrorRetries.Counts()["TxRollback"]
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	_, err := qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deadlock")
+	assert.Equal(t, 3, db.GetQueryCalledNum(selectQuery))
+	assert.Equal(t, retries+2, tsv.Stats().TransientErrorRetries.Counts()["TxRollback"])
+
+	// Other errors are not retried.
+	qre = newTestQueryExecutor(ctx, tsv, "insert into test_table(pk) values (1)", 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum(insertQuery))
+
+	// Statements in a transaction are not retried.
+	target := tsv.sm.Target()
+	txid, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	defer tsv.Commit(ctx, &target, txid)
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table", txid)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Equal(t, 4, db.GetQueryCalledNum(selectQuery))
+}
+
 // TestQueryExecutorSelectImpossible is separate because it's a special case
 // because the "in transaction" case is a no-op.
 func TestQueryExecutorSelectImpossible(t *testing.T) {
@@ -386,6 +514,44 @@ func TestQueryExecutorSelectImpossible(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorDDLHooks(t *testing.T) {
+	var phases []string
+	abort := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := &ddlhooks.Event{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(event))
+		phases = append(phases, event.Phase+" "+strings.Join(event.Tables, ","))
+		if abort {
+			http.Error(w, "denied", http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+	require.NoError(t, flag.Set("ddl_hooks_webhook_url", server.URL))
+	defer flag.Set("ddl_hooks_webhook_url", "")
+
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("alter table test_table add column zipcode int", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// A failing pre phase aborts the DDL.
+	qre := newTestQueryExecutor(ctx, tsv, "alter table test_table add zipcode int", 0)
+	_, err := qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DDL aborted: pre DDL hook failed for alter on test_table")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	assert.Equal(t, []string{"pre test_table"}, phases)
+
+	abort = false
+	phases = nil
+	qre = newTestQueryExecutor(ctx, tsv, "alter table test_table add zipcode int", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pre test_table", "post test_table"}, phases)
+}
+
 func TestQueryExecutorLimitFailure(t *testing.T) {
 	type dbResponse struct {
 		query  string
@@ -1001,6 +1167,102 @@ func TestQueryExecutorTableAclDryRun(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorTableAclAdmins(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{},
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	defer tableacl.InitFromProto(tableacl.GetCurrentConfig())
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group02",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+		Admins: []string{"dba"},
+	}
+	if err := tableacl.InitFromProto(config); err != nil {
+		t.Fatalf("unable to load tableacl config, error: %v", err)
+	}
+
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	tsv := newTestTabletServer(ctx, enableStrictTableACL, db)
+	defer tsv.StopService()
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_PERMISSION_DENIED {
+		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_PERMISSION_DENIED)
+	}
+
+	// The admins are not subject to the table ACLs.
+	ctx = callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "dba"})
+	exemptCount := tsv.qe.tableaclExemptCount.Get()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want: nil", err)
+	}
+	assert.Equal(t, exemptCount+1, tsv.qe.tableaclExemptCount.Get())
+}
+
+func TestQueryExecutorTableAclConfigDryRun(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{},
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	defer tableacl.InitFromProto(tableacl.GetCurrentConfig())
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group02",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+		DryRun: true,
+	}
+	if err := tableacl.InitFromProto(config); err != nil {
+		t.Fatalf("unable to load tableacl config, error: %v", err)
+	}
+
+	tableACLStatsKey := strings.Join([]string{
+		"test_table",
+		"group02",
+		planbuilder.PlanSelect.String(),
+		"u2",
+	}, ".")
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	// The dry run of the config applies even if the tablet enforces the table ACLs.
+	tsv := newTestTabletServer(ctx, enableStrictTableACL, db)
+	defer tsv.StopService()
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	beforeCount := tsv.stats.TableaclPseudoDenied.Counts()[tableACLStatsKey]
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want: nil", err)
+	}
+	afterCount := tsv.stats.TableaclPseudoDenied.Counts()[tableACLStatsKey]
+	if afterCount-beforeCount != 1 {
+		t.Fatalf("table acl pseudo denied count should increase by one. got: %d, want: %d", afterCount, beforeCount+1)
+	}
+}
+
 func TestQueryExecutorBlacklistQRFail(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1108,6 +1370,235 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+// fakeAuthorizer records the requests it gets and denies the ones of denyUser.
+type fakeAuthorizer struct {
+	denyUser string
+	requests []*extauthz.Request
+}
+
+func (fa *fakeAuthorizer) Authorize(ctx context.Context, req *extauthz.Request) error {
+	fa.requests = append(fa.requests, req)
+	if req.User == fa.denyUser {
+		return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "denied")
+	}
+	return nil
+}
+
+func TestQueryExecutorExternalAuthz(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	authorizer := &fakeAuthorizer{denyUser: "u2"}
+	tsv.qe.externalAuthz = authorizer
+
+	ctx = callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1", Groups: []string{"g1"}})
+	qre := newTestQueryExecutor(ctx, tsv, "/* trace */ "+query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []*extauthz.Request{{
+		User:     "u1",
+		Groups:   []string{"g1"},
+		Table:    "test_table",
+		PlanType: "Select",
+		SQL:      query,
+	}}, authorizer.requests)
+
+	ctx = callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+
+	// The users exempted from the table ACL are checked too.
+	tsv.qe.exemptACL, err = (&simpleacl.Factory{}).New([]string{"u2"})
+	require.NoError(t, err)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+
+	// And so are the queries without caller id.
+	qre = newTestQueryExecutor(context.Background(), tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Len(t, authorizer.requests, 4)
+	assert.Equal(t, "", authorizer.requests[3].User)
+
+	// Local queries are not checked.
+	qre = newTestQueryExecutor(tabletenv.LocalContext(), tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Len(t, authorizer.requests, 4)
+}
+
+func TestQueryExecutorShouldConsolidate(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	testcases := []struct {
+		mode       string
+		tabletType topodatapb.TabletType
+		want       bool
+	}{
+		{tabletenv.Enable, topodatapb.TabletType_MASTER, true},
+		{tabletenv.Enable, topodatapb.TabletType_REPLICA, true},
+		{tabletenv.Disable, topodatapb.TabletType_MASTER, false},
+		{tabletenv.Disable, topodatapb.TabletType_REPLICA, false},
+		{tabletenv.NotOnPrimary, topodatapb.TabletType_MASTER, false},
+		{tabletenv.NotOnPrimary, topodatapb.TabletType_REPLICA, true},
+		{tabletenv.NotOnPrimary, topodatapb.TabletType_RDONLY, true},
+		{tabletenv.NotOnMaster, topodatapb.TabletType_MASTER, false},
+		{tabletenv.NotOnMaster, topodatapb.TabletType_REPLICA, true},
+	}
+	for _, tcase := range testcases {
+		tsv.SetConsolidatorMode(tcase.mode)
+		qre := &QueryExecutor{ctx: ctx, tsv: tsv, tabletType: tcase.tabletType}
+		assert.Equal(t, tcase.want, qre.shouldConsolidate(), "%s on %v", tcase.mode, tcase.tabletType)
+	}
+}
+
+func TestQueryExecutorAttributionComments(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := callerid.NewContext(context.Background(), &vtrpcpb.CallerID{Principal: "app", Component: "checkout svc"}, &querypb.VTGateCallerID{Username: "vt_app"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	query := "select * from test_table limit 1000"
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(1000)
+	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	require.NoError(t, err)
+	assert.Equal(t, query, sql)
+
+	tsv.config.AttributionComments = true
+	qre.connID = 12
+	qre.options = &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	qre.marginComments = sqlparser.MarginComments{Trailing: " /* trailing */"}
+	sql, withoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	require.NoError(t, err)
+	assert.Equal(t, query+" /* trailing */ /*caller='vt_app',component='checkout+svc',principal='app',transaction_id='12',workload='olap'*/", sql)
+	assert.Equal(t, query, withoutComments)
+}
+
+func TestQueryExecutorBindVarTypes(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table where pk in ::pks", 0)
+	require.Equal(t, []planbuilder.PKBindVar{{Name: "pks", Column: "pk", Type: sqltypes.Int32}}, qre.plan.PKBindVars)
+
+	testcases := []struct {
+		bv      *querypb.BindVariable
+		matches bool
+	}{
+		{sqltypes.Int64BindVariable(1), true},
+		{sqltypes.StringBindVariable("12"), true},
+		{sqltypes.StringBindVariable("a"), false},
+		{sqltypes.Float64BindVariable(1.5), false},
+		{sqltypes.NullBindVariable, true},
+		{&querypb.BindVariable{Type: querypb.Type_TUPLE, Values: []*querypb.Value{
+			{Type: sqltypes.Int64, Value: []byte("1")},
+			{Type: sqltypes.VarChar, Value: []byte("2")},
+		}}, true},
+		{&querypb.BindVariable{Type: querypb.Type_TUPLE, Values: []*querypb.Value{
+			{Type: sqltypes.Int64, Value: []byte("1")},
+			{Type: sqltypes.VarChar, Value: []byte("b")},
+		}}, false},
+	}
+	mismatches := tsv.stats.BindVarTypeMismatches.Counts()["test_table.pk"]
+	for _, tcase := range testcases {
+		qre.bindVars["pks"] = tcase.bv
+
+		tsv.config.StrictBindVarTypes = false
+		require.NoError(t, qre.checkBindVarTypes())
+		if !tcase.matches {
+			mismatches++
+		}
+		assert.Equal(t, mismatches, tsv.stats.BindVarTypeMismatches.Counts()["test_table.pk"], "%v", tcase.bv)
+
+		tsv.config.StrictBindVarTypes = true
+		err := qre.checkBindVarTypes()
+		if tcase.matches {
+			assert.NoError(t, err, "%v", tcase.bv)
+			continue
+		}
+		mismatches++
+		assert.Contains(t, fmt.Sprint(err), "bind variable pks of type", "%v", tcase.bv)
+		assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	}
+
+	// The streaming queries are checked too.
+	streamPlan, err := tsv.qe.GetStreamPlan("select * from test_table where pk in ::pks", false)
+	require.NoError(t, err)
+	qre.plan = streamPlan
+	require.Equal(t, []planbuilder.PKBindVar{{Name: "pks", Column: "pk", Type: sqltypes.Int32}}, qre.plan.PKBindVars)
+	qre.bindVars["pks"] = sqltypes.StringBindVariable("a")
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
+func TestQueryExecutorForeignKeys(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowForeignKeysRow("child", "fk_child_test_table", "test_table", "RESTRICT", "CASCADE"),
+		},
+	})
+	tsv.config.ForeignKeyMode = tabletenv.ForeignKeyBlock
+	require.NoError(t, tsv.se.Reload(ctx))
+
+	qre := newTestQueryExecutor(ctx, tsv, "delete from test_table where pk = 1", 0)
+	err := qre.checkForeignKeys()
+	assert.EqualError(t, err, "deleting the rows of table test_table is not allowed: foreign key fk_child_test_table of table child changes the child rows")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	// A replace deletes the rows it replaces.
+	qre = newTestQueryExecutor(ctx, tsv, "replace into test_table(pk, name) values (1, 'a')", 0)
+	assert.EqualError(t, qre.checkForeignKeys(), "replacing the rows of table test_table is not allowed: foreign key fk_child_test_table of table child changes the child rows")
+
+	// The updates of the parent rows don't change the child rows.
+	qre = newTestQueryExecutor(ctx, tsv, "update test_table set name = 'a' where pk = 1", 0)
+	assert.NoError(t, qre.checkForeignKeys())
+	qre = newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, name) values (1, 'a') on duplicate key update name = 'a'", 0)
+	assert.NoError(t, qre.checkForeignKeys())
+	qre = newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, name) values (1, 'a')", 0)
+	assert.NoError(t, qre.checkForeignKeys())
+
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowForeignKeysRow("child", "fk_child_test_table", "test_table", "CASCADE", "RESTRICT"),
+		},
+	})
+	require.NoError(t, tsv.se.Reload(ctx))
+	qre = newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, name) values (1, 'a') on duplicate key update name = 'a'", 0)
+	assert.EqualError(t, qre.checkForeignKeys(), "updating the rows of table test_table is not allowed: foreign key fk_child_test_table of table child changes the child rows")
+	qre = newTestQueryExecutor(ctx, tsv, "replace into test_table(pk, name) values (1, 'a')", 0)
+	assert.NoError(t, qre.checkForeignKeys())
+
+	tsv.config.ForeignKeyMode = tabletenv.Disable
+	qre = newTestQueryExecutor(ctx, tsv, "delete from test_table where pk = 1", 0)
+	assert.NoError(t, qre.checkForeignKeys())
+}
+
 type executorFlags int64
 
 const (
@@ -1191,10 +1682,13 @@ func setUpQueryExecutorTest(t *testing.T) *fakesqldb.DB {
 
 const baseShowTablesPattern = `SELECT t\.table_name.*`
 
+const baseShowPartitionsPattern = `SELECT table_name, partition_name.*`
+
 func initQueryExecutorTestDB(db *fakesqldb.DB) {
 	for query, result := range getQueryExecutorSupportedQueries() {
 		db.AddQuery(query, result)
 	}
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
 		Fields: mysql.BaseShowTablesFields,
 		Rows: [][]sqltypes.Value{
@@ -99,6 +99,15 @@ func (ql *QueryList) TerminateAll() {
 	}
 }
 
+// forEach calls f for every QueryDetail in the QueryList.
+func (ql *QueryList) forEach(f func(qd *QueryDetail)) {
+	ql.mu.Lock()
+	defer ql.mu.Unlock()
+	for _, qd := range ql.queryDetails {
+		f(qd)
+	}
+}
+
 // QueryDetailzRow is used for rendering QueryDetail in a template
 type QueryDetailzRow struct {
 	Type              string
@@ -19,7 +19,9 @@ package rules
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -31,12 +33,32 @@ type Map struct {
 	mu sync.Mutex
 	// queryRulesMap maps the names of different query rule sources to the actual Rules structure
 	queryRulesMap map[string]*Rules
+	// history keeps the most recent Rules set for each source, oldest first.
+	history  map[string][]RulesVersion
+	versions map[string]int
+	// writers write the Rules of a source back to where the source
+	// loads them from, like a file or a topo node.
+	writers map[string]func(*Rules) error
+}
+
+// maxRulesVersions is the number of Rules kept in the history of a source.
+const maxRulesVersions = 20
+
+// RulesVersion is a Rules that was set for a source.
+// Versions are numbered from 1 in the order they were set.
+type RulesVersion struct {
+	Version int       `json:"version"`
+	Time    time.Time `json:"time"`
+	Rules   *Rules    `json:"rules"`
 }
 
 // NewMap returns an empty Map object.
 func NewMap() *Map {
 	qri := &Map{
 		queryRulesMap: map[string]*Rules{},
+		history:       map[string][]RulesVersion{},
+		versions:      map[string]int{},
+		writers:       map[string]func(*Rules) error{},
 	}
 	return qri
 }
@@ -57,6 +79,22 @@ func (qri *Map) UnRegisterSource(ruleSource string) {
 	qri.mu.Lock()
 	defer qri.mu.Unlock()
 	delete(qri.queryRulesMap, ruleSource)
+	delete(qri.history, ruleSource)
+	delete(qri.versions, ruleSource)
+	delete(qri.writers, ruleSource)
+}
+
+// SetRulesWriter sets the function that writes the Rules of ruleSource
+// back to where the source loads them from. Only the sources with a
+// writer can be rolled back.
+func (qri *Map) SetRulesWriter(ruleSource string, write func(*Rules) error) error {
+	qri.mu.Lock()
+	defer qri.mu.Unlock()
+	if _, ok := qri.queryRulesMap[ruleSource]; !ok {
+		return errors.New("Rule source identifier " + ruleSource + " is not valid")
+	}
+	qri.writers[ruleSource] = write
+	return nil
 }
 
 // SetRules takes an external Rules structure and overwrite one of the
@@ -69,11 +107,86 @@ func (qri *Map) SetRules(ruleSource string, newRules *Rules) error {
 	defer qri.mu.Unlock()
 	if _, ok := qri.queryRulesMap[ruleSource]; ok {
 		qri.queryRulesMap[ruleSource] = newRules.Copy()
+		version := qri.recordVersion(ruleSource, newRules)
+		log.Infof("Query rules version %d applied for source %s", version, ruleSource)
 		return nil
 	}
 	return errors.New("Rule source identifier " + ruleSource + " is not valid")
 }
 
+// recordVersion adds newRules to the history of ruleSource and returns
+// its version. Rules equal to the latest ones are not recorded again.
+// The caller must hold the lock.
+func (qri *Map) recordVersion(ruleSource string, newRules *Rules) int {
+	if history := qri.history[ruleSource]; len(history) > 0 && history[len(history)-1].Rules.Equal(newRules) {
+		return qri.versions[ruleSource]
+	}
+	qri.versions[ruleSource]++
+	version := qri.versions[ruleSource]
+	history := append(qri.history[ruleSource], RulesVersion{
+		Version: version,
+		Time:    time.Now(),
+		Rules:   newRules.Copy(),
+	})
+	if len(history) > maxRulesVersions {
+		history = history[len(history)-maxRulesVersions:]
+	}
+	qri.history[ruleSource] = history
+	return version
+}
+
+// History returns the most recent Rules set for ruleSource, oldest first.
+func (qri *Map) History(ruleSource string) ([]RulesVersion, error) {
+	qri.mu.Lock()
+	defer qri.mu.Unlock()
+	if _, ok := qri.queryRulesMap[ruleSource]; !ok {
+		return nil, errors.New("Rule source identifier " + ruleSource + " is not valid")
+	}
+	history := make([]RulesVersion, 0, len(qri.history[ruleSource]))
+	for _, rv := range qri.history[ruleSource] {
+		rv.Rules = rv.Rules.Copy()
+		history = append(history, rv)
+	}
+	return history, nil
+}
+
+// RulesAt returns the Rules of a version from the history of ruleSource.
+func (qri *Map) RulesAt(ruleSource string, version int) (*Rules, error) {
+	history, err := qri.History(ruleSource)
+	if err != nil {
+		return nil, err
+	}
+	for _, rv := range history {
+		if rv.Version == version {
+			return rv.Rules, nil
+		}
+	}
+	return nil, fmt.Errorf("query rules version %d of source %s is not in the history", version, ruleSource)
+}
+
+// Rollback sets the Rules of a previous version of ruleSource again.
+// It is recorded as a new version. The Rules are first written back to
+// where the source loads them from, so that the source does not undo
+// the rollback the next time it loads them. A source without a writer,
+// like the blacklist which is computed from the shard records, cannot
+// be rolled back.
+func (qri *Map) Rollback(ruleSource string, version int) error {
+	rules, err := qri.RulesAt(ruleSource, version)
+	if err != nil {
+		return err
+	}
+	qri.mu.Lock()
+	write := qri.writers[ruleSource]
+	qri.mu.Unlock()
+	if write == nil {
+		return fmt.Errorf("query rules of source %s cannot be rolled back, since the source would overwrite them the next time it loads them", ruleSource)
+	}
+	if err := write(rules.Copy()); err != nil {
+		return fmt.Errorf("cannot write query rules version %d of source %s back: %v", version, ruleSource, err)
+	}
+	return qri.SetRules(ruleSource, rules)
+}
+
 // Get returns the corresponding Rules as designated by ruleSource parameter.
 func (qri *Map) Get(ruleSource string) (*Rules, error) {
 	qri.mu.Lock()
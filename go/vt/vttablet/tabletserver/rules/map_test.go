@@ -162,6 +162,94 @@ func TestMapGetSetQueryRules(t *testing.T) {
 	}
 }
 
+func TestMapHistoryAndRollback(t *testing.T) {
+	setupRules()
+	qri := NewMap()
+	qri.RegisterSource(blacklistQueryRules)
+
+	if err := qri.SetRules(blacklistQueryRules, blacklistRules); err != nil {
+		t.Fatal(err)
+	}
+	if err := qri.SetRules(blacklistQueryRules, otherRules); err != nil {
+		t.Fatal(err)
+	}
+	history, err := qri.History(blacklistQueryRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Version != 1 || history[1].Version != 2 {
+		t.Fatalf("got history %v, want versions 1 and 2", history)
+	}
+	if !history[0].Rules.Equal(blacklistRules) || !history[1].Rules.Equal(otherRules) {
+		t.Fatalf("history does not hold the rules that were set: %v", history)
+	}
+
+	want := []string{`- rule "blacklisted_table"`, `+ rule "customrule_ban_bindvar"`}
+	if got := history[0].Rules.Diff(history[1].Rules); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got diff %q, want %q", got, want)
+	}
+	changed := otherRules.Copy()
+	changed.Find("customrule_ban_bindvar").AddTableCond("t_order")
+	want = []string{`~ rule "customrule_ban_bindvar": {"Description":"sample custom rule","Name":"customrule_ban_bindvar","TableNames":["t_customer"],"BindVarConds":[{"Name":"bindvar1","OnAbsent":true,"Operator":""}],"Action":"FAIL"} -> {"Description":"sample custom rule","Name":"customrule_ban_bindvar","TableNames":["t_customer","t_order"],"BindVarConds":[{"Name":"bindvar1","OnAbsent":true,"Operator":""}],"Action":"FAIL"}`}
+	if got := otherRules.Diff(changed); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got diff %q, want %q", got, want)
+	}
+
+	// A source without a writer would undo the rollback.
+	if err := qri.Rollback(blacklistQueryRules, 1); err == nil || !strings.Contains(err.Error(), "cannot be rolled back") {
+		t.Fatalf("got error %v for a source without a writer", err)
+	}
+	var written *Rules
+	if err := qri.SetRulesWriter(blacklistQueryRules, func(qrs *Rules) error {
+		written = qrs
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := qri.Rollback(blacklistQueryRules, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !written.Equal(blacklistRules) {
+		t.Fatalf("got rules %v written back, want %v", written, blacklistRules)
+	}
+	if rules, _ := qri.Get(blacklistQueryRules); !rules.Equal(blacklistRules) {
+		t.Fatalf("got rules %v after rollback, want %v", rules, blacklistRules)
+	}
+	if history, _ = qri.History(blacklistQueryRules); len(history) != 3 || history[2].Version != 3 {
+		t.Fatalf("a rollback should be recorded as version 3, got %v", history)
+	}
+	if err := qri.Rollback(blacklistQueryRules, 10); err == nil || !strings.Contains(err.Error(), "version 10") {
+		t.Fatalf("got error %v for an unknown version", err)
+	}
+	if _, err := qri.History(customQueryRules); err == nil {
+		t.Fatalf("should get an error for an unregistered source")
+	}
+	if err := qri.SetRulesWriter(customQueryRules, nil); err == nil {
+		t.Fatalf("should get an error for an unregistered source")
+	}
+
+	// Setting the latest rules again is not recorded.
+	if err := qri.SetRules(blacklistQueryRules, blacklistRules); err != nil {
+		t.Fatal(err)
+	}
+	if history, _ = qri.History(blacklistQueryRules); len(history) != 3 {
+		t.Fatalf("unchanged rules should not be recorded, got %v", history)
+	}
+
+	for i := 0; i < maxRulesVersions; i++ {
+		newRules := otherRules
+		if i%2 == 1 {
+			newRules = blacklistRules
+		}
+		if err := qri.SetRules(blacklistQueryRules, newRules); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if history, _ = qri.History(blacklistQueryRules); len(history) != maxRulesVersions || history[0].Version != 4 {
+		t.Fatalf("got %d versions starting at %d, want %d starting at 4", len(history), history[0].Version, maxRulesVersions)
+	}
+}
+
 func TestMapFilterByPlan(t *testing.T) {
 	var qrs *Rules
 	setupRules()
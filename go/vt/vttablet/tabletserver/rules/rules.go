@@ -109,6 +109,27 @@ func (qrs *Rules) Delete(name string) (qr *Rule) {
 	return nil
 }
 
+// Diff returns the differences between the receiver and other, one
+// line per added, removed or changed rule. Rules are matched by name.
+func (qrs *Rules) Diff(other *Rules) []string {
+	var diffs []string
+	for _, qr := range qrs.rules {
+		otherqr := other.Find(qr.Name)
+		switch {
+		case otherqr == nil:
+			diffs = append(diffs, fmt.Sprintf("- rule %q", qr.Name))
+		case !qr.Equal(otherqr):
+			diffs = append(diffs, fmt.Sprintf("~ rule %q: %s -> %s", qr.Name, qr.compactJSON(), otherqr.compactJSON()))
+		}
+	}
+	for _, otherqr := range other.rules {
+		if qrs.Find(otherqr.Name) == nil {
+			diffs = append(diffs, fmt.Sprintf("+ rule %q", otherqr.Name))
+		}
+	}
+	return diffs
+}
+
 // UnmarshalJSON unmarshals Rules.
 func (qrs *Rules) UnmarshalJSON(data []byte) (err error) {
 	var rulesInfo []map[string]interface{}
@@ -292,6 +313,16 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// compactJSON returns the rule as a single line of JSON.
+func (qr *Rule) compactJSON() string {
+	data, _ := qr.MarshalJSON()
+	b := bytes.NewBuffer(nil)
+	if err := json.Compact(b, data); err != nil {
+		return string(data)
+	}
+	return b.String()
+}
+
 // SetIPCond adds a regular expression condition for the client IP.
 // It has to be a full match (not substring).
 func (qr *Rule) SetIPCond(pattern string) (err error) {
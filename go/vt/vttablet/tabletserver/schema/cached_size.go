@@ -34,6 +34,41 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Partition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Description string
+	size += int64(len(cached.Description))
+	return size
+}
+func (cached *PartitionInfo) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Method string
+	size += int64(len(cached.Method))
+	// field Expression string
+	size += int64(len(cached.Expression))
+	// field Partitions []vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Partition
+	{
+		size += int64(cap(cached.Partitions)) * int64(32)
+		for _, elem := range cached.Partitions {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *SequenceInfo) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -50,7 +85,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(104)
+		size += int64(112)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Name.CachedSize(false)
@@ -69,5 +104,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	size += cached.SequenceInfo.CachedSize(true)
 	// field MessageInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.MessageInfo
 	size += cached.MessageInfo.CachedSize(true)
+	// field PartitionInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.PartitionInfo
+	size += cached.PartitionInfo.CachedSize(true)
 	return size
 }
@@ -20,7 +20,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -48,6 +50,9 @@ import (
 
 const maxTableCount = 10000
 
+// maxPartitionCount is the maximum number of partitions of a table in MySQL.
+const maxPartitionCount = 8192
+
 type notifier func(full map[string]*Table, created, altered, dropped []string)
 
 // Engine stores the schema info and performs operations that
@@ -56,12 +61,20 @@ type Engine struct {
 	env tabletenv.Env
 	cp  dbconfigs.Connector
 
+	// reloadMu serializes the reloads, and Open and Close. The reloads
+	// only hold mu to apply their changes, so that the schema can be
+	// read while the changed tables are loaded.
+	reloadMu sync.Mutex
+
 	// mu protects the following fields.
 	mu         sync.Mutex
 	isOpen     bool
 	tables     map[string]*Table
 	lastChange int64
 	reloadTime time.Duration
+	// childForeignKeys are the foreign keys referencing each table.
+	// They are only loaded in the block foreign key mode.
+	childForeignKeys map[string][]*ForeignKey
 	//the position at which the schema was last loaded. it is only used in conjunction with ReloadAt
 	reloadAtPos mysql.Position
 	notifierMu  sync.Mutex
@@ -169,6 +182,8 @@ func (se *Engine) EnsureConnectionAndDB(tabletType topodatapb.TabletType) error
 // Open initializes the Engine. Calling Open on an already
 // open engine is a no-op.
 func (se *Engine) Open() error {
+	se.reloadMu.Lock()
+	defer se.reloadMu.Unlock()
 	se.mu.Lock()
 	defer se.mu.Unlock()
 	if se.isOpen {
@@ -194,9 +209,11 @@ func (se *Engine) Open() error {
 	}
 	se.notifiers = make(map[string]notifier)
 
-	if err := se.reload(ctx); err != nil {
+	changes, err := se.loadChanges(ctx, se.tables, se.lastChange, nil)
+	if err != nil {
 		return err
 	}
+	se.applyChanges(changes)
 	if !se.SkipMetaCheck {
 		if err := se.historian.Open(); err != nil {
 			return err
@@ -223,6 +240,8 @@ func (se *Engine) IsOpen() bool {
 // Close shuts down Engine and is idempotent.
 // It can be re-opened after Close.
 func (se *Engine) Close() {
+	se.reloadMu.Lock()
+	defer se.reloadMu.Unlock()
 	se.mu.Lock()
 	defer se.mu.Unlock()
 	if !se.isOpen {
@@ -235,6 +254,7 @@ func (se *Engine) Close() {
 
 	se.tables = make(map[string]*Table)
 	se.lastChange = 0
+	se.childForeignKeys = nil
 	se.notifiers = make(map[string]notifier)
 	se.isOpen = false
 	log.Info("Schema Engine: closed")
@@ -273,63 +293,150 @@ func (se *Engine) Reload(ctx context.Context) error {
 // It maintains the position at which the schema was reloaded and if the same position is provided
 // (say by multiple vstreams) it returns the cached schema. In case of a newer or empty pos it always reloads the schema
 func (se *Engine) ReloadAt(ctx context.Context, pos mysql.Position) error {
-	se.mu.Lock()
-	defer se.mu.Unlock()
-	if !se.isOpen {
+	se.reloadMu.Lock()
+	defer se.reloadMu.Unlock()
+	tables, lastChange, reloadAtPos, ok := se.reloadState()
+	if !ok {
 		log.Warning("Schema reload called for an engine that is not yet open")
 		return nil
 	}
-	if !pos.IsZero() && se.reloadAtPos.AtLeast(pos) {
+	if !pos.IsZero() && reloadAtPos.AtLeast(pos) {
 		log.V(2).Infof("ReloadAt: found cached schema at %s", mysql.EncodePosition(pos))
 		return nil
 	}
-	if err := se.reload(ctx); err != nil {
+	changes, err := se.loadChanges(ctx, tables, lastChange, nil)
+	if err != nil {
 		return err
 	}
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	se.applyChanges(changes)
 	se.reloadAtPos = pos
 	return nil
 }
 
-// reload reloads the schema. It can also be used to initialize it.
-func (se *Engine) reload(ctx context.Context) error {
+// ReloadTables reloads the schema of the given tables only, whether
+// they look changed or not. It is cheaper than Reload when the changed
+// tables are known, like after a DDL. The tables that don't exist
+// anymore are dropped.
+func (se *Engine) ReloadTables(ctx context.Context, tableNames []string) error {
+	if len(tableNames) == 0 {
+		return nil
+	}
+	se.reloadMu.Lock()
+	defer se.reloadMu.Unlock()
+	tables, _, _, ok := se.reloadState()
+	if !ok {
+		log.Warning("Schema reload called for an engine that is not yet open")
+		return nil
+	}
+	changes, err := se.loadChanges(ctx, tables, 0, tableNames)
+	if err != nil {
+		return err
+	}
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	se.applyChanges(changes)
+	return nil
+}
+
+// reloadState returns a copy of the tables, and the state of the last
+// reload. It returns false if the engine is not open.
+func (se *Engine) reloadState() (map[string]*Table, int64, mysql.Position, bool) {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	if !se.isOpen {
+		return nil, 0, mysql.Position{}, false
+	}
+	tables := make(map[string]*Table, len(se.tables))
+	for name, table := range se.tables {
+		tables[name] = table
+	}
+	return tables, se.lastChange, se.reloadAtPos, true
+}
+
+// tableSize is the size of a table on disk.
+type tableSize struct {
+	fileSize, allocatedSize uint64
+}
+
+// schemaChanges are the changes of the schema found by loadChanges.
+type schemaChanges struct {
+	// curTime is saved into lastChange if the changes are the ones
+	// of all the tables.
+	curTime int64
+	full    bool
+	// tables are the created and altered tables.
+	tables map[string]*Table
+	// sizes are the sizes of the unchanged tables.
+	sizes                     map[string]tableSize
+	created, altered, dropped []string
+	// childForeignKeys are the foreign keys of the whole schema, by
+	// parent table, if they were loaded.
+	childForeignKeys map[string][]*ForeignKey
+}
+
+// loadChanges loads the tables created or altered since lastChange, and
+// finds the dropped ones. If tableNames is set, only these tables are
+// loaded, whether they changed or not. tables is the current schema,
+// which is only read. The caller must hold reloadMu. It returns nil if
+// the table metadata is not needed.
+func (se *Engine) loadChanges(ctx context.Context, tables map[string]*Table, lastChange int64, tableNames []string) (*schemaChanges, error) {
 	defer func() {
 		se.env.LogError()
 	}()
 
 	conn, err := se.conns.Get(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer conn.Recycle()
 
 	// curTime will be saved into lastChange after schema is loaded.
 	curTime, err := se.mysqlTime(ctx, conn)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// if this flag is set, then we don't need table meta information
 	if se.SkipMetaCheck {
-		return nil
+		return nil, nil
+	}
+	changes := &schemaChanges{
+		curTime: curTime,
+		full:    tableNames == nil,
+		tables:  make(map[string]*Table),
+		sizes:   make(map[string]tableSize),
+	}
+	query := conn.BaseShowTables()
+	// wanted is nil for a full reload.
+	var wanted map[string]bool
+	if !changes.full {
+		query = mysql.BaseShowTablesForTables(tableNames)
+		wanted = make(map[string]bool, len(tableNames))
+		for _, tableName := range tableNames {
+			wanted[tableName] = true
+		}
 	}
-	tableData, err := conn.Exec(ctx, conn.BaseShowTables(), maxTableCount, false)
+	tableData, err := conn.Exec(ctx, query, maxTableCount, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = se.updateInnoDBRowsRead(ctx, conn)
-	if err != nil {
-		return err
+	if changes.full {
+		err = se.updateInnoDBRowsRead(ctx, conn)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	rec := concurrency.AllErrorRecorder{}
 	// curTables keeps track of tables in the new snapshot so we can detect what was dropped.
 	curTables := map[string]bool{"dual": true}
-	// changedTables keeps track of tables that have changed so we can reload their pk info.
-	changedTables := make(map[string]*Table)
-	// created and altered contain the names of created and altered tables for broadcast.
-	var created, altered []string
 	for _, row := range tableData.Rows {
 		tableName := row[0].ToString()
+		if wanted != nil && !wanted[tableName] {
+			continue
+		}
 		curTables[tableName] = true
 		createTime, _ := evalengine.ToInt64(row[2])
 		fileSize, _ := evalengine.ToUint64(row[4])
@@ -341,10 +448,9 @@ func (se *Engine) reload(ctx context.Context) error {
 
 		// TODO(sougou); find a better way detect changed tables. This method
 		// seems unreliable. The endtoend test flags all tables as changed.
-		tbl, isInTablesMap := se.tables[tableName]
-		if isInTablesMap && createTime < se.lastChange {
-			tbl.FileSize = fileSize
-			tbl.AllocatedSize = allocatedSize
+		_, isInTablesMap := tables[tableName]
+		if isInTablesMap && createTime < lastChange && wanted == nil {
+			changes.sizes[tableName] = tableSize{fileSize: fileSize, allocatedSize: allocatedSize}
 			continue
 		}
 
@@ -356,41 +462,72 @@ func (se *Engine) reload(ctx context.Context) error {
 		}
 		table.FileSize = fileSize
 		table.AllocatedSize = allocatedSize
-		changedTables[tableName] = table
+		changes.tables[tableName] = table
 		if isInTablesMap {
-			altered = append(altered, tableName)
+			changes.altered = append(changes.altered, tableName)
 		} else {
-			created = append(created, tableName)
+			changes.created = append(changes.created, tableName)
 		}
 	}
 	if rec.HasErrors() {
-		return rec.Error()
+		return nil, rec.Error()
 	}
 
-	// Compute and handle dropped tables.
-	var dropped []string
-	for tableName := range se.tables {
-		if !curTables[tableName] {
-			dropped = append(dropped, tableName)
-			delete(se.tables, tableName)
+	// Compute dropped tables.
+	for tableName := range tables {
+		if (wanted == nil || wanted[tableName]) && !curTables[tableName] {
+			changes.dropped = append(changes.dropped, tableName)
 		}
 	}
 
 	// Populate PKColumns for changed tables.
-	if err := se.populatePrimaryKeys(ctx, conn, changedTables); err != nil {
-		return err
+	if err := se.populatePrimaryKeys(ctx, conn, changes.tables); err != nil {
+		return nil, err
+	}
+	// Populate PartitionInfo for changed tables.
+	if err := se.populatePartitions(ctx, conn, changes.tables); err != nil {
+		return nil, err
+	}
+	// The foreign keys of unchanged tables can reference changed ones:
+	// they are all loaded every time.
+	if se.env.Config().ForeignKeyMode == tabletenv.ForeignKeyBlock {
+		if changes.childForeignKeys, err = se.loadForeignKeys(ctx, conn); err != nil {
+			return nil, err
+		}
 	}
+	return changes, nil
+}
 
+// applyChanges applies the changes found by loadChanges to the schema,
+// and broadcasts them. The caller must hold mu.
+func (se *Engine) applyChanges(changes *schemaChanges) {
+	if changes == nil {
+		return
+	}
+	for tableName, size := range changes.sizes {
+		if tbl, ok := se.tables[tableName]; ok {
+			tbl.FileSize = size.fileSize
+			tbl.AllocatedSize = size.allocatedSize
+		}
+	}
+	for _, tableName := range changes.dropped {
+		delete(se.tables, tableName)
+	}
 	// Update se.tables and se.lastChange
-	for k, t := range changedTables {
+	for k, t := range changes.tables {
 		se.tables[k] = t
 	}
-	se.lastChange = curTime
+	if changes.full {
+		se.lastChange = changes.curTime
+	}
+	if changes.childForeignKeys != nil {
+		se.childForeignKeys = changes.childForeignKeys
+	}
+	created, altered, dropped := changes.created, changes.altered, changes.dropped
 	if len(created) > 0 || len(altered) > 0 || len(dropped) > 0 {
 		log.Infof("schema engine created %v, altered %v, dropped %v", created, altered, dropped)
 	}
 	se.broadcast(created, altered, dropped)
-	return nil
 }
 
 func (se *Engine) updateInnoDBRowsRead(ctx context.Context, conn *connpool.DBConn) error {
@@ -450,6 +587,69 @@ func (se *Engine) populatePrimaryKeys(ctx context.Context, conn *connpool.DBConn
 	return nil
 }
 
+// populatePartitions populates the PartitionInfo for the specified tables
+// that are partitioned. Only the partitions of those tables are read.
+func (se *Engine) populatePartitions(ctx context.Context, conn *connpool.DBConn, tables map[string]*Table) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	tableNames := make([]string, 0, len(tables))
+	for tableName := range tables {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+	partData, err := conn.Exec(ctx, mysql.BaseShowPartitionsForTables(tableNames), len(tables)*maxPartitionCount, false)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get table partition info: %v", err)
+	}
+	for _, row := range partData.Rows {
+		table, ok := tables[row[0].ToString()]
+		if !ok {
+			continue
+		}
+		if table.PartitionInfo == nil {
+			table.PartitionInfo = &PartitionInfo{
+				Method:     row[2].ToString(),
+				Expression: row[3].ToString(),
+			}
+		}
+		table.PartitionInfo.Partitions = append(table.PartitionInfo.Partitions, Partition{
+			Name:        row[1].ToString(),
+			Description: row[4].ToString(),
+		})
+	}
+	return nil
+}
+
+// loadForeignKeys loads the foreign keys of the schema, by parent table.
+func (se *Engine) loadForeignKeys(ctx context.Context, conn *connpool.DBConn) (map[string][]*ForeignKey, error) {
+	// A table can have any number of foreign keys.
+	fkData, err := conn.Exec(ctx, mysql.BaseShowForeignKeys, math.MaxInt32, false)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get foreign key info: %v", err)
+	}
+	childForeignKeys := make(map[string][]*ForeignKey)
+	for _, row := range fkData.Rows {
+		fk := &ForeignKey{
+			ChildTable:  row[0].ToString(),
+			Name:        row[1].ToString(),
+			ParentTable: row[2].ToString(),
+			UpdateRule:  row[3].ToString(),
+			DeleteRule:  row[4].ToString(),
+		}
+		childForeignKeys[fk.ParentTable] = append(childForeignKeys[fk.ParentTable], fk)
+	}
+	return childForeignKeys, nil
+}
+
+// ChildForeignKeys returns the foreign keys of the child tables that
+// reference tableName. They are only loaded in the block foreign key mode.
+func (se *Engine) ChildForeignKeys(tableName string) []*ForeignKey {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	return se.childForeignKeys[tableName]
+}
+
 // RegisterVersionEvent is called by the vstream when it encounters a version event (an insert into _vt.schema_tracking)
 // It triggers the historian to load the newer rows from the database to update its cache
 func (se *Engine) RegisterVersionEvent() error {
@@ -476,6 +676,27 @@ func (se *Engine) GetTableForPos(tableName sqlparser.TableIdent, gtid string) (*
 	return newMinimalTable(st), nil
 }
 
+// GetSchemaForPos returns the tables of the schema as of gtid, sorted by
+// name, from the schema versions recorded in _vt.schema_version by the
+// tracker, or the current schema if gtid is after the last version.
+// Unlike GetTableForPos, it fails if no version is tracked at or before gtid.
+func (se *Engine) GetSchemaForPos(gtid string) ([]*binlogdatapb.MinimalTable, error) {
+	tables, err := se.historian.GetSchemaForPos(gtid)
+	if err != nil || tables != nil {
+		return tables, err
+	}
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	for name, st := range se.tables {
+		if name == "dual" {
+			continue
+		}
+		tables = append(tables, newMinimalTable(st))
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables, nil
+}
+
 // RegisterNotifier registers the function for schema change notification.
 // It also causes an immediate notification to the caller. The notified
 // function must not change the map or its contents. The only exception
@@ -46,6 +46,8 @@ import (
 
 const baseShowTablesPattern = `SELECT t\.table_name.*`
 
+const baseShowPartitionsPattern = `SELECT table_name, partition_name.*`
+
 var mustMatch = utils.MustMatchFn(
 	[]interface{}{ // types with unexported fields
 		sqlparser.TableIdent{},
@@ -59,6 +61,7 @@ func TestOpenAndReload(t *testing.T) {
 	for query, result := range schematest.Queries() {
 		db.AddQuery(query, result)
 	}
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern,
 		&sqltypes.Result{
 			Fields:       mysql.BaseShowTablesFields,
@@ -101,6 +104,7 @@ func TestOpenAndReload(t *testing.T) {
 	// Add test_table_04
 	// Drop msg
 	db.ClearQueryPattern()
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
 		Fields: mysql.BaseShowTablesFields,
 		Rows: [][]sqltypes.Value{
@@ -148,6 +152,16 @@ func TestOpenAndReload(t *testing.T) {
 			mysql.ShowPrimaryRow("seq", "id"),
 		},
 	})
+	// Only the partitions of the created and altered tables are read.
+	partitions := &sqltypes.Result{
+		Fields: mysql.ShowPartitionsFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowPartitionsRow("test_table_03", "p0", "RANGE", "`pk1`", "100"),
+			mysql.ShowPartitionsRow("test_table_03", "p1", "RANGE", "`pk1`", "MAXVALUE"),
+		},
+	}
+	db.AddQuery(mysql.BaseShowPartitionsForTables([]string{"test_table_03", "test_table_04"}), partitions)
+	db.AddQuery(mysql.BaseShowPartitionsForTables([]string{"test_table_03"}), partitions)
 	secondReadRowsValue := 123
 	AddFakeInnoDBReadRowsResult(db, secondReadRowsValue)
 
@@ -184,7 +198,18 @@ func TestOpenAndReload(t *testing.T) {
 			Name: "val",
 			Type: sqltypes.Int32,
 		}},
-		PKColumns:     []int{0, 1},
+		PKColumns: []int{0, 1},
+		PartitionInfo: &PartitionInfo{
+			Method:     "RANGE",
+			Expression: "`pk1`",
+			Partitions: []Partition{{
+				Name:        "p0",
+				Description: "100",
+			}, {
+				Name:        "p1",
+				Description: "MAXVALUE",
+			}},
+		},
 		FileSize:      128,
 		AllocatedSize: 256,
 	}
@@ -217,6 +242,7 @@ func TestOpenAndReload(t *testing.T) {
 	assert.Equal(t, want, se.GetSchema())
 
 	db.ClearQueryPattern()
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
 		Fields: mysql.BaseShowTablesFields,
 		Rows: [][]sqltypes.Value{
@@ -246,6 +272,129 @@ func TestOpenAndReload(t *testing.T) {
 	assert.Equal(t, want, se.GetSchema())
 }
 
+func TestReloadTables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
+	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+			mysql.BaseShowTablesRow("test_table_02", false, ""),
+			mysql.BaseShowTablesRow("test_table_03", false, ""),
+			mysql.BaseShowTablesRow("seq", false, "vitess_sequence"),
+			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30"),
+		},
+	})
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("t", "int64"), "1427325876"))
+	AddFakeInnoDBReadRowsResult(db, 12)
+	se := newEngine(10, 10*time.Second, 10*time.Second, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+	want := initialSchema()
+
+	// test_table_03 is reloaded in spite of its older timestamp, msg
+	// doesn't exist anymore, and the other tables are not looked at.
+	db.AddQuery(mysql.BaseShowTablesForTables([]string{"test_table_03", "msg"}), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_03", false, ""),
+		},
+	})
+	db.AddQuery("select * from test_table_03 where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "val",
+			Type: sqltypes.Int32,
+		}},
+	})
+	notifications := 0
+	se.RegisterNotifier("test", func(full map[string]*Table, created, altered, dropped []string) {
+		// The first notification is the one of the registration.
+		notifications++
+		if notifications == 2 {
+			assert.Empty(t, created)
+			assert.Equal(t, []string{"test_table_03"}, altered)
+			assert.Equal(t, []string{"msg"}, dropped)
+		}
+	})
+	require.NoError(t, se.ReloadTables(context.Background(), []string{"test_table_03", "msg"}))
+	assert.Equal(t, 2, notifications)
+
+	want["test_table_03"] = &Table{
+		Name: sqlparser.NewTableIdent("test_table_03"),
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "val",
+			Type: sqltypes.Int32,
+		}},
+		PKColumns:     []int{0},
+		FileSize:      100,
+		AllocatedSize: 150,
+	}
+	delete(want, "msg")
+	assert.Equal(t, want, se.GetSchema())
+	assert.EqualValues(t, 1427325876, se.lastChange)
+}
+
+func TestForeignKeys(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
+	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+			mysql.BaseShowTablesRow("test_table_02", false, ""),
+		},
+	})
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{
+		Fields: mysql.ShowForeignKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowForeignKeysRow("test_table_02", "fk_01", "test_table_01", "RESTRICT", "CASCADE"),
+		},
+	})
+	AddFakeInnoDBReadRowsResult(db, 12)
+	se := newEngine(10, 10*time.Second, 10*time.Second, db)
+	se.env.Config().ForeignKeyMode = tabletenv.ForeignKeyBlock
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	fks := se.ChildForeignKeys("test_table_01")
+	require.Len(t, fks, 1)
+	assert.Equal(t, &ForeignKey{
+		Name:        "fk_01",
+		ChildTable:  "test_table_02",
+		ParentTable: "test_table_01",
+		UpdateRule:  "RESTRICT",
+		DeleteRule:  "CASCADE",
+	}, fks[0])
+	assert.False(t, fks[0].CascadesUpdate())
+	assert.True(t, fks[0].CascadesDelete())
+	assert.Empty(t, se.ChildForeignKeys("test_table_02"))
+
+	// The foreign keys are reloaded with any table.
+	db.AddQuery(mysql.BaseShowForeignKeys, &sqltypes.Result{Fields: mysql.ShowForeignKeysFields})
+	db.AddQuery(mysql.BaseShowTablesForTables([]string{"test_table_02"}), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_02", false, ""),
+		},
+	})
+	require.NoError(t, se.ReloadTables(context.Background(), []string{"test_table_02"}))
+	assert.Empty(t, se.ChildForeignKeys("test_table_01"))
+}
+
 func TestOpenFailedDueToMissMySQLTime(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -329,6 +478,7 @@ func TestOpenFailedDueToTableErr(t *testing.T) {
 	for query, result := range schematest.Queries() {
 		db.AddQuery(query, result)
 	}
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
 		Fields: mysql.BaseShowTablesFields,
 		Rows: [][]sqltypes.Value{
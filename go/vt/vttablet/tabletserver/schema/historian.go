@@ -221,16 +221,54 @@ func (h *historian) sortSchemas() {
 
 // getTableFromHistoryForPos looks in the cache for a schema for a specific gtid
 func (h *historian) getTableFromHistoryForPos(tableName sqlparser.TableIdent, pos mysql.Position) *binlogdatapb.MinimalTable {
+	schema := h.getSchemaFromHistoryForPos(pos)
+	if schema == nil {
+		log.Infof("Schema not found in cache for %s with pos %s", tableName, pos)
+		return nil
+	}
+	return schema[tableName.String()]
+}
+
+// getSchemaFromHistoryForPos returns the tracked schema in effect at pos,
+// or nil if pos is before the first one.
+func (h *historian) getSchemaFromHistoryForPos(pos mysql.Position) map[string]*binlogdatapb.MinimalTable {
 	idx := sort.Search(len(h.schemas), func(i int) bool {
 		return pos.Equal(h.schemas[i].pos) || !pos.AtLeast(h.schemas[i].pos)
 	})
 	if idx >= len(h.schemas) || idx == 0 && !pos.Equal(h.schemas[idx].pos) { // beyond the range of the cache
-		log.Infof("Schema not found in cache for %s with pos %s", tableName, pos)
 		return nil
 	}
 	if pos.Equal(h.schemas[idx].pos) { //exact match to a cache entry
-		return h.schemas[idx].schema[tableName.String()]
+		return h.schemas[idx].schema
 	}
 	//not an exact match, so based on our sort algo idx is one less than found: from 40,44,48 : 43 < 44 but we want 40
-	return h.schemas[idx-1].schema[tableName.String()]
+	return h.schemas[idx-1].schema
+}
+
+// GetSchemaForPos returns the tables of the tracked schema in effect
+// at gtid, sorted by name. It returns nil if gtid is after the last
+// tracked version, in which case the current schema applies.
+func (h *historian) GetSchemaForPos(gtid string) ([]*binlogdatapb.MinimalTable, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.isOpen {
+		return nil, fmt.Errorf("schema versions are not tracked, enable -track_schema_versions")
+	}
+	pos, err := mysql.DecodePosition(gtid)
+	if err != nil {
+		return nil, err
+	}
+	if len(h.schemas) == 0 || !pos.AtLeast(h.schemas[0].pos) {
+		return nil, fmt.Errorf("no schema version tracked at or before position %s", gtid)
+	}
+	schema := h.getSchemaFromHistoryForPos(pos)
+	if schema == nil {
+		return nil, nil
+	}
+	tables := make([]*binlogdatapb.MinimalTable, 0, len(schema))
+	for _, t := range schema {
+		tables = append(tables, t)
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables, nil
 }
@@ -173,4 +173,14 @@ func TestHistorian(t *testing.T) {
 	tab, err = se.GetTableForPos(sqlparser.NewTableIdent("t1"), gtid3)
 	require.NoError(t, err)
 	require.Equal(t, exp3, fmt.Sprintf("%v", tab))
+
+	_, err = se.GetSchemaForPos(gtidPrefix + "1-5")
+	require.EqualError(t, err, "no schema version tracked at or before position "+gtidPrefix+"1-5")
+	schema, err := se.GetSchemaForPos(gtidPrefix + "1-25")
+	require.NoError(t, err)
+	require.Len(t, schema, 1)
+	require.Equal(t, exp2, fmt.Sprintf("%v", schema[0]))
+	schema, err = se.GetSchemaForPos(gtidPrefix + "1-40")
+	require.NoError(t, err)
+	require.Len(t, schema, len(se.tables)-1)
 }
@@ -36,6 +36,7 @@ func getTestSchemaEngine(t *testing.T) (*Engine, *fakesqldb.DB, func()) {
 	))
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{})
 	db.AddQuery(mysql.BaseShowPrimary, &sqltypes.Result{})
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{})
 	AddFakeInnoDBReadRowsResult(db, 1)
 	se := newEngine(10, 10*time.Second, 10*time.Second, db)
 	require.NoError(t, se.Open())
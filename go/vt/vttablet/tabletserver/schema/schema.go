@@ -17,6 +17,7 @@ limitations under the License.
 package schema
 
 import (
+	"strings"
 	"sync"
 	"time"
 
@@ -53,6 +54,9 @@ type Table struct {
 	// MessageInfo contains info for message tables.
 	MessageInfo *MessageInfo
 
+	// PartitionInfo contains info for partitioned tables.
+	PartitionInfo *PartitionInfo
+
 	FileSize      uint64
 	AllocatedSize uint64
 }
@@ -105,6 +109,61 @@ type MessageInfo struct {
 	MaxBackoff time.Duration
 }
 
+// PartitionInfo contains info specific to the tables partitioned
+// by MySQL.
+type PartitionInfo struct {
+	// Method is the partitioning type, e.g. RANGE or HASH.
+	Method string
+
+	// Expression is the partitioning expression or column list.
+	Expression string
+
+	// Partitions are the partitions of the table, in order.
+	Partitions []Partition
+}
+
+// Partition is a partition of a partitioned table.
+type Partition struct {
+	Name string
+
+	// Description is the upper bound of a RANGE partition, or the
+	// values of a LIST partition. It is empty for the other methods.
+	Description string
+}
+
+// ForeignKey is a foreign key of a child table, which references
+// a parent table.
+type ForeignKey struct {
+	Name        string
+	ChildTable  string
+	ParentTable string
+
+	// UpdateRule and DeleteRule are the referential actions of the
+	// foreign key, e.g. CASCADE or RESTRICT.
+	UpdateRule string
+	DeleteRule string
+}
+
+// CascadesUpdate returns true if updating the parent rows changes
+// the child rows.
+func (fk *ForeignKey) CascadesUpdate() bool {
+	return changesChildRows(fk.UpdateRule)
+}
+
+// CascadesDelete returns true if deleting the parent rows changes
+// the child rows.
+func (fk *ForeignKey) CascadesDelete() bool {
+	return changesChildRows(fk.DeleteRule)
+}
+
+func changesChildRows(rule string) bool {
+	switch strings.ToUpper(rule) {
+	case "CASCADE", "SET NULL", "SET DEFAULT":
+		return true
+	}
+	return false
+}
+
 // NewTable creates a new Table.
 func NewTable(name string) *Table {
 	return &Table{
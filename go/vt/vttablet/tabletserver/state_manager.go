@@ -22,12 +22,14 @@ import (
 	"sync"
 	"time"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
@@ -55,6 +57,26 @@ func (state servingState) String() string {
 	return "Not connected to mysql"
 }
 
+// servingStateName returns the name of the serving state as seen by
+// clients. Unlike servingState, it distinguishes read-write from
+// read-only serving, and reports a serving tablet that is about to
+// stop serving as draining.
+func servingStateName(tabletType topodatapb.TabletType, state, wantState servingState) string {
+	switch state {
+	case StateNotConnected:
+		return "NotConnected"
+	case StateNotServing:
+		return "NotServing"
+	}
+	if wantState != StateServing {
+		return "Draining"
+	}
+	if tabletType == topodatapb.TabletType_MASTER {
+		return "ServingRW"
+	}
+	return "ServingRO"
+}
+
 // transitionRetryInterval is for tests.
 var transitionRetryInterval = 1 * time.Second
 
@@ -117,6 +139,11 @@ type stateManager struct {
 	// hcticks starts on initialiazation and runs forever.
 	hcticks *timer.Timer
 
+	// transitions counts the state changes by serving state name, and
+	// transitionTimings records how long each transition took.
+	transitions       *stats.CountersWithMultiLabels
+	transitionTimings *servenv.TimingsWrapper
+
 	// checkMySQLThrottler ensures that CheckMysql
 	// doesn't get spammed.
 	checkMySQLThrottler *sync2.Semaphore
@@ -190,6 +217,8 @@ func (sm *stateManager) Init(env tabletenv.Env, target querypb.Target) {
 	sm.unhealthyThreshold = env.Config().Healthcheck.UnhealthyThresholdSeconds.Get()
 	sm.shutdownGracePeriod = env.Config().GracePeriods.ShutdownSeconds.Get()
 	sm.transitionGracePeriod = env.Config().GracePeriods.TransitionSeconds.Get()
+	sm.transitions = env.Exporter().NewCountersWithMultiLabels("TabletStateTransitions", "Tablet server state transitions", []string{"from", "to"})
+	sm.transitionTimings = env.Exporter().NewTimings("TabletStateTransitionTimings", "Tablet server state transition timings", "to")
 }
 
 // SetServingType changes the state to the specified settings.
@@ -238,6 +267,7 @@ func (sm *stateManager) mustTransition(tabletType topodatapb.TabletType, terTime
 
 func (sm *stateManager) execTransition(tabletType topodatapb.TabletType, state servingState) error {
 	defer sm.transitioning.Release()
+	defer sm.transitionTimings.Record(servingStateName(tabletType, state, state), time.Now())
 
 	var err error
 	switch state {
@@ -575,6 +605,7 @@ func (sm *stateManager) setState(tabletType topodatapb.TabletType, state serving
 		sm.stateStringLocked(sm.target.TabletType, sm.state), sm.stateStringLocked(tabletType, state),
 		sm.target.Cell, sm.target.Keyspace, sm.target.Shard)
 	sm.handleGracePeriod(tabletType)
+	from := servingStateName(sm.target.TabletType, sm.state, sm.wantState)
 	sm.target.TabletType = tabletType
 	if sm.state == StateNotConnected {
 		// If we're transitioning out of StateNotConnected, we have
@@ -582,6 +613,7 @@ func (sm *stateManager) setState(tabletType topodatapb.TabletType, state serving
 		_, _ = sm.refreshReplHealthLocked()
 	}
 	sm.state = state
+	sm.transitions.Add([]string{from, servingStateName(tabletType, state, sm.wantState)}, 1)
 	// Broadcast also obtains a lock. Trigger in a goroutine to avoid a deadlock.
 	go sm.hcticks.Trigger()
 }
@@ -761,6 +793,13 @@ func (sm *stateManager) Target() querypb.Target {
 	return target
 }
 
+// ServingStateName returns the name of the current serving state.
+func (sm *stateManager) ServingStateName() string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return servingStateName(sm.target.TabletType, sm.state, sm.wantState)
+}
+
 // IsServingString returns the name of the current TabletServer state.
 func (sm *stateManager) IsServingString() string {
 	if sm.IsServing() {
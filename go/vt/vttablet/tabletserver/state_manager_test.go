@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -62,6 +63,60 @@ func TestStateManagerStateByName(t *testing.T) {
 	assert.Equal(t, "NOT_SERVING", sm.IsServingString())
 }
 
+func TestServingStateName(t *testing.T) {
+	testcases := []struct {
+		tabletType topodatapb.TabletType
+		state      servingState
+		wantState  servingState
+		want       string
+	}{{
+		tabletType: topodatapb.TabletType_MASTER,
+		state:      StateNotConnected,
+		wantState:  StateServing,
+		want:       "NotConnected",
+	}, {
+		tabletType: topodatapb.TabletType_REPLICA,
+		state:      StateNotServing,
+		wantState:  StateServing,
+		want:       "NotServing",
+	}, {
+		tabletType: topodatapb.TabletType_MASTER,
+		state:      StateServing,
+		wantState:  StateServing,
+		want:       "ServingRW",
+	}, {
+		tabletType: topodatapb.TabletType_RDONLY,
+		state:      StateServing,
+		wantState:  StateServing,
+		want:       "ServingRO",
+	}, {
+		tabletType: topodatapb.TabletType_MASTER,
+		state:      StateServing,
+		wantState:  StateNotServing,
+		want:       "Draining",
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, servingStateName(tcase.tabletType, tcase.state, tcase.wantState))
+	}
+}
+
+func TestStateManagerTransitionStats(t *testing.T) {
+	sm := newTestStateManager(t)
+	defer sm.StopService()
+	counts := sm.transitions.Counts()
+
+	err := sm.SetServingType(topodatapb.TabletType_MASTER, testNow, StateServing, "")
+	require.NoError(t, err)
+	assert.Equal(t, "ServingRW", sm.ServingStateName())
+	err = sm.SetServingType(topodatapb.TabletType_MASTER, testNow, StateNotServing, "")
+	require.NoError(t, err)
+	assert.Equal(t, "NotServing", sm.ServingStateName())
+
+	newCounts := sm.transitions.Counts()
+	assert.Equal(t, counts["NotConnected.ServingRW"]+1, newCounts["NotConnected.ServingRW"])
+	assert.Equal(t, counts["Draining.NotServing"]+1, newCounts["Draining.NotServing"])
+}
+
 func TestStateManagerServeMaster(t *testing.T) {
 	sm := newTestStateManager(t)
 	defer sm.StopService()
@@ -624,8 +679,10 @@ func TestStateManagerNotify(t *testing.T) {
 		Target: &querypb.Target{
 			TabletType: topodatapb.TabletType_REPLICA,
 		},
-		Serving:     true,
-		TabletAlias: &topodatapb.TabletAlias{},
+		Serving:             true,
+		TabletAlias:         &topodatapb.TabletAlias{},
+		QueryServiceVersion: queryservice.Version,
+		Capabilities:        queryservice.LocalCapabilities(),
 	}
 	sm.hcticks.Stop()
 	assert.Equal(t, wantshr, gotshr)
@@ -214,6 +214,17 @@ func (sf *StatefulConnectionPool) ForAllTxProperties(f func(*tx.Properties)) {
 	}
 }
 
+// ForAllTxConns executes a function on every connection that has a not-nil TxProperties,
+// along with the id of its MySQL connection.
+func (sf *StatefulConnectionPool) ForAllTxConns(f func(txID tx.ConnID, mysqlConnID int64, props *tx.Properties)) {
+	for _, connection := range mapToTxConn(sf.active.GetAll()) {
+		props, dbConn := connection.txProps, connection.dbConn
+		if props != nil && dbConn != nil {
+			f(connection.ConnID, dbConn.ID(), props)
+		}
+	}
+}
+
 // Unregister forgets the specified connection.  If the connection is not present, it's ignored.
 func (sf *StatefulConnectionPool) unregister(id tx.ConnID, reason string) {
 	sf.active.Unregister(id, reason)
@@ -242,6 +253,20 @@ func (sf *StatefulConnectionPool) Capacity() int {
 	return int(sf.conns.Capacity())
 }
 
+// Utilization returns the fraction of the pool connections currently in use.
+func (sf *StatefulConnectionPool) Utilization() float64 {
+	capacity := sf.conns.Capacity() + sf.foundRowsPool.Capacity()
+	if capacity == 0 {
+		return 0
+	}
+	return float64(sf.conns.InUse()+sf.foundRowsPool.InUse()) / float64(capacity)
+}
+
+// Waiters returns how many transactions are waiting for a connection.
+func (sf *StatefulConnectionPool) Waiters() int64 {
+	return sf.conns.Waiters() + sf.foundRowsPool.Waiters()
+}
+
 // renewConn unregister and registers with new id.
 func (sf *StatefulConnectionPool) renewConn(sc *StatefulConnection) error {
 	sf.active.Unregister(sc.ConnID, "renew existing connection")
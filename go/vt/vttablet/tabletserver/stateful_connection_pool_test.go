@@ -57,6 +57,26 @@ func TestActivePoolClientRowsFound(t *testing.T) {
 	assert.Equal(t, startFoundRowsSize, pool.conns.Available(), "default pool not restored after release")
 }
 
+func TestActivePoolUtilization(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	pool := newActivePool()
+	assert.Equal(t, 0.0, pool.Utilization())
+
+	pool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer pool.Close()
+	capacity := float64(2 * pool.Capacity())
+	conn1, err := pool.NewConn(ctx, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	conn2, err := pool.NewConn(ctx, &querypb.ExecuteOptions{ClientFoundRows: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2/capacity, pool.Utilization())
+
+	conn1.Release(tx.TxClose)
+	conn2.Release(tx.TxClose)
+	assert.Equal(t, 0.0, pool.Utilization())
+}
+
 func TestActivePoolForAllTxProps(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -34,12 +34,17 @@ import (
 
 // These constants represent values for various config parameters.
 const (
-	Enable      = "enable"
-	Disable     = "disable"
-	Dryrun      = "dryRun"
+	Enable       = "enable"
+	Disable      = "disable"
+	Dryrun       = "dryRun"
+	NotOnPrimary = "notOnPrimary"
+	Polling      = "polling"
+	Heartbeat    = "heartbeat"
+	Webhook      = "webhook"
+	OPA          = "opa"
+
+	// NotOnMaster is the deprecated name of NotOnPrimary.
 	NotOnMaster = "notOnMaster"
-	Polling     = "polling"
-	Heartbeat   = "heartbeat"
 )
 
 var (
@@ -73,6 +78,7 @@ var (
 	enableHotRowProtectionDryRun bool
 	enableConsolidator           bool
 	enableConsolidatorReplicas   bool
+	consolidatorMode             string
 	enableHeartbeat              bool
 	heartbeatInterval            time.Duration
 	healthCheckInterval          time.Duration
@@ -97,16 +103,25 @@ func init() {
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "how long to wait (in seconds) for queries and transactions to complete during graceful shutdown.")
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "transaction_shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "DEPRECATED: use shutdown_grace_period instead.")
 	flag.IntVar(&currentConfig.Oltp.MaxRows, "queryserver-config-max-result-size", defaultConfig.Oltp.MaxRows, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
+	flag.IntVar(&currentConfig.Oltp.TransientErrorRetries, "queryserver-config-transient-error-retries", defaultConfig.Oltp.TransientErrorRetries, "query server transient error retries, the number of times a statement executed in autocommit mode is retried after a deadlock or a lock wait timeout. 0 disables the retries.")
+	SecondsVar(&currentConfig.Oltp.TransientErrorBackoffSeconds, "queryserver-config-transient-error-backoff", defaultConfig.Oltp.TransientErrorBackoffSeconds, "query server transient error backoff (in seconds), how long vttablet waits before retrying a statement after a transient error")
 	flag.IntVar(&currentConfig.Oltp.WarnRows, "queryserver-config-warn-result-size", defaultConfig.Oltp.WarnRows, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
 	flag.IntVar(&deprecatedMaxDMLRows, "queryserver-config-max-dml-rows", 0, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.BoolVar(&currentConfig.PassthroughDML, "queryserver-config-passthrough-dmls", defaultConfig.PassthroughDML, "query server pass through all dml statements without rewriting")
 	flag.BoolVar(&deprecateAllowUnsafeDMLs, "queryserver-config-allowunsafe-dmls", false, "deprecated")
 
+	flag.IntVar(&currentConfig.StreamLimits.MaxRows, "queryserver-config-stream-max-rows", defaultConfig.StreamLimits.MaxRows, "query server stream max rows, maximum number of rows a streaming query can return. The query is killed when it exceeds this limit. 0 means unlimited. The limit can be overridden per table and per user in the streamLimits section of -tablet_config.")
+	flag.IntVar(&currentConfig.StreamLimits.MaxBytes, "queryserver-config-stream-max-bytes", defaultConfig.StreamLimits.MaxBytes, "query server stream max bytes, maximum number of bytes a streaming query can return. The query is killed when it exceeds this limit. 0 means unlimited. The limit can be overridden per table and per user in the streamLimits section of -tablet_config.")
+	flag.IntVar(&currentConfig.ExaminedRowsLimits.MaxRows, "queryserver-config-max-examined-rows", defaultConfig.ExaminedRowsLimits.MaxRows, "query server max examined rows, maximum number of rows a non-streaming select outside of a transaction is estimated to examine by its EXPLAIN, checked before executing it. 0 disables the check, which costs an EXPLAIN per select. The limit can be overridden per table in the examinedRowsLimits section of -tablet_config.")
+	flag.StringVar(&currentConfig.ExaminedRowsLimits.Action, "queryserver-config-examined-rows-action", defaultConfig.ExaminedRowsLimits.Action, "what to do with the selects over -queryserver-config-max-examined-rows: reject fails them, stream_pool executes them with the connections of the streaming queries, so that they don't exhaust the connections of the OLTP queries. Their results are still returned at once: only the streaming queries stream their results.")
 	flag.IntVar(&currentConfig.StreamBufferSize, "queryserver-config-stream-buffer-size", defaultConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call. It's recommended to keep this value in sync with vtgate's stream_buffer_size.")
 	flag.IntVar(&currentConfig.QueryCacheSize, "queryserver-config-query-cache-size", defaultConfig.QueryCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	flag.Int64Var(&currentConfig.QueryCacheMemory, "queryserver-config-query-cache-memory", defaultConfig.QueryCacheMemory, "query server query cache size in bytes, maximum amount of memory to be used for caching. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	flag.BoolVar(&currentConfig.QueryCacheLFU, "queryserver-config-query-cache-lfu", defaultConfig.QueryCacheLFU, "query server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
 	SecondsVar(&currentConfig.SchemaReloadIntervalSeconds, "queryserver-config-schema-reload-time", defaultConfig.SchemaReloadIntervalSeconds, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
+	SecondsVar(&currentConfig.SchemaErrorReloadIntervalSeconds, "queryserver-config-schema-error-reload-interval", defaultConfig.SchemaErrorReloadIntervalSeconds, "minimum time between two schema reloads triggered by queries failing with an unknown table or column error, in seconds. Such queries reload the schema and are retried once, instead of failing until the next periodic reload.")
+	SecondsVar(&currentConfig.LockObserverIntervalSeconds, "queryserver-config-lock-observer-interval", defaultConfig.LockObserverIntervalSeconds, "how often vttablet samples the InnoDB lock waits and the latest deadlock, and matches them with the queries and transactions it runs, in seconds. The results are shown in /debug/lock_waits. It needs the PROCESS privilege for the dba user. 0 disables it.")
+	SecondsVar(&currentConfig.TabletConfigRefreshIntervalSeconds, "queryserver-config-tablet-config-refresh-interval", defaultConfig.TabletConfigRefreshIntervalSeconds, "how often vttablet reloads the runtime settings saved in the topo for its keyspace and shard (see the SetTabletConfig vtctl command), and the drain state of its cell (see the DrainCell vtctl command), in seconds. 0 disables it.")
 	SecondsVar(&currentConfig.Oltp.QueryTimeoutSeconds, "queryserver-config-query-timeout", defaultConfig.Oltp.QueryTimeoutSeconds, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
 	SecondsVar(&currentConfig.OltpReadPool.TimeoutSeconds, "queryserver-config-query-pool-timeout", defaultConfig.OltpReadPool.TimeoutSeconds, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	SecondsVar(&currentConfig.OlapReadPool.TimeoutSeconds, "queryserver-config-stream-pool-timeout", defaultConfig.OlapReadPool.TimeoutSeconds, "query server stream pool timeout (in seconds), it is how long vttablet waits for a connection from the stream pool. If set to 0 (default) then there is no timeout.")
@@ -114,11 +129,27 @@ func init() {
 	SecondsVar(&currentConfig.OltpReadPool.IdleTimeoutSeconds, "queryserver-config-idle-timeout", defaultConfig.OltpReadPool.IdleTimeoutSeconds, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
 	flag.IntVar(&currentConfig.OltpReadPool.MaxWaiters, "queryserver-config-query-pool-waiter-cap", defaultConfig.OltpReadPool.MaxWaiters, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
 	flag.IntVar(&currentConfig.TxPool.MaxWaiters, "queryserver-config-txpool-waiter-cap", defaultConfig.TxPool.MaxWaiters, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
+	SecondsVar(&currentConfig.OltpReadPool.KillHeldSeconds, "queryserver-config-query-pool-kill-held-threshold", defaultConfig.OltpReadPool.KillHeldSeconds, "when a query times out waiting for a connection from the query pool, kill the connections of the pool held for longer than this, in seconds. The state of the pool is logged and shown in /debug/pool_diagnostics either way. 0 (default) never kills.")
+	SecondsVar(&currentConfig.OlapReadPool.KillHeldSeconds, "queryserver-config-stream-pool-kill-held-threshold", defaultConfig.OlapReadPool.KillHeldSeconds, "when a query times out waiting for a connection from the stream pool, kill the connections of the pool held for longer than this, in seconds. The state of the pool is logged and shown in /debug/pool_diagnostics either way. 0 (default) never kills.")
+	flag.BoolVar(&currentConfig.PoolDiagnostics, "queryserver-config-pool-diagnostics", defaultConfig.PoolDiagnostics, "track the connections in use of the pools, so that /debug/pool_diagnostics and the logs of the pool exhaustions list the longest held ones with their queries. It takes a lock of the pool on every use of a connection, so it is off by default. The pools with a kill held threshold track them either way.")
 	// tableacl related configurations.
+	flag.StringVar(&currentConfig.ExternalAuthz.Mode, "external_authz_mode", defaultConfig.ExternalAuthz.Mode, "external authorization of queries: disable, webhook or opa. In webhook mode, vttablet posts the user, table, plan type and sql of every query to -external_authz_url and expects {\"allowed\": true}. In opa mode, the url is an OPA data API path and the policy gets them as input.")
+	flag.StringVar(&currentConfig.ExternalAuthz.URL, "external_authz_url", defaultConfig.ExternalAuthz.URL, "url of the external authorization webhook or OPA policy")
+	SecondsVar(&currentConfig.ExternalAuthz.TimeoutSeconds, "external_authz_timeout", defaultConfig.ExternalAuthz.TimeoutSeconds, "timeout (in seconds) of an external authorization request")
+	SecondsVar(&currentConfig.ExternalAuthz.CacheTTLSeconds, "external_authz_cache_ttl", defaultConfig.ExternalAuthz.CacheTTLSeconds, "how long (in seconds) external authorization decisions are cached. 0 disables the cache.")
+	flag.IntVar(&currentConfig.ExternalAuthz.CacheSize, "external_authz_cache_size", defaultConfig.ExternalAuthz.CacheSize, "maximum number of cached external authorization decisions")
+	flag.BoolVar(&currentConfig.ExternalAuthz.FailOpen, "external_authz_fail_open", defaultConfig.ExternalAuthz.FailOpen, "if true, queries are allowed when the external authorization service cannot be reached")
+	flag.BoolVar(&currentConfig.Admission.Enable, "enable_admission_control", defaultConfig.Admission.Enable, "If true, the requests are admitted by the priority class of their effective caller id: when the connection pool of a request or the CPUs, as measured by -admission_control_cpu_source, are used over -admission_control_batch_threshold, the batch requests are rejected, and over -admission_control_normal_threshold, the normal requests too. The critical requests are always admitted.")
+	flag.Float64Var(&currentConfig.Admission.BatchThreshold, "admission_control_batch_threshold", defaultConfig.Admission.BatchThreshold, "fraction of a connection pool or of the CPUs in use from which the batch requests are rejected")
+	flag.Float64Var(&currentConfig.Admission.NormalThreshold, "admission_control_normal_threshold", defaultConfig.Admission.NormalThreshold, "fraction of a connection pool or of the CPUs in use from which the normal requests are rejected")
+	flag.StringVar(&currentConfig.Admission.CPUSource, "admission_control_cpu_source", defaultConfig.Admission.CPUSource, "how the admission control measures the CPU usage, as a proxy for the one of mysqld, which it can't read: host reads the CPUs of the host in /proc/stat, including the ones of a mysqld on the same host, vttablet reads the CPU time of vttablet relative to GOMAXPROCS, which follows the query load but not the cost of the queries in mysqld, and none ignores the CPUs, e.g. for a remote mysqld")
 	flag.BoolVar(&currentConfig.StrictTableACL, "queryserver-config-strict-table-acl", defaultConfig.StrictTableACL, "only allow queries that pass table acl checks")
 	flag.BoolVar(&currentConfig.EnableTableACLDryRun, "queryserver-config-enable-table-acl-dry-run", defaultConfig.EnableTableACLDryRun, "If this flag is enabled, tabletserver will emit monitoring metrics and let the request pass regardless of table acl check results")
 	flag.StringVar(&currentConfig.TableACLExemptACL, "queryserver-config-acl-exempt-acl", defaultConfig.TableACLExemptACL, "an acl that exempt from table acl checking (this acl is free to access any vitess tables).")
 	flag.BoolVar(&currentConfig.TerseErrors, "queryserver-config-terse-errors", defaultConfig.TerseErrors, "prevent bind vars from escaping in returned errors")
+	flag.BoolVar(&currentConfig.AttributionComments, "queryserver-config-attribution-comments", defaultConfig.AttributionComments, "append a comment with the caller id, workload and transaction id to the queries sent to MySQL, so that the slow log and performance_schema can attribute them to their callers")
+	flag.BoolVar(&currentConfig.StrictBindVarTypes, "queryserver-config-strict-bind-var-types", defaultConfig.StrictBindVarTypes, "reject the queries comparing a primary key column with a bind variable of a mismatching type, like a string that is not a number for an integer column, instead of only counting them in BindVarTypeMismatches")
+	flag.StringVar(&currentConfig.ForeignKeyMode, "queryserver-config-foreign-key-mode", defaultConfig.ForeignKeyMode, "foreign key mode: disable or block. In block mode, vttablet tracks the foreign keys of the schema and rejects the updates and deletes of the parent tables whose foreign keys cascade to their child tables, because MySQL doesn't write the changes of the child rows to the binlogs, and the online DDL migrations of the tables with foreign keys, which the table copy would break")
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", false, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
@@ -129,6 +160,7 @@ func init() {
 	flagutil.DualFormatBoolVar(&currentConfig.EnableTxThrottler, "enable_tx_throttler", defaultConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flagutil.DualFormatStringVar(&currentConfig.TxThrottlerConfig, "tx_throttler_config", defaultConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
 	flagutil.DualFormatStringListVar(&currentConfig.TxThrottlerHealthCheckCells, "tx_throttler_healthcheck_cells", defaultConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
+	SecondsVar(&currentConfig.TxThrottlerMaxDelay, "tx_throttler_max_delay", defaultConfig.TxThrottlerMaxDelay, "time in seconds. If non-zero, the transactions throttled because of replication lag wait up to this long for the transaction throttler to let them through, instead of being rejected right away.")
 
 	flag.BoolVar(&enableHotRowProtection, "enable_hot_row_protection", false, "If true, incoming transactions for the same row (range) will be queued and cannot consume all txpool slots.")
 	flag.BoolVar(&enableHotRowProtectionDryRun, "enable_hot_row_protection_dry_run", false, "If true, hot row protection is not enforced but logs if transactions would have been queued.")
@@ -151,6 +183,7 @@ func init() {
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
 	flagutil.DualFormatBoolVar(&enableConsolidatorReplicas, "enable_consolidator_replicas", false, "This option enables the query consolidator only on replicas.")
+	flag.StringVar(&consolidatorMode, "consolidator_mode", "", "Query consolidator mode: enable, disable or notOnPrimary. If set, it overrides -enable_consolidator and -enable_consolidator_replicas.")
 	flagutil.DualFormatBoolVar(&currentConfig.CacheResultFields, "enable_query_plan_field_caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
 
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
@@ -179,8 +212,10 @@ func Init() {
 	}
 
 	switch {
+	case consolidatorMode != "":
+		currentConfig.Consolidator = consolidatorMode
 	case enableConsolidatorReplicas:
-		currentConfig.Consolidator = NotOnMaster
+		currentConfig.Consolidator = NotOnPrimary
 	case enableConsolidator:
 		currentConfig.Consolidator = Enable
 	default:
@@ -235,25 +270,42 @@ type TabletConfig struct {
 
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
+	StreamLimits     StreamLimitsConfig     `json:"streamLimits,omitempty"`
+
+	ExaminedRowsLimits ExaminedRowsLimitsConfig `json:"examinedRowsLimits,omitempty"`
 
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
 
 	ReplicationTracker ReplicationTrackerConfig `json:"replicationTracker,omitempty"`
 
-	// Consolidator can be enable, disable, or notOnMaster. Default is enable.
-	Consolidator                string  `json:"consolidator,omitempty"`
-	PassthroughDML              bool    `json:"passthroughDML,omitempty"`
-	StreamBufferSize            int     `json:"streamBufferSize,omitempty"`
-	QueryCacheSize              int     `json:"queryCacheSize,omitempty"`
-	QueryCacheMemory            int64   `json:"queryCacheMemory,omitempty"`
-	QueryCacheLFU               bool    `json:"queryCacheLFU,omitempty"`
-	SchemaReloadIntervalSeconds Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
-	WatchReplication            bool    `json:"watchReplication,omitempty"`
-	TrackSchemaVersions         bool    `json:"trackSchemaVersions,omitempty"`
-	TerseErrors                 bool    `json:"terseErrors,omitempty"`
-	MessagePostponeParallelism  int     `json:"messagePostponeParallelism,omitempty"`
-	CacheResultFields           bool    `json:"cacheResultFields,omitempty"`
+	ExternalAuthz ExternalAuthzConfig `json:"externalAuthz,omitempty"`
+
+	Admission AdmissionConfig `json:"admission,omitempty"`
+
+	// Consolidator can be enable, disable, or notOnPrimary. Default is enable.
+	Consolidator                     string  `json:"consolidator,omitempty"`
+	PassthroughDML                   bool    `json:"passthroughDML,omitempty"`
+	StreamBufferSize                 int     `json:"streamBufferSize,omitempty"`
+	QueryCacheSize                   int     `json:"queryCacheSize,omitempty"`
+	QueryCacheMemory                 int64   `json:"queryCacheMemory,omitempty"`
+	QueryCacheLFU                    bool    `json:"queryCacheLFU,omitempty"`
+	SchemaReloadIntervalSeconds      Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
+	SchemaErrorReloadIntervalSeconds Seconds `json:"schemaErrorReloadIntervalSeconds,omitempty"`
+	WatchReplication                 bool    `json:"watchReplication,omitempty"`
+	TrackSchemaVersions              bool    `json:"trackSchemaVersions,omitempty"`
+	TerseErrors                      bool    `json:"terseErrors,omitempty"`
+	AttributionComments              bool    `json:"attributionComments,omitempty"`
+	PoolDiagnostics                  bool    `json:"poolDiagnostics,omitempty"`
+	StrictBindVarTypes               bool    `json:"strictBindVarTypes,omitempty"`
+	MessagePostponeParallelism       int     `json:"messagePostponeParallelism,omitempty"`
+	CacheResultFields                bool    `json:"cacheResultFields,omitempty"`
+
+	// ForeignKeyMode can be disable or block. Default is disable.
+	ForeignKeyMode string `json:"foreignKeyMode,omitempty"`
+
+	TabletConfigRefreshIntervalSeconds Seconds `json:"tabletConfigRefreshIntervalSeconds,omitempty"`
+	LockObserverIntervalSeconds        Seconds `json:"lockObserverIntervalSeconds,omitempty"`
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
@@ -267,6 +319,7 @@ type TabletConfig struct {
 	EnableTxThrottler           bool     `json:"-"`
 	TxThrottlerConfig           string   `json:"-"`
 	TxThrottlerHealthCheckCells []string `json:"-"`
+	TxThrottlerMaxDelay         Seconds  `json:"-"`
 
 	EnableLagThrottler bool `json:"-"`
 
@@ -282,6 +335,7 @@ type ConnPoolConfig struct {
 	IdleTimeoutSeconds Seconds `json:"idleTimeoutSeconds,omitempty"`
 	PrefillParallelism int     `json:"prefillParallelism,omitempty"`
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
+	KillHeldSeconds    Seconds `json:"killHeldSeconds,omitempty"`
 }
 
 // OltpConfig contains the config for oltp settings.
@@ -290,6 +344,84 @@ type OltpConfig struct {
 	TxTimeoutSeconds    Seconds `json:"txTimeoutSeconds,omitempty"`
 	MaxRows             int     `json:"maxRpws,omitempty"`
 	WarnRows            int     `json:"warnRows,omitempty"`
+
+	// TransientErrorRetries is the number of times a statement executed
+	// in autocommit mode is retried after a transient MySQL error.
+	TransientErrorRetries        int     `json:"transientErrorRetries,omitempty"`
+	TransientErrorBackoffSeconds Seconds `json:"transientErrorBackoffSeconds,omitempty"`
+}
+
+// StreamLimitsConfig contains the limits of the rows and bytes returned
+// by a streaming query. A query that exceeds them is killed.
+type StreamLimitsConfig struct {
+	MaxRows  int `json:"maxRows,omitempty"`
+	MaxBytes int `json:"maxBytes,omitempty"`
+
+	// Tables and Users override the limits for the queries on a table,
+	// and for the queries of a user. The user limits take precedence.
+	Tables map[string]StreamLimit `json:"tables,omitempty"`
+	Users  map[string]StreamLimit `json:"users,omitempty"`
+}
+
+// StreamLimit is a limit of StreamLimitsConfig. 0 means unlimited.
+type StreamLimit struct {
+	MaxRows  int `json:"maxRows,omitempty"`
+	MaxBytes int `json:"maxBytes,omitempty"`
+}
+
+// Get returns the limit of the queries of user on table.
+func (c *StreamLimitsConfig) Get(table, user string) StreamLimit {
+	if limit, ok := c.Users[user]; ok {
+		return limit
+	}
+	if limit, ok := c.Tables[table]; ok {
+		return limit
+	}
+	return StreamLimit{MaxRows: c.MaxRows, MaxBytes: c.MaxBytes}
+}
+
+// ForeignKeyBlock is the ForeignKeyMode that blocks the DMLs and the
+// online DDLs the foreign keys make unsafe.
+const ForeignKeyBlock = "block"
+
+// Actions of ExaminedRowsLimitsConfig.
+const (
+	ExaminedRowsReject     = "reject"
+	ExaminedRowsStreamPool = "stream_pool"
+)
+
+// CPU sources of AdmissionConfig.
+const (
+	AdmissionCPUHost     = "host"
+	AdmissionCPUVttablet = "vttablet"
+	AdmissionCPUNone     = "none"
+)
+
+// ExaminedRowsLimitsConfig contains the limits of the rows a select is
+// estimated to examine by its EXPLAIN. They are checked before executing
+// the non-streaming selects outside of a transaction.
+type ExaminedRowsLimitsConfig struct {
+	// MaxRows is the limit of the rows examined by the select in total.
+	MaxRows int `json:"maxRows,omitempty"`
+	// Action can be reject or stream_pool. Default is reject.
+	Action string `json:"action,omitempty"`
+
+	// Tables are the limits of the selects on a table. They
+	// override MaxRows.
+	Tables map[string]int `json:"tables,omitempty"`
+}
+
+// Enabled returns true if the selects must be checked.
+func (c *ExaminedRowsLimitsConfig) Enabled() bool {
+	return c.MaxRows > 0 || len(c.Tables) > 0
+}
+
+// Get returns the limit of the selects on a table, 0 if there is none.
+func (c *ExaminedRowsLimitsConfig) Get(tableName string) int {
+	if limit, ok := c.Tables[tableName]; ok {
+		return limit
+	}
+	return c.MaxRows
 }
 
 // HotRowProtectionConfig contains the config for hot row protection.
@@ -322,6 +454,33 @@ type ReplicationTrackerConfig struct {
 	HeartbeatIntervalSeconds Seconds `json:"heartbeatIntervalSeconds,omitempty"`
 }
 
+// ExternalAuthzConfig contains the config for the external authorization
+// of queries.
+type ExternalAuthzConfig struct {
+	// Mode can be disable, webhook or opa. Default is disable.
+	Mode            string  `json:"mode,omitempty"`
+	URL             string  `json:"url,omitempty"`
+	TimeoutSeconds  Seconds `json:"timeoutSeconds,omitempty"`
+	CacheTTLSeconds Seconds `json:"cacheTTLSeconds,omitempty"`
+	CacheSize       int     `json:"cacheSize,omitempty"`
+	FailOpen        bool    `json:"failOpen,omitempty"`
+}
+
+// AdmissionConfig contains the config for the admission of the requests
+// by priority class.
+type AdmissionConfig struct {
+	Enable bool `json:"enable,omitempty"`
+	// BatchThreshold and NormalThreshold are the pressures from which the
+	// batch and the normal requests are rejected. The pressure is the
+	// largest fraction in use of the connection pool of the request and
+	// of the CPUs.
+	BatchThreshold  float64 `json:"batchThreshold,omitempty"`
+	NormalThreshold float64 `json:"normalThreshold,omitempty"`
+	// CPUSource is how the CPU usage is measured. vttablet can't read the
+	// one of mysqld, so the sources are proxies for it.
+	CPUSource string `json:"cpuSource,omitempty"`
+}
+
 // TransactionLimitConfig captures configuration of transaction pool slots
 // limiter configuration.
 type TransactionLimitConfig struct {
@@ -358,6 +517,22 @@ func (c *TabletConfig) Verify() error {
 	if err := c.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	mode, err := ParseConsolidatorMode(c.Consolidator)
+	if err != nil {
+		return err
+	}
+	c.Consolidator = mode
+	if err := c.verifyExternalAuthzConfig(); err != nil {
+		return err
+	}
+	if batch, normal := c.Admission.BatchThreshold, c.Admission.NormalThreshold; batch <= 0 || batch > normal || normal > 1 {
+		return fmt.Errorf("admission thresholds must be within 0 < -admission_control_batch_threshold <= -admission_control_normal_threshold <= 1 (specified values: %v, %v)", batch, normal)
+	}
+	switch v := c.Admission.CPUSource; v {
+	case AdmissionCPUHost, AdmissionCPUVttablet, AdmissionCPUNone:
+	default:
+		return fmt.Errorf("-admission_control_cpu_source must be %s, %s or %s (specified value: %v)", AdmissionCPUHost, AdmissionCPUVttablet, AdmissionCPUNone, v)
+	}
 	if v := c.HotRowProtection.MaxQueueSize; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_max_queue_size must be > 0 (specified value: %v)", v)
 	}
@@ -370,6 +545,47 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	switch v := c.ExaminedRowsLimits.Action; v {
+	case ExaminedRowsReject, ExaminedRowsStreamPool:
+	default:
+		return fmt.Errorf("-queryserver-config-examined-rows-action must be %s or %s (specified value: %v)", ExaminedRowsReject, ExaminedRowsStreamPool, v)
+	}
+	switch v := c.ForeignKeyMode; v {
+	case Disable, ForeignKeyBlock:
+	default:
+		return fmt.Errorf("-queryserver-config-foreign-key-mode must be %s or %s (specified value: %v)", Disable, ForeignKeyBlock, v)
+	}
+	return nil
+}
+
+// ParseConsolidatorMode validates a consolidator mode and returns
+// its canonical name. The deprecated notOnMaster is accepted as
+// notOnPrimary.
+func ParseConsolidatorMode(mode string) (string, error) {
+	switch mode {
+	case Enable, Disable, NotOnPrimary:
+		return mode, nil
+	case NotOnMaster:
+		return NotOnPrimary, nil
+	}
+	return "", fmt.Errorf("invalid consolidator mode: %s, must be one of %s, %s or %s", mode, Enable, Disable, NotOnPrimary)
+}
+
+// verifyExternalAuthzConfig checks ExternalAuthzConfig for sanity.
+func (c *TabletConfig) verifyExternalAuthzConfig() error {
+	switch c.ExternalAuthz.Mode {
+	case "", Disable:
+		return nil
+	case Webhook, OPA:
+	default:
+		return fmt.Errorf("-external_authz_mode must be one of %s, %s or %s (specified value: %v)", Disable, Webhook, OPA, c.ExternalAuthz.Mode)
+	}
+	if c.ExternalAuthz.URL == "" {
+		return errors.New("-external_authz_url is required when -external_authz_mode is set")
+	}
+	if c.ExternalAuthz.TimeoutSeconds <= 0 {
+		return fmt.Errorf("-external_authz_timeout must be > 0 (specified value: %v)", c.ExternalAuthz.TimeoutSeconds)
+	}
 	return nil
 }
 
@@ -425,6 +641,8 @@ var defaultConfig = TabletConfig{
 		QueryTimeoutSeconds: 30,
 		TxTimeoutSeconds:    30,
 		MaxRows:             10000,
+
+		TransientErrorBackoffSeconds: 0.01,
 	},
 	Healthcheck: HealthcheckConfig{
 		IntervalSeconds:           20,
@@ -444,20 +662,38 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
-	Consolidator: Enable,
+	ExaminedRowsLimits: ExaminedRowsLimitsConfig{
+		Action: ExaminedRowsReject,
+	},
+	ExternalAuthz: ExternalAuthzConfig{
+		Mode:            Disable,
+		TimeoutSeconds:  1,
+		CacheTTLSeconds: 60,
+		CacheSize:       10000,
+	},
+	Admission: AdmissionConfig{
+		BatchThreshold:  0.7,
+		NormalThreshold: 0.9,
+		CPUSource:       AdmissionCPUHost,
+	},
+	Consolidator:   Enable,
+	ForeignKeyMode: Disable,
 	// The value for StreamBufferSize was chosen after trying out a few of
 	// them. Too small buffers force too many packets to be sent. Too big
 	// buffers force the clients to read them in multiple chunks and make
 	// memory copies.  so with the encoding overhead, this seems to work
 	// great (the overhead makes the final packets on the wire about twice
 	// bigger than this).
-	StreamBufferSize:            32 * 1024,
-	QueryCacheSize:              int(cache.DefaultConfig.MaxEntries),
-	QueryCacheMemory:            cache.DefaultConfig.MaxMemoryUsage,
-	QueryCacheLFU:               cache.DefaultConfig.LFU,
-	SchemaReloadIntervalSeconds: 30 * 60,
-	MessagePostponeParallelism:  4,
-	CacheResultFields:           true,
+	StreamBufferSize:                 32 * 1024,
+	QueryCacheSize:                   int(cache.DefaultConfig.MaxEntries),
+	QueryCacheMemory:                 cache.DefaultConfig.MaxMemoryUsage,
+	QueryCacheLFU:                    cache.DefaultConfig.LFU,
+	SchemaReloadIntervalSeconds:      30 * 60,
+	SchemaErrorReloadIntervalSeconds: 10,
+	MessagePostponeParallelism:       4,
+	CacheResultFields:                true,
+
+	TabletConfigRefreshIntervalSeconds: 30,
 
 	EnableTxThrottler:           false,
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
@@ -49,7 +49,8 @@ func TestConfigParse(t *testing.T) {
 	}
 	gotBytes, err := yaml2.Marshal(&cfg)
 	require.NoError(t, err)
-	wantBytes := `db:
+	wantBytes := `admission: {}
+db:
   allprivs:
     password: '****'
   app:
@@ -65,6 +66,8 @@ func TestConfigParse(t *testing.T) {
   repl:
     password: '****'
   socket: a
+examinedRowsLimits: {}
+externalAuthz: {}
 gracePeriods: {}
 healthcheck: {}
 hotRowProtection: {}
@@ -77,6 +80,7 @@ oltpReadPool:
   size: 16
   timeoutSeconds: 10
 replicationTracker: {}
+streamLimits: {}
 txPool: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
@@ -107,8 +111,20 @@ oltpReadPool:
 func TestDefaultConfig(t *testing.T) {
 	gotBytes, err := yaml2.Marshal(NewDefaultConfig())
 	require.NoError(t, err)
-	want := `cacheResultFields: true
+	want := `admission:
+  batchThreshold: 0.7
+  cpuSource: host
+  normalThreshold: 0.9
+cacheResultFields: true
 consolidator: enable
+examinedRowsLimits:
+  action: reject
+externalAuthz:
+  cacheSize: 10000
+  cacheTTLSeconds: 60
+  mode: disable
+  timeoutSeconds: 1
+foreignKeyMode: disable
 gracePeriods: {}
 healthcheck:
   degradedThresholdSeconds: 30
@@ -126,6 +142,7 @@ olapReadPool:
 oltp:
   maxRpws: 10000
   queryTimeoutSeconds: 30
+  transientErrorBackoffSeconds: 0.01
   txTimeoutSeconds: 30
 oltpReadPool:
   idleTimeoutSeconds: 1800
@@ -137,8 +154,11 @@ queryCacheSize: 5000
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+schemaErrorReloadIntervalSeconds: 10
 schemaReloadIntervalSeconds: 1800
 streamBufferSize: 32768
+streamLimits: {}
+tabletConfigRefreshIntervalSeconds: 30
 txPool:
   idleTimeoutSeconds: 1800
   maxWaiters: 5000
@@ -186,20 +206,41 @@ func TestFlags(t *testing.T) {
 			QueryTimeoutSeconds: 30,
 			TxTimeoutSeconds:    30,
 			MaxRows:             10000,
+
+			TransientErrorBackoffSeconds: 0.01,
 		},
 		HotRowProtection: HotRowProtectionConfig{
 			MaxQueueSize:       20,
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
-		StreamBufferSize:            32768,
-		QueryCacheSize:              int(cache.DefaultConfig.MaxEntries),
-		QueryCacheMemory:            cache.DefaultConfig.MaxMemoryUsage,
-		QueryCacheLFU:               cache.DefaultConfig.LFU,
-		SchemaReloadIntervalSeconds: 1800,
-		TrackSchemaVersions:         false,
-		MessagePostponeParallelism:  4,
-		CacheResultFields:           true,
+		ExaminedRowsLimits: ExaminedRowsLimitsConfig{
+			Action: ExaminedRowsReject,
+		},
+		ExternalAuthz: ExternalAuthzConfig{
+			Mode:            Disable,
+			TimeoutSeconds:  1,
+			CacheTTLSeconds: 60,
+			CacheSize:       10000,
+		},
+		Admission: AdmissionConfig{
+			BatchThreshold:  0.7,
+			NormalThreshold: 0.9,
+			CPUSource:       AdmissionCPUHost,
+		},
+		StreamBufferSize:                 32768,
+		QueryCacheSize:                   int(cache.DefaultConfig.MaxEntries),
+		QueryCacheMemory:                 cache.DefaultConfig.MaxMemoryUsage,
+		QueryCacheLFU:                    cache.DefaultConfig.LFU,
+		SchemaReloadIntervalSeconds:      1800,
+		SchemaErrorReloadIntervalSeconds: 10,
+		TrackSchemaVersions:              false,
+		MessagePostponeParallelism:       4,
+		CacheResultFields:                true,
+		ForeignKeyMode:                   Disable,
+
+		TabletConfigRefreshIntervalSeconds: 30,
+
 		TxThrottlerConfig:           "target_replication_lag_sec: 2\nmax_replication_lag_sec: 10\ninitial_rate: 100\nmax_increase: 1\nemergency_decrease: 0.5\nmin_duration_between_increases_sec: 40\nmax_duration_between_increases_sec: 62\nmin_duration_between_decreases_sec: 20\nspread_backlog_across_sec: 20\nage_bad_rate_after_sec: 180\nbad_rate_increase: 0.1\nmax_rate_approach_threshold: 0.9\n",
 		TxThrottlerHealthCheckCells: []string{},
 		TransactionLimitConfig: TransactionLimitConfig{
@@ -254,7 +295,7 @@ func TestFlags(t *testing.T) {
 	enableConsolidator = true
 	enableConsolidatorReplicas = true
 	Init()
-	want.Consolidator = NotOnMaster
+	want.Consolidator = NotOnPrimary
 	assert.Equal(t, want, currentConfig)
 
 	enableConsolidator = true
@@ -266,7 +307,7 @@ func TestFlags(t *testing.T) {
 	enableConsolidator = false
 	enableConsolidatorReplicas = true
 	Init()
-	want.Consolidator = NotOnMaster
+	want.Consolidator = NotOnPrimary
 	assert.Equal(t, want, currentConfig)
 
 	enableConsolidator = false
@@ -275,6 +316,16 @@ func TestFlags(t *testing.T) {
 	want.Consolidator = Disable
 	assert.Equal(t, want, currentConfig)
 
+	consolidatorMode = NotOnPrimary
+	Init()
+	want.Consolidator = NotOnPrimary
+	assert.Equal(t, want, currentConfig)
+
+	consolidatorMode = ""
+	Init()
+	want.Consolidator = Disable
+	assert.Equal(t, want, currentConfig)
+
 	enableHeartbeat = true
 	heartbeatInterval = 1 * time.Second
 	currentConfig.ReplicationTracker.Mode = ""
@@ -326,3 +377,105 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestVerifyConsolidator(t *testing.T) {
+	cfg := NewDefaultConfig()
+	for _, mode := range []string{Enable, Disable, NotOnPrimary} {
+		cfg.Consolidator = mode
+		require.NoError(t, cfg.Verify())
+		assert.Equal(t, mode, cfg.Consolidator)
+	}
+
+	cfg.Consolidator = NotOnMaster
+	require.NoError(t, cfg.Verify())
+	assert.Equal(t, NotOnPrimary, cfg.Consolidator)
+
+	cfg.Consolidator = "onlyOnPrimary"
+	assert.EqualError(t, cfg.Verify(), "invalid consolidator mode: onlyOnPrimary, must be one of enable, disable or notOnPrimary")
+}
+
+func TestVerifyExternalAuthz(t *testing.T) {
+	cfg := NewDefaultConfig()
+	require.NoError(t, cfg.Verify())
+
+	cfg.ExternalAuthz.Mode = Webhook
+	assert.EqualError(t, cfg.Verify(), "-external_authz_url is required when -external_authz_mode is set")
+
+	cfg.ExternalAuthz.URL = "http://localhost:8181/v1/data/vitess/allow"
+	require.NoError(t, cfg.Verify())
+	cfg.ExternalAuthz.Mode = OPA
+	require.NoError(t, cfg.Verify())
+
+	cfg.ExternalAuthz.TimeoutSeconds = 0
+	assert.EqualError(t, cfg.Verify(), "-external_authz_timeout must be > 0 (specified value: 0)")
+
+	cfg.ExternalAuthz.Mode = "ldap"
+	assert.EqualError(t, cfg.Verify(), "-external_authz_mode must be one of disable, webhook or opa (specified value: ldap)")
+}
+
+func TestVerifyAdmission(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Admission.Enable = true
+	require.NoError(t, cfg.Verify())
+
+	cfg.Admission.BatchThreshold = 0.95
+	assert.EqualError(t, cfg.Verify(), "admission thresholds must be within 0 < -admission_control_batch_threshold <= -admission_control_normal_threshold <= 1 (specified values: 0.95, 0.9)")
+
+	cfg.Admission.BatchThreshold = 0
+	assert.Error(t, cfg.Verify())
+
+	cfg = NewDefaultConfig()
+	cfg.Admission.CPUSource = "mysqld"
+	assert.EqualError(t, cfg.Verify(), "-admission_control_cpu_source must be host, vttablet or none (specified value: mysqld)")
+}
+
+func TestStreamLimits(t *testing.T) {
+	inBytes := []byte(`streamLimits:
+  maxRows: 1000
+  maxBytes: 1000000
+  tables:
+    t1:
+      maxRows: 10
+  users:
+    batch:
+      maxBytes: 0
+`)
+	var cfg TabletConfig
+	require.NoError(t, yaml2.Unmarshal(inBytes, &cfg))
+	limits := &cfg.StreamLimits
+	assert.Equal(t, StreamLimit{MaxRows: 1000, MaxBytes: 1000000}, limits.Get("t2", "user"))
+	assert.Equal(t, StreamLimit{MaxRows: 10}, limits.Get("t1", "user"))
+	assert.Equal(t, StreamLimit{}, limits.Get("t1", "batch"))
+}
+
+func TestExaminedRowsLimits(t *testing.T) {
+	inBytes := []byte(`examinedRowsLimits:
+  maxRows: 100000
+  action: stream_pool
+  tables:
+    t1: 1000
+`)
+	cfg := NewDefaultConfig()
+	require.NoError(t, yaml2.Unmarshal(inBytes, cfg))
+	require.NoError(t, cfg.Verify())
+	limits := &cfg.ExaminedRowsLimits
+	assert.True(t, limits.Enabled())
+	assert.Equal(t, ExaminedRowsStreamPool, limits.Action)
+	assert.Equal(t, 100000, limits.Get("t2"))
+	assert.Equal(t, 1000, limits.Get("t1"))
+
+	cfg.ExaminedRowsLimits.Action = "kill"
+	assert.EqualError(t, cfg.Verify(), "-queryserver-config-examined-rows-action must be reject or stream_pool (specified value: kill)")
+	assert.False(t, NewDefaultConfig().ExaminedRowsLimits.Enabled())
+}
+
+func TestVerifyForeignKeyMode(t *testing.T) {
+	cfg := NewDefaultConfig()
+	require.NoError(t, cfg.Verify())
+
+	cfg.ForeignKeyMode = ForeignKeyBlock
+	require.NoError(t, cfg.Verify())
+
+	cfg.ForeignKeyMode = "cascade"
+	assert.EqualError(t, cfg.Verify(), "-queryserver-config-foreign-key-mode must be disable or block (specified value: cascade)")
+}
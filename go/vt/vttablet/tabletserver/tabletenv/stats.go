@@ -34,9 +34,19 @@ type Stats struct {
 	ErrorCounters          *stats.CountersWithSingleLabel
 	InternalErrors         *stats.CountersWithSingleLabel
 	Warnings               *stats.CountersWithSingleLabel
+	MySQLErrors            *stats.CountersWithSingleLabel // MySQL errors per class
+	TransientErrorRetries  *stats.CountersWithSingleLabel // Autocommit statements retried per error class
+	SchemaErrorReloads     *stats.CountersWithSingleLabel // Schema reloads caused by unknown table/column errors
+	StreamLimitKills       *stats.CountersWithMultiLabels // Per CallerID/table streaming queries killed for exceeding their limits
+	ExaminedRowsLimitHits  *stats.CountersWithMultiLabels // Per table/action selects estimated to examine too many rows
+	BindVarTypeMismatches  *stats.CountersWithMultiLabels // Per table/column bind variables not matching the type of a primary key column
 	Unresolved             *stats.GaugesWithSingleLabel   // For now, only Prepares are tracked
 	UserTableQueryCount    *stats.CountersWithMultiLabels // Per CallerID/table counts
 	UserTableQueryTimesNs  *stats.CountersWithMultiLabels // Per CallerID/table latencies
+	UserTableMysqlTimesNs  *stats.CountersWithMultiLabels // Per CallerID/table MySQL time
+	UserTableRowsReturned  *stats.CountersWithMultiLabels // Per CallerID/table rows returned
+	UserTableRowsAffected  *stats.CountersWithMultiLabels // Per CallerID/table rows affected
+	UserTableBytesReturned *stats.CountersWithMultiLabels // Per CallerID/table bytes returned
 	UserTransactionCount   *stats.CountersWithMultiLabels // Per CallerID transaction counts
 	UserTransactionTimesNs *stats.CountersWithMultiLabels // Per CallerID transaction latencies
 	ResultHistogram        *stats.Histogram               // Row count histograms
@@ -80,9 +90,19 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		),
 		InternalErrors:         exporter.NewCountersWithSingleLabel("InternalErrors", "Internal component errors", "type", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "WatchdogFail", "Messages"),
 		Warnings:               exporter.NewCountersWithSingleLabel("Warnings", "Warnings", "type", "ResultsExceeded"),
+		MySQLErrors:            exporter.NewCountersWithSingleLabel("MysqlErrors", "MySQL errors per error class", "class"),
+		TransientErrorRetries:  exporter.NewCountersWithSingleLabel("TransientErrorRetries", "Autocommit statements retried after a transient MySQL error", "class"),
+		SchemaErrorReloads:     exporter.NewCountersWithSingleLabel("SchemaErrorReloads", "Schema reloads triggered by an unknown table or column error", "table"),
+		StreamLimitKills:       exporter.NewCountersWithMultiLabels("StreamLimitKills", "Streaming queries killed for exceeding their row or byte limit for each CallerID/table combination", []string{"TableName", "CallerID"}),
+		ExaminedRowsLimitHits:  exporter.NewCountersWithMultiLabels("ExaminedRowsLimitHits", "Selects estimated to examine more rows than their limit for each table/action combination", []string{"TableName", "Action"}),
+		BindVarTypeMismatches:  exporter.NewCountersWithMultiLabels("BindVarTypeMismatches", "Bind variables compared with a primary key column of a mismatching type for each table/column combination", []string{"TableName", "Column"}),
 		Unresolved:             exporter.NewGaugesWithSingleLabel("Unresolved", "Unresolved items", "item_type", "Prepares"),
 		UserTableQueryCount:    exporter.NewCountersWithMultiLabels("UserTableQueryCount", "Queries received for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
 		UserTableQueryTimesNs:  exporter.NewCountersWithMultiLabels("UserTableQueryTimesNs", "Total latency for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
+		UserTableMysqlTimesNs:  exporter.NewCountersWithMultiLabels("UserTableMysqlTimesNs", "Total MySQL time for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
+		UserTableRowsReturned:  exporter.NewCountersWithMultiLabels("UserTableRowsReturned", "Rows returned for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
+		UserTableRowsAffected:  exporter.NewCountersWithMultiLabels("UserTableRowsAffected", "Rows affected for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
+		UserTableBytesReturned: exporter.NewCountersWithMultiLabels("UserTableBytesReturned", "Bytes returned for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
 		UserTransactionCount:   exporter.NewCountersWithMultiLabels("UserTransactionCount", "transactions received for each CallerID", []string{"CallerID", "Conclusion"}),
 		UserTransactionTimesNs: exporter.NewCountersWithMultiLabels("UserTransactionTimesNs", "Total transaction latency for each CallerID", []string{"CallerID", "Conclusion"}),
 		ResultHistogram:        exporter.NewHistogram("Results", "Distribution of rows returned", []int64{0, 1, 5, 10, 50, 100, 500, 1000, 5000, 10000}),
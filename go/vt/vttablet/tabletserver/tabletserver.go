@@ -55,6 +55,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -68,8 +69,12 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
 )
 
-// logPoolFull is for throttling transaction / query pool full messages in the log.
-var logPoolFull = logutil.NewThrottledLogger("PoolFull", 1*time.Minute)
+// logPoolFull is for aggregating transaction / query pool full messages in the log.
+var logPoolFull = logutil.NewAggregatedLogger("PoolFull", 1*time.Minute)
+
+// logQueryErrors is for aggregating the other query errors in the log,
+// so that a failing query does not flood it.
+var logQueryErrors = logutil.NewAggregatedLogger("QueryErrors", 1*time.Minute)
 
 var logComputeRowSerializerKey = logutil.NewThrottledLogger("ComputeRowSerializerKey", 1*time.Minute)
 
@@ -113,11 +118,19 @@ type TabletServer struct {
 	hs           *healthStreamer
 	lagThrottler *throttle.Throttler
 	tableGC      *gc.TableGC
+	dc           *dynamicConfig
+	lo           *lockObserver
+	admission    *admissionController
 
 	// sm manages state transitions.
 	sm                *stateManager
 	onlineDDLExecutor *onlineddl.Executor
 
+	// schemaErrorMu serializes the schema reloads triggered by query
+	// errors. lastSchemaErrorReload is the time of the last one.
+	schemaErrorMu         sync.Mutex
+	lastSchemaErrorReload time.Time
+
 	// alias is used for identifying this tabletserver in healthcheck responses.
 	alias topodatapb.TabletAlias
 }
@@ -177,10 +190,14 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
+	tsv.hs.txPoolUtilization = tsv.te.txPool.scp.Utilization
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
 	tsv.tableGC = gc.NewTableGC(tsv, topoServer, tabletTypeFunc, tsv.lagThrottler)
+	tsv.dc = newDynamicConfig(tsv, topoServer)
+	tsv.lo = newLockObserver(tsv)
+	tsv.admission = newAdmissionController(tsv)
 
 	tsv.sm = &stateManager{
 		statelessql: tsv.statelessql,
@@ -209,6 +226,9 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.exporter.NewGaugesFuncWithMultiLabels("TabletServerState", "Tablet server state labeled by state name", []string{"name"}, func() map[string]int64 {
 		return map[string]int64{tsv.sm.IsServingString(): 1}
 	})
+	tsv.exporter.NewGaugesFuncWithMultiLabels("TabletServingState", "Tablet server serving state labeled by state name", []string{"name"}, func() map[string]int64 {
+		return map[string]int64{tsv.sm.ServingStateName(): 1}
+	})
 	tsv.exporter.NewGaugeDurationFunc("QueryTimeout", "Tablet server query timeout", tsv.QueryTimeout.Get)
 
 	tsv.registerHealthzHealthHandler()
@@ -219,6 +239,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
 	tsv.registerDebugEnvHandler()
+	tsv.registerLockWaitsHandler()
+	tsv.registerPoolDiagnosticsHandler()
 
 	return tsv
 }
@@ -241,6 +263,8 @@ func (tsv *TabletServer) InitDBConfig(target querypb.Target, dbcfgs *dbconfigs.D
 	tsv.onlineDDLExecutor.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
 	tsv.lagThrottler.InitDBConfig(target.Keyspace, target.Shard)
 	tsv.tableGC.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
+	tsv.dc.Open(target.Keyspace, target.Shard)
+	tsv.lo.Open()
 	return nil
 }
 
@@ -295,6 +319,12 @@ func (tsv *TabletServer) SetQueryRules(ruleSource string, qrs *rules.Rules) erro
 	return nil
 }
 
+// SetQueryRulesWriter sets the function that writes the query rules of a
+// registered ruleSource back to where the source loads them from.
+func (tsv *TabletServer) SetQueryRulesWriter(ruleSource string, write func(*rules.Rules) error) error {
+	return tsv.qe.queryRuleSources.SetRulesWriter(ruleSource, write)
+}
+
 func (tsv *TabletServer) initACL(tableACLConfigFile string, enforceTableACLConfig bool) {
 	// tabletacl.Init loads ACL from file if *tableACLConfig is not empty
 	err := tableacl.Init(
@@ -360,6 +390,8 @@ func (tsv *TabletServer) StartService(target querypb.Target, dbcfgs *dbconfigs.D
 // should be called before process termination, or if MySQL is unreachable.
 // Under normal circumstances, SetServingType should be called.
 func (tsv *TabletServer) StopService() {
+	tsv.dc.Close()
+	tsv.lo.Close()
 	tsv.sm.StopService()
 }
 
@@ -442,8 +474,15 @@ func (tsv *TabletServer) begin(ctx context.Context, target *querypb.Target, preQ
 		"Begin", "begin", nil,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if reservedID == 0 {
+				done, err := tsv.admission.admit(ctx, tsv.te.txPool.scp.Utilization)
+				if err != nil {
+					return err
+				}
+				defer done()
+			}
 			startTime := time.Now()
-			if tsv.txThrottler.Throttle() {
+			if tsv.txThrottler.Throttle(ctx) {
 				return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "Transaction throttled")
 			}
 			var beginSQL string
@@ -690,6 +729,13 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if err != nil {
 				return err
 			}
+			if transactionID == 0 && reservedID == 0 {
+				done, err := tsv.admission.admit(ctx, tsv.poolUtilization(plan))
+				if err != nil {
+					return err
+				}
+				defer done()
+			}
 			// If both the values are non-zero then by design they are same value. So, it is safe to overwrite.
 			connID := reservedID
 			if transactionID != 0 {
@@ -710,6 +756,13 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				tabletType:     target.GetTabletType(),
 			}
 			result, err = qre.Execute()
+			if err != nil && tsv.reloadSchemaOnError(ctx, plan, logStats.StartTime, err) {
+				// The schema was stale: retry once with a fresh plan.
+				if qre.plan, err = tsv.qe.GetPlan(ctx, logStats, query, skipQueryPlanCache(options), reservedID != 0); err != nil {
+					return err
+				}
+				result, err = qre.Execute()
+			}
 			if err != nil {
 				return err
 			}
@@ -729,6 +782,49 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 	return result, err
 }
 
+// poolUtilization returns the utilization of the connection pool that
+// executes plan outside of a transaction: the DMLs run in autocommit
+// transactions of the transaction pool, and the other plans use the
+// query pool.
+func (tsv *TabletServer) poolUtilization(plan *TabletPlan) func() float64 {
+	switch plan.PlanID {
+	case planbuilder.PlanInsert, planbuilder.PlanUpdate, planbuilder.PlanDelete, planbuilder.PlanInsertMessage,
+		planbuilder.PlanDDL, planbuilder.PlanLoad, planbuilder.PlanUpdateLimit, planbuilder.PlanDeleteLimit:
+		return tsv.te.txPool.scp.Utilization
+	}
+	return tsv.qe.conns.Utilization
+}
+
+// reloadSchemaOnError reloads the schema if err says that the table of
+// the plan, or one of its columns, does not exist in MySQL, and clears
+// the cached plans of that table. It returns true if the query should be
+// retried. A reload done by another failed query after since is reused,
+// and there is at most one reload per schema error reload interval, so
+// that queries that keep failing do not keep reloading the schema.
+func (tsv *TabletServer) reloadSchemaOnError(ctx context.Context, plan *TabletPlan, since time.Time, err error) bool {
+	if plan.Table == nil || connpool.ClassifyError(err) != connpool.ErrorClassSchemaChanged {
+		return false
+	}
+	tableName := plan.TableName().String()
+
+	tsv.schemaErrorMu.Lock()
+	defer tsv.schemaErrorMu.Unlock()
+	if !tsv.lastSchemaErrorReload.After(since) {
+		if time.Since(tsv.lastSchemaErrorReload) < tsv.config.SchemaErrorReloadIntervalSeconds.Get() {
+			return false
+		}
+		log.Infof("Reloading schema after error on table %s: %v", tableName, err)
+		if err := tsv.se.Reload(ctx); err != nil {
+			log.Warningf("Schema reload after error on table %s failed: %v", tableName, err)
+			return false
+		}
+		tsv.lastSchemaErrorReload = time.Now()
+		tsv.stats.SchemaErrorReloads.Add(tableName, 1)
+	}
+	tsv.qe.ClearTablePlans(tableName)
+	return true
+}
+
 // smallerTimeout returns the smaller of the two timeouts.
 // 0 is treated as infinity.
 func smallerTimeout(t1, t2 time.Duration) time.Duration {
@@ -753,6 +849,13 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 		"StreamExecute", sql, bindVariables,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if transactionID == 0 {
+				done, err := tsv.admission.admit(ctx, tsv.qe.streamConns.Utilization)
+				if err != nil {
+					return err
+				}
+				defer done()
+			}
 			if bindVariables == nil {
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
@@ -1098,17 +1201,58 @@ func (tsv *TabletServer) VStreamRows(ctx context.Context, target *querypb.Target
 	if err := tsv.sm.VerifyTarget(ctx, target); err != nil {
 		return err
 	}
-	var row []sqltypes.Value
-	if lastpk != nil {
-		r := sqltypes.Proto3ToResult(lastpk)
-		if len(r.Rows) != 1 {
-			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected lastpk input: %v", lastpk)
-		}
-		row = r.Rows[0]
+	row, err := pkRow("lastpk", lastpk)
+	if err != nil {
+		return err
 	}
 	return tsv.vstreamer.StreamRows(ctx, query, row, send)
 }
 
+// ExportTable streams the rows of a table from a consistent snapshot,
+// after lastpk and up to endpk if they are set. The caller must be
+// allowed to read the table, as with a select.
+func (tsv *TabletServer) ExportTable(ctx context.Context, target *querypb.Target, table string, lastpk, endpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	if err := tsv.sm.VerifyTarget(ctx, target); err != nil {
+		return err
+	}
+	query := "select * from " + sqlparser.String(sqlparser.NewTableIdent(table))
+	plan, err := tsv.qe.GetStreamPlan(query, false /* isReservedConn */)
+	if err != nil {
+		return err
+	}
+	qre := &QueryExecutor{
+		query:    query,
+		plan:     plan,
+		ctx:      ctx,
+		logStats: tabletenv.NewLogStats(ctx, "ExportTable"),
+		tsv:      tsv,
+	}
+	if err := qre.checkPermissions(); err != nil {
+		return err
+	}
+	lastRow, err := pkRow("lastpk", lastpk)
+	if err != nil {
+		return err
+	}
+	endRow, err := pkRow("endpk", endpk)
+	if err != nil {
+		return err
+	}
+	return tsv.vstreamer.ExportTable(ctx, table, lastRow, endRow, send)
+}
+
+// pkRow returns the row of a pk input, or nil if it is not set.
+func pkRow(name string, pk *querypb.QueryResult) ([]sqltypes.Value, error) {
+	if pk == nil {
+		return nil, nil
+	}
+	r := sqltypes.Proto3ToResult(pk)
+	if len(r.Rows) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected %s input: %v", name, pk)
+	}
+	return r.Rows[0], nil
+}
+
 // VStreamResults streams rows from the specified starting point.
 func (tsv *TabletServer) VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
 	if err := tsv.sm.VerifyTarget(ctx, target); err != nil {
@@ -1128,6 +1272,11 @@ func (tsv *TabletServer) ReserveBeginExecute(ctx context.Context, target *queryp
 		"ReserveBegin", "begin", bindVariables,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			done, err := tsv.admission.admit(ctx, tsv.te.txPool.scp.Utilization)
+			if err != nil {
+				return err
+			}
+			defer done()
 			defer tsv.stats.QueryTimings.Record("RESERVE", time.Now())
 			connID, err = tsv.te.ReserveBegin(ctx, options, preQueries)
 			if err != nil {
@@ -1157,6 +1306,13 @@ func (tsv *TabletServer) ReserveExecute(ctx context.Context, target *querypb.Tar
 		"Reserve", "", bindVariables,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if transactionID == 0 {
+				done, err := tsv.admission.admit(ctx, tsv.te.txPool.scp.Utilization)
+				if err != nil {
+					return err
+				}
+				defer done()
+			}
 			defer tsv.stats.QueryTimings.Record("RESERVE", time.Now())
 			connID, err = tsv.te.Reserve(ctx, options, transactionID, preQueries)
 			if err != nil {
@@ -1253,7 +1409,7 @@ func (tsv *TabletServer) handlePanicAndSendLogStats(
 			queryAsString(sql, bindVariables),
 			x,
 			tb.Stack(4) /* Skip the last 4 boiler-plate frames. */)
-		log.Errorf(errorMessage)
+		logQueryErrors.Errorf("%s", errorMessage)
 		terr := vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "%s", errorMessage)
 		tsv.stats.InternalErrors.Add("Panic", 1)
 		if logStats != nil {
@@ -1283,17 +1439,21 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 		callerID = fmt.Sprintf(" (CallerID: %s)", cid.Username)
 	}
 
-	logMethod := log.Errorf
+	logMethod := logQueryErrors.KeyedErrorf
 	// Suppress or demote some errors in logs.
 	switch errCode {
 	case vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_ALREADY_EXISTS:
 		logMethod = nil
 	case vtrpcpb.Code_RESOURCE_EXHAUSTED:
-		logMethod = logPoolFull.Errorf
+		logMethod = logPoolFull.KeyedErrorf
 	case vtrpcpb.Code_ABORTED:
-		logMethod = log.Warningf
+		logMethod = logQueryErrors.KeyedWarningf
 	case vtrpcpb.Code_INVALID_ARGUMENT, vtrpcpb.Code_DEADLINE_EXCEEDED:
-		logMethod = log.Infof
+		logMethod = logQueryErrors.KeyedInfof
+	}
+	var logKey string
+	if logMethod != nil {
+		logKey = queryErrorLogKey(errCode, sql, err)
 	}
 
 	// If TerseErrors is on, strip the error message returned by MySQL and only
@@ -1324,6 +1484,11 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 			}
 		}
 	} else {
+		if vterrors.ErrState(err) != vterrors.Undefined {
+			// Send the state of the error to the vtgates in the format
+			// of the MySQL errors, so that they return it to the clients.
+			err = mysql.NewSQLErrorFromError(err)
+		}
 		err = vterrors.Errorf(errCode, "%v%s", err.Error(), callerID)
 		if tsv.TerseErrors && len(bindVariables) != 0 && errCode != vtrpcpb.Code_FAILED_PRECONDITION {
 			if logMethod != nil {
@@ -1337,7 +1502,7 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 	}
 
 	if logMethod != nil {
-		logMethod(message)
+		logMethod(logKey, "%s", message)
 	}
 
 	if logStats != nil {
@@ -1347,6 +1512,21 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 	return err
 }
 
+// queryErrorLogKey returns the key that aggregates the log messages of
+// the errors of sql: the errors of the same query with other values, and
+// with the same code and MySQL error, are only logged once per interval.
+func queryErrorLogKey(errCode vtrpcpb.Code, sql string, err error) string {
+	if stripped, _ := sqlparser.SplitMarginComments(sql); stripped != "" {
+		if redacted, rerr := sqlparser.RedactSQLQuery(sql); rerr == nil {
+			sql = redacted
+		}
+	}
+	if sqlErr, ok := err.(*mysql.SQLError); ok {
+		return fmt.Sprintf("%v %d %s: %s", errCode, sqlErr.Number(), sqlErr.SQLState(), sql)
+	}
+	return fmt.Sprintf("%v %v: %s", errCode, vterrors.ErrState(err), sql)
+}
+
 // truncateSQLAndBindVars calls TruncateForLog which:
 //  splits off trailing comments, truncates the query, and re-adds the trailing comments
 // appends quoted bindvar: value pairs in sorted order
@@ -1575,6 +1755,15 @@ func (tsv *TabletServer) registerMigrationStatusHandler() {
 		}
 		w.Write([]byte("ok"))
 	})
+	tsv.exporter.HandleFunc("/schema-migration/cut-over", func(w http.ResponseWriter, r *http.Request) {
+		ctx := tabletenv.LocalContext()
+		query := r.URL.Query()
+		if err := tsv.onlineDDLExecutor.OnSchemaMigrationCutOver(ctx, query.Get("uuid"), query.Get("phase"), query.Get("dryrun")); err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
 }
 
 // registerThrottlerCheckHandlers registers throttler "check" requests
@@ -1658,6 +1847,39 @@ func (tsv *TabletServer) registerDebugEnvHandler() {
 	})
 }
 
+func (tsv *TabletServer) registerLockWaitsHandler() {
+	tsv.exporter.HandleFunc("/debug/lock_waits", tsv.lo.ServeHTTP)
+}
+
+// poolDiagnostics is an entry of /debug/pool_diagnostics.
+type poolDiagnostics struct {
+	Current        *connpool.Diagnostics `json:"current"`
+	LastExhaustion *connpool.Diagnostics `json:"last_exhaustion"`
+}
+
+func (tsv *TabletServer) registerPoolDiagnosticsHandler() {
+	tsv.exporter.HandleFunc("/debug/pool_diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		var diagnostics []poolDiagnostics
+		for _, pool := range []*connpool.Pool{tsv.qe.conns, tsv.qe.streamConns, tsv.te.txPool.scp.conns} {
+			diagnostics = append(diagnostics, poolDiagnostics{
+				Current:        pool.Diagnose(),
+				LastExhaustion: pool.LastExhaustion(),
+			})
+		}
+		b, err := json.MarshalIndent(diagnostics, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Write(b)
+	})
+}
+
 // EnableHeartbeat forces heartbeat to be on or off.
 // Only to be used for testing.
 func (tsv *TabletServer) EnableHeartbeat(enabled bool) {
@@ -1769,9 +1991,9 @@ func (tsv *TabletServer) SetPassthroughDMLs(val bool) {
 }
 
 // SetConsolidatorMode sets the consolidator mode.
+// Unknown modes are ignored.
 func (tsv *TabletServer) SetConsolidatorMode(mode string) {
-	switch mode {
-	case tabletenv.NotOnMaster, tabletenv.Enable, tabletenv.Disable:
+	if mode, err := tabletenv.ParseConsolidatorMode(mode); err == nil {
 		tsv.qe.consolidatorMode.Set(mode)
 	}
 }
@@ -1781,6 +2003,21 @@ func (tsv *TabletServer) ConsolidatorMode() string {
 	return tsv.qe.consolidatorMode.Get()
 }
 
+// SetQueryTimeout changes the query timeout to the specified value.
+func (tsv *TabletServer) SetQueryTimeout(val time.Duration) {
+	tsv.QueryTimeout.Set(val)
+}
+
+// SetStrictTableACL changes whether the table ACLs are enforced.
+func (tsv *TabletServer) SetStrictTableACL(val bool) {
+	tsv.qe.strictTableACL.Set(val)
+}
+
+// StrictTableACL returns true if the table ACLs are enforced.
+func (tsv *TabletServer) StrictTableACL() bool {
+	return tsv.qe.strictTableACL.Get()
+}
+
 // queryAsString returns a readable version of query+bind variables.
 func queryAsString(sql string, bindVariables map[string]*querypb.BindVariable) string {
 	buf := &bytes.Buffer{}
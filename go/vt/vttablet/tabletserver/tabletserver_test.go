@@ -44,6 +44,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
@@ -51,7 +52,9 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -475,6 +478,57 @@ func TestTabletServerCommitPrepared(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTabletServerReloadSchemaOnError(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	// An unknown column on a known table reloads the schema and
+	// retries the query once.
+	executeSQL := "select * from test_table limit 10001"
+	db.AddRejectedQuery(executeSQL, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column 'name' in 'field list'"))
+	reloads := tsv.stats.SchemaErrorReloads.Counts()["test_table"]
+	_, err := tsv.Execute(ctx, &target, "select * from test_table", nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Equal(t, 2, db.GetQueryCalledNum(executeSQL))
+	assert.Equal(t, reloads+1, tsv.stats.SchemaErrorReloads.Counts()["test_table"])
+
+	// Once the schema is fixed, the query succeeds again.
+	db.DeleteRejectedQuery(executeSQL)
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+	_, err = tsv.Execute(ctx, &target, "select * from test_table", nil, 0, 0, nil)
+	require.NoError(t, err)
+
+	// Within the schema error reload interval, the errors do not reload
+	// the schema again.
+	db.AddRejectedQuery(executeSQL, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column 'name' in 'field list'"))
+	interval := tsv.config.SchemaErrorReloadIntervalSeconds
+	tsv.config.SchemaErrorReloadIntervalSeconds.Set(time.Hour)
+	_, err = tsv.Execute(ctx, &target, "select * from test_table", nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Equal(t, 2, db.GetQueryCalledNum(executeSQL))
+	assert.Equal(t, reloads+1, tsv.stats.SchemaErrorReloads.Counts()["test_table"])
+
+	// Once the interval is over, the errors reload the schema again.
+	tsv.config.SchemaErrorReloadIntervalSeconds.Set(0)
+	_, err = tsv.Execute(ctx, &target, "select * from test_table", nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Equal(t, 4, db.GetQueryCalledNum(executeSQL))
+	assert.Equal(t, reloads+2, tsv.stats.SchemaErrorReloads.Counts()["test_table"])
+	tsv.config.SchemaErrorReloadIntervalSeconds = interval
+	db.DeleteRejectedQuery(executeSQL)
+
+	// Tables unknown to the schema engine are not reloaded.
+	db.AddQuery("select * from unknown_table where 1 != 1", &sqltypes.Result{})
+	executeSQL = "select * from unknown_table limit 10001"
+	db.AddRejectedQuery(executeSQL, mysql.NewSQLError(mysql.ERNoSuchTable, "42S02", "Table 'unknown_table' doesn't exist"))
+	_, err = tsv.Execute(ctx, &target, "select * from unknown_table", nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum(executeSQL))
+	assert.Equal(t, reloads+2, tsv.stats.SchemaErrorReloads.Counts()["test_table"])
+}
+
 func TestSmallerTimeout(t *testing.T) {
 	testcases := []struct {
 		t1, t2, want time.Duration
@@ -661,6 +715,61 @@ func TestTabletServerStreamExecute(t *testing.T) {
 	}
 }
 
+func TestTabletServerStreamExecuteLimits(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarBinary},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarBinary("row01")},
+			{sqltypes.NewVarBinary("row02")},
+			{sqltypes.NewVarBinary("row03")},
+		},
+	})
+	db.AddQueryPattern("kill query .*", &sqltypes.Result{})
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	var rows int
+	callback := func(qr *sqltypes.Result) error {
+		rows += len(qr.Rows)
+		return nil
+	}
+
+	tsv.config.StreamLimits = tabletenv.StreamLimitsConfig{
+		MaxRows: 2,
+		Tables: map[string]tabletenv.StreamLimit{
+			"test_table": {MaxBytes: 10},
+		},
+		Users: map[string]tabletenv.StreamLimit{
+			"admin": {},
+		},
+	}
+	// The limit of the table overrides the default one.
+	err := tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, nil, callback)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "streamed bytes exceeded 10")
+	assert.Zero(t, rows)
+	assert.EqualValues(t, 1, tsv.stats.StreamLimitKills.Counts()["test_table."])
+
+	// Only the query is killed, so its transaction can be committed.
+	transactionID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, transactionID, nil, callback)
+	assert.Contains(t, err.Error(), "streamed bytes exceeded 10")
+	_, err = tsv.Commit(ctx, &target, transactionID)
+	require.NoError(t, err)
+
+	// The limit of the user overrides the one of the table.
+	adminCtx := callerid.NewContext(ctx, &vtrpcpb.CallerID{Principal: "admin"}, nil)
+	require.NoError(t, tsv.StreamExecute(adminCtx, &target, executeSQL, nil, 0, nil, callback))
+	assert.Equal(t, 3, rows)
+}
+
 func TestTabletServerStreamExecuteComments(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -1334,7 +1443,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 
 		<-tx1Started
 		_, _, _, err := tsv.BeginExecute(ctx, &target, nil, q2, bvTx2, 0, nil)
-		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1')" {
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1') (errno 1205) (sqlstate HY000)" {
 			t.Errorf("tx2 should have failed because there are too many pending requests: %v", err)
 		}
 		// No commit necessary because the Begin failed.
@@ -1423,7 +1532,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests_ExecuteBatchAsTrans
 			Sql:           q2,
 			BindVariables: bvTx2,
 		}}, true /*asTransaction*/, 0 /*connID*/, nil /*options*/)
-		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1')" {
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1') (errno 1205) (sqlstate HY000)" {
 			t.Errorf("tx2 should have failed because there are too many pending requests: %v results: %+v", err, results)
 		}
 	}()
@@ -1580,6 +1689,32 @@ func TestMessageStream(t *testing.T) {
 	}
 }
 
+func TestExportTableACL(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	config := tabletenv.NewDefaultConfig()
+	config.StrictTableACL = true
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+	require.NoError(t, tableacl.InitFromProto(&tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+	}))
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	send := func(*binlogdatapb.VStreamRowsResponse) error { return nil }
+
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	err := tsv.ExportTable(ctx, &target, "test_table", nil, nil, send)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "table acl error")
+}
+
 func TestMessageAck(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -1670,24 +1805,49 @@ func TestHandleExecUnknownError(t *testing.T) {
 }
 
 type testLogger struct {
-	logs        []string
-	savedInfof  func(format string, args ...interface{})
-	savedErrorf func(format string, args ...interface{})
+	logs             []string
+	savedInfof       func(format string, args ...interface{})
+	savedErrorf      func(format string, args ...interface{})
+	savedInfoDepth   func(depth int, args ...interface{})
+	savedErrorDepth  func(depth int, args ...interface{})
+	savedQueryErrors *logutil.AggregatedLogger
 }
 
 func newTestLogger() *testLogger {
 	tl := &testLogger{
-		savedInfof:  log.Infof,
-		savedErrorf: log.Errorf,
+		savedInfof:       log.Infof,
+		savedErrorf:      log.Errorf,
+		savedInfoDepth:   log.InfoDepth,
+		savedErrorDepth:  log.ErrorDepth,
+		savedQueryErrors: logQueryErrors,
 	}
 	log.Infof = tl.recordInfof
 	log.Errorf = tl.recordErrorf
+	log.InfoDepth = tl.recordInfoDepth
+	log.ErrorDepth = tl.recordErrorDepth
+	// Start with no aggregated messages.
+	logQueryErrors = logutil.NewAggregatedLogger("QueryErrors", time.Minute)
 	return tl
 }
 
 func (tl *testLogger) Close() {
 	log.Infof = tl.savedInfof
 	log.Errorf = tl.savedErrorf
+	log.InfoDepth = tl.savedInfoDepth
+	log.ErrorDepth = tl.savedErrorDepth
+	logQueryErrors = tl.savedQueryErrors
+}
+
+func (tl *testLogger) recordInfoDepth(depth int, args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	tl.logs = append(tl.logs, msg)
+	tl.savedInfoDepth(depth+1, msg)
+}
+
+func (tl *testLogger) recordErrorDepth(depth int, args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	tl.logs = append(tl.logs, msg)
+	tl.savedErrorDepth(depth+1, msg)
 }
 
 func (tl *testLogger) recordInfof(format string, args ...interface{}) {
@@ -1752,6 +1912,20 @@ func TestTerseErrorsNonSQLError(t *testing.T) {
 	}
 }
 
+func TestConvertErrorWithState(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	err := tsv.convertAndLogError(
+		ctx,
+		"select * from test_table",
+		nil,
+		vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.LockWaitTimeout, "too many queued transactions"),
+		nil,
+	)
+	require.EqualError(t, err, "too many queued transactions (errno 1205) (sqlstate HY000)")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+}
+
 func TestTerseErrorsBindVars(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.TerseErrors = true
@@ -1774,7 +1948,7 @@ func TestTerseErrorsBindVars(t *testing.T) {
 		t.Errorf("error got '%v', want '%s'", err, want)
 	}
 
-	wantLog := "sensitive message (errno 10) (sqlstate HY000): Sql: \"select * from test_table where a = :a\", BindVars: {a: \"type:INT64 value:\\\"1\\\" \"}"
+	wantLog := "QueryErrors: sensitive message (errno 10) (sqlstate HY000): Sql: \"select * from test_table where a = :a\", BindVars: {a: \"type:INT64 value:\\\"1\\\" \"}"
 	if wantLog != tl.getLog(0) {
 		t.Errorf("log got '%s', want '%s'", tl.getLog(0), wantLog)
 	}
@@ -1819,11 +1993,14 @@ func TestTruncateErrors(t *testing.T) {
 		t.Errorf("error got '%v', want '%s'", err, wantErr)
 	}
 
-	wantLog := "sensitive message (errno 10) (sqlstate HY000): Sql: \"select * from test_table where xyz = :vt [TRUNCATED]\", BindVars: {vtg1: \"type:VARBINARY value:\\ [TRUNCATED]"
+	wantLog := "QueryErrors: sensitive message (errno 10) (sqlstate HY000): Sql: \"select * from test_table where xyz = :vt [TRUNCATED]\", BindVars: {vtg1: \"type:VARBINARY value:\\ [TRUNCATED]"
 	if wantLog != tl.getLog(0) {
 		t.Errorf("log got '%s', want '%s'", tl.getLog(0), wantLog)
 	}
 
+	// The errors of the same query are only logged once per interval:
+	// start a new one.
+	logQueryErrors = logutil.NewAggregatedLogger("QueryErrors", time.Minute)
 	*sqlparser.TruncateErrLen = 140
 	err = tsv.convertAndLogError(
 		ctx,
@@ -1838,13 +2015,41 @@ func TestTruncateErrors(t *testing.T) {
 		t.Errorf("error got '%v', want '%s'", err, wantErr)
 	}
 
-	wantLog = "sensitive message (errno 10) (sqlstate HY000): Sql: \"select * from test_table where xyz = :vtg1 order by abc desc\", BindVars: {vtg1: \"type:VARBINARY value:\\\"this is kinda long eh\\\" \"}"
+	wantLog = "QueryErrors: sensitive message (errno 10) (sqlstate HY000): Sql: \"select * from test_table where xyz = :vtg1 order by abc desc\", BindVars: {vtg1: \"type:VARBINARY value:\\\"this is kinda long eh\\\" \"}"
 	if wantLog != tl.getLog(1) {
 		t.Errorf("log got '%s', want '%s'", tl.getLog(1), wantLog)
 	}
 	*sqlparser.TruncateErrLen = 0
 }
 
+func TestQueryErrorsLogAggregation(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	tl := newTestLogger()
+	defer tl.Close()
+
+	// The errors of the same query with other values are aggregated,
+	// but not the other errors of the query.
+	for _, tcase := range []struct {
+		sql string
+		err error
+	}{{
+		sql: "insert into test_table values (1)",
+		err: mysql.NewSQLError(10, "HY000", "error for 1"),
+	}, {
+		sql: "insert into test_table values (2)",
+		err: mysql.NewSQLError(10, "HY000", "error for 2"),
+	}, {
+		sql: "insert into test_table values (3)",
+		err: mysql.NewSQLError(11, "HY000", "error for 3"),
+	}} {
+		_ = tsv.convertAndLogError(ctx, tcase.sql, nil, tcase.err, nil)
+	}
+	require.Len(t, tl.logs, 2)
+	assert.Contains(t, tl.logs[0], "error for 1")
+	assert.Contains(t, tl.logs[1], "error for 3")
+}
+
 func TestTerseErrorsIgnoreFailoverInProgress(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.TerseErrors = true
@@ -2458,6 +2663,7 @@ func setupFakeDB(t *testing.T) *fakesqldb.DB {
 	for query, result := range getSupportedQueries() {
 		db.AddQuery(query, result)
 	}
+	db.AddQueryPattern(baseShowPartitionsPattern, &sqltypes.Result{Fields: mysql.ShowPartitionsFields})
 	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
 		Fields: mysql.BaseShowTablesFields,
 		Rows: [][]sqltypes.Value{
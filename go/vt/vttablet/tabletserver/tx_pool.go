@@ -28,6 +28,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/trace"
@@ -67,6 +68,9 @@ type (
 		logMu   sync.Mutex
 		lastLog time.Time
 		txStats *servenv.TimingsWrapper
+		// poolFull counts the transactions rejected because the pool
+		// had no connection for them in time.
+		poolFull *stats.Counter
 	}
 	queries struct {
 		setIsolationLevel string
@@ -85,6 +89,7 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 		ticks:              timer.NewTimer(transactionTimeout / 10),
 		limiter:            limiter,
 		txStats:            env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
+		poolFull:           env.Exporter().NewCounter("TransactionPoolFull", "Transactions rejected because the transaction pool was full"),
 	}
 	// Careful: conns also exports name+"xxx" vars,
 	// but we know it doesn't export Timeout.
@@ -280,7 +285,14 @@ func (tp *TxPool) createConn(ctx context.Context, options *querypb.ExecuteOption
 			err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool aborting request due to already expired context")
 		case pools.ErrTimeout:
 			tp.LogActive()
-			err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded")
+			tp.poolFull.Add(1)
+			err = vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.TooManyConnections, "transaction pool connection limit exceeded")
+		default:
+			if vterrors.Code(err) == vtrpcpb.Code_RESOURCE_EXHAUSTED {
+				// Too many transactions are already waiting for a connection.
+				tp.poolFull.Add(1)
+				err = vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.TooManyConnections, "transaction pool waiter count exceeded")
+			}
 		}
 		return nil, err
 	}
@@ -336,6 +348,12 @@ func (tp *TxPool) LogActive() {
 	})
 }
 
+// Rejections returns how many transactions were rejected because the pool
+// was full.
+func (tp *TxPool) Rejections() int64 {
+	return tp.poolFull.Get()
+}
+
 // Timeout returns the transaction timeout.
 func (tp *TxPool) Timeout() time.Duration {
 	return tp.transactionTimeout.Get()
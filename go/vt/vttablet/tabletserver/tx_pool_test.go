@@ -286,16 +286,60 @@ func TestTxPoolWaitTimeoutError(t *testing.T) {
 	defer conn.Unlock()
 
 	// try locking one more connection.
+	rejections := txPool.Rejections()
 	_, _, err = txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
 
 	// then
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "transaction pool connection limit exceeded")
 	require.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	require.Equal(t, vterrors.TooManyConnections, vterrors.ErrState(err))
+	require.Equal(t, rejections+1, txPool.Rejections())
 	require.Equal(t, "begin", db.QueryLog())
 	require.True(t, conn.TxProperties().LogToFile)
 }
 
+func TestTxPoolWaiterCapError(t *testing.T) {
+	env := newEnv("TabletServerTest")
+	env.Config().TxPool.Size = 1
+	env.Config().TxPool.MaxWaiters = 1
+	env.Config().TxPool.TimeoutSeconds = 10
+	// given
+	_, txPool, _, closer := setupWithEnv(t, env)
+	defer closer()
+
+	// lock the only connection in the pool.
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+
+	// queue one transaction.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		txPool.RollbackAndRelease(ctx, conn)
+	}()
+	for txPool.scp.Waiters() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// the queue is full.
+	rejections := txPool.Rejections()
+	_, _, err = txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.EqualError(t, err, "transaction pool waiter count exceeded")
+	require.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	require.Equal(t, vterrors.TooManyConnections, vterrors.ErrState(err))
+	require.Equal(t, rejections+1, txPool.Rejections())
+
+	txPool.RollbackAndRelease(ctx, conn)
+	wg.Wait()
+}
+
 func TestTxPoolRollbackFailIsPassedThrough(t *testing.T) {
 	sql := "alter table test_table add test_column int"
 	db, txPool, _, closer := setup(t)
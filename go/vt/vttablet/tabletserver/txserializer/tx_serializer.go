@@ -94,7 +94,7 @@ type TxSerializer struct {
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.HotRowProtection.Mode == tabletenv.Dryrun,
@@ -130,7 +130,11 @@ func New(env tabletenv.Env) *TxSerializer {
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
 	}
-
+	env.Exporter().NewGaugeFunc(
+		"TxSerializerGlobalQueueSize",
+		"Number of transactions currently queued or in flight for a hot row range",
+		func() int64 { return int64(txs.GlobalQueueSize()) })
+	return txs
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -176,7 +180,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 			txs.logGlobalQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d)", txs.globalSize, txs.maxGlobalQueueSize)
 		} else {
 			txs.globalQueueExceeded.Add(1)
-			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+			return false, vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.LockWaitTimeout,
 				"hot row protection: too many queued transactions (%d >= %d)", txs.globalSize, txs.maxGlobalQueueSize)
 		}
 	}
@@ -187,7 +191,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
 		} else {
 			txs.queueExceeded.Add(table, 1)
-			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+			return false, vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.LockWaitTimeout,
 				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
 		}
 	}
@@ -304,6 +308,25 @@ func (txs *TxSerializer) Pending(key string) int {
 	return q.size
 }
 
+// GlobalQueueSize returns the number of transactions currently queued or in
+// flight across all row ranges, which is limited by the max global queue size.
+func (txs *TxSerializer) GlobalQueueSize() int {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	return txs.globalSize
+}
+
+// Rejections returns how many transactions were rejected because the max
+// queue size per row range or the max global queue size was exceeded.
+func (txs *TxSerializer) Rejections() int64 {
+	rejections := txs.globalQueueExceeded.Get()
+	for _, count := range txs.queueExceeded.Counts() {
+		rejections += count
+	}
+	return rejections
+}
+
 // ServeHTTP lists the most recent, cached queries and their count.
 func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if *streamlog.RedactDebugUIQueries {
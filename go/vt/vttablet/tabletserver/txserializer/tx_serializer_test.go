@@ -505,9 +505,18 @@ func TestTxSerializerGlobalQueueOverflow(t *testing.T) {
 	if got, want := txs.globalQueueExceeded.Get(), int64(1); got != want {
 		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
 	}
+	if got, want := txs.Rejections(), int64(1); got != want {
+		t.Errorf("Rejections() = %v, want = %v", got, want)
+	}
+	if got, want := txs.GlobalQueueSize(), 2; got != want {
+		t.Errorf("GlobalQueueSize() = %v, want = %v", got, want)
+	}
 
 	done1()
 	done2()
+	if got, want := txs.GlobalQueueSize(), 0; got != want {
+		t.Errorf("GlobalQueueSize() = %v, want = %v", got, want)
+	}
 }
 
 func TestTxSerializerPending(t *testing.T) {
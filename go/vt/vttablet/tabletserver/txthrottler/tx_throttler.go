@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
@@ -50,7 +51,7 @@ import (
 //   }
 //
 //   // Checking whether to throttle can be done as follows before starting a transaction.
-//   if t.Throttle() {
+//   if t.Throttle(ctx) {
 //     return fmt.Errorf("Transaction throttled!")
 //   } else {
 //     // execute transaction.
@@ -73,8 +74,17 @@ type TxThrottler struct {
 	state *txThrottlerState
 
 	target querypb.Target
+
+	// after is time.After, overridden in tests.
+	after func(time.Duration) <-chan time.Time
 }
 
+var (
+	throttleRequests = stats.NewCountersWithSingleLabel("TxThrottlerRequests", "Transactions checked by the transaction throttler, by result", "Result")
+	throttleDelays   = stats.NewTimings("TxThrottlerDelays", "Time the transactions waited for the transaction throttler, by result", "Result")
+	throttleWaiting  = stats.NewGauge("TxThrottlerWaiting", "Number of transactions currently delayed by the transaction throttler")
+)
+
 // NewTxThrottler tries to construct a TxThrottler from the
 // relevant fields in the tabletenv.Config object. It returns a disabled TxThrottler if
 // any error occurs.
@@ -120,6 +130,7 @@ func tryCreateTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Serve
 		topoServer:       topoServer,
 		throttlerConfig:  &throttlerConfig,
 		healthCheckCells: healthCheckCells,
+		maxDelay:         config.TxThrottlerMaxDelay.Get(),
 	})
 }
 
@@ -136,6 +147,10 @@ type txThrottlerConfig struct {
 	// healthCheckCells stores the cell names in which running vttablets will be monitored for
 	// replication lag.
 	healthCheckCells []string
+	// maxDelay is how long a throttled transaction waits for the throttler
+	// to let it through before it's rejected. If zero, throttled transactions
+	// are rejected right away.
+	maxDelay time.Duration
 }
 
 // ThrottlerInterface defines the public interface that is implemented by go/vt/throttler.Throttler
@@ -215,6 +230,7 @@ func newTxThrottler(config *txThrottlerConfig) (*TxThrottler, error) {
 	}
 	return &TxThrottler{
 		config: config,
+		after:  time.After,
 	}, nil
 }
 
@@ -251,14 +267,75 @@ func (t *TxThrottler) Close() {
 // It returns true if the transaction should not proceed (the caller
 // should back off). Throttle requires that Open() was previously called
 // successfully.
-func (t *TxThrottler) Throttle() (result bool) {
+// If a maximum delay is configured, a throttled transaction waits for
+// the backoff returned by the throttler instead, until it's let through,
+// the maximum delay is exceeded, or ctx is done. Since the throttler
+// adjusts its rate to the replication lag, the transactions are spread
+// out over time rather than failed when the replicas fall behind.
+func (t *TxThrottler) Throttle(ctx context.Context) (result bool) {
 	if !t.config.enabled {
 		return false
 	}
 	if t.state == nil {
 		panic("BUG: Throttle() called on a closed TxThrottler")
 	}
-	return t.state.throttle()
+	backoff := t.state.throttle()
+	if backoff == 0 {
+		throttleRequests.Add("Allowed", 1)
+		return false
+	}
+	if t.config.maxDelay <= 0 {
+		throttleRequests.Add("Throttled", 1)
+		return true
+	}
+
+	throttleWaiting.Add(1)
+	defer throttleWaiting.Add(-1)
+	start := time.Now()
+	deadline := start.Add(t.config.maxDelay)
+	for backoff > 0 {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		if backoff > remaining {
+			backoff = remaining
+		}
+		select {
+		case <-ctx.Done():
+			throttleRequests.Add("Throttled", 1)
+			throttleDelays.Record("Throttled", start)
+			return true
+		case <-t.after(backoff):
+		}
+		backoff = t.state.throttle()
+	}
+	if backoff > 0 {
+		throttleRequests.Add("Throttled", 1)
+		throttleDelays.Record("Throttled", start)
+		return true
+	}
+	throttleRequests.Add("Delayed", 1)
+	throttleDelays.Record("Delayed", start)
+	return false
+}
+
+// Waiting returns the number of transactions currently delayed
+// by the transaction throttler.
+func (t *TxThrottler) Waiting() int64 {
+	return throttleWaiting.Get()
+}
+
+// Rejections returns the number of transactions rejected by
+// the transaction throttler.
+func (t *TxThrottler) Rejections() int64 {
+	return throttleRequests.Counts()["Throttled"]
+}
+
+// Delays returns the histograms of the time the transactions
+// were delayed by the transaction throttler, by result.
+func (t *TxThrottler) Delays() map[string]*stats.Histogram {
+	return throttleDelays.Histograms()
 }
 
 func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
@@ -298,14 +375,16 @@ func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 	return result, nil
 }
 
-func (ts *txThrottlerState) throttle() bool {
+// throttle returns the backoff duration of the wrapped throttler:
+// zero if the transaction can proceed.
+func (ts *txThrottlerState) throttle() time.Duration {
 	if ts.throttler == nil {
 		panic("BUG: throttle called after deallocateResources was called.")
 	}
 	// Serialize calls to ts.throttle.Throttle()
 	ts.throttleMu.Lock()
 	defer ts.throttleMu.Unlock()
-	return ts.throttler.Throttle(0 /* threadId */) > 0
+	return ts.throttler.Throttle(0 /* threadId */)
 }
 
 func (ts *txThrottlerState) deallocateResources() {
@@ -22,6 +22,7 @@ package txthrottler
 //go:generate mockgen -destination mock_topology_watcher_test.go -package txthrottler vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler TopologyWatcherInterface
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -47,7 +48,7 @@ func TestDisabledThrottler(t *testing.T) {
 	if err := throttler.Open(); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
-	if result := throttler.Throttle(); result != false {
+	if result := throttler.Throttle(context.Background()); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
 	throttler.Close()
@@ -129,7 +130,7 @@ func TestEnabledThrottler(t *testing.T) {
 	if err := throttler.Open(); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
-	if result := throttler.Throttle(); result != false {
+	if result := throttler.Throttle(context.Background()); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
 	hcListener.StatsUpdate(tabletStats)
@@ -141,8 +142,105 @@ func TestEnabledThrottler(t *testing.T) {
 	// This call should not be forwarded to the go/vt/throttler.Throttler object.
 	hcListener.StatsUpdate(rdonlyTabletStats)
 	// The second throttle call should reject.
-	if result := throttler.Throttle(); result != true {
+	if result := throttler.Throttle(context.Background()); result != true {
 		t.Errorf("want: true, got: %v", result)
 	}
 	throttler.Close()
 }
+
+func TestDelayedThrottler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	defer resetTxThrottlerFactories()
+	ts := memorytopo.NewServer("cell1")
+
+	mockHealthCheck := NewMockHealthCheck(mockCtrl)
+	mockHealthCheck.EXPECT().SetListener(gomock.Any(), false /* sendDownEvents */)
+	mockHealthCheck.EXPECT().Close()
+	healthCheckFactory = func() discovery.LegacyHealthCheck { return mockHealthCheck }
+	topologyWatcherFactory = func(topoServer *topo.Server, tr discovery.LegacyTabletRecorder, cell, keyspace, shard string, refreshInterval time.Duration, topoReadConcurrency int) TopologyWatcherInterface {
+		result := NewMockTopologyWatcherInterface(mockCtrl)
+		result.EXPECT().Stop()
+		return result
+	}
+	mockThrottler := NewMockThrottlerInterface(mockCtrl)
+	throttlerFactory = func(name, unit string, threadCount int, maxRate, maxReplicationLag int64) (ThrottlerInterface, error) {
+		return mockThrottler, nil
+	}
+	mockThrottler.EXPECT().UpdateConfiguration(gomock.Any(), true /* copyZeroValues */)
+	gomock.InOrder(
+		// Let through after one backoff.
+		mockThrottler.EXPECT().Throttle(0).Return(500*time.Millisecond),
+		mockThrottler.EXPECT().Throttle(0).Return(0*time.Second),
+		// Rejected once the max delay is exceeded.
+		mockThrottler.EXPECT().Throttle(0).Return(1*time.Second),
+		mockThrottler.EXPECT().Throttle(0).Return(1*time.Second),
+		// Rejected when the context is done.
+		mockThrottler.EXPECT().Throttle(0).Return(1*time.Second),
+	)
+	mockThrottler.EXPECT().Close()
+
+	config := tabletenv.NewDefaultConfig()
+	config.EnableTxThrottler = true
+	config.TxThrottlerHealthCheckCells = []string{"cell1"}
+	config.TxThrottlerMaxDelay.Set(time.Second)
+
+	throttler, err := tryCreateTxThrottler(config, ts)
+	if err != nil {
+		t.Fatalf("want: nil, got: %v", err)
+	}
+	var waits []time.Duration
+	throttler.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		return time.After(0)
+	}
+	throttler.InitDBConfig(querypb.Target{
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	})
+	if err := throttler.Open(); err != nil {
+		t.Fatalf("want: nil, got: %v", err)
+	}
+
+	delayed := throttleRequests.Counts()["Delayed"]
+	throttled := throttleRequests.Counts()["Throttled"]
+	if result := throttler.Throttle(context.Background()); result != false {
+		t.Errorf("want: false, got: %v", result)
+	}
+	if got, want := throttleRequests.Counts()["Delayed"], delayed+1; got != want {
+		t.Errorf("Delayed: want: %v, got: %v", want, got)
+	}
+	if len(waits) != 1 || waits[0] != 500*time.Millisecond {
+		t.Errorf("waits: want: [500ms], got: %v", waits)
+	}
+
+	// The wait is capped by the time left before the max delay.
+	waits = nil
+	throttler.config.maxDelay = 100 * time.Millisecond
+	throttler.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		return time.After(d)
+	}
+	if result := throttler.Throttle(context.Background()); result != true {
+		t.Errorf("want: true, got: %v", result)
+	}
+	if len(waits) != 1 || waits[0] > 100*time.Millisecond {
+		t.Errorf("waits: want: one wait of at most 100ms, got: %v", waits)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	throttler.config.maxDelay = time.Hour
+	throttler.after = func(d time.Duration) <-chan time.Time { return nil }
+	if result := throttler.Throttle(ctx); result != true {
+		t.Errorf("want: true, got: %v", result)
+	}
+	if got, want := throttler.Rejections(), throttled+2; got != want {
+		t.Errorf("Rejections(): want: %v, got: %v", want, got)
+	}
+	if got := throttler.Waiting(); got != 0 {
+		t.Errorf("Waiting(): want: 0, got: %v", got)
+	}
+	throttler.Close()
+}
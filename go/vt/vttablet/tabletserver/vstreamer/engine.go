@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -228,11 +229,24 @@ func (vse *Engine) Stream(ctx context.Context, startPos string, tablePKs []*binl
 // StreamRows streams rows.
 // This streams the table data rows (so we can copy the table data snapshot)
 func (vse *Engine) StreamRows(ctx context.Context, query string, lastpk []sqltypes.Value, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	log.Infof("Streaming rows for query %s, lastpk: %s", query, lastpk)
+	return vse.streamRows(ctx, query, lastpk, nil, send)
+}
+
+// ExportTable streams all the columns of the rows of a table, after lastpk
+// and up to endpk if they are set, from a consistent snapshot.
+func (vse *Engine) ExportTable(ctx context.Context, table string, lastpk, endpk []sqltypes.Value, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	log.Infof("Exporting table %s, lastpk: %s, endpk: %s", table, lastpk, endpk)
+	query := sqlparser.NewTrackedBuffer(nil)
+	query.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+	return vse.streamRows(ctx, query.String(), lastpk, endpk, send)
+}
+
+func (vse *Engine) streamRows(ctx context.Context, query string, lastpk, endpk []sqltypes.Value, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	// Ensure vschema is initialized and the watcher is started.
 	// Starting of the watcher has to be delayed till the first call to Stream
 	// because this overhead should be incurred only if someone uses this feature.
 	vse.watcherOnce.Do(vse.setWatch)
-	log.Infof("Streaming rows for query %s, lastpk: %s", query, lastpk)
 
 	// Create stream and add it to the map.
 	rowStreamer, idx, err := func() (*rowStreamer, int, error) {
@@ -242,7 +256,7 @@ func (vse *Engine) StreamRows(ctx context.Context, query string, lastpk []sqltyp
 			return nil, 0, errors.New("VStreamer is not open")
 		}
 
-		rowStreamer := newRowStreamer(ctx, vse.env.Config().DB.AppWithDB(), vse.se, query, lastpk, vse.lvschema, send, vse)
+		rowStreamer := newRowStreamer(ctx, vse.env.Config().DB.AppWithDB(), vse.se, query, lastpk, endpk, vse.lvschema, send, vse)
 		idx := vse.streamIdx
 		vse.rowStreamers[idx] = rowStreamer
 		vse.streamIdx++
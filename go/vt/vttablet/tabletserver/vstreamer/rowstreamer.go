@@ -39,7 +39,7 @@ type RowStreamer interface {
 
 // NewRowStreamer returns a RowStreamer
 func NewRowStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engine, query string, lastpk []sqltypes.Value, send func(*binlogdatapb.VStreamRowsResponse) error, vse *Engine) RowStreamer {
-	return newRowStreamer(ctx, cp, se, query, lastpk, &localVSchema{vschema: &vindexes.VSchema{}}, send, vse)
+	return newRowStreamer(ctx, cp, se, query, lastpk, nil, &localVSchema{vschema: &vindexes.VSchema{}}, send, vse)
 }
 
 // rowStreamer is used for copying the existing rows of a table
@@ -49,7 +49,8 @@ func NewRowStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engi
 // its events as of the returned GTID before adding the new rows.
 // For every set of rows sent, the last pk value is also sent.
 // This allows for the streaming to be resumed based on the last
-// pk value processed.
+// pk value processed. If endpk is set, the streaming stops after
+// the row with that pk value.
 type rowStreamer struct {
 	ctx    context.Context
 	cancel func()
@@ -58,6 +59,7 @@ type rowStreamer struct {
 	se      *schema.Engine
 	query   string
 	lastpk  []sqltypes.Value
+	endpk   []sqltypes.Value
 	send    func(*binlogdatapb.VStreamRowsResponse) error
 	vschema *localVSchema
 
@@ -67,7 +69,7 @@ type rowStreamer struct {
 	vse       *Engine
 }
 
-func newRowStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engine, query string, lastpk []sqltypes.Value, vschema *localVSchema, send func(*binlogdatapb.VStreamRowsResponse) error, vse *Engine) *rowStreamer {
+func newRowStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engine, query string, lastpk, endpk []sqltypes.Value, vschema *localVSchema, send func(*binlogdatapb.VStreamRowsResponse) error, vse *Engine) *rowStreamer {
 	ctx, cancel := context.WithCancel(ctx)
 	return &rowStreamer{
 		ctx:     ctx,
@@ -76,6 +78,7 @@ func newRowStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engi
 		se:      se,
 		query:   query,
 		lastpk:  lastpk,
+		endpk:   endpk,
 		send:    send,
 		vschema: vschema,
 		vse:     vse,
@@ -170,29 +173,24 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 		prefix = ", "
 	}
 	buf.Myprintf(" from %v", sqlparser.NewTableIdent(rs.plan.Table.Name))
-	if len(rs.lastpk) != 0 {
-		if len(rs.lastpk) != len(rs.pkColumns) {
-			return "", fmt.Errorf("primary key values don't match length: %v vs %v", rs.lastpk, rs.pkColumns)
+	for _, pk := range [][]sqltypes.Value{rs.lastpk, rs.endpk} {
+		if len(pk) != 0 && len(pk) != len(rs.pkColumns) {
+			return "", fmt.Errorf("primary key values don't match length: %v vs %v", pk, rs.pkColumns)
 		}
+	}
+	switch {
+	case len(rs.lastpk) != 0 && len(rs.endpk) != 0:
+		buf.WriteString(" where (")
+		rs.writePKBound(buf, rs.lastpk, ">", ">")
+		buf.WriteString(") and (")
+		rs.writePKBound(buf, rs.endpk, "<", "<=")
+		buf.WriteString(")")
+	case len(rs.lastpk) != 0:
 		buf.WriteString(" where ")
-		prefix := ""
-		// This loop handles the case for composite pks. For example,
-		// if lastpk was (1,2), the where clause would be:
-		// (col1 = 1 and col2 > 2) or (col1 > 1).
-		// A tuple inequality like (col1,col2) > (1,2) ends up
-		// being a full table scan for mysql.
-		for lastcol := len(rs.pkColumns) - 1; lastcol >= 0; lastcol-- {
-			buf.Myprintf("%s(", prefix)
-			prefix = " or "
-			for i, pk := range rs.pkColumns[:lastcol] {
-				buf.Myprintf("%v = ", sqlparser.NewColIdent(rs.plan.Table.Fields[pk].Name))
-				rs.lastpk[i].EncodeSQL(buf)
-				buf.Myprintf(" and ")
-			}
-			buf.Myprintf("%v > ", sqlparser.NewColIdent(rs.plan.Table.Fields[rs.pkColumns[lastcol]].Name))
-			rs.lastpk[lastcol].EncodeSQL(buf)
-			buf.Myprintf(")")
-		}
+		rs.writePKBound(buf, rs.lastpk, ">", ">")
+	case len(rs.endpk) != 0:
+		buf.WriteString(" where ")
+		rs.writePKBound(buf, rs.endpk, "<", "<=")
 	}
 	buf.Myprintf(" order by ", sqlparser.NewTableIdent(rs.plan.Table.Name))
 	prefix = ""
@@ -203,6 +201,33 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 	return buf.String(), nil
 }
 
+// writePKBound writes the condition comparing the pk columns to the values.
+// op compares the leading pk columns, and lastOp compares all of them.
+// This handles the case for composite pks. For example, if lastpk
+// was (1,2), the where clause would be:
+// (col1 = 1 and col2 > 2) or (col1 > 1).
+// A tuple inequality like (col1,col2) > (1,2) ends up
+// being a full table scan for mysql.
+func (rs *rowStreamer) writePKBound(buf *sqlparser.TrackedBuffer, values []sqltypes.Value, op, lastOp string) {
+	prefix := ""
+	for lastcol := len(rs.pkColumns) - 1; lastcol >= 0; lastcol-- {
+		buf.Myprintf("%s(", prefix)
+		prefix = " or "
+		for i, pk := range rs.pkColumns[:lastcol] {
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(rs.plan.Table.Fields[pk].Name))
+			values[i].EncodeSQL(buf)
+			buf.Myprintf(" and ")
+		}
+		colOp := op
+		if lastcol == len(rs.pkColumns)-1 {
+			colOp = lastOp
+		}
+		buf.Myprintf("%v %s ", sqlparser.NewColIdent(rs.plan.Table.Fields[rs.pkColumns[lastcol]].Name), colOp)
+		values[lastcol].EncodeSQL(buf)
+		buf.Myprintf(")")
+	}
+}
+
 func (rs *rowStreamer) streamQuery(conn *snapshotConn, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	log.Infof("Streaming query: %v\n", rs.sendQuery)
 	gtid, err := conn.streamWithSnapshot(rs.ctx, rs.plan.Table.Name, rs.sendQuery)
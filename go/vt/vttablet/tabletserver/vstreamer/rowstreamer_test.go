@@ -25,6 +25,7 @@ import (
 
 	"vitess.io/vitess/go/vt/log"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
@@ -386,6 +387,55 @@ func TestStreamRowsCancel(t *testing.T) {
 	}
 }
 
+func TestExportTable(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		"insert into t1 values (1, 'aaa'), (2, 'bbb')",
+		"create table t2(id1 int, id2 int, val varbinary(128), primary key(id1, id2))",
+		"insert into t2 values (1, 2, 'aaa'), (1, 3, 'bbb'), (2, 3, 'ccc'), (2, 4, 'ddd')",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		"drop table t2",
+	})
+	engine.se.Reload(context.Background())
+
+	export := func(table string, lastpk, endpk []sqltypes.Value) (string, []string) {
+		t.Helper()
+		var sendQuery string
+		var rows []string
+		err := engine.ExportTable(context.Background(), table, lastpk, endpk, func(response *binlogdatapb.VStreamRowsResponse) error {
+			if response.Fields != nil {
+				sendQuery = engine.rowStreamers[engine.streamIdx-1].sendQuery
+				return nil
+			}
+			rows = append(rows, fmt.Sprintf("%v", response))
+			return nil
+		})
+		require.NoError(t, err)
+		return sendQuery, rows
+	}
+
+	// t1: up to endpk=1
+	query, rows := export("t1", nil, []sqltypes.Value{sqltypes.NewInt64(1)})
+	assert.Equal(t, "select id, val from t1 where (id <= 1) order by id", query)
+	assert.Equal(t, []string{`rows:<lengths:1 lengths:3 values:"1aaa" > lastpk:<lengths:1 values:"1" > `}, rows)
+
+	// t2: after lastpk=1,2 and up to endpk=2,3
+	query, rows = export("t2", []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(3)})
+	assert.Equal(t, "select id1, id2, val from t2 where ((id1 = 1 and id2 > 2) or (id1 > 1)) and ((id1 = 2 and id2 <= 3) or (id1 < 2)) order by id1, id2", query)
+	assert.Equal(t, []string{`rows:<lengths:1 lengths:1 lengths:3 values:"13bbb" > rows:<lengths:1 lengths:1 lengths:3 values:"23ccc" > lastpk:<lengths:1 lengths:1 values:"23" > `}, rows)
+
+	// t2: endpk must have all the pk columns
+	err := engine.ExportTable(context.Background(), "t2", nil, []sqltypes.Value{sqltypes.NewInt64(2)}, func(*binlogdatapb.VStreamRowsResponse) error { return nil })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "primary key values don't match length")
+}
+
 func checkStream(t *testing.T, query string, lastpk []sqltypes.Value, wantQuery string, wantStream []string) {
 	t.Helper()
 
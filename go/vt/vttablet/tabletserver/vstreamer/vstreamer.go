@@ -45,6 +45,10 @@ import (
 // PacketSize is the suggested packet size for VReplication streamer.
 var PacketSize = flag.Int("vstream_packet_size", 250000, "Suggested packet size for VReplication streamer. This is used only as a recommendation. The actual packet size may be more or less than this amount.")
 
+// BatchMaxLatency is the maximum time committed transactions are held
+// to be sent together with the following ones.
+var BatchMaxLatency = flag.Duration("vstream_batch_max_latency", 0, "Maximum time the VReplication streamer holds committed transactions to send them in a single packet of up to -vstream_packet_size with the transactions that follow. 0 sends every transaction as soon as it is committed.")
+
 // HeartbeatTime is set to slightly below 1s, compared to idleTimeout
 // set by VPlayer at slightly above 1s. This minimizes conflicts
 // between the two timeouts.
@@ -192,7 +196,17 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 	var (
 		bufferedEvents []*binlogdatapb.VEvent
 		curSize        int
+		// batchDeadline is set while committed transactions are
+		// buffered, and is the time at which they must be sent.
+		batchDeadline time.Time
 	)
+	batchTimer := time.NewTimer(time.Hour)
+	batchTimer.Stop()
+	defer batchTimer.Stop()
+	send := func(vevents []*binlogdatapb.VEvent) error {
+		batchDeadline = time.Time{}
+		return vs.send(vevents)
+	}
 	// Only the following patterns are possible:
 	// BEGIN->ROWs or Statements->GTID->COMMIT. In the case of large transactions, this can be broken into chunks.
 	// BEGIN->JOURNAL->GTID->COMMIT
@@ -206,17 +220,34 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 	// If a new row event causes the packet size to be exceeded,
 	// all existing rows are sent without the new row.
 	// If a single row exceeds the packet size, it will be in its own packet.
+	//
+	// If -vstream_batch_max_latency is set, a COMMIT is not sent right away
+	// unless the packet size is reached: the transaction is sent with the
+	// ones that follow it within that time.
 	bufferAndTransmit := func(vevent *binlogdatapb.VEvent) error {
 		switch vevent.Type {
+		case binlogdatapb.VEventType_COMMIT:
+			bufferedEvents = append(bufferedEvents, vevent)
+			if *BatchMaxLatency > 0 && curSize < *PacketSize {
+				if batchDeadline.IsZero() {
+					batchDeadline = time.Now().Add(*BatchMaxLatency)
+					batchTimer.Reset(*BatchMaxLatency)
+				}
+				return nil
+			}
+			vevents := bufferedEvents
+			bufferedEvents = nil
+			curSize = 0
+			return send(vevents)
 		case binlogdatapb.VEventType_GTID, binlogdatapb.VEventType_BEGIN, binlogdatapb.VEventType_FIELD,
 			binlogdatapb.VEventType_JOURNAL:
 			// We never have to send GTID, BEGIN, FIELD events on their own.
 			// A JOURNAL event is always preceded by a BEGIN and followed by a COMMIT.
 			// So, we don't have to send it right away.
 			bufferedEvents = append(bufferedEvents, vevent)
-		case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER,
+		case binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER,
 			binlogdatapb.VEventType_HEARTBEAT, binlogdatapb.VEventType_VERSION:
-			// COMMIT, DDL, OTHER and HEARTBEAT must be immediately sent.
+			// DDL, OTHER and HEARTBEAT must be immediately sent.
 			// Although unlikely, it's possible to get a HEARTBEAT in the middle
 			// of a transaction. If so, we still send the partial transaction along
 			// with the heartbeat.
@@ -224,7 +255,7 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 			vevents := bufferedEvents
 			bufferedEvents = nil
 			curSize = 0
-			return vs.send(vevents)
+			return send(vevents)
 		case binlogdatapb.VEventType_INSERT, binlogdatapb.VEventType_DELETE, binlogdatapb.VEventType_UPDATE, binlogdatapb.VEventType_REPLACE:
 			newSize := len(vevent.GetDml())
 			if curSize+newSize > *PacketSize {
@@ -232,7 +263,7 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 				vevents := bufferedEvents
 				bufferedEvents = []*binlogdatapb.VEvent{vevent}
 				curSize = newSize
-				return vs.send(vevents)
+				return send(vevents)
 			}
 			curSize += newSize
 			bufferedEvents = append(bufferedEvents, vevent)
@@ -253,7 +284,7 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 				vevents := bufferedEvents
 				bufferedEvents = []*binlogdatapb.VEvent{vevent}
 				curSize = newSize
-				return vs.send(vevents)
+				return send(vevents)
 			}
 			curSize += newSize
 			bufferedEvents = append(bufferedEvents, vevent)
@@ -330,6 +361,26 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 			vschemaUpdateCount.Add(1)
 		case <-ctx.Done():
 			return nil
+		case <-batchTimer.C:
+			if batchDeadline.IsZero() {
+				// The transactions were already sent.
+				continue
+			}
+			if wait := time.Until(batchDeadline); wait > 0 {
+				batchTimer.Reset(wait)
+				continue
+			}
+			vevents := bufferedEvents
+			bufferedEvents = nil
+			curSize = 0
+			vs.vse.vstreamerNumPackets.Add(1)
+			if err := send(vevents); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				vs.vse.errorCounts.Add("Send", 1)
+				return fmt.Errorf("error sending event: %v", err)
+			}
 		case <-timer.C:
 			now := time.Now().UnixNano()
 			if err := bufferAndTransmit(&binlogdatapb.VEvent{
@@ -1394,6 +1394,60 @@ func TestBuffering(t *testing.T) {
 	runCases(t, nil, testcases, "", nil)
 }
 
+func TestBatching(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	savedLatency := *BatchMaxLatency
+	*BatchMaxLatency = 2 * time.Second
+	defer func() { *BatchMaxLatency = savedLatency }()
+
+	execStatement(t, "create table batch_test(id int, val varbinary(128), primary key(id))")
+	defer execStatement(t, "drop table batch_test")
+	engine.se.Reload(context.Background())
+
+	testcases := []testcase{{
+		// Transactions committed together are sent in one packet.
+		input: []string{
+			"begin",
+			"insert into batch_test values (1, '123')",
+			"commit",
+			"begin",
+			"insert into batch_test values (2, '456')",
+			"commit",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:FIELD field_event:<table_name:"batch_test" fields:<name:"id" type:INT32 table:"batch_test" org_table:"batch_test" database:"vttest" org_name:"id" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"batch_test" org_table:"batch_test" database:"vttest" org_name:"val" column_length:128 charset:63 > > `,
+			`type:ROW row_event:<table_name:"batch_test" row_changes:<after:<lengths:1 lengths:3 values:"1123" > > > `,
+			`gtid`,
+			`commit`,
+			`begin`,
+			`type:ROW row_event:<table_name:"batch_test" row_changes:<after:<lengths:1 lengths:3 values:"2456" > > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}, {
+		// A DDL is sent right away, with the pending transactions.
+		input: []string{
+			"begin",
+			"insert into batch_test values (3, '789')",
+			"commit",
+			"alter table batch_test change val val varchar(128)",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:ROW row_event:<table_name:"batch_test" row_changes:<after:<lengths:1 lengths:3 values:"3789" > > > `,
+			`gtid`,
+			`commit`,
+			`gtid`,
+			`type:DDL statement:"alter table batch_test change val val varchar(128)" `,
+		}},
+	}}
+	runCases(t, nil, testcases, "", nil)
+}
+
 func TestBestEffortNameInFieldEvent(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
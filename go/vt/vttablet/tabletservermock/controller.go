@@ -200,6 +200,11 @@ func (tqsc *Controller) SetQueryRules(ruleSource string, qrs *rules.Rules) error
 	return nil
 }
 
+// SetQueryRulesWriter is part of the tabletserver.Controller interface
+func (tqsc *Controller) SetQueryRulesWriter(ruleSource string, write func(*rules.Rules) error) error {
+	return nil
+}
+
 // QueryService is part of the tabletserver.Controller interface
 func (tqsc *Controller) QueryService() queryservice.QueryService {
 	return nil
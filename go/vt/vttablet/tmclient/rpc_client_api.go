@@ -49,6 +49,10 @@ type TabletManagerClient interface {
 	// GetSchema asks the remote tablet for its database schema
 	GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	// GetSchemaForPos asks the remote tablet for its database schema as of
+	// a replication position, from the schema versions it tracks
+	GetSchemaForPos(ctx context.Context, tablet *topodatapb.Tablet, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error)
+
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
@@ -288,6 +288,28 @@ func tmRPCTestGetSchema(ctx context.Context, t *testing.T, client tmclient.Table
 	compareError(t, "GetSchema", err, result, testGetSchemaReply)
 }
 
+var testGetSchemaForPosPosition = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-100"
+
+func (fra *fakeRPCTM) GetSchemaForPos(ctx context.Context, position string, tables, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSchemaForPos position", position, testGetSchemaForPosPosition)
+	compare(fra.t, "GetSchemaForPos tables", tables, testGetSchemaTables)
+	compare(fra.t, "GetSchemaForPos excludeTables", excludeTables, testGetSchemaExcludeTables)
+	return testGetSchemaReply, nil
+}
+
+func tmRPCTestGetSchemaForPos(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetSchemaForPos(ctx, tablet, testGetSchemaForPosPosition, testGetSchemaTables, testGetSchemaExcludeTables)
+	compareError(t, "GetSchemaForPos", err, result, testGetSchemaReply)
+}
+
+func tmRPCTestGetSchemaForPosPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetSchemaForPos(ctx, tablet, testGetSchemaForPosPosition, testGetSchemaTables, testGetSchemaExcludeTables)
+	expectHandleRPCPanic(t, "GetSchema", false /*verbose*/, err)
+}
+
 func tmRPCTestGetSchemaPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
 	_, err := client.GetSchema(ctx, tablet, testGetSchemaTables, testGetSchemaExcludeTables, true)
 	expectHandleRPCPanic(t, "GetSchema", false /*verbose*/, err)
@@ -1244,6 +1266,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	tmRPCTestPing(ctx, t, client, tablet)
 	tmRPCTestGetSchema(ctx, t, client, tablet)
+	tmRPCTestGetSchemaForPos(ctx, t, client, tablet)
 	tmRPCTestGetPermissions(ctx, t, client, tablet)
 
 	// Various read-write methods
@@ -1300,6 +1323,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	tmRPCTestPingPanic(ctx, t, client, tablet)
 	tmRPCTestGetSchemaPanic(ctx, t, client, tablet)
+	tmRPCTestGetSchemaForPosPanic(ctx, t, client, tablet)
 	tmRPCTestGetPermissionsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
@@ -33,6 +33,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
@@ -94,6 +95,10 @@ type tableDiffer struct {
 	// comparePKs is the list of pk columns to compare. The logic
 	// for comparing pk columns is different from compareCols
 	comparePKs []int
+	// collations contains the collations of the text columns that
+	// are compared in vitess instead of through their weight string.
+	// It's indexed by column number, and nil for the other columns.
+	collations []collations.Collation
 
 	// source Primitive and targetPrimitive are used for streaming
 	// results from source and target.
@@ -419,21 +424,36 @@ func (df *vdiff) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, quer
 			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
 		}
 	}
-	fields := make(map[string]querypb.Type)
+	fields := make(map[string]*querypb.Field)
 	for _, field := range table.Fields {
-		fields[strings.ToLower(field.Name)] = field.Type
+		fields[strings.ToLower(field.Name)] = field
+	}
+	pks := make(map[string]bool)
+	for _, pk := range table.PrimaryKeyColumns {
+		pks[strings.ToLower(pk)] = true
 	}
 
 	// Start with adding all columns for comparison.
 	td.compareCols = make([]int, len(sourceSelect.SelectExprs))
 	for i := range td.compareCols {
 		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
-		typ, ok := fields[colname]
+		field, ok := fields[colname]
 		if !ok {
 			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
 		}
 		td.compareCols[i] = i
-		if sqltypes.IsText(typ) {
+		if !sqltypes.IsText(field.Type) {
+			continue
+		}
+		// Text columns that have a supported collation are compared in vitess. The pk columns
+		// keep their weight string: the rows are merge sorted on them, in the order of MySQL.
+		// So do the columns with an unsupported collation, which LookupByID reports as nil.
+		if coll := collations.LookupByID(collations.ID(field.Charset)); coll != nil && !pks[colname] {
+			if td.collations == nil {
+				td.collations = make([]collations.Collation, len(td.compareCols))
+			}
+			td.collations[i] = coll
+		} else {
 			// For text columns, we need to additionally pull their weight string values for lexical comparisons.
 			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, wrapWeightString(sourceSelect.SelectExprs[i]))
 			targetSelect.SelectExprs = append(targetSelect.SelectExprs, wrapWeightString(targetSelect.SelectExprs[i]))
@@ -961,7 +981,11 @@ func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int
 		if col == -1 {
 			continue
 		}
-		c, err := evalengine.NullsafeCompare(sourceRow[col], targetRow[col])
+		var coll collations.Collation
+		if col < len(td.collations) {
+			coll = td.collations[col]
+		}
+		c, err := evalengine.NullsafeCompareCollation(sourceRow[col], targetRow[col], coll)
 		if err != nil {
 			return 0, err
 		}
@@ -31,8 +31,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
@@ -59,6 +61,16 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			Columns:           []string{"c1", "c2"},
 			PrimaryKeyColumns: []string{"c1", "c2"},
 			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}, {
+			Name:              "collated",
+			Columns:           []string{"c1", "textcol"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            collatedFields(sqltypes.MakeTestFields("c1|textcol", "varchar|varchar"), 45),
+		}, {
+			Name:              "uncollated",
+			Columns:           []string{"c1", "textcol"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            collatedFields(sqltypes.MakeTestFields("c1|textcol", "varchar|varchar"), 8),
 		}, {
 			Name:              "aggr",
 			Columns:           []string{"c1", "c2", "c3", "c4"},
@@ -177,6 +189,37 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourcePrimitive:  newMergeSorter(nil, []int{1}),
 			targetPrimitive:  newMergeSorter(nil, []int{1}),
 		},
+	}, {
+		// text columns with a known collation: only the pk column needs a weight string.
+		input: &binlogdatapb.Rule{
+			Match: "collated",
+		},
+		table: "collated",
+		td: &tableDiffer{
+			targetTable:      "collated",
+			sourceExpression: "select c1, textcol, weight_string(c1) from collated order by c1 asc",
+			targetExpression: "select c1, textcol, weight_string(c1) from collated order by c1 asc",
+			compareCols:      []int{-1, 1},
+			comparePKs:       []int{2},
+			collations:       []collations.Collation{nil, collations.LookupByID(45)},
+			sourcePrimitive:  newMergeSorter(nil, []int{2}),
+			targetPrimitive:  newMergeSorter(nil, []int{2}),
+		},
+	}, {
+		// text columns with an unsupported collation, like latin1_swedish_ci: all of them need a weight string.
+		input: &binlogdatapb.Rule{
+			Match: "uncollated",
+		},
+		table: "uncollated",
+		td: &tableDiffer{
+			targetTable:      "uncollated",
+			sourceExpression: "select c1, textcol, weight_string(c1), weight_string(textcol) from uncollated order by c1 asc",
+			targetExpression: "select c1, textcol, weight_string(c1), weight_string(textcol) from uncollated order by c1 asc",
+			compareCols:      []int{-1, 3},
+			comparePKs:       []int{2},
+			sourcePrimitive:  newMergeSorter(nil, []int{2}),
+			targetPrimitive:  newMergeSorter(nil, []int{2}),
+		},
 	}, {
 		// pk text column.
 		input: &binlogdatapb.Rule{
@@ -378,6 +421,30 @@ func TestVDiffPlanSuccess(t *testing.T) {
 	}
 }
 
+func collatedFields(fields []*querypb.Field, collation uint32) []*querypb.Field {
+	for _, field := range fields {
+		field.Charset = collation
+	}
+	return fields
+}
+
+func TestVDiffCompareCollation(t *testing.T) {
+	td := &tableDiffer{
+		compareCols: []int{-1, 1, 2},
+		collations:  []collations.Collation{nil, collations.LookupByName("utf8mb4_general_ci"), nil},
+	}
+	source := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("Résumé"), sqltypes.NewVarBinary("a")}
+	target := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("resume "), sqltypes.NewVarBinary("a")}
+	c, err := td.compare(source, target, td.compareCols)
+	require.NoError(t, err)
+	assert.Equal(t, 0, c)
+
+	target[1] = sqltypes.NewVarChar("resumes")
+	c, err = td.compare(source, target, td.compareCols)
+	require.NoError(t, err)
+	assert.Equal(t, -1, c)
+}
+
 func TestVDiffPlanFailure(t *testing.T) {
 	schm := &tabletmanagerdatapb.SchemaDefinition{
 		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{